@@ -6,6 +6,9 @@ git reset --hard
 
 go generate gonum.org/v1/gonum/blas
 go generate gonum.org/v1/gonum/blas/gonum
+go generate gonum.org/v1/gonum/lapack
+go generate gonum.org/v1/gonum/lapack/gonum
+go generate gonum.org/v1/gonum/mat32
 go generate gonum.org/v1/gonum/unit
 go generate gonum.org/v1/gonum/unit/constant
 go generate gonum.org/v1/gonum/graph/formats/dot
@@ -148,8 +148,8 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []f
 	// available and completed cases.
 	//
 	// http://alexkr.com/docs/matrixmult.pdf is a good reference on matrix-matrix
-	// multiplies, though this code does not copy matrices to attempt to eliminate
-	// cache misses.
+	// multiplies. When an assembly micro-kernel is available, dgemmSerial copies
+	// the sub-blocks into packed buffers to reduce cache misses.
 
 	maxKLen := k
 	parBlocks := blocks(m, blockSize) * blocks(n, blockSize)
@@ -215,6 +215,10 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []f
 
 // dgemmSerial is serial matrix multiply
 func dgemmSerial(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, alpha float64) {
+	if f64.UseGemmKernel() && m >= f64.GemmMR && n >= f64.GemmNR {
+		dgemmSerialPacked(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+		return
+	}
 	switch {
 	case !aTrans && !bTrans:
 		dgemmSerialNotNot(m, n, k, a, lda, b, ldb, c, ldc, alpha)
@@ -292,6 +296,122 @@ func dgemmSerialTransTrans(m, n, k int, a []float64, lda int, b []float64, ldb i
 	}
 }
 
+// dgemmPackedK is the maximum number of columns of A and rows of B packed at a
+// time by dgemmSerialPacked.
+const dgemmPackedK = 256
+
+// dgemmPool holds the buffers used for packing by dgemmSerialPacked.
+var dgemmPool = sync.Pool{
+	New: func() interface{} { return new([]float64) },
+}
+
+// dgemmSerialPacked is serial matrix multiply using the f64.GemmKernel
+// micro-kernel. Panels of A and B are copied into contiguous buffers in the
+// order the micro-kernel reads them, so that the kernel can update each
+// f64.GemmMR×f64.GemmNR block of C with unit-stride loads.
+func dgemmSerialPacked(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, alpha float64) {
+	const (
+		mr = f64.GemmMR
+		nr = f64.GemmNR
+	)
+	mp := blocks(m, mr)
+	np := blocks(n, nr)
+	kc := min(k, dgemmPackedK)
+
+	bufA := dgemmPool.Get().(*[]float64)
+	bufB := dgemmPool.Get().(*[]float64)
+	defer dgemmPool.Put(bufA)
+	defer dgemmPool.Put(bufB)
+	packA := growFloat64s(bufA, mp*mr*kc)
+	packB := growFloat64s(bufB, np*nr*kc)
+
+	// edge holds the result for the blocks of C at the bottom and right edges.
+	var edge [mr * nr]float64
+
+	for l := 0; l < k; l += kc {
+		lenk := min(kc, k-l)
+		dgemmPackA(aTrans, m, lenk, a, lda, l, packA)
+		dgemmPackB(bTrans, n, lenk, b, ldb, l, packB)
+		for jp := 0; jp < np; jp++ {
+			j := jp * nr
+			bp := packB[jp*nr*lenk : (jp+1)*nr*lenk]
+			for ip := 0; ip < mp; ip++ {
+				i := ip * mr
+				ap := packA[ip*mr*lenk : (ip+1)*mr*lenk]
+				if i+mr <= m && j+nr <= n {
+					f64.GemmKernel(uintptr(lenk), alpha, ap, bp, c[i*ldc+j:(i+mr-1)*ldc+j+nr], uintptr(ldc))
+					continue
+				}
+				edge = [mr * nr]float64{}
+				f64.GemmKernel(uintptr(lenk), alpha, ap, bp, edge[:], nr)
+				for ii := 0; ii < min(mr, m-i); ii++ {
+					ctmp := c[(i+ii)*ldc+j : (i+ii)*ldc+min(j+nr, n)]
+					for jj := range ctmp {
+						ctmp[jj] += edge[ii*nr+jj]
+					}
+				}
+			}
+		}
+	}
+}
+
+// dgemmPackA copies the m×lenk block of op(A) starting at column l into
+// panels of f64.GemmMR rows stored column by column. Rows beyond m in the last
+// panel are set to zero.
+func dgemmPackA(aTrans bool, m, lenk int, a []float64, lda, l int, dst []float64) {
+	const mr = f64.GemmMR
+	for i := 0; i < m; i += mr {
+		p := dst[i*lenk : (i+mr)*lenk]
+		rows := min(mr, m-i)
+		for ll := 0; ll < lenk; ll++ {
+			col := p[ll*mr : ll*mr+mr]
+			if aTrans {
+				copy(col, a[(l+ll)*lda+i:(l+ll)*lda+i+rows])
+			} else {
+				for ii := 0; ii < rows; ii++ {
+					col[ii] = a[(i+ii)*lda+l+ll]
+				}
+			}
+			for ii := rows; ii < mr; ii++ {
+				col[ii] = 0
+			}
+		}
+	}
+}
+
+// dgemmPackB copies the lenk×n block of op(B) starting at row l into panels of
+// f64.GemmNR columns stored row by row. Columns beyond n in the last panel are
+// set to zero.
+func dgemmPackB(bTrans bool, n, lenk int, b []float64, ldb, l int, dst []float64) {
+	const nr = f64.GemmNR
+	for j := 0; j < n; j += nr {
+		p := dst[j*lenk : (j+nr)*lenk]
+		cols := min(nr, n-j)
+		for ll := 0; ll < lenk; ll++ {
+			row := p[ll*nr : ll*nr+nr]
+			if bTrans {
+				for jj := 0; jj < cols; jj++ {
+					row[jj] = b[(j+jj)*ldb+l+ll]
+				}
+			} else {
+				copy(row, b[(l+ll)*ldb+j:(l+ll)*ldb+j+cols])
+			}
+			for jj := cols; jj < nr; jj++ {
+				row[jj] = 0
+			}
+		}
+	}
+}
+
+// growFloat64s returns a slice of length n backed by the buffer held in buf,
+// reallocating it if its capacity is less than n.
+func growFloat64s(buf *[]float64, n int) []float64 {
+	if cap(*buf) < n {
+		*buf = make([]float64, n)
+	}
+	return (*buf)[:n]
+}
+
 func sliceView64(a []float64, lda, i, j, r, c int) []float64 {
 	return a[i*lda+j : (i+r-1)*lda+j+c]
 }
@@ -5,6 +5,9 @@
 package testblas
 
 import (
+	"fmt"
+	"math"
+	"math/rand/v2"
 	"testing"
 
 	"gonum.org/v1/gonum/blas"
@@ -241,6 +244,105 @@ func TestDgemm(t *testing.T, blasser Dgemmer) {
 		dgemmcomp(i, "RowMajorTransBoth", t, blasser, blas.Trans, blas.Trans,
 			test.m, test.n, test.k, test.alpha, test.beta, transpose(test.a), transpose(test.b), test.c, test.ans)
 	}
+
+	// Test random matrices with sizes that exercise blocked and packed
+	// implementations, including partial blocks at the edges.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, m := range []int{1, 3, 4, 9, 33, 70} {
+				for _, n := range []int{1, 7, 8, 17, 70} {
+					for _, k := range []int{1, 9, 300} {
+						dgemmRandomTest(t, blasser, tA, tB, m, n, k, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgemmRandomTest(t *testing.T, blasser Dgemmer, tA, tB blas.Transpose, m, n, k int, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	rowA, colA := m, k
+	if tA != blas.NoTrans {
+		rowA, colA = k, m
+	}
+	rowB, colB := k, n
+	if tB != blas.NoTrans {
+		rowB, colB = n, k
+	}
+	lda := colA + 2
+	ldb := colB + 3
+	ldc := n + 4
+	for _, beta := range []float64{0, 1, -0.5} {
+		const alpha = 0.7
+		a := make([]float64, rowA*lda)
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+		aCopy := sliceCopy(a)
+		b := make([]float64, rowB*ldb)
+		for i := range b {
+			b[i] = rnd.NormFloat64()
+		}
+		bCopy := sliceCopy(b)
+		c := make([]float64, m*ldc)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+
+		// Compute the expected result using a naive matrix multiplication.
+		want := sliceCopy(c)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				var sum float64
+				for l := 0; l < k; l++ {
+					var av, bv float64
+					if tA == blas.NoTrans {
+						av = a[i*lda+l]
+					} else {
+						av = a[l*lda+i]
+					}
+					if tB == blas.NoTrans {
+						bv = b[l*ldb+j]
+					} else {
+						bv = b[j*ldb+l]
+					}
+					sum += av * bv
+				}
+				want[i*ldc+j] = alpha * sum
+				if beta != 0 {
+					want[i*ldc+j] += beta * c[i*ldc+j]
+				}
+			}
+		}
+
+		blasser.Dgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+
+		prefix := fmt.Sprintf("tA=%v,tB=%v,m=%v,n=%v,k=%v,beta=%v", transString(tA), transString(tB), m, n, k, beta)
+		if !dSliceEqual(a, aCopy) {
+			t.Errorf("%v: unexpected modification of A", prefix)
+		}
+		if !dSliceEqual(b, bCopy) {
+			t.Errorf("%v: unexpected modification of B", prefix)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < ldc; j++ {
+				got := c[i*ldc+j]
+				if j >= n {
+					// Elements outside of the matrix must not be modified.
+					if got != want[i*ldc+j] {
+						t.Errorf("%v: unexpected modification of C outside of the matrix at (%d,%d)", prefix, i, j)
+					}
+					continue
+				}
+				if math.Abs(got-want[i*ldc+j]) > tol*float64(k) {
+					t.Errorf("%v: unexpected C at (%d,%d); got %v, want %v", prefix, i, j, got, want[i*ldc+j])
+				}
+			}
+		}
+	}
 }
 
 func dgemmcomp(i int, name string, t *testing.T, blasser Dgemmer, tA, tB blas.Transpose, m, n, k int,
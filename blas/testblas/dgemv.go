@@ -5,6 +5,9 @@
 package testblas
 
 import (
+	"fmt"
+	"math"
+	"math/rand/v2"
 	"testing"
 
 	"gonum.org/v1/gonum/blas"
@@ -638,6 +641,91 @@ func DgemvTest(t *testing.T, blasser Dgemver) {
 			dgemvbad(t, test, cas, i, blasser)
 		}
 	}
+
+	// Test random matrices with sizes that exercise unrolled
+	// implementations, including partial blocks at the edges.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, m := range []int{1, 3, 4, 5, 9, 17, 40} {
+			for _, n := range []int{1, 3, 4, 7, 8, 13, 40} {
+				for _, inc := range []int{1, 2, -1} {
+					dgemvRandomTest(t, blasser, tA, m, n, inc, rnd)
+				}
+			}
+		}
+	}
+}
+
+func dgemvRandomTest(t *testing.T, blasser Dgemver, tA blas.Transpose, m, n, inc int, rnd *rand.Rand) {
+	const tol = 1e-14
+
+	lenX, lenY := n, m
+	if tA != blas.NoTrans {
+		lenX, lenY = m, n
+	}
+	lda := n + 3
+	for _, beta := range []float64{0, 1, -0.5} {
+		const alpha = 0.7
+		a := make([]float64, m*lda)
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+		aCopy := sliceCopy(a)
+		x := make([]float64, lenX)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		y := make([]float64, lenY)
+		for i := range y {
+			if beta == 0 {
+				// y must not be read when beta is zero.
+				y[i] = math.NaN()
+			} else {
+				y[i] = rnd.NormFloat64()
+			}
+		}
+
+		// Compute the expected result using a naive matrix-vector product.
+		want := make([]float64, lenY)
+		for i := range want {
+			var sum float64
+			for j := range x {
+				if tA == blas.NoTrans {
+					sum += a[i*lda+j] * x[j]
+				} else {
+					sum += a[j*lda+i] * x[j]
+				}
+			}
+			want[i] = alpha * sum
+			if beta != 0 {
+				want[i] += beta * y[i]
+			}
+		}
+
+		xInc := makeIncremented(x, inc, 0)
+		xIncCopy := sliceCopy(xInc)
+		yInc := makeIncremented(y, inc, 0)
+		blasser.Dgemv(tA, m, n, alpha, a, lda, xInc, inc, beta, yInc, inc)
+
+		prefix := fmt.Sprintf("tA=%v,m=%v,n=%v,inc=%v,beta=%v", transString(tA), m, n, inc, beta)
+		if !dSliceEqual(a, aCopy) {
+			t.Errorf("%v: unexpected modification of A", prefix)
+		}
+		if !dSliceEqual(xInc, xIncCopy) {
+			t.Errorf("%v: unexpected modification of x", prefix)
+		}
+		iy := 0
+		if inc < 0 {
+			iy = -(lenY - 1) * inc
+		}
+		for i := range want {
+			got := yInc[iy]
+			if math.Abs(got-want[i]) > tol*float64(lenX) {
+				t.Errorf("%v: unexpected y[%d]; got %v, want %v", prefix, i, got, want[i])
+			}
+			iy += inc
+		}
+	}
 }
 
 func dgemvcomp(t *testing.T, test DgemvCase, cas DgemvSubcase, i int, blasser Dgemver) {
@@ -0,0 +1,589 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"cmp"
+	"math"
+	"math/cmplx"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Lanczos computes k eigenvalues and eigenvectors of the n×n symmetric matrix
+// A using the implicitly restarted Lanczos method. which specifies the part of
+// the spectrum that is computed, Largest or Smallest for the algebraically
+// largest or smallest eigenvalues, or LargestMagnitude. k must satisfy
+// 0 < k <= n. Only the product of A with vectors is used and the symmetry of
+// A is not checked.
+//
+// As with other single-vector Krylov methods, only one copy of a multiple
+// eigenvalue is found in general, together with a vector in its eigenspace.
+//
+// settings provide means for adjusting the iterative process. Zero values of
+// the fields mean default values. If settings is nil, the default settings
+// are used.
+//
+// If the wanted eigenpairs do not converge within the allowed number of
+// restarts, ErrRestartLimit is returned together with the current
+// approximations.
+func Lanczos(a Operator, k int, which Which, settings *Settings) (*SymResult, error) {
+	n := checkProblem(a, k, which)
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	defaultSettings(&s, n, k, 1)
+
+	f := newArnoldi(a, true, n, s)
+	rz, err := f.solve(k, which, s.Tolerance, s.MaxRestarts)
+	if rz == nil {
+		return nil, err
+	}
+	res := &SymResult{
+		Values:  make([]float64, k),
+		Vectors: mat.NewDense(n, k, nil),
+		Stats:   f.stats,
+	}
+	for i := range res.Values {
+		res.Values[i] = real(rz.values[i])
+	}
+	res.Vectors.Mul(f.v.T(), rz.yr.Slice(0, f.m, 0, k))
+	return res, err
+}
+
+// Arnoldi computes k eigenvalues and eigenvectors of the n×n matrix A using
+// the implicitly restarted Arnoldi method. which specifies the part of the
+// spectrum that is computed, Largest or Smallest for the eigenvalues with the
+// largest or smallest real part, or LargestMagnitude. k must satisfy
+// 0 < k <= n. Only the product of A with vectors is used. As with Lanczos,
+// only one copy of a multiple eigenvalue is found in general.
+//
+// settings provide means for adjusting the iterative process. Zero values of
+// the fields mean default values. If settings is nil, the default settings
+// are used.
+//
+// If the wanted eigenpairs do not converge within the allowed number of
+// restarts, ErrRestartLimit is returned together with the current
+// approximations.
+func Arnoldi(a Operator, k int, which Which, settings *Settings) (*Result, error) {
+	n := checkProblem(a, k, which)
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	defaultSettings(&s, n, k, 2)
+
+	f := newArnoldi(a, false, n, s)
+	rz, err := f.solve(k, which, s.Tolerance, s.MaxRestarts)
+	if rz == nil {
+		return nil, err
+	}
+	res := &Result{
+		Values:  make([]complex128, k),
+		Vectors: mat.NewCDense(n, k, nil),
+		Stats:   f.stats,
+	}
+	copy(res.Values, rz.values)
+	var xr, xi mat.Dense
+	xr.Mul(f.v.T(), rz.yr.Slice(0, f.m, 0, k))
+	xi.Mul(f.v.T(), rz.yi.Slice(0, f.m, 0, k))
+	for i := 0; i < n; i++ {
+		for j := 0; j < k; j++ {
+			res.Vectors.Set(i, j, complex(xr.At(i, j), xi.At(i, j)))
+		}
+	}
+	return res, err
+}
+
+// checkProblem checks the operator, the number of wanted eigenvalues and
+// which, and returns the order of the operator.
+func checkProblem(a Operator, k int, which Which) int {
+	n, c := a.Dims()
+	if n != c {
+		panic("eigsolve: operator not square")
+	}
+	if k <= 0 || n < k {
+		panic("eigsolve: invalid number of eigenvalues")
+	}
+	if which < Largest || LargestMagnitude < which {
+		panic("eigsolve: invalid Which")
+	}
+	return n
+}
+
+// arnoldi is an m-step Arnoldi factorization
+//
+//	A * Vᵀ = Vᵀ * H + β * f * e_mᵀ,
+//
+// where the rows of V are orthonormal, H is upper Hessenberg and f is a unit
+// vector orthogonal to the rows of V. If A is symmetric, H is symmetric
+// tridiagonal and the factorization is a Lanczos factorization.
+type arnoldi struct {
+	a    Operator
+	sym  bool
+	n, m int
+
+	v    *mat.Dense
+	h    *mat.Dense
+	f    *mat.VecDense
+	beta float64
+
+	work  *mat.VecDense
+	norm  func() float64
+	stats Stats
+}
+
+// newArnoldi returns a factorization of A with subspace dimension
+// s.SubspaceDim and with the first basis vector set to the normalized
+// starting vector.
+func newArnoldi(a Operator, sym bool, n int, s Settings) *arnoldi {
+	m := s.SubspaceDim
+	f := &arnoldi{
+		a:    a,
+		sym:  sym,
+		n:    n,
+		m:    m,
+		v:    mat.NewDense(m, n, nil),
+		h:    mat.NewDense(m, m, nil),
+		f:    mat.NewVecDense(n, nil),
+		work: mat.NewVecDense(n, nil),
+		norm: normFloat64(s.Src),
+	}
+	v0 := f.row(0)
+	if s.InitVector != nil {
+		v0.CopyVec(s.InitVector)
+	} else {
+		for i := 0; i < n; i++ {
+			v0.SetVec(i, f.norm())
+		}
+	}
+	nrm := mat.Norm(v0, 2)
+	if nrm == 0 {
+		panic("eigsolve: zero initial vector")
+	}
+	v0.ScaleVec(1/nrm, v0)
+	return f
+}
+
+// row returns a view of the j-th basis vector.
+func (f *arnoldi) row(j int) *mat.VecDense {
+	return mat.NewVecDense(f.n, f.v.RawRowView(j))
+}
+
+// extend extends a j0-step factorization to an m-step factorization.
+func (f *arnoldi) extend(j0 int) {
+	w := f.work
+	for j := j0; j < f.m; j++ {
+		if j > 0 {
+			f.h.Set(j, j-1, f.beta)
+			if f.sym {
+				f.h.Set(j-1, j, f.beta)
+			}
+			f.row(j).CopyVec(f.f)
+		}
+		f.a.MulVecTo(w, false, f.row(j))
+		f.stats.MulVec++
+		wnorm := mat.Norm(w, 2)
+
+		c := f.orthogonalize(w, j+1)
+		if f.sym {
+			// The other coefficients vanish in exact arithmetic.
+			f.h.Set(j, j, c.AtVec(j))
+		} else {
+			for i := 0; i <= j; i++ {
+				f.h.Set(i, j, c.AtVec(i))
+			}
+		}
+
+		f.beta = mat.Norm(w, 2)
+		if f.beta > dlamchE*wnorm {
+			f.f.ScaleVec(1/f.beta, w)
+			continue
+		}
+		// The basis spans an invariant subspace of A. Continue with a
+		// random vector orthogonal to it.
+		f.beta = 0
+		if j+1 < f.n {
+			f.randomOrthogonal(j + 1)
+		} else {
+			f.f.Zero()
+		}
+	}
+}
+
+// orthogonalize orthogonalizes w against the first j basis vectors and
+// returns the coefficients of the projection. Classical Gram-Schmidt is
+// applied twice to maintain orthogonality to working precision.
+func (f *arnoldi) orthogonalize(w *mat.VecDense, j int) *mat.VecDense {
+	basis := f.v.Slice(0, j, 0, f.n)
+	c := mat.NewVecDense(j, nil)
+	var d, tmp mat.VecDense
+	for range 2 {
+		d.MulVec(basis, w)
+		tmp.MulVec(basis.T(), &d)
+		w.SubVec(w, &tmp)
+		c.AddVec(c, &d)
+	}
+	return c
+}
+
+// randomOrthogonal sets f to a random unit vector orthogonal to the first j
+// basis vectors.
+func (f *arnoldi) randomOrthogonal(j int) {
+	w := f.work
+	for {
+		for i := 0; i < f.n; i++ {
+			w.SetVec(i, f.norm())
+		}
+		nrm0 := mat.Norm(w, 2)
+		f.orthogonalize(w, j)
+		nrm := mat.Norm(w, 2)
+		if nrm > dlamchE*nrm0 {
+			f.f.ScaleVec(1/nrm, w)
+			return
+		}
+	}
+}
+
+// ritzPairs holds the Ritz pairs of an Arnoldi factorization ordered by
+// preference.
+type ritzPairs struct {
+	values []complex128
+	// bounds holds the residual norms |A*x - λ*x| of the Ritz pairs.
+	bounds []float64
+	// yr and yi hold the real and imaginary parts of the Ritz vectors of
+	// H in their columns. yi is nil for a symmetric factorization.
+	yr, yi *mat.Dense
+}
+
+// ritz returns the Ritz pairs of the factorization ordered according to
+// which.
+func (f *arnoldi) ritz(which Which) (*ritzPairs, error) {
+	m := f.m
+	values := make([]complex128, m)
+	var yr, yi mat.Dense
+	if f.sym {
+		t := mat.NewSymDense(m, nil)
+		for i := 0; i < m; i++ {
+			t.SetSym(i, i, f.h.At(i, i))
+			if i > 0 {
+				t.SetSym(i-1, i, f.h.At(i, i-1))
+			}
+		}
+		var es mat.EigenSym
+		if !es.Factorize(t, true) {
+			return nil, ErrProjection
+		}
+		for i, v := range es.Values(nil) {
+			values[i] = complex(v, 0)
+		}
+		es.VectorsTo(&yr)
+	} else {
+		var eig mat.Eigen
+		if !eig.Factorize(f.h, mat.EigenRight) {
+			return nil, ErrProjection
+		}
+		eig.Values(values)
+		var y mat.CDense
+		eig.VectorsTo(&y)
+		yr.ReuseAs(m, m)
+		yi.ReuseAs(m, m)
+		for j := 0; j < m; j++ {
+			var nrm float64
+			for i := 0; i < m; i++ {
+				nrm = math.Hypot(nrm, cmplx.Abs(y.At(i, j)))
+			}
+			for i := 0; i < m; i++ {
+				v := y.At(i, j) / complex(nrm, 0)
+				yr.Set(i, j, real(v))
+				yi.Set(i, j, imag(v))
+			}
+		}
+	}
+
+	perm := make([]int, m)
+	for i := range perm {
+		perm[i] = i
+	}
+	slices.SortStableFunc(perm, func(i, j int) int {
+		return compareRitz(values[i], values[j], which)
+	})
+
+	rz := &ritzPairs{
+		values: make([]complex128, m),
+		bounds: make([]float64, m),
+		yr:     mat.NewDense(m, m, nil),
+	}
+	if !f.sym {
+		rz.yi = mat.NewDense(m, m, nil)
+	}
+	col := make([]float64, m)
+	for j, p := range perm {
+		rz.values[j] = values[p]
+		rz.yr.SetCol(j, mat.Col(col, p, &yr))
+		last := complex(yr.At(m-1, p), 0)
+		if !f.sym {
+			rz.yi.SetCol(j, mat.Col(col, p, &yi))
+			last += complex(0, yi.At(m-1, p))
+		}
+		rz.bounds[j] = f.beta * cmplx.Abs(last)
+	}
+	return rz, nil
+}
+
+// compareRitz returns a negative number if a is preferred over b according
+// to which, a positive number if b is preferred over a, and zero otherwise.
+// Ties are broken so that complex conjugate pairs are adjacent with the
+// positive imaginary part first.
+func compareRitz(a, b complex128, which Which) int {
+	switch which {
+	case Largest:
+		if c := cmp.Compare(real(b), real(a)); c != 0 {
+			return c
+		}
+	case Smallest:
+		if c := cmp.Compare(real(a), real(b)); c != 0 {
+			return c
+		}
+	case LargestMagnitude:
+		if c := cmp.Compare(cmplx.Abs(b), cmplx.Abs(a)); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(real(b), real(a)); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(imag(b), imag(a))
+}
+
+// solve runs the implicitly restarted Arnoldi iteration until the k wanted
+// Ritz pairs have converged or the restart limit has been reached, and
+// returns the final Ritz pairs.
+func (f *arnoldi) solve(k int, which Which, tol float64, maxRestarts int) (*ritzPairs, error) {
+	var kcur int
+	for {
+		f.extend(kcur)
+		rz, err := f.ritz(which)
+		if err != nil {
+			return nil, err
+		}
+
+		var anorm float64
+		for _, v := range rz.values {
+			anorm = math.Max(anorm, cmplx.Abs(v))
+		}
+		var nconv int
+		for _, b := range rz.bounds[:k] {
+			if b <= tol*anorm {
+				nconv++
+			}
+		}
+		if nconv == k {
+			return rz, nil
+		}
+		if f.stats.Restarts == maxRestarts {
+			return rz, ErrRestartLimit
+		}
+
+		// Keep some of the converged unwanted Ritz values to prevent
+		// stagnation, as is done in ARPACK.
+		kk := k + min(nconv, (f.m-k)/2)
+		if kk == 1 {
+			// Keeping a single vector makes the restart polynomial
+			// too aggressive.
+			if f.m >= 6 {
+				kk = f.m / 2
+			} else if f.m > 2 {
+				kk = 2
+			}
+		}
+		if !f.sym && imag(rz.values[kk-1]) > 0 {
+			// Do not split a complex conjugate pair.
+			if kk+1 < f.m {
+				kk++
+			} else {
+				kk--
+			}
+		}
+		f.restart(kk, rz.values[kk:])
+		f.stats.Restarts++
+		kcur = kk
+	}
+}
+
+// restart applies the shifts to the m-step factorization and truncates it to
+// a k-step factorization. Complex shifts must come in adjacent conjugate
+// pairs.
+func (f *arnoldi) restart(k int, shifts []complex128) {
+	m, n := f.m, f.n
+	q := mat.NewDense(m, m, nil)
+	for i := 0; i < m; i++ {
+		q.Set(i, i, 1)
+	}
+	for i := 0; i < len(shifts); i++ {
+		mu := shifts[i]
+		if imag(mu) == 0 {
+			qrStep(f.h, q, false, real(mu), 0)
+			continue
+		}
+		qrStep(f.h, q, true, 2*real(mu), real(mu)*real(mu)+imag(mu)*imag(mu))
+		i++
+	}
+	if f.sym {
+		// Restore the symmetric tridiagonal structure lost to rounding.
+		for i := 0; i < m; i++ {
+			for j := 0; j < m; j++ {
+				switch {
+				case j == i+1:
+					v := (f.h.At(i, j) + f.h.At(j, i)) / 2
+					f.h.Set(i, j, v)
+					f.h.Set(j, i, v)
+				case j > i+1:
+					f.h.Set(i, j, 0)
+					f.h.Set(j, i, 0)
+				}
+			}
+		}
+	}
+
+	// Update the basis and the residual vector as
+	//
+	//	V ← Q[:, :k]ᵀ * V,
+	//	f ← H[k, k-1] * (Q[:, k]ᵀ * V) + β * Q[m-1, k-1] * f.
+	var vq mat.Dense
+	vq.Mul(q.Slice(0, m, 0, k+1).T(), f.v)
+	w := f.work
+	w.ScaleVec(f.h.At(k, k-1), mat.NewVecDense(n, vq.RawRowView(k)))
+	w.AddScaledVec(w, f.beta*q.At(m-1, k-1), f.f)
+	f.v.Slice(0, k, 0, n).(*mat.Dense).Copy(vq.Slice(0, k, 0, n))
+
+	nrm0 := mat.Norm(w, 2)
+	f.orthogonalize(w, k)
+	f.beta = mat.Norm(w, 2)
+	if f.beta > dlamchE*nrm0 {
+		f.f.ScaleVec(1/f.beta, w)
+	} else {
+		f.beta = 0
+		f.randomOrthogonal(k)
+	}
+
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			if i >= k || j >= k {
+				f.h.Set(i, j, 0)
+			}
+		}
+	}
+}
+
+// qrStep applies an implicitly shifted QR step to the upper Hessenberg matrix
+// h so that h ← Pᵀ * h * P, and accumulates the orthogonal transformation
+// into q so that q ← q * P. If double is false, the single real shift s is
+// used, otherwise the double shift is formed from the roots of z² - s*z + t.
+func qrStep(h, q *mat.Dense, double bool, s, t float64) {
+	m, _ := h.Dims()
+	if m < 2 {
+		return
+	}
+	var x, y, z float64
+	width := 2
+	if double {
+		width = 3
+		h00, h01, h10, h11 := h.At(0, 0), h.At(0, 1), h.At(1, 0), h.At(1, 1)
+		x = h00*h00 + h01*h10 - s*h00 + t
+		y = h10 * (h00 + h11 - s)
+		if m > 2 {
+			z = h10 * h.At(2, 1)
+		}
+	} else {
+		x = h.At(0, 0) - s
+		y = h.At(1, 0)
+	}
+
+	var u, v [3]float64
+	for k := 0; k < m-1; k++ {
+		nr := min(width, m-k)
+		u = [3]float64{x, y, z}
+		tau := householder(v[:nr], u[:nr])
+		if tau != 0 {
+			// h ← P * h.
+			for j := max(0, k-1); j < m; j++ {
+				var d float64
+				for i := 0; i < nr; i++ {
+					d += v[i] * h.At(k+i, j)
+				}
+				d *= tau
+				for i := 0; i < nr; i++ {
+					h.Set(k+i, j, h.At(k+i, j)-d*v[i])
+				}
+			}
+			// h ← h * P.
+			for i := 0; i <= min(k+nr, m-1); i++ {
+				applyRow(h, i, k, tau, v[:nr])
+			}
+			// q ← q * P.
+			for i := 0; i < m; i++ {
+				applyRow(q, i, k, tau, v[:nr])
+			}
+		}
+		if k > 0 {
+			for i := 1; i < nr; i++ {
+				h.Set(k+i, k-1, 0)
+			}
+		}
+		x = h.At(k+1, k)
+		y, z = 0, 0
+		if k+2 < m {
+			y = h.At(k+2, k)
+		}
+		if double && k+3 < m {
+			z = h.At(k+3, k)
+		}
+	}
+	for i := 2; i < m; i++ {
+		for j := 0; j < i-1; j++ {
+			h.Set(i, j, 0)
+		}
+	}
+}
+
+// applyRow applies the reflector I - tau * v * vᵀ from the right to the
+// elements j0:j0+len(v) of the i-th row of a.
+func applyRow(a *mat.Dense, i, j0 int, tau float64, v []float64) {
+	var d float64
+	for j, vj := range v {
+		d += a.At(i, j0+j) * vj
+	}
+	d *= tau
+	for j, vj := range v {
+		a.Set(i, j0+j, a.At(i, j0+j)-d*vj)
+	}
+}
+
+// householder computes an elementary reflector I - tau * v * vᵀ with
+// v[0] = 1 that maps u to a multiple of the first unit vector, stores v into
+// the provided slice and returns tau.
+func householder(v, u []float64) (tau float64) {
+	alpha := u[0]
+	var xnorm float64
+	for _, ui := range u[1:] {
+		xnorm = math.Hypot(xnorm, ui)
+	}
+	v[0] = 1
+	if xnorm == 0 {
+		for i := 1; i < len(v); i++ {
+			v[i] = 0
+		}
+		return 0
+	}
+	beta := -math.Copysign(math.Hypot(alpha, xnorm), alpha)
+	scale := 1 / (alpha - beta)
+	for i := 1; i < len(v); i++ {
+		v[i] = u[i] * scale
+	}
+	return (beta - alpha) / beta
+}
@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package eigsolve provides iterative methods for computing a few eigenvalues
+// and singular values of large matrices.
+//
+// # Background
+//
+// The decompositions in the mat package, such as mat.EigenSym, mat.Eigen and
+// mat.SVD, compute the full spectrum of a matrix. They require O(n²) storage
+// and O(n³) arithmetic operations, which makes them impractical for large
+// matrices, for example the graph Laplacians used for spectral embedding or
+// the data matrices used for principal component analysis. Often only a few
+// extreme eigenvalues or the largest singular values are of interest.
+//
+// The methods in this package only need the matrix A through matrix-vector
+// products, so A may be a sparse matrix such as a sparse.CSR, a banded matrix
+// such as a mat.SymBandDense, or an operator that is never formed explicitly.
+// Any mat.Matrix can be used by wrapping it in a MatrixOperator.
+//
+// # Methods
+//
+// Lanczos computes k eigenpairs of a symmetric matrix and Arnoldi computes k
+// eigenpairs of a general square matrix. Both use the implicitly restarted
+// Arnoldi method of Sorensen, which is also the basis of ARPACK. A Krylov
+// subspace of dimension m > k is built, the Ritz values that are not wanted
+// are used as shifts of an implicit QR iteration applied to the small
+// projected matrix, and the compressed factorization is extended again. This
+// keeps the storage at O(n*m) while concentrating the subspace on the wanted
+// part of the spectrum. Which part is wanted is specified by a Which value.
+//
+// RandomizedSVD computes an approximate truncated singular value
+// decomposition of a general matrix using the randomized range finder of
+// Halko, Martinsson and Tropp with subspace (power) iterations.
+//
+// # References
+//
+//   - Sorensen, D.C. (1992). Implicit application of polynomial filters in a
+//     k-step Arnoldi method. SIAM J. Matrix Anal. Appl. 13(1), 357-385.
+//   - Lehoucq, R.B., Sorensen, D.C. and Yang, C. (1998). ARPACK Users' Guide.
+//     SIAM.
+//   - Halko, N., Martinsson, P.G. and Tropp, J.A. (2011). Finding structure
+//     with randomness: Probabilistic algorithms for constructing approximate
+//     matrix decompositions. SIAM Review 53(2), 217-288.
+package eigsolve // import "gonum.org/v1/gonum/eigsolve"
@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"errors"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultTolerance   = 1e-10
+	defaultMaxRestarts = 300
+	minSubspaceDim     = 20
+)
+
+var (
+	// ErrRestartLimit is returned when the maximum number of restarts was
+	// reached before all wanted eigenpairs converged.
+	ErrRestartLimit = errors.New("eigsolve: restart limit reached")
+
+	// ErrProjection is returned when the decomposition of the small
+	// projected matrix fails.
+	ErrProjection = errors.New("eigsolve: decomposition of projected matrix failed")
+)
+
+// Operator represents an m×n matrix A by means of matrix-vector products.
+// MulVecTo computes A*x or Aᵀ*x if trans is true and stores the result into
+// dst. mat.BandDense, mat.SymBandDense, mat.Tridiag, sparse.CSR and
+// sparse.CSC satisfy Operator.
+type Operator interface {
+	Dims() (r, c int)
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// MatrixOperator is an Operator that computes matrix-vector products with
+// the embedded mat.Matrix.
+type MatrixOperator struct {
+	mat.Matrix
+}
+
+// MulVecTo computes A*x or Aᵀ*x if trans is true and stores the result into
+// dst.
+func (a MatrixOperator) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	if trans {
+		dst.MulVec(a.Matrix.T(), x)
+		return
+	}
+	dst.MulVec(a.Matrix, x)
+}
+
+// Which specifies which part of the spectrum is computed.
+type Which int
+
+const (
+	// Largest specifies the eigenvalues with the largest real part.
+	Largest Which = iota
+	// Smallest specifies the eigenvalues with the smallest real part.
+	Smallest
+	// LargestMagnitude specifies the eigenvalues with the largest
+	// absolute value.
+	LargestMagnitude
+)
+
+// Settings holds settings for computing a partial eigendecomposition. See the
+// field comments for default values.
+type Settings struct {
+	// SubspaceDim is the dimension m of the Krylov subspace. Larger values
+	// usually reduce the number of restarts at the cost of O(n*m) storage
+	// and O(n*m²) work per restart. If SubspaceDim is zero, a default value
+	// of min(n, max(2*k+1, 20)) is used, otherwise it must satisfy
+	// k < m <= n for Lanczos and k+1 < m <= n for Arnoldi, unless m == n.
+	SubspaceDim int
+
+	// Tolerance specifies the convergence criterion. An eigenpair (λ, x)
+	// is accepted when
+	//
+	//	|A*x - λ*x| <= Tolerance * |A|,
+	//
+	// where |A| is estimated by the largest Ritz value in absolute value.
+	// If Tolerance is zero, a default value of 1e-10 is used, otherwise it
+	// must satisfy 0 < Tolerance < 1.
+	Tolerance float64
+
+	// MaxRestarts is the maximum number of implicit restarts.
+	// ErrRestartLimit is returned if the wanted eigenpairs have not
+	// converged within this number of restarts. If MaxRestarts is zero, a
+	// default value of 300 is used.
+	MaxRestarts int
+
+	// InitVector is the starting vector of the Krylov subspace. If it is
+	// nil, a random vector is used, otherwise its length must be equal to
+	// the order of the matrix and it must be non-zero.
+	InitVector *mat.VecDense
+
+	// Src is the source of random numbers used for the starting vector
+	// and in the rare case of an invariant subspace being found. If Src
+	// is nil, the global random source is used.
+	Src rand.Source
+}
+
+// defaultSettings fills s with default values for computing k eigenpairs of
+// a matrix of order n. minExtra is the smallest allowed difference between
+// the subspace dimension and k.
+func defaultSettings(s *Settings, n, k, minExtra int) {
+	if s.InitVector != nil && s.InitVector.Len() != n {
+		panic("eigsolve: mismatched length of initial vector")
+	}
+	if s.SubspaceDim == 0 {
+		s.SubspaceDim = min(n, max(2*k+1, minSubspaceDim))
+	}
+	if s.SubspaceDim > n || (s.SubspaceDim != n && s.SubspaceDim < k+minExtra) {
+		panic("eigsolve: invalid subspace dimension")
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = defaultTolerance
+	}
+	if s.Tolerance <= 0 || 1 <= s.Tolerance {
+		panic("eigsolve: invalid tolerance")
+	}
+	if s.MaxRestarts == 0 {
+		s.MaxRestarts = defaultMaxRestarts
+	}
+	if s.MaxRestarts < 0 {
+		panic("eigsolve: negative maximum number of restarts")
+	}
+}
+
+// Stats holds statistics about a run.
+type Stats struct {
+	// Restarts is the number of implicit restarts performed.
+	Restarts int
+
+	// MulVec is the number of matrix-vector products with A
+	// or Aᵀ performed.
+	MulVec int
+}
+
+// SymResult holds a partial eigendecomposition of a symmetric matrix.
+type SymResult struct {
+	// Values holds the computed eigenvalues ordered from the most to
+	// the least extreme according to the requested Which.
+	Values []float64
+
+	// Vectors holds the corresponding orthonormal eigenvectors
+	// in its columns.
+	Vectors *mat.Dense
+
+	// Stats holds statistics about the run.
+	Stats Stats
+}
+
+// Result holds a partial eigendecomposition of a general square matrix.
+type Result struct {
+	// Values holds the computed eigenvalues ordered from the most to
+	// the least extreme according to the requested Which.
+	Values []complex128
+
+	// Vectors holds the corresponding eigenvectors normalized to unit
+	// length in its columns.
+	Vectors *mat.CDense
+
+	// Stats holds statistics about the run.
+	Stats Stats
+}
+
+// normFloat64 returns a function generating standard normally distributed
+// values from src, or from the global source if src is nil.
+func normFloat64(src rand.Source) func() float64 {
+	if src == nil {
+		return rand.NormFloat64
+	}
+	return rand.New(src).NormFloat64
+}
+
+// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
+const dlamchE = 0x1p-53
@@ -0,0 +1,286 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randSym returns a random n×n symmetric matrix.
+func randSym(n int, rnd *rand.Rand) *mat.SymDense {
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// pathLaplacian returns the Laplacian of the path graph with n nodes. Its
+// eigenvalues are 2 - 2*cos(π*j/n) for j = 0, ..., n-1.
+func pathLaplacian(n int) *mat.SymBandDense {
+	l := mat.NewSymBandDense(n, 1, nil)
+	for i := 0; i < n; i++ {
+		d := 2.0
+		if i == 0 || i == n-1 {
+			d = 1
+		}
+		l.SetSymBand(i, i, d)
+		if i < n-1 {
+			l.SetSymBand(i, i+1, -1)
+		}
+	}
+	return l
+}
+
+func TestLanczos(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, k, m int
+	}{
+		{n: 1, k: 1},
+		{n: 5, k: 2},
+		{n: 10, k: 10},
+		{n: 50, k: 1},
+		{n: 100, k: 6},
+		{n: 100, k: 6, m: 40},
+		{n: 300, k: 10},
+	} {
+		a := randSym(test.n, rnd)
+		var es mat.EigenSym
+		es.Factorize(a, false)
+		all := es.Values(nil)
+		for _, which := range []Which{Largest, Smallest, LargestMagnitude} {
+			name := fmt.Sprintf("n=%d,k=%d,m=%d,which=%d", test.n, test.k, test.m, which)
+			res, err := Lanczos(MatrixOperator{a}, test.k, which, &Settings{
+				SubspaceDim: test.m,
+				Src:         rand.NewPCG(1, 1),
+			})
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+
+			want := slices.Clone(all)
+			slices.SortStableFunc(want, func(x, y float64) int {
+				return compareRitz(complex(x, 0), complex(y, 0), which)
+			})
+			if !floats.EqualApprox(res.Values, want[:test.k], 1e-8) {
+				t.Errorf("%s: unexpected eigenvalues:\ngot  %v\nwant %v", name, res.Values, want[:test.k])
+			}
+			checkSymPairs(t, name, a, res, 1e-8)
+		}
+	}
+}
+
+func TestLanczosLaplacian(t *testing.T) {
+	t.Parallel()
+	const n = 200
+	l := pathLaplacian(n)
+	for _, k := range []int{1, 4, 8} {
+		res, err := Lanczos(l, k, Smallest, &Settings{Src: rand.NewPCG(1, 1)})
+		if err != nil {
+			t.Errorf("k=%d: unexpected error: %v", k, err)
+			continue
+		}
+		want := make([]float64, k)
+		for j := range want {
+			want[j] = 2 - 2*math.Cos(math.Pi*float64(j)/n)
+		}
+		if !floats.EqualApprox(res.Values, want, 1e-9) {
+			t.Errorf("k=%d: unexpected eigenvalues:\ngot  %v\nwant %v", k, res.Values, want)
+		}
+		checkSymPairs(t, fmt.Sprintf("k=%d", k), l, res, 1e-8)
+	}
+}
+
+func TestLanczosRestartLimit(t *testing.T) {
+	t.Parallel()
+	l := pathLaplacian(500)
+	res, err := Lanczos(l, 5, Smallest, &Settings{MaxRestarts: 1, Src: rand.NewPCG(1, 1)})
+	if err != ErrRestartLimit {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrRestartLimit)
+	}
+	if len(res.Values) != 5 || res.Stats.Restarts != 1 {
+		t.Errorf("unexpected result for restart limit: %d values after %d restarts", len(res.Values), res.Stats.Restarts)
+	}
+}
+
+// checkSymPairs checks that the eigenvectors in res are orthonormal and that
+// the residuals of the eigenpairs are small.
+func checkSymPairs(t *testing.T, name string, a mat.Symmetric, res *SymResult, tol float64) {
+	t.Helper()
+	n, k := res.Vectors.Dims()
+	var vtv mat.Dense
+	vtv.Mul(res.Vectors.T(), res.Vectors)
+	if !mat.EqualApprox(&vtv, eye(k), 1e-12) {
+		t.Errorf("%s: eigenvectors not orthonormal", name)
+	}
+	anorm := mat.Norm(a, 2)
+	var r mat.VecDense
+	for j, v := range res.Values {
+		x := res.Vectors.ColView(j)
+		r.MulVec(a, x)
+		r.AddScaledVec(&r, -v, x)
+		if mat.Norm(&r, 2) > tol*math.Max(anorm, 1)*math.Sqrt(float64(n)) {
+			t.Errorf("%s: large residual for eigenpair %d: %v", name, j, mat.Norm(&r, 2))
+		}
+	}
+}
+
+func TestArnoldi(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, k, m int
+	}{
+		{n: 1, k: 1},
+		{n: 5, k: 2},
+		{n: 10, k: 10},
+		{n: 50, k: 1},
+		{n: 100, k: 6},
+		{n: 100, k: 6, m: 40},
+		{n: 200, k: 10},
+	} {
+		a := mat.NewDense(test.n, test.n, nil)
+		a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+		var eig mat.Eigen
+		eig.Factorize(a, mat.EigenNone)
+		all := eig.Values(nil)
+		for _, which := range []Which{Largest, Smallest, LargestMagnitude} {
+			name := fmt.Sprintf("n=%d,k=%d,m=%d,which=%d", test.n, test.k, test.m, which)
+			res, err := Arnoldi(MatrixOperator{a}, test.k, which, &Settings{
+				SubspaceDim: test.m,
+				Src:         rand.NewPCG(1, 1),
+			})
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+
+			want := slices.Clone(all)
+			slices.SortStableFunc(want, func(x, y complex128) int {
+				return compareRitz(x, y, which)
+			})
+			for i, v := range res.Values {
+				// The last wanted value may be either member of a
+				// complex conjugate pair.
+				w := want[i]
+				if i == test.k-1 && imag(w) < 0 {
+					w = cmplx.Conj(w)
+				}
+				if cmplx.Abs(v-w) > 1e-8 && cmplx.Abs(v-cmplx.Conj(w)) > 1e-8 {
+					t.Errorf("%s: unexpected eigenvalue %d: got %v, want %v", name, i, v, want[i])
+				}
+			}
+
+			anorm := mat.Norm(a, 2)
+			xr := mat.NewVecDense(test.n, nil)
+			xi := mat.NewVecDense(test.n, nil)
+			var axr, axi mat.VecDense
+			for j, v := range res.Values {
+				for i := 0; i < test.n; i++ {
+					xr.SetVec(i, real(res.Vectors.At(i, j)))
+					xi.SetVec(i, imag(res.Vectors.At(i, j)))
+				}
+				if nrm := math.Hypot(mat.Norm(xr, 2), mat.Norm(xi, 2)); math.Abs(nrm-1) > 1e-12 {
+					t.Errorf("%s: eigenvector %d not normalized: %v", name, j, nrm)
+				}
+				// Compute the real and imaginary parts of A*x - λ*x.
+				axr.MulVec(a, xr)
+				axr.AddScaledVec(&axr, -real(v), xr)
+				axr.AddScaledVec(&axr, imag(v), xi)
+				axi.MulVec(a, xi)
+				axi.AddScaledVec(&axi, -real(v), xi)
+				axi.AddScaledVec(&axi, -imag(v), xr)
+				resid := math.Hypot(mat.Norm(&axr, 2), mat.Norm(&axi, 2))
+				if resid > 1e-8*anorm*math.Sqrt(float64(test.n)) {
+					t.Errorf("%s: large residual for eigenpair %d: %v", name, j, resid)
+				}
+			}
+		}
+	}
+}
+
+func TestRandomizedSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k int
+	}{
+		{m: 1, n: 1, k: 1},
+		{m: 10, n: 5, k: 5},
+		{m: 100, n: 60, k: 5},
+		{m: 60, n: 100, k: 5},
+		{m: 300, n: 200, k: 10},
+	} {
+		// Construct a matrix with geometrically decaying singular values.
+		r := min(test.m, test.n)
+		u := randOrthonormal(test.m, r, rnd)
+		v := randOrthonormal(test.n, r, rnd)
+		sigma := make([]float64, r)
+		for i := range sigma {
+			sigma[i] = math.Pow(0.5, float64(i))
+		}
+		var us, a mat.Dense
+		us.Mul(u, mat.NewDiagDense(r, sigma))
+		a.Mul(&us, v.T())
+
+		name := fmt.Sprintf("m=%d,n=%d,k=%d", test.m, test.n, test.k)
+		res, err := RandomizedSVD(MatrixOperator{&a}, test.k, &SVDSettings{Src: rand.NewPCG(1, 1)})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.EqualApprox(res.Values, sigma[:test.k], 1e-10) {
+			t.Errorf("%s: unexpected singular values:\ngot  %v\nwant %v", name, res.Values, sigma[:test.k])
+		}
+		var utu, vtv mat.Dense
+		utu.Mul(res.U.T(), res.U)
+		vtv.Mul(res.V.T(), res.V)
+		if !mat.EqualApprox(&utu, eye(test.k), 1e-12) || !mat.EqualApprox(&vtv, eye(test.k), 1e-12) {
+			t.Errorf("%s: singular vectors not orthonormal", name)
+		}
+		// Check A*v_j = σ_j*u_j.
+		var av, su mat.Dense
+		av.Mul(&a, res.V)
+		su.Mul(res.U, mat.NewDiagDense(test.k, res.Values))
+		if !mat.EqualApprox(&av, &su, 1e-10) {
+			t.Errorf("%s: A*V != U*Σ", name)
+		}
+		if res.Stats.MulVec == 0 {
+			t.Errorf("%s: no matrix-vector products recorded", name)
+		}
+	}
+}
+
+// randOrthonormal returns a random m×n matrix with orthonormal columns.
+func randOrthonormal(m, n int, rnd *rand.Rand) *mat.Dense {
+	a := mat.NewDense(m, n, nil)
+	a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+	var qr mat.QR
+	qr.Factorize(a)
+	var q mat.Dense
+	qr.QTo(&q)
+	return q.Slice(0, m, 0, n).(*mat.Dense)
+}
+
+func eye(n int) *mat.Dense {
+	d := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		d.Set(i, i, 1)
+	}
+	return d
+}
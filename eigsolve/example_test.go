@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/eigsolve"
+	"gonum.org/v1/gonum/mat/sparse"
+)
+
+func ExampleLanczos() {
+	// Compute the smallest eigenvalues of the Laplacian of a path graph
+	// with n nodes. The eigenvalues are 2 - 2*cos(π*j/n), j = 0, ..., n-1.
+	const n = 100
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < n-1; i++ {
+		a.Append(i, i, 1)
+		a.Append(i+1, i+1, 1)
+		a.Append(i, i+1, -1)
+		a.Append(i+1, i, -1)
+	}
+	l := a.ToCSR()
+
+	res, err := eigsolve.Lanczos(l, 5, eigsolve.Smallest, &eigsolve.Settings{
+		Src: rand.NewPCG(1, 1),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, v := range res.Values {
+		// The Laplacian is positive semi-definite so the absolute value
+		// only hides rounding errors around zero.
+		fmt.Printf("%.6f\n", math.Abs(v))
+	}
+
+	// Output:
+	// 0.000000
+	// 0.000987
+	// 0.003947
+	// 0.008876
+	// 0.015771
+}
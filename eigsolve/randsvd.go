@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eigsolve
+
+import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultOversampling    = 10
+	defaultPowerIterations = 2
+)
+
+// SVDSettings holds settings for computing a randomized singular value
+// decomposition. See the field comments for default values.
+type SVDSettings struct {
+	// Oversampling is the number of random vectors used in addition to
+	// the number of wanted singular values. If Oversampling is zero, a
+	// default value of 10 is used, otherwise it must be positive.
+	Oversampling int
+
+	// PowerIterations is the number of subspace iterations with A*Aᵀ
+	// used to sharpen the approximate range of A. Each iteration improves
+	// the accuracy when the singular values decay slowly at the cost of
+	// 2*(k+Oversampling) matrix-vector products. If PowerIterations is
+	// zero, a default value of 2 is used. If it is negative, no subspace
+	// iterations are performed.
+	PowerIterations int
+
+	// Src is the source of random numbers used for the test vectors. If
+	// Src is nil, the global random source is used.
+	Src rand.Source
+}
+
+// SVDResult holds a truncated singular value decomposition
+//
+//	A ≈ U * Σ * Vᵀ.
+type SVDResult struct {
+	// Values holds the approximate singular values in descending order.
+	Values []float64
+
+	// U and V hold the corresponding orthonormal left and right singular
+	// vectors in their columns.
+	U, V *mat.Dense
+
+	// Stats holds statistics about the run.
+	Stats Stats
+}
+
+// RandomizedSVD computes an approximation of the k largest singular values
+// and the corresponding singular vectors of the m×n matrix A using the
+// randomized algorithm of Halko, Martinsson and Tropp. k must satisfy
+// 0 < k <= min(m, n). Only the products of A and Aᵀ with vectors are used.
+//
+// The range of A is approximated by the span of Q, the orthonormalized
+// product of (A*Aᵀ)^q * A with k+p random vectors, where p is the
+// oversampling and q is the number of power iterations. The singular value
+// decomposition of the small matrix Qᵀ*A then gives the approximate
+// decomposition of A. The error of the approximation is close to the
+// (k+1)-th singular value of A when the singular values decay quickly or
+// when enough power iterations are used.
+//
+// settings provide means for adjusting the computation. Zero values of the
+// fields mean default values. If settings is nil, the default settings are
+// used.
+func RandomizedSVD(a Operator, k int, settings *SVDSettings) (*SVDResult, error) {
+	m, n := a.Dims()
+	if k <= 0 || min(m, n) < k {
+		panic("eigsolve: invalid number of singular values")
+	}
+	var s SVDSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Oversampling == 0 {
+		s.Oversampling = defaultOversampling
+	}
+	if s.Oversampling < 0 {
+		panic("eigsolve: negative oversampling")
+	}
+	if s.PowerIterations == 0 {
+		s.PowerIterations = defaultPowerIterations
+	}
+	l := min(k+s.Oversampling, m, n)
+
+	var stats Stats
+	// mulRows sets the rows of dst to the products of A or Aᵀ with the
+	// rows of src.
+	mulRows := func(dst *mat.Dense, trans bool, src *mat.Dense) {
+		_, c := dst.Dims()
+		_, r := src.Dims()
+		for i := 0; i < l; i++ {
+			a.MulVecTo(mat.NewVecDense(c, dst.RawRowView(i)), trans, mat.NewVecDense(r, src.RawRowView(i)))
+			stats.MulVec++
+		}
+	}
+
+	// The rows of omega are the random test vectors and the rows of y
+	// span the approximate range of A.
+	norm := normFloat64(s.Src)
+	omega := mat.NewDense(l, n, nil)
+	omega.Apply(func(_, _ int, _ float64) float64 { return norm() }, omega)
+	y := mat.NewDense(l, m, nil)
+	mulRows(y, false, omega)
+	orthonormalizeRows(y)
+	for range s.PowerIterations {
+		mulRows(omega, true, y)
+		orthonormalizeRows(omega)
+		mulRows(y, false, omega)
+		orthonormalizeRows(y)
+	}
+
+	// Compute the singular value decomposition of B = Qᵀ*A where Q = yᵀ.
+	b := omega
+	mulRows(b, true, y)
+	var svd mat.SVD
+	if !svd.Factorize(b, mat.SVDThin) {
+		return nil, ErrProjection
+	}
+	var ub, vb mat.Dense
+	svd.UTo(&ub)
+	svd.VTo(&vb)
+
+	res := &SVDResult{
+		Values: svd.Values(nil)[:k],
+		U:      mat.NewDense(m, k, nil),
+		V:      mat.DenseCopyOf(vb.Slice(0, n, 0, k)),
+		Stats:  stats,
+	}
+	res.U.Mul(y.T(), ub.Slice(0, l, 0, k))
+	return res, nil
+}
+
+// orthonormalizeRows replaces the rows of the r×c matrix a, r <= c, by an
+// orthonormal basis of their span using an LQ factorization.
+func orthonormalizeRows(a *mat.Dense) {
+	r, _ := a.Dims()
+	raw := a.RawMatrix()
+	tau := make([]float64, r)
+	work := make([]float64, 1)
+	lapack64.Gelqf(raw, tau, work, -1)
+	lwork := int(work[0])
+	lapack64.Orglq(raw, tau, work, -1)
+	lwork = max(lwork, int(work[0]))
+	work = make([]float64, lwork)
+	lapack64.Gelqf(raw, tau, work, lwork)
+	lapack64.Orglq(raw, tau, work, lwork)
+}
@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && avx2 && !noasm && !gccgo && !safe
+// +build amd64,avx2,!noasm,!gccgo,!safe
+
+package layout_test
+
+import "gonum.org/v1/gonum/internal/asm/f64"
+
+// Change the testdata path for calculations done with the AVX2 kernels.
+func init() {
+	if f64.UseAVX2() {
+		tag = "_avx2"
+	}
+}
@@ -19,7 +19,8 @@ import (
 
 var (
 	// tag is modified in isomap_noasm_test.go to "_noasm" when any
-	// build tag prevents use of the assembly numerical kernels.
+	// build tag prevents use of the assembly numerical kernels, and
+	// in isomap_avx2_test.go to "_avx2" when the AVX2 kernels are used.
 	tag string
 
 	// arch is modified in isomap_arm64_test.go to "_arm64" on arm64
@@ -0,0 +1,33 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32
+
+import "gonum.org/v1/gonum/internal/math32"
+
+// L1Norm is
+//
+//	for _, v := range x {
+//		sum += math32.Abs(v)
+//	}
+//	return sum
+func L1Norm(x []float32) (sum float32) {
+	for _, v := range x {
+		sum += math32.Abs(v)
+	}
+	return sum
+}
+
+// L1NormInc is
+//
+//	for i := 0; i < n*incX; i += incX {
+//		sum += math32.Abs(x[i])
+//	}
+//	return sum
+func L1NormInc(x []float32, n, incX int) (sum float32) {
+	for i := 0; i < n*incX; i += incX {
+		sum += math32.Abs(x[i])
+	}
+	return sum
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32_test
+
+import (
+	"testing"
+
+	. "gonum.org/v1/gonum/internal/asm/f32"
+)
+
+func TestL1Norm(t *testing.T) {
+	var src_gd float32 = 1
+	for j, v := range []struct {
+		want float32
+		x    []float32
+	}{
+		{want: 0, x: []float32{}},
+		{want: 2, x: []float32{2}},
+		{want: 6, x: []float32{1, 2, 3}},
+		{want: 6, x: []float32{-1, -2, -3}},
+		{want: nan, x: []float32{nan}},
+		{want: 40, x: []float32{8, -8, 8, -8, 8}},
+		{want: 5, x: []float32{0, 1, 0, -1, 0, 1, 0, -1, 0, 1}},
+	} {
+		g_ln := 4 + j%2
+		v.x = guardVector(v.x, src_gd, g_ln)
+		src := v.x[g_ln : len(v.x)-g_ln]
+		ret := L1Norm(src)
+		if !same(ret, v.want) {
+			t.Errorf("Test %d L1Norm error Got: %f Expected: %f", j, ret, v.want)
+		}
+		if !isValidGuard(v.x, src_gd, g_ln) {
+			t.Errorf("Test %d Guard violated in src vector %v %v", j, v.x[:g_ln], v.x[len(v.x)-g_ln:])
+		}
+	}
+}
+
+func TestL1NormInc(t *testing.T) {
+	var src_gd float32 = 1
+	for j, v := range []struct {
+		inc  int
+		want float32
+		x    []float32
+	}{
+		{inc: 2, want: 0, x: []float32{}},
+		{inc: 3, want: 2, x: []float32{2}},
+		{inc: 10, want: 6, x: []float32{1, 2, 3}},
+		{inc: 5, want: 6, x: []float32{-1, -2, -3}},
+		{inc: 3, want: nan, x: []float32{nan}},
+		{inc: 15, want: 40, x: []float32{8, -8, 8, -8, 8}},
+		{inc: 1, want: 5, x: []float32{0, 1, 0, -1, 0, 1, 0, -1, 0, 1}},
+	} {
+		g_ln, ln := 4+j%2, len(v.x)
+		v.x = guardIncVector(v.x, src_gd, v.inc, g_ln)
+		src := v.x[g_ln : len(v.x)-g_ln]
+		ret := L1NormInc(src, ln, v.inc)
+		if !same(ret, v.want) {
+			t.Errorf("Test %d L1NormInc error Got: %f Expected: %f", j, ret, v.want)
+		}
+		checkValidIncGuard(t, v.x, src_gd, v.inc, g_ln)
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build avx2 && !noasm && !gccgo && !safe
+// +build avx2,!noasm,!gccgo,!safe
+
+package f64
+
+// avx2Enabled is whether the AVX2 and FMA kernels may be used on CPUs that
+// support them.
+const avx2Enabled = true
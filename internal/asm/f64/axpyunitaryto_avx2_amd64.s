@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define X_PTR SI
+#define Y_PTR DX
+#define DST_PTR DI
+#define IDX AX
+#define LEN CX
+#define END BX
+#define ALPHA Y0
+#define ALPHA_X X0
+
+// func axpyUnitaryToAVX2(dst []float64, alpha float64, x, y []float64)
+TEXT ·axpyUnitaryToAVX2(SB), NOSPLIT, $0
+	MOVQ         dst_base+0(FP), DST_PTR // DST_PTR := &dst
+	MOVQ         x_base+32(FP), X_PTR    // X_PTR := &x
+	MOVQ         y_base+56(FP), Y_PTR    // Y_PTR := &y
+	MOVQ         x_len+40(FP), LEN       // LEN = min( len(x), len(y), len(dst) )
+	CMPQ         y_len+64(FP), LEN
+	CMOVQLE      y_len+64(FP), LEN
+	CMPQ         dst_len+8(FP), LEN
+	CMOVQLE      dst_len+8(FP), LEN
+	VBROADCASTSD alpha+24(FP), ALPHA     // ALPHA := { alpha, alpha, alpha, alpha }
+	XORQ         IDX, IDX
+	MOVQ         LEN, END
+	ANDQ         $-16, END               // END = LEN - LEN % 16
+	JZ           loop4_start
+
+loop16: // do {
+	// dst[i] = alpha * x[i] + y[i] unrolled 16x.
+	VMOVUPD     (Y_PTR)(IDX*8), Y1
+	VMOVUPD     32(Y_PTR)(IDX*8), Y2
+	VMOVUPD     64(Y_PTR)(IDX*8), Y3
+	VMOVUPD     96(Y_PTR)(IDX*8), Y4
+	VFMADD231PD (X_PTR)(IDX*8), ALPHA, Y1
+	VFMADD231PD 32(X_PTR)(IDX*8), ALPHA, Y2
+	VFMADD231PD 64(X_PTR)(IDX*8), ALPHA, Y3
+	VFMADD231PD 96(X_PTR)(IDX*8), ALPHA, Y4
+	VMOVUPD     Y1, (DST_PTR)(IDX*8)
+	VMOVUPD     Y2, 32(DST_PTR)(IDX*8)
+	VMOVUPD     Y3, 64(DST_PTR)(IDX*8)
+	VMOVUPD     Y4, 96(DST_PTR)(IDX*8)
+	ADDQ        $16, IDX                 // i += 16
+	CMPQ        IDX, END
+	JL          loop16                   // } while i < END
+
+loop4_start:
+	MOVQ LEN, END
+	ANDQ $-4, END   // END = LEN - LEN % 4
+	CMPQ IDX, END
+	JGE  tail_start
+
+loop4: // do {
+	VMOVUPD     (Y_PTR)(IDX*8), Y1
+	VFMADD231PD (X_PTR)(IDX*8), ALPHA, Y1
+	VMOVUPD     Y1, (DST_PTR)(IDX*8)
+	ADDQ        $4, IDX                  // i += 4
+	CMPQ        IDX, END
+	JL          loop4                    // } while i < END
+
+tail_start:
+	CMPQ IDX, LEN
+	JGE  end
+
+tail: // do {
+	VMOVSD      (Y_PTR)(IDX*8), X1
+	VFMADD231SD (X_PTR)(IDX*8), ALPHA_X, X1
+	VMOVSD      X1, (DST_PTR)(IDX*8)
+	INCQ        IDX                     // i++
+	CMPQ        IDX, LEN
+	JL          tail                    // } while i < LEN
+
+end:
+	VZEROUPPER
+	RET
@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64
+
+// useAVX2 is whether the kernels that use the AVX2 and FMA instruction set
+// extensions are called instead of the SSE2 kernels. It is set during package
+// initialization if the package is built with the avx2 build tag and both the
+// CPU and the operating system support AVX2 and FMA.
+var useAVX2 = avx2Enabled && hasAVX2FMA()
+
+// UseAVX2 returns whether the kernels that use the AVX2 and FMA instruction
+// set extensions are called. Results computed with these kernels may differ
+// in the last bits from those computed with the SSE2 kernels.
+func UseAVX2() bool {
+	return useAVX2
+}
+
+// cpuid executes the CPUID instruction with the given EAX and ECX inputs.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the contents of the extended control register XCR0.
+func xgetbv() (eax, edx uint32)
+
+// hasAVX2FMA returns whether the CPU supports the AVX2 and FMA instructions
+// and the operating system preserves the YMM registers across context
+// switches.
+func hasAVX2FMA() bool {
+	const (
+		// CPUID.(EAX=1):ECX.
+		fma     = 1 << 12
+		osxsave = 1 << 27
+		avx     = 1 << 28
+
+		// CPUID.(EAX=7,ECX=0):EBX.
+		avx2 = 1 << 5
+
+		// XCR0 bits for the XMM and YMM state.
+		xmmYMMState = 1<<1 | 1<<2
+	)
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&(fma|osxsave|avx) != fma|osxsave|avx {
+		return false
+	}
+	if xcr0, _ := xgetbv(); xcr0&xmmYMMState != xmmYMMState {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&avx2 != 0
+}
+
+// The following kernels are the AVX2 and FMA implementations of the unitary
+// level 1 routines with the same names. They are called by the SSE2 kernels
+// when useAVX2 is true.
+
+func axpyUnitaryAVX2(alpha float64, x, y []float64)
+
+func axpyUnitaryToAVX2(dst []float64, alpha float64, x, y []float64)
+
+func dotUnitaryAVX2(x, y []float64) (sum float64)
+
+func scalUnitaryAVX2(alpha float64, x []float64)
+
+func scalUnitaryToAVX2(dst []float64, alpha float64, x []float64)
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64_test
+
+import (
+	"testing"
+
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// dispatchTests are the tests of the routines that dispatch to either the
+// AVX2 or the SSE2 kernels.
+var dispatchTests = []struct {
+	name string
+	test func(*testing.T)
+}{
+	{"AxpyUnitary", TestAxpyUnitary},
+	{"AxpyUnitaryTo", TestAxpyUnitaryTo},
+	{"DotUnitary", TestDotUnitary},
+	{"ScalUnitary", TestScalUnitary},
+	{"ScalUnitaryTo", TestScalUnitaryTo},
+	{"UnitaryLengths", TestUnitaryLengths},
+	{"Gemv", TestGemv},
+	{"GemmKernel", TestGemmKernel},
+}
+
+// TestSSE2 runs the tests of the dispatching routines with the AVX2 kernels
+// disabled so that the SSE2 kernels are also tested when the package is built
+// with the avx2 build tag.
+func TestSSE2(t *testing.T) {
+	prev := SetUseAVX2(false)
+	defer SetUseAVX2(prev)
+	for _, test := range dispatchTests {
+		t.Run(test.name, test.test)
+	}
+}
+
+// TestAVX2 runs the tests of the dispatching routines with the AVX2 kernels
+// enabled so that they are tested on CPUs that support them even when the
+// package is built without the avx2 build tag.
+func TestAVX2(t *testing.T) {
+	if !HasAVX2FMA() {
+		t.Skip("AVX2 and FMA not supported")
+	}
+	prev := SetUseAVX2(true)
+	defer SetUseAVX2(prev)
+	for _, test := range dispatchTests {
+		t.Run(test.name, test.test)
+	}
+}
+
+// TestUseGemmKernel checks that the AVX2 kernels are used if and only if the
+// package is built with the avx2 build tag and the CPU supports them.
+func TestUseGemmKernel(t *testing.T) {
+	want := AVX2Enabled && HasAVX2FMA()
+	if got := UseGemmKernel(); got != want {
+		t.Errorf("unexpected use of the AVX2 kernels: got %t, want %t", got, want)
+	}
+}
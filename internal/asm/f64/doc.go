@@ -3,4 +3,12 @@
 // license that can be found in the LICENSE file.
 
 // Package f64 provides float64 vector primitives.
+//
+// On amd64, when the package is built with the avx2 build tag, AxpyUnitary,
+// AxpyUnitaryTo, DotUnitary, ScalUnitary, ScalUnitaryTo, GemvN, GemvT and
+// GemmKernel use kernels written with the AVX2 and FMA instruction set
+// extensions if they are supported by the CPU and the operating system, and
+// fall back to SSE2 or Go implementations otherwise. The AVX2 and FMA kernels
+// round differently from the SSE2 kernels, so results computed with them may
+// differ in the last bits.
 package f64 // import "gonum.org/v1/gonum/internal/asm/f64"
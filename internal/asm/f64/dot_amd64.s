@@ -41,6 +41,11 @@
 // func DdotUnitary(x, y []float64) (sum float64)
 // This function assumes len(y) >= len(x).
 TEXT ·DotUnitary(SB), NOSPLIT, $0
+	CMPB ·useAVX2(SB), $0 // Use the AVX2 kernel if it is supported.
+	JEQ  sse2
+	JMP  ·dotUnitaryAVX2(SB)
+
+sse2:
 	MOVQ x+0(FP), R8
 	MOVQ x_len+8(FP), DI // n = len(x)
 	MOVQ y+24(FP), R9
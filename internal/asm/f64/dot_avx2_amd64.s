@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define X_PTR SI
+#define Y_PTR DI
+#define IDX AX
+#define LEN CX
+#define END BX
+
+// func dotUnitaryAVX2(x, y []float64) (sum float64)
+TEXT ·dotUnitaryAVX2(SB), NOSPLIT, $0
+	MOVQ   x_base+0(FP), X_PTR  // X_PTR := &x
+	MOVQ   y_base+24(FP), Y_PTR // Y_PTR := &y
+	MOVQ   x_len+8(FP), LEN     // LEN = len(x)
+	VXORPD Y0, Y0, Y0           // Use four accumulators for pipelining.
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+	XORQ   IDX, IDX
+	MOVQ   LEN, END
+	ANDQ   $-16, END            // END = LEN - LEN % 16
+	JZ     loop4_start
+
+loop16: // do {
+	// sum += x[i] * y[i] unrolled 16x.
+	VMOVUPD     (X_PTR)(IDX*8), Y4
+	VMOVUPD     32(X_PTR)(IDX*8), Y5
+	VMOVUPD     64(X_PTR)(IDX*8), Y6
+	VMOVUPD     96(X_PTR)(IDX*8), Y7
+	VFMADD231PD (Y_PTR)(IDX*8), Y4, Y0
+	VFMADD231PD 32(Y_PTR)(IDX*8), Y5, Y1
+	VFMADD231PD 64(Y_PTR)(IDX*8), Y6, Y2
+	VFMADD231PD 96(Y_PTR)(IDX*8), Y7, Y3
+	ADDQ        $16, IDX                 // i += 16
+	CMPQ        IDX, END
+	JL          loop16                   // } while i < END
+
+loop4_start:
+	MOVQ LEN, END
+	ANDQ $-4, END   // END = LEN - LEN % 4
+	CMPQ IDX, END
+	JGE  reduce
+
+loop4: // do {
+	VMOVUPD     (X_PTR)(IDX*8), Y4
+	VFMADD231PD (Y_PTR)(IDX*8), Y4, Y0
+	ADDQ        $4, IDX                // i += 4
+	CMPQ        IDX, END
+	JL          loop4                  // } while i < END
+
+reduce:
+	// Add the accumulators and their elements.
+	VADDPD       Y1, Y0, Y0
+	VADDPD       Y3, Y2, Y2
+	VADDPD       Y2, Y0, Y0
+	VEXTRACTF128 $1, Y0, X1
+	VADDPD       X1, X0, X0
+	VHADDPD      X0, X0, X0
+	CMPQ         IDX, LEN
+	JGE          end
+
+tail: // do {
+	VMOVSD      (X_PTR)(IDX*8), X4
+	VFMADD231SD (Y_PTR)(IDX*8), X4, X0
+	INCQ        IDX                    // i++
+	CMPQ        IDX, LEN
+	JL          tail                   // } while i < LEN
+
+end:
+	VMOVSD     X0, sum+48(FP) // Return final sum.
+	VZEROUPPER
+	RET
@@ -0,0 +1,22 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !noasm && !gccgo && !safe
+// +build !noasm,!gccgo,!safe
+
+package f64
+
+// AVX2Enabled is whether the package was built with the avx2 build tag.
+const AVX2Enabled = avx2Enabled
+
+// HasAVX2FMA returns whether the AVX2 and FMA kernels can be used.
+func HasAVX2FMA() bool { return hasAVX2FMA() }
+
+// SetUseAVX2 sets whether the AVX2 and FMA kernels are used and returns the
+// previous setting. It must only be set to true if HasAVX2FMA returns true,
+// and it must not be called concurrently with any of the kernels.
+func SetUseAVX2(use bool) (prev bool) {
+	prev, useAVX2 = useAVX2, use
+	return prev
+}
@@ -19,11 +19,55 @@ func Ger(m, n uintptr, alpha float64, x []float64, incX uintptr, y []float64, in
 //	y = alpha * A * x + beta * y
 //
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
-func GemvN(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+func GemvN(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr) {
+	if useAVX2 && incX == 1 && incY == 1 {
+		gemvNAVX2(m, n, alpha, a, lda, x, beta, y)
+		return
+	}
+	gemvNSSE2(m, n, alpha, a, lda, x, incX, beta, y, incY)
+}
 
 // GemvT computes
 //
 //	y = alpha * Aᵀ * x + beta * y
 //
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
-func GemvT(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+func GemvT(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr) {
+	if useAVX2 && incX == 1 && incY == 1 {
+		if m == 0 || n == 0 {
+			return
+		}
+		y = y[:n]
+		switch beta {
+		case 0:
+			for i := range y {
+				y[i] = 0
+			}
+		case 1:
+		default:
+			scalUnitaryAVX2(beta, y)
+		}
+		gemvTAVX2(m, n, alpha, a, lda, x, y)
+		return
+	}
+	gemvTSSE2(m, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+func gemvNSSE2(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+
+func gemvTSSE2(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+
+// gemvNAVX2 computes
+//
+//	y = alpha * A * x + beta * y
+//
+// for unit increments of x and y using AVX2 and FMA instructions. The
+// elements of y are not read when beta is zero.
+func gemvNAVX2(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, beta float64, y []float64)
+
+// gemvTAVX2 computes
+//
+//	y += alpha * Aᵀ * x
+//
+// for unit increments of x and y using AVX2 and FMA instructions.
+func gemvTAVX2(m, n uintptr, alpha float64, a []float64, lda uintptr, x, y []float64)
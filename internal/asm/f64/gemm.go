@@ -0,0 +1,33 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+// GemmMR and GemmNR are the number of rows and columns of the block of C
+// updated by a single call to GemmKernel.
+const (
+	GemmMR = 4
+	GemmNR = 8
+)
+
+// gemmKernel is the Go implementation of GemmKernel.
+func gemmKernel(k uintptr, alpha float64, a, b, c []float64, ldc uintptr) {
+	var acc [GemmMR * GemmNR]float64
+	for l := 0; l < int(k); l++ {
+		av := a[l*GemmMR : l*GemmMR+GemmMR]
+		bv := b[l*GemmNR : l*GemmNR+GemmNR]
+		for i, v := range av {
+			row := acc[i*GemmNR : i*GemmNR+GemmNR]
+			for j, w := range bv {
+				row[j] += v * w
+			}
+		}
+	}
+	for i := 0; i < GemmMR; i++ {
+		ctmp := c[uintptr(i)*ldc : uintptr(i)*ldc+GemmNR]
+		for j, v := range acc[i*GemmNR : i*GemmNR+GemmNR] {
+			ctmp[j] += alpha * v
+		}
+	}
+}
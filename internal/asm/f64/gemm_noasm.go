@@ -0,0 +1,36 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || noasm || gccgo || safe
+// +build !amd64 noasm gccgo safe
+
+package f64
+
+// GemmKernel computes
+//
+//	for l := 0; l < k; l++ {
+//		for i := 0; i < GemmMR; i++ {
+//			for j := 0; j < GemmNR; j++ {
+//				sum[i][j] += a[l*GemmMR+i] * b[l*GemmNR+j]
+//			}
+//		}
+//	}
+//	for i := 0; i < GemmMR; i++ {
+//		for j := 0; j < GemmNR; j++ {
+//			c[i*ldc+j] += alpha * sum[i][j]
+//		}
+//	}
+//
+// where a and b hold a GemmMR×k and a k×GemmNR matrix packed column by column
+// and row by row respectively, and c is a GemmMR×GemmNR dense matrix.
+func GemmKernel(k uintptr, alpha float64, a, b, c []float64, ldc uintptr) {
+	gemmKernel(k, alpha, a, b, c, ldc)
+}
+
+// UseGemmKernel returns whether GemmKernel is implemented using an assembly
+// micro-kernel. When it returns false, GemmKernel is not faster than the level
+// 1 routines and callers should prefer their unpacked algorithms.
+func UseGemmKernel() bool {
+	return false
+}
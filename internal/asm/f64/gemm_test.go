@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+func TestGemmKernel(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, k := range []int{0, 1, 2, 7, 64} {
+		for _, ldc := range []int{GemmNR, GemmNR + 3} {
+			for _, alpha := range []float64{0, 1, -0.7} {
+				prefix := fmt.Sprintf("k=%d,ldc=%d,alpha=%v", k, ldc, alpha)
+				a := randSlice(k*GemmMR+1, 1, rnd)[:k*GemmMR]
+				b := randSlice(k*GemmNR+1, 1, rnd)[:k*GemmNR]
+				cData := randSlice((GemmMR-1)*ldc+GemmNR, 1, rnd)
+				c, cFront, cBack := newGuardedVector(cData, 1)
+
+				GemmKernel(uintptr(k), alpha, a, b, c, uintptr(ldc))
+
+				if !allNaN(cFront) || !allNaN(cBack) {
+					t.Errorf("%v: guard violated in c", prefix)
+				}
+				for i := 0; i < GemmMR; i++ {
+					for j := 0; j < ldc; j++ {
+						idx := i*ldc + j
+						if idx >= len(c) {
+							break
+						}
+						if j >= GemmNR {
+							if c[idx] != cData[idx] {
+								t.Errorf("%v: unexpected modification of c outside of the block at (%d,%d)", prefix, i, j)
+							}
+							continue
+						}
+						var sum float64
+						for l := 0; l < k; l++ {
+							sum += a[l*GemmMR+i] * b[l*GemmNR+j]
+						}
+						want := cData[idx] + alpha*sum
+						if math.Abs(c[idx]-want) > tol*float64(max(k, 1)) {
+							t.Errorf("%v: unexpected c at (%d,%d); got %v, want %v", prefix, i, j, c[idx], want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
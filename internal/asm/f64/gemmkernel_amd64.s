@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define K CX
+#define A_PTR SI
+#define B_PTR DI
+#define C_PTR DX
+#define LDC R8
+
+#define ALPHA Y15
+
+// The 4×8 block of C is accumulated in Y0-Y7 with row i held in Y(2i) and
+// Y(2i+1).
+#define STORE_ROW(LO, HI) \
+	VMOVUPD     (C_PTR), Y8           \
+	VMOVUPD     4*SIZE(C_PTR), Y9     \
+	VFMADD231PD LO, ALPHA, Y8         \
+	VFMADD231PD HI, ALPHA, Y9         \
+	VMOVUPD     Y8, (C_PTR)           \
+	VMOVUPD     Y9, 4*SIZE(C_PTR)
+
+// func gemmKernelAVX2(k uintptr, alpha float64, a, b, c []float64, ldc uintptr)
+TEXT ·gemmKernelAVX2(SB), NOSPLIT, $0
+	MOVQ k+0(FP), K
+	MOVQ a_base+16(FP), A_PTR
+	MOVQ b_base+40(FP), B_PTR
+	MOVQ c_base+64(FP), C_PTR
+	MOVQ ldc+88(FP), LDC      // LDC = ldc * sizeof(float64)
+	SHLQ $3, LDC
+
+	VXORPD Y0, Y0, Y0
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+	VXORPD Y4, Y4, Y4
+	VXORPD Y5, Y5, Y5
+	VXORPD Y6, Y6, Y6
+	VXORPD Y7, Y7, Y7
+
+	TESTQ K, K
+	JZ    store
+
+loop: // do {
+	// Rank-1 update of the accumulators with a column of a and a row of b.
+	VMOVUPD      (B_PTR), Y8
+	VMOVUPD      4*SIZE(B_PTR), Y9
+	VBROADCASTSD (A_PTR), Y10
+	VBROADCASTSD SIZE(A_PTR), Y11
+	VFMADD231PD  Y8, Y10, Y0
+	VFMADD231PD  Y9, Y10, Y1
+	VFMADD231PD  Y8, Y11, Y2
+	VFMADD231PD  Y9, Y11, Y3
+	VBROADCASTSD 2*SIZE(A_PTR), Y12
+	VBROADCASTSD 3*SIZE(A_PTR), Y13
+	VFMADD231PD  Y8, Y12, Y4
+	VFMADD231PD  Y9, Y12, Y5
+	VFMADD231PD  Y8, Y13, Y6
+	VFMADD231PD  Y9, Y13, Y7
+	ADDQ         $4*SIZE, A_PTR
+	ADDQ         $8*SIZE, B_PTR
+	DECQ         K
+	JNZ          loop           // } while --k > 0
+
+store: // c[i*ldc+j] += alpha * acc[i][j]
+	VBROADCASTSD alpha+8(FP), ALPHA
+	STORE_ROW(Y0, Y1)
+	ADDQ         LDC, C_PTR
+	STORE_ROW(Y2, Y3)
+	ADDQ         LDC, C_PTR
+	STORE_ROW(Y4, Y5)
+	ADDQ         LDC, C_PTR
+	STORE_ROW(Y6, Y7)
+
+	VZEROUPPER
+	RET
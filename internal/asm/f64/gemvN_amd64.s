@@ -224,13 +224,13 @@
 	ADDSD  X0, X4      \
 	MOVSD  X4, (Y_PTR)
 
-// func GemvN(m, n int,
+// func gemvNSSE2(m, n int,
 //	alpha float64,
 //	a []float64, lda int,
 //	x []float64, incX int,
 //	beta float64,
 //	y []float64, incY int)
-TEXT ·GemvN(SB), NOSPLIT, $32-128
+TEXT ·gemvNSSE2(SB), NOSPLIT, $32-128
 	MOVQ M_DIM, M
 	MOVQ N_DIM, N
 	CMPQ M, $0
@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define IDX AX
+#define N BX
+#define M CX
+#define Y_PTR DX
+#define A_ROW SI
+#define X_PTR DI
+#define LDA R8
+#define TMP R9
+#define N4 R10
+#define A_PTR1 R11
+#define A_PTR2 R12
+#define A_PTR3 R13
+
+#define ALPHA Y14
+#define ALPHA_X X14
+#define BETA Y15
+#define BETA_X X15
+
+// func gemvNAVX2(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, beta float64, y []float64)
+TEXT ·gemvNAVX2(SB), NOSPLIT, $0
+	MOVQ m+0(FP), M
+	MOVQ n+8(FP), N
+	CMPQ M, $0
+	JE   end
+	CMPQ N, $0
+	JE   end
+
+	VBROADCASTSD alpha+16(FP), ALPHA
+	VBROADCASTSD beta+80(FP), BETA
+
+	MOVQ a_base+24(FP), A_ROW
+	MOVQ x_base+56(FP), X_PTR
+	MOVQ y_base+88(FP), Y_PTR
+	MOVQ lda+48(FP), LDA      // LDA = lda * sizeof(float64)
+	SHLQ $3, LDA
+	MOVQ N, N4
+	ANDQ $-4, N4              // N4 = n - n % 4
+
+	CMPQ M, $4
+	JL   row1
+
+row4: // Compute four elements of y at a time.
+	LEAQ (A_ROW)(LDA*1), A_PTR1
+	LEAQ (A_ROW)(LDA*2), A_PTR2
+	LEAQ (A_PTR1)(LDA*2), A_PTR3
+
+	// Use two accumulators per row for pipelining.
+	VXORPD Y0, Y0, Y0
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+	VXORPD Y8, Y8, Y8
+	VXORPD Y9, Y9, Y9
+	VXORPD Y10, Y10, Y10
+	VXORPD Y11, Y11, Y11
+	XORQ   IDX, IDX
+
+	MOVQ N, TMP
+	ANDQ $-8, TMP  // TMP = n - n % 8
+	JZ   row4_col4
+
+row4_col8:
+	VMOVUPD     (X_PTR)(IDX*8), Y4
+	VMOVUPD     4*SIZE(X_PTR)(IDX*8), Y5
+	VFMADD231PD (A_ROW)(IDX*8), Y4, Y0
+	VFMADD231PD (A_PTR1)(IDX*8), Y4, Y1
+	VFMADD231PD (A_PTR2)(IDX*8), Y4, Y2
+	VFMADD231PD (A_PTR3)(IDX*8), Y4, Y3
+	VFMADD231PD 4*SIZE(A_ROW)(IDX*8), Y5, Y8
+	VFMADD231PD 4*SIZE(A_PTR1)(IDX*8), Y5, Y9
+	VFMADD231PD 4*SIZE(A_PTR2)(IDX*8), Y5, Y10
+	VFMADD231PD 4*SIZE(A_PTR3)(IDX*8), Y5, Y11
+	ADDQ        $8, IDX
+	CMPQ        IDX, TMP
+	JL          row4_col8
+
+row4_col4:
+	CMPQ IDX, N4
+	JGE  row4_reduce
+
+	VMOVUPD     (X_PTR)(IDX*8), Y4
+	VFMADD231PD (A_ROW)(IDX*8), Y4, Y0
+	VFMADD231PD (A_PTR1)(IDX*8), Y4, Y1
+	VFMADD231PD (A_PTR2)(IDX*8), Y4, Y2
+	VFMADD231PD (A_PTR3)(IDX*8), Y4, Y3
+	ADDQ        $4, IDX
+
+row4_reduce:
+	VADDPD Y8, Y0, Y0
+	VADDPD Y9, Y1, Y1
+	VADDPD Y10, Y2, Y2
+	VADDPD Y11, Y3, Y3
+
+	// Y0 = { sum(Y0), sum(Y1), sum(Y2), sum(Y3) }
+	VHADDPD    Y1, Y0, Y0
+	VHADDPD    Y3, Y2, Y2
+	VPERM2F128 $0x21, Y2, Y0, Y5
+	VBLENDPD   $0x0C, Y2, Y0, Y6
+	VADDPD     Y5, Y6, Y0
+
+	CMPQ IDX, N
+	JGE  row4_store
+
+row4_tail: // Add the remaining columns one at a time.
+	VBROADCASTSD (X_PTR)(IDX*8), Y4
+	VMOVSD       (A_ROW)(IDX*8), X5
+	VMOVHPD      (A_PTR1)(IDX*8), X5, X5
+	VMOVSD       (A_PTR2)(IDX*8), X6
+	VMOVHPD      (A_PTR3)(IDX*8), X6, X6
+	VINSERTF128  $1, X6, Y5, Y5
+	VFMADD231PD  Y5, Y4, Y0
+	INCQ         IDX
+	CMPQ         IDX, N
+	JL           row4_tail
+
+row4_store:
+	VMULPD      ALPHA, Y0, Y0
+	MOVQ        beta+80(FP), TMP
+	SHLQ        $1, TMP              // Clear the sign bit of beta.
+	JZ          row4_beta_zero       // if beta == 0 { do not read y }
+	VFMADD231PD (Y_PTR), BETA, Y0
+
+row4_beta_zero:
+	VMOVUPD Y0, (Y_PTR)
+
+	ADDQ $4*SIZE, Y_PTR
+	LEAQ (A_ROW)(LDA*4), A_ROW
+	SUBQ $4, M
+	CMPQ M, $4
+	JGE  row4
+
+row1:
+	CMPQ M, $0
+	JE   end
+
+row1_loop: // Compute the remaining elements of y one at a time.
+	VXORPD Y0, Y0, Y0
+	XORQ   IDX, IDX
+	CMPQ   N4, $0
+	JE     row1_reduce
+
+row1_col4:
+	VMOVUPD     (X_PTR)(IDX*8), Y4
+	VFMADD231PD (A_ROW)(IDX*8), Y4, Y0
+	ADDQ        $4, IDX
+	CMPQ        IDX, N4
+	JL          row1_col4
+
+row1_reduce:
+	VEXTRACTF128 $1, Y0, X1
+	VADDPD       X1, X0, X0
+	VHADDPD      X0, X0, X0
+	CMPQ         IDX, N
+	JGE          row1_store
+
+row1_tail:
+	VMOVSD      (X_PTR)(IDX*8), X4
+	VFMADD231SD (A_ROW)(IDX*8), X4, X0
+	INCQ        IDX
+	CMPQ        IDX, N
+	JL          row1_tail
+
+row1_store:
+	VMULSD      ALPHA_X, X0, X0
+	MOVQ        beta+80(FP), TMP
+	SHLQ        $1, TMP              // Clear the sign bit of beta.
+	JZ          row1_beta_zero       // if beta == 0 { do not read y }
+	VFMADD231SD (Y_PTR), BETA_X, X0
+
+row1_beta_zero:
+	VMOVSD X0, (Y_PTR)
+
+	ADDQ $SIZE, Y_PTR
+	ADDQ LDA, A_ROW
+	DECQ M
+	JNZ  row1_loop
+
+end:
+	VZEROUPPER
+	RET
@@ -260,13 +260,13 @@
 	MOVSD X0, (PTR)        \
 	MOVSD X1, (PTR)(INC*1)
 
-// func GemvT(m, n int,
+// func gemvTSSE2(m, n int,
 //	alpha float64,
 //	a []float64, lda int,
 //	x []float64, incX int,
 //	beta float64,
 //	y []float64, incY int)
-TEXT ·GemvT(SB), NOSPLIT, $32-128
+TEXT ·gemvTSSE2(SB), NOSPLIT, $32-128
 	MOVQ M_DIM, M
 	MOVQ N_DIM, N
 	CMPQ M, $0
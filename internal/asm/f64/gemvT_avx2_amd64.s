@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define IDX AX
+#define N BX
+#define M CX
+#define Y_PTR DX
+#define A_ROW SI
+#define X_PTR DI
+#define LDA R8
+#define TMP R9
+#define N4 R10
+#define A_PTR1 R11
+#define A_PTR2 R12
+#define A_PTR3 R13
+
+#define ALPHA Y14
+
+// func gemvTAVX2(m, n uintptr, alpha float64, a []float64, lda uintptr, x, y []float64)
+TEXT ·gemvTAVX2(SB), NOSPLIT, $0
+	MOVQ m+0(FP), M
+	MOVQ n+8(FP), N
+	CMPQ M, $0
+	JE   end
+	CMPQ N, $0
+	JE   end
+
+	VBROADCASTSD alpha+16(FP), ALPHA
+
+	MOVQ a_base+24(FP), A_ROW
+	MOVQ x_base+56(FP), X_PTR
+	MOVQ y_base+80(FP), Y_PTR
+	MOVQ lda+48(FP), LDA      // LDA = lda * sizeof(float64)
+	SHLQ $3, LDA
+	MOVQ N, N4
+	ANDQ $-4, N4              // N4 = n - n % 4
+
+	CMPQ M, $4
+	JL   row1
+
+row4: // Add four rows of A scaled by alpha * x[i] to y at a time.
+	LEAQ (A_ROW)(LDA*1), A_PTR1
+	LEAQ (A_ROW)(LDA*2), A_PTR2
+	LEAQ (A_PTR1)(LDA*2), A_PTR3
+
+	VBROADCASTSD (X_PTR), Y0
+	VBROADCASTSD SIZE(X_PTR), Y1
+	VBROADCASTSD 2*SIZE(X_PTR), Y2
+	VBROADCASTSD 3*SIZE(X_PTR), Y3
+	VMULPD       ALPHA, Y0, Y0
+	VMULPD       ALPHA, Y1, Y1
+	VMULPD       ALPHA, Y2, Y2
+	VMULPD       ALPHA, Y3, Y3
+	XORQ         IDX, IDX
+
+	MOVQ N, TMP
+	ANDQ $-8, TMP  // TMP = n - n % 8
+	JZ   row4_col4
+
+row4_col8:
+	VMOVUPD     (Y_PTR)(IDX*8), Y4
+	VMOVUPD     4*SIZE(Y_PTR)(IDX*8), Y5
+	VFMADD231PD (A_ROW)(IDX*8), Y0, Y4
+	VFMADD231PD 4*SIZE(A_ROW)(IDX*8), Y0, Y5
+	VFMADD231PD (A_PTR1)(IDX*8), Y1, Y4
+	VFMADD231PD 4*SIZE(A_PTR1)(IDX*8), Y1, Y5
+	VFMADD231PD (A_PTR2)(IDX*8), Y2, Y4
+	VFMADD231PD 4*SIZE(A_PTR2)(IDX*8), Y2, Y5
+	VFMADD231PD (A_PTR3)(IDX*8), Y3, Y4
+	VFMADD231PD 4*SIZE(A_PTR3)(IDX*8), Y3, Y5
+	VMOVUPD     Y4, (Y_PTR)(IDX*8)
+	VMOVUPD     Y5, 4*SIZE(Y_PTR)(IDX*8)
+	ADDQ        $8, IDX
+	CMPQ        IDX, TMP
+	JL          row4_col8
+
+row4_col4:
+	CMPQ IDX, N4
+	JGE  row4_tail_start
+
+	VMOVUPD     (Y_PTR)(IDX*8), Y4
+	VFMADD231PD (A_ROW)(IDX*8), Y0, Y4
+	VFMADD231PD (A_PTR1)(IDX*8), Y1, Y4
+	VFMADD231PD (A_PTR2)(IDX*8), Y2, Y4
+	VFMADD231PD (A_PTR3)(IDX*8), Y3, Y4
+	VMOVUPD     Y4, (Y_PTR)(IDX*8)
+	ADDQ        $4, IDX
+
+row4_tail_start:
+	CMPQ IDX, N
+	JGE  row4_end
+
+row4_tail:
+	VMOVSD      (Y_PTR)(IDX*8), X4
+	VFMADD231SD (A_ROW)(IDX*8), X0, X4
+	VFMADD231SD (A_PTR1)(IDX*8), X1, X4
+	VFMADD231SD (A_PTR2)(IDX*8), X2, X4
+	VFMADD231SD (A_PTR3)(IDX*8), X3, X4
+	VMOVSD      X4, (Y_PTR)(IDX*8)
+	INCQ        IDX
+	CMPQ        IDX, N
+	JL          row4_tail
+
+row4_end:
+	ADDQ $4*SIZE, X_PTR
+	LEAQ (A_ROW)(LDA*4), A_ROW
+	SUBQ $4, M
+	CMPQ M, $4
+	JGE  row4
+
+row1:
+	CMPQ M, $0
+	JE   end
+
+row1_loop: // Add the remaining rows one at a time.
+	VBROADCASTSD (X_PTR), Y0
+	VMULPD       ALPHA, Y0, Y0
+	XORQ         IDX, IDX
+	CMPQ         N4, $0
+	JE           row1_tail_start
+
+row1_col4:
+	VMOVUPD     (Y_PTR)(IDX*8), Y4
+	VFMADD231PD (A_ROW)(IDX*8), Y0, Y4
+	VMOVUPD     Y4, (Y_PTR)(IDX*8)
+	ADDQ        $4, IDX
+	CMPQ        IDX, N4
+	JL          row1_col4
+
+row1_tail_start:
+	CMPQ IDX, N
+	JGE  row1_end
+
+row1_tail:
+	VMOVSD      (Y_PTR)(IDX*8), X4
+	VFMADD231SD (A_ROW)(IDX*8), X0, X4
+	VMOVSD      X4, (Y_PTR)(IDX*8)
+	INCQ        IDX
+	CMPQ        IDX, N
+	JL          row1_tail
+
+row1_end:
+	ADDQ $SIZE, X_PTR
+	ADDQ LDA, A_ROW
+	DECQ M
+	JNZ  row1_loop
+
+end:
+	VZEROUPPER
+	RET
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !avx2 && !noasm && !gccgo && !safe
+// +build !avx2,!noasm,!gccgo,!safe
+
+package f64
+
+// avx2Enabled is whether the AVX2 and FMA kernels may be used on CPUs that
+// support them. The kernels round differently from the SSE2 kernels, so they
+// are only used when the package is built with the avx2 build tag.
+const avx2Enabled = false
@@ -50,6 +50,11 @@
 
 // func ScalUnitary(alpha float64, x []float64)
 TEXT ·ScalUnitary(SB), NOSPLIT, $0
+	CMPB ·useAVX2(SB), $0 // Use the AVX2 kernel if it is supported.
+	JEQ  sse2
+	JMP  ·scalUnitaryAVX2(SB)
+
+sse2:
 	MOVDDUP_ALPHA            // ALPHA = { alpha, alpha }
 	MOVQ x_base+8(FP), X_PTR // X_PTR = &x
 	MOVQ x_len+16(FP), LEN   // LEN = len(x)
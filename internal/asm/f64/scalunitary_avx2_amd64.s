@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!gccgo,!safe
+
+#include "textflag.h"
+
+#define X_PTR SI
+#define IDX AX
+#define LEN CX
+#define END BX
+#define ALPHA Y0
+#define ALPHA_X X0
+
+// func scalUnitaryAVX2(alpha float64, x []float64)
+TEXT ·scalUnitaryAVX2(SB), NOSPLIT, $0
+	MOVQ         x_base+8(FP), X_PTR // X_PTR := &x
+	MOVQ         x_len+16(FP), LEN   // LEN = len(x)
+	VBROADCASTSD alpha+0(FP), ALPHA  // ALPHA := { alpha, alpha, alpha, alpha }
+	XORQ         IDX, IDX
+	MOVQ         LEN, END
+	ANDQ         $-16, END           // END = LEN - LEN % 16
+	JZ           loop4_start
+
+loop16: // do {
+	// x[i] *= alpha unrolled 16x.
+	VMULPD  (X_PTR)(IDX*8), ALPHA, Y1
+	VMULPD  32(X_PTR)(IDX*8), ALPHA, Y2
+	VMULPD  64(X_PTR)(IDX*8), ALPHA, Y3
+	VMULPD  96(X_PTR)(IDX*8), ALPHA, Y4
+	VMOVUPD Y1, (X_PTR)(IDX*8)
+	VMOVUPD Y2, 32(X_PTR)(IDX*8)
+	VMOVUPD Y3, 64(X_PTR)(IDX*8)
+	VMOVUPD Y4, 96(X_PTR)(IDX*8)
+	ADDQ    $16, IDX                 // i += 16
+	CMPQ    IDX, END
+	JL      loop16                   // } while i < END
+
+loop4_start:
+	MOVQ LEN, END
+	ANDQ $-4, END   // END = LEN - LEN % 4
+	CMPQ IDX, END
+	JGE  tail_start
+
+loop4: // do {
+	VMULPD  (X_PTR)(IDX*8), ALPHA, Y1
+	VMOVUPD Y1, (X_PTR)(IDX*8)
+	ADDQ    $4, IDX                // i += 4
+	CMPQ    IDX, END
+	JL      loop4                  // } while i < END
+
+tail_start:
+	CMPQ IDX, LEN
+	JGE  end
+
+tail: // do {
+	VMULSD (X_PTR)(IDX*8), ALPHA_X, X1
+	VMOVSD X1, (X_PTR)(IDX*8)
+	INCQ   IDX                         // i++
+	CMPQ   IDX, LEN
+	JL     tail                        // } while i < LEN
+
+end:
+	VZEROUPPER
+	RET
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	. "gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// TestUnitaryLengths checks the unitary level 1 routines against reference
+// implementations for lengths that exercise the unrolled loops and their tails
+// in the vectorized kernels.
+func TestUnitaryLengths(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for n := 0; n <= 70; n++ {
+		const alpha = 0.7
+		xData := randSlice(n, 1, rnd)
+		yData := randSlice(n, 1, rnd)
+		if n == 0 {
+			xData, yData = nil, nil
+		}
+		prefix := fmt.Sprintf("n=%d", n)
+
+		x, xFront, xBack := newGuardedVector(xData, 1)
+		y, yFront, yBack := newGuardedVector(yData, 1)
+		dst, dstFront, dstBack := newGuardedVector(make([]float64, n), 1)
+		checkGuards := func(name string) {
+			t.Helper()
+			if !allNaN(xFront) || !allNaN(xBack) {
+				t.Errorf("%v: %s: guard violated in x", prefix, name)
+			}
+			if !allNaN(yFront) || !allNaN(yBack) {
+				t.Errorf("%v: %s: guard violated in y", prefix, name)
+			}
+			if !allNaN(dstFront) || !allNaN(dstBack) {
+				t.Errorf("%v: %s: guard violated in dst", prefix, name)
+			}
+			if !equalStrided(xData, x, 1) {
+				t.Errorf("%v: %s: modified read-only x argument", prefix, name)
+			}
+		}
+
+		var want, norm float64
+		for i := range xData {
+			want += xData[i] * yData[i]
+			norm += math.Abs(xData[i] * yData[i])
+		}
+		got := DotUnitary(x, y)
+		if math.Abs(got-want) > tol*norm {
+			t.Errorf("%v: DotUnitary: got %v, want %v", prefix, got, want)
+		}
+		checkGuards("DotUnitary")
+
+		AxpyUnitaryTo(dst, alpha, x, y)
+		for i := range dst {
+			want := alpha*xData[i] + yData[i]
+			if math.Abs(dst[i]-want) > tol*math.Max(1, math.Abs(want)) {
+				t.Errorf("%v: AxpyUnitaryTo: unexpected dst[%d]; got %v, want %v", prefix, i, dst[i], want)
+			}
+		}
+		checkGuards("AxpyUnitaryTo")
+
+		ScalUnitaryTo(dst, alpha, x)
+		for i := range dst {
+			if want := alpha * xData[i]; dst[i] != want {
+				t.Errorf("%v: ScalUnitaryTo: unexpected dst[%d]; got %v, want %v", prefix, i, dst[i], want)
+			}
+		}
+		checkGuards("ScalUnitaryTo")
+
+		AxpyUnitary(alpha, x, y)
+		for i := range y {
+			want := alpha*xData[i] + yData[i]
+			if math.Abs(y[i]-want) > tol*math.Max(1, math.Abs(want)) {
+				t.Errorf("%v: AxpyUnitary: unexpected y[%d]; got %v, want %v", prefix, i, y[i], want)
+			}
+		}
+		checkGuards("AxpyUnitary")
+
+		copy(y, yData)
+		ScalUnitary(alpha, y)
+		for i := range y {
+			if want := alpha * yData[i]; y[i] != want {
+				t.Errorf("%v: ScalUnitary: unexpected y[%d]; got %v, want %v", prefix, i, y[i], want)
+			}
+		}
+		checkGuards("ScalUnitary")
+	}
+}
@@ -3,5 +3,5 @@
 // license that can be found in the LICENSE file.
 
 // Package math32 provides float32 versions of standard library math package
-// routines used by gonum/blas/native.
+// routines used by the float32 BLAS, LAPACK and matrix packages.
 package math32 // import "gonum.org/v1/gonum/internal/math32"
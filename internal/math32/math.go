@@ -21,6 +21,13 @@ const (
 	bias    = 127
 )
 
+// Mathematical constants.
+const (
+	Sqrt2 = math.Sqrt2
+
+	MaxFloat32 = math.MaxFloat32
+)
+
 // Abs returns the absolute value of x.
 //
 // Special cases are:
@@ -37,6 +44,17 @@ func Abs(x float32) float32 {
 	return x
 }
 
+// Ceil returns the least integer value greater than or equal to x.
+//
+// Special cases are:
+//
+//	Ceil(±0) = ±0
+//	Ceil(±Inf) = ±Inf
+//	Ceil(NaN) = NaN
+func Ceil(x float32) float32 {
+	return float32(math.Ceil(float64(x)))
+}
+
 // Copysign returns a value with the magnitude
 // of x and the sign of y.
 func Copysign(x, y float32) float32 {
@@ -44,6 +62,19 @@ func Copysign(x, y float32) float32 {
 	return math.Float32frombits(math.Float32bits(x)&^sign | math.Float32bits(y)&sign)
 }
 
+// Exp returns e**x, the base-e exponential of x.
+//
+// Special cases are:
+//
+//	Exp(+Inf) = +Inf
+//	Exp(NaN) = NaN
+//
+// Very large values overflow to 0 or +Inf.
+// Very small values underflow to 1.
+func Exp(x float32) float32 {
+	return float32(math.Exp(float64(x)))
+}
+
 // Hypot returns Sqrt(p*p + q*q), taking care to avoid
 // unnecessary overflow and underflow.
 //
@@ -109,6 +140,24 @@ func IsNaN(f float32) (is bool) {
 	return f != f
 }
 
+// Log returns the natural logarithm of x.
+//
+// Special cases are:
+//
+//	Log(+Inf) = +Inf
+//	Log(0) = -Inf
+//	Log(x < 0) = NaN
+//	Log(NaN) = NaN
+func Log(x float32) float32 {
+	return float32(math.Log(float64(x)))
+}
+
+// Log2 returns the binary logarithm of x.
+// The special cases are the same as for Log.
+func Log2(x float32) float32 {
+	return float32(math.Log2(float64(x)))
+}
+
 // Max returns the larger of x or y.
 //
 // Special cases are:
@@ -162,5 +211,11 @@ func Min(x, y float32) float32 {
 	return y
 }
 
+// Pow returns x**y, the base-x exponential of y. Special cases are as for
+// math.Pow.
+func Pow(x, y float32) float32 {
+	return float32(math.Pow(float64(x), float64(y)))
+}
+
 // NaN returns an IEEE 754 “not-a-number” value.
 func NaN() float32 { return math.Float32frombits(unan) }
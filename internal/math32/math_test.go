@@ -24,6 +24,15 @@ func TestAbs(t *testing.T) {
 	}
 }
 
+func TestCeil(t *testing.T) {
+	f := func(x float32) bool {
+		return Ceil(x) == float32(math.Ceil(float64(x)))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestCopySign(t *testing.T) {
 	f := func(x struct{ X, Y float32 }) bool {
 		y := Copysign(x.X, x.Y)
@@ -50,6 +59,23 @@ func TestHypot(t *testing.T) {
 	}
 }
 
+func TestExp(t *testing.T) {
+	f := func(x float32) bool {
+		y := Exp(x)
+		want := math.Exp(float64(x))
+		switch {
+		case want > math.MaxFloat32:
+			return IsInf(y, 1)
+		case want < math.SmallestNonzeroFloat32:
+			return true
+		}
+		return scalar.EqualWithinRel(float64(y), want, tol)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestInf(t *testing.T) {
 	if float64(Inf(1)) != math.Inf(1) || float64(Inf(-1)) != math.Inf(-1) {
 		t.Error("float32(inf) not infinite")
@@ -84,6 +110,34 @@ func TestIsNaN(t *testing.T) {
 	}
 }
 
+func TestLog(t *testing.T) {
+	f := func(x float32) bool {
+		y := Log(x)
+		want := math.Log(float64(x))
+		if math.IsNaN(want) {
+			return IsNaN(y)
+		}
+		return scalar.EqualWithinRel(float64(y), want, tol)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLog2(t *testing.T) {
+	f := func(x float32) bool {
+		y := Log2(x)
+		want := math.Log2(float64(x))
+		if math.IsNaN(want) {
+			return IsNaN(y)
+		}
+		return scalar.EqualWithinRel(float64(y), want, tol)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestMax(t *testing.T) {
 	values := []float32{
 		Inf(-1),
@@ -136,6 +190,25 @@ func TestNaN(t *testing.T) {
 	}
 }
 
+func TestPow(t *testing.T) {
+	f := func(x struct{ X, Y float32 }) bool {
+		y := Pow(x.X, x.Y)
+		want := math.Pow(float64(x.X), float64(x.Y))
+		switch {
+		case math.IsNaN(want):
+			return IsNaN(y)
+		case math.Abs(want) > math.MaxFloat32:
+			return IsInf(y, 0)
+		case math.Abs(want) < math.SmallestNonzeroFloat32:
+			return true
+		}
+		return scalar.EqualWithinRel(float64(y), want, tol)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestSignbit(t *testing.T) {
 	f := func(x float32) bool {
 		return Signbit(x) == math.Signbit(float64(x))
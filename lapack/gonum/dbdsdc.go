@@ -0,0 +1,221 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dbdsdc computes the singular value decomposition of a real n×n bidiagonal
+// matrix B
+//
+//	B = U * S * VT,
+//
+// where S is a diagonal matrix containing the singular values of B, U contains
+// the left singular vectors and VT contains the transposed right singular
+// vectors of B. If the singular vectors are requested, Dbdsdc uses a divide and
+// conquer method which is much faster than the implicit zero-shift QR method in
+// Dbdsqr for large matrices.
+//
+// If uplo is blas.Upper, B is upper bidiagonal, otherwise B is lower
+// bidiagonal.
+//
+// compq specifies whether the singular vectors are computed:
+//   - lapack.SVDCompNone: only the singular values are computed,
+//   - lapack.SVDCompExplicit: the singular values and vectors are computed.
+//
+// On entry, d must have length n and contain the diagonal of B. On return, if
+// ok is true, d contains the singular values of B in decreasing order.
+//
+// On entry, e must have length n-1 and contain the off-diagonal of B. On
+// return, e is overwritten.
+//
+// If compq is lapack.SVDCompExplicit, on return the n×n matrix U contains the
+// left singular vectors and the n×n matrix VT contains the transposed right
+// singular vectors of B. If compq is lapack.SVDCompNone, U and VT are not
+// referenced.
+//
+// work must have length at least 4*n if compq is lapack.SVDCompNone and
+// 3*n*n+4*n if compq is lapack.SVDCompExplicit. iwork must have length at
+// least 8*n.
+//
+// Dbdsdc returns whether the computation was successful. If it returns false,
+// a singular value did not converge.
+//
+// Dbdsdc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dbdsdc(uplo blas.Uplo, compq lapack.SVDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool) {
+	wantVec := compq == lapack.SVDCompExplicit
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case compq != lapack.SVDCompNone && compq != lapack.SVDCompExplicit:
+		panic(badSVDComp)
+	case n < 0:
+		panic(nLT0)
+	case ldu < 1, wantVec && ldu < n:
+		panic(badLdU)
+	case ldvt < 1, wantVec && ldvt < n:
+		panic(badLdVT)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	lwork := 4 * n
+	if wantVec {
+		lwork = 3*n*n + 4*n
+	}
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case wantVec && len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case wantVec && len(vt) < (n-1)*ldvt+n:
+		panic(shortVT)
+	case len(work) < lwork:
+		panic(shortWork)
+	case len(iwork) < 8*n:
+		panic(shortIWork)
+	}
+
+	if n == 1 {
+		if wantVec {
+			u[0] = math.Copysign(1, d[0])
+			vt[0] = 1
+		}
+		d[0] = math.Abs(d[0])
+		return true
+	}
+
+	// If the matrix is lower bidiagonal, apply orthogonal transformations to
+	// make it upper bidiagonal. The rotations are kept in work[:2*n-2] to be
+	// applied to U at the end.
+	var wstart int
+	if uplo == blas.Lower {
+		if wantVec {
+			wstart = 2*n - 2
+		}
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if wantVec {
+				work[i] = cs
+				work[n-1+i] = -sn
+			}
+		}
+	}
+
+	if !wantVec {
+		// Only the singular values are requested.
+		ok = impl.Dlasdq(blas.Upper, 0, n, 0, 0, 0, d, e, vt, ldvt, u, ldu, u, ldu, work)
+	} else {
+		ok = impl.dbdsdcVectors(n, d, e, u, ldu, vt, ldvt, work[wstart:], iwork)
+	}
+	if !ok {
+		return false
+	}
+
+	// Sort the singular values into decreasing order using selection sort to
+	// minimize swaps of singular vectors.
+	bi := blas64.Implementation()
+	for i := 0; i < n-1; i++ {
+		kk := i
+		p := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] > p {
+				kk = j
+				p = d[j]
+			}
+		}
+		if kk != i {
+			d[kk] = d[i]
+			d[i] = p
+			if wantVec {
+				bi.Dswap(n, u[i:], ldu, u[kk:], ldu)
+				bi.Dswap(n, vt[i*ldvt:], 1, vt[kk*ldvt:], 1)
+			}
+		}
+	}
+
+	// If B is lower bidiagonal, update U by the rotations which made it upper
+	// bidiagonal.
+	if uplo == blas.Lower && wantVec {
+		impl.Dlasr(blas.Left, lapack.Variable, lapack.Backward, n, n, work[:n-1], work[n-1:2*n-2], u, ldu)
+	}
+	return true
+}
+
+// dbdsdcVectors computes the singular values and vectors of the n×n upper
+// bidiagonal matrix with diagonal d and off-diagonal e. The singular values
+// are returned in d in no particular order.
+func (impl Implementation) dbdsdcVectors(n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool) {
+	impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+	impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+
+	smlsiz := impl.Ilaenv(9, "DBDSDC", " ", 0, 0, 0, 0)
+	if n <= smlsiz {
+		return impl.Dlasdq(blas.Upper, 0, n, n, n, 0, d, e, vt, ldvt, u, ldu, u, ldu, work)
+	}
+
+	// Scale.
+	orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if orgnrm == 0 {
+		return true
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n-1, 1, e, 1)
+
+	eps := 0.9 * dlamchE
+	for i := 0; i < n; i++ {
+		if math.Abs(d[i]) < eps {
+			d[i] = math.Copysign(eps, d[i])
+		}
+	}
+
+	// Split the matrix at negligible off-diagonal elements and solve each
+	// subproblem by divide and conquer.
+	start := 0
+	for i := 0; i < n-1; i++ {
+		if math.Abs(e[i]) >= eps && i < n-2 {
+			continue
+		}
+		var nsize int
+		switch {
+		case i < n-2:
+			// A subproblem with e[i] small.
+			nsize = i - start + 1
+		case math.Abs(e[i]) >= eps:
+			// A subproblem with e[n-2] not too small, i == n-2.
+			nsize = n - start
+		default:
+			// A subproblem with e[n-2] small. This implies a 1×1
+			// subproblem at the end of the matrix.
+			nsize = i - start + 1
+			u[(n-1)*ldu+n-1] = math.Copysign(1, d[n-1])
+			vt[(n-1)*ldvt+n-1] = 1
+			d[n-1] = math.Abs(d[n-1])
+		}
+		ok = impl.Dlasd0(nsize, 0, d[start:], e[start:], u[start*ldu+start:], ldu,
+			vt[start*ldvt+start:], ldvt, smlsiz, iwork, work)
+		if !ok {
+			return false
+		}
+		start = i + 1
+	}
+
+	// Unscale.
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+	return true
+}
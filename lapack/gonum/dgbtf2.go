@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Dgbtf2 computes the LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges. The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements and at most kl non-zero elements below the diagonal in each column,
+// and U is upper triangular with kl+ku super-diagonals.
+//
+// The band storage scheme is illustrated below when m = n = 6, kl = 2 and
+// ku = 1. Elements marked * are not used by the function and elements marked +
+// need not be set on entry, but are required to store elements of U because
+// of fill-in resulting from the row interchanges. On return, mij are the
+// multipliers used during the factorization.
+//
+//	On entry:                      On return:
+//	  *    *   a00  a01   +    +     *    *   u00  u01  u02  u03
+//	  *   a10  a11  a12   +    +     *   m10  u11  u12  u13  u14
+//	 a20  a21  a22  a23   +    +    m20  m21  u22  u23  u24  u25
+//	 a31  a32  a33  a34   +    *    m31  m32  u33  u34  u35   *
+//	 a42  a43  a44  a45   *    *    m42  m43  u44  u45   *    *
+//	 a53  a54  a55   *    *    *    m53  m54  u55   *    *    *
+//
+// so that ldab must be at least 2*kl+ku+1.
+//
+// L is stored as a product of permutations and unit lower triangular matrices
+//
+//	L = P_0 * L_0 * P_1 * L_1 * ... * P_{k-1} * L_{k-1}
+//
+// where k = min(m,n), P_j interchanges rows j and ipiv[j], and L_j is the
+// identity matrix with the multipliers of step j stored below the diagonal
+// in column j. ipiv must have length min(m,n), and Dgbtf2 will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Dgbtf2 returns whether the matrix A is nonsingular. The LU factorization is
+// computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equations.
+//
+// Dgbtf2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgbtf2(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	kv := ku + kl
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < kl+kv+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	mn := min(m, n)
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (min(m, n+kl)-1)*ldab+kl+kv+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// Set the fill-in elements to zero.
+	for i := 0; i < min(m, n+kl); i++ {
+		for jb := kl + ku + 1; jb <= kl+kv; jb++ {
+			ab[i*ldab+jb] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+
+	ok = true
+	// ju is the index of the last column affected by the current stage of
+	// the factorization.
+	var ju int
+	for j := 0; j < mn; j++ {
+		// Find pivot and test for singularity. km is the number of
+		// sub-diagonal elements in the current column.
+		km := min(kl, m-j-1)
+		var jp int
+		if km > 0 {
+			jp = bi.Idamax(km+1, ab[j*ldab+kl:], ldab-1)
+		}
+		ipiv[j] = j + jp
+		if ab[(j+jp)*ldab+kl-jp] == 0 {
+			ok = false
+			continue
+		}
+		ju = max(ju, min(j+ku+jp, n-1))
+		if jp != 0 {
+			// Interchange rows j and j+jp in columns j to ju.
+			bi.Dswap(ju-j+1, ab[(j+jp)*ldab+kl-jp:], 1, ab[j*ldab+kl:], 1)
+		}
+		if km > 0 {
+			// Compute multipliers.
+			bi.Dscal(km, 1/ab[j*ldab+kl], ab[(j+1)*ldab+kl-1:], ldab-1)
+			// Update trailing submatrix within the band.
+			if ju > j {
+				bi.Dger(km, ju-j, -1, ab[(j+1)*ldab+kl-1:], ldab-1,
+					ab[j*ldab+kl+1:], 1,
+					ab[(j+1)*ldab+kl:], ldab-1)
+			}
+		}
+	}
+	return ok
+}
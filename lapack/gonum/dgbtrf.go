@@ -0,0 +1,289 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrf computes the LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges. The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements and at most kl non-zero elements below the diagonal in each column,
+// and U is upper triangular with kl+ku super-diagonals.
+//
+// The band storage scheme is illustrated below when m = n = 6, kl = 2 and
+// ku = 1. Elements marked * are not used by the function and elements marked +
+// need not be set on entry, but are required to store elements of U because
+// of fill-in resulting from the row interchanges. On return, mij are the
+// multipliers used during the factorization.
+//
+//	On entry:                      On return:
+//	  *    *   a00  a01   +    +     *    *   u00  u01  u02  u03
+//	  *   a10  a11  a12   +    +     *   m10  u11  u12  u13  u14
+//	 a20  a21  a22  a23   +    +    m20  m21  u22  u23  u24  u25
+//	 a31  a32  a33  a34   +    *    m31  m32  u33  u34  u35   *
+//	 a42  a43  a44  a45   *    *    m42  m43  u44  u45   *    *
+//	 a53  a54  a55   *    *    *    m53  m54  u55   *    *    *
+//
+// so that ldab must be at least 2*kl+ku+1.
+//
+// L is stored as a product of permutations and unit lower triangular matrices
+//
+//	L = P_0 * L_0 * P_1 * L_1 * ... * P_{k-1} * L_{k-1}
+//
+// where k = min(m,n), P_j interchanges rows j and ipiv[j], and L_j is the
+// identity matrix with the multipliers of step j stored below the diagonal
+// in column j. ipiv must have length min(m,n), and Dgbtrf will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Dgbtrf returns whether the matrix A is nonsingular. The LU factorization is
+// computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equations.
+func (impl Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	const (
+		nbmax  = 64
+		ldwork = nbmax
+	)
+
+	kv := ku + kl
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < kl+kv+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	mn := min(m, n)
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (min(m, n+kl)-1)*ldab+kl+kv+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	nb := impl.Ilaenv(1, "DGBTRF", " ", m, n, kl, ku)
+	// The block size must not exceed the limit set by the size of the local
+	// arrays work13 and work31.
+	nb = min(nb, nbmax)
+
+	if nb <= 1 || kl < nb {
+		// Use unblocked code.
+		return impl.Dgbtf2(m, n, kl, ku, ab, ldab, ipiv)
+	}
+
+	// Use blocked code.
+	//
+	// The strictly upper triangular part of work13 and the strictly lower
+	// triangular part of work31 lie outside the band and are zero.
+	work13 := make([]float64, nbmax*ldwork)
+	work31 := make([]float64, nbmax*ldwork)
+
+	// Set the fill-in elements to zero.
+	for i := 0; i < min(m, n+kl); i++ {
+		for jb := kl + ku + 1; jb <= kl+kv; jb++ {
+			ab[i*ldab+jb] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+
+	ok = true
+	// ju is the index of the last column affected by the current stage of
+	// the factorization.
+	var ju int
+	for j := 0; j < mn; j += nb {
+		jb := min(nb, mn-j)
+
+		// The active part of the matrix is partitioned
+		//
+		//	A11   A12   A13
+		//	A21   A22   A23
+		//	A31   A32   A33
+		//
+		// Here A11, A21 and A31 denote the current block of jb columns
+		// which is about to be factorized. The number of rows in the
+		// partitioning are jb, i2, i3 respectively, and the numbers of
+		// columns are jb, j2, j3. The superdiagonal elements of A13 and
+		// the subdiagonal elements of A31 lie outside the band.
+		i2 := min(kl-jb, m-j-jb)
+		i3 := min(jb, m-j-kl)
+
+		// Factorize the current block of jb columns.
+		for jj := j; jj < j+jb; jj++ {
+			// Find pivot and test for singularity. km is the number of
+			// sub-diagonal elements in the current column.
+			km := min(kl, m-jj-1)
+			jp := bi.Idamax(km+1, ab[jj*ldab+kl:], ldab-1)
+			// The pivot index is relative to the start of the block.
+			ipiv[jj] = jj + jp - j
+			if ab[(jj+jp)*ldab+kl-jp] != 0 {
+				ju = max(ju, min(jj+ku+jp, n-1))
+				if jp != 0 {
+					// Apply the interchange to columns j to j+jb-1.
+					if jj+jp < j+kl {
+						bi.Dswap(jb, ab[jj*ldab+kl+j-jj:], 1, ab[(jj+jp)*ldab+kl+j-jj-jp:], 1)
+					} else {
+						// The interchange affects columns j to jj-1 of A31
+						// which are stored in the work array work31.
+						bi.Dswap(jj-j, ab[jj*ldab+kl+j-jj:], 1, work31[(jj+jp-j-kl)*ldwork:], 1)
+						bi.Dswap(j+jb-jj, ab[jj*ldab+kl:], 1, ab[(jj+jp)*ldab+kl-jp:], 1)
+					}
+				}
+
+				if km > 0 {
+					// Compute multipliers.
+					bi.Dscal(km, 1/ab[jj*ldab+kl], ab[(jj+1)*ldab+kl-1:], ldab-1)
+
+					// Update trailing submatrix within the band and within
+					// the current block. jm is the index of the last column
+					// which needs to be updated.
+					jm := min(ju, j+jb-1)
+					if jm > jj {
+						bi.Dger(km, jm-jj, -1, ab[(jj+1)*ldab+kl-1:], ldab-1,
+							ab[jj*ldab+kl+1:], 1,
+							ab[(jj+1)*ldab+kl:], ldab-1)
+					}
+				}
+			} else {
+				ok = false
+			}
+
+			// Copy the current column of A31 into the work array work31.
+			nw := min(jj-j+1, i3)
+			if nw > 0 {
+				bi.Dcopy(nw, ab[(j+kl)*ldab+jj-j:], ldab-1, work31[jj-j:], ldwork)
+			}
+		}
+
+		if j+jb < n {
+			// Apply the row interchanges to the other blocks.
+			j2 := min(ju-j+1, kv) - jb
+			j3 := max(0, ju-j-kv+1)
+
+			// Use Dlaswp to apply the row interchanges to A12, A22 and A32.
+			impl.Dlaswp(j2, ab[j*ldab+kl+jb:], ldab-1, 0, jb-1, ipiv[j:j+jb], 1)
+
+			// Adjust the pivot indices.
+			for i := j; i < j+jb; i++ {
+				ipiv[i] += j
+			}
+
+			// Apply the row interchanges to A13, A23 and A33 columnwise.
+			k2 := j + jb + j2
+			for i := 0; i < j3; i++ {
+				jj := k2 + i
+				for ii := j + i; ii < j+jb; ii++ {
+					ip := ipiv[ii]
+					if ip != ii {
+						ab[ii*ldab+kl+jj-ii], ab[ip*ldab+kl+jj-ip] = ab[ip*ldab+kl+jj-ip], ab[ii*ldab+kl+jj-ii]
+					}
+				}
+			}
+
+			// Update the relevant part of the trailing submatrix.
+			if j2 > 0 {
+				// Update A12.
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, jb, j2,
+					1, ab[j*ldab+kl:], ldab-1,
+					ab[j*ldab+kl+jb:], ldab-1)
+				if i2 > 0 {
+					// Update A22.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i2, j2, jb,
+						-1, ab[(j+jb)*ldab+kl-jb:], ldab-1,
+						ab[j*ldab+kl+jb:], ldab-1,
+						1, ab[(j+jb)*ldab+kl:], ldab-1)
+				}
+				if i3 > 0 {
+					// Update A32.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i3, j2, jb,
+						-1, work31, ldwork,
+						ab[j*ldab+kl+jb:], ldab-1,
+						1, ab[(j+kl)*ldab+jb:], ldab-1)
+				}
+			}
+
+			if j3 > 0 {
+				// Copy the lower triangle of A13 into the work array work13.
+				for jj := 0; jj < j3; jj++ {
+					for ii := jj; ii < jb; ii++ {
+						work13[ii*ldwork+jj] = ab[(j+ii)*ldab+kl+kv+jj-ii]
+					}
+				}
+
+				// Update A13 in the work array.
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, jb, j3,
+					1, ab[j*ldab+kl:], ldab-1,
+					work13, ldwork)
+				if i2 > 0 {
+					// Update A23.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i2, j3, jb,
+						-1, ab[(j+jb)*ldab+kl-jb:], ldab-1,
+						work13, ldwork,
+						1, ab[(j+jb)*ldab+kl+kv-jb:], ldab-1)
+				}
+				if i3 > 0 {
+					// Update A33.
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, i3, j3, jb,
+						-1, work31, ldwork,
+						work13, ldwork,
+						1, ab[(j+kl)*ldab+kv:], ldab-1)
+				}
+
+				// Copy the lower triangle of A13 back into place.
+				for jj := 0; jj < j3; jj++ {
+					for ii := jj; ii < jb; ii++ {
+						ab[(j+ii)*ldab+kl+kv+jj-ii] = work13[ii*ldwork+jj]
+					}
+				}
+			}
+		} else {
+			// Adjust the pivot indices.
+			for i := j; i < j+jb; i++ {
+				ipiv[i] += j
+			}
+		}
+
+		// Partially undo the interchanges in the current block to restore
+		// the upper triangular form of A31 and copy the upper triangle of
+		// A31 back into place.
+		for jj := j + jb - 1; jj >= j; jj-- {
+			jp := ipiv[jj] - jj
+			if jp != 0 {
+				// Apply the interchange to columns j to jj-1.
+				if jj+jp < j+kl {
+					// The interchange does not affect A31.
+					bi.Dswap(jj-j, ab[jj*ldab+kl+j-jj:], 1, ab[(jj+jp)*ldab+kl+j-jj-jp:], 1)
+				} else {
+					// The interchange does affect A31.
+					bi.Dswap(jj-j, ab[jj*ldab+kl+j-jj:], 1, work31[(jj+jp-j-kl)*ldwork:], 1)
+				}
+			}
+
+			// Copy the current column of A31 back into place.
+			nw := min(i3, jj-j+1)
+			if nw > 0 {
+				bi.Dcopy(nw, work31[jj-j:], ldwork, ab[(j+kl)*ldab+jj-j:], ldab-1)
+			}
+		}
+	}
+	return ok
+}
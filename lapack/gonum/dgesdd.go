@@ -0,0 +1,391 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesdd computes the singular value decomposition of the m×n matrix A using a
+// divide and conquer method.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// If the singular vectors are requested, Dgesdd is usually much faster than
+// Dgesvd for large matrices because the singular vectors of the bidiagonal
+// matrix are computed by the divide and conquer method in Dbdsdc.
+//
+// jobz specifies the singular vectors that are computed:
+//
+//	jobz == lapack.SVDAll       All m columns of U and all n rows of Vᵀ are
+//	                            returned in u and vt.
+//	jobz == lapack.SVDStore     The first min(m,n) columns of U and the first
+//	                            min(m,n) rows of Vᵀ are returned in u and vt.
+//	jobz == lapack.SVDOverwrite If m >= n, the first n columns of U are written
+//	                            into a and all n rows of Vᵀ are returned in vt.
+//	                            Otherwise, all m columns of U are returned in u
+//	                            and the first m rows of Vᵀ are written into a.
+//	jobz == lapack.SVDNone      No singular vectors are computed.
+//
+// On entry, a contains the data for the m×n matrix A. On return, if jobz is
+// not lapack.SVDOverwrite, the contents of A are destroyed.
+//
+// s must have length at least min(m,n) and on return it contains the singular
+// values in decreasing order.
+//
+// u and vt are not referenced if they are not needed for the requested
+// singular vectors.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. Let mn = min(m,n) and mx = max(m,n). lwork must be at least
+//
+//	3*mn + max(mx, 4*mn)            if jobz == lapack.SVDNone,
+//	4*mn*mn + 8*mn + mx             if jobz == lapack.SVDAll or lapack.SVDStore,
+//	4*mn*mn + 8*mn + mx + m*n       if jobz == lapack.SVDOverwrite.
+//
+// If lwork == -1, instead of performing Dgesdd, the optimal work length will
+// be stored into work[0]. iwork must have length at least 8*min(m,n).
+//
+// Dgesdd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	wntqa := jobz == lapack.SVDAll
+	wntqs := jobz == lapack.SVDStore
+	wntqo := jobz == lapack.SVDOverwrite
+	wntqn := jobz == lapack.SVDNone
+	minmn := min(m, n)
+	maxmn := max(m, n)
+
+	// Determine the dimensions of the U and VT matrices that are returned in
+	// u and vt.
+	var ucol, vrow int
+	switch {
+	case wntqa:
+		ucol, vrow = m, n
+	case wntqs:
+		ucol, vrow = minmn, minmn
+	case wntqo && m >= n:
+		vrow = n
+	case wntqo:
+		ucol = m
+	}
+
+	minwork := 1
+	if minmn > 0 {
+		if wntqn {
+			minwork = 3*minmn + max(maxmn, 4*minmn)
+		} else {
+			minwork = 4*minmn*minmn + 8*minmn + maxmn
+			if wntqo {
+				minwork += m * n
+			}
+		}
+	}
+	switch {
+	case !wntqa && !wntqs && !wntqo && !wntqn:
+		panic(badSVDJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < max(1, ucol):
+		panic(badLdU)
+	case ldvt < 1, vrow > 0 && ldvt < n:
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// Compute the optimal workspace. The QR or LQ factorization is computed
+	// first if A has many more rows than columns or columns than rows.
+	mnthr := minmn * 11 / 6
+	tall := maxmn >= mnthr
+	var maxwrk int
+	if m >= n {
+		nwork := 3 * n
+		if tall {
+			impl.Dgeqrf(m, n, a, lda, nil, work, -1)
+			maxwrk = n + int(work[0])
+			if !wntqn {
+				nwork += n + n*n
+			}
+		}
+		if wntqo {
+			nwork += m * n
+		}
+		impl.Dgebrd(n, n, a, lda, s, nil, nil, nil, work, -1)
+		if !tall {
+			impl.Dgebrd(m, n, a, lda, s, nil, nil, nil, work, -1)
+		}
+		maxwrk = max(maxwrk, nwork+int(work[0]))
+		if wntqn {
+			maxwrk = max(maxwrk, nwork+4*n)
+		} else {
+			maxwrk = max(maxwrk, nwork+3*n*n+4*n)
+			nq := m
+			if tall {
+				nq = n
+				impl.Dormqr(blas.Left, blas.NoTrans, m, max(n, ucol), n, a, lda, nil, u, ldu, work, -1)
+				maxwrk = max(maxwrk, nwork+int(work[0]))
+			}
+			impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, nq, max(n, ucol), n, a, lda, nil, u, ldu, work, -1)
+			maxwrk = max(maxwrk, nwork+int(work[0]))
+			impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, nil, vt, ldvt, work, -1)
+			maxwrk = max(maxwrk, nwork+int(work[0]))
+		}
+	} else {
+		nwork := 3 * m
+		if tall {
+			impl.Dgelqf(m, n, a, lda, nil, work, -1)
+			maxwrk = m + int(work[0])
+			if !wntqn {
+				nwork += m + m*m
+			}
+		}
+		if wntqo {
+			nwork += m * n
+		}
+		impl.Dgebrd(m, m, a, lda, s, nil, nil, nil, work, -1)
+		if !tall {
+			impl.Dgebrd(m, n, a, lda, s, nil, nil, nil, work, -1)
+		}
+		maxwrk = max(maxwrk, nwork+int(work[0]))
+		if wntqn {
+			maxwrk = max(maxwrk, nwork+4*m)
+		} else {
+			maxwrk = max(maxwrk, nwork+3*m*m+4*m)
+			nq := n
+			if tall {
+				nq = m
+				impl.Dormlq(blas.Right, blas.NoTrans, max(m, vrow), n, m, a, lda, nil, vt, ldvt, work, -1)
+				maxwrk = max(maxwrk, nwork+int(work[0]))
+			}
+			impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, nil, u, ldu, work, -1)
+			maxwrk = max(maxwrk, nwork+int(work[0]))
+			impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, max(m, vrow), nq, m, a, lda, nil, vt, ldvt, work, -1)
+			maxwrk = max(maxwrk, nwork+int(work[0]))
+		}
+	}
+	maxwrk = max(maxwrk, minwork)
+
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case ucol > 0 && len(u) < (m-1)*ldu+ucol:
+		panic(shortU)
+	case vrow > 0 && len(vt) < (vrow-1)*ldvt+n:
+		panic(shortVT)
+	case len(iwork) < 8*minmn:
+		panic(shortIWork)
+	}
+
+	// Scale A if max element outside range [smlnum, bignum].
+	eps := dlamchP
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	if math.IsNaN(anrm) {
+		return false
+	}
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+
+	if m >= n {
+		ok = impl.dgesddTall(jobz, tall, m, n, a, lda, s, u, ldu, ucol, vt, ldvt, work, lwork, iwork)
+	} else {
+		ok = impl.dgesddWide(jobz, tall, m, n, a, lda, s, u, ldu, vt, ldvt, vrow, work, lwork, iwork)
+	}
+
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			impl.Dlascl(lapack.General, 0, 0, bignum, anrm, minmn, 1, s, 1)
+		}
+		if anrm < smlnum {
+			impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, minmn, 1, s, 1)
+		}
+	}
+	work[0] = float64(maxwrk)
+	return ok
+}
+
+// dgesddTall computes the singular value decomposition of the m×n matrix A
+// with m >= n. If qr is true, the QR factorization of A is computed first.
+// ucol is the number of columns of U that are returned in u.
+func (impl Implementation) dgesddTall(jobz lapack.SVDJob, qr bool, m, n int, a []float64, lda int, s, u []float64, ldu, ucol int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	// Compute the QR factorization A = Q*R and continue with the n×n upper
+	// triangular matrix R.
+	var itau, ir int
+	nwork := 0
+	ab, ldab, mb := a, lda, m
+	if qr {
+		itau = 0
+		nwork = itau + n
+		impl.Dgeqrf(m, n, a, lda, work[itau:itau+n], work[nwork:], lwork-nwork)
+		if jobz == lapack.SVDNone {
+			// The reflectors are not needed.
+			if n > 1 {
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+			}
+			nwork = 0
+		} else {
+			ir = nwork
+			nwork = ir + n*n
+			ab, ldab = work[ir:nwork], n
+			impl.Dlacpy(blas.Upper, n, n, a, lda, ab, ldab)
+			if n > 1 {
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, ab[ldab:], ldab)
+			}
+		}
+		mb = n
+	}
+
+	// Reduce A or R to bidiagonal form.
+	ie := nwork
+	itauq := ie + n
+	itaup := itauq + n
+	nwork = itaup + n
+	impl.Dgebrd(mb, n, ab, ldab, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+	if jobz == lapack.SVDNone {
+		return impl.Dbdsdc(blas.Upper, lapack.SVDCompNone, n, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+	}
+
+	// Compute the left singular vectors into u or, if they overwrite A, into
+	// a temporary m×n matrix.
+	uc, lduc := u, ldu
+	if jobz == lapack.SVDOverwrite {
+		ucol = n
+		lduc = n
+		uc = work[nwork : nwork+m*n]
+		nwork += m * n
+	}
+	impl.Dlaset(blas.All, m, ucol, 0, 1, uc, lduc)
+	ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompExplicit, n, s, work[ie:], uc, lduc, vt, ldvt, work[nwork:], iwork)
+	if !ok {
+		return false
+	}
+
+	// Multiply the singular vectors of the bidiagonal matrix by the
+	// orthogonal matrices from the reduction.
+	if qr {
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, ab, ldab, work[itauq:], uc, lduc, work[nwork:], lwork-nwork)
+		impl.Dormqr(blas.Left, blas.NoTrans, m, ucol, n, a, lda, work[itau:itau+n], uc, lduc, work[nwork:], lwork-nwork)
+	} else {
+		impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, ucol, n, ab, ldab, work[itauq:], uc, lduc, work[nwork:], lwork-nwork)
+	}
+	impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, mb, ab, ldab, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+	if jobz == lapack.SVDOverwrite {
+		impl.Dlacpy(blas.All, m, n, uc, lduc, a, lda)
+	}
+	return true
+}
+
+// dgesddWide computes the singular value decomposition of the m×n matrix A
+// with m < n. If lq is true, the LQ factorization of A is computed first.
+// vrow is the number of rows of Vᵀ that are returned in vt.
+func (impl Implementation) dgesddWide(jobz lapack.SVDJob, lq bool, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt, vrow int, work []float64, lwork int, iwork []int) (ok bool) {
+	// Compute the LQ factorization A = L*Q and continue with the m×m lower
+	// triangular matrix L.
+	var itau, il int
+	nwork := 0
+	ab, ldab, nb := a, lda, n
+	if lq {
+		itau = 0
+		nwork = itau + m
+		impl.Dgelqf(m, n, a, lda, work[itau:itau+m], work[nwork:], lwork-nwork)
+		if jobz == lapack.SVDNone {
+			// The reflectors are not needed.
+			impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+			nwork = 0
+		} else {
+			il = nwork
+			nwork = il + m*m
+			ab, ldab = work[il:nwork], m
+			impl.Dlacpy(blas.Lower, m, m, a, lda, ab, ldab)
+			impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, ab[1:], ldab)
+		}
+		nb = m
+	}
+
+	// Reduce A or L to bidiagonal form. The bidiagonal matrix is lower
+	// bidiagonal if m < nb, and upper bidiagonal otherwise.
+	uplo := blas.Upper
+	if m < nb {
+		uplo = blas.Lower
+	}
+	ie := nwork
+	itauq := ie + m
+	itaup := itauq + m
+	nwork = itaup + m
+	impl.Dgebrd(m, nb, ab, ldab, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+	if jobz == lapack.SVDNone {
+		return impl.Dbdsdc(uplo, lapack.SVDCompNone, m, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+	}
+
+	// Compute the right singular vectors into vt or, if they overwrite A,
+	// into a temporary m×n matrix.
+	vtc, ldvtc := vt, ldvt
+	if jobz == lapack.SVDOverwrite {
+		vrow = m
+		ldvtc = n
+		vtc = work[nwork : nwork+m*n]
+		nwork += m * n
+	}
+	impl.Dlaset(blas.All, vrow, n, 0, 1, vtc, ldvtc)
+	ok = impl.Dbdsdc(uplo, lapack.SVDCompExplicit, m, s, work[ie:], u, ldu, vtc, ldvtc, work[nwork:], iwork)
+	if !ok {
+		return false
+	}
+
+	// Multiply the singular vectors of the bidiagonal matrix by the
+	// orthogonal matrices from the reduction.
+	impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, nb, ab, ldab, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+	if lq {
+		impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, ab, ldab, work[itaup:], vtc, ldvtc, work[nwork:], lwork-nwork)
+		impl.Dormlq(blas.Right, blas.NoTrans, vrow, n, m, a, lda, work[itau:itau+m], vtc, ldvtc, work[nwork:], lwork-nwork)
+	} else {
+		impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, vrow, n, m, ab, ldab, work[itaup:], vtc, ldvtc, work[nwork:], lwork-nwork)
+	}
+
+	if jobz == lapack.SVDOverwrite {
+		impl.Dlacpy(blas.All, m, n, vtc, ldvtc, a, lda)
+	}
+	return true
+}
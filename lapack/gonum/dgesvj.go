@@ -0,0 +1,701 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesvj computes the singular value decomposition of an m×n matrix A with
+// m >= n using the one-sided Jacobi method.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an n×n diagonal matrix containing the singular values of A,
+// U is an m×n matrix with orthonormal columns and V is an n×n orthogonal
+// matrix.
+//
+// Dgesvj applies plane rotations from the right to A until its columns are
+// mutually orthogonal to working precision, using a row-cyclic pivot strategy
+// with de Rijk's pivoting. It is slower than Dgesvd and Dgesdd, but it
+// computes the singular values to high relative accuracy if A = B*D with a
+// diagonal matrix D and a well-conditioned matrix B, that is, even if the
+// columns of A are badly scaled.
+//
+// joba specifies the structure of A:
+//
+//	joba == lapack.General  A is a general m×n matrix.
+//	joba == lapack.UpperTri A is upper triangular (trapezoidal).
+//	joba == lapack.LowerTri A is lower triangular (trapezoidal).
+//
+// If A is triangular, the elements outside the triangle must be zero.
+//
+// jobu specifies whether the left singular vectors are computed:
+//
+//	jobu == lapack.LeftSVCompute The left singular vectors corresponding to
+//	                             the non-zero singular values are written into
+//	                             the leading columns of a.
+//	jobu == lapack.LeftSVTol     As for lapack.LeftSVCompute, but the columns
+//	                             are orthogonalized to within the tolerance
+//	                             ctol*eps where ctol is given in work[0] on
+//	                             entry. ctol must be greater than 1.
+//	jobu == lapack.LeftSVNone    The left singular vectors are not computed and
+//	                             the contents of a are destroyed.
+//
+// jobv specifies whether the right singular vectors are computed:
+//
+//	jobv == lapack.RightSVCompute The n×n matrix V is returned in v.
+//	jobv == lapack.RightSVApply   The Jacobi rotations are applied to the mv×n
+//	                              matrix stored in v on entry, that is, v
+//	                              contains the product of that matrix and V
+//	                              on return.
+//	jobv == lapack.RightSVNone    V is not computed and v is not referenced.
+//
+// mv is only referenced if jobv == lapack.RightSVApply and it must be
+// non-negative.
+//
+// sva must have length n. On return it contains the singular values of A
+// scaled by 1/work[0] in decreasing order. The scaling factor work[0] differs
+// from 1 only if some of the singular values would overflow or underflow.
+//
+// work must have length at least lwork and lwork must be at least max(6,m+n).
+// If lwork is -1, instead of computing the decomposition, the minimum work
+// length will be stored into work[0]. On return,
+//
+//	work[0] is the scaling factor such that work[0]*sva[i] is the i-th
+//	        singular value of A,
+//	work[1] is the number of the computed non-zero singular values,
+//	work[2] is the number of the computed singular values that are larger
+//	        than the underflow threshold,
+//	work[3] is the number of sweeps of Jacobi rotations that were needed for
+//	        convergence,
+//	work[4] is the largest absolute value of the cosines of the angles
+//	        between pairs of columns of A in the last sweep,
+//	work[5] is the largest absolute value of the sines of the Jacobi rotation
+//	        angles in the last sweep.
+//
+// Dgesvj returns whether the iteration converged in 30 sweeps and A does not
+// contain infinite or NaN elements. If the iteration did not converge, the
+// computed decomposition may still be useful.
+//
+// The reference implementation of DGESVJ precedes the sweeps by quasi-block
+// transformations of large triangular matrices that speed up convergence.
+// They are not implemented and triangular matrices are processed like general
+// matrices apart from the initial scaling.
+func (impl Implementation) Dgesvj(joba lapack.MatrixType, jobu lapack.LeftSVJob, jobv lapack.RightSVJob, m, n int, a []float64, lda int, sva []float64, mv int, v []float64, ldv int, work []float64, lwork int) (ok bool) {
+	lsvec := jobu == lapack.LeftSVCompute
+	uctol := jobu == lapack.LeftSVTol
+	rsvec := jobv == lapack.RightSVCompute
+	applv := jobv == lapack.RightSVApply
+	upper := joba == lapack.UpperTri
+	lower := joba == lapack.LowerTri
+
+	minwork := max(6, m+n)
+	switch {
+	case !upper && !lower && joba != lapack.General:
+		panic(badMatrixType)
+	case !lsvec && !uctol && jobu != lapack.LeftSVNone:
+		panic(badLeftSVJob)
+	case !rsvec && !applv && jobv != lapack.RightSVNone:
+		panic(badRightSVJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	case applv && mv < 0:
+		panic(mvLT0)
+	case ldv < 1, (rsvec || applv) && ldv < n:
+		panic(badLdV)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if lwork == -1 {
+		work[0] = float64(minwork)
+		return true
+	}
+	if n == 0 {
+		return true
+	}
+
+	var mvl int
+	switch {
+	case rsvec:
+		mvl = n
+	case applv:
+		mvl = mv
+	}
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(sva) < n:
+		panic(shortSVA)
+	case mvl > 0 && len(v) < (mvl-1)*ldv+n:
+		panic(shortV)
+	case uctol && work[0] <= 1:
+		panic(ctolLE1)
+	}
+
+	var ctol float64
+	switch {
+	case uctol:
+		ctol = work[0]
+	case lsvec || rsvec || applv:
+		ctol = math.Sqrt(float64(m))
+	default:
+		ctol = float64(m)
+	}
+	wantu := lsvec || uctol
+
+	eps := dlamchE
+	rooteps := math.Sqrt(eps)
+	sfmin := dlamchS
+	small := sfmin / eps
+	big := float64(math.MaxFloat64)
+	bigtheta := 1 / rooteps
+	tol := ctol * eps
+	roottol := math.Sqrt(tol)
+
+	bi := blas64.Implementation()
+
+	// Initialize the right singular vector matrix.
+	if rsvec {
+		impl.Dlaset(blas.All, n, n, 0, 1, v, ldv)
+	}
+	// The rotations are accumulated into v only if it has any rows.
+	rsvec = rsvec || (applv && mv > 0)
+
+	// Initialize sva[0:n] to the column norms of A. If necessary, scale A
+	// to protect the largest singular value from overflow. The scaling is
+	// almost minimal in the sense that it only makes sure that no column
+	// norm overflows and that sqrt(n)*max(sva) does not overflow.
+	skl := 1 / math.Sqrt(float64(m)*float64(n))
+	noscale := true
+	goscale := true
+	for p := 0; p < n; p++ {
+		var aapp, aaqq float64
+		switch {
+		case lower:
+			aapp, aaqq = impl.Dlassq(m-p, a[p*lda+p:], lda, 0, 1)
+		case upper:
+			aapp, aaqq = impl.Dlassq(p+1, a[p:], lda, 0, 1)
+		default:
+			aapp, aaqq = impl.Dlassq(m, a[p:], lda, 0, 1)
+		}
+		if aapp > big || math.IsNaN(aapp) || math.IsInf(aaqq, 1) || math.IsNaN(aaqq) {
+			// A contains infinite or NaN elements.
+			return false
+		}
+		aaqq = math.Sqrt(aaqq)
+		if aapp < big/aaqq && noscale {
+			sva[p] = aapp * aaqq
+		} else {
+			noscale = false
+			sva[p] = aapp * (aaqq * skl)
+			if goscale {
+				goscale = false
+				for q := 0; q < p; q++ {
+					sva[q] *= skl
+				}
+			}
+		}
+	}
+	if noscale {
+		skl = 1
+	}
+
+	// Determine the position of the non-zero column norms relative to
+	// [sfmin, big].
+	var aapp float64
+	aaqq := big
+	for _, s := range sva[:n] {
+		if s != 0 {
+			aaqq = math.Min(aaqq, s)
+		}
+		aapp = math.Max(aapp, s)
+	}
+
+	// Quick return for the zero matrix.
+	if aapp == 0 {
+		if wantu {
+			impl.Dlaset(blas.All, m, n, 0, 1, a, lda)
+		}
+		work[0] = 1
+		for i := 1; i < 6; i++ {
+			work[i] = 0
+		}
+		return true
+	}
+
+	// Quick return for a matrix with one column.
+	if n == 1 {
+		if wantu {
+			impl.Dlascl(lapack.General, 0, 0, sva[0], skl, m, 1, a, lda)
+		}
+		work[0] = 1 / skl
+		work[1] = 0
+		if sva[0] >= sfmin {
+			work[1] = 1
+		}
+		for i := 2; i < 6; i++ {
+			work[i] = 0
+		}
+		return true
+	}
+
+	// Protect small singular values from underflow and try to avoid
+	// underflow and overflow in computing the Jacobi rotations.
+	sn := math.Sqrt(sfmin / eps)
+	temp1 := math.Sqrt(big / float64(n))
+	switch {
+	case aapp <= sn, aaqq >= temp1, sn <= aaqq && aapp <= temp1:
+		temp1 = math.Min(big, temp1/aapp)
+	case aaqq <= sn && aapp <= temp1:
+		temp1 = math.Min(sn/aaqq, big/(aapp*math.Sqrt(float64(n))))
+	case aaqq >= sn && aapp >= temp1:
+		temp1 = math.Max(sn/aaqq, temp1/aapp)
+	case aaqq <= sn && aapp >= temp1:
+		temp1 = math.Min(sn/aaqq, big/(math.Sqrt(float64(n))*aapp))
+	default:
+		temp1 = 1
+	}
+	if temp1 != 1 {
+		impl.Dlascl(lapack.General, 0, 0, 1, temp1, n, 1, sva, 1)
+	}
+	skl *= temp1
+	if skl != 1 {
+		impl.Dlascl(joba, 0, 0, 1, skl, m, n, a, lda)
+		skl = 1 / skl
+	}
+
+	// A is represented in the factored form A*diag(d), where d is
+	// initialized to the identity and updated by the fast scaled rotations.
+	// The remaining m elements of work hold a column of A.
+	d := work[:n]
+	for i := range d {
+		d[i] = 1
+	}
+	col := work[n : n+m]
+
+	// The column norms are recomputed explicitly at the start of a sweep and
+	// whenever cancellation occurs in their updates. Dnrm2 does not overflow
+	// or underflow for norms close to the limits of the floating point range,
+	// so unlike the reference implementation Dgesvj does not need Dlassq for
+	// them.
+
+	// cosine returns the cosine of the angle between the columns p and q of
+	// A*diag(d) with norms aapp and aaqq without overflow or underflow.
+	cosine := func(p, q int, aapp, aaqq float64) float64 {
+		if (aaqq >= 1 && aapp < big/aaqq) || (aaqq < 1 && aapp > small/aaqq) {
+			return (bi.Ddot(m, a[p:], lda, a[q:], lda) * d[p] * d[q] / aaqq) / aapp
+		}
+		if aaqq >= 1 {
+			bi.Dcopy(m, a[p:], lda, col, 1)
+			impl.Dlascl(lapack.General, 0, 0, aapp, d[p], m, 1, col, 1)
+			return bi.Ddot(m, col, 1, a[q:], lda) * d[q] / aaqq
+		}
+		bi.Dcopy(m, a[q:], lda, col, 1)
+		impl.Dlascl(lapack.General, 0, 0, aaqq, d[q], m, 1, col, 1)
+		return bi.Ddot(m, col, 1, a[p:], lda) * d[p] / aapp
+	}
+
+	// fastRotate applies a rotation whose tangent t is so small that its
+	// cosine is one to the columns p and q of A*diag(d) and of V.
+	fastRotate := func(p, q int, t float64) {
+		fastr := blas.DrotmParams{
+			Flag: blas.OffDiagonal,
+			H:    [4]float64{0, t * d[p] / d[q], -t * d[q] / d[p], 0},
+		}
+		bi.Drotm(m, a[p:], lda, a[q:], lda, fastr)
+		if rsvec {
+			bi.Drotm(mvl, v[p:], ldv, v[q:], ldv, fastr)
+		}
+	}
+
+	// orthogonalize makes column q of A*diag(d) orthogonal to column p by a
+	// modified Gram-Schmidt step. It is used instead of a rotation if the
+	// norm of column q is negligible compared to that of column p.
+	orthogonalize := func(p, q int, aapp, aaqq, aapq float64) {
+		bi.Dcopy(m, a[p:], lda, col, 1)
+		impl.Dlascl(lapack.General, 0, 0, aapp, 1, m, 1, col, 1)
+		impl.Dlascl(lapack.General, 0, 0, aaqq, 1, m, 1, a[q:], lda)
+		bi.Daxpy(m, -aapq*d[p]/d[q], col, 1, a[q:], lda)
+		impl.Dlascl(lapack.General, 0, 0, 1, aaqq, m, 1, a[q:], lda)
+	}
+
+	const nsweep = 30
+
+	// Row-cyclic Jacobi SVD algorithm with column pivoting. Each sweep is
+	// unrolled using kbl×kbl tiles over the pivot pairs 0 <= p < q < n.
+	emptsw := n * (n - 1) / 2
+	swband := 3
+	kbl := min(8, n)
+	nbl := (n + kbl - 1) / kbl
+	blskip := kbl * kbl
+	rowskip := min(5, kbl)
+	const lkahead = 1
+
+	var (
+		mxaapq, mxsinj float64
+		sweep          int
+	)
+	converged := false
+	for sweep = 1; sweep <= nsweep; sweep++ {
+		mxaapq = 0
+		mxsinj = 0
+		iswrot := 0
+		notrot := 0
+		pskipped := 0
+
+		for ibr := 0; ibr < nbl; ibr++ {
+			igl := ibr * kbl
+
+			// Diagonal block (ibr, ibr) and, looking ahead, (ibr+1, ibr+1).
+			for ir1 := 0; ir1 <= min(lkahead, nbl-1-ibr); ir1++ {
+				igl += ir1 * kbl
+				for p := igl; p < min(igl+kbl, n-1); p++ {
+					// de Rijk's pivoting.
+					if q := p + bi.Idamax(n-p, sva[p:], 1); q != p {
+						bi.Dswap(m, a[p:], lda, a[q:], lda)
+						if rsvec {
+							bi.Dswap(mvl, v[p:], ldv, v[q:], ldv)
+						}
+						sva[p], sva[q] = sva[q], sva[p]
+						d[p], d[q] = d[q], d[p]
+					}
+					if ir1 == 0 {
+						sva[p] = bi.Dnrm2(m, a[p:], lda) * d[p]
+					}
+					aapp := sva[p]
+					if aapp <= 0 {
+						if ir1 == 0 && aapp == 0 {
+							notrot += min(igl+kbl, n) - 1 - p
+						}
+						continue
+					}
+
+					pskipped = 0
+					for q := p + 1; q < min(igl+kbl, n); q++ {
+						aaqq := sva[q]
+						if aaqq > 0 {
+							aapp0 := aapp
+							var rotok bool
+							if aaqq >= 1 {
+								rotok = small*aapp <= aaqq
+							} else {
+								rotok = aapp <= aaqq/small
+							}
+							aapq := cosine(p, q, aapp, aaqq)
+							mxaapq = math.Max(mxaapq, math.Abs(aapq))
+
+							if math.Abs(aapq) > tol {
+								if ir1 == 0 {
+									notrot = 0
+									pskipped = 0
+									iswrot++
+								}
+								if rotok {
+									aqoap := aaqq / aapp
+									apoaq := aapp / aaqq
+									theta := -0.5 * math.Abs(aqoap-apoaq) / aapq
+									var t float64
+									if math.Abs(theta) > bigtheta {
+										t = 0.5 / theta
+										fastRotate(p, q, t)
+										mxsinj = math.Max(mxsinj, math.Abs(t))
+									} else {
+										thsign := -math.Copysign(1, aapq)
+										t = 1 / (theta + thsign*math.Sqrt(1+theta*theta))
+										cs := math.Sqrt(1 / (1 + t*t))
+										sn := t * cs
+										mxsinj = math.Max(mxsinj, math.Abs(sn))
+										dgesvjRotate(m, a, lda, mvl, v, ldv, rsvec, d, p, q, t, cs, sn)
+									}
+									sva[q] = aaqq * math.Sqrt(math.Max(0, 1+t*apoaq*aapq))
+									aapp *= math.Sqrt(math.Max(0, 1-t*aqoap*aapq))
+								} else {
+									orthogonalize(p, q, aapp, aaqq, aapq)
+									sva[q] = aaqq * math.Sqrt(math.Max(0, 1-aapq*aapq))
+									mxsinj = math.Max(mxsinj, sfmin)
+								}
+
+								// Recompute the norms if cancellation occurred
+								// in their updates.
+								if (sva[q]/aaqq)*(sva[q]/aaqq) <= rooteps {
+									sva[q] = bi.Dnrm2(m, a[q:], lda) * d[q]
+								}
+								if aapp/aapp0 <= rooteps {
+									aapp = bi.Dnrm2(m, a[p:], lda) * d[p]
+									sva[p] = aapp
+								}
+							} else {
+								// The columns p and q are already
+								// numerically orthogonal.
+								if ir1 == 0 {
+									notrot++
+								}
+								pskipped++
+							}
+						} else {
+							// Column q is zero.
+							if ir1 == 0 {
+								notrot++
+							}
+							pskipped++
+						}
+
+						if sweep <= swband && pskipped > rowskip {
+							if ir1 == 0 {
+								aapp = -aapp
+							}
+							notrot = 0
+							break
+						}
+					}
+					sva[p] = aapp
+				}
+			}
+
+			// Off-diagonal blocks (ibr, jbc).
+			igl = ibr * kbl
+		offDiagonal:
+			for jbc := ibr + 1; jbc < nbl; jbc++ {
+				jgl := jbc * kbl
+				ijblsk := 0
+				for p := igl; p < min(igl+kbl, n); p++ {
+					aapp := sva[p]
+					if aapp <= 0 {
+						if aapp == 0 {
+							notrot += min(jgl+kbl, n) - jgl
+						} else {
+							notrot = 0
+						}
+						continue
+					}
+
+					pskipped = 0
+					for q := jgl; q < min(jgl+kbl, n); q++ {
+						aaqq := sva[q]
+						if aaqq > 0 {
+							aapp0 := aapp
+							var rotok bool
+							if aaqq >= 1 {
+								rotok = small*math.Max(aapp, aaqq) <= math.Min(aapp, aaqq)
+							} else {
+								rotok = math.Max(aapp, aaqq) <= math.Min(aapp, aaqq)/small
+							}
+							aapq := cosine(p, q, aapp, aaqq)
+							mxaapq = math.Max(mxaapq, math.Abs(aapq))
+
+							if math.Abs(aapq) > tol {
+								notrot = 0
+								pskipped = 0
+								iswrot++
+								if rotok {
+									aqoap := aaqq / aapp
+									apoaq := aapp / aaqq
+									theta := -0.5 * math.Abs(aqoap-apoaq) / aapq
+									if aaqq > aapp0 {
+										theta = -theta
+									}
+									var t float64
+									if math.Abs(theta) > bigtheta {
+										t = 0.5 / theta
+										fastRotate(p, q, t)
+										mxsinj = math.Max(mxsinj, math.Abs(t))
+									} else {
+										thsign := -math.Copysign(1, aapq)
+										if aaqq > aapp0 {
+											thsign = -thsign
+										}
+										t = 1 / (theta + thsign*math.Sqrt(1+theta*theta))
+										cs := math.Sqrt(1 / (1 + t*t))
+										sn := t * cs
+										mxsinj = math.Max(mxsinj, math.Abs(sn))
+										dgesvjRotate(m, a, lda, mvl, v, ldv, rsvec, d, p, q, t, cs, sn)
+									}
+									sva[q] = aaqq * math.Sqrt(math.Max(0, 1+t*apoaq*aapq))
+									aapp *= math.Sqrt(math.Max(0, 1-t*aqoap*aapq))
+								} else {
+									if aapp > aaqq {
+										orthogonalize(p, q, aapp, aaqq, aapq)
+										sva[q] = aaqq * math.Sqrt(math.Max(0, 1-aapq*aapq))
+									} else {
+										orthogonalize(q, p, aaqq, aapp, aapq)
+										aapp *= math.Sqrt(math.Max(0, 1-aapq*aapq))
+										sva[p] = aapp
+									}
+									mxsinj = math.Max(mxsinj, sfmin)
+								}
+
+								// Recompute the norms if cancellation occurred
+								// in their updates.
+								if (sva[q]/aaqq)*(sva[q]/aaqq) <= rooteps {
+									sva[q] = bi.Dnrm2(m, a[q:], lda) * d[q]
+								}
+								if (aapp/aapp0)*(aapp/aapp0) <= rooteps {
+									aapp = bi.Dnrm2(m, a[p:], lda) * d[p]
+									sva[p] = aapp
+								}
+							} else {
+								notrot++
+								pskipped++
+								ijblsk++
+							}
+						} else {
+							notrot++
+							pskipped++
+							ijblsk++
+						}
+
+						if sweep <= swband && ijblsk >= blskip {
+							sva[p] = aapp
+							notrot = 0
+							break offDiagonal
+						}
+						if sweep <= swband && pskipped > rowskip {
+							aapp = -aapp
+							notrot = 0
+							break
+						}
+					}
+					sva[p] = aapp
+				}
+			}
+			for p := igl; p < min(igl+kbl, n); p++ {
+				sva[p] = math.Abs(sva[p])
+			}
+		}
+
+		// Update the norm of the last column.
+		sva[n-1] = bi.Dnrm2(m, a[n-1:], lda) * d[n-1]
+
+		// Additional steering devices.
+		if sweep < swband && (mxaapq <= roottol || iswrot <= n) {
+			swband = sweep
+		}
+		if sweep > swband+1 && mxaapq < math.Sqrt(float64(n))*tol && float64(n)*mxaapq*mxsinj < tol {
+			converged = true
+			break
+		}
+		if notrot >= emptsw {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		sweep = nsweep
+	}
+
+	// Sort the singular values and find how many are non-zero and how many
+	// are above the underflow threshold.
+	var n2, n4 int
+	for p := 0; p < n; p++ {
+		if p < n-1 {
+			if q := p + bi.Idamax(n-p, sva[p:], 1); q != p {
+				sva[p], sva[q] = sva[q], sva[p]
+				d[p], d[q] = d[q], d[p]
+				bi.Dswap(m, a[p:], lda, a[q:], lda)
+				if rsvec {
+					bi.Dswap(mvl, v[p:], ldv, v[q:], ldv)
+				}
+			}
+		}
+		if sva[p] != 0 {
+			n4++
+			if sva[p]*skl > sfmin {
+				n2++
+			}
+		}
+	}
+
+	// Normalize the left singular vectors.
+	if wantu {
+		for p := 0; p < n4; p++ {
+			bi.Dscal(m, d[p]/sva[p], a[p:], lda)
+		}
+	}
+
+	// Assemble the product of the fast scaled rotations.
+	if rsvec {
+		for p := 0; p < n; p++ {
+			if applv {
+				bi.Dscal(mvl, d[p], v[p:], ldv)
+			} else {
+				bi.Dscal(mvl, 1/bi.Dnrm2(mvl, v[p:], ldv), v[p:], ldv)
+			}
+		}
+	}
+
+	// Undo scaling if possible.
+	if (skl > 1 && sva[0] < big/skl) || (skl < 1 && sva[max(n2, 1)-1] > sfmin/skl) {
+		for p := range sva[:n] {
+			sva[p] *= skl
+		}
+		skl = 1
+	}
+
+	work[0] = skl
+	work[1] = float64(n4)
+	work[2] = float64(n2)
+	work[3] = float64(sweep)
+	work[4] = mxaapq
+	work[5] = mxsinj
+	return converged
+}
+
+// dgesvjRotate applies the Jacobi rotation with tangent t, cosine cs and sine
+// sn to the columns p and q of the m×n matrix A*diag(d) and, if rsvec is true,
+// of the mv×n matrix V*diag(d), updating the diagonal scaling d so that the
+// larger of d[p] and d[q] is multiplied by cs.
+func dgesvjRotate(m int, a []float64, lda int, mv int, v []float64, ldv int, rsvec bool, d []float64, p, q int, t, cs, sn float64) {
+	bi := blas64.Implementation()
+	apoaq := d[p] / d[q]
+	aqoap := d[q] / d[p]
+	switch {
+	case d[p] >= 1 && d[q] >= 1:
+		fastr := blas.DrotmParams{
+			Flag: blas.OffDiagonal,
+			H:    [4]float64{0, t * apoaq, -t * aqoap, 0},
+		}
+		d[p] *= cs
+		d[q] *= cs
+		bi.Drotm(m, a[p:], lda, a[q:], lda, fastr)
+		if rsvec {
+			bi.Drotm(mv, v[p:], ldv, v[q:], ldv, fastr)
+		}
+	case d[p] >= 1 || d[p] >= d[q]:
+		bi.Daxpy(m, -t*aqoap, a[q:], lda, a[p:], lda)
+		bi.Daxpy(m, cs*sn*apoaq, a[p:], lda, a[q:], lda)
+		if rsvec {
+			bi.Daxpy(mv, -t*aqoap, v[q:], ldv, v[p:], ldv)
+			bi.Daxpy(mv, cs*sn*apoaq, v[p:], ldv, v[q:], ldv)
+		}
+		d[p] *= cs
+		d[q] /= cs
+	default:
+		bi.Daxpy(m, t*apoaq, a[p:], lda, a[q:], lda)
+		bi.Daxpy(m, -cs*sn*aqoap, a[q:], lda, a[p:], lda)
+		if rsvec {
+			bi.Daxpy(mv, t*apoaq, v[p:], ldv, v[q:], ldv)
+			bi.Daxpy(mv, -cs*sn*aqoap, v[q:], ldv, v[p:], ldv)
+		}
+		d[p] /= cs
+		d[q] *= cs
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dggglm solves the general Gauss-Markov linear model (GLM) problem
+//
+//	minimize |y|_2  subject to  d = A*x + B*y
+//	   x
+//
+// where A is an n×m matrix, B is an n×p matrix, and d is a vector of length n.
+// It is assumed that
+//
+//	m <= n <= m+p,
+//	rank(A) = m,
+//	rank([ A B ]) = n.
+//
+// Under these assumptions, the constrained equation is always consistent, and
+// there is a unique solution x and a minimal 2-norm solution y, which are
+// obtained using a generalized QR factorization of the matrices A and B.
+//
+// In particular, if B is square and nonsingular, the GLM problem is equivalent
+// to the weighted linear least squares problem
+//
+//	minimize |B⁻¹*(d - A*x)|_2.
+//	   x
+//
+// On return, A and B are overwritten by the factors of the generalized QR
+// factorization, and d is destroyed. d must have length at least n, x must have
+// length at least m and y must have length at least p.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// n+m+p, or 1 if n == 0, otherwise Dggglm will panic. For optimum performance
+// lwork should be at least m+min(n,p)+max(n,p)*nb, where nb is an upper
+// bound for the optimal block sizes of Dgeqrf, Dgerqf, Dormqr and Dormrq.
+// On return, work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, instead of performing Dggglm, only the optimal value of
+// lwork will be stored in work[0].
+//
+// Dggglm returns whether the solution was successfully computed. It returns
+// false if the upper triangular factor T22 of B in the generalized QR
+// factorization is singular, so that the rank of [A B] is less than n, or if
+// the upper triangular factor R11 of A is singular, so that rank(A) < m. In
+// both cases the least squares solution cannot be computed.
+func (impl Implementation) Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) (ok bool) {
+	np := min(n, p)
+	lwkmin := 1
+	if n > 0 {
+		lwkmin = n + m + p
+	}
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0:
+		panic(mLT0)
+	case m > n:
+		panic(mGTN)
+	case p < 0:
+		panic(pLT0)
+	case n > m+p:
+		panic(nGTMPlusP)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, p):
+		panic(badLdB)
+	case lwork < lwkmin && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		// Since m <= n, x is empty and y is zero.
+		if lwork != -1 {
+			if len(y) < p {
+				panic(shortY)
+			}
+			for i := range y[:p] {
+				y[i] = 0
+			}
+		}
+		work[0] = 1
+		return true
+	}
+
+	nb1 := impl.Ilaenv(1, "DGEQRF", " ", n, m, -1, -1)
+	nb2 := impl.Ilaenv(1, "DGERQF", " ", n, m, -1, -1)
+	nb3 := impl.Ilaenv(1, "DORMQR", " ", n, m, p, -1)
+	nb4 := impl.Ilaenv(1, "DORMRQ", " ", n, m, p, -1)
+	nb := max(nb1, nb2, nb3, nb4)
+	lwkopt := m + np + max(n, p)*nb
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return true
+	}
+
+	switch {
+	case m > 0 && len(a) < (n-1)*lda+m:
+		panic(shortA)
+	case p > 0 && len(b) < (n-1)*ldb+p:
+		panic(shortB)
+	case len(d) < n:
+		panic(shortD)
+	case len(x) < m:
+		panic(shortX)
+	case len(y) < p:
+		panic(shortY)
+	}
+
+	bi := blas64.Implementation()
+
+	// Compute the generalized QR factorization of matrices A and B:
+	//  Qᵀ*A = [ R11 ] m,  Qᵀ*B*Zᵀ = [ T11 T12 ] m
+	//         [  0  ] n-m           [  0  T22 ] n-m
+	//                                m+p-n n-m
+	// where R11 and T22 are upper triangular, and Q and Z are orthogonal.
+	taua := work[:m]
+	taub := work[m : m+np]
+	wrk := work[m+np:]
+	lwrk := lwork - m - np
+	impl.Dggqrf(n, m, p, a, lda, taua, b, ldb, taub, wrk, lwrk)
+	lopt := int(wrk[0])
+
+	// Update d := Qᵀ*d = [ d1 ] m
+	//                    [ d2 ] n-m
+	impl.Dormqr(blas.Left, blas.Trans, n, 1, m, a, lda, taua, d, 1, wrk, lwrk)
+	lopt = max(lopt, int(wrk[0]))
+
+	// Solve T22*y2 = d2 for y2.
+	if n > m {
+		ok = impl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, n-m, 1, b[m*ldb+m+p-n:], ldb, d[m:], 1)
+		if !ok {
+			return false
+		}
+		bi.Dcopy(n-m, d[m:], 1, y[m+p-n:], 1)
+	}
+
+	// Set y1 = 0.
+	for i := range y[:m+p-n] {
+		y[i] = 0
+	}
+
+	// Update d1 := d1 - T12*y2.
+	bi.Dgemv(blas.NoTrans, m, n-m, -1, b[m+p-n:], ldb, y[m+p-n:], 1, 1, d, 1)
+
+	// Solve R11*x = d1 for x.
+	if m > 0 {
+		ok = impl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, m, 1, a, lda, d, 1)
+		if !ok {
+			return false
+		}
+		bi.Dcopy(m, d, 1, x, 1)
+	}
+
+	// Backward transformation y := Zᵀ*y.
+	if p > 0 {
+		impl.Dormrq(blas.Left, blas.Trans, p, 1, np, b[max(0, n-p)*ldb:], ldb, taub, y, 1, wrk, lwrk)
+		lopt = max(lopt, int(wrk[0]))
+	}
+	work[0] = float64(m + np + lopt)
+	return true
+}
@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgglse solves the linear equality-constrained least squares (LSE) problem
+//
+//	minimize |c - A*x|_2  subject to  B*x = d
+//
+// where A is an m×n matrix, B is a p×n matrix, c is a vector of length m, d is
+// a vector of length p, and x is the solution vector of length n. It is assumed
+// that
+//
+//	p <= n <= m+p,
+//	rank(B) = p,
+//	rank([ A ]) = n.
+//	     [ B ]
+//
+// These conditions ensure that the LSE problem has a unique solution, which is
+// obtained using a generalized RQ factorization of the matrices B and A.
+//
+// On return, A and B are overwritten by the factors of the generalized RQ
+// factorization, and d is destroyed. The residual sum of squares for the
+// solution is given by the sum of squares of elements n-p:m of c, and the
+// remaining elements of c are overwritten. c must have length at least m, d
+// must have length at least p and x must have length at least n.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// m+n+p, or 1 if n == 0, otherwise Dgglse will panic. For optimum performance
+// lwork should be at least p+min(m,n)+max(m,n)*nb, where nb is an upper
+// bound for the optimal block sizes of Dgeqrf, Dgerqf, Dormqr and Dormrq.
+// On return, work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, instead of performing Dgglse, only the optimal value of
+// lwork will be stored in work[0].
+//
+// Dgglse returns whether the solution was successfully computed. It returns
+// false if the upper triangular factor T12 of B in the generalized RQ
+// factorization is singular, so that rank(B) < p, or if the upper triangular
+// factor R11 of A is singular, so that the rank of the stacked matrix [A; B]
+// is less than n. In both cases the least squares solution cannot be computed.
+func (impl Implementation) Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) (ok bool) {
+	mn := min(m, n)
+	lwkmin := 1
+	if n > 0 {
+		lwkmin = m + n + p
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case p < 0:
+		panic(pLT0)
+	case p > n:
+		panic(pGTN)
+	case n > m+p:
+		panic(nGTMPlusP)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < lwkmin && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	nb1 := impl.Ilaenv(1, "DGEQRF", " ", m, n, -1, -1)
+	nb2 := impl.Ilaenv(1, "DGERQF", " ", m, n, -1, -1)
+	nb3 := impl.Ilaenv(1, "DORMQR", " ", m, n, p, -1)
+	nb4 := impl.Ilaenv(1, "DORMRQ", " ", m, n, p, -1)
+	nb := max(nb1, nb2, nb3, nb4)
+	lwkopt := p + mn + max(m, n)*nb
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case len(c) < m:
+		panic(shortC)
+	case len(d) < p:
+		panic(shortD)
+	case len(x) < n:
+		panic(shortX)
+	}
+
+	bi := blas64.Implementation()
+
+	// Compute the generalized RQ factorization of matrices B and A:
+	//  B*Qᵀ = [ 0 T12 ],  Zᵀ*A*Qᵀ = [ R11 R12 ]
+	//                               [  0  R22 ],
+	// where T12 and R11 are upper triangular, and Q and Z are orthogonal.
+	taub := work[:p]
+	taua := work[p : p+mn]
+	wrk := work[p+mn:]
+	lwrk := lwork - p - mn
+	impl.Dggrqf(p, m, n, b, ldb, taub, a, lda, taua, wrk, lwrk)
+	lopt := int(wrk[0])
+
+	// Update c := Zᵀ*c = [ c1 ] n-p
+	//                    [ c2 ] m+p-n
+	impl.Dormqr(blas.Left, blas.Trans, m, 1, mn, a, lda, taua, c, 1, wrk, lwrk)
+	lopt = max(lopt, int(wrk[0]))
+
+	// Solve T12*x2 = d for x2.
+	if p > 0 {
+		ok = impl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, p, 1, b[n-p:], ldb, d, 1)
+		if !ok {
+			return false
+		}
+		// Put the solution in x[n-p:n].
+		bi.Dcopy(p, d, 1, x[n-p:], 1)
+		// Update c1 := c1 - R12*x2.
+		bi.Dgemv(blas.NoTrans, n-p, p, -1, a[n-p:], lda, d, 1, 1, c, 1)
+	}
+
+	// Solve R11*x1 = c1 for x1.
+	if n > p {
+		ok = impl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, n-p, 1, a, lda, c, 1)
+		if !ok {
+			return false
+		}
+		// Put the solution in x[0:n-p].
+		bi.Dcopy(n-p, c, 1, x, 1)
+	}
+
+	// Compute the residual vector.
+	var nr int
+	if m < n {
+		nr = m + p - n
+		if nr > 0 {
+			bi.Dgemv(blas.NoTrans, nr, n-m, -1, a[(n-p)*lda+m:], lda, d[nr:], 1, 1, c[n-p:], 1)
+		}
+	} else {
+		nr = p
+	}
+	if nr > 0 {
+		bi.Dtrmv(blas.Upper, blas.NoTrans, blas.NonUnit, nr, a[(n-p)*lda+n-p:], lda, d, 1)
+		bi.Daxpy(nr, -1, d, 1, c[n-p:], 1)
+	}
+
+	// Backward transformation x := Qᵀ*x.
+	impl.Dormrq(blas.Left, blas.Trans, n, 1, p, b, ldb, taub, x, 1, wrk, lwrk)
+	work[0] = float64(p + mn + max(lopt, int(wrk[0])))
+	return true
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dggqrf computes a generalized QR factorization of an n×m matrix A and an
+// n×p matrix B,
+//
+//	A = Q * R,  B = Q * T * Z,
+//
+// where Q is an n×n orthogonal matrix, Z is a p×p orthogonal matrix, and R and
+// T assume one of the forms
+//
+//	R = [ R11 ]  if n >= m,  R = [ R11 R12 ]  if n < m,
+//	    [  0  ]
+//
+// where R11 is min(n,m)×min(n,m) upper triangular, and
+//
+//	T = [ 0 T12 ]  if n <= p,  T = [ T11 ]  if n > p,
+//	                               [ T21 ]
+//
+// where T12 or T21 is an n×n or p×p upper triangular matrix.
+//
+// In particular, if B is square and nonsingular, the generalized QR
+// factorization of A and B implicitly gives the QR factorization of B⁻¹*A.
+//
+// On return, the elements on and above the diagonal of A contain R, and the
+// elements below the diagonal together with taua represent Q as a product of
+// min(n,m) elementary reflectors as returned by Dgeqrf. If n <= p, the upper
+// triangle of B[0:n, p-n:p] contains the upper triangular matrix T, and if
+// n > p, the elements on and above the (n-p)th subdiagonal contain T. The
+// remaining elements of B together with taub represent Z as a product of
+// min(n,p) elementary reflectors as returned by Dgerqf.
+//
+// taua must have length min(n,m) and taub must have length min(n,p), otherwise
+// Dggqrf will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n,m,p), otherwise Dggqrf will panic. For optimum performance lwork
+// should be at least max(n,m,p)*max(nb1,nb2,nb3), where nb1 is the optimal
+// block size for the QR factorization of an n×m matrix, nb2 is the optimal
+// block size for the RQ factorization of an n×p matrix, and nb3 is the optimal
+// block size for a call of Dormqr. On return, work[0] will contain the optimal
+// value of lwork.
+//
+// If lwork == -1, instead of performing Dggqrf, only the optimal value of
+// lwork will be stored in work[0].
+//
+// Dggqrf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggqrf(n, m, p int, a []float64, lda int, taua []float64, b []float64, ldb int, taub, work []float64, lwork int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, p):
+		panic(badLdB)
+	case lwork < max(1, n, m, p) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	nb1 := impl.Ilaenv(1, "DGEQRF", " ", n, m, -1, -1)
+	nb2 := impl.Ilaenv(1, "DGERQF", " ", n, p, -1, -1)
+	nb3 := impl.Ilaenv(1, "DORMQR", " ", n, m, p, -1)
+	nb := max(nb1, nb2, nb3)
+	lwkopt := max(1, max(n, m, p)*nb)
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return
+	}
+
+	switch {
+	case m > 0 && len(a) < (n-1)*lda+m:
+		panic(shortA)
+	case p > 0 && len(b) < (n-1)*ldb+p:
+		panic(shortB)
+	case len(taua) != min(n, m):
+		panic(badLenTau)
+	case len(taub) != min(n, p):
+		panic(badLenTau)
+	}
+
+	// QR factorization of the n×m matrix A: A = Q*R.
+	impl.Dgeqrf(n, m, a, lda, taua, work, lwork)
+	lopt := int(work[0])
+
+	// Update B := Qᵀ*B.
+	impl.Dormqr(blas.Left, blas.Trans, n, p, min(n, m), a, lda, taua, b, ldb, work, lwork)
+	lopt = max(lopt, int(work[0]))
+
+	// RQ factorization of the n×p matrix B: B = T*Z.
+	impl.Dgerqf(n, p, b, ldb, taub, work, lwork)
+	work[0] = float64(max(lopt, int(work[0])))
+}
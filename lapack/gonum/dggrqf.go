@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dggrqf computes a generalized RQ factorization of an m×n matrix A and a
+// p×n matrix B,
+//
+//	A = R * Q,  B = Z * T * Q,
+//
+// where Q is an n×n orthogonal matrix, Z is a p×p orthogonal matrix, and R and
+// T assume one of the forms
+//
+//	R = [ 0 R12 ]  if m <= n,  R = [ R11 ]  if m > n,
+//	                               [ R21 ]
+//
+// where R12 or R21 is an m×m or n×n upper triangular matrix, and
+//
+//	T = [ T11 ]  if p >= n,  T = [ T11 T12 ]  if p < n,
+//	    [  0  ]
+//
+// where T11 is min(p,n)×min(p,n) upper triangular.
+//
+// In particular, if B is square and nonsingular, the generalized RQ
+// factorization of A and B implicitly gives the RQ factorization of A*B⁻¹.
+//
+// On return, if m <= n, the upper triangle of A[0:m, n-m:n] contains the upper
+// triangular matrix R, and if m > n, the elements on and above the (m-n)th
+// subdiagonal contain R. The remaining elements of A together with taua
+// represent Q as a product of min(m,n) elementary reflectors as returned by
+// Dgerqf. The elements on and above the diagonal of B contain T, and the
+// elements below the diagonal together with taub represent Z as a product of
+// min(p,n) elementary reflectors as returned by Dgeqrf.
+//
+// taua must have length min(m,n) and taub must have length min(p,n), otherwise
+// Dggrqf will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n,m,p), otherwise Dggrqf will panic. For optimum performance lwork
+// should be at least max(n,m,p)*max(nb1,nb2,nb3), where nb1 is the optimal
+// block size for the RQ factorization of an m×n matrix, nb2 is the optimal
+// block size for the QR factorization of a p×n matrix, and nb3 is the optimal
+// block size for a call of Dormrq. On return, work[0] will contain the optimal
+// value of lwork.
+//
+// If lwork == -1, instead of performing Dggrqf, only the optimal value of
+// lwork will be stored in work[0].
+//
+// Dggrqf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggrqf(m, p, n int, a []float64, lda int, taua []float64, b []float64, ldb int, taub, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case p < 0:
+		panic(pLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, n, m, p) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	nb1 := impl.Ilaenv(1, "DGERQF", " ", m, n, -1, -1)
+	nb2 := impl.Ilaenv(1, "DGEQRF", " ", p, n, -1, -1)
+	nb3 := impl.Ilaenv(1, "DORMRQ", " ", m, n, p, -1)
+	nb := max(nb1, nb2, nb3)
+	lwkopt := max(1, max(n, m, p)*nb)
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return
+	}
+
+	switch {
+	case n > 0 && len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case n > 0 && len(b) < (p-1)*ldb+n:
+		panic(shortB)
+	case len(taua) != min(m, n):
+		panic(badLenTau)
+	case len(taub) != min(p, n):
+		panic(badLenTau)
+	}
+
+	// RQ factorization of the m×n matrix A: A = R*Q.
+	impl.Dgerqf(m, n, a, lda, taua, work, lwork)
+	lopt := int(work[0])
+
+	// Update B := B*Qᵀ.
+	if k := min(m, n); k > 0 {
+		impl.Dormrq(blas.Right, blas.Trans, p, n, k, a[(m-k)*lda:], lda, taua, b, ldb, work, lwork)
+		lopt = max(lopt, int(work[0]))
+	}
+
+	// QR factorization of the p×n matrix B: B = Z*T.
+	impl.Dgeqrf(p, n, b, ldb, taub, work, lwork)
+	work[0] = float64(max(lopt, int(work[0])))
+}
@@ -0,0 +1,911 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dhgeqz computes the eigenvalues of a real matrix pair (H,T), where H is an
+// upper Hessenberg matrix and T is upper triangular, using the double-shift QZ
+// method. Matrix pairs of this type are produced by the reduction to
+// generalized upper Hessenberg form of a real matrix pair (A,B):
+//
+//	A = Q1*H*Z1ᵀ,  B = Q1*T*Z1ᵀ,
+//
+// as computed by Dgghrd.
+//
+// If job is lapack.EigenvaluesAndSchur, then (H,T) is also reduced to
+// generalized Schur form,
+//
+//	H = Q*S*Zᵀ,  T = Q*P*Zᵀ,
+//
+// where Q and Z are orthogonal matrices, P is an upper triangular matrix, and
+// S is a quasi-triangular matrix with 1×1 and 2×2 diagonal blocks. The 1×1
+// blocks correspond to real eigenvalues of the matrix pair (H,T) and the 2×2
+// blocks correspond to complex conjugate pairs of eigenvalues. Additionally,
+// the 2×2 upper triangular diagonal blocks of P corresponding to 2×2 blocks
+// of S are reduced to positive diagonal form, that is, if S[j+1,j] is
+// non-zero, then P[j+1,j] = P[j,j+1] = 0, P[j,j] > 0, and P[j+1,j+1] > 0.
+// On return, h will contain S and t will contain P.
+//
+// If job is lapack.EigenvaluesOnly, only the eigenvalues are computed and the
+// contents of h and t on return are unspecified.
+//
+// Optionally, the orthogonal matrix Q from the generalized Schur
+// factorization may be postmultiplied into an input matrix Q1, and the
+// orthogonal matrix Z may be postmultiplied into an input matrix Z1. If Q1
+// and Z1 are the orthogonal matrices from Dgghrd that reduced the matrix pair
+// (A,B) to generalized upper Hessenberg form, then the output matrices Q1*Q
+// and Z1*Z are the orthogonal factors from the generalized Schur
+// factorization of (A,B):
+//
+//	A = (Q1*Q)*S*(Z1*Z)ᵀ,  B = (Q1*Q)*P*(Z1*Z)ᵀ.
+//
+// compq and compz specify how Q and Z are computed:
+//   - lapack.OrthoNone: the matrix is not computed and q or z is not
+//     referenced,
+//   - lapack.OrthoPostmul: on entry q or z must contain the orthogonal matrix
+//     Q1 or Z1 and on return it will contain the product Q1*Q or Z1*Z,
+//   - lapack.OrthoExplicit: q or z is initialized to the identity and on
+//     return it will contain Q or Z.
+//
+// To avoid overflow, eigenvalues of the matrix pair (H,T) (equivalently, of
+// (A,B)) are computed as a pair of values (alpha,beta), where alpha is complex
+// and beta is real. If beta is nonzero, λ = alpha / beta is an eigenvalue of
+// the generalized nonsymmetric eigenvalue problem
+//
+//	A*x = λ*B*x,
+//
+// and if alpha is nonzero, μ = beta / alpha is an eigenvalue of the
+// alternate form of the problem
+//
+//	μ*A*y = B*y.
+//
+// Real eigenvalues can be read directly from the generalized Schur form:
+//
+//	alpha = S[i,i],  beta = P[i,i].
+//
+// On return, alphar and alphai will contain the real and imaginary parts of
+// alpha, respectively, and beta will contain beta. If alphai[j] is zero, then
+// the j-th eigenvalue is real; if positive, then the j-th and (j+1)-st
+// eigenvalues are a complex conjugate pair, with alphai[j+1] = -alphai[j].
+// If job is lapack.EigenvaluesAndSchur, alphar[j], alphai[j] and beta[j] will
+// be the values that would result if the 2×2 diagonal blocks of (H,T) were
+// further reduced to triangular form using complex unitary transformations.
+// alphar, alphai and beta must have length n.
+//
+// ilo and ihi specify that H is already upper triangular in rows and columns
+// [0:ilo] and [ihi+1:n]. It must hold that 0 <= ilo <= ihi < n if n > 0, and
+// ilo == 0 and ihi == -1 if n == 0.
+//
+// work must have length at least max(1,lwork) and lwork must be at least
+// max(1,n), otherwise Dhgeqz will panic. On return, work[0] contains the
+// optimal value of lwork. If lwork is -1, instead of performing Dhgeqz, only
+// the optimal value of lwork will be stored in work[0].
+//
+// If unconverged is positive, the QZ iteration failed to converge. (H,T) is
+// not in generalized Schur form, but alphar[i], alphai[i] and beta[i] for
+// i in [unconverged:n] are correct.
+//
+// References:
+//
+//	[1] C.B. Moler, G.W. Stewart. An Algorithm for Generalized Matrix
+//	    Eigenvalue Problems. SIAM J. Numer. Anal. 10(2) (1973), pp. 241—256
+//	    URL: https://doi.org/10.1137/0710024
+//
+// Dhgeqz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dhgeqz(job lapack.SchurJob, compq, compz lapack.OrthoComp, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, work []float64, lwork int) (unconverged int) {
+	wantq := compq != lapack.OrthoNone
+	wantz := compz != lapack.OrthoNone
+	switch {
+	case job != lapack.EigenvaluesOnly && job != lapack.EigenvaluesAndSchur:
+		panic(badSchurJob)
+	case compq != lapack.OrthoNone && compq != lapack.OrthoExplicit && compq != lapack.OrthoPostmul:
+		panic(badOrthoComp)
+	case compz != lapack.OrthoNone && compz != lapack.OrthoExplicit && compz != lapack.OrthoPostmul:
+		panic(badOrthoComp)
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(badIlo)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(badIhi)
+	case ldh < max(1, n):
+		panic(badLdH)
+	case ldt < max(1, n):
+		panic(badLdT)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case ldz < 1, wantz && ldz < n:
+		panic(badLdZ)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	if lwork == -1 {
+		work[0] = float64(n)
+		return 0
+	}
+
+	switch {
+	case len(h) < (n-1)*ldh+n:
+		panic(shortH)
+	case len(t) < (n-1)*ldt+n:
+		panic(shortT)
+	case len(alphar) != n:
+		panic(badLenAlphar)
+	case len(alphai) != n:
+		panic(badLenAlphai)
+	case len(beta) != n:
+		panic(badLenBeta)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case wantz && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	wantt := job == lapack.EigenvaluesAndSchur
+
+	// Initialize Q and Z.
+	if compq == lapack.OrthoExplicit {
+		impl.Dlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+	if compz == lapack.OrthoExplicit {
+		impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+	}
+
+	const (
+		safmin = dlamchS
+		safmax = 1 / safmin
+		ulp    = dlamchP
+	)
+	bi := blas64.Implementation()
+
+	in := ihi + 1 - ilo
+	anorm := impl.Dlanhs(lapack.Frobenius, in, h[ilo*ldh+ilo:], ldh, nil)
+	bnorm := impl.Dlanhs(lapack.Frobenius, in, t[ilo*ldt+ilo:], ldt, nil)
+	atol := math.Max(safmin, ulp*anorm)
+	btol := math.Max(safmin, ulp*bnorm)
+	ascale := 1 / math.Max(safmin, anorm)
+	bscale := 1 / math.Max(safmin, bnorm)
+
+	// setEigenvalue standardizes the 1×1 block at j so that T[j,j] is
+	// non-negative and stores the corresponding eigenvalue.
+	setEigenvalue := func(j, ifrstm int) {
+		if t[j*ldt+j] < 0 {
+			if wantt {
+				for jr := ifrstm; jr <= j; jr++ {
+					h[jr*ldh+j] *= -1
+					t[jr*ldt+j] *= -1
+				}
+			} else {
+				h[j*ldh+j] *= -1
+				t[j*ldt+j] *= -1
+			}
+			if wantz {
+				bi.Dscal(n, -1, z[j:], ldz)
+			}
+		}
+		alphar[j] = h[j*ldh+j]
+		alphai[j] = 0
+		beta[j] = t[j*ldt+j]
+	}
+
+	// Set eigenvalues ihi+1:n.
+	for j := ihi + 1; j < n; j++ {
+		setEigenvalue(j, 0)
+	}
+
+	// If ihi < ilo, skip QZ steps.
+	if ihi < ilo {
+		for j := 0; j < ilo; j++ {
+			setEigenvalue(j, 0)
+		}
+		work[0] = float64(n)
+		return 0
+	}
+
+	// Main QZ iteration loop.
+	//
+	// Eigenvalues ilast+1:n have been found.
+	// Column operations modify rows ifrstm:whatever.
+	// Row operations modify columns whatever:ilastm.
+	//
+	// If only eigenvalues are being computed, then ifrstm is the row of
+	// the last splitting row above row ilast; this is always at least ilo.
+	// iiter counts iterations since the last eigenvalue was found, to tell
+	// when to use an extraordinary shift.
+	ilast := ihi
+	var ifrstm, ilastm int
+	if wantt {
+		ifrstm = 0
+		ilastm = n - 1
+	} else {
+		ifrstm = ilo
+		ilastm = ihi
+	}
+	var (
+		iiter  int
+		eshift float64
+		ifirst int
+		v      [3]float64
+	)
+	maxit := 30 * (ihi - ilo + 1)
+	converged := false
+	for jiter := 0; jiter < maxit; jiter++ {
+		// Split the matrix if possible. Two tests:
+		//  1: H[j,j-1] == 0 or j == ilo
+		//  2: T[j,j] == 0
+		const (
+			splitOff = iota // Standardize the 1×1 block at ilast.
+			zeroT           // T[ilast,ilast] == 0; clear H[ilast,ilast-1].
+			qzStep          // Perform a QZ step on ifirst:ilast+1.
+		)
+		action := -1
+		if ilast == ilo {
+			// Special case: j == ilast.
+			action = splitOff
+		} else if math.Abs(h[ilast*ldh+ilast-1]) <= math.Max(safmin, ulp*(math.Abs(h[ilast*ldh+ilast])+math.Abs(h[(ilast-1)*ldh+ilast-1]))) {
+			h[ilast*ldh+ilast-1] = 0
+			action = splitOff
+		} else if math.Abs(t[ilast*ldt+ilast]) <= btol {
+			t[ilast*ldt+ilast] = 0
+			action = zeroT
+		} else {
+			// General case: j < ilast.
+			for j := ilast - 1; j >= ilo; j-- {
+				// Test 1: for H[j,j-1] == 0 or j == ilo.
+				var ilazro bool
+				if j == ilo {
+					ilazro = true
+				} else if math.Abs(h[j*ldh+j-1]) <= math.Max(safmin, ulp*(math.Abs(h[j*ldh+j])+math.Abs(h[(j-1)*ldh+j-1]))) {
+					h[j*ldh+j-1] = 0
+					ilazro = true
+				}
+
+				// Test 2: for T[j,j] == 0.
+				if math.Abs(t[j*ldt+j]) < btol {
+					t[j*ldt+j] = 0
+
+					// Test 1a: check for 2 consecutive small
+					// subdiagonals in A.
+					var ilazr2 bool
+					if !ilazro {
+						temp := math.Abs(h[j*ldh+j-1])
+						temp2 := math.Abs(h[j*ldh+j])
+						tempr := math.Max(temp, temp2)
+						if tempr < 1 && tempr != 0 {
+							temp /= tempr
+							temp2 /= tempr
+						}
+						if temp*(ascale*math.Abs(h[(j+1)*ldh+j])) <= temp2*(ascale*atol) {
+							ilazr2 = true
+						}
+					}
+
+					if ilazro || ilazr2 {
+						// If both tests pass (1 & 2), i.e., the leading
+						// diagonal element of B in the block is zero,
+						// split a 1×1 block off at the top (i.e., at the
+						// j-th row/column). The leading diagonal element
+						// of the remainder can also be zero, so this may
+						// have to be done repeatedly.
+						action = zeroT
+						for jch := j; jch < ilast; jch++ {
+							var c, s float64
+							c, s, h[jch*ldh+jch] = impl.Dlartg(h[jch*ldh+jch], h[(jch+1)*ldh+jch])
+							h[(jch+1)*ldh+jch] = 0
+							bi.Drot(ilastm-jch, h[jch*ldh+jch+1:], 1, h[(jch+1)*ldh+jch+1:], 1, c, s)
+							bi.Drot(ilastm-jch, t[jch*ldt+jch+1:], 1, t[(jch+1)*ldt+jch+1:], 1, c, s)
+							if wantq {
+								bi.Drot(n, q[jch:], ldq, q[jch+1:], ldq, c, s)
+							}
+							if ilazr2 {
+								h[jch*ldh+jch-1] *= c
+							}
+							ilazr2 = false
+							if math.Abs(t[(jch+1)*ldt+jch+1]) >= btol {
+								if jch+1 >= ilast {
+									action = splitOff
+								} else {
+									ifirst = jch + 1
+									action = qzStep
+								}
+								break
+							}
+							t[(jch+1)*ldt+jch+1] = 0
+						}
+					} else {
+						// Only test 2 passed: chase the zero to
+						// T[ilast,ilast], then process as in the case
+						// T[ilast,ilast] == 0.
+						for jch := j; jch < ilast; jch++ {
+							var c, s float64
+							c, s, t[jch*ldt+jch+1] = impl.Dlartg(t[jch*ldt+jch+1], t[(jch+1)*ldt+jch+1])
+							t[(jch+1)*ldt+jch+1] = 0
+							if jch < ilastm-1 {
+								bi.Drot(ilastm-jch-1, t[jch*ldt+jch+2:], 1, t[(jch+1)*ldt+jch+2:], 1, c, s)
+							}
+							bi.Drot(ilastm-jch+2, h[jch*ldh+jch-1:], 1, h[(jch+1)*ldh+jch-1:], 1, c, s)
+							if wantq {
+								bi.Drot(n, q[jch:], ldq, q[jch+1:], ldq, c, s)
+							}
+							c, s, h[(jch+1)*ldh+jch] = impl.Dlartg(h[(jch+1)*ldh+jch], h[(jch+1)*ldh+jch-1])
+							h[(jch+1)*ldh+jch-1] = 0
+							bi.Drot(jch+1-ifrstm, h[ifrstm*ldh+jch:], ldh, h[ifrstm*ldh+jch-1:], ldh, c, s)
+							bi.Drot(jch-ifrstm, t[ifrstm*ldt+jch:], ldt, t[ifrstm*ldt+jch-1:], ldt, c, s)
+							if wantz {
+								bi.Drot(n, z[jch:], ldz, z[jch-1:], ldz, c, s)
+							}
+						}
+						action = zeroT
+					}
+					break
+				} else if ilazro {
+					// Only test 1 passed: work on j:ilast.
+					ifirst = j
+					action = qzStep
+					break
+				}
+				// Neither test passed: try next j.
+			}
+		}
+
+		switch action {
+		case -1:
+			// Drop-through is "impossible".
+			work[0] = float64(n)
+			return ilast + 1
+
+		case zeroT:
+			// T[ilast,ilast] == 0: clear H[ilast,ilast-1] to split off
+			// a 1×1 block.
+			var c, s float64
+			c, s, h[ilast*ldh+ilast] = impl.Dlartg(h[ilast*ldh+ilast], h[ilast*ldh+ilast-1])
+			h[ilast*ldh+ilast-1] = 0
+			bi.Drot(ilast-ifrstm, h[ifrstm*ldh+ilast:], ldh, h[ifrstm*ldh+ilast-1:], ldh, c, s)
+			bi.Drot(ilast-ifrstm, t[ifrstm*ldt+ilast:], ldt, t[ifrstm*ldt+ilast-1:], ldt, c, s)
+			if wantz {
+				bi.Drot(n, z[ilast:], ldz, z[ilast-1:], ldz, c, s)
+			}
+			fallthrough
+
+		case splitOff:
+			// H[ilast,ilast-1] == 0: standardize B, set alphar, alphai
+			// and beta.
+			setEigenvalue(ilast, ifrstm)
+
+			// Go to next block; exit if finished.
+			ilast--
+			if ilast < ilo {
+				converged = true
+				break
+			}
+
+			// Reset counters.
+			iiter = 0
+			eshift = 0
+			if !wantt {
+				ilastm = ilast
+				if ifrstm > ilast {
+					ifrstm = ilo
+				}
+			}
+			continue
+		}
+		if converged {
+			break
+		}
+
+		// QZ step.
+		//
+		// This iteration only involves rows/columns ifirst:ilast+1. We
+		// assume ifirst < ilast, and that the diagonal of B is non-zero.
+		iiter++
+		if !wantt {
+			ifrstm = ifirst
+		}
+
+		// Compute single shifts.
+		//
+		// At this point, ifirst < ilast, and the diagonal elements of
+		// T[ifirst:ilast+1,ifirst:ilast+1] are larger than btol in
+		// magnitude.
+		var s1, wr float64
+		complexShift := false
+		if iiter%10 == 0 {
+			// Exceptional shift. Chosen for no particularly good reason.
+			// (Single shift only.)
+			if float64(maxit)*safmin*math.Abs(h[ilast*ldh+ilast-1]) < math.Abs(t[(ilast-1)*ldt+ilast-1]) {
+				eshift = h[ilast*ldh+ilast-1] / t[(ilast-1)*ldt+ilast-1]
+			} else {
+				eshift += 1 / (safmin * float64(maxit))
+			}
+			s1 = 1
+			wr = eshift
+		} else {
+			// Shifts based on the generalized eigenvalues of the
+			// bottom-right 2×2 block of A and B. The first eigenvalue
+			// returned by Dlag2 is the Wilkinson shift (AEP p.512).
+			var s2, wr2, wi float64
+			s1, s2, wr, wr2, wi = impl.Dlag2(h[(ilast-1)*ldh+ilast-1:], ldh, t[(ilast-1)*ldt+ilast-1:], ldt)
+			if math.Abs((wr/s1)*t[ilast*ldt+ilast]-h[ilast*ldh+ilast]) > math.Abs((wr2/s2)*t[ilast*ldt+ilast]-h[ilast*ldh+ilast]) {
+				wr, wr2 = wr2, wr
+				s1, s2 = s2, s1
+			}
+			complexShift = wi != 0
+		}
+
+		if !complexShift {
+			// Fiddle with shift to avoid overflow.
+			temp := math.Min(ascale, 1) * (0.5 * safmax)
+			scale := 1.0
+			if s1 > temp {
+				scale = temp / s1
+			}
+			temp = math.Min(bscale, 1) * (0.5 * safmax)
+			if math.Abs(wr) > temp {
+				scale = math.Min(scale, temp/math.Abs(wr))
+			}
+			s1 *= scale
+			wr *= scale
+
+			// Now check for two consecutive small subdiagonals.
+			istart := ifirst
+			for j := ilast - 1; j > ifirst; j-- {
+				temp := math.Abs(s1 * h[j*ldh+j-1])
+				temp2 := math.Abs(s1*h[j*ldh+j] - wr*t[j*ldt+j])
+				tempr := math.Max(temp, temp2)
+				if tempr < 1 && tempr != 0 {
+					temp /= tempr
+					temp2 /= tempr
+				}
+				if math.Abs((ascale*h[(j+1)*ldh+j])*temp) <= (ascale*atol)*temp2 {
+					istart = j
+					break
+				}
+			}
+
+			// Do an implicit single-shift QZ sweep.
+			//
+			// Initial Q.
+			c, s, _ := impl.Dlartg(s1*h[istart*ldh+istart]-wr*t[istart*ldt+istart], s1*h[(istart+1)*ldh+istart])
+
+			// Sweep.
+			for j := istart; j < ilast; j++ {
+				if j > istart {
+					c, s, h[j*ldh+j-1] = impl.Dlartg(h[j*ldh+j-1], h[(j+1)*ldh+j-1])
+					h[(j+1)*ldh+j-1] = 0
+				}
+				bi.Drot(ilastm-j+1, h[j*ldh+j:], 1, h[(j+1)*ldh+j:], 1, c, s)
+				bi.Drot(ilastm-j+1, t[j*ldt+j:], 1, t[(j+1)*ldt+j:], 1, c, s)
+				if wantq {
+					bi.Drot(n, q[j:], ldq, q[j+1:], ldq, c, s)
+				}
+
+				c, s, t[(j+1)*ldt+j+1] = impl.Dlartg(t[(j+1)*ldt+j+1], t[(j+1)*ldt+j])
+				t[(j+1)*ldt+j] = 0
+				bi.Drot(min(j+2, ilast)-ifrstm+1, h[ifrstm*ldh+j+1:], ldh, h[ifrstm*ldh+j:], ldh, c, s)
+				bi.Drot(j-ifrstm+1, t[ifrstm*ldt+j+1:], ldt, t[ifrstm*ldt+j:], ldt, c, s)
+				if wantz {
+					bi.Drot(n, z[j+1:], ldz, z[j:], ldz, c, s)
+				}
+			}
+			continue
+		}
+
+		// Use Francis double-shift.
+		//
+		// Note: the Francis double-shift should work with real shifts, but
+		// only if the block is at least 3×3. This code may break if this
+		// point is reached with a 2×2 block with real eigenvalues.
+		if ifirst+1 == ilast {
+			// Special case: 2×2 block with complex eigenvectors.
+			//
+			// Step 1: Standardize, that is, rotate so that
+			//
+			//	    [ B11  0  ]
+			//	B = [         ] with B11 non-negative.
+			//	    [  0  B22 ]
+			b22, b11, sr, cr, sl, cl := impl.Dlasv2(t[(ilast-1)*ldt+ilast-1], t[(ilast-1)*ldt+ilast], t[ilast*ldt+ilast])
+			if b11 < 0 {
+				cr = -cr
+				sr = -sr
+				b11 = -b11
+				b22 = -b22
+			}
+			bi.Drot(ilastm+1-ifirst, h[(ilast-1)*ldh+ilast-1:], 1, h[ilast*ldh+ilast-1:], 1, cl, sl)
+			bi.Drot(ilast+1-ifrstm, h[ifrstm*ldh+ilast-1:], ldh, h[ifrstm*ldh+ilast:], ldh, cr, sr)
+			if ilast < ilastm {
+				bi.Drot(ilastm-ilast, t[(ilast-1)*ldt+ilast+1:], 1, t[ilast*ldt+ilast+1:], 1, cl, sl)
+			}
+			if ifrstm < ilast-1 {
+				bi.Drot(ifirst-ifrstm, t[ifrstm*ldt+ilast-1:], ldt, t[ifrstm*ldt+ilast:], ldt, cr, sr)
+			}
+			if wantq {
+				bi.Drot(n, q[ilast-1:], ldq, q[ilast:], ldq, cl, sl)
+			}
+			if wantz {
+				bi.Drot(n, z[ilast-1:], ldz, z[ilast:], ldz, cr, sr)
+			}
+			t[(ilast-1)*ldt+ilast-1] = b11
+			t[(ilast-1)*ldt+ilast] = 0
+			t[ilast*ldt+ilast-1] = 0
+			t[ilast*ldt+ilast] = b22
+
+			// If B22 is negative, negate column ilast.
+			if b22 < 0 {
+				for j := ifrstm; j <= ilast; j++ {
+					h[j*ldh+ilast] *= -1
+					t[j*ldt+ilast] *= -1
+				}
+				if wantz {
+					bi.Dscal(n, -1, z[ilast:], ldz)
+				}
+				b22 = -b22
+			}
+
+			// Step 2: Compute alphar, alphai, and beta.
+			//
+			// Recompute shift.
+			var wi float64
+			s1, _, wr, _, wi = impl.Dlag2(h[(ilast-1)*ldh+ilast-1:], ldh, t[(ilast-1)*ldt+ilast-1:], ldt)
+
+			// If standardization has perturbed the shift onto real
+			// line, do another (real single-shift) QR step.
+			if wi == 0 {
+				continue
+			}
+			s1inv := 1 / s1
+
+			// Do EISPACK (QZVAL) computation of alpha and beta.
+			a11 := h[(ilast-1)*ldh+ilast-1]
+			a21 := h[ilast*ldh+ilast-1]
+			a12 := h[(ilast-1)*ldh+ilast]
+			a22 := h[ilast*ldh+ilast]
+
+			// Compute complex Givens rotation on right (assume some
+			// element of C = (sA - wB) > unfl):
+			//
+			//	(sA - wB) [ cz  -conj(sz) ]
+			//	          [ sz   cz       ]
+			c11r := s1*a11 - wr*b11
+			c11i := -wi * b11
+			c12 := s1 * a12
+			c21 := s1 * a21
+			c22r := s1*a22 - wr*b22
+			c22i := -wi * b22
+
+			var cz, szr, szi float64
+			if math.Abs(c11r)+math.Abs(c11i)+math.Abs(c12) > math.Abs(c21)+math.Abs(c22r)+math.Abs(c22i) {
+				t1 := dlapy3(c12, c11r, c11i)
+				cz = c12 / t1
+				szr = -c11r / t1
+				szi = -c11i / t1
+			} else {
+				cz = impl.Dlapy2(c22r, c22i)
+				if cz <= safmin {
+					cz = 0
+					szr = 1
+					szi = 0
+				} else {
+					tempr := c22r / cz
+					tempi := c22i / cz
+					t1 := impl.Dlapy2(cz, c21)
+					cz /= t1
+					szr = -c21 * tempr / t1
+					szi = c21 * tempi / t1
+				}
+			}
+
+			// Compute Givens rotation on left:
+			//
+			//	[  cq         sq ]
+			//	[ -conj(sq)   cq ] A or B
+			an := math.Abs(a11) + math.Abs(a12) + math.Abs(a21) + math.Abs(a22)
+			bn := math.Abs(b11) + math.Abs(b22)
+			wabs := math.Abs(wr) + math.Abs(wi)
+			var cq, sqr, sqi float64
+			if s1*an > wabs*bn {
+				cq = cz * b11
+				sqr = szr * b22
+				sqi = -szi * b22
+			} else {
+				a1r := cz*a11 + szr*a12
+				a1i := szi * a12
+				a2r := cz*a21 + szr*a22
+				a2i := szi * a22
+				cq = impl.Dlapy2(a1r, a1i)
+				if cq <= safmin {
+					cq = 0
+					sqr = 1
+					sqi = 0
+				} else {
+					tempr := a1r / cq
+					tempi := a1i / cq
+					sqr = tempr*a2r + tempi*a2i
+					sqi = tempi*a2r - tempr*a2i
+				}
+			}
+			t1 := dlapy3(cq, sqr, sqi)
+			cq /= t1
+			sqr /= t1
+			sqi /= t1
+
+			// Compute diagonal elements of QBZ.
+			tempr := sqr*szr - sqi*szi
+			tempi := sqr*szi + sqi*szr
+			b1r := cq*cz*b11 + tempr*b22
+			b1i := tempi * b22
+			b1a := impl.Dlapy2(b1r, b1i)
+			b2r := cq*cz*b22 + tempr*b11
+			b2i := -tempi * b11
+			b2a := impl.Dlapy2(b2r, b2i)
+
+			// Normalize so beta > 0, and Im(alpha1) > 0.
+			beta[ilast-1] = b1a
+			beta[ilast] = b2a
+			alphar[ilast-1] = (wr * b1a) * s1inv
+			alphai[ilast-1] = (wi * b1a) * s1inv
+			alphar[ilast] = (wr * b2a) * s1inv
+			alphai[ilast] = -(wi * b2a) * s1inv
+
+			// Step 3: Go to next block; exit if finished.
+			ilast = ifirst - 1
+			if ilast < ilo {
+				converged = true
+				break
+			}
+
+			// Reset counters.
+			iiter = 0
+			eshift = 0
+			if !wantt {
+				ilastm = ilast
+				if ifrstm > ilast {
+					ifrstm = ilo
+				}
+			}
+			continue
+		}
+
+		// Usual case: 3×3 or larger block, using Francis implicit
+		// double-shift.
+		//
+		// Eigenvalue equation is w² - c*w + d = 0, so compute the first
+		// column of (A*B⁻¹)² - c*A*B⁻¹ + d using the formula in QZIT
+		// (from EISPACK).
+		//
+		// We assume that the block is at least 3×3.
+		ad11 := (ascale * h[(ilast-1)*ldh+ilast-1]) / (bscale * t[(ilast-1)*ldt+ilast-1])
+		ad21 := (ascale * h[ilast*ldh+ilast-1]) / (bscale * t[(ilast-1)*ldt+ilast-1])
+		ad12 := (ascale * h[(ilast-1)*ldh+ilast]) / (bscale * t[ilast*ldt+ilast])
+		ad22 := (ascale * h[ilast*ldh+ilast]) / (bscale * t[ilast*ldt+ilast])
+		u12 := t[(ilast-1)*ldt+ilast] / t[ilast*ldt+ilast]
+		ad11l := (ascale * h[ifirst*ldh+ifirst]) / (bscale * t[ifirst*ldt+ifirst])
+		ad21l := (ascale * h[(ifirst+1)*ldh+ifirst]) / (bscale * t[ifirst*ldt+ifirst])
+		ad12l := (ascale * h[ifirst*ldh+ifirst+1]) / (bscale * t[(ifirst+1)*ldt+ifirst+1])
+		ad22l := (ascale * h[(ifirst+1)*ldh+ifirst+1]) / (bscale * t[(ifirst+1)*ldt+ifirst+1])
+		ad32l := (ascale * h[(ifirst+2)*ldh+ifirst+1]) / (bscale * t[(ifirst+1)*ldt+ifirst+1])
+		u12l := t[ifirst*ldt+ifirst+1] / t[(ifirst+1)*ldt+ifirst+1]
+
+		v[0] = (ad11-ad11l)*(ad22-ad11l) - ad12*ad21 + ad21*u12*ad11l + (ad12l-ad11l*u12l)*ad21l
+		v[1] = ((ad22l - ad11l) - ad21l*u12l - (ad11 - ad11l) - (ad22 - ad11l) + ad21*u12) * ad21l
+		v[2] = ad32l * ad21l
+
+		istart := ifirst
+		var tau float64
+		_, tau = impl.Dlarfg(3, v[0], v[1:], 1)
+		v[0] = 1
+
+		// Sweep.
+		for j := istart; j < ilast-1; j++ {
+			// All but last elements: use 3×3 Householder transforms.
+			//
+			// Zero (j-1)st column of A.
+			if j > istart {
+				v[1] = h[(j+1)*ldh+j-1]
+				v[2] = h[(j+2)*ldh+j-1]
+				h[j*ldh+j-1], tau = impl.Dlarfg(3, h[j*ldh+j-1], v[1:], 1)
+				v[0] = 1
+				h[(j+1)*ldh+j-1] = 0
+				h[(j+2)*ldh+j-1] = 0
+			}
+
+			t2 := tau * v[1]
+			t3 := tau * v[2]
+			for jc := j; jc <= ilastm; jc++ {
+				temp := h[j*ldh+jc] + v[1]*h[(j+1)*ldh+jc] + v[2]*h[(j+2)*ldh+jc]
+				h[j*ldh+jc] -= temp * tau
+				h[(j+1)*ldh+jc] -= temp * t2
+				h[(j+2)*ldh+jc] -= temp * t3
+				temp2 := t[j*ldt+jc] + v[1]*t[(j+1)*ldt+jc] + v[2]*t[(j+2)*ldt+jc]
+				t[j*ldt+jc] -= temp2 * tau
+				t[(j+1)*ldt+jc] -= temp2 * t2
+				t[(j+2)*ldt+jc] -= temp2 * t3
+			}
+			if wantq {
+				for jr := 0; jr < n; jr++ {
+					temp := q[jr*ldq+j] + v[1]*q[jr*ldq+j+1] + v[2]*q[jr*ldq+j+2]
+					q[jr*ldq+j] -= temp * tau
+					q[jr*ldq+j+1] -= temp * t2
+					q[jr*ldq+j+2] -= temp * t3
+				}
+			}
+
+			// Zero j-th column of B.
+			//
+			// Swap rows to pivot.
+			var (
+				w11, w12, w21, w22 float64
+				u1, u2             float64
+				scale              float64
+				ilpivt             bool
+			)
+			temp := math.Max(math.Abs(t[(j+1)*ldt+j+1]), math.Abs(t[(j+1)*ldt+j+2]))
+			temp2 := math.Max(math.Abs(t[(j+2)*ldt+j+1]), math.Abs(t[(j+2)*ldt+j+2]))
+			if math.Max(temp, temp2) < safmin {
+				scale = 0
+				u1 = 1
+				u2 = 0
+			} else {
+				if temp >= temp2 {
+					w11 = t[(j+1)*ldt+j+1]
+					w21 = t[(j+2)*ldt+j+1]
+					w12 = t[(j+1)*ldt+j+2]
+					w22 = t[(j+2)*ldt+j+2]
+					u1 = t[(j+1)*ldt+j]
+					u2 = t[(j+2)*ldt+j]
+				} else {
+					w21 = t[(j+1)*ldt+j+1]
+					w11 = t[(j+2)*ldt+j+1]
+					w22 = t[(j+1)*ldt+j+2]
+					w12 = t[(j+2)*ldt+j+2]
+					u2 = t[(j+1)*ldt+j]
+					u1 = t[(j+2)*ldt+j]
+				}
+
+				// Swap columns if necessary.
+				if math.Abs(w12) > math.Abs(w11) {
+					ilpivt = true
+					w12, w11 = w11, w12
+					w22, w21 = w21, w22
+				}
+
+				// LU-factor.
+				temp = w21 / w11
+				u2 -= temp * u1
+				w22 -= temp * w12
+				w21 = 0
+
+				// Compute scale.
+				scale = 1
+				if math.Abs(w22) < safmin {
+					scale = 0
+					u2 = 1
+					u1 = -w12 / w11
+				} else {
+					if math.Abs(w22) < math.Abs(u2) {
+						scale = math.Abs(w22 / u2)
+					}
+					if math.Abs(w11) < math.Abs(u1) {
+						scale = math.Min(scale, math.Abs(w11/u1))
+					}
+
+					// Solve.
+					u2 = (scale * u2) / w22
+					u1 = (scale*u1 - w12*u2) / w11
+				}
+			}
+			if ilpivt {
+				u1, u2 = u2, u1
+			}
+
+			// Compute Householder vector.
+			t1 := math.Sqrt(scale*scale + u1*u1 + u2*u2)
+			tau = 1 + scale/t1
+			vs := -1 / (scale + t1)
+			v[0] = 1
+			v[1] = vs * u1
+			v[2] = vs * u2
+
+			// Apply transformations from the right.
+			t2 = tau * v[1]
+			t3 = tau * v[2]
+			for jr := ifrstm; jr <= min(j+3, ilast); jr++ {
+				temp := h[jr*ldh+j] + v[1]*h[jr*ldh+j+1] + v[2]*h[jr*ldh+j+2]
+				h[jr*ldh+j] -= temp * tau
+				h[jr*ldh+j+1] -= temp * t2
+				h[jr*ldh+j+2] -= temp * t3
+			}
+			for jr := ifrstm; jr <= j+2; jr++ {
+				temp := t[jr*ldt+j] + v[1]*t[jr*ldt+j+1] + v[2]*t[jr*ldt+j+2]
+				t[jr*ldt+j] -= temp * tau
+				t[jr*ldt+j+1] -= temp * t2
+				t[jr*ldt+j+2] -= temp * t3
+			}
+			if wantz {
+				for jr := 0; jr < n; jr++ {
+					temp := z[jr*ldz+j] + v[1]*z[jr*ldz+j+1] + v[2]*z[jr*ldz+j+2]
+					z[jr*ldz+j] -= temp * tau
+					z[jr*ldz+j+1] -= temp * t2
+					z[jr*ldz+j+2] -= temp * t3
+				}
+			}
+			t[(j+1)*ldt+j] = 0
+			t[(j+2)*ldt+j] = 0
+		}
+
+		// Last elements: use Givens rotations.
+		//
+		// Rotations from the left.
+		j := ilast - 1
+		var c, s float64
+		c, s, h[j*ldh+j-1] = impl.Dlartg(h[j*ldh+j-1], h[(j+1)*ldh+j-1])
+		h[(j+1)*ldh+j-1] = 0
+		bi.Drot(ilastm-j+1, h[j*ldh+j:], 1, h[(j+1)*ldh+j:], 1, c, s)
+		bi.Drot(ilastm-j+1, t[j*ldt+j:], 1, t[(j+1)*ldt+j:], 1, c, s)
+		if wantq {
+			bi.Drot(n, q[j:], ldq, q[j+1:], ldq, c, s)
+		}
+
+		// Rotations from the right.
+		c, s, t[(j+1)*ldt+j+1] = impl.Dlartg(t[(j+1)*ldt+j+1], t[(j+1)*ldt+j])
+		t[(j+1)*ldt+j] = 0
+		bi.Drot(ilast-ifrstm+1, h[ifrstm*ldh+j+1:], ldh, h[ifrstm*ldh+j:], ldh, c, s)
+		bi.Drot(ilast-ifrstm, t[ifrstm*ldt+j+1:], ldt, t[ifrstm*ldt+j:], ldt, c, s)
+		if wantz {
+			bi.Drot(n, z[j+1:], ldz, z[j:], ldz, c, s)
+		}
+		// End of double-shift code.
+	}
+
+	if !converged {
+		// Drop-through means non-convergence.
+		work[0] = float64(n)
+		return ilast + 1
+	}
+
+	// Successful completion of all QZ steps.
+	//
+	// Set eigenvalues 0:ilo.
+	for j := 0; j < ilo; j++ {
+		setEigenvalue(j, 0)
+	}
+
+	work[0] = float64(n)
+	return 0
+}
+
+// dlapy3 returns sqrt(x²+y²+z²) taking care not to cause unnecessary
+// overflow and unnecessary underflow.
+func dlapy3(x, y, z float64) float64 {
+	xabs := math.Abs(x)
+	yabs := math.Abs(y)
+	zabs := math.Abs(z)
+	w := math.Max(xabs, math.Max(yabs, zabs))
+	if w == 0 {
+		// W can be zero for max(0,nan,0) adding all three entries
+		// together will make sure NaN will not disappear.
+		return xabs + yabs + zabs
+	}
+	xabs /= w
+	yabs /= w
+	zabs /= w
+	return w * math.Sqrt(xabs*xabs+yabs*yabs+zabs*zabs)
+}
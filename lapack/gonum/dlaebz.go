@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaebz contains the iteration loops which compute and use the function
+// N(w), the count of eigenvalues of a symmetric tridiagonal matrix T less than
+// or equal to its argument w. It performs a choice of two types of loops:
+//
+//	ijob == 1: Compute N(w) for the endpoints of minp intervals.
+//	ijob == 2: Perform bisection iteration to find eigenvalues of T.
+//	ijob == 3: Perform bisection iteration to invert N(w), that is, find
+//	           a point which has a specified number of eigenvalues of T to
+//	           its left.
+//
+// The intervals are stored as pairs in ab so that the j-th interval is
+// (ab[2*j], ab[2*j+1]], and nab[2*j] and nab[2*j+1] hold the corresponding
+// values of N(w). On entry, the first minp intervals must be set. For
+// ijob == 1, mout returns the total number of eigenvalues in the intervals and
+// nab is set on return.
+//
+// For ijob == 2, nab must contain N(w) at the interval endpoints on entry.
+// The intervals are bisected until each contains a single distinct eigenvalue
+// or has converged, and new intervals are appended as they are split. mmax is
+// the maximum number of intervals and ab, c and nab must have room for mmax
+// intervals. On return, mout is the number of intervals.
+//
+// For ijob == 3, nval[j] specifies the target count for the j-th interval and
+// c[j] must contain the initial search point. On return the j-th interval
+// contains a point w with N(w) == nval[j] if possible.
+//
+// An interval is considered converged if its width is less than
+// max(abstol, pivmin, reltol*max(|a|,|b|)). nitmax is the maximum number of
+// bisection steps. pivmin is the minimum absolute value of a pivot allowed in
+// the Sturm sequence.
+//
+// d must contain the n diagonal elements of T and e2 the n-1 squared
+// off-diagonal elements.
+//
+// info is mmax+1 if the number of intervals exceeded mmax and otherwise it is
+// the number of intervals that did not converge.
+//
+// Dlaebz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaebz(ijob, nitmax, n, mmax, minp int, abstol, reltol, pivmin float64, d, e2 []float64, nval []int, ab, c []float64, nab []int) (mout, info int) {
+	switch {
+	case ijob < 1 || 3 < ijob:
+		panic(badIjob)
+	case n < 0:
+		panic(nLT0)
+	case minp < 0:
+		panic(minpLT0)
+	case mmax < minp:
+		panic(mmaxLTMinp)
+	}
+
+	if n == 0 {
+		return 0, 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e2) < n-1:
+		panic(shortE2)
+	case len(ab) < 2*mmax:
+		panic(shortAB)
+	case len(nab) < 2*mmax:
+		panic(shortNab)
+	case ijob > 1 && len(c) < mmax:
+		panic(shortC)
+	case ijob == 3 && len(nval) < minp:
+		panic(shortNval)
+	}
+
+	// sturm returns the number of eigenvalues of T less than or equal to
+	// x computed using the Sturm sequence.
+	sturm := func(x float64) int {
+		var cnt int
+		tmp := d[0] - x
+		if tmp <= pivmin {
+			cnt++
+			tmp = math.Min(tmp, -pivmin)
+		}
+		for j := 1; j < n; j++ {
+			tmp = d[j] - e2[j-1]/tmp - x
+			if tmp <= pivmin {
+				cnt++
+				tmp = math.Min(tmp, -pivmin)
+			}
+		}
+		return cnt
+	}
+
+	if ijob == 1 {
+		// Compute the number of eigenvalues in the initial intervals.
+		for ji := 0; ji < minp; ji++ {
+			for jp := 0; jp < 2; jp++ {
+				tmp := d[0] - ab[2*ji+jp]
+				if math.Abs(tmp) < pivmin {
+					tmp = -pivmin
+				}
+				nab[2*ji+jp] = 0
+				if tmp <= 0 {
+					nab[2*ji+jp] = 1
+				}
+				for j := 1; j < n; j++ {
+					tmp = d[j] - e2[j-1]/tmp - ab[2*ji+jp]
+					if math.Abs(tmp) < pivmin {
+						tmp = -pivmin
+					}
+					if tmp <= 0 {
+						nab[2*ji+jp]++
+					}
+				}
+			}
+			mout += nab[2*ji+1] - nab[2*ji]
+		}
+		return mout, 0
+	}
+
+	// Initialize for the loop. kf and kl are the first and one past the last
+	// index of the intervals that have not converged.
+	kf := 0
+	kl := minp
+
+	// If ijob == 2, initialize c. If ijob == 3, use the user-supplied
+	// starting point.
+	if ijob == 2 {
+		for ji := 0; ji < minp; ji++ {
+			c[ji] = 0.5 * (ab[2*ji] + ab[2*ji+1])
+		}
+	}
+
+	for jit := 0; jit < nitmax; jit++ {
+		klnew := kl
+		for ji := kf; ji < kl; ji++ {
+			x := c[ji]
+			cnt := sturm(x)
+			if ijob == 2 {
+				// Bisection. Keep the half containing eigenvalues and
+				// split the interval if both halves contain some.
+				cnt = min(nab[2*ji+1], max(nab[2*ji], cnt))
+				switch {
+				case cnt == nab[2*ji+1]:
+					// No eigenvalue in the upper interval, so just use
+					// the lower interval.
+					ab[2*ji+1] = x
+				case cnt == nab[2*ji]:
+					// No eigenvalue in the lower interval, so just use
+					// the upper interval.
+					ab[2*ji] = x
+				case klnew < mmax:
+					// Eigenvalues in both intervals, so add the upper
+					// interval to the list.
+					ab[2*klnew+1] = ab[2*ji+1]
+					nab[2*klnew+1] = nab[2*ji+1]
+					ab[2*klnew] = x
+					nab[2*klnew] = cnt
+					ab[2*ji+1] = x
+					nab[2*ji+1] = cnt
+					klnew++
+				default:
+					// Too many intervals.
+					return kl, mmax + 1
+				}
+			} else {
+				// Binary search for the target count.
+				if cnt <= nval[ji] {
+					ab[2*ji] = x
+					nab[2*ji] = cnt
+				}
+				if cnt >= nval[ji] {
+					ab[2*ji+1] = x
+					nab[2*ji+1] = cnt
+				}
+			}
+		}
+		kl = klnew
+
+		// Check for convergence and move the converged intervals to the
+		// front.
+		kfnew := kf
+		for ji := kf; ji < kl; ji++ {
+			tmp1 := math.Abs(ab[2*ji+1] - ab[2*ji])
+			tmp2 := math.Max(math.Abs(ab[2*ji+1]), math.Abs(ab[2*ji]))
+			if tmp1 < math.Max(math.Max(abstol, pivmin), reltol*tmp2) || nab[2*ji] >= nab[2*ji+1] {
+				if ji > kfnew {
+					ab[2*ji], ab[2*kfnew] = ab[2*kfnew], ab[2*ji]
+					ab[2*ji+1], ab[2*kfnew+1] = ab[2*kfnew+1], ab[2*ji+1]
+					nab[2*ji], nab[2*kfnew] = nab[2*kfnew], nab[2*ji]
+					nab[2*ji+1], nab[2*kfnew+1] = nab[2*kfnew+1], nab[2*ji+1]
+					if ijob == 3 {
+						nval[ji], nval[kfnew] = nval[kfnew], nval[ji]
+					}
+				}
+				kfnew++
+			}
+		}
+		kf = kfnew
+
+		// Choose the midpoints.
+		for ji := kf; ji < kl; ji++ {
+			c[ji] = 0.5 * (ab[2*ji] + ab[2*ji+1])
+		}
+
+		// If no more intervals to refine, quit.
+		if kf >= kl {
+			break
+		}
+	}
+
+	return kl, max(kl-kf, 0)
+}
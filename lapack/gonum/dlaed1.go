@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// Dlaed1 computes the updated eigensystem of a diagonal matrix after
+// modification by a rank-one symmetric matrix. It is used when the original
+// matrix is tridiagonal and it is the merge step of the divide and conquer
+// algorithm implemented by Dlaed0.
+//
+// Dlaed1 computes the updated eigenvalues and eigenvectors of
+//
+//	T = Q * (D + rho * z * zᵀ) * Qᵀ
+//
+// where D and Q contain the eigenvalues and eigenvectors of the two
+// independent subproblems of size cutpnt and n-cutpnt that were obtained by
+// cutting T, rho is the off-diagonal element associated with the cut and z is
+// formed from the last row of the first eigenvector matrix and the first row
+// of the second eigenvector matrix. cutpnt must be equal to n/2 and it must be
+// positive.
+//
+// The eigenvalues are computed in three stages. First, the eigenvalues and
+// eigenvectors are merged and the problem is deflated by Dlaed2. Then the
+// secular equation is solved and the eigenvectors are updated by Dlaed3.
+// Finally, the permutation which sorts the eigenvalues is computed.
+//
+// On entry, d contains the eigenvalues of the two subproblems and the n×n
+// matrix Q contains their eigenvectors in its two square diagonal blocks. On
+// return, d contains the eigenvalues of the merged problem and Q contains the
+// corresponding eigenvectors.
+//
+// On entry, indxq contains the permutation which separately sorts the two
+// subproblems in d into ascending order. On return, it contains the
+// permutation which sorts the merged eigenvalues into ascending order.
+//
+// work must have length at least 4*n+n*n and iwork must have length at least
+// 4*n.
+//
+// Dlaed1 returns whether all the roots of the secular equation were found.
+//
+// Dlaed1 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed1(n int, d, q []float64, ldq int, indxq []int, rho float64, cutpnt int, work []float64, iwork []int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	case n > 0 && (cutpnt < 1 || cutpnt != n/2):
+		panic(badCutpnt)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(indxq) < n:
+		panic(shortIndxq)
+	case len(work) < 4*n+n*n:
+		panic(shortWork)
+	case len(iwork) < 4*n:
+		panic(shortIWork)
+	}
+
+	// The following values are indices into the workspace used by a
+	// particular array in Dlaed2 and Dlaed3.
+	iz := 0
+	idlmda := iz + n
+	iw := idlmda + n
+	iq2 := iw + n
+
+	indx := 0
+	indxc := indx + n
+	coltyp := indxc + n
+	indxp := coltyp + n
+
+	// Form the z vector which consists of the last row of Q_1 and the first
+	// row of Q_2.
+	copy(work[iz:iz+cutpnt], q[(cutpnt-1)*ldq:(cutpnt-1)*ldq+cutpnt])
+	copy(work[iz+cutpnt:iz+n], q[cutpnt*ldq+cutpnt:cutpnt*ldq+n])
+
+	// Deflate eigenvalues.
+	k, rho := impl.Dlaed2(n, cutpnt, d, q, ldq, indxq, rho, work[iz:], work[idlmda:], work[iw:], work[iq2:],
+		iwork[indx:], iwork[indxc:], iwork[indxp:], iwork[coltyp:])
+
+	if k == 0 {
+		for i := 0; i < n; i++ {
+			indxq[i] = i
+		}
+		return true
+	}
+
+	// Solve the secular equation.
+	ctot := iwork[coltyp : coltyp+4]
+	is := (ctot[0]+ctot[1])*cutpnt + (ctot[1]+ctot[2])*(n-cutpnt) + iq2
+	ok = impl.Dlaed3(k, n, cutpnt, d, q, ldq, rho, work[idlmda:], work[iq2:], iwork[indxc:], ctot, work[iw:], work[is:])
+	if !ok {
+		return false
+	}
+
+	// Prepare the indxq sorting permutation.
+	impl.Dlamrg(k, n-k, d, 1, -1, indxq)
+	return true
+}
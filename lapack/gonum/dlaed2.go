@@ -0,0 +1,298 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlaed2 merges the two sets of eigenvalues together into a single sorted set.
+// Then it tries to deflate the size of the problem. There are two ways in
+// which deflation can occur: when two or more eigenvalues are close together
+// or if there is a tiny entry in the z vector. For each such occurrence the
+// order of the related secular equation problem is reduced by one.
+//
+// On entry, d contains the eigenvalues of the two submatrices to be combined,
+// the first n1 elements belonging to the first subproblem, and the n×n matrix
+// Q contains the eigenvectors of the two submatrices in its two square
+// diagonal blocks. indxq contains the permutation which separately sorts the
+// two subproblems in d into ascending order. rho is the off-diagonal element
+// associated with the rank-one cut which originally split the two submatrices
+// and z is the updating vector formed from the last row of the first
+// eigenvector matrix and the first row of the second eigenvector matrix.
+//
+// On return, d[k:n] contains the deflated eigenvalues, the corresponding
+// eigenvectors are stored in the columns k to n-1 of Q, and z is destroyed.
+// dlamda[:k] contains a copy of the first k eigenvalues which will be used by
+// Dlaed3 to form the secular equation, and w[:k] contains the first k values
+// of the final deflation-altered z vector which will be passed to Dlaed3. q2
+// contains a copy of the first k eigenvectors which will be used by Dlaed3 in
+// a matrix multiply to solve for the new eigenvectors. It is packed as an
+// n1×(ctot[0]+ctot[1]) matrix holding the upper parts of the columns of type
+// 1 and 2, followed by an (n-n1)×(ctot[1]+ctot[2]) matrix holding the lower
+// parts of the columns of type 2 and 3. q2 must have length at least n*n.
+//
+// indx, indxc and indxp are workspace that must have length at least n. On
+// return, indxc contains the permutation used to arrange the non-deflated
+// columns of Q into three groups: the first group contains the columns with
+// non-zero elements only in the first n1 rows, the second group contains the
+// dense columns, and the third group contains the columns with non-zero
+// elements only in the last n-n1 rows. coltyp is workspace of length at least
+// n and on return its first four elements contain the number of columns of
+// each of the four types.
+//
+// Dlaed2 returns the number k of non-deflated eigenvalues and the modified
+// value of rho to be used in the secular equation.
+//
+// Dlaed2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed2(n, n1 int, d, q []float64, ldq int, indxq []int, rho float64, z, dlamda, w, q2 []float64, indx, indxc, indxp, coltyp []int) (k int, rhoOut float64) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case n1 < min(1, n/2) || n/2 < n1:
+		panic(badN1)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, rho
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(indxq) < n:
+		panic(shortIndxq)
+	case len(z) < n:
+		panic(shortZ)
+	case len(dlamda) < n:
+		panic(shortDlamda)
+	case len(w) < n:
+		panic(shortW)
+	case len(q2) < n*n:
+		panic(shortQ2)
+	case len(indx) < n:
+		panic(shortIndx)
+	case len(indxc) < n:
+		panic(shortIndxc)
+	case len(indxp) < n:
+		panic(shortIndxp)
+	case len(coltyp) < n:
+		panic(shortColtyp)
+	}
+
+	bi := blas64.Implementation()
+
+	n2 := n - n1
+	if rho < 0 {
+		bi.Dscal(n2, -1, z[n1:], 1)
+	}
+
+	// Normalize z so that its Euclidean norm is one. Since z is the
+	// concatenation of two normalized vectors, its norm is sqrt(2).
+	bi.Dscal(n, 1/math.Sqrt2, z, 1)
+
+	// rho = |norm(z)^2 * rho|.
+	rho = math.Abs(2 * rho)
+
+	// Sort the eigenvalues into increasing order.
+	for i := n1; i < n; i++ {
+		indxq[i] += n1
+	}
+
+	// Re-integrate the deflated parts from the last pass.
+	for i := 0; i < n; i++ {
+		dlamda[i] = d[indxq[i]]
+	}
+	impl.Dlamrg(n1, n2, dlamda, 1, 1, indxc)
+	for i := 0; i < n; i++ {
+		indx[i] = indxq[indxc[i]]
+	}
+
+	// Calculate the allowable deflation tolerance.
+	imax := bi.Idamax(n, z, 1)
+	jmax := bi.Idamax(n, d, 1)
+	eps := dlamchE
+	tol := 8 * eps * max(math.Abs(d[jmax]), math.Abs(z[imax]))
+
+	// If the rank-1 modifier is small enough, no more needs to be done except
+	// to reorganize Q so that its columns correspond with the elements in d.
+	if rho*math.Abs(z[imax]) <= tol {
+		for j := 0; j < n; j++ {
+			i := indx[j]
+			bi.Dcopy(n, q[i:], ldq, q2[j:], n)
+			dlamda[j] = d[i]
+		}
+		impl.Dlacpy(blas.All, n, n, q2, n, q, ldq)
+		copy(d[:n], dlamda[:n])
+		return 0, rho
+	}
+
+	// If there are multiple eigenvalues then the problem deflates. Here the
+	// number of equal eigenvalues are found. As each equal eigenvalue is
+	// found, an elementary reflector is computed to rotate the corresponding
+	// eigensubspace so that the corresponding components of z are zero in
+	// this new basis.
+	//
+	// The columns of Q are classified into four types:
+	//  1: non-zero in the upper half only,
+	//  2: dense,
+	//  3: non-zero in the lower half only,
+	//  4: deflated.
+	for i := 0; i < n1; i++ {
+		coltyp[i] = 1
+	}
+	for i := n1; i < n; i++ {
+		coltyp[i] = 3
+	}
+
+	k2 := n
+	var pj, j int
+	for ; j < n; j++ {
+		nj := indx[j]
+		if rho*math.Abs(z[nj]) > tol {
+			pj = nj
+			break
+		}
+		// Deflate due to small z component.
+		k2--
+		coltyp[nj] = 4
+		indxp[k2] = nj
+	}
+	for j++; j < n; j++ {
+		nj := indx[j]
+		if rho*math.Abs(z[nj]) <= tol {
+			// Deflate due to small z component.
+			k2--
+			coltyp[nj] = 4
+			indxp[k2] = nj
+			continue
+		}
+
+		// Check if eigenvalues are close enough to allow deflation.
+		s := z[pj]
+		c := z[nj]
+
+		// Find sqrt(a^2+b^2) without overflow or destructive underflow.
+		tau := impl.Dlapy2(c, s)
+		t := d[nj] - d[pj]
+		c /= tau
+		s = -s / tau
+		if math.Abs(t*c*s) > tol {
+			dlamda[k] = d[pj]
+			w[k] = z[pj]
+			indxp[k] = pj
+			k++
+			pj = nj
+			continue
+		}
+
+		// Deflation is possible.
+		z[nj] = tau
+		z[pj] = 0
+		if coltyp[nj] != coltyp[pj] {
+			coltyp[nj] = 2
+		}
+		coltyp[pj] = 4
+		bi.Drot(n, q[pj:], ldq, q[nj:], ldq, c, s)
+		t = d[pj]*c*c + d[nj]*s*s
+		d[nj] = d[pj]*s*s + d[nj]*c*c
+		d[pj] = t
+		k2--
+		i := 1
+		for k2+i < n && d[pj] < d[indxp[k2+i]] {
+			indxp[k2+i-1] = indxp[k2+i]
+			i++
+		}
+		indxp[k2+i-1] = pj
+		pj = nj
+	}
+
+	// Record the last eigenvalue.
+	dlamda[k] = d[pj]
+	w[k] = z[pj]
+	indxp[k] = pj
+	k++
+
+	// Count up the total number of the various types of columns, then form
+	// a permutation which positions the four column types into four uniform
+	// groups (although one or more of these groups may be empty).
+	var ctot [4]int
+	for j := 0; j < n; j++ {
+		ctot[coltyp[j]-1]++
+	}
+
+	// psm is the position in the submatrix of types 1 through 4.
+	psm := [4]int{0, ctot[0], ctot[0] + ctot[1], ctot[0] + ctot[1] + ctot[2]}
+	k = n - ctot[3]
+
+	// Fill out the indxc array so that the permutation which it induces will
+	// place all type-1 columns first, all type-2 columns next, then all
+	// type-3's, and finally all type-4's.
+	for j := 0; j < n; j++ {
+		js := indxp[j]
+		ct := coltyp[js] - 1
+		indx[psm[ct]] = js
+		indxc[psm[ct]] = j
+		psm[ct]++
+	}
+
+	// Sort the eigenvalues and corresponding eigenvectors into dlamda and q2
+	// respectively. The eigenvalues and vectors which were not deflated go
+	// into the first k slots of dlamda and q2 respectively, while those which
+	// were deflated go into the last n-k slots.
+	n12 := ctot[0] + ctot[1]
+	n23 := ctot[1] + ctot[2]
+	iq2 := n1 * n12
+	var i, j1, j2 int
+	for j := 0; j < ctot[0]; j++ {
+		js := indx[i]
+		bi.Dcopy(n1, q[js:], ldq, q2[j1:], n12)
+		z[i] = d[js]
+		i++
+		j1++
+	}
+	for j := 0; j < ctot[1]; j++ {
+		js := indx[i]
+		bi.Dcopy(n1, q[js:], ldq, q2[j1:], n12)
+		bi.Dcopy(n2, q[n1*ldq+js:], ldq, q2[iq2+j2:], n23)
+		z[i] = d[js]
+		i++
+		j1++
+		j2++
+	}
+	for j := 0; j < ctot[2]; j++ {
+		js := indx[i]
+		bi.Dcopy(n2, q[n1*ldq+js:], ldq, q2[iq2+j2:], n23)
+		z[i] = d[js]
+		i++
+		j2++
+	}
+	iq1 := iq2 + n2*n23
+	for j := 0; j < ctot[3]; j++ {
+		js := indx[i]
+		bi.Dcopy(n, q[js:], ldq, q2[iq1+j:], ctot[3])
+		z[i] = d[js]
+		i++
+	}
+
+	// The deflated eigenvalues and their corresponding vectors go back into
+	// the last n-k slots of d and Q respectively.
+	if k < n {
+		impl.Dlacpy(blas.All, n, ctot[3], q2[iq1:], ctot[3], q[k:], ldq)
+		copy(d[k:n], z[k:n])
+	}
+
+	// Copy ctot into coltyp for referencing in Dlaed3.
+	copy(coltyp[:4], ctot[:])
+	return k, rho
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlaed3 finds the roots of the secular equation, as defined by the values in
+// dlamda, w and rho, between 0 and k-1. It makes the appropriate calls to
+// Dlaed4 and then updates the eigenvectors by multiplying the matrix of
+// eigenvectors of the pair of eigensystems being combined by the matrix of
+// eigenvectors of the k×k system which is solved here.
+//
+// Dlaed3 is used by Dlaed1 and it expects its arguments to be as produced by
+// Dlaed2. n1 is the size of the first subproblem and n is the size of the
+// merged problem. On return, d[:k] contains the updated eigenvalues and the
+// first k columns of the n×n matrix Q contain the corresponding updated
+// eigenvectors.
+//
+// q2 contains the non-deflated eigenvectors of the two subproblems packed as
+// computed by Dlaed2. indx and ctot are the permutation that groups the
+// columns by their type and the number of columns of each type as computed by
+// Dlaed2. w contains the components of the deflation-adjusted updating vector
+// and it is destroyed during the computation. s is workspace and must have
+// length at least max(ctot[0]+ctot[1], ctot[1]+ctot[2])*k and at least k.
+//
+// Dlaed3 returns whether all the roots of the secular equation were found.
+//
+// Dlaed3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed3(k, n, n1 int, d, q []float64, ldq int, rho float64, dlamda, q2 []float64, indx, ctot []int, w, s []float64) (ok bool) {
+	switch {
+	case k < 0:
+		panic(kLT0)
+	case n < k:
+		panic(nLTK)
+	case n1 < 0 || n < n1:
+		panic(badN1)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if k == 0 {
+		return true
+	}
+
+	n2 := n - n1
+	n12 := ctot[0] + ctot[1]
+	n23 := ctot[1] + ctot[2]
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(dlamda) < k:
+		panic(shortDlamda)
+	case len(q2) < n1*n12+n2*n23:
+		panic(shortQ2)
+	case len(indx) < k:
+		panic(shortIndx)
+	case len(ctot) < 4:
+		panic(shortCtot)
+	case len(w) < k:
+		panic(shortW)
+	case len(s) < max(k, max(n12, n23)*k):
+		panic(shortS)
+	}
+
+	bi := blas64.Implementation()
+
+	// Solve the secular equation for each root. Dlaed4 returns the
+	// differences dlamda[i]-d[j] in s, which are then stored in the j-th
+	// column of Q.
+	for j := 0; j < k; j++ {
+		d[j], ok = impl.Dlaed4(k, j, dlamda, w, s, rho)
+		if !ok {
+			// The zero finder failed, so the computation is terminated.
+			return false
+		}
+		bi.Dcopy(k, s, 1, q[j:], ldq)
+	}
+
+	switch k {
+	case 1:
+	case 2:
+		for j := 0; j < k; j++ {
+			w[0] = q[j]
+			w[1] = q[ldq+j]
+			q[j] = w[indx[0]]
+			q[ldq+j] = w[indx[1]]
+		}
+	default:
+		// Compute the updated w.
+		bi.Dcopy(k, w, 1, s, 1)
+
+		// Initialize w[i] = Q[i,i].
+		bi.Dcopy(k, q, ldq+1, w, 1)
+		for j := 0; j < k; j++ {
+			for i := 0; i < j; i++ {
+				w[i] *= q[i*ldq+j] / (dlamda[i] - dlamda[j])
+			}
+			for i := j + 1; i < k; i++ {
+				w[i] *= q[i*ldq+j] / (dlamda[i] - dlamda[j])
+			}
+		}
+		for i := 0; i < k; i++ {
+			w[i] = math.Copysign(math.Sqrt(-w[i]), s[i])
+		}
+
+		// Compute the eigenvectors of the modified rank-1 modification.
+		for j := 0; j < k; j++ {
+			for i := 0; i < k; i++ {
+				s[i] = w[i] / q[i*ldq+j]
+			}
+			temp := bi.Dnrm2(k, s, 1)
+			for i := 0; i < k; i++ {
+				q[i*ldq+j] = s[indx[i]] / temp
+			}
+		}
+	}
+
+	// Compute the updated eigenvectors.
+	impl.Dlacpy(blas.All, n23, k, q[ctot[0]*ldq:], ldq, s, k)
+	if n23 != 0 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n2, k, n23,
+			1, q2[n1*n12:], n23, s, k,
+			0, q[n1*ldq:], ldq)
+	} else {
+		impl.Dlaset(blas.All, n2, k, 0, 0, q[n1*ldq:], ldq)
+	}
+	impl.Dlacpy(blas.All, n12, k, q, ldq, s, k)
+	if n12 != 0 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n1, k, n12,
+			1, q2, n12, s, k,
+			0, q, ldq)
+	} else {
+		impl.Dlaset(blas.All, n1, k, 0, 0, q, ldq)
+	}
+	return true
+}
@@ -0,0 +1,579 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaed4 computes the i-th updated eigenvalue of a symmetric rank-one
+// modification to a diagonal matrix whose elements are given in the array d,
+// that is, the i-th root of the secular equation
+//
+//	1/rho + sum_j z[j]^2/(d[j]-λ) = 0.
+//
+// It is assumed that
+//
+//	d[j] < d[j+1] for j = 0, ..., n-2,
+//	rho > 0,
+//
+// and that the Euclidean norm of z is one.
+//
+// The method used is due to Ren-Cang Li (1993) and computes each root by a
+// rational interpolation of the secular function around the two nearest
+// poles, falling back to the three most relevant poles when the interpolation
+// stagnates.
+//
+// i must satisfy 0 <= i < n, and d, z and delta must have length at least n.
+// On return, delta[j] contains d[j] - λ_i, the information necessary to
+// construct the eigenvectors.
+//
+// Dlaed4 returns the computed eigenvalue λ_i and whether the iteration
+// converged.
+//
+// Dlaed4 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed4(n, i int, d, z, delta []float64, rho float64) (dlam float64, ok bool) {
+	const maxit = 30
+
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case i < 0 || n <= i:
+		panic(badI)
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < n:
+		panic(shortZ)
+	case len(delta) < n:
+		panic(shortDelta)
+	}
+
+	if n == 1 {
+		// Presumably, i == 0 upon entry.
+		delta[0] = 1
+		return d[0] + rho*z[0]*z[0], true
+	}
+	if n == 2 {
+		return impl.Dlaed5(i, d, z, delta, rho), true
+	}
+
+	eps := dlamchE
+	rhoinv := 1 / rho
+
+	if i == n-1 {
+		// The case i == n-1.
+		ii := n - 2
+		niter := 1
+
+		// Calculate the initial guess.
+		midpt := rho / 2
+
+		// If the Euclidean norm of z is not one, then temp should be set to
+		// rho * |z|_2^2 / 2.
+		for j := 0; j < n; j++ {
+			delta[j] = (d[j] - d[i]) - midpt
+		}
+		var psi float64
+		for j := 0; j < n-2; j++ {
+			psi += z[j] * z[j] / delta[j]
+		}
+		c := rhoinv + psi
+		w := c + z[ii]*z[ii]/delta[ii] + z[n-1]*z[n-1]/delta[n-1]
+
+		var tau, dltlb, dltub float64
+		if w <= 0 {
+			temp := z[n-2]*z[n-2]/(d[n-1]-d[n-2]+rho) + z[n-1]*z[n-1]/rho
+			if c <= temp {
+				tau = rho
+			} else {
+				del := d[n-1] - d[n-2]
+				a := -c*del + z[n-2]*z[n-2] + z[n-1]*z[n-1]
+				b := z[n-1] * z[n-1] * del
+				if a < 0 {
+					tau = 2 * b / (math.Sqrt(a*a+4*b*c) - a)
+				} else {
+					tau = (a + math.Sqrt(a*a+4*b*c)) / (2 * c)
+				}
+			}
+			// It can be proved that
+			//  d[n-1]+rho/2 <= λ_{n-1} < d[n-1]+tau <= d[n-1]+rho.
+			dltlb = midpt
+			dltub = rho
+		} else {
+			del := d[n-1] - d[n-2]
+			a := -c*del + z[n-2]*z[n-2] + z[n-1]*z[n-1]
+			b := z[n-1] * z[n-1] * del
+			if a < 0 {
+				tau = 2 * b / (math.Sqrt(a*a+4*b*c) - a)
+			} else {
+				tau = (a + math.Sqrt(a*a+4*b*c)) / (2 * c)
+			}
+			// It can be proved that
+			//  d[n-1] < d[n-1]+tau < λ_{n-1} < d[n-1]+rho/2.
+			dltlb = 0
+			dltub = midpt
+		}
+
+		for j := 0; j < n; j++ {
+			delta[j] = (d[j] - d[i]) - tau
+		}
+
+		// evaluate returns the value of the secular function, the
+		// derivatives of its two parts and a bound on the rounding error.
+		evaluate := func() (w, dpsi, dphi, erretm float64) {
+			// Evaluate psi and the derivative dpsi.
+			var psi float64
+			for j := 0; j <= ii; j++ {
+				temp := z[j] / delta[j]
+				psi += z[j] * temp
+				dpsi += temp * temp
+				erretm += psi
+			}
+			erretm = math.Abs(erretm)
+
+			// Evaluate phi and the derivative dphi.
+			temp := z[n-1] / delta[n-1]
+			phi := z[n-1] * temp
+			dphi = temp * temp
+			erretm = 8*(-phi-psi) + erretm - phi + rhoinv + math.Abs(tau)*(dpsi+dphi)
+			w = rhoinv + phi + psi
+			return w, dpsi, dphi, erretm
+		}
+		w, dpsi, dphi, erretm := evaluate()
+
+		// Test for convergence.
+		if math.Abs(w) <= eps*erretm {
+			return d[i] + tau, true
+		}
+
+		if w <= 0 {
+			dltlb = max(dltlb, tau)
+		} else {
+			dltub = min(dltub, tau)
+		}
+
+		// Calculate the new step.
+		niter++
+		c = w - delta[n-2]*dpsi - delta[n-1]*dphi
+		a := (delta[n-2]+delta[n-1])*w - delta[n-2]*delta[n-1]*(dpsi+dphi)
+		b := delta[n-2] * delta[n-1] * w
+		if c < 0 {
+			c = math.Abs(c)
+		}
+		var eta float64
+		switch {
+		case c == 0:
+			// Update proposed by Li, Ren-Cang.
+			eta = -w / (dpsi + dphi)
+		case a >= 0:
+			eta = (a + math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		default:
+			eta = 2 * b / (a - math.Sqrt(math.Abs(a*a-4*b*c)))
+		}
+
+		// Note that eta should be positive if w is negative, and eta should
+		// be negative otherwise. However, if for some reason caused by
+		// roundoff eta*w > 0, we simply use one Newton step instead. This way
+		// will guarantee eta*w < 0.
+		if w*eta > 0 {
+			eta = -w / (dpsi + dphi)
+		}
+		temp := tau + eta
+		if dltub < temp || temp < dltlb {
+			if w < 0 {
+				eta = (dltub - tau) / 2
+			} else {
+				eta = (dltlb - tau) / 2
+			}
+		}
+		for j := 0; j < n; j++ {
+			delta[j] -= eta
+		}
+		tau += eta
+		w, dpsi, dphi, erretm = evaluate()
+
+		// Main loop to update the values of the array delta.
+		for niter++; niter <= maxit; niter++ {
+			// Test for convergence.
+			if math.Abs(w) <= eps*erretm {
+				return d[i] + tau, true
+			}
+
+			if w <= 0 {
+				dltlb = max(dltlb, tau)
+			} else {
+				dltub = min(dltub, tau)
+			}
+
+			// Calculate the new step.
+			c := w - delta[n-2]*dpsi - delta[n-1]*dphi
+			a := (delta[n-2]+delta[n-1])*w - delta[n-2]*delta[n-1]*(dpsi+dphi)
+			b := delta[n-2] * delta[n-1] * w
+			var eta float64
+			if a >= 0 {
+				eta = (a + math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+			} else {
+				eta = 2 * b / (a - math.Sqrt(math.Abs(a*a-4*b*c)))
+			}
+
+			// Note that eta should be positive if w is negative, and eta
+			// should be negative otherwise. However, if for some reason
+			// caused by roundoff eta*w > 0, we simply use one Newton step
+			// instead. This way will guarantee eta*w < 0.
+			if w*eta > 0 {
+				eta = -w / (dpsi + dphi)
+			}
+			temp := tau + eta
+			if dltub < temp || temp < dltlb {
+				if w < 0 {
+					eta = (dltub - tau) / 2
+				} else {
+					eta = (dltlb - tau) / 2
+				}
+			}
+			for j := 0; j < n; j++ {
+				delta[j] -= eta
+			}
+			tau += eta
+			w, dpsi, dphi, erretm = evaluate()
+		}
+
+		// Return with the iteration not converged.
+		return d[i] + tau, false
+	}
+
+	// The case i < n-1.
+	niter := 1
+	ip1 := i + 1
+
+	// Calculate the initial guess.
+	del := d[ip1] - d[i]
+	midpt := del / 2
+	for j := 0; j < n; j++ {
+		delta[j] = (d[j] - d[i]) - midpt
+	}
+	var psi float64
+	for j := 0; j < i; j++ {
+		psi += z[j] * z[j] / delta[j]
+	}
+	var phi float64
+	for j := n - 1; j >= i+2; j-- {
+		phi += z[j] * z[j] / delta[j]
+	}
+	c := rhoinv + psi + phi
+	w := c + z[i]*z[i]/delta[i] + z[ip1]*z[ip1]/delta[ip1]
+
+	var (
+		orgati       bool
+		tau          float64
+		dltlb, dltub float64
+	)
+	if w > 0 {
+		// d[i] < λ_i < (d[i]+d[i+1])/2.
+		//
+		// We choose d[i] as origin.
+		orgati = true
+		a := c*del + z[i]*z[i] + z[ip1]*z[ip1]
+		b := z[i] * z[i] * del
+		if a > 0 {
+			tau = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		} else {
+			tau = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		}
+		dltlb = 0
+		dltub = midpt
+	} else {
+		// (d[i]+d[i+1])/2 <= λ_i < d[i+1].
+		//
+		// We choose d[i+1] as origin.
+		orgati = false
+		a := c*del - z[i]*z[i] - z[ip1]*z[ip1]
+		b := z[ip1] * z[ip1] * del
+		if a < 0 {
+			tau = 2 * b / (a - math.Sqrt(math.Abs(a*a+4*b*c)))
+		} else {
+			tau = -(a + math.Sqrt(math.Abs(a*a+4*b*c))) / (2 * c)
+		}
+		dltlb = -midpt
+		dltub = 0
+	}
+
+	// origin is the pole chosen as the origin of the iteration.
+	var ii int
+	if orgati {
+		ii = i
+	} else {
+		ii = i + 1
+	}
+	origin := d[ii]
+	for j := 0; j < n; j++ {
+		delta[j] = (d[j] - origin) - tau
+	}
+	iim1 := ii - 1
+	iip1 := ii + 1
+
+	// evaluate returns the value of the secular function with its ii-th
+	// term removed and the derivatives of its two parts, and a partial bound
+	// on the rounding error.
+	evaluate := func() (w, psi, dpsi, phi, dphi, erretm float64) {
+		// Evaluate psi and the derivative dpsi.
+		for j := 0; j <= iim1; j++ {
+			temp := z[j] / delta[j]
+			psi += z[j] * temp
+			dpsi += temp * temp
+			erretm += psi
+		}
+		erretm = math.Abs(erretm)
+
+		// Evaluate phi and the derivative dphi.
+		for j := n - 1; j >= iip1; j-- {
+			temp := z[j] / delta[j]
+			phi += z[j] * temp
+			dphi += temp * temp
+			erretm += phi
+		}
+		w = rhoinv + phi + psi
+		return w, psi, dpsi, phi, dphi, erretm
+	}
+	w, psi, dpsi, phi, dphi, erretm := evaluate()
+
+	// w is the value of the secular function with its ii-th element removed.
+	swtch3 := false
+	if orgati {
+		if w < 0 {
+			swtch3 = true
+		}
+	} else {
+		if w > 0 {
+			swtch3 = true
+		}
+	}
+	if ii == 0 || ii == n-1 {
+		swtch3 = false
+	}
+
+	temp := z[ii] / delta[ii]
+	dw := dpsi + dphi + temp*temp
+	temp *= z[ii]
+	w += temp
+	erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau)*dw
+
+	// Test for convergence.
+	if math.Abs(w) <= eps*erretm {
+		return origin + tau, true
+	}
+
+	if w <= 0 {
+		dltlb = max(dltlb, tau)
+	} else {
+		dltub = min(dltub, tau)
+	}
+
+	// Calculate the new step.
+	niter++
+	var eta float64
+	var zz [3]float64
+	if !swtch3 {
+		if orgati {
+			c = w - delta[ip1]*dw - (d[i]-d[ip1])*(z[i]/delta[i])*(z[i]/delta[i])
+		} else {
+			c = w - delta[i]*dw - (d[ip1]-d[i])*(z[ip1]/delta[ip1])*(z[ip1]/delta[ip1])
+		}
+		a := (delta[i]+delta[ip1])*w - delta[i]*delta[ip1]*dw
+		b := delta[i] * delta[ip1] * w
+		switch {
+		case c == 0:
+			if a == 0 {
+				if orgati {
+					a = z[i]*z[i] + delta[ip1]*delta[ip1]*(dpsi+dphi)
+				} else {
+					a = z[ip1]*z[ip1] + delta[i]*delta[i]*(dpsi+dphi)
+				}
+			}
+			eta = b / a
+		case a <= 0:
+			eta = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		default:
+			eta = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		}
+	} else {
+		// Interpolation using the three most relevant poles.
+		temp := rhoinv + psi + phi
+		if orgati {
+			temp1 := z[iim1] / delta[iim1]
+			temp1 *= temp1
+			c = temp - delta[iip1]*(dpsi+dphi) - (d[iim1]-d[iip1])*temp1
+			zz[0] = z[iim1] * z[iim1]
+			zz[2] = delta[iip1] * delta[iip1] * ((dpsi - temp1) + dphi)
+		} else {
+			temp1 := z[iip1] / delta[iip1]
+			temp1 *= temp1
+			c = temp - delta[iim1]*(dpsi+dphi) - (d[iip1]-d[iim1])*temp1
+			zz[0] = delta[iim1] * delta[iim1] * (dpsi + (dphi - temp1))
+			zz[2] = z[iip1] * z[iip1]
+		}
+		zz[1] = z[ii] * z[ii]
+		eta, ok = impl.Dlaed6(niter, orgati, c, delta[iim1:], zz[:], w)
+		if !ok {
+			return origin + tau, false
+		}
+	}
+
+	// Note that eta should be positive if w is negative, and eta should be
+	// negative otherwise. However, if for some reason caused by roundoff
+	// eta*w > 0, we simply use one Newton step instead. This way will
+	// guarantee eta*w < 0.
+	if w*eta >= 0 {
+		eta = -w / dw
+	}
+	temp = tau + eta
+	if dltub < temp || temp < dltlb {
+		if w < 0 {
+			eta = (dltub - tau) / 2
+		} else {
+			eta = (dltlb - tau) / 2
+		}
+	}
+
+	prew := w
+
+	for j := 0; j < n; j++ {
+		delta[j] -= eta
+	}
+	w, psi, dpsi, phi, dphi, erretm = evaluate()
+	temp = z[ii] / delta[ii]
+	dw = dpsi + dphi + temp*temp
+	temp *= z[ii]
+	w += temp
+	erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau+eta)*dw
+
+	swtch := false
+	if orgati {
+		if -w > math.Abs(prew)/10 {
+			swtch = true
+		}
+	} else {
+		if w > math.Abs(prew)/10 {
+			swtch = true
+		}
+	}
+
+	tau += eta
+
+	// Main loop to update the values of the array delta.
+	for niter++; niter <= maxit; niter++ {
+		// Test for convergence.
+		if math.Abs(w) <= eps*erretm {
+			return origin + tau, true
+		}
+
+		if w <= 0 {
+			dltlb = max(dltlb, tau)
+		} else {
+			dltub = min(dltub, tau)
+		}
+
+		// Calculate the new step.
+		var eta float64
+		if !swtch3 {
+			var c float64
+			if !swtch {
+				if orgati {
+					c = w - delta[ip1]*dw - (d[i]-d[ip1])*(z[i]/delta[i])*(z[i]/delta[i])
+				} else {
+					c = w - delta[i]*dw - (d[ip1]-d[i])*(z[ip1]/delta[ip1])*(z[ip1]/delta[ip1])
+				}
+			} else {
+				temp := z[ii] / delta[ii]
+				if orgati {
+					dpsi += temp * temp
+				} else {
+					dphi += temp * temp
+				}
+				c = w - delta[i]*dpsi - delta[ip1]*dphi
+			}
+			a := (delta[i]+delta[ip1])*w - delta[i]*delta[ip1]*dw
+			b := delta[i] * delta[ip1] * w
+			switch {
+			case c == 0:
+				if a == 0 {
+					if !swtch {
+						if orgati {
+							a = z[i]*z[i] + delta[ip1]*delta[ip1]*(dpsi+dphi)
+						} else {
+							a = z[ip1]*z[ip1] + delta[i]*delta[i]*(dpsi+dphi)
+						}
+					} else {
+						a = delta[i]*delta[i]*dpsi + delta[ip1]*delta[ip1]*dphi
+					}
+				}
+				eta = b / a
+			case a <= 0:
+				eta = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+			default:
+				eta = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+			}
+		} else {
+			// Interpolation using the three most relevant poles.
+			var c float64
+			temp := rhoinv + psi + phi
+			if swtch {
+				c = temp - delta[iim1]*dpsi - delta[iip1]*dphi
+				zz[0] = delta[iim1] * delta[iim1] * dpsi
+				zz[2] = delta[iip1] * delta[iip1] * dphi
+			} else {
+				if orgati {
+					temp1 := z[iim1] / delta[iim1]
+					temp1 *= temp1
+					c = temp - delta[iip1]*(dpsi+dphi) - (d[iim1]-d[iip1])*temp1
+					zz[0] = z[iim1] * z[iim1]
+					zz[2] = delta[iip1] * delta[iip1] * ((dpsi - temp1) + dphi)
+				} else {
+					temp1 := z[iip1] / delta[iip1]
+					temp1 *= temp1
+					c = temp - delta[iim1]*(dpsi+dphi) - (d[iip1]-d[iim1])*temp1
+					zz[0] = delta[iim1] * delta[iim1] * (dpsi + (dphi - temp1))
+					zz[2] = z[iip1] * z[iip1]
+				}
+			}
+			eta, ok = impl.Dlaed6(niter, orgati, c, delta[iim1:], zz[:], w)
+			if !ok {
+				return origin + tau, false
+			}
+		}
+
+		// Note that eta should be positive if w is negative, and eta should
+		// be negative otherwise. However, if for some reason caused by
+		// roundoff eta*w > 0, we simply use one Newton step instead. This
+		// way will guarantee eta*w < 0.
+		if w*eta >= 0 {
+			eta = -w / dw
+		}
+		temp := tau + eta
+		if dltub < temp || temp < dltlb {
+			if w < 0 {
+				eta = (dltub - tau) / 2
+			} else {
+				eta = (dltlb - tau) / 2
+			}
+		}
+
+		for j := 0; j < n; j++ {
+			delta[j] -= eta
+		}
+		tau += eta
+		prew := w
+		w, psi, dpsi, phi, dphi, erretm = evaluate()
+		temp = z[ii] / delta[ii]
+		dw = dpsi + dphi + temp*temp
+		temp *= z[ii]
+		w += temp
+		erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau)*dw
+		if w*prew > 0 && math.Abs(w) > math.Abs(prew)/10 {
+			swtch = !swtch
+		}
+	}
+
+	// Return with the iteration not converged.
+	return origin + tau, false
+}
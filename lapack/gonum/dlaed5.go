@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaed5 computes the i-th eigenvalue of a symmetric rank-one modification of
+// a 2×2 diagonal matrix
+//
+//	diag(d) + rho * z * zᵀ.
+//
+// The diagonal elements in d are assumed to satisfy d[0] < d[1], rho is
+// assumed to be positive and the Euclidean norm of z is assumed to be one.
+//
+// i must be 0 or 1. d, z and delta must have length at least 2. On return,
+// delta contains the information necessary to construct the corresponding
+// eigenvector.
+//
+// Dlaed5 returns the computed eigenvalue.
+//
+// Dlaed5 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed5(i int, d, z, delta []float64, rho float64) (dlam float64) {
+	switch {
+	case i != 0 && i != 1:
+		panic(badI)
+	case len(d) < 2:
+		panic(shortD)
+	case len(z) < 2:
+		panic(shortZ)
+	case len(delta) < 2:
+		panic(shortDelta)
+	}
+
+	del := d[1] - d[0]
+	if i == 0 {
+		w := 1 + 2*rho*(z[1]*z[1]-z[0]*z[0])/del
+		if w > 0 {
+			b := del + rho*(z[0]*z[0]+z[1]*z[1])
+			c := rho * z[0] * z[0] * del
+			// b > 0, always.
+			tau := 2 * c / (b + math.Sqrt(math.Abs(b*b-4*c)))
+			dlam = d[0] + tau
+			delta[0] = -z[0] / tau
+			delta[1] = z[1] / (del - tau)
+		} else {
+			b := -del + rho*(z[0]*z[0]+z[1]*z[1])
+			c := rho * z[1] * z[1] * del
+			var tau float64
+			if b > 0 {
+				tau = -2 * c / (b + math.Sqrt(b*b+4*c))
+			} else {
+				tau = (b - math.Sqrt(b*b+4*c)) / 2
+			}
+			dlam = d[1] + tau
+			delta[0] = -z[0] / (del + tau)
+			delta[1] = -z[1] / tau
+		}
+	} else {
+		b := -del + rho*(z[0]*z[0]+z[1]*z[1])
+		c := rho * z[1] * z[1] * del
+		var tau float64
+		if b > 0 {
+			tau = (b + math.Sqrt(b*b+4*c)) / 2
+		} else {
+			tau = 2 * c / (-b + math.Sqrt(b*b+4*c))
+		}
+		dlam = d[1] + tau
+		delta[0] = -z[0] / (del + tau)
+		delta[1] = -z[1] / tau
+	}
+	temp := math.Hypot(delta[0], delta[1])
+	delta[0] /= temp
+	delta[1] /= temp
+	return dlam
+}
@@ -0,0 +1,245 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaed6 computes the positive or negative root closest to the origin of
+//
+//	f(x) = rho + z[0]/(d[0]-x) + z[1]/(d[1]-x) + z[2]/(d[2]-x).
+//
+// It is assumed that d[0] < d[1] < d[2] and that all elements of z are
+// positive. If orgati is true, the root is between d[1] and d[2], otherwise
+// it is between d[0] and d[1]. finit is the value of f at 0. kniter is the
+// number of iterations already performed by the caller and it is only used to
+// compute an initial guess when kniter == 2.
+//
+// Dlaed6 is used by Dlaed4 as a three pole interpolation step of the secular
+// equation solver. d and z must have length at least 3.
+//
+// Dlaed6 returns the computed root tau and whether the iteration converged.
+//
+// Dlaed6 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed6(kniter int, orgati bool, rho float64, d, z []float64, finit float64) (tau float64, ok bool) {
+	const maxit = 40
+
+	switch {
+	case len(d) < 3:
+		panic(shortD)
+	case len(z) < 3:
+		panic(shortZ)
+	}
+
+	var lbd, ubd float64
+	if orgati {
+		lbd = d[1]
+		ubd = d[2]
+	} else {
+		lbd = d[0]
+		ubd = d[1]
+	}
+	if finit < 0 {
+		lbd = 0
+	} else {
+		ubd = 0
+	}
+
+	if kniter == 2 {
+		var a, b, c float64
+		if orgati {
+			temp := (d[2] - d[1]) / 2
+			c = rho + z[0]/((d[0]-d[1])-temp)
+			a = c*(d[1]+d[2]) + z[1] + z[2]
+			b = c*d[1]*d[2] + z[1]*d[2] + z[2]*d[1]
+		} else {
+			temp := (d[0] - d[1]) / 2
+			c = rho + z[2]/((d[2]-d[1])-temp)
+			a = c*(d[0]+d[1]) + z[0] + z[1]
+			b = c*d[0]*d[1] + z[0]*d[1] + z[1]*d[0]
+		}
+		temp := max(math.Abs(a), math.Abs(b), math.Abs(c))
+		a /= temp
+		b /= temp
+		c /= temp
+		switch {
+		case c == 0:
+			tau = b / a
+		case a <= 0:
+			tau = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		default:
+			tau = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		}
+		if tau < lbd || ubd < tau {
+			tau = (lbd + ubd) / 2
+		}
+		if d[0] == tau || d[1] == tau || d[2] == tau {
+			tau = 0
+		} else {
+			temp := finit + tau*z[0]/(d[0]*(d[0]-tau)) +
+				tau*z[1]/(d[1]*(d[1]-tau)) +
+				tau*z[2]/(d[2]*(d[2]-tau))
+			if temp <= 0 {
+				lbd = tau
+			} else {
+				ubd = tau
+			}
+			if math.Abs(finit) <= math.Abs(temp) {
+				tau = 0
+			}
+		}
+	}
+
+	// Determine the machine parameters for the possible scaling to avoid
+	// overflow.
+	eps := dlamchE
+	small1 := math.Pow(dlamchB, float64(int(math.Log(dlamchS)/math.Log(dlamchB)/3)))
+	sminv1 := 1 / small1
+	small2 := small1 * small1
+	sminv2 := sminv1 * sminv1
+
+	// Determine whether scaling of inputs is necessary to avoid overflow when
+	// computing 1/temp^3.
+	var temp float64
+	if orgati {
+		temp = min(math.Abs(d[1]-tau), math.Abs(d[2]-tau))
+	} else {
+		temp = min(math.Abs(d[0]-tau), math.Abs(d[1]-tau))
+	}
+	var dscale, zscale [3]float64
+	scale := temp <= small1
+	var sclinv float64
+	if scale {
+		var sclfac float64
+		if temp <= small2 {
+			// Scale up by power of radix nearest 1/safmin^(2/3).
+			sclfac = sminv2
+			sclinv = small2
+		} else {
+			// Scale up by power of radix nearest 1/safmin^(1/3).
+			sclfac = sminv1
+			sclinv = small1
+		}
+		// Scaling up is safe because d, z and tau are scaled elsewhere to
+		// be O(1).
+		for i := range dscale {
+			dscale[i] = d[i] * sclfac
+			zscale[i] = z[i] * sclfac
+		}
+		tau *= sclfac
+		lbd *= sclfac
+		ubd *= sclfac
+	} else {
+		copy(dscale[:], d[:3])
+		copy(zscale[:], z[:3])
+	}
+
+	var fc, df, ddf float64
+	for i := range dscale {
+		temp := 1 / (dscale[i] - tau)
+		temp1 := zscale[i] * temp
+		temp2 := temp1 * temp
+		temp3 := temp2 * temp
+		fc += temp1 / dscale[i]
+		df += temp2
+		ddf += temp3
+	}
+	f := finit + tau*fc
+
+	if math.Abs(f) <= 0 {
+		if scale {
+			tau *= sclinv
+		}
+		return tau, true
+	}
+	if f <= 0 {
+		lbd = tau
+	} else {
+		ubd = tau
+	}
+
+	// Iteration begins using the Gragg-Thornton-Warner cubic convergent
+	// scheme.
+	//
+	// It is not hard to see that
+	//
+	//  1. iterations will go up monotonically if finit < 0,
+	//  2. iterations will go down monotonically if finit > 0.
+	for niter := 2; niter <= maxit; niter++ {
+		var temp1, temp2 float64
+		if orgati {
+			temp1 = dscale[1] - tau
+			temp2 = dscale[2] - tau
+		} else {
+			temp1 = dscale[0] - tau
+			temp2 = dscale[1] - tau
+		}
+		a := (temp1+temp2)*f - temp1*temp2*df
+		b := temp1 * temp2 * f
+		c := f - (temp1+temp2)*df + temp1*temp2*ddf
+		temp := max(math.Abs(a), math.Abs(b), math.Abs(c))
+		a /= temp
+		b /= temp
+		c /= temp
+		var eta float64
+		switch {
+		case c == 0:
+			eta = b / a
+		case a <= 0:
+			eta = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		default:
+			eta = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		}
+		if f*eta >= 0 {
+			eta = -f / df
+		}
+
+		tau += eta
+		if tau < lbd || ubd < tau {
+			tau = (lbd + ubd) / 2
+		}
+
+		fc = 0
+		erretm := 0.0
+		df = 0
+		ddf = 0
+		converged := false
+		for i := range dscale {
+			if dscale[i]-tau == 0 {
+				converged = true
+				break
+			}
+			temp := 1 / (dscale[i] - tau)
+			temp1 := zscale[i] * temp
+			temp2 := temp1 * temp
+			temp3 := temp2 * temp
+			temp4 := temp1 / dscale[i]
+			fc += temp4
+			erretm += math.Abs(temp4)
+			df += temp2
+			ddf += temp3
+		}
+		if converged {
+			ok = true
+			break
+		}
+		f = finit + tau*fc
+		erretm = 8*(math.Abs(finit)+math.Abs(tau)*erretm) + math.Abs(tau)*df
+		if math.Abs(f) <= 4*eps*erretm || ubd-lbd <= 4*eps*math.Abs(tau) {
+			ok = true
+			break
+		}
+		if f <= 0 {
+			lbd = tau
+		} else {
+			ubd = tau
+		}
+	}
+
+	// Undo scaling.
+	if scale {
+		tau *= sclinv
+	}
+	return tau, ok
+}
@@ -0,0 +1,121 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaneg computes the Sturm count, the number of negative pivots encountered
+// while factoring the tridiagonal matrix
+//
+//	T - sigma*I = L * D * Lᵀ - sigma*I
+//
+// using the twisted factorization with twist index r, where D is diagonal with
+// the elements d and L is unit lower bidiagonal with the subdiagonal elements
+// l. The factorization is computed by the stationary transform from the top
+// down to r and by the progressive transform from the bottom up to r. The
+// Sturm count is the number of eigenvalues of L*D*Lᵀ that are less than sigma.
+//
+// d must have length at least n and lld, containing the elements d[i]*l[i]*l[i],
+// must have length at least n-1. r must satisfy 0 <= r < n.
+//
+// The implementation is robust against zero pivots, and NaNs that arise from
+// the resulting infinities are detected and the affected blocks of the
+// factorization recomputed by a slower loop.
+//
+// Dlaneg is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaneg(n int, d, lld []float64, sigma float64, r int) (negcnt int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case n > 0 && (r < 0 || n <= r):
+		panic(badR)
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(lld) < n-1:
+		panic(shortLLD)
+	}
+
+	// Some architectures propagate infinities and NaNs slowly, so the loops
+	// are blocked and the NaN check is done once per block.
+	const blklen = 128
+
+	// Upper part: L D Lᵀ - sigma I = L+ D+ L+ᵀ.
+	t := -sigma
+	for bj := 0; bj < r; bj += blklen {
+		var neg1 int
+		bsav := t
+		for j := bj; j < min(bj+blklen, r); j++ {
+			dplus := d[j] + t
+			if dplus < 0 {
+				neg1++
+			}
+			tmp := t / dplus
+			t = tmp*lld[j] - sigma
+		}
+		if math.IsNaN(t) {
+			// Run a slower version of the above loop if a NaN is detected.
+			neg1 = 0
+			t = bsav
+			for j := bj; j < min(bj+blklen, r); j++ {
+				dplus := d[j] + t
+				if dplus < 0 {
+					neg1++
+				}
+				tmp := t / dplus
+				if math.IsNaN(tmp) {
+					tmp = 1
+				}
+				t = tmp*lld[j] - sigma
+			}
+		}
+		negcnt += neg1
+	}
+
+	// Lower part: L D Lᵀ - sigma I = U- D- U-ᵀ.
+	p := d[n-1] - sigma
+	for bj := n - 2; bj >= r; bj -= blklen {
+		var neg2 int
+		bsav := p
+		for j := bj; j >= max(bj-blklen+1, r); j-- {
+			dminus := lld[j] + p
+			if dminus < 0 {
+				neg2++
+			}
+			tmp := p / dminus
+			p = tmp*d[j] - sigma
+		}
+		if math.IsNaN(p) {
+			// Run a slower version of the above loop if a NaN is detected.
+			neg2 = 0
+			p = bsav
+			for j := bj; j >= max(bj-blklen+1, r); j-- {
+				dminus := lld[j] + p
+				if dminus < 0 {
+					neg2++
+				}
+				tmp := p / dminus
+				if math.IsNaN(tmp) {
+					tmp = 1
+				}
+				p = tmp*d[j] - sigma
+			}
+		}
+		negcnt += neg2
+	}
+
+	// Twist index. t was shifted by sigma initially.
+	gamma := (t + sigma) + p
+	if gamma < 0 {
+		negcnt++
+	}
+	return negcnt
+}
@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlar1v computes the scaled r-th column of the inverse of the submatrix in
+// rows b1 through bn of the tridiagonal matrix
+//
+//	L*D*Lᵀ - lambda*I.
+//
+// When lambda is close to an eigenvalue, the computed vector is an accurate
+// eigenvector. Usually, r corresponds to the index where the eigenvector is
+// largest in magnitude. The following steps accomplish this computation:
+//
+//  1. Stationary qd transform, L*D*Lᵀ - lambda*I = L₊*D₊*L₊ᵀ,
+//  2. Progressive qd transform, L*D*Lᵀ - lambda*I = U₋*D₋*U₋ᵀ,
+//  3. Computation of the diagonal elements of the inverse of
+//     L*D*Lᵀ - lambda*I from 1 and 2,
+//  4. Computation of the (scaled) r-th column of the inverse using the
+//     twisted factorization obtained by combining the top part of 1 and the
+//     bottom part of 2.
+//
+// d contains the n diagonal elements of D, l the n-1 subdiagonal elements of
+// L, ld the n-1 elements d[i]*l[i] and lld the n-1 elements d[i]*l[i]*l[i].
+// b1 and bn are the zero-based first and last indices of the submatrix.
+//
+// pivmin is the minimum pivot in the Sturm sequence and gaptol is the
+// tolerance that indicates when eigenvector entries are negligible with
+// respect to their contribution to the residual.
+//
+// On return, the elements b1 through bn of z contain the unnormalized
+// eigenvector and isuppz[0] and isuppz[1] the zero-based indices of the first
+// and last nonzero elements of z. z must have length at least n and isuppz at
+// least 2.
+//
+// If r < 0, the twist index is chosen as the index in [b1,bn] where the
+// diagonal of the inverse is largest in magnitude, otherwise r is used as the
+// twist index. The twist index is returned in rOut.
+//
+// If wantnc is true, negcnt returns the Sturm count, the number of negative
+// pivots encountered in the twisted factorization. Otherwise negcnt is -1.
+//
+// ztz is the square of the 2-norm of z, mingma the reciprocal of the largest
+// diagonal element of the inverse of L*D*Lᵀ - lambda*I, nrminv is 1/sqrt(ztz),
+// resid the residual of the FP vector, resid = |mingma|/sqrt(ztz), and rqcorr
+// the Rayleigh quotient correction to lambda, rqcorr = mingma/ztz.
+//
+// work must have length at least 4*n.
+//
+// Dlar1v is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlar1v(n, b1, bn int, lambda float64, d, l, ld, lld []float64, pivmin, gaptol float64, z []float64, wantnc bool, r int, isuppz []int, work []float64) (negcnt int, ztz, mingma float64, rOut int, nrminv, resid, rqcorr float64) {
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case b1 < 0 || n <= b1:
+		panic(badB1)
+	case bn < b1 || n <= bn:
+		panic(badBn)
+	case n <= r:
+		panic(badR)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(l) < n-1:
+		panic(shortL)
+	case len(ld) < n-1:
+		panic(shortLD)
+	case len(lld) < n-1:
+		panic(shortLLD)
+	case len(z) < n:
+		panic(shortZ)
+	case len(isuppz) < 2:
+		panic(shortIsuppz)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	eps := dlamchP
+
+	r1 := r
+	r2 := r
+	if r < 0 {
+		r1 = b1
+		r2 = bn
+	}
+
+	// The workspace holds L₊ and U₋ and the auxiliary quantities s and p
+	// of the differential qd transforms for each row.
+	lplus := work[:n]
+	uminus := work[n : 2*n]
+	sv := work[2*n : 3*n]
+	pv := work[3*n : 4*n]
+
+	if b1 == 0 {
+		sv[0] = 0
+	} else {
+		sv[b1] = lld[b1-1]
+	}
+
+	// Compute the stationary transform using the differential form until
+	// the index r2.
+	var neg1 int
+	s := sv[b1] - lambda
+	for i := b1; i < r1; i++ {
+		dplus := d[i] + s
+		lplus[i] = ld[i] / dplus
+		if dplus < 0 {
+			neg1++
+		}
+		sv[i+1] = s * lplus[i] * l[i]
+		s = sv[i+1] - lambda
+	}
+	sawnan1 := math.IsNaN(s)
+	if !sawnan1 {
+		for i := r1; i < r2; i++ {
+			dplus := d[i] + s
+			lplus[i] = ld[i] / dplus
+			sv[i+1] = s * lplus[i] * l[i]
+			s = sv[i+1] - lambda
+		}
+		sawnan1 = math.IsNaN(s)
+	}
+	if sawnan1 {
+		// Run a slower version of the above loop if a NaN is detected.
+		neg1 = 0
+		s = sv[b1] - lambda
+		for i := b1; i < r1; i++ {
+			dplus := d[i] + s
+			if math.Abs(dplus) < pivmin {
+				dplus = -pivmin
+			}
+			lplus[i] = ld[i] / dplus
+			if dplus < 0 {
+				neg1++
+			}
+			sv[i+1] = s * lplus[i] * l[i]
+			if lplus[i] == 0 {
+				sv[i+1] = lld[i]
+			}
+			s = sv[i+1] - lambda
+		}
+		for i := r1; i < r2; i++ {
+			dplus := d[i] + s
+			if math.Abs(dplus) < pivmin {
+				dplus = -pivmin
+			}
+			lplus[i] = ld[i] / dplus
+			sv[i+1] = s * lplus[i] * l[i]
+			if lplus[i] == 0 {
+				sv[i+1] = lld[i]
+			}
+			s = sv[i+1] - lambda
+		}
+	}
+
+	// Compute the progressive transform using the differential form until
+	// the index r1.
+	var neg2 int
+	pv[bn] = d[bn] - lambda
+	for i := bn - 1; i >= r1; i-- {
+		dminus := lld[i] + pv[i+1]
+		tmp := d[i] / dminus
+		if dminus < 0 {
+			neg2++
+		}
+		uminus[i] = l[i] * tmp
+		pv[i] = pv[i+1]*tmp - lambda
+	}
+	sawnan2 := math.IsNaN(pv[r1])
+	if sawnan2 {
+		// Run a slower version of the above loop if a NaN is detected.
+		neg2 = 0
+		for i := bn - 1; i >= r1; i-- {
+			dminus := lld[i] + pv[i+1]
+			if math.Abs(dminus) < pivmin {
+				dminus = -pivmin
+			}
+			tmp := d[i] / dminus
+			if dminus < 0 {
+				neg2++
+			}
+			uminus[i] = l[i] * tmp
+			pv[i] = pv[i+1]*tmp - lambda
+			if tmp == 0 {
+				pv[i] = d[i] - lambda
+			}
+		}
+	}
+
+	// Find the index from r1 to r2 of the largest in magnitude diagonal
+	// element of the inverse.
+	mingma = sv[r1] + pv[r1]
+	if mingma < 0 {
+		neg1++
+	}
+	negcnt = -1
+	if wantnc {
+		negcnt = neg1 + neg2
+	}
+	if mingma == 0 {
+		mingma = eps * sv[r1]
+	}
+	r = r1
+	for i := r1 + 1; i <= r2; i++ {
+		tmp := sv[i] + pv[i]
+		if tmp == 0 {
+			tmp = eps * sv[i]
+		}
+		if math.Abs(tmp) <= math.Abs(mingma) {
+			mingma = tmp
+			r = i
+		}
+	}
+
+	// Compute the FP vector by solving Nᵀ v = e_r.
+	isuppz[0] = b1
+	isuppz[1] = bn
+	z[r] = 1
+	ztz = 1
+
+	// Compute the FP vector upwards from r.
+	if !sawnan1 && !sawnan2 {
+		for i := r - 1; i >= b1; i-- {
+			z[i] = -(lplus[i] * z[i+1])
+			if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(ld[i]) < gaptol {
+				z[i] = 0
+				isuppz[0] = i + 1
+				break
+			}
+			ztz += z[i] * z[i]
+		}
+	} else {
+		// Run a slower loop if a NaN occurred.
+		for i := r - 1; i >= b1; i-- {
+			if z[i+1] == 0 {
+				z[i] = -(ld[i+1] / ld[i]) * z[i+2]
+			} else {
+				z[i] = -(lplus[i] * z[i+1])
+			}
+			if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(ld[i]) < gaptol {
+				z[i] = 0
+				isuppz[0] = i + 1
+				break
+			}
+			ztz += z[i] * z[i]
+		}
+	}
+
+	// Compute the FP vector downwards from r.
+	if !sawnan1 && !sawnan2 {
+		for i := r; i < bn; i++ {
+			z[i+1] = -(uminus[i] * z[i])
+			if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(ld[i]) < gaptol {
+				z[i+1] = 0
+				isuppz[1] = i
+				break
+			}
+			ztz += z[i+1] * z[i+1]
+		}
+	} else {
+		// Run a slower loop if a NaN occurred.
+		for i := r; i < bn; i++ {
+			if z[i] == 0 {
+				z[i+1] = -(ld[i-1] / ld[i]) * z[i-1]
+			} else {
+				z[i+1] = -(uminus[i] * z[i])
+			}
+			if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(ld[i]) < gaptol {
+				z[i+1] = 0
+				isuppz[1] = i
+				break
+			}
+			ztz += z[i+1] * z[i+1]
+		}
+	}
+
+	// Compute quantities for the convergence test.
+	tmp := 1 / ztz
+	nrminv = math.Sqrt(tmp)
+	resid = math.Abs(mingma) * nrminv
+	rqcorr = mingma * tmp
+	return negcnt, ztz, mingma, r, nrminv, resid, rqcorr
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarra computes the splitting points of a symmetric tridiagonal matrix T
+// with the specified threshold. Off-diagonal elements of T that are
+// sufficiently small are set to zero, so T splits into independent diagonal
+// blocks.
+//
+// d contains the n diagonal elements of T. e and e2 contain the n-1
+// off-diagonal elements of T and their squares, and on return the elements
+// corresponding to splits are set to zero.
+//
+// If spltol < 0, an absolute criterion |e[i]| <= |spltol|*tnrm is used where
+// tnrm is a norm of T. Otherwise the relative accuracy criterion
+// |e[i]| <= spltol*sqrt(|d[i]|)*sqrt(|d[i+1]|) is used.
+//
+// On return, the first nsplit elements of isplit contain the zero-based
+// indices of the last rows of the blocks. isplit must have length at least n.
+//
+// Dlarra is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarra(n int, d, e, e2 []float64, spltol, tnrm float64, isplit []int) (nsplit int) {
+	if n < 0 {
+		panic(nLT0)
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(e2) < n-1:
+		panic(shortE2)
+	case len(isplit) < n:
+		panic(shortIsplit)
+	}
+
+	if spltol < 0 {
+		// Criterion based on absolute off-diagonal value.
+		thresh := math.Abs(spltol) * tnrm
+		for i := 0; i < n-1; i++ {
+			if math.Abs(e[i]) <= thresh {
+				e[i] = 0
+				e2[i] = 0
+				isplit[nsplit] = i
+				nsplit++
+			}
+		}
+	} else {
+		// Criterion that guarantees relative accuracy.
+		for i := 0; i < n-1; i++ {
+			if math.Abs(e[i]) <= spltol*math.Sqrt(math.Abs(d[i]))*math.Sqrt(math.Abs(d[i+1])) {
+				e[i] = 0
+				e2[i] = 0
+				isplit[nsplit] = i
+				nsplit++
+			}
+		}
+	}
+	isplit[nsplit] = n - 1
+	nsplit++
+	return nsplit
+}
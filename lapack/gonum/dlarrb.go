@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarrb refines the eigenvalue approximations of L*D*Lᵀ by bisection.
+//
+// Given the relatively robust representation L*D*Lᵀ, where D is diagonal with
+// the n elements d and lld contains the n-1 elements d[i]*l[i]*l[i], Dlarrb
+// refines the eigenvalues with zero-based indices ifirst through ilast until
+// the width of the enclosing intervals is below max(rtol1*gap, rtol2*|λ|),
+// where gap is the distance to the neighboring eigenvalues.
+//
+// On entry, w[i-offset] must contain an approximation of the i-th eigenvalue
+// with the error bound werr[i-offset], and wgap[i-offset] the gap to the right
+// of it. On return, w, werr and wgap are updated for the refined eigenvalues.
+//
+// pivmin is the minimum pivot in the Sturm sequence and spdiam the spectral
+// diameter of the matrix. twist is the twist index for the twisted
+// factorization used to compute the Sturm count and it is set to n-1 if it
+// is out of range.
+//
+// work must have length at least 2*n and iwork must have length at least 2*n.
+//
+// Dlarrb is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrb(n int, d, lld []float64, ifirst, ilast int, rtol1, rtol2 float64, offset int, w, wgap, werr, work []float64, iwork []int, pivmin, spdiam float64, twist int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case n > 0 && (ifirst < 0 || n <= ifirst):
+		panic(badIfirst)
+	case n > 0 && (ilast < ifirst || n <= ilast):
+		panic(badIlast)
+	case n > 0 && (offset < 0 || ifirst < offset):
+		panic(badOffset)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(lld) < n-1:
+		panic(shortLLD)
+	case len(w) < ilast-offset+1:
+		panic(shortW)
+	case len(wgap) < ilast-offset+1:
+		panic(shortWgap)
+	case len(werr) < ilast-offset+1:
+		panic(shortWerr)
+	case len(work) < 2*n:
+		panic(shortWork)
+	case len(iwork) < 2*n:
+		panic(shortIWork)
+	}
+
+	// Markers for intervals in the linked list of unconverged intervals.
+	const (
+		converged = -1 // The interval has converged on entry.
+		refined   = -2 // The interval has been refined by bisection.
+	)
+
+	maxitr := int((math.Log(spdiam+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+	mnwdth := 2 * pivmin
+
+	r := twist
+	if r < 0 || n <= r {
+		r = n - 1
+	}
+
+	// Initialize the unconverged intervals in [work[2*i], work[2*i+1]]. The
+	// Sturm count at work[2*i] is arranged to be i, while the count at
+	// work[2*i+1] is stored in iwork[2*i+1]. For an unconverged interval,
+	// iwork[2*i] is set to the index of the next unconverged interval, so
+	// that a linked list of unconverged intervals is set up, and for a
+	// converged interval it holds a marker.
+	i1 := ifirst
+	var nint int // The number of unconverged intervals.
+	prev := -1   // The last unconverged interval found.
+	rgap := wgap[i1-offset]
+	for i := i1; i <= ilast; i++ {
+		ii := i - offset
+		left := w[ii] - werr[ii]
+		right := w[ii] + werr[ii]
+		lgap := rgap
+		rgap = wgap[ii]
+		gap := math.Min(lgap, rgap)
+
+		// Make sure that [left,right] contains the desired eigenvalue.
+		back := werr[ii]
+		for impl.Dlaneg(n, d, lld, left, r) > i {
+			left -= back
+			back *= 2
+		}
+		back = werr[ii]
+		negcnt := impl.Dlaneg(n, d, lld, right, r)
+		for negcnt <= i {
+			right += back
+			back *= 2
+			negcnt = impl.Dlaneg(n, d, lld, right, r)
+		}
+
+		width := 0.5 * math.Abs(left-right)
+		tmp := math.Max(math.Abs(left), math.Abs(right))
+		cvrgd := math.Max(rtol1*gap, rtol2*tmp)
+		if width <= cvrgd || width <= mnwdth {
+			// This interval has already converged and does not need
+			// refinement. The gaps might change through refining the
+			// eigenvalues, however, they can only get bigger. Remove
+			// it from the list.
+			iwork[2*i] = converged
+			// Make sure that i1 always points to the first unconverged
+			// interval.
+			if i == i1 && i < ilast {
+				i1 = i + 1
+			}
+			if prev >= i1 && i <= ilast {
+				iwork[2*prev] = i + 1
+			}
+		} else {
+			// Unconverged interval found.
+			prev = i
+			nint++
+			iwork[2*i] = i + 1
+			iwork[2*i+1] = negcnt
+		}
+		work[2*i] = left
+		work[2*i+1] = right
+	}
+
+	// Bisect while there are still unconverged intervals. In the last
+	// iteration, all intervals are accepted since this is the best that can
+	// be done.
+	for iter := 0; nint > 0 && iter <= maxitr; iter++ {
+		prev = i1 - 1
+		i := i1
+		olnint := nint
+		for ip := 0; ip < olnint; ip++ {
+			ii := i - offset
+			rgap := wgap[ii]
+			lgap := rgap
+			if ii > 0 {
+				lgap = wgap[ii-1]
+			}
+			gap := math.Min(lgap, rgap)
+			next := iwork[2*i]
+			left := work[2*i]
+			right := work[2*i+1]
+			mid := 0.5 * (left + right)
+
+			// Semiwidth of the interval.
+			width := right - mid
+			tmp := math.Max(math.Abs(left), math.Abs(right))
+			cvrgd := math.Max(rtol1*gap, rtol2*tmp)
+			if width <= cvrgd || width <= mnwdth || iter == maxitr {
+				// Reduce the number of unconverged intervals and mark the
+				// interval as refined.
+				nint--
+				iwork[2*i] = refined
+				if i1 == i {
+					i1 = next
+				} else if prev >= i1 {
+					// prev holds the last unconverged interval
+					// previously examined.
+					iwork[2*prev] = next
+				}
+				i = next
+				continue
+			}
+			prev = i
+
+			// Perform one bisection step.
+			if impl.Dlaneg(n, d, lld, mid, r) <= i {
+				work[2*i] = mid
+			} else {
+				work[2*i+1] = mid
+			}
+			i = next
+		}
+	}
+
+	// At this point, all the intervals have converged.
+	for i := ifirst; i <= ilast; i++ {
+		ii := i - offset
+		// All intervals marked as refined have been updated.
+		if iwork[2*i] == refined {
+			w[ii] = 0.5 * (work[2*i] + work[2*i+1])
+			werr[ii] = work[2*i+1] - w[ii]
+		}
+	}
+	for i := ifirst + 1; i <= ilast; i++ {
+		ii := i - offset
+		wgap[ii-1] = math.Max(0, w[ii]-werr[ii]-w[ii-1]-werr[ii-1])
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarrc computes the number of eigenvalues of a symmetric tridiagonal matrix
+// in the half-open interval (vl,vu]. The count is computed by the Sturm
+// sequence of the matrix shifted by vl and vu.
+//
+// If tridiag is true, d and e hold the diagonal and off-diagonal elements of
+// the tridiagonal matrix T. Otherwise d and e hold the diagonal elements of D
+// and the subdiagonal elements of L of the factorization L*D*Lᵀ. d must have
+// length at least n and e must have length at least n-1.
+//
+// pivmin is the minimum absolute value of a pivot in the Sturm sequence.
+// Pivots smaller in magnitude are replaced by -pivmin.
+//
+// Dlarrc returns the number of eigenvalues in (vl,vu] as eigcnt, and the
+// number of eigenvalues less than or equal to vl and to vu as lcnt and rcnt
+// respectively.
+//
+// Dlarrc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrc(tridiag bool, n int, vl, vu float64, d, e []float64, pivmin float64) (eigcnt, lcnt, rcnt int) {
+	if n < 0 {
+		panic(nLT0)
+	}
+
+	if n == 0 {
+		return 0, 0, 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	}
+
+	if tridiag {
+		// Sturm sequence count on T.
+		lpivot := d[0] - vl
+		rpivot := d[0] - vu
+		if math.Abs(lpivot) < pivmin {
+			lpivot = -pivmin
+		}
+		if math.Abs(rpivot) < pivmin {
+			rpivot = -pivmin
+		}
+		if lpivot <= 0 {
+			lcnt++
+		}
+		if rpivot <= 0 {
+			rcnt++
+		}
+		for i := 0; i < n-1; i++ {
+			tmp := e[i] * e[i]
+			lpivot = d[i+1] - vl - tmp/lpivot
+			rpivot = d[i+1] - vu - tmp/rpivot
+			if math.Abs(lpivot) < pivmin {
+				lpivot = -pivmin
+			}
+			if math.Abs(rpivot) < pivmin {
+				rpivot = -pivmin
+			}
+			if lpivot <= 0 {
+				lcnt++
+			}
+			if rpivot <= 0 {
+				rcnt++
+			}
+		}
+		return rcnt - lcnt, lcnt, rcnt
+	}
+
+	// Sturm sequence count on L D Lᵀ computed by the stationary qd transform.
+	sl := -vl
+	su := -vu
+	for i := 0; i < n-1; i++ {
+		lpivot := d[i] + sl
+		rpivot := d[i] + su
+		if math.Abs(lpivot) < pivmin {
+			lpivot = -pivmin
+		}
+		if math.Abs(rpivot) < pivmin {
+			rpivot = -pivmin
+		}
+		if lpivot <= 0 {
+			lcnt++
+		}
+		if rpivot <= 0 {
+			rcnt++
+		}
+		tmp := e[i] * d[i] * e[i]
+
+		tmp2 := tmp / lpivot
+		if tmp2 == 0 {
+			sl = tmp - vl
+		} else {
+			sl = sl*tmp2 - vl
+		}
+
+		tmp2 = tmp / rpivot
+		if tmp2 == 0 {
+			su = tmp - vu
+		} else {
+			su = su*tmp2 - vu
+		}
+	}
+	if d[n-1]+sl <= 0 {
+		lcnt++
+	}
+	if d[n-1]+su <= 0 {
+		rcnt++
+	}
+	return rcnt - lcnt, lcnt, rcnt
+}
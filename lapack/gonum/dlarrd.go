@@ -0,0 +1,359 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlarrd computes the eigenvalues of a symmetric tridiagonal matrix T to
+// suitable accuracy by bisection. The eigenvalues are returned ordered by
+// split-off block and, within each block, in ascending order.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	lapack.EVRangeAll:   all eigenvalues,
+//	lapack.EVRangeValue: the eigenvalues in the half-open interval (vl,vu],
+//	lapack.EVRangeIndex: the il-th through iu-th eigenvalues with zero-based
+//	                     indices, 0 <= il <= iu < n.
+//
+// gers must contain the Gerschgorin intervals of the rows of T so that the
+// i-th interval is [gers[2*i], gers[2*i+1]]. reltol is the minimum relative
+// width of an interval. d must contain the n diagonal elements of T and e2 the
+// n-1 squared off-diagonal elements. pivmin is the minimum pivot allowed in
+// the Sturm sequence.
+//
+// The first nsplit elements of isplit must contain the zero-based indices of
+// the last rows of the diagonal blocks of T as returned by Dlarra.
+//
+// On return, the first m elements of w contain the eigenvalue approximations,
+// werr their error bounds, iblock the zero-based indices of the blocks the
+// eigenvalues belong to and indexw their zero-based indices within the
+// blocks. w, werr, iblock and indexw must have length at least n.
+//
+// work must have length at least 4*n and iwork must have length at least 3*n.
+//
+// Dlarrd returns the number of computed eigenvalues m and, in wl and wu,
+// bounds of the interval (wl,wu] that contains the computed eigenvalues. ok is
+// false if the bisection did not converge or fewer than the requested number
+// of eigenvalues were found.
+//
+// Dlarrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrd(rng lapack.EVRange, n int, vl, vu float64, il, iu int, gers []float64, reltol float64, d, e2 []float64, pivmin float64, nsplit int, isplit []int, w, werr []float64, iblock, indexw []int, work []float64, iwork []int) (m int, wl, wu float64, ok bool) {
+	switch {
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vl >= vu:
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || max(0, n-1) < il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || n <= iu):
+		panic(badIu)
+	}
+
+	if n == 0 {
+		return 0, 0, 0, true
+	}
+
+	switch {
+	case len(gers) < 2*n:
+		panic(shortGers)
+	case len(d) < n:
+		panic(shortD)
+	case len(e2) < n-1:
+		panic(shortE2)
+	case nsplit < 1 || n < nsplit:
+		panic(badNsplit)
+	case len(isplit) < nsplit:
+		panic(shortIsplit)
+	case len(w) < n:
+		panic(shortW)
+	case len(werr) < n:
+		panic(shortWerr)
+	case len(iblock) < n:
+		panic(shortIblock)
+	case len(indexw) < n:
+		panic(shortIndexw)
+	case len(work) < 4*n:
+		panic(shortWork)
+	case len(iwork) < 3*n:
+		panic(shortIWork)
+	}
+
+	const fudge = 2
+
+	if rng == lapack.EVRangeIndex && il == 0 && iu == n-1 {
+		rng = lapack.EVRangeAll
+	}
+
+	eps := dlamchP
+	uflow := dlamchS
+
+	// Treat the case of a 1×1 matrix for quick return.
+	if n == 1 {
+		if rng == lapack.EVRangeAll ||
+			(rng == lapack.EVRangeValue && d[0] > vl && d[0] <= vu) ||
+			(rng == lapack.EVRangeIndex && il == 0 && iu == 0) {
+			w[0] = d[0]
+			werr[0] = 0
+			iblock[0] = 0
+			indexw[0] = 0
+			return 1, 0, 0, true
+		}
+		return 0, 0, 0, true
+	}
+
+	// Find the global spectral radius and compute the global Gerschgorin
+	// bounds.
+	gl := d[0]
+	gu := d[0]
+	for i := 0; i < n; i++ {
+		gl = math.Min(gl, gers[2*i])
+		gu = math.Max(gu, gers[2*i+1])
+	}
+	tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+	gl -= fudge*tnorm*eps*float64(n) + fudge*2*pivmin
+	gu += fudge*tnorm*eps*float64(n) + fudge*2*pivmin
+
+	// The absolute tolerance for interval convergence is very small so
+	// that convergence is determined by the relative width of the interval
+	// and strongly graded matrices get relatively accurate eigenvalues.
+	rtoli := reltol
+	atoli := fudge*2*uflow + fudge*2*pivmin
+
+	var wlu, wul float64
+	switch rng {
+	case lapack.EVRangeIndex:
+		// Compute an interval containing the eigenvalues il through iu. The
+		// initial interval [gl,gu] from the global Gerschgorin bounds is
+		// refined by Dlaebz.
+		itmax := int((math.Log(tnorm+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+		ab := work[n : n+4]
+		c := work[n+4 : n+6]
+		nab := iwork[:4]
+		nval := iwork[4:6]
+		ab[0], ab[1], ab[2], ab[3] = gl, gu, gl, gu
+		c[0], c[1] = gl, gu
+		nab[0], nab[1], nab[2], nab[3] = -1, n+1, -1, n+1
+		nval[0], nval[1] = il, iu+1
+		_, iinfo := impl.Dlaebz(3, itmax, n, 2, 2, atoli, rtoli, pivmin, d, e2, nval, ab, c, nab)
+		if iinfo != 0 {
+			return 0, 0, 0, false
+		}
+		var nwl, nwu int
+		if nval[1] == iu+1 {
+			wl, wlu, nwl = ab[0], ab[1], nab[0]
+			wu, wul, nwu = ab[3], ab[2], nab[3]
+		} else {
+			wl, wlu, nwl = ab[2], ab[3], nab[2]
+			wu, wul, nwu = ab[1], ab[0], nab[1]
+		}
+		if nwl < 0 || nwl >= n || nwu < 1 || nwu > n {
+			return 0, 0, 0, false
+		}
+	case lapack.EVRangeValue:
+		wl = vl
+		wu = vu
+	default:
+		wl = gl
+		wu = gu
+	}
+
+	// Find the eigenvalues in each block. nwl and nwu count the eigenvalues
+	// less than or equal to wl and wu.
+	var nwl, nwu int
+	ibegin := 0
+	for jblk := 0; jblk < nsplit; jblk++ {
+		iend := isplit[jblk]
+		in := iend - ibegin + 1
+
+		if in == 1 {
+			// 1×1 block.
+			if wl >= d[ibegin]-pivmin {
+				nwl++
+			}
+			if wu >= d[ibegin]-pivmin {
+				nwu++
+			}
+			if rng == lapack.EVRangeAll || (wl < d[ibegin]-pivmin && wu >= d[ibegin]-pivmin) {
+				w[m] = d[ibegin]
+				werr[m] = 0
+				iblock[m] = jblk
+				indexw[m] = 0
+				m++
+			}
+			ibegin = iend + 1
+			continue
+		}
+
+		// General case of a block of size in >= 2. Compute the local
+		// Gerschgorin interval and use it as the initial interval for
+		// Dlaebz.
+		gl := d[ibegin]
+		gu := d[ibegin]
+		for j := ibegin; j <= iend; j++ {
+			gl = math.Min(gl, gers[2*j])
+			gu = math.Max(gu, gers[2*j+1])
+		}
+		spdiam := gu - gl
+		gl -= fudge*spdiam*eps*float64(in) + fudge*pivmin
+		gu += fudge*spdiam*eps*float64(in) + fudge*pivmin
+
+		if rng != lapack.EVRangeAll {
+			if gu < wl {
+				// The local block contains none of the wanted eigenvalues.
+				nwl += in
+				nwu += in
+				ibegin = iend + 1
+				continue
+			}
+			// Refine the search interval if possible, only the range
+			// (wl,wu] matters.
+			gl = math.Max(gl, wl)
+			gu = math.Min(gu, wu)
+			if gl >= gu {
+				ibegin = iend + 1
+				continue
+			}
+		}
+
+		// Find the Sturm counts of the initial interval boundaries gl and gu.
+		ab := work[n : n+2*in]
+		c := work[n+2*in : n+3*in]
+		nab := iwork[:2*in]
+		ab[0] = gl
+		ab[1] = gu
+		im, _ := impl.Dlaebz(1, 0, in, in, 1, atoli, rtoli, pivmin, d[ibegin:], e2[ibegin:], nil, ab, c, nab)
+		nwl += nab[0]
+		nwu += nab[1]
+		iwoff := m - nab[0]
+
+		// Compute the eigenvalues.
+		itmax := int((math.Log(gu-gl+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+		iout, iinfo := impl.Dlaebz(2, itmax, in, in, 1, atoli, rtoli, pivmin, d[ibegin:], e2[ibegin:], nil, ab, c, nab)
+		if iinfo != 0 {
+			return 0, 0, 0, false
+		}
+
+		// Copy the eigenvalues into w and iblock. The eigenvalue
+		// approximation is the midpoint of the interval and the error bound
+		// is its half-width.
+		for j := 0; j < iout; j++ {
+			tmp1 := 0.5 * (ab[2*j] + ab[2*j+1])
+			tmp2 := 0.5 * math.Abs(ab[2*j]-ab[2*j+1])
+			for je := nab[2*j] + iwoff; je < nab[2*j+1]+iwoff; je++ {
+				w[je] = tmp1
+				werr[je] = tmp2
+				indexw[je] = je - iwoff
+				iblock[je] = jblk
+			}
+		}
+		m += im
+		ibegin = iend + 1
+	}
+
+	// If rng is lapack.EVRangeIndex, then (wl,wu] contains the eigenvalues
+	// nwl through nwu-1. Discard the extra eigenvalues.
+	if rng == lapack.EVRangeIndex {
+		idiscl := il - nwl
+		idiscu := nwu - (iu + 1)
+		if idiscl > 0 {
+			// Remove some of the smallest eigenvalues from the left.
+			var im int
+			for je := 0; je < m; je++ {
+				if w[je] <= wlu && idiscl > 0 {
+					idiscl--
+					continue
+				}
+				w[im] = w[je]
+				werr[im] = werr[je]
+				indexw[im] = indexw[je]
+				iblock[im] = iblock[je]
+				im++
+			}
+			m = im
+		}
+		if idiscu > 0 {
+			// Remove some of the largest eigenvalues from the right.
+			im := m
+			for je := m - 1; je >= 0; je-- {
+				if w[je] >= wul && idiscu > 0 {
+					idiscu--
+					continue
+				}
+				im--
+				w[im] = w[je]
+				werr[im] = werr[je]
+				indexw[im] = indexw[je]
+				iblock[im] = iblock[je]
+			}
+			copy(w, w[im:m])
+			copy(werr, werr[im:m])
+			copy(indexw, indexw[im:m])
+			copy(iblock, iblock[im:m])
+			m -= im
+		}
+
+		if idiscl > 0 || idiscu > 0 {
+			// Deal with effects of bad arithmetic: some low eigenvalues to
+			// be discarded are not in (wl,wlu], or high eigenvalues to be
+			// discarded are not in (wul,wu], so kill off the smallest
+			// idiscl and largest idiscu eigenvalues by marking their blocks
+			// with -1.
+			const killed = -1
+			if idiscl > 0 {
+				wkill := wu
+				for jdisc := 0; jdisc < idiscl; jdisc++ {
+					iw := -1
+					for je := 0; je < m; je++ {
+						if iblock[je] != killed && (w[je] < wkill || iw == -1) {
+							iw = je
+							wkill = w[je]
+						}
+					}
+					iblock[iw] = killed
+				}
+			}
+			if idiscu > 0 {
+				wkill := wl
+				for jdisc := 0; jdisc < idiscu; jdisc++ {
+					iw := -1
+					for je := 0; je < m; je++ {
+						if iblock[je] != killed && (w[je] >= wkill || iw == -1) {
+							iw = je
+							wkill = w[je]
+						}
+					}
+					iblock[iw] = killed
+				}
+			}
+			// Erase all eigenvalues with killed blocks.
+			var im int
+			for je := 0; je < m; je++ {
+				if iblock[je] == killed {
+					continue
+				}
+				w[im] = w[je]
+				werr[im] = werr[je]
+				indexw[im] = indexw[je]
+				iblock[im] = iblock[je]
+				im++
+			}
+			m = im
+		}
+		if idiscl < 0 || idiscu < 0 {
+			return m, wl, wu, false
+		}
+	}
+
+	if (rng == lapack.EVRangeAll && m != n) || (rng == lapack.EVRangeIndex && m != iu-il+1) {
+		return m, wl, wu, false
+	}
+	return m, wl, wu, true
+}
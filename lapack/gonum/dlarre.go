@@ -0,0 +1,527 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlarre finds, for each unreduced block Tᵢ of a symmetric tridiagonal matrix
+// T, a shift σᵢ and a base representation Lᵢ*Dᵢ*Lᵢᵀ = Tᵢ - σᵢ*I with high
+// relative accuracy, and it computes suitable approximations of the wanted
+// eigenvalues of Lᵢ*Dᵢ*Lᵢᵀ. The eigenvalues are computed by dqds if all or a
+// large fraction of them are wanted and by bisection otherwise.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	lapack.EVRangeAll:   all eigenvalues,
+//	lapack.EVRangeValue: the eigenvalues in the half-open interval (vl,vu],
+//	lapack.EVRangeIndex: the il-th through iu-th eigenvalues with zero-based
+//	                     indices, 0 <= il <= iu < n.
+//
+// On entry, d contains the n diagonal elements of T and e the n-1
+// off-diagonal elements. On return, d contains the diagonal elements of the
+// Dᵢ, e[0:n-1] the subdiagonal elements of the unit bidiagonal Lᵢ, and the
+// element of e at the last row of each block contains the corresponding shift
+// σᵢ. e must have length at least n. e2 contains the n-1 squared off-diagonal
+// elements of T and it is overwritten. Off-diagonal elements that are set to
+// zero by the splitting criterion spltol are also zeroed in e2.
+//
+// rtol1 and rtol2 are the relative and absolute tolerances used for the
+// bisection of the eigenvalues.
+//
+// On return, the first nsplit elements of isplit contain the zero-based
+// indices of the last rows of the blocks. The first m elements of w contain
+// the eigenvalue approximations of the Lᵢ*Dᵢ*Lᵢᵀ in ascending order within
+// blocks, werr their error bounds, wgap the gaps to the next eigenvalues,
+// iblock the zero-based indices of the blocks and indexw the zero-based
+// indices of the eigenvalues within the blocks. gers contains the Gerschgorin
+// intervals of the rows of T and pivmin is the minimum pivot used in the
+// Sturm sequences. w, werr, wgap, iblock, indexw and isplit must have length
+// at least n and gers at least 2*n.
+//
+// work must have length at least 6*n and iwork must have length at least 5*n.
+//
+// Dlarre returns the interval (vl,vu] that contains the wanted eigenvalues,
+// the number of blocks nsplit, the number of computed eigenvalues m, pivmin
+// and whether the computation succeeded.
+//
+// Dlarre is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarre(rng lapack.EVRange, n int, vl, vu float64, il, iu int, d, e, e2 []float64, rtol1, rtol2, spltol float64, isplit []int, w, werr, wgap []float64, iblock, indexw []int, gers, work []float64, iwork []int) (vlOut, vuOut float64, nsplit, m int, pivmin float64, ok bool) {
+	switch {
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vl >= vu:
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || max(0, n-1) < il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || n <= iu):
+		panic(badIu)
+	}
+
+	if n == 0 {
+		return vl, vu, 0, 0, 0, true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n:
+		panic(shortE)
+	case len(e2) < n-1:
+		panic(shortE2)
+	case len(isplit) < n:
+		panic(shortIsplit)
+	case len(w) < n:
+		panic(shortW)
+	case len(werr) < n:
+		panic(shortWerr)
+	case len(wgap) < n:
+		panic(shortWgap)
+	case len(iblock) < n:
+		panic(shortIblock)
+	case len(indexw) < n:
+		panic(shortIndexw)
+	case len(gers) < 2*n:
+		panic(shortGers)
+	case len(work) < 6*n:
+		panic(shortWork)
+	case len(iwork) < 5*n:
+		panic(shortIWork)
+	}
+
+	const (
+		fac       = 0.5
+		maxgrowth = 64
+		fudge     = 2
+		maxtry    = 6
+		pert      = 8
+	)
+
+	safmin := dlamchS
+	eps := dlamchP
+
+	rtl := math.Sqrt(eps)
+	bsrtol := math.Sqrt(eps)
+
+	// Treat the case of a 1×1 matrix for quick return.
+	if n == 1 {
+		if rng == lapack.EVRangeAll ||
+			(rng == lapack.EVRangeValue && d[0] > vl && d[0] <= vu) ||
+			(rng == lapack.EVRangeIndex && il == 0 && iu == 0) {
+			m = 1
+			w[0] = d[0]
+			// The computation error of the eigenvalue is zero.
+			werr[0] = 0
+			wgap[0] = 0
+			iblock[0] = 0
+			indexw[0] = 0
+			gers[0] = d[0]
+			gers[1] = d[0]
+		}
+		// Store the shift for the initial RRR, which is zero in this case.
+		e[0] = 0
+		isplit[0] = 0
+		return vl, vu, 1, m, safmin, true
+	}
+
+	// Compute the Gerschgorin intervals and the spectral diameter, and the
+	// maximum off-diagonal entry and pivmin.
+	gl := d[0]
+	gu := d[0]
+	var eold, emax float64
+	e[n-1] = 0
+	for i := 0; i < n; i++ {
+		werr[i] = 0
+		wgap[i] = 0
+		eabs := math.Abs(e[i])
+		if eabs >= emax {
+			emax = eabs
+		}
+		tmp1 := eabs + eold
+		gers[2*i] = d[i] - tmp1
+		gl = math.Min(gl, gers[2*i])
+		gers[2*i+1] = d[i] + tmp1
+		gu = math.Max(gu, gers[2*i+1])
+		eold = eabs
+	}
+	// The minimum pivot allowed in the Sturm sequence for T.
+	pivmin = safmin * math.Max(1, emax*emax)
+	// Compute the spectral diameter. The Gerschgorin bounds give an
+	// estimate that is wrong by at most a factor of sqrt(2).
+	spdiam := gu - gl
+
+	// Compute the splitting points.
+	nsplit = impl.Dlarra(n, d, e, e2, spltol, spdiam, isplit)
+
+	// dqds is used if all eigenvalues are wanted.
+	usedqd := rng == lapack.EVRangeAll
+
+	var mm int
+	if rng == lapack.EVRangeAll {
+		// Set the interval (vl,vu] that contains all eigenvalues.
+		vl = gl
+		vu = gu
+	} else {
+		// Find crude approximations to the eigenvalues in the desired
+		// range. If rng is lapack.EVRangeIndex, also obtain the interval
+		// (vl,vu] that contains all the wanted eigenvalues.
+		var ok bool
+		mm, vl, vu, ok = impl.Dlarrd(rng, n, vl, vu, il, iu, gers, bsrtol, d, e2, pivmin, nsplit, isplit, w, werr, iblock, indexw, work, iwork)
+		if !ok {
+			return vl, vu, nsplit, 0, pivmin, false
+		}
+		// Make sure that the entries mm through n-1 are zero.
+		for i := mm; i < n; i++ {
+			w[i] = 0
+			werr[i] = 0
+			iblock[i] = 0
+			indexw[i] = 0
+		}
+	}
+
+	// Loop over the unreduced blocks.
+	ibegin := 0
+	wbegin := 0
+	for jblk := 0; jblk < nsplit; jblk++ {
+		iend := isplit[jblk]
+		in := iend - ibegin + 1
+
+		if in == 1 {
+			// 1×1 block.
+			if rng == lapack.EVRangeAll ||
+				(rng == lapack.EVRangeValue && d[ibegin] > vl && d[ibegin] <= vu) ||
+				(rng == lapack.EVRangeIndex && wbegin < mm && iblock[wbegin] == jblk) {
+				w[m] = d[ibegin]
+				werr[m] = 0
+				// The gap for a single block does not matter for the later
+				// algorithm.
+				wgap[m] = 0
+				iblock[m] = jblk
+				indexw[m] = 0
+				m++
+				wbegin++
+			}
+			// e[iend] holds the shift for the initial RRR.
+			e[iend] = 0
+			ibegin = iend + 1
+			continue
+		}
+
+		// Blocks of size larger than 1×1. e[iend] will hold the shift for the
+		// initial RRR, for now set it to zero.
+		e[iend] = 0
+
+		// Find the local outer bounds gl and gu for the block.
+		gl := d[ibegin]
+		gu := d[ibegin]
+		for i := ibegin; i <= iend; i++ {
+			gl = math.Min(gers[2*i], gl)
+			gu = math.Max(gers[2*i+1], gu)
+		}
+		spdiam := gu - gl
+
+		var mb, wend, indl, indu int
+		if rng != lapack.EVRangeAll {
+			// Count the number of eigenvalues in the current block.
+			for i := wbegin; i < mm && iblock[i] == jblk; i++ {
+				mb++
+			}
+			if mb == 0 {
+				// No eigenvalue in the current block lies in the desired
+				// range.
+				ibegin = iend + 1
+				continue
+			}
+			// Decide whether dqds or bisection is more efficient.
+			usedqd = float64(mb) > fac*float64(in)
+			wend = wbegin + mb - 1
+			// Calculate the gaps for the current block.
+			for i := wbegin; i < wend; i++ {
+				wgap[i] = math.Max(0, w[i+1]-werr[i+1]-(w[i]+werr[i]))
+			}
+			// The gap of the last eigenvalue is bounded by the Gerschgorin
+			// interval so that a distant vu does not limit the accuracy of
+			// the refinement by bisection.
+			wgap[wend] = math.Max(0, math.Min(vu, gu)-(w[wend]+werr[wend]))
+			// Find the local indices of the first and last desired
+			// eigenvalues.
+			indl = indexw[wbegin]
+			indu = indexw[wend]
+		}
+
+		var isleft, isrght float64
+		if usedqd {
+			// Find approximations to the extremal eigenvalues of the block.
+			tmp, tmp1, ok := impl.Dlarrk(in, 0, gl, gu, d[ibegin:], e2[ibegin:], pivmin, rtl)
+			if !ok {
+				return vl, vu, nsplit, m, pivmin, false
+			}
+			isleft = math.Max(gl, tmp-tmp1-100*eps*math.Abs(tmp-tmp1))
+
+			tmp, tmp1, ok = impl.Dlarrk(in, in-1, gl, gu, d[ibegin:], e2[ibegin:], pivmin, rtl)
+			if !ok {
+				return vl, vu, nsplit, m, pivmin, false
+			}
+			isrght = math.Min(gu, tmp+tmp1+100*eps*math.Abs(tmp+tmp1))
+			// Improve the estimate of the spectral diameter.
+			spdiam = isrght - isleft
+		} else {
+			// Find approximations to the wanted extremal eigenvalues.
+			isleft = math.Max(gl, w[wbegin]-werr[wbegin]-100*eps*math.Abs(w[wbegin]-werr[wbegin]))
+			isrght = math.Min(gu, w[wend]+werr[wend]+100*eps*math.Abs(w[wend]+werr[wend]))
+		}
+
+		// Decide whether the base representation for the current block
+		//  L_jblk D_jblk L_jblkᵀ = T_jblk - sigma_jblk I
+		// should be on the left or the right end of the current block. The
+		// strategy is to shift to the end which is more populated.
+		var s1, s2 float64
+		switch {
+		case rng == lapack.EVRangeAll:
+			// All the eigenvalues have to be computed, so use dqds.
+			indl = 0
+			indu = in - 1
+			mb = in
+			wend = wbegin + mb - 1
+			// Define the 1/4 and 3/4 points of the spectrum.
+			s1 = isleft + 0.25*spdiam
+			s2 = isrght - 0.25*spdiam
+		case usedqd:
+			s1 = isleft + 0.25*spdiam
+			s2 = isrght - 0.25*spdiam
+		default:
+			tmp := math.Min(isrght, vu) - math.Max(isleft, vl)
+			s1 = math.Max(isleft, vl) + 0.25*tmp
+			s2 = math.Min(isrght, vu) - 0.25*tmp
+		}
+
+		// Compute the Sturm counts at the 1/4 and 3/4 points and choose
+		// the initial shift sigma.
+		var sigma, sgndef float64
+		var cnt1, cnt2 int
+		if mb > 1 {
+			_, cnt1, cnt2 = impl.Dlarrc(true, in, s1, s2, d[ibegin:], e[ibegin:], pivmin)
+		}
+		switch {
+		case mb == 1:
+			sigma = gl
+			sgndef = 1
+		case cnt1-(indl+1) >= (indu+1)-cnt2:
+			switch {
+			case rng == lapack.EVRangeAll:
+				sigma = math.Max(isleft, gl)
+			case usedqd:
+				// Use the Gerschgorin bound as shift to get a positive
+				// definite matrix for dqds.
+				sigma = isleft
+			default:
+				// Use the approximation of the first desired eigenvalue of
+				// the block as shift.
+				sigma = math.Max(isleft, vl)
+			}
+			sgndef = 1
+		default:
+			switch {
+			case rng == lapack.EVRangeAll:
+				sigma = math.Min(isrght, gu)
+			case usedqd:
+				// Use the Gerschgorin bound as shift to get a negative
+				// definite matrix for dqds.
+				sigma = isrght
+			default:
+				// Use the approximation of the last desired eigenvalue of
+				// the block as shift.
+				sigma = math.Min(isrght, vu)
+			}
+			sgndef = -1
+		}
+
+		// An initial sigma has been chosen that will be used for computing
+		// T - sigma I = L D Lᵀ. Define the increment tau of the shift in
+		// case the initial shift needs to be refined to obtain a
+		// factorization with not too much element growth.
+		var tau float64
+		switch {
+		case usedqd:
+			// The initial sigma was to the outer end of the spectrum, the
+			// matrix is definite and there is no need to retreat.
+			tau = spdiam*eps*float64(n) + 2*pivmin
+			tau = math.Max(tau, 2*eps*math.Abs(sigma))
+		case mb > 1:
+			clwdth := w[wend] + werr[wend] - w[wbegin] - werr[wbegin]
+			avgap := math.Abs(clwdth / float64(wend-wbegin))
+			if sgndef == 1 {
+				tau = 0.5 * math.Max(wgap[wbegin], avgap)
+				tau = math.Max(tau, werr[wbegin])
+			} else {
+				tau = 0.5 * math.Max(wgap[wend-1], avgap)
+				tau = math.Max(tau, werr[wend])
+			}
+		default:
+			tau = werr[wbegin]
+		}
+
+		// Compute the L D Lᵀ factorization of T - sigma I. D is stored in
+		// work[:in] and L in work[in:2*in-1].
+		var found bool
+		for idum := 0; idum < maxtry; idum++ {
+			dpivot := d[ibegin] - sigma
+			work[0] = dpivot
+			dmax := math.Abs(work[0])
+			for i := 0; i < in-1; i++ {
+				j := ibegin + i
+				tmp := e[j] / work[i]
+				work[in+i] = tmp
+				dpivot = (d[j+1] - sigma) - tmp*e[j]
+				work[i+1] = dpivot
+				dmax = math.Max(dmax, math.Abs(dpivot))
+			}
+			// Check for element growth.
+			norep := dmax > maxgrowth*spdiam
+			if usedqd && !norep {
+				// Ensure the definiteness of the representation. All
+				// entries of D must have the same sign.
+				for i := 0; i < in; i++ {
+					if sgndef*work[i] < 0 {
+						norep = true
+						break
+					}
+				}
+			}
+			if !norep {
+				// An initial RRR is found.
+				found = true
+				break
+			}
+			// In the case of all eigenvalues, the Gerschgorin shift makes
+			// the matrix definite, so this point should only be reached for
+			// the value and index ranges.
+			if idum == maxtry-2 {
+				// The fudged Gerschgorin shift should succeed.
+				if sgndef == 1 {
+					sigma = gl - fudge*spdiam*eps*float64(n) - fudge*2*pivmin
+				} else {
+					sigma = gu + fudge*spdiam*eps*float64(n) + fudge*2*pivmin
+				}
+			} else {
+				sigma -= sgndef * tau
+				tau *= 2
+			}
+		}
+		if !found {
+			// No base representation could be found in maxtry iterations.
+			return vl, vu, nsplit, m, pivmin, false
+		}
+
+		// An initial base representation T - sigma I = L D Lᵀ with not too
+		// much element growth has been found. Store the shift, D and L.
+		e[iend] = sigma
+		copy(d[ibegin:iend+1], work[:in])
+		copy(e[ibegin:iend], work[in:2*in-1])
+
+		if mb > 1 {
+			// Perturb each entry of the base representation by a small
+			// multiple of its own size. The perturbation is deterministic
+			// so that the results are reproducible.
+			rnd := rand.New(rand.NewPCG(1, 1))
+			for i := 0; i < 2*in-1; i++ {
+				work[i] = 2*float64(rnd.Uint32())/(1<<32) - 1
+			}
+			for i := 0; i < in-1; i++ {
+				d[ibegin+i] *= 1 + eps*pert*work[i]
+				e[ibegin+i] *= 1 + eps*pert*work[in+i]
+			}
+			d[iend] *= 1 + eps*4*work[in-1]
+		}
+
+		// The Gerschgorin intervals are not updated because keeping track of
+		// the updates would be too much work in Dlarrv. w is updated instead
+		// and it is used to locate the proper Gerschgorin intervals.
+
+		// Compute the required eigenvalues of L D Lᵀ by bisection or dqds.
+		if !usedqd {
+			// Dlarrd has been used, so shift the eigenvalue approximations
+			// according to their representation. This is necessary for a
+			// uniform Dlarrv since dqds computes the eigenvalues of the
+			// shifted representation.
+			for j := wbegin; j <= wend; j++ {
+				w[j] -= sigma
+				werr[j] += math.Abs(w[j]) * eps
+			}
+			// Use bisection to reduce the error of the approximations from
+			// Dlarrd.
+			for i := ibegin; i < iend; i++ {
+				work[i] = d[i] * e[i] * e[i]
+			}
+			impl.Dlarrb(in, d[ibegin:], work[ibegin:], indl, indu, rtol1, rtol2, indl, w[wbegin:], wgap[wbegin:], werr[wbegin:], work[2*n:], iwork, pivmin, spdiam, in-1)
+			// Dlarrb computes all gaps correctly except for the last one, so
+			// record the distance to vu.
+			wgap[wend] = math.Max(0, (vu-sigma)-(w[wend]+werr[wend]))
+			for i := indl; i <= indu; i++ {
+				iblock[m] = jblk
+				indexw[m] = i
+				m++
+			}
+		} else {
+			// Call dqds to get all eigenvalues and then possibly delete
+			// the unwanted ones. dqds finds the eigenvalues of the L D Lᵀ
+			// representation to high relative accuracy. rtol is an estimate
+			// of the error of dqds; the worst case bound of 4*n*eps is
+			// usually too large and requires unnecessary work to be done by
+			// bisection when computing the eigenvectors.
+			rtol := math.Log(float64(in)) * 4 * eps
+			for i := 0; i < in-1; i++ {
+				j := ibegin + i
+				work[2*i] = math.Abs(d[j])
+				work[2*i+1] = e[j] * e[j] * work[2*i]
+			}
+			work[2*in-2] = math.Abs(d[iend])
+			work[2*in-1] = 0
+			if impl.Dlasq2(in, work) != 0 {
+				return vl, vu, nsplit, m, pivmin, false
+			}
+			// Test that all eigenvalues are positive as expected.
+			for i := 0; i < in; i++ {
+				if work[i] < 0 {
+					return vl, vu, nsplit, m, pivmin, false
+				}
+			}
+			// The eigenvalues are returned by Dlasq2 in descending order.
+			if sgndef > 0 {
+				for i := indl; i <= indu; i++ {
+					w[m] = work[in-1-i]
+					iblock[m] = jblk
+					indexw[m] = i
+					m++
+				}
+			} else {
+				for i := indl; i <= indu; i++ {
+					w[m] = -work[i]
+					iblock[m] = jblk
+					indexw[m] = i
+					m++
+				}
+			}
+			for i := m - mb; i < m; i++ {
+				werr[i] = rtol * math.Abs(w[i])
+			}
+			for i := m - mb; i < m-1; i++ {
+				// Compute the right gap between the intervals.
+				wgap[i] = math.Max(0, w[i+1]-werr[i+1]-(w[i]+werr[i]))
+			}
+			wgap[m-1] = math.Max(0, (vu-sigma)-(w[m-1]+werr[m-1]))
+		}
+		// Proceed with the next block.
+		ibegin = iend + 1
+		wbegin = wend + 1
+	}
+	return vl, vu, nsplit, m, pivmin, true
+}
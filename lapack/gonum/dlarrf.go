@@ -0,0 +1,249 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarrf finds a new relatively robust representation
+//
+//	L*D*Lᵀ - sigma*I = L₊*D₊*L₊ᵀ
+//
+// such that at least one of the eigenvalues of L₊*D₊*L₊ᵀ is relatively
+// isolated, given the initial representation L*D*Lᵀ and its cluster of close
+// eigenvalues with zero-based indices clstrt through clend in a list of
+// eigenvalues w.
+//
+// d contains the n diagonal elements of D, l the n-1 subdiagonal elements of
+// L and ld the n-1 elements d[i]*l[i].
+//
+// w, wgap and werr contain the eigenvalue approximations of L*D*Lᵀ, the gaps
+// to the right of them and their error bounds. spdiam is the spectral diameter
+// of L*D*Lᵀ and clgapl and clgapr the absolute gaps on each end of the
+// cluster. pivmin is the minimum pivot in the Sturm sequence.
+//
+// On return, dplus contains the n diagonal elements of D₊ and lplus the n-1
+// subdiagonal elements of L₊. work must have length at least 2*n.
+//
+// Dlarrf returns the shift sigma. If none of the investigated shifts leads to
+// acceptable element growth, the shift with the smallest growth is used.
+//
+// Dlarrf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrf(n int, d, l, ld []float64, clstrt, clend int, w, wgap, werr []float64, spdiam, clgapl, clgapr, pivmin float64, dplus, lplus, work []float64) (sigma float64) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case clstrt < 0:
+		panic(badClstrt)
+	case clend <= clstrt:
+		panic(badClend)
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(l) < n-1:
+		panic(shortL)
+	case len(ld) < n-1:
+		panic(shortLD)
+	case len(w) <= clend:
+		panic(shortW)
+	case len(wgap) <= clend:
+		panic(shortWgap)
+	case len(werr) <= clend:
+		panic(shortWerr)
+	case len(dplus) < n:
+		panic(shortDplus)
+	case len(lplus) < n-1:
+		panic(shortLplus)
+	case len(work) < 2*n:
+		panic(shortWork)
+	}
+
+	const (
+		maxgrowth1 = 8
+		maxgrowth2 = 8
+		ktrymax    = 1
+		fact       = 1 << ktrymax
+	)
+
+	eps := dlamchP
+
+	// The factorizations with a small or even moderate element growth
+	// cannot be guaranteed for any of the shifts tried, so pivmin is used to
+	// ensure that at least the L*D*Lᵀ factorization exists.
+
+	// Compute the average gap length of the cluster.
+	clwdth := math.Abs(w[clend]-w[clstrt]) + werr[clend] + werr[clstrt]
+	avgap := clwdth / float64(clend-clstrt)
+	mingap := math.Min(clgapl, clgapr)
+
+	// Initial values for shifts to both ends of the cluster. Use a small
+	// fudge to make sure that they really are outside.
+	lsigma := math.Min(w[clstrt], w[clend]) - werr[clstrt]
+	rsigma := math.Max(w[clstrt], w[clend]) + werr[clend]
+	lsigma -= math.Abs(lsigma) * 4 * eps
+	rsigma += math.Abs(rsigma) * 4 * eps
+
+	// Compute upper bounds for how much to back off the initial shifts.
+	ldmax := 0.25*mingap + 2*pivmin
+	rdmax := 0.25*mingap + 2*pivmin
+
+	ldelta := math.Max(avgap, wgap[clstrt]) / fact
+	rdelta := math.Max(avgap, wgap[clend-1]) / fact
+
+	// Initialize the record of the best representation found.
+	smlgrowth := 1 / dlamchS
+	fail2 := float64(n-1) * mingap / (spdiam * math.Sqrt(eps))
+	bestshift := lsigma
+
+	growthbound := maxgrowth1 * spdiam
+
+	// The representation shifted to the right end of the cluster is
+	// computed in the workspace.
+	rdplus := work[:n]
+	rlplus := work[n : 2*n]
+
+	var forcer bool
+	var ktry int
+	for {
+		// Ensure that the initial shifts are not backed off too much.
+		ldelta = math.Min(ldmax, ldelta)
+		rdelta = math.Min(rdmax, rdelta)
+
+		// Compute the element growth when shifting to both ends of the
+		// cluster and accept the shift if there is no element growth at one
+		// of the two ends.
+
+		// Left end. The refined RRR test must not be used if a pivot had to
+		// be replaced by pivmin, so sawnan1 is set in that case.
+		var sawnan1 bool
+		s := -lsigma
+		dplus[0] = d[0] + s
+		if math.Abs(dplus[0]) < pivmin {
+			dplus[0] = -pivmin
+			sawnan1 = true
+		}
+		max1 := math.Abs(dplus[0])
+		for i := 0; i < n-1; i++ {
+			lplus[i] = ld[i] / dplus[i]
+			s = s*lplus[i]*l[i] - lsigma
+			dplus[i+1] = d[i+1] + s
+			if math.Abs(dplus[i+1]) < pivmin {
+				dplus[i+1] = -pivmin
+				sawnan1 = true
+			}
+			max1 = math.Max(max1, math.Abs(dplus[i+1]))
+		}
+		sawnan1 = sawnan1 || math.IsNaN(max1)
+
+		if forcer || (max1 <= growthbound && !sawnan1) {
+			return lsigma
+		}
+
+		// Right end.
+		var sawnan2 bool
+		s = -rsigma
+		rdplus[0] = d[0] + s
+		if math.Abs(rdplus[0]) < pivmin {
+			rdplus[0] = -pivmin
+			sawnan2 = true
+		}
+		max2 := math.Abs(rdplus[0])
+		for i := 0; i < n-1; i++ {
+			rlplus[i] = ld[i] / rdplus[i]
+			s = s*rlplus[i]*l[i] - rsigma
+			rdplus[i+1] = d[i+1] + s
+			if math.Abs(rdplus[i+1]) < pivmin {
+				rdplus[i+1] = -pivmin
+				sawnan2 = true
+			}
+			max2 = math.Max(max2, math.Abs(rdplus[i+1]))
+		}
+		sawnan2 = sawnan2 || math.IsNaN(max2)
+
+		if max2 <= growthbound && !sawnan2 {
+			copy(dplus, rdplus)
+			copy(lplus[:n-1], rlplus[:n-1])
+			return rsigma
+		}
+
+		// Both shifts led to too much element growth. Record the better of
+		// the two shifts provided it did not lead to NaN.
+		if !sawnan1 || !sawnan2 {
+			var indx int
+			if !sawnan1 {
+				indx = 1
+				if max1 <= smlgrowth {
+					smlgrowth = max1
+					bestshift = lsigma
+				}
+			}
+			if !sawnan2 {
+				if sawnan1 || max2 <= max1 {
+					indx = 2
+				}
+				if max2 <= smlgrowth {
+					smlgrowth = max2
+					bestshift = rsigma
+				}
+			}
+
+			// If the element growth is moderate, the representation may
+			// still be accepted if it passes a refined test for RRR. This
+			// test supposes that no NaN occurred and it is used only for
+			// isolated clusters.
+			if clwdth < mingap/128 && math.Min(max1, max2) < fail2 && !sawnan1 && !sawnan2 {
+				dp, lp := dplus, lplus
+				if indx == 2 {
+					dp, lp = rdplus, rlplus
+				}
+				tmp := math.Abs(dp[n-1])
+				znm2 := 1.0
+				prod := 1.0
+				oldp := 1.0
+				for i := n - 2; i >= 0; i-- {
+					if prod <= eps {
+						prod = ((dp[i+1] * lp[i+1]) / (dp[i] * lp[i])) * oldp
+					} else {
+						prod *= math.Abs(lp[i])
+					}
+					oldp = prod
+					znm2 += prod * prod
+					tmp = math.Max(tmp, math.Abs(dp[i]*prod))
+				}
+				rrr := tmp / (spdiam * math.Sqrt(znm2))
+				if rrr <= maxgrowth2 {
+					if indx == 1 {
+						return lsigma
+					}
+					copy(dplus, rdplus)
+					copy(lplus[:n-1], rlplus[:n-1])
+					return rsigma
+				}
+			}
+		}
+
+		if ktry < ktrymax {
+			// Both shifts failed also the RRR test. Back off to the
+			// outside.
+			lsigma = math.Max(lsigma-ldelta, lsigma-ldmax)
+			rsigma = math.Min(rsigma+rdelta, rsigma+rdmax)
+			ldelta *= 2
+			rdelta *= 2
+			ktry++
+			continue
+		}
+
+		// None of the representations investigated satisfied the criteria.
+		// Take the best one found.
+		lsigma = bestshift
+		rsigma = bestshift
+		forcer = true
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarrj refines the eigenvalue approximations of a symmetric tridiagonal
+// matrix T by bisection.
+//
+// d contains the n diagonal elements of T and e2 the n-1 squared off-diagonal
+// elements. Dlarrj refines the eigenvalues with zero-based indices ifirst
+// through ilast until the width of the enclosing intervals is below
+// rtol*|λ|.
+//
+// On entry, w[i-offset] must contain an approximation of the i-th eigenvalue
+// with the error bound werr[i-offset]. On return, w and werr are updated for
+// the refined eigenvalues.
+//
+// pivmin is the minimum pivot in the Sturm sequence and spdiam the spectral
+// diameter of T.
+//
+// work must have length at least 2*n and iwork must have length at least 2*n.
+//
+// Dlarrj is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrj(n int, d, e2 []float64, ifirst, ilast int, rtol float64, offset int, w, werr, work []float64, iwork []int, pivmin, spdiam float64) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case n > 0 && (ifirst < 0 || n <= ifirst):
+		panic(badIfirst)
+	case n > 0 && (ilast < ifirst || n <= ilast):
+		panic(badIlast)
+	case n > 0 && (offset < 0 || ifirst < offset):
+		panic(badOffset)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e2) < n-1:
+		panic(shortE2)
+	case len(w) < ilast-offset+1:
+		panic(shortW)
+	case len(werr) < ilast-offset+1:
+		panic(shortWerr)
+	case len(work) < 2*n:
+		panic(shortWork)
+	case len(iwork) < 2*n:
+		panic(shortIWork)
+	}
+
+	// Markers for intervals in the linked list of unconverged intervals.
+	const (
+		converged = -1 // The interval has converged on entry.
+		refined   = -2 // The interval has been refined by bisection.
+	)
+
+	// sturm returns the number of eigenvalues of T less than s.
+	sturm := func(s float64) int {
+		var cnt int
+		dplus := d[0] - s
+		if dplus < 0 {
+			cnt++
+		}
+		for j := 1; j < n; j++ {
+			dplus = d[j] - s - e2[j-1]/dplus
+			if dplus < 0 {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	maxitr := int((math.Log(spdiam+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+
+	// Initialize the unconverged intervals in [work[2*i], work[2*i+1]]. The
+	// Sturm count at work[2*i] is arranged to be i, while the count at
+	// work[2*i+1] is stored in iwork[2*i+1]. For an unconverged interval,
+	// iwork[2*i] is set to the index of the next unconverged interval, so
+	// that a linked list of unconverged intervals is set up, and for a
+	// converged interval it holds a marker.
+	i1 := ifirst
+	i2 := ilast
+	var nint int // The number of unconverged intervals.
+	prev := -1   // The last unconverged interval found.
+	for i := i1; i <= i2; i++ {
+		ii := i - offset
+		left := w[ii] - werr[ii]
+		mid := w[ii]
+		right := w[ii] + werr[ii]
+		width := right - mid
+		tmp := math.Max(math.Abs(left), math.Abs(right))
+
+		if width < rtol*tmp {
+			// This interval has already converged and does not need
+			// refinement.
+			iwork[2*i] = converged
+			// Make sure that i1 always points to the first unconverged
+			// interval.
+			if i == i1 && i < i2 {
+				i1 = i + 1
+			}
+			if prev >= i1 && i <= i2 {
+				iwork[2*prev] = i + 1
+			}
+		} else {
+			// Unconverged interval found.
+			prev = i
+			// Make sure that [left,right] contains the desired eigenvalue.
+			fac := 1.0
+			for sturm(left) > i {
+				left -= werr[ii] * fac
+				fac *= 2
+			}
+			fac = 1
+			cnt := sturm(right)
+			for cnt <= i {
+				right += werr[ii] * fac
+				fac *= 2
+				cnt = sturm(right)
+			}
+			nint++
+			iwork[2*i] = i + 1
+			iwork[2*i+1] = cnt
+		}
+		work[2*i] = left
+		work[2*i+1] = right
+	}
+
+	savi1 := i1
+
+	// Bisect while there are still unconverged intervals. In the last
+	// iteration, all intervals are accepted since this is the best that can
+	// be done.
+	for iter := 0; nint > 0 && iter <= maxitr; iter++ {
+		prev = i1 - 1
+		i := i1
+		olnint := nint
+		for p := 0; p < olnint; p++ {
+			next := iwork[2*i]
+			left := work[2*i]
+			right := work[2*i+1]
+			mid := 0.5 * (left + right)
+
+			// Semiwidth of the interval.
+			width := right - mid
+			tmp := math.Max(math.Abs(left), math.Abs(right))
+			if width < rtol*tmp || iter == maxitr {
+				// Reduce the number of unconverged intervals and mark the
+				// interval as refined.
+				nint--
+				iwork[2*i] = refined
+				if i1 == i {
+					i1 = next
+				} else if prev >= i1 {
+					iwork[2*prev] = next
+				}
+				i = next
+				continue
+			}
+			prev = i
+
+			// Perform one bisection step.
+			if sturm(mid) <= i {
+				work[2*i] = mid
+			} else {
+				work[2*i+1] = mid
+			}
+			i = next
+		}
+	}
+
+	// At this point, all the intervals have converged.
+	for i := savi1; i <= ilast; i++ {
+		ii := i - offset
+		// All intervals marked as refined have been updated.
+		if iwork[2*i] == refined {
+			w[ii] = 0.5 * (work[2*i] + work[2*i+1])
+			werr[ii] = work[2*i+1] - w[ii]
+		}
+	}
+}
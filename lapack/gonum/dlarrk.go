@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarrk computes one eigenvalue of a symmetric tridiagonal matrix T to
+// suitable accuracy by bisection.
+//
+// iw is the zero-based index of the wanted eigenvalue in ascending order and
+// must satisfy 0 <= iw < n. gl and gu are lower and upper bounds on the
+// spectrum of T.
+//
+// d must contain the n diagonal elements of T and e2 the n-1 squared
+// off-diagonal elements. pivmin is the minimum pivot allowed in the Sturm
+// sequence and reltol is the minimum relative width of the returned interval.
+//
+// Dlarrk returns the approximate eigenvalue w and the error bound werr such
+// that the eigenvalue lies in [w-werr, w+werr]. ok is false if the bisection
+// did not converge.
+//
+// Dlarrk is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrk(n, iw int, gl, gu float64, d, e2 []float64, pivmin, reltol float64) (w, werr float64, ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case n > 0 && (iw < 0 || n <= iw):
+		panic(badIw)
+	}
+
+	if n == 0 {
+		return 0, 0, true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e2) < n-1:
+		panic(shortE2)
+	}
+
+	const fudge = 2
+
+	eps := dlamchP
+	tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+	rtoli := reltol
+	atoli := fudge * 2 * pivmin
+	itmax := int((math.Log(tnorm+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+
+	left := gl - fudge*tnorm*eps*float64(n) - fudge*2*pivmin
+	right := gu + fudge*tnorm*eps*float64(n) + fudge*2*pivmin
+
+	for it := 0; ; it++ {
+		// Check if the interval has converged or the maximum number of
+		// iterations has been reached.
+		tmp1 := math.Abs(right - left)
+		tmp2 := math.Max(math.Abs(right), math.Abs(left))
+		if tmp1 < math.Max(math.Max(atoli, pivmin), rtoli*tmp2) {
+			ok = true
+			break
+		}
+		if it > itmax {
+			break
+		}
+
+		// Count the number of negative pivots for the midpoint.
+		mid := 0.5 * (left + right)
+		var negcnt int
+		tmp1 = d[0] - mid
+		if math.Abs(tmp1) < pivmin {
+			tmp1 = -pivmin
+		}
+		if tmp1 <= 0 {
+			negcnt++
+		}
+		for i := 1; i < n; i++ {
+			tmp1 = d[i] - e2[i-1]/tmp1 - mid
+			if math.Abs(tmp1) < pivmin {
+				tmp1 = -pivmin
+			}
+			if tmp1 <= 0 {
+				negcnt++
+			}
+		}
+
+		if negcnt > iw {
+			right = mid
+		} else {
+			left = mid
+		}
+	}
+
+	// Converged or maximum number of iterations reached.
+	w = 0.5 * (left + right)
+	werr = 0.5 * math.Abs(right-left)
+	return w, werr, ok
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlarrr performs tests to decide whether the symmetric tridiagonal matrix T
+// with diagonal d and off-diagonal e warrants expensive computations which
+// guarantee high relative accuracy in the eigenvalues.
+//
+// Dlarrr returns true if T is scaled diagonally dominant so that its
+// eigenvalues are determined to high relative accuracy by its entries.
+//
+// Dlarrr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrr(n int, d, e []float64) bool {
+	if n < 0 {
+		panic(nLT0)
+	}
+
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	}
+
+	const relcond = 0.999
+
+	// Test for scaled diagonal dominance. Let D be the diagonal of T and set
+	// D^{-1/2} * T * D^{-1/2} = I + offdiag. If the 2-norm of offdiag is less
+	// than one, the matrix is scaled diagonally dominant and relative
+	// accuracy in the eigenvalues is guaranteed.
+	safmin := dlamchS
+	eps := dlamchP
+	rmin := math.Sqrt(safmin / eps)
+	tmp := math.Sqrt(math.Abs(d[0]))
+	if tmp < rmin {
+		return false
+	}
+	var offdig float64
+	for i := 1; i < n; i++ {
+		tmp2 := math.Sqrt(math.Abs(d[i]))
+		if tmp2 < rmin {
+			return false
+		}
+		offdig2 := math.Abs(e[i-1]) / (tmp * tmp2)
+		if offdig+offdig2 >= relcond {
+			return false
+		}
+		tmp = tmp2
+		offdig = offdig2
+	}
+	return true
+}
@@ -0,0 +1,551 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Dlarrv computes the eigenvectors of the symmetric tridiagonal matrix T
+// given the relatively robust representations L*D*Lᵀ of its unreduced blocks
+// and the approximations of its eigenvalues computed by Dlarre.
+//
+// vl and vu are the bounds of the interval containing the wanted eigenvalues
+// returned by Dlarre. On entry, d contains the n diagonal elements of the
+// Dᵢ and l the n-1 subdiagonal elements of the Lᵢ, where the element of l at
+// the last row of each block contains the shift σᵢ of the representation.
+// Both d and l are overwritten. pivmin is the minimum pivot allowed in the
+// Sturm sequences. The first elements of isplit contain the zero-based
+// indices of the last rows of the blocks.
+//
+// m is the number of eigenvalues. minrgp is the relative gap threshold that
+// decides whether eigenvalues are in a cluster, and rtol1 and rtol2 are the
+// relative and absolute tolerances of the bisection of the eigenvalues.
+//
+// On entry, the first m elements of w contain the eigenvalue approximations
+// of the L*D*Lᵀ in ascending order within blocks, werr their error bounds,
+// wgap the gaps to the next eigenvalues, iblock the zero-based indices of the
+// blocks and indexw the zero-based indices of the eigenvalues within the
+// blocks. gers contains the Gerschgorin intervals of the rows of T. On return,
+// w contains the refined eigenvalues of T, and werr and wgap are updated.
+//
+// On return, z contains the orthonormal eigenvectors of T in its first m
+// columns and the support of the i-th eigenvector is in the rows isuppz[2*i]
+// through isuppz[2*i+1] of z. z must have n rows and ldz >= max(1,m), and
+// isuppz must have length at least 2*m.
+//
+// work must have length at least 12*n and iwork must have length at least
+// 7*n.
+//
+// Dlarrv returns whether all eigenvectors were computed.
+//
+// Dlarrv is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarrv(n int, vl, vu float64, d, l []float64, pivmin float64, isplit []int, m int, minrgp, rtol1, rtol2 float64, w, werr, wgap []float64, iblock, indexw []int, gers, z []float64, ldz int, isuppz []int, work []float64, iwork []int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0 || n < m:
+		panic(badM)
+	case ldz < max(1, m):
+		panic(badLdZ)
+	}
+
+	if n == 0 || m == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(l) < n:
+		panic(shortL)
+	case len(isplit) < n:
+		panic(shortIsplit)
+	case len(w) < m:
+		panic(shortW)
+	case len(werr) < m:
+		panic(shortWerr)
+	case len(wgap) < m:
+		panic(shortWgap)
+	case len(iblock) < m:
+		panic(shortIblock)
+	case len(indexw) < m:
+		panic(shortIndexw)
+	case len(gers) < 2*n:
+		panic(shortGers)
+	case len(z) < (n-1)*ldz+m:
+		panic(shortZ)
+	case len(isuppz) < 2*m:
+		panic(shortIsuppz)
+	case len(work) < 12*n:
+		panic(shortWork)
+	case len(iwork) < 7*n:
+		panic(shortIWork)
+	}
+
+	const maxitr = 10
+
+	// The first n elements of work are reserved for the eigenvalues of the
+	// current representations, followed by d*l and d*l*l of the current
+	// representation and the workspace for the auxiliary routines. zbuf
+	// holds the eigenvector being computed and dplus and lplus a new
+	// representation computed by Dlarrf.
+	const (
+		indld  = 1
+		indlld = 2
+		indwrk = 3
+	)
+	wrep := work[:n]
+	ld := work[indld*n : 2*n]
+	lld := work[indlld*n : 3*n]
+	wrk := work[indwrk*n : 7*n]
+	zbuf := work[7*n : 8*n]
+	dplus := work[8*n : 9*n]
+	lplus := work[9*n : 10*n]
+	for i := range work[:12*n] {
+		work[i] = 0
+	}
+
+	// iwork[iindr:iindr+n] hold the twist indices for the factorizations
+	// used to compute the FP vectors. A negative twist index means that it
+	// has not been chosen yet. iwork[iindc1:iindc1+n] and
+	// iwork[iindc2:iindc2+n] store the clusters of the current layer and
+	// the one above.
+	const (
+		iindr  = 0
+		iindc1 = 1
+		iindc2 = 2
+		iindwk = 3
+	)
+	twist := iwork[iindr*n : iindc1*n]
+	iwrk := iwork[iindwk*n : 7*n]
+	for i := range iwork[:7*n] {
+		iwork[i] = 0
+	}
+	for i := range twist {
+		twist[i] = -1
+	}
+
+	impl.Dlaset(blas.All, n, m, 0, 0, z, ldz)
+
+	eps := dlamchP
+	rqtol := 2 * eps
+
+	// The elements wbegin through wend of w, werr and wgap correspond to the
+	// desired eigenvalues of the current block. The support of the nonzero
+	// eigenvector elements is contained in the rows ibegin through iend.
+	ibegin := 0
+	wbegin := 0
+	for jblk := 0; jblk <= iblock[m-1]; jblk++ {
+		iend := isplit[jblk]
+		sigma := l[iend]
+
+		// Find the eigenvectors of the submatrix indexed ibegin through
+		// iend.
+		wend := wbegin - 1
+		for wend < m-1 && iblock[wend+1] == jblk {
+			wend++
+		}
+		if wend < wbegin {
+			ibegin = iend + 1
+			continue
+		}
+
+		// Find the local spectral diameter of the block.
+		gl := gers[2*ibegin]
+		gu := gers[2*ibegin+1]
+		for i := ibegin + 1; i <= iend; i++ {
+			gl = math.Min(gers[2*i], gl)
+			gu = math.Max(gers[2*i+1], gu)
+		}
+		spdiam := gu - gl
+
+		// The size of the current block and the number of eigenvalues in
+		// it.
+		in := iend - ibegin + 1
+		im := wend - wbegin + 1
+
+		// 1×1 block.
+		if ibegin == iend {
+			z[ibegin*ldz+wbegin] = 1
+			isuppz[2*wbegin] = ibegin
+			isuppz[2*wbegin+1] = ibegin
+			w[wbegin] += sigma
+			wrep[wbegin] = w[wbegin]
+			ibegin = iend + 1
+			wbegin++
+			continue
+		}
+
+		// The desired shifted eigenvalues are stored in wrep. They can be
+		// approximations with the uncertainty given by werr and they are
+		// refined when necessary as high relative accuracy is required for
+		// the computation of the corresponding eigenvectors. w stores the
+		// eigenvalue approximations with respect to the original matrix T.
+		copy(wrep[wbegin:wend+1], w[wbegin:wend+1])
+		for i := wbegin; i <= wend; i++ {
+			w[i] += sigma
+		}
+
+		// ndepth is the current depth of the representation tree and nclus
+		// the number of clusters for its next level, starting with one for
+		// the root.
+		ndepth := 0
+		parity := 1
+		nclus := 1
+		iwork[iindc1*n] = 0
+		iwork[iindc1*n+1] = im - 1
+
+		// idone is the number of eigenvectors already computed in the
+		// current block. Generate the representation tree for the current
+		// block and compute the eigenvectors.
+		idone := 0
+		for idone < im {
+			// This is a crude protection against infinitely deep trees.
+			if ndepth > m {
+				return false
+			}
+
+			// Breadth first processing of the current level of the
+			// representation tree. oldncl is the number of clusters on the
+			// current level.
+			oldncl := nclus
+			nclus = 0
+			parity = 1 - parity
+			oldcls := iindc1 * n
+			newcls := iindc2 * n
+			if parity != 0 {
+				oldcls, newcls = newcls, oldcls
+			}
+
+			// Process the clusters on the current level.
+			for i := 0; i < oldncl; i++ {
+				// oldfst and oldlst are the first and last indices of the
+				// current cluster relative to wbegin.
+				oldfst := iwork[oldcls+2*i]
+				oldlst := iwork[oldcls+2*i+1]
+				if ndepth > 0 {
+					// Retrieve the relatively robust representation of the
+					// cluster that has been computed at the previous level.
+					// It is stored in the columns of z at the location of
+					// the leftmost eigenvalue of the cluster, and it is
+					// overwritten once the eigenvectors have been computed
+					// or when the cluster is refined.
+					j := wbegin + oldfst
+					for k := 0; k < in; k++ {
+						d[ibegin+k] = z[(ibegin+k)*ldz+j]
+						l[ibegin+k] = z[(ibegin+k)*ldz+j+1]
+						z[(ibegin+k)*ldz+j] = 0
+						z[(ibegin+k)*ldz+j+1] = 0
+					}
+					sigma = l[iend]
+				}
+
+				// Compute d*l and d*l*l of the current representation.
+				for j := ibegin; j < iend; j++ {
+					tmp := d[j] * l[j]
+					ld[j] = tmp
+					lld[j] = tmp * l[j]
+				}
+
+				if ndepth > 0 {
+					// p and q are the indices of the first and last
+					// eigenvalues to compute within the current block.
+					p := indexw[wbegin+oldfst]
+					q := indexw[wbegin+oldlst]
+					// Use offset for the arrays wrep, wgap and werr so that
+					// their elements p-offset through q-offset are used.
+					offset := indexw[wbegin]
+					// Perform limited bisection if necessary to get
+					// approximate eigenvalues to the needed precision.
+					impl.Dlarrb(in, d[ibegin:], lld[ibegin:], p, q, rtol1, rtol2, offset, wrep[wbegin:], wgap[wbegin:], werr[wbegin:], wrk, iwrk, pivmin, spdiam, in-1)
+					// Recompute the extremal gaps. w holds all eigenvalues
+					// of the unshifted matrix and must be used for the
+					// computation of wgap since the elements of wrep might
+					// stem from representations with different shifts. The
+					// gaps inside the cluster are correctly computed by
+					// Dlarrb, and the gaps are only allowed to become larger
+					// since this is what should happen when werr decreases.
+					if oldfst > 0 {
+						k := wbegin + oldfst
+						wgap[k-1] = math.Max(wgap[k-1], w[k]-werr[k]-w[k-1]-werr[k-1])
+					}
+					if wbegin+oldlst < wend {
+						k := wbegin + oldlst
+						wgap[k] = math.Max(wgap[k], w[k+1]-werr[k+1]-w[k]-werr[k])
+					}
+					// Each time the eigenvalues in wrep get refined, store
+					// the newly found approximation with all shifts applied
+					// in w.
+					for j := oldfst; j <= oldlst; j++ {
+						w[wbegin+j] = wrep[wbegin+j] + sigma
+					}
+				}
+
+				// Process the current node.
+				newfst := oldfst
+				for j := oldfst; j <= oldlst; j++ {
+					// The child cluster newfst through newlst ends either at
+					// the right end of the current cluster or where the
+					// right relative gap is big enough.
+					if j != oldlst && wgap[wbegin+j] < minrgp*math.Abs(wrep[wbegin+j]) {
+						continue
+					}
+					newlst := j
+					newsiz := newlst - newfst + 1
+
+					// newftt is the column of z where the new
+					// representation or the computed eigenvector is stored.
+					newftt := wbegin + newfst
+
+					if newsiz > 1 {
+						// The current child is a cluster, so compute and
+						// store its new representation.
+						//
+						// The left and right cluster gaps are not computed
+						// from wrep because the eigenvalue approximations may
+						// stem from representations with different shifts.
+						// However, the gaps inside wgap have to be computed
+						// from wrep since the elements in w might be of the
+						// same order so that gaps are not exhibited
+						// correctly for very close eigenvalues.
+						var lgap float64
+						if newfst == 0 {
+							lgap = math.Max(0, w[wbegin]-werr[wbegin]-vl)
+						} else {
+							lgap = wgap[wbegin+newfst-1]
+						}
+						rgap := wgap[wbegin+newlst]
+
+						// Compute the leftmost and rightmost eigenvalues of
+						// the child to high precision in order to shift as
+						// close as possible and obtain as large relative
+						// gaps as possible.
+						offset := indexw[wbegin]
+						for _, k := range []int{newfst, newlst} {
+							p := indexw[wbegin+k]
+							impl.Dlarrb(in, d[ibegin:], lld[ibegin:], p, p, rqtol, rqtol, offset, wrep[wbegin:], wgap[wbegin:], werr[wbegin:], wrk, iwrk, pivmin, spdiam, in-1)
+						}
+
+						// Compute the representation of the child cluster
+						// and store it in z.
+						tau := impl.Dlarrf(in, d[ibegin:], l[ibegin:], ld[ibegin:], newfst, newlst, wrep[wbegin:], wgap[wbegin:], werr[wbegin:], spdiam, lgap, rgap, pivmin, dplus, lplus, wrk)
+						for k := 0; k < in; k++ {
+							z[(ibegin+k)*ldz+newftt] = dplus[k]
+							if k < in-1 {
+								z[(ibegin+k)*ldz+newftt+1] = lplus[k]
+							}
+						}
+						// Update the shift and store it.
+						z[iend*ldz+newftt+1] = sigma + tau
+						// wrep contains the midpoints and werr the
+						// semiwidths of the intervals. The elements of w
+						// are unchanged. The gaps are not fudged: provided
+						// that werr is small when eigenvalues are close, a
+						// zero gap indicates that a new representation is
+						// needed for resolving the cluster, and a fudge
+						// could lead to a wrong decision of judging
+						// eigenvalues separated which in reality are not.
+						for k := newfst; k <= newlst; k++ {
+							fudge := 3 * eps * math.Abs(wrep[wbegin+k])
+							wrep[wbegin+k] -= tau
+							fudge += 4 * eps * math.Abs(wrep[wbegin+k])
+							werr[wbegin+k] += fudge
+						}
+
+						iwork[newcls+2*nclus] = newfst
+						iwork[newcls+2*nclus+1] = newlst
+						nclus++
+					} else {
+						// Compute the eigenvector of the singleton.
+						tol := 4 * math.Log(float64(in)) * eps
+						k := newfst
+						windex := wbegin + k
+						windmn := max(windex-1, 0)
+						windpl := min(windex+1, m-1)
+						lambda := wrep[windex]
+						left := wrep[windex] - werr[windex]
+						right := wrep[windex] + werr[windex]
+						indeig := indexw[windex]
+
+						// All eigenvalue approximations of a child are with
+						// respect to the same shift, so the elements of wrep
+						// are used for computing the gaps since they exhibit
+						// even very small differences in the eigenvalues, as
+						// opposed to the elements of w. At the ends of the
+						// block a small gap is forced to prevent an
+						// overestimation of the gap with not much initial
+						// accuracy in lambda, vl and vu, and thus an
+						// inadequately early convergence of the Rayleigh
+						// quotient iteration.
+						var lgap, rgap float64
+						if k == 0 {
+							lgap = eps * math.Max(math.Abs(left), math.Abs(right))
+						} else {
+							lgap = wgap[windmn]
+						}
+						if k == im-1 {
+							rgap = eps * math.Max(math.Abs(left), math.Abs(right))
+						} else {
+							rgap = wgap[windex]
+						}
+						gap := math.Min(lgap, rgap)
+						// The eigenvector support can become wrong because
+						// significant elements could be cut off due to a
+						// large gaptol in Dlar1v at the ends of the block.
+						gaptol := gap * eps
+						if k == 0 || k == im-1 {
+							gaptol = 0
+						}
+						isupmn := in - 1
+						isupmx := 0
+						// Update wgap so that it holds the minimum gap to the
+						// left or the right. This is crucial in the case
+						// where bisection is used to ensure that the
+						// eigenvalue is refined up to the required precision.
+						// The correct value is restored afterwards.
+						savgap := wgap[windex]
+						wgap[windex] = gap
+
+						// The Rayleigh quotient correction is used as often
+						// as possible since it converges quadratically when
+						// close enough to the desired eigenvalue. However,
+						// it can have the wrong sign and lead away from the
+						// desired eigenvalue, and in this case bisection is
+						// used.
+						var usedbs, usedrq, needbs bool
+						var bstres, bstw, nrminv float64
+						sup := isuppz[2*windex : 2*windex+2]
+						for ii := range zbuf[:in] {
+							zbuf[ii] = 0
+						}
+						for iter := 0; ; {
+							if needbs {
+								// Take the bisection as the new iterate.
+								usedbs = true
+								offset := indexw[wbegin]
+								impl.Dlarrb(in, d[ibegin:], lld[ibegin:], indeig, indeig, 0, 2*eps, offset, wrep[wbegin:], wgap[wbegin:], werr[wbegin:], wrk, iwrk, pivmin, spdiam, twist[windex])
+								lambda = wrep[windex]
+								// Reset the twist index from the inaccurate
+								// lambda to force the computation of the true
+								// mingma.
+								twist[windex] = -1
+							}
+							// Given lambda, compute the eigenvector.
+							negcnt, _, _, r, nrm, resid, rqcorr := impl.Dlar1v(in, 0, in-1, lambda, d[ibegin:], l[ibegin:], ld[ibegin:], lld[ibegin:], pivmin, gaptol, zbuf, !usedbs, twist[windex], sup, wrk)
+							twist[windex] = r
+							nrminv = nrm
+							if iter == 0 || resid < bstres {
+								bstres = resid
+								bstw = lambda
+							}
+							isupmn = min(isupmn, sup[0])
+							isupmx = max(isupmx, sup[1])
+							iter++
+
+							// Convergence test for the Rayleigh quotient
+							// iteration, omitted if bisection has been used.
+							// Both the residual and the gap are proportional
+							// to the matrix, so its norm does not play a
+							// role in the quotient.
+							if resid > tol*gap && math.Abs(rqcorr) > rqtol*math.Abs(lambda) && !usedbs {
+								// Check that the correction does not move
+								// the eigenvalue away from the desired one
+								// and towards a neighbor.
+								sgndef := 1.0
+								if indeig < negcnt {
+									// The wanted eigenvalue lies to the left.
+									sgndef = -1
+								}
+								// Only use the correction if it improves the
+								// iterate reasonably.
+								if rqcorr*sgndef >= 0 && lambda+rqcorr <= right && lambda+rqcorr >= left {
+									usedrq = true
+									// Store the new midpoint of the bisection
+									// interval in wrep.
+									if sgndef == 1 {
+										left = lambda
+									} else {
+										right = lambda
+									}
+									wrep[windex] = 0.5 * (right + left)
+									lambda += rqcorr
+									// Update the width of the error interval.
+									werr[windex] = 0.5 * (right - left)
+								} else {
+									needbs = true
+								}
+								switch {
+								case right-left < rqtol*math.Abs(lambda):
+									// The eigenvalue is computed to bisection
+									// accuracy, so compute the eigenvector
+									// and stop.
+									usedbs = true
+								case iter < maxitr:
+								case iter == maxitr:
+									needbs = true
+								default:
+									return false
+								}
+								continue
+							}
+							if usedrq && usedbs && bstres <= resid {
+								// Improve the error angle by a second step.
+								lambda = bstw
+								_, _, _, r, nrm, _, _ = impl.Dlar1v(in, 0, in-1, lambda, d[ibegin:], l[ibegin:], ld[ibegin:], lld[ibegin:], pivmin, gaptol, zbuf, false, twist[windex], sup, wrk)
+								twist[windex] = r
+								nrminv = nrm
+							}
+							wrep[windex] = lambda
+							break
+						}
+
+						// Ensure that the vector is correct if its support
+						// changed during the Rayleigh quotient iteration,
+						// normalize it and store it in z.
+						for ii := isupmn; ii < sup[0]; ii++ {
+							zbuf[ii] = 0
+						}
+						for ii := sup[1] + 1; ii <= isupmx; ii++ {
+							zbuf[ii] = 0
+						}
+						for ii := sup[0]; ii <= sup[1]; ii++ {
+							zbuf[ii] *= nrminv
+						}
+						for ii := 0; ii < in; ii++ {
+							z[(ibegin+ii)*ldz+windex] = zbuf[ii]
+						}
+						// Compute the support with respect to the whole
+						// matrix.
+						sup[0] += ibegin
+						sup[1] += ibegin
+
+						// Update w and recompute the gaps on the left and
+						// right, but only allow them to become larger, which
+						// can only happen through bad cancellation and does
+						// not reflect the theory where the initial gaps are
+						// underestimated due to werr being too crude.
+						w[windex] = lambda + sigma
+						if k > 0 {
+							wgap[windmn] = math.Max(wgap[windmn], w[windex]-werr[windex]-w[windmn]-werr[windmn])
+						}
+						if windex < wend {
+							wgap[windex] = math.Max(savgap, w[windpl]-werr[windpl]-w[windex]-werr[windex])
+						}
+						idone++
+					}
+					// Proceed to any remaining child nodes.
+					newfst = j + 1
+				}
+			}
+			ndepth++
+		}
+		ibegin = iend + 1
+		wbegin = wend + 1
+	}
+	return true
+}
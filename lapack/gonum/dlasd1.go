@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd1 computes the singular value decomposition of an upper bidiagonal
+// n×m matrix B, where n = nl + nr + 1 and m = n + sqre. It is used when the
+// singular values and vectors of the upper nl×(nl+1) and the lower
+// nr×(nr+sqre) blocks of B are known and it is the merge step of the divide and
+// conquer algorithm implemented by Dlasd0.
+//
+// The singular values of B are computed in three stages. First, the singular
+// values and vectors of the two blocks are merged and the problem is deflated
+// by Dlasd2. Then the secular equation is solved and the singular vectors are
+// updated by Dlasd3. Finally, the permutation which sorts the singular values
+// is computed.
+//
+// On entry, d[:nl] contains the singular values of the upper block and
+// d[nl+1:n] contains the singular values of the lower block. alpha is the
+// diagonal element and beta is the off-diagonal element of the row nl of B.
+// On return, d contains the singular values of B.
+//
+// On entry, the n×n matrix U contains the left singular vectors of the upper
+// block in U[:nl,:nl] and of the lower block in U[nl+1:n,nl+1:n]. The m×m
+// matrix VT contains the transposed right singular vectors of the upper block
+// in VT[:nl+1,:nl+1] and of the lower block in VT[nl+1:m,nl+1:m]. All other
+// elements of U and VT must be zero. On return, U and VT contain the left and
+// the transposed right singular vectors of B.
+//
+// On entry, idxq contains the permutation which separately sorts the two
+// subproblems in d into ascending order. On return, it contains the
+// permutation which sorts the singular values of B into ascending order.
+// idxq must have length at least n.
+//
+// iwork must have length at least 4*n and work must have length at least
+// 3*m*m+2*m.
+//
+// Dlasd1 returns whether all the roots of the secular equation were found.
+//
+// Dlasd1 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd1(nl, nr, sqre int, d []float64, alpha, beta float64, u []float64, ldu int, vt []float64, ldvt int, idxq, iwork []int, work []float64) (ok bool) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < n:
+		panic(badLdU)
+	case ldvt < m:
+		panic(badLdVT)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(idxq) < n:
+		panic(shortIndxq)
+	case len(iwork) < 4*n:
+		panic(shortIWork)
+	case len(work) < 3*m*m+2*m:
+		panic(shortWork)
+	}
+
+	// The following values are for bookkeeping purposes only. They are
+	// indices which indicate the portion of the workspace used by a
+	// particular array in Dlasd2 and Dlasd3.
+	ldu2 := n
+	ldvt2 := m
+
+	iz := 0
+	isigma := iz + m
+	iu2 := isigma + n
+	ivt2 := iu2 + ldu2*n
+	iq := ivt2 + ldvt2*m
+
+	idx := 0
+	idxc := idx + n
+	coltyp := idxc + n
+	idxp := coltyp + n
+
+	// Scale.
+	orgnrm := max(math.Abs(alpha), math.Abs(beta))
+	d[nl] = 0
+	for i := 0; i < n; i++ {
+		orgnrm = max(orgnrm, math.Abs(d[i]))
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	alpha /= orgnrm
+	beta /= orgnrm
+
+	// Deflate singular values.
+	k := impl.Dlasd2(nl, nr, sqre, d, work[iz:isigma], alpha, beta, u, ldu, vt, ldvt,
+		work[isigma:iu2], work[iu2:ivt2], ldu2, work[ivt2:iq], ldvt2,
+		iwork[idxp:], iwork[idx:idxc], iwork[idxc:coltyp], idxq, iwork[coltyp:])
+
+	// Solve the secular equation and update the singular vectors.
+	ldq := k
+	ok = impl.Dlasd3(nl, nr, sqre, k, d, work[iq:], ldq, work[isigma:iu2], u, ldu,
+		work[iu2:ivt2], ldu2, vt, ldvt, work[ivt2:iq], ldvt2,
+		iwork[idxc:coltyp], iwork[coltyp:coltyp+4], work[iz:isigma])
+	if !ok {
+		return false
+	}
+
+	// Unscale.
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+
+	// Prepare the idxq sorting permutation.
+	impl.Dlamrg(k, n-k, d, 1, -1, idxq)
+	return true
+}
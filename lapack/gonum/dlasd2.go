@@ -0,0 +1,345 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlasd2 merges the two sets of singular values together into a single
+// sorted set. Then it tries to deflate the size of the problem. There are two
+// ways in which deflation can occur: when two or more singular values are
+// close together or if there is a tiny entry in the z vector. For each such
+// occurrence the order of the related secular equation problem is reduced by
+// one. Dlasd2 is called from Dlasd1.
+//
+// The upper bidiagonal n×m matrix B, where n = nl + nr + 1 and m = n + sqre,
+// is represented as
+//
+//	B = U * [ D1      0   0         0 ] * VTᵀ
+//	        [ z1ᵀ alpha z2ᵀ beta*e_mᵀ ]
+//	        [ 0       0  D2         0 ]
+//
+// where D1 and D2 contain the singular values of the upper nl×(nl+1) and the
+// lower nr×(nr+sqre) blocks and U and VT contain the corresponding singular
+// vectors. sqre must be 0 or 1, nl and nr must be at least 1.
+//
+// On entry, d[:nl] contains the singular values of the upper block and
+// d[nl+1:n] contains the singular values of the lower block. On return,
+// d[k:n] contains the deflated singular values.
+//
+// On entry, the n×n matrix U contains the left singular vectors of the upper
+// block in U[:nl,:nl] and of the lower block in U[nl+1:n,nl+1:n]. On return, U
+// contains the left singular vectors of the deflated singular values in its
+// last n-k columns.
+//
+// On entry, the m×m matrix VT contains the transposed right singular vectors of
+// the upper block in VT[:nl+1,:nl+1] and of the lower block in
+// VT[nl+1:m,nl+1:m]. On return, VT contains the transposed right singular
+// vectors of the deflated singular values in its last n-k rows. If sqre == 1,
+// the last row of VT is also updated.
+//
+// On return, z contains the first k elements of the updating row vector of the
+// deflated secular equation and dsigma contains the poles of the secular
+// equation. The n×n matrix U2 and the m×m matrix VT2 contain the left and the
+// transposed right singular vectors of the nondeflated singular values,
+// grouped by their structure so that the matrix products in Dlasd3 can
+// exploit the zero blocks.
+//
+// idxq must contain on entry the permutation which separately sorts the two
+// subproblems in d into ascending order. Note that the entries of idxq
+// corresponding to the lower block are relative to nl+1. idxp, idx, idxc and
+// coltyp are used as workspace and on return idxc contains the permutation
+// used to arrange the columns of U2 and coltyp[:4] contains the number of
+// columns of each of the four structural types.
+//
+// z must have length at least m, dsigma, idxp, idx, idxc and idxq must have
+// length at least n and coltyp must have length at least max(4,n).
+//
+// Dlasd2 returns the dimension k of the non-deflated matrix, 1 <= k <= n.
+//
+// Dlasd2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd2(nl, nr, sqre int, d, z []float64, alpha, beta float64, u []float64, ldu int, vt []float64, ldvt int, dsigma, u2 []float64, ldu2 int, vt2 []float64, ldvt2 int, idxp, idx, idxc, idxq, coltyp []int) (k int) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < n:
+		panic(badLdU)
+	case ldvt < m:
+		panic(badLdVT)
+	case ldu2 < n:
+		panic(badLdU)
+	case ldvt2 < m:
+		panic(badLdVT)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < m:
+		panic(shortZ)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(dsigma) < n:
+		panic(shortD)
+	case len(u2) < (n-1)*ldu2+n:
+		panic(shortU)
+	case len(vt2) < (m-1)*ldvt2+m:
+		panic(shortVT)
+	case len(idxp) < n:
+		panic(shortIndxp)
+	case len(idx) < n:
+		panic(shortIndx)
+	case len(idxc) < n:
+		panic(shortIndxc)
+	case len(idxq) < n:
+		panic(shortIndxq)
+	case len(coltyp) < max(4, n):
+		panic(shortColtyp)
+	}
+
+	bi := blas64.Implementation()
+
+	// Generate the first part of the vector z and move the singular values in
+	// the first part of d one position backward.
+	z1 := alpha * vt[nl*ldvt+nl]
+	z[0] = z1
+	for i := nl - 1; i >= 0; i-- {
+		z[i+1] = alpha * vt[i*ldvt+nl]
+		d[i+1] = d[i]
+		idxq[i+1] = idxq[i] + 1
+	}
+
+	// Generate the second part of the vector z.
+	for i := nl + 1; i < m; i++ {
+		z[i] = beta * vt[i*ldvt+nl+1]
+	}
+
+	// Initialize some reference arrays. The columns of the upper block are of
+	// type 1 and the columns of the lower block are of type 2.
+	for i := 1; i <= nl; i++ {
+		coltyp[i] = 1
+	}
+	for i := nl + 1; i < n; i++ {
+		coltyp[i] = 2
+	}
+
+	// Sort the singular values into increasing order.
+	for i := nl + 1; i < n; i++ {
+		idxq[i] += nl + 1
+	}
+
+	// dsigma, idxc and the first column of U2 are used as storage space.
+	for i := 1; i < n; i++ {
+		dsigma[i] = d[idxq[i]]
+		u2[i*ldu2] = z[idxq[i]]
+		idxc[i] = coltyp[idxq[i]]
+	}
+	impl.Dlamrg(nl, nr, dsigma[1:], 1, 1, idx[1:])
+	for i := 1; i < n; i++ {
+		idxi := 1 + idx[i]
+		d[i] = dsigma[idxi]
+		z[i] = u2[idxi*ldu2]
+		coltyp[i] = idxc[idxi]
+	}
+
+	// Calculate the allowable deflation tolerance.
+	eps := dlamchE
+	tol := max(math.Abs(alpha), math.Abs(beta))
+	tol = 8 * eps * max(math.Abs(d[n-1]), tol)
+
+	// There are 2 kinds of deflation -- first a value in the z-vector is
+	// small, second two (or more) singular values are very close together
+	// (their difference is small).
+	//
+	// If the value in the z-vector is small, we simply permute the array so
+	// that the corresponding singular value is moved to the end.
+	//
+	// If two values in the d-vector are close, we perform a two-sided rotation
+	// designed to make one of the corresponding z-vector entries zero, and
+	// then permute the array so that the deflated singular value is moved to
+	// the end.
+	//
+	// If there are multiple singular values then the problem deflates. Here
+	// the number of equal singular values are found. As each equal singular
+	// value is found, an elementary reflector is computed to rotate the
+	// corresponding singular subspace so that the corresponding components of
+	// z are zero in this new basis.
+	k = 1
+	k2 := n
+	jprev := -1
+	for j := 1; j < n; j++ {
+		if math.Abs(z[j]) <= tol {
+			// Deflate due to small z component.
+			k2--
+			idxp[k2] = j
+			coltyp[j] = 4
+			continue
+		}
+		if jprev < 0 {
+			jprev = j
+			continue
+		}
+
+		// Check if singular values are close enough to allow deflation.
+		if math.Abs(d[j]-d[jprev]) > tol {
+			k++
+			u2[(k-1)*ldu2] = z[jprev]
+			dsigma[k-1] = d[jprev]
+			idxp[k-1] = jprev
+			jprev = j
+			continue
+		}
+
+		// Deflation is possible.
+		s := z[jprev]
+		c := z[j]
+
+		// Find sqrt(a**2+b**2) without overflow or destructive underflow.
+		tau := impl.Dlapy2(c, s)
+		c /= tau
+		s = -s / tau
+		z[j] = tau
+		z[jprev] = 0
+
+		// Apply back the Givens rotation to the left and right singular
+		// vector matrices.
+		idxjp := idxq[idx[jprev]+1]
+		idxj := idxq[idx[j]+1]
+		if idxjp <= nl {
+			idxjp--
+		}
+		if idxj <= nl {
+			idxj--
+		}
+		bi.Drot(n, u[idxjp:], ldu, u[idxj:], ldu, c, s)
+		bi.Drot(m, vt[idxjp*ldvt:], 1, vt[idxj*ldvt:], 1, c, s)
+		if coltyp[j] != coltyp[jprev] {
+			coltyp[j] = 3
+		}
+		coltyp[jprev] = 4
+		k2--
+		idxp[k2] = jprev
+		jprev = j
+	}
+	if jprev >= 0 {
+		// Record the last singular value.
+		k++
+		u2[(k-1)*ldu2] = z[jprev]
+		dsigma[k-1] = d[jprev]
+		idxp[k-1] = jprev
+	}
+
+	// Count up the total number of the various types of columns, then form a
+	// permutation which positions the four column types into four groups of
+	// uniform structure (although one or more of these groups may be empty).
+	var ctot [4]int
+	for j := 1; j < n; j++ {
+		ctot[coltyp[j]-1]++
+	}
+
+	// psm[i] is the position in the submatrix of the columns of type i+1.
+	var psm [4]int
+	psm[0] = 1
+	psm[1] = 1 + ctot[0]
+	psm[2] = psm[1] + ctot[1]
+	psm[3] = psm[2] + ctot[2]
+
+	// Fill out the idxc array so that the permutation which it induces will
+	// place all type-1 columns first, all type-2 columns next, then all
+	// type-3's, and finally all type-4's, starting from the second column.
+	// This applies similarly to the rows of VT.
+	for j := 1; j < n; j++ {
+		jp := idxp[j]
+		ct := coltyp[jp]
+		idxc[psm[ct-1]] = j
+		psm[ct-1]++
+	}
+
+	// Sort the singular values and corresponding singular vectors into dsigma,
+	// U2 and VT2 respectively. The singular values and vectors which were not
+	// deflated go into the first k slots of dsigma, U2 and VT2 respectively,
+	// while those which were deflated go into the last n-k slots, except that
+	// the first column and row will be treated separately.
+	for j := 1; j < n; j++ {
+		jp := idxp[j]
+		dsigma[j] = d[jp]
+		idxj := idxq[idx[idxp[idxc[j]]]+1]
+		if idxj <= nl {
+			idxj--
+		}
+		bi.Dcopy(n, u[idxj:], ldu, u2[j:], ldu2)
+		bi.Dcopy(m, vt[idxj*ldvt:], 1, vt2[j*ldvt2:], 1)
+	}
+
+	// Determine dsigma[0], dsigma[1] and z[0].
+	dsigma[0] = 0
+	hlftol := tol / 2
+	if math.Abs(dsigma[1]) <= hlftol {
+		dsigma[1] = hlftol
+	}
+	var c, s float64
+	if m > n {
+		z[0] = impl.Dlapy2(z1, z[m-1])
+		if z[0] <= tol {
+			c = 1
+			s = 0
+			z[0] = tol
+		} else {
+			c = z1 / z[0]
+			s = z[m-1] / z[0]
+		}
+	} else {
+		if math.Abs(z1) <= tol {
+			z[0] = tol
+		} else {
+			z[0] = z1
+		}
+	}
+
+	// Move the rest of the updating row to z.
+	bi.Dcopy(k-1, u2[ldu2:], ldu2, z[1:], 1)
+
+	// Determine the first column of U2, the first row of VT2 and the last row
+	// of VT.
+	impl.Dlaset(blas.All, n, 1, 0, 0, u2, ldu2)
+	u2[nl*ldu2] = 1
+	if m > n {
+		for i := 0; i <= nl; i++ {
+			vt[(m-1)*ldvt+i] = -s * vt[nl*ldvt+i]
+			vt2[i] = c * vt[nl*ldvt+i]
+		}
+		for i := nl + 1; i < m; i++ {
+			vt2[i] = s * vt[(m-1)*ldvt+i]
+			vt[(m-1)*ldvt+i] *= c
+		}
+		bi.Dcopy(m, vt[(m-1)*ldvt:], 1, vt2[(m-1)*ldvt2:], 1)
+	} else {
+		bi.Dcopy(m, vt[nl*ldvt:], 1, vt2, 1)
+	}
+
+	// The deflated singular values and their corresponding vectors go into
+	// the back of d, U and VT respectively.
+	if n > k {
+		bi.Dcopy(n-k, dsigma[k:], 1, d[k:], 1)
+		impl.Dlacpy(blas.All, n, n-k, u2[k:], ldu2, u[k:], ldu)
+		impl.Dlacpy(blas.All, n-k, m, vt2[k*ldvt2:], ldvt2, vt[k*ldvt:], ldvt)
+	}
+
+	// Copy ctot into coltyp for referencing in Dlasd3.
+	copy(coltyp[:4], ctot[:])
+	return k
+}
@@ -0,0 +1,597 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlasd4 computes the square root of the i-th updated eigenvalue of a positive
+// symmetric rank-one modification to a positive diagonal matrix whose entries
+// are given as the squares of the corresponding entries in the array d, that
+// is, the i-th root sigma_i of the secular equation
+//
+//	1/rho + sum_j z[j]^2/((d[j]-sigma)*(d[j]+sigma)) = 0.
+//
+// It is assumed that
+//
+//	0 <= d[j] < d[j+1] for j = 0, ..., n-2,
+//	rho > 0,
+//
+// and that the Euclidean norm of z is one.
+//
+// The method is the same as in Dlaed4 applied to the squared singular values.
+// The distances to the poles are kept in the factored form
+// (d[j]-sigma)*(d[j]+sigma) so that they are computed with high relative
+// accuracy.
+//
+// i must satisfy 0 <= i < n, and d, z, delta and work must have length at
+// least n. On return, delta[j] contains d[j] - sigma_i and work[j] contains
+// d[j] + sigma_i, the information necessary to construct the singular vectors.
+//
+// Dlasd4 returns the computed value sigma_i and whether the iteration
+// converged.
+//
+// Dlasd4 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd4(n, i int, d, z, delta []float64, rho float64, work []float64) (sigma float64, ok bool) {
+	const maxit = 400
+
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case i < 0 || n <= i:
+		panic(badI)
+	case len(d) < n:
+		panic(shortD)
+	case len(z) < n:
+		panic(shortZ)
+	case len(delta) < n:
+		panic(shortDelta)
+	case len(work) < n:
+		panic(shortWork)
+	}
+
+	if n == 1 {
+		// Presumably, i == 0 upon entry.
+		delta[0] = 1
+		work[0] = 1
+		return math.Sqrt(d[0]*d[0] + rho*z[0]*z[0]), true
+	}
+	if n == 2 {
+		return impl.Dlasd5(i, d, z, delta, rho, work), true
+	}
+
+	eps := dlamchE
+	rhoinv := 1 / rho
+
+	// origin is the pole chosen as the origin of the iteration. The iteration
+	// is carried out in terms of tau2 = sigma^2 - origin^2.
+	var origin float64
+	// setTau updates delta and work for sigma^2 = origin^2 + tau2 and returns
+	// sigma - origin.
+	setTau := func(tau2 float64) (tau float64) {
+		tau = tau2 / (origin + math.Sqrt(origin*origin+tau2))
+		for j := 0; j < n; j++ {
+			delta[j] = (d[j] - origin) - tau
+			work[j] = d[j] + origin + tau
+		}
+		return tau
+	}
+
+	if i == n-1 {
+		// The case i == n-1.
+		ii := n - 2
+		niter := 1
+		origin = d[n-1]
+
+		// Calculate the initial guess.
+		midpt := rho / 2
+
+		// If the Euclidean norm of z is not one, then midpt should be set to
+		// rho * |z|_2^2 / 2.
+		setTau(midpt)
+		var psi float64
+		for j := 0; j < n-2; j++ {
+			psi += z[j] * z[j] / (delta[j] * work[j])
+		}
+		c := rhoinv + psi
+		w := c + z[ii]*z[ii]/(delta[ii]*work[ii]) + z[n-1]*z[n-1]/(delta[n-1]*work[n-1])
+
+		delsq := (d[n-1] - d[n-2]) * (d[n-1] + d[n-2])
+		var tau2, dltlb, dltub float64
+		if w <= 0 {
+			temp1 := math.Sqrt(d[n-1]*d[n-1] + rho)
+			temp := z[n-2]*z[n-2]/((d[n-2]+temp1)*(d[n-1]-d[n-2]+rho/(d[n-1]+temp1))) + z[n-1]*z[n-1]/rho
+			if c <= temp {
+				tau2 = rho
+			} else {
+				a := -c*delsq + z[n-2]*z[n-2] + z[n-1]*z[n-1]
+				b := z[n-1] * z[n-1] * delsq
+				if a < 0 {
+					tau2 = 2 * b / (math.Sqrt(a*a+4*b*c) - a)
+				} else {
+					tau2 = (a + math.Sqrt(a*a+4*b*c)) / (2 * c)
+				}
+			}
+			// It can be proved that
+			//  d[n-1]^2+rho/2 <= sigma_{n-1}^2 < d[n-1]^2+tau2 <= d[n-1]^2+rho.
+			dltlb = midpt
+			dltub = rho
+		} else {
+			a := -c*delsq + z[n-2]*z[n-2] + z[n-1]*z[n-1]
+			b := z[n-1] * z[n-1] * delsq
+			if a < 0 {
+				tau2 = 2 * b / (math.Sqrt(a*a+4*b*c) - a)
+			} else {
+				tau2 = (a + math.Sqrt(a*a+4*b*c)) / (2 * c)
+			}
+			// It can be proved that
+			//  d[n-1]^2 < d[n-1]^2+tau2 < sigma_{n-1}^2 < d[n-1]^2+rho/2.
+			dltlb = 0
+			dltub = midpt
+		}
+		tau := setTau(tau2)
+
+		// evaluate returns the value of the secular function, the
+		// derivatives of its two parts and a bound on the rounding error.
+		evaluate := func() (w, dpsi, dphi, erretm float64) {
+			// Evaluate psi and the derivative dpsi.
+			var psi float64
+			for j := 0; j <= ii; j++ {
+				temp := z[j] / (delta[j] * work[j])
+				psi += z[j] * temp
+				dpsi += temp * temp
+				erretm += psi
+			}
+			erretm = math.Abs(erretm)
+
+			// Evaluate phi and the derivative dphi.
+			temp := z[n-1] / (delta[n-1] * work[n-1])
+			phi := z[n-1] * temp
+			dphi = temp * temp
+			erretm = 8*(-phi-psi) + erretm - phi + rhoinv + math.Abs(tau2)*(dpsi+dphi)
+			w = rhoinv + phi + psi
+			return w, dpsi, dphi, erretm
+		}
+		w, dpsi, dphi, erretm := evaluate()
+
+		// Test for convergence.
+		if math.Abs(w) <= eps*erretm {
+			return origin + tau, true
+		}
+
+		if w <= 0 {
+			dltlb = max(dltlb, tau2)
+		} else {
+			dltub = min(dltub, tau2)
+		}
+
+		// Calculate the new step.
+		niter++
+		dtnsq1 := work[n-2] * delta[n-2]
+		dtnsq := work[n-1] * delta[n-1]
+		c = w - dtnsq1*dpsi - dtnsq*dphi
+		a := (dtnsq+dtnsq1)*w - dtnsq*dtnsq1*(dpsi+dphi)
+		b := dtnsq * dtnsq1 * w
+		if c < 0 {
+			c = math.Abs(c)
+		}
+		var eta float64
+		switch {
+		case c == 0:
+			eta = -w / (dpsi + dphi)
+		case a >= 0:
+			eta = (a + math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		default:
+			eta = 2 * b / (a - math.Sqrt(math.Abs(a*a-4*b*c)))
+		}
+
+		// Note that eta should be positive if w is negative, and eta should
+		// be negative otherwise. However, if for some reason caused by
+		// roundoff eta*w > 0, we simply use one Newton step instead. This way
+		// will guarantee eta*w < 0.
+		if w*eta > 0 {
+			eta = -w / (dpsi + dphi)
+		}
+		temp := tau2 + eta
+		if dltub < temp || temp < dltlb {
+			if w < 0 {
+				eta = (dltub - tau2) / 2
+			} else {
+				eta = (dltlb - tau2) / 2
+			}
+		}
+		tau2 += eta
+		tau = setTau(tau2)
+		w, dpsi, dphi, erretm = evaluate()
+
+		// Main loop to update the values of the arrays delta and work.
+		for niter++; niter <= maxit; niter++ {
+			// Test for convergence.
+			if math.Abs(w) <= eps*erretm {
+				return origin + tau, true
+			}
+
+			if w <= 0 {
+				dltlb = max(dltlb, tau2)
+			} else {
+				dltub = min(dltub, tau2)
+			}
+
+			// Calculate the new step.
+			dtnsq1 := work[n-2] * delta[n-2]
+			dtnsq := work[n-1] * delta[n-1]
+			c := w - dtnsq1*dpsi - dtnsq*dphi
+			a := (dtnsq+dtnsq1)*w - dtnsq1*dtnsq*(dpsi+dphi)
+			b := dtnsq1 * dtnsq * w
+			var eta float64
+			if a >= 0 {
+				eta = (a + math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+			} else {
+				eta = 2 * b / (a - math.Sqrt(math.Abs(a*a-4*b*c)))
+			}
+
+			// Note that eta should be positive if w is negative, and eta
+			// should be negative otherwise. However, if for some reason
+			// caused by roundoff eta*w > 0, we simply use one Newton step
+			// instead. This way will guarantee eta*w < 0.
+			if w*eta > 0 {
+				eta = -w / (dpsi + dphi)
+			}
+			temp := tau2 + eta
+			if dltub < temp || temp < dltlb {
+				if w < 0 {
+					eta = (dltub - tau2) / 2
+				} else {
+					eta = (dltlb - tau2) / 2
+				}
+			}
+			tau2 += eta
+			tau = setTau(tau2)
+			w, dpsi, dphi, erretm = evaluate()
+		}
+
+		// Return with the iteration not converged.
+		return origin + tau, false
+	}
+
+	// The case i < n-1.
+	niter := 1
+	ip1 := i + 1
+
+	// Calculate the initial guess.
+	delsq := (d[ip1] - d[i]) * (d[ip1] + d[i])
+	midpt := delsq / 2
+	origin = d[i]
+	setTau(midpt)
+	var psi float64
+	for j := 0; j < i; j++ {
+		psi += z[j] * z[j] / (delta[j] * work[j])
+	}
+	var phi float64
+	for j := n - 1; j >= i+2; j-- {
+		phi += z[j] * z[j] / (delta[j] * work[j])
+	}
+	c := rhoinv + psi + phi
+	w := c + z[i]*z[i]/(delta[i]*work[i]) + z[ip1]*z[ip1]/(delta[ip1]*work[ip1])
+
+	var (
+		orgati       bool
+		tau2         float64
+		dltlb, dltub float64
+	)
+	if w > 0 {
+		// d[i]^2 < sigma_i^2 < (d[i]^2+d[i+1]^2)/2.
+		//
+		// We choose d[i] as origin.
+		orgati = true
+		a := c*delsq + z[i]*z[i] + z[ip1]*z[ip1]
+		b := z[i] * z[i] * delsq
+		if a > 0 {
+			tau2 = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		} else {
+			tau2 = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		}
+		dltlb = 0
+		dltub = midpt
+	} else {
+		// (d[i]^2+d[i+1]^2)/2 <= sigma_i^2 < d[i+1]^2.
+		//
+		// We choose d[i+1] as origin.
+		orgati = false
+		a := c*delsq - z[i]*z[i] - z[ip1]*z[ip1]
+		b := z[ip1] * z[ip1] * delsq
+		if a < 0 {
+			tau2 = 2 * b / (a - math.Sqrt(math.Abs(a*a+4*b*c)))
+		} else {
+			tau2 = -(a + math.Sqrt(math.Abs(a*a+4*b*c))) / (2 * c)
+		}
+		dltlb = -midpt
+		dltub = 0
+	}
+
+	var ii int
+	if orgati {
+		ii = i
+	} else {
+		ii = i + 1
+	}
+	origin = d[ii]
+	tau := setTau(tau2)
+	iim1 := ii - 1
+	iip1 := ii + 1
+
+	// evaluate returns the value of the secular function with its ii-th
+	// term removed and the derivatives of its two parts, and a partial bound
+	// on the rounding error.
+	evaluate := func() (w, psi, dpsi, phi, dphi, erretm float64) {
+		// Evaluate psi and the derivative dpsi.
+		for j := 0; j <= iim1; j++ {
+			temp := z[j] / (delta[j] * work[j])
+			psi += z[j] * temp
+			dpsi += temp * temp
+			erretm += psi
+		}
+		erretm = math.Abs(erretm)
+
+		// Evaluate phi and the derivative dphi.
+		for j := n - 1; j >= iip1; j-- {
+			temp := z[j] / (delta[j] * work[j])
+			phi += z[j] * temp
+			dphi += temp * temp
+			erretm += phi
+		}
+		w = rhoinv + phi + psi
+		return w, psi, dpsi, phi, dphi, erretm
+	}
+	w, psi, dpsi, phi, dphi, erretm := evaluate()
+
+	// w is the value of the secular function with its ii-th element removed.
+	swtch3 := false
+	if orgati {
+		if w < 0 {
+			swtch3 = true
+		}
+	} else {
+		if w > 0 {
+			swtch3 = true
+		}
+	}
+	if ii == 0 || ii == n-1 {
+		swtch3 = false
+	}
+
+	temp := z[ii] / (delta[ii] * work[ii])
+	dw := dpsi + dphi + temp*temp
+	temp *= z[ii]
+	w += temp
+	erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau2)*dw
+
+	// Test for convergence.
+	if math.Abs(w) <= eps*erretm {
+		return origin + tau, true
+	}
+
+	if w <= 0 {
+		dltlb = max(dltlb, tau2)
+	} else {
+		dltub = min(dltub, tau2)
+	}
+
+	// Calculate the new step.
+	niter++
+	dtipsq := work[ip1] * delta[ip1]
+	dtisq := work[i] * delta[i]
+	var eta float64
+	var dd, zz [3]float64
+	if !swtch3 {
+		if orgati {
+			c = w - dtipsq*dw - (d[i]-d[ip1])*(d[i]+d[ip1])*(z[i]/dtisq)*(z[i]/dtisq)
+		} else {
+			c = w - dtisq*dw - (d[ip1]-d[i])*(d[ip1]+d[i])*(z[ip1]/dtipsq)*(z[ip1]/dtipsq)
+		}
+		a := (dtipsq+dtisq)*w - dtipsq*dtisq*dw
+		b := dtipsq * dtisq * w
+		switch {
+		case c == 0:
+			if a == 0 {
+				if orgati {
+					a = z[i]*z[i] + dtipsq*dtipsq*(dpsi+dphi)
+				} else {
+					a = z[ip1]*z[ip1] + dtisq*dtisq*(dpsi+dphi)
+				}
+			}
+			eta = b / a
+		case a <= 0:
+			eta = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+		default:
+			eta = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+		}
+	} else {
+		// Interpolation using the three most relevant poles.
+		dtiim := work[iim1] * delta[iim1]
+		dtiip := work[iip1] * delta[iip1]
+		temp := rhoinv + psi + phi
+		if orgati {
+			temp1 := z[iim1] / dtiim
+			temp1 *= temp1
+			c = temp - dtiip*(dpsi+dphi) - (d[iim1]-d[iip1])*(d[iim1]+d[iip1])*temp1
+			zz[0] = z[iim1] * z[iim1]
+			zz[2] = dtiip * dtiip * ((dpsi - temp1) + dphi)
+		} else {
+			temp1 := z[iip1] / dtiip
+			temp1 *= temp1
+			c = temp - dtiim*(dpsi+dphi) - (d[iip1]-d[iim1])*(d[iim1]+d[iip1])*temp1
+			zz[0] = dtiim * dtiim * (dpsi + (dphi - temp1))
+			zz[2] = z[iip1] * z[iip1]
+		}
+		zz[1] = z[ii] * z[ii]
+		dd[0] = dtiim
+		dd[1] = delta[ii] * work[ii]
+		dd[2] = dtiip
+		eta, ok = impl.Dlaed6(niter, orgati, c, dd[:], zz[:], w)
+		if !ok {
+			return origin + tau, false
+		}
+	}
+
+	// Note that eta should be positive if w is negative, and eta should be
+	// negative otherwise. However, if for some reason caused by roundoff
+	// eta*w > 0, we simply use one Newton step instead. This way will
+	// guarantee eta*w < 0.
+	if w*eta >= 0 {
+		eta = -w / dw
+	}
+	temp = tau2 + eta
+	if dltub < temp || temp < dltlb {
+		if w < 0 {
+			eta = (dltub - tau2) / 2
+		} else {
+			eta = (dltlb - tau2) / 2
+		}
+	}
+
+	prew := w
+
+	tau2 += eta
+	tau = setTau(tau2)
+	w, psi, dpsi, phi, dphi, erretm = evaluate()
+	temp = z[ii] / (delta[ii] * work[ii])
+	dw = dpsi + dphi + temp*temp
+	temp *= z[ii]
+	w += temp
+	erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau2)*dw
+
+	swtch := false
+	if orgati {
+		if -w > math.Abs(prew)/10 {
+			swtch = true
+		}
+	} else {
+		if w > math.Abs(prew)/10 {
+			swtch = true
+		}
+	}
+
+	// Main loop to update the values of the arrays delta and work.
+	for niter++; niter <= maxit; niter++ {
+		// Test for convergence.
+		if math.Abs(w) <= eps*erretm {
+			return origin + tau, true
+		}
+
+		if w <= 0 {
+			dltlb = max(dltlb, tau2)
+		} else {
+			dltub = min(dltub, tau2)
+		}
+
+		// Calculate the new step.
+		dtipsq := work[ip1] * delta[ip1]
+		dtisq := work[i] * delta[i]
+		var eta float64
+		if !swtch3 {
+			var c float64
+			if !swtch {
+				if orgati {
+					c = w - dtipsq*dw - (d[i]-d[ip1])*(d[i]+d[ip1])*(z[i]/dtisq)*(z[i]/dtisq)
+				} else {
+					c = w - dtisq*dw - (d[ip1]-d[i])*(d[ip1]+d[i])*(z[ip1]/dtipsq)*(z[ip1]/dtipsq)
+				}
+			} else {
+				temp := z[ii] / (delta[ii] * work[ii])
+				if orgati {
+					dpsi += temp * temp
+				} else {
+					dphi += temp * temp
+				}
+				c = w - dtisq*dpsi - dtipsq*dphi
+			}
+			a := (dtipsq+dtisq)*w - dtipsq*dtisq*dw
+			b := dtipsq * dtisq * w
+			switch {
+			case c == 0:
+				if a == 0 {
+					if !swtch {
+						if orgati {
+							a = z[i]*z[i] + dtipsq*dtipsq*(dpsi+dphi)
+						} else {
+							a = z[ip1]*z[ip1] + dtisq*dtisq*(dpsi+dphi)
+						}
+					} else {
+						a = dtisq*dtisq*dpsi + dtipsq*dtipsq*dphi
+					}
+				}
+				eta = b / a
+			case a <= 0:
+				eta = (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+			default:
+				eta = 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+			}
+		} else {
+			// Interpolation using the three most relevant poles.
+			dtiim := work[iim1] * delta[iim1]
+			dtiip := work[iip1] * delta[iip1]
+			var c float64
+			temp := rhoinv + psi + phi
+			if swtch {
+				c = temp - dtiim*dpsi - dtiip*dphi
+				zz[0] = dtiim * dtiim * dpsi
+				zz[2] = dtiip * dtiip * dphi
+			} else {
+				if orgati {
+					temp1 := z[iim1] / dtiim
+					temp1 *= temp1
+					c = temp - dtiip*(dpsi+dphi) - (d[iim1]-d[iip1])*(d[iim1]+d[iip1])*temp1
+					zz[0] = z[iim1] * z[iim1]
+					zz[2] = dtiip * dtiip * ((dpsi - temp1) + dphi)
+				} else {
+					temp1 := z[iip1] / dtiip
+					temp1 *= temp1
+					c = temp - dtiim*(dpsi+dphi) - (d[iip1]-d[iim1])*(d[iim1]+d[iip1])*temp1
+					zz[0] = dtiim * dtiim * (dpsi + (dphi - temp1))
+					zz[2] = z[iip1] * z[iip1]
+				}
+			}
+			dd[0] = dtiim
+			dd[1] = delta[ii] * work[ii]
+			dd[2] = dtiip
+			eta, ok = impl.Dlaed6(niter, orgati, c, dd[:], zz[:], w)
+			if !ok {
+				return origin + tau, false
+			}
+		}
+
+		// Note that eta should be positive if w is negative, and eta should
+		// be negative otherwise. However, if for some reason caused by
+		// roundoff eta*w > 0, we simply use one Newton step instead. This
+		// way will guarantee eta*w < 0.
+		if w*eta >= 0 {
+			eta = -w / dw
+		}
+		temp := tau2 + eta
+		if dltub < temp || temp < dltlb {
+			if w < 0 {
+				eta = (dltub - tau2) / 2
+			} else {
+				eta = (dltlb - tau2) / 2
+			}
+		}
+
+		tau2 += eta
+		tau = setTau(tau2)
+		prew := w
+		w, psi, dpsi, phi, dphi, erretm = evaluate()
+		temp = z[ii] / (delta[ii] * work[ii])
+		dw = dpsi + dphi + temp*temp
+		temp *= z[ii]
+		w += temp
+		erretm = 8*(phi-psi) + erretm + 2*rhoinv + 3*math.Abs(temp) + math.Abs(tau2)*dw
+		if w*prew > 0 && math.Abs(w) > math.Abs(prew)/10 {
+			swtch = !swtch
+		}
+	}
+
+	// Return with the iteration not converged.
+	return origin + tau, false
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlasd5 computes the square root of the i-th eigenvalue of a positive
+// symmetric rank-one modification of a 2×2 diagonal matrix
+//
+//	diag(d)*diag(d) + rho * z * zᵀ.
+//
+// The diagonal elements in d are assumed to satisfy 0 <= d[0] < d[1], rho is
+// assumed to be positive and the Euclidean norm of z is assumed to be one.
+//
+// i must be 0 or 1. d, z, delta and work must have length at least 2. On
+// return, delta[j] contains d[j] - sigma_i and work[j] contains
+// d[j] + sigma_i, the information necessary to construct the singular
+// vectors.
+//
+// Dlasd5 returns the computed value sigma_i.
+//
+// Dlasd5 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd5(i int, d, z, delta []float64, rho float64, work []float64) (sigma float64) {
+	switch {
+	case i != 0 && i != 1:
+		panic(badI)
+	case len(d) < 2:
+		panic(shortD)
+	case len(z) < 2:
+		panic(shortZ)
+	case len(delta) < 2:
+		panic(shortDelta)
+	case len(work) < 2:
+		panic(shortWork)
+	}
+
+	del := d[1] - d[0]
+	delsq := del * (d[1] + d[0])
+	if i == 0 {
+		w := 1 + 4*rho*(z[1]*z[1]/(d[0]+3*d[1])-z[0]*z[0]/(3*d[0]+d[1]))/del
+		if w > 0 {
+			b := delsq + rho*(z[0]*z[0]+z[1]*z[1])
+			c := rho * z[0] * z[0] * delsq
+			// b > 0, always.
+			//
+			// The following tau is sigma*sigma - d[0]*d[0].
+			tau := 2 * c / (b + math.Sqrt(math.Abs(b*b-4*c)))
+			// The following tau is sigma - d[0].
+			tau /= d[0] + math.Sqrt(d[0]*d[0]+tau)
+			delta[0] = -tau
+			delta[1] = del - tau
+			work[0] = 2*d[0] + tau
+			work[1] = (d[0] + tau) + d[1]
+			return d[0] + tau
+		}
+		b := -delsq + rho*(z[0]*z[0]+z[1]*z[1])
+		c := rho * z[1] * z[1] * delsq
+		// The following tau is sigma*sigma - d[1]*d[1].
+		var tau float64
+		if b > 0 {
+			tau = -2 * c / (b + math.Sqrt(b*b+4*c))
+		} else {
+			tau = (b - math.Sqrt(b*b+4*c)) / 2
+		}
+		// The following tau is sigma - d[1].
+		tau /= d[1] + math.Sqrt(math.Abs(d[1]*d[1]+tau))
+		delta[0] = -(del + tau)
+		delta[1] = -tau
+		work[0] = d[0] + tau + d[1]
+		work[1] = 2*d[1] + tau
+		return d[1] + tau
+	}
+
+	// Now i == 1.
+	b := -delsq + rho*(z[0]*z[0]+z[1]*z[1])
+	c := rho * z[1] * z[1] * delsq
+	// The following tau is sigma*sigma - d[1]*d[1].
+	var tau float64
+	if b > 0 {
+		tau = (b + math.Sqrt(b*b+4*c)) / 2
+	} else {
+		tau = 2 * c / (-b + math.Sqrt(b*b+4*c))
+	}
+	// The following tau is sigma - d[1].
+	tau /= d[1] + math.Sqrt(d[1]*d[1]+tau)
+	delta[0] = -(del + tau)
+	delta[1] = -tau
+	work[0] = d[0] + tau + d[1]
+	work[1] = 2*d[1] + tau
+	return d[1] + tau
+}
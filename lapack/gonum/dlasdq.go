@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasdq computes the singular value decomposition of a real bidiagonal
+// matrix B with diagonal d and off-diagonal e, possibly with an additional
+// row or column. It is used by the divide and conquer singular value
+// decomposition to solve the subproblems at the bottom of the tree.
+//
+// If uplo == blas.Upper, B is an upper bidiagonal n×(n+sqre) matrix and if
+// uplo == blas.Lower, B is a lower bidiagonal (n+sqre)×n matrix. sqre must be 0
+// or 1. d must have length at least n and contains the diagonal of B. e must
+// have length at least n-1+sqre and contains the off-diagonal of B. If
+// sqre == 1, e[n-1] is the element of the additional column or row.
+//
+// The singular value decomposition of B is computed as
+//
+//	B = Q * S * Pᵀ,
+//
+// and, as in Dbdsqr, Pᵀ * VT, U * Q and Qᵀ * C are computed instead of P and
+// Q. If uplo == blas.Upper, VT is an (n+sqre)×ncvt matrix, U is an nru×n
+// matrix and C is an n×ncc matrix. If uplo == blas.Lower, VT is an n×ncvt
+// matrix, U is an nru×(n+sqre) matrix and C is an (n+sqre)×ncc matrix.
+//
+// On return, d contains the singular values of B in ascending order and e is
+// destroyed.
+//
+// work must have length at least 4*n.
+//
+// Dlasdq returns whether the singular values have been found.
+//
+// Dlasdq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasdq(uplo blas.Uplo, sqre, n, ncvt, nru, ncc int, d, e, vt []float64, ldvt int, u []float64, ldu int, c []float64, ldc int, work []float64) (ok bool) {
+	np1 := n + 1
+	// The number of rows of VT and C and the number of columns of U.
+	nrvt, nrc, ncu := n, n, n
+	if uplo == blas.Upper {
+		nrvt += sqre
+	} else {
+		nrc += sqre
+		ncu += sqre
+	}
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case n < 0:
+		panic(nLT0)
+	case ncvt < 0:
+		panic(ncvtLT0)
+	case nru < 0:
+		panic(nruLT0)
+	case ncc < 0:
+		panic(nccLT0)
+	case ldvt < max(1, ncvt):
+		panic(badLdVT)
+	case ldu < max(1, ncu) && nru > 0, ldu < 1:
+		panic(badLdU)
+	case ldc < max(1, ncc):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1+sqre:
+		panic(shortE)
+	case ncvt > 0 && len(vt) < (nrvt-1)*ldvt+ncvt:
+		panic(shortVT)
+	case nru > 0 && len(u) < (nru-1)*ldu+ncu:
+		panic(shortU)
+	case ncc > 0 && len(c) < (nrc-1)*ldc+ncc:
+		panic(shortC)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	// rotate indicates whether the rotations have to be saved.
+	rotate := ncvt > 0 || nru > 0 || ncc > 0
+	lower := uplo == blas.Lower
+	sqre1 := sqre
+
+	// If the matrix is a non-square upper bidiagonal matrix, rotate it to be
+	// lower bidiagonal. The rotations are on the right but do not affect the
+	// extra column.
+	if !lower && sqre1 == 1 {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+		cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+		d[n-1] = r
+		e[n-1] = 0
+		if rotate {
+			work[n-1] = cs
+			work[2*n-1] = sn
+		}
+		lower = true
+		sqre1 = 0
+
+		// Update the singular vectors if desired.
+		if ncvt > 0 {
+			impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncvt, work[:n], work[n:2*n], vt, ldvt)
+		}
+	}
+
+	// If the matrix is lower bidiagonal, rotate it to be upper bidiagonal by
+	// applying Givens rotations on the left.
+	if lower {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+
+		// If the matrix is (n+1)×n lower bidiagonal, one additional rotation
+		// is needed.
+		if sqre1 == 1 {
+			cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+			d[n-1] = r
+			if rotate {
+				work[n-1] = cs
+				work[2*n-1] = sn
+			}
+		}
+
+		// Update the singular vectors if desired.
+		if nru > 0 {
+			if sqre1 == 0 {
+				impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, n, work[:n-1], work[n:2*n-1], u, ldu)
+			} else {
+				impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, np1, work[:n], work[n:2*n], u, ldu)
+			}
+		}
+		if ncc > 0 {
+			if sqre1 == 0 {
+				impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, n, ncc, work[:n-1], work[n:2*n-1], c, ldc)
+			} else {
+				impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncc, work[:n], work[n:2*n], c, ldc)
+			}
+		}
+	}
+
+	// Compute the singular value decomposition of the reduced n×n upper
+	// bidiagonal matrix.
+	ok = impl.Dbdsqr(blas.Upper, n, ncvt, nru, ncc, d, e, vt, ldvt, u, ldu, c, ldc, work)
+
+	// Sort the singular values into ascending order. Use selection sort to
+	// minimize swaps of singular vectors.
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		// Scan for the smallest d[i].
+		isub := i
+		smin := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < smin {
+				isub = j
+				smin = d[j]
+			}
+		}
+		if isub != i {
+			// Swap the singular values and vectors.
+			d[isub] = d[i]
+			d[i] = smin
+			if ncvt > 0 {
+				bi.Dswap(ncvt, vt[isub*ldvt:], 1, vt[i*ldvt:], 1)
+			}
+			if nru > 0 {
+				bi.Dswap(nru, u[isub:], ldu, u[i:], ldu)
+			}
+			if ncc > 0 {
+				bi.Dswap(ncc, c[isub*ldc:], 1, c[i*ldc:], 1)
+			}
+		}
+	}
+	return ok
+}
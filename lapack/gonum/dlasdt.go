@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlasdt creates a tree of subproblems for bidiagonal divide and conquer.
+//
+// The rows 0, ..., n-1 of the bidiagonal matrix are recursively split at a
+// center row into a left and a right part until the parts have at most msub
+// rows. The nodes of the tree are numbered in level order, the children of the
+// node k are the nodes 2*k+1 and 2*k+2. For each node k, inode[k] contains the
+// center row, ndiml[k] the number of rows of the left part and ndimr[k] the
+// number of rows of the right part.
+//
+// inode, ndiml and ndimr must have length at least n. msub must be positive.
+//
+// Dlasdt returns the number of levels lvl and the number of nodes nd of the
+// tree.
+//
+// Dlasdt is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlasdt(n, msub int, inode, ndiml, ndimr []int) (lvl, nd int) {
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case msub < 1:
+		panic(msubLT1)
+	case len(inode) < n:
+		panic(shortInode)
+	case len(ndiml) < n:
+		panic(shortNdiml)
+	case len(ndimr) < n:
+		panic(shortNdimr)
+	}
+
+	// Find the number of levels on the tree.
+	lvl = int(math.Log2(float64(n)/float64(msub+1))) + 1
+
+	i := n / 2
+	inode[0] = i
+	ndiml[0] = i
+	ndimr[0] = n - i - 1
+	il := -1
+	ir := 0
+	llst := 1
+	for nlvl := 1; nlvl < lvl; nlvl++ {
+		// Construct the tree at the (nlvl+1)-st level. The number of nodes
+		// created on this level is llst*2.
+		for i := 0; i < llst; i++ {
+			il += 2
+			ir += 2
+			ncrnt := llst + i - 1
+			ndiml[il] = ndiml[ncrnt] / 2
+			ndimr[il] = ndiml[ncrnt] - ndiml[il] - 1
+			inode[il] = inode[ncrnt] - ndimr[il] - 1
+			ndiml[ir] = ndimr[ncrnt] / 2
+			ndimr[ir] = ndimr[ncrnt] - ndiml[ir] - 1
+			inode[ir] = inode[ncrnt] + ndiml[ir] + 1
+		}
+		llst *= 2
+	}
+	nd = 2*llst - 1
+	return lvl, nd
+}
@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dormrq multiplies the matrix C by the orthogonal matrix Q defined by the
+// slices a and tau. A and tau are as returned from Dgerqf.
+//
+//	C = Q * C   if side == blas.Left and trans == blas.NoTrans
+//	C = Qᵀ * C  if side == blas.Left and trans == blas.Trans
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans
+//	C = C * Qᵀ  if side == blas.Right and trans == blas.Trans
+//
+// If side == blas.Left, A is a matrix of size k×m, and if side == blas.Right
+// A is of size k×n. A holds the last k rows of the matrix factorized by Dgerqf.
+// This uses a blocked algorithm.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n if side == blas.Left and lwork >= m if side == blas.Right,
+// and this function will panic otherwise.
+// Dormrq uses a block algorithm, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Dormrq,
+// the optimal work length will be stored into work[0].
+//
+// tau contains the Householder scales and must have length at least k, and
+// this function will panic otherwise.
+//
+// Dormrq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormrq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.Trans && trans != blas.NoTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	const (
+		nbmax = 64
+		ldt   = nbmax
+		tsize = nbmax * ldt
+	)
+	opts := string(side) + string(trans)
+	nb := min(nbmax, impl.Ilaenv(1, "DORMRQ", opts, m, n, k, -1))
+	lworkopt := max(1, nw)*nb + tsize
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < k {
+		iws := nw*nb + tsize
+		if lwork < iws {
+			nb = (lwork - tsize) / nw
+			nbmin = max(2, impl.Ilaenv(2, "DORMRQ", opts, m, n, k, -1))
+		}
+	}
+	if nb < nbmin || k <= nb {
+		// Call unblocked code.
+		impl.Dormr2(side, trans, m, n, k, a, lda, tau, c, ldc, work)
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	t := work[:tsize]
+	wrk := work[tsize:]
+	ldwrk := nb
+
+	transt := blas.NoTrans
+	if trans == blas.NoTrans {
+		transt = blas.Trans
+	}
+
+	apply := func(i int) {
+		ib := min(nb, k-i)
+		// Form the triangular factor of the block reflector
+		//  H = H_{i+ib-1} ... H_{i+1} H_i.
+		impl.Dlarft(lapack.Backward, lapack.RowWise, nq-k+i+ib, ib,
+			a[i*lda:], lda,
+			tau[i:],
+			t, ldt)
+		// H or Hᵀ is applied to C[0:m-k+i+ib, 0:n] if side is left,
+		// and to C[0:m, 0:n-k+i+ib] otherwise.
+		mi, ni := m, n
+		if left {
+			mi = m - k + i + ib
+		} else {
+			ni = n - k + i + ib
+		}
+		impl.Dlarfb(side, transt, lapack.Backward, lapack.RowWise, mi, ni, ib,
+			a[i*lda:], lda,
+			t, ldt,
+			c, ldc,
+			wrk, ldwrk)
+	}
+	if left == (trans == blas.Trans) {
+		for i := 0; i < k; i += nb {
+			apply(i)
+		}
+	} else {
+		for i := ((k - 1) / nb) * nb; i >= 0; i -= nb {
+			apply(i)
+		}
+	}
+	work[0] = float64(lworkopt)
+}
@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsbev computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric band matrix A with kd super- or sub-diagonals. A is first reduced
+// to tridiagonal form by Dsbtrd.
+//
+// The band storage scheme of A is the same as in Dpbtrf. On return, ab is
+// overwritten.
+//
+// w contains the eigenvalues in ascending order upon return. w must have
+// length at least n.
+//
+// If jobz == lapack.EVCompute, z contains the orthonormal eigenvectors of A on
+// return, with the i-th column holding the eigenvector associated with w[i].
+// z is not referenced if jobz == lapack.EVNone.
+//
+// work must have length at least max(1,3*n-2).
+//
+// Dsbev returns whether the computation succeeded.
+func (impl Implementation) Dsbev(jobz lapack.EVJob, uplo blas.Uplo, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64) (ok bool) {
+	wantz := jobz == lapack.EVCompute
+	switch {
+	case jobz != lapack.EVNone && !wantz:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case kd < 0:
+		panic(kdLT0)
+	case ldab < kd+1:
+		panic(badLdA)
+	case ldz < 1, wantz && ldz < n:
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(shortAB)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	case len(work) < max(1, 3*n-2):
+		panic(shortWork)
+	}
+
+	if n == 1 {
+		if uplo == blas.Upper {
+			w[0] = ab[0]
+		} else {
+			w[0] = ab[kd]
+		}
+		if wantz {
+			z[0] = 1
+		}
+		return true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale the matrix to the allowable range, if necessary.
+	anrm := impl.Dlansb(lapack.MaxAbs, uplo, n, kd, ab, ldab, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		dscalsb(uplo, n, kd, sigma, ab, ldab)
+	}
+
+	// Reduce the band matrix to tridiagonal form.
+	inde := 0
+	indwrk := inde + n
+	vect := lapack.OrthoNone
+	if wantz {
+		vect = lapack.OrthoExplicit
+	}
+	impl.Dsbtrd(vect, uplo, n, kd, ab, ldab, w, work[inde:indwrk], z, ldz)
+
+	// For eigenvalues only, call Dsterf. For eigenvectors, call Dsteqr.
+	if wantz {
+		ok = impl.Dsteqr(lapack.EVOrig, n, w, work[inde:indwrk], z, ldz, work[indwrk:])
+	} else {
+		ok = impl.Dsterf(n, w, work[inde:indwrk])
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale the eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(n, 1/sigma, w, 1)
+	}
+	return true
+}
+
+// dscalsb multiplies the elements of the symmetric band matrix A stored in ab
+// by alpha.
+func dscalsb(uplo blas.Uplo, n, kd int, alpha float64, ab []float64, ldab int) {
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		if uplo == blas.Upper {
+			bi.Dscal(min(kd+1, n-i), alpha, ab[i*ldab:], 1)
+		} else {
+			k := min(i, kd)
+			bi.Dscal(k+1, alpha, ab[i*ldab+kd-k:], 1)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsbevx computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric band matrix A with kd super- or sub-diagonals. A is first reduced
+// to tridiagonal form T by Dsbtrd, the selected eigenpairs of T are computed
+// by Dstemr and the eigenvectors are transformed back to those of A.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	lapack.EVRangeAll:   all eigenvalues,
+//	lapack.EVRangeValue: the eigenvalues in the half-open interval (vl,vu],
+//	lapack.EVRangeIndex: the il-th through iu-th eigenvalues with zero-based
+//	                     indices in ascending order.
+//
+// If rng == lapack.EVRangeValue, vl must be less than vu. If
+// rng == lapack.EVRangeIndex, il and iu must satisfy 0 <= il <= iu < n if
+// n > 0, and il == 0 and iu == -1 if n == 0.
+//
+// The band storage scheme of A is the same as in Dpbtrf. On return, ab is
+// overwritten.
+//
+// If jobz == lapack.EVCompute, q is used to store the n×n orthogonal matrix
+// used in the reduction to tridiagonal form. q is not referenced if
+// jobz == lapack.EVNone.
+//
+// On return, the first m elements of w contain the selected eigenvalues in
+// ascending order. w must have length at least n.
+//
+// If jobz == lapack.EVCompute, the first m columns of z contain the
+// orthonormal eigenvectors of A corresponding to the selected eigenvalues,
+// with the i-th column holding the eigenvector associated with w[i]. z has n
+// rows and must have at least iu-il+1 columns if rng == lapack.EVRangeIndex,
+// and n columns otherwise, and ldz must be at least that number of columns. z
+// is not referenced if jobz == lapack.EVNone.
+//
+// work must have length at least max(1,20*n) and iwork must have length at
+// least max(1,12*n).
+//
+// Dsbevx returns the number of computed eigenvalues m and whether the
+// computation succeeded.
+func (impl Implementation) Dsbevx(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n, kd int, ab []float64, ldab int, q []float64, ldq int, vl, vu float64, il, iu int, w, z []float64, ldz int, work []float64, iwork []int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && !wantz:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case kd < 0:
+		panic(kdLT0)
+	case ldab < kd+1:
+		panic(badLdA)
+	case ldq < 1, wantz && ldq < n:
+		panic(badLdQ)
+	case rng == lapack.EVRangeValue && n > 0 && vl >= vu:
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || max(0, n-1) < il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || n <= iu):
+		panic(badIu)
+	case ldz < 1, wantz && ldz < ncols:
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(shortAB)
+	case wantz && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+ncols:
+		panic(shortZ)
+	case len(work) < 20*n:
+		panic(shortWork)
+	case len(iwork) < 12*n:
+		panic(shortIWork)
+	}
+
+	if n == 1 {
+		a00 := ab[0]
+		if uplo == blas.Lower {
+			a00 = ab[kd]
+		}
+		if rng != lapack.EVRangeValue || (vl < a00 && vu >= a00) {
+			m = 1
+			w[0] = a00
+			if wantz {
+				z[0] = 1
+			}
+		}
+		return m, true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale the matrix to the allowable range, if necessary.
+	anrm := impl.Dlansb(lapack.MaxAbs, uplo, n, kd, ab, ldab, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	vll, vuu := vl, vu
+	if scaled {
+		dscalsb(uplo, n, kd, sigma, ab, ldab)
+		if rng == lapack.EVRangeValue {
+			vll *= sigma
+			vuu *= sigma
+		}
+	}
+
+	// Reduce the band matrix to tridiagonal form.
+	indd := 0
+	inde := indd + n
+	indwk := inde + n
+	vect := lapack.OrthoNone
+	if wantz {
+		vect = lapack.OrthoExplicit
+	}
+	impl.Dsbtrd(vect, uplo, n, kd, ab, ldab, work[indd:inde], work[inde:indwk], q, ldq)
+
+	// Compute the selected eigenpairs of the tridiagonal matrix. The support
+	// of the eigenvectors is stored at the beginning of iwork.
+	isuppz := iwork[:2*n]
+	m, ok = impl.Dstemr(jobz, rng, n, work[indd:inde], work[inde:indwk], vll, vuu, il, iu, w, z, ldz, ncols, isuppz, true, work[indwk:], len(work)-indwk, iwork[2*n:], len(iwork)-2*n)
+	if !ok {
+		return m, false
+	}
+
+	bi := blas64.Implementation()
+
+	// Apply the orthogonal matrix used in the reduction to tridiagonal form
+	// to the eigenvectors of T one column at a time.
+	if wantz {
+		for j := 0; j < m; j++ {
+			bi.Dcopy(n, z[j:], ldz, work, 1)
+			bi.Dgemv(blas.NoTrans, n, n, 1, q, ldq, work, 1, 0, z[j:], ldz)
+		}
+	}
+
+	// If the matrix was scaled, then rescale the eigenvalues appropriately.
+	if scaled {
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+	return m, true
+}
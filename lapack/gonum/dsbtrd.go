@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsbtrd reduces a real symmetric band matrix A with kd super- or
+// sub-diagonals to symmetric tridiagonal form T by an orthogonal similarity
+// transformation
+//
+//	Qᵀ * A * Q = T
+//
+// The band storage scheme of A is the same as in Dpbtrf. On return, ab is
+// overwritten. The diagonal elements of T are returned in d and the
+// off-diagonal elements in e. d must have length at least n and e must have
+// length at least n-1.
+//
+// The reduction applies plane rotations that annihilate the elements of the
+// outermost diagonal one at a time and chase the resulting bulges off the
+// bottom of the matrix, so that no storage beyond the band of A is needed.
+// The computational cost is O(n²·kd).
+//
+// vect specifies whether and how the orthogonal matrix Q is computed:
+//
+//	vect == lapack.OrthoNone     Q is not computed and q is not referenced.
+//	vect == lapack.OrthoExplicit q is set to the n×n orthogonal matrix Q.
+//	vect == lapack.OrthoPostmul  On entry, q contains an n×n matrix X and on
+//	                             return it contains X*Q.
+//
+// Dsbtrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dsbtrd(vect lapack.OrthoComp, uplo blas.Uplo, n, kd int, ab []float64, ldab int, d, e, q []float64, ldq int) {
+	wantq := vect == lapack.OrthoExplicit || vect == lapack.OrthoPostmul
+	switch {
+	case vect != lapack.OrthoNone && !wantq:
+		panic(badOrthoComp)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case kd < 0:
+		panic(kdLT0)
+	case ldab < kd+1:
+		panic(badLdA)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(shortAB)
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	}
+
+	if vect == lapack.OrthoExplicit {
+		impl.Dlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+
+	// idx returns the position in ab of the element A[i,j] with i <= j and
+	// j-i <= kd.
+	idx := func(i, j int) int {
+		if uplo == blas.Upper {
+			return i*ldab + j - i
+		}
+		return j*ldab + kd + i - j
+	}
+
+	bi := blas64.Implementation()
+
+	// Reduce the bandwidth of A by one in each pass.
+	for b := kd; b > 1; b-- {
+		for i := 0; i+b < n; i++ {
+			// Annihilate the element A[i,i+b] on the outermost diagonal.
+			r := i
+			j := i + b
+			x := ab[idx(r, j)]
+			ab[idx(r, j)] = 0
+			for x != 0 {
+				// Apply the rotation in the (j-1,j) plane that annihilates
+				// the element x at A[r,j] from both sides. All other
+				// elements of A in the rows and columns above r are zero.
+				p := j - 1
+				cs, sn, rr := impl.Dlartg(ab[idx(r, p)], x)
+				ab[idx(r, p)] = rr
+				for k := r + 1; k < p; k++ {
+					kp := idx(k, p)
+					kj := idx(k, j)
+					akp := ab[kp]
+					akj := ab[kj]
+					ab[kp] = cs*akp + sn*akj
+					ab[kj] = cs*akj - sn*akp
+				}
+				pp := idx(p, p)
+				pj := idx(p, j)
+				jj := idx(j, j)
+				app := ab[pp]
+				apj := ab[pj]
+				ajj := ab[jj]
+				ab[pp] = cs*cs*app + 2*cs*sn*apj + sn*sn*ajj
+				ab[jj] = sn*sn*app - 2*cs*sn*apj + cs*cs*ajj
+				ab[pj] = cs*sn*(ajj-app) + (cs-sn)*(cs+sn)*apj
+				for k := j + 1; k <= min(n-1, p+b); k++ {
+					pk := idx(p, k)
+					jk := idx(j, k)
+					apk := ab[pk]
+					ajk := ab[jk]
+					ab[pk] = cs*apk + sn*ajk
+					ab[jk] = cs*ajk - sn*apk
+				}
+				if wantq {
+					bi.Drot(n, q[p:], ldq, q[j:], ldq, cs, sn)
+				}
+
+				// The rotation creates the bulge A[p,j+b] outside the
+				// current band. Chase it down in the next step.
+				k := j + b
+				if k >= n {
+					break
+				}
+				jk := idx(j, k)
+				x = sn * ab[jk]
+				ab[jk] *= cs
+				r = p
+				j = k
+			}
+		}
+	}
+
+	// Copy the tridiagonal matrix into d and e.
+	for i := 0; i < n; i++ {
+		d[i] = ab[idx(i, i)]
+	}
+	for i := 0; i < n-1; i++ {
+		if kd > 0 {
+			e[i] = ab[idx(i, i+1)]
+		} else {
+			e[i] = 0
+		}
+	}
+}
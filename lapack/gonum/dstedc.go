@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstedc computes all eigenvalues and, optionally, eigenvectors of a symmetric
+// tridiagonal matrix using the divide and conquer method. The eigenvectors of
+// a full or band symmetric matrix can also be found if Dsytrd, Dsptrd, or
+// Dsbtrd have been used to reduce this matrix to tridiagonal form.
+//
+// d, on entry, contains the diagonal elements of the tridiagonal matrix. On
+// exit, d contains the eigenvalues in ascending order. d must have length n
+// and Dstedc will panic otherwise.
+//
+// e, on entry, contains the off-diagonal elements of the tridiagonal matrix
+// and it is destroyed on exit. e must have length n-1 and Dstedc will panic
+// otherwise.
+//
+// z, on entry, contains the n×n orthogonal matrix used in the reduction to
+// tridiagonal form if compz == lapack.EVOrig. On exit, if
+// compz == lapack.EVOrig, z contains the orthonormal eigenvectors of the
+// original symmetric matrix, and if compz == lapack.EVTridiag, z contains the
+// orthonormal eigenvectors of the symmetric tridiagonal matrix. z is not used
+// if compz == lapack.EVCompNone.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). If n <= 1 or compz == lapack.EVCompNone, lwork and
+// liwork must be at least 1. Otherwise, if n <= Ilaenv(9, "DSTEDC", ...),
+// lwork must be at least 2*(n-1) and liwork at least 1, and if n is larger
+// than that,
+//
+//	lwork >= 1 + 4*n + n*n    if compz == lapack.EVTridiag,
+//	lwork >= 1 + 4*n + 2*n*n  if compz == lapack.EVOrig,
+//	liwork >= 3 + 5*n.
+//
+// If lwork == -1 or liwork == -1, instead of computing the eigendecomposition
+// the minimum workspace sizes are returned in work[0] and iwork[0].
+//
+// Dstedc returns whether all eigenvalues were found.
+//
+// Dstedc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	switch {
+	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
+		panic(badEVComp)
+	case n < 0:
+		panic(nLT0)
+	case ldz < 1, compz != lapack.EVCompNone && ldz < n:
+		panic(badLdZ)
+	}
+
+	// Compute the workspace requirements.
+	smlsiz := impl.Ilaenv(9, "DSTEDC", " ", 0, 0, 0, 0)
+	var lwmin, liwmin int
+	switch {
+	case n <= 1 || compz == lapack.EVCompNone:
+		lwmin = 1
+		liwmin = 1
+	case n <= smlsiz:
+		lwmin = 2 * (n - 1)
+		liwmin = 1
+	case compz == lapack.EVOrig:
+		lwmin = 1 + 4*n + 2*n*n
+		liwmin = 3 + 5*n
+	default:
+		lwmin = 1 + 4*n + n*n
+		liwmin = 3 + 5*n
+	}
+	lquery := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !lquery:
+		panic(badLWork)
+	case liwork < liwmin && !lquery:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		iwork[0] = 1
+		return true
+	}
+
+	if lquery {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case compz != lapack.EVCompNone && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if compz != lapack.EVCompNone {
+			z[0] = 1
+		}
+		return true
+	}
+
+	// If compz == lapack.EVCompNone, use Dsterf to compute the eigenvalues.
+	if compz == lapack.EVCompNone {
+		return impl.Dsterf(n, d, e)
+	}
+
+	// If n is smaller than the minimum divide size smlsiz+1, then solve the
+	// problem with another solver.
+	if n <= smlsiz {
+		return impl.Dsteqr(compz, n, d, e, z, ldz, work)
+	}
+
+	bi := blas64.Implementation()
+
+	if compz == lapack.EVTridiag {
+		impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+	}
+
+	orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if orgnrm == 0 {
+		return true
+	}
+
+	eps := dlamchE
+	start := 0
+	for start < n {
+		// Let finish be the position of the next subdiagonal entry such that
+		// e[finish] <= tiny or finish = n-1 if no such subdiagonal exists.
+		// The matrix identified by the elements between start and finish
+		// constitutes an independent subproblem.
+		finish := start
+		for finish < n-1 {
+			tiny := eps * math.Sqrt(math.Abs(d[finish])) * math.Sqrt(math.Abs(d[finish+1]))
+			if math.Abs(e[finish]) <= tiny {
+				break
+			}
+			finish++
+		}
+
+		// The subproblem is determined. Compute its size and solve it.
+		m := finish - start + 1
+		if m == 1 {
+			start = finish + 1
+			continue
+		}
+		if m > smlsiz {
+			// Scale.
+			orgnrm := impl.Dlanst(lapack.MaxAbs, m, d[start:], e[start:])
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m, 1, d[start:], 1)
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m-1, 1, e[start:], 1)
+
+			if compz == lapack.EVTridiag {
+				ok = impl.Dlaed0(m, d[start:], e[start:], z[start*ldz+start:], ldz, work, iwork)
+			} else {
+				// Compute the eigenvectors of the subproblem in the
+				// workspace and multiply them back into z.
+				ok = impl.Dlaed0(m, d[start:], e[start:], work, m, work[m*m:], iwork)
+				if ok {
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, n, m, m,
+						1, z[start:], ldz, work, m,
+						0, work[m*m:], m)
+					impl.Dlacpy(blas.All, n, m, work[m*m:], m, z[start:], ldz)
+				}
+			}
+			if !ok {
+				return false
+			}
+
+			// Scale back.
+			impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, m, 1, d[start:], 1)
+		} else {
+			if compz == lapack.EVTridiag {
+				ok = impl.Dsteqr(lapack.EVTridiag, m, d[start:], e[start:], z[start*ldz+start:], ldz, work)
+			} else {
+				// Since QR won't update a z matrix which is larger than the
+				// length of d, we must solve the subproblem in a workspace
+				// and then multiply back into z.
+				ok = impl.Dsteqr(lapack.EVTridiag, m, d[start:], e[start:], work, m, work[m*m:])
+				if ok {
+					impl.Dlacpy(blas.All, n, m, z[start:], ldz, work[m*m:], m)
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, n, m, m,
+						1, work[m*m:], m, work, m,
+						0, z[start:], ldz)
+				}
+			}
+			if !ok {
+				return false
+			}
+		}
+		start = finish + 1
+	}
+
+	// Use selection sort to minimize swaps of eigenvectors.
+	for ii := 1; ii < n; ii++ {
+		i := ii - 1
+		k := i
+		p := d[i]
+		for j := ii; j < n; j++ {
+			if d[j] < p {
+				k = j
+				p = d[j]
+			}
+		}
+		if k != i {
+			d[k] = d[i]
+			d[i] = p
+			bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+		}
+	}
+
+	work[0] = float64(lwmin)
+	iwork[0] = liwmin
+	return true
+}
@@ -0,0 +1,414 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstemr computes selected eigenvalues and, optionally, eigenvectors of a
+// real symmetric tridiagonal matrix T using the method of multiple relatively
+// robust representations (MRRR). Any such unreduced matrix has a well defined
+// set of pairwise different real eigenvalues and the corresponding real
+// eigenvectors are pairwise orthogonal.
+//
+// For each unreduced block of T, Dstemr computes a representation
+// T - σ*I = L*D*Lᵀ with high relative accuracy, refines the eigenvalues of
+// L*D*Lᵀ and, for eigenvalues that are relatively isolated, computes the
+// eigenvectors by the twisted factorization. For clusters of close
+// eigenvalues, new representations are computed recursively until all
+// eigenvalues are relatively isolated. The eigenvectors are computed in
+// O(n²) operations.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	lapack.EVRangeAll:   all eigenvalues,
+//	lapack.EVRangeValue: the eigenvalues in the half-open interval (vl,vu],
+//	lapack.EVRangeIndex: the il-th through iu-th eigenvalues with zero-based
+//	                     indices in ascending order.
+//
+// If rng == lapack.EVRangeValue, vl must be less than vu. If
+// rng == lapack.EVRangeIndex, il and iu must satisfy 0 <= il <= iu < n if
+// n > 0, and il == 0 and iu == -1 if n == 0.
+//
+// On entry, d contains the n diagonal elements of T and e the n-1
+// off-diagonal elements of T in its first n-1 elements. e must have length at
+// least n since its last element is used as workspace. d and e are
+// overwritten on return.
+//
+// On return, the first m elements of w contain the selected eigenvalues in
+// ascending order. w must have length at least n.
+//
+// If jobz == lapack.EVCompute, the first m columns of z contain the
+// orthonormal eigenvectors of T corresponding to the selected eigenvalues,
+// with the i-th column holding the eigenvector associated with w[i]. z has n
+// rows and nzc columns, and nzc must be at least the number of eigenvectors
+// that are computed: n if rng == lapack.EVRangeAll, iu-il+1 if
+// rng == lapack.EVRangeIndex, and the number of eigenvalues in (vl,vu] if
+// rng == lapack.EVRangeValue. ldz must be at least max(1,nzc). If nzc == -1,
+// a query is assumed and Dstemr returns the required number of columns in m
+// without computing anything. It may be combined with a workspace query. For
+// rng == lapack.EVRangeValue this number is a Sturm count which can in rare
+// cases be smaller than the number of eigenvalues found, in which case Dstemr
+// returns false. nzc == n is always sufficient. isuppz indicates the nonzero
+// elements of z: the i-th eigenvector is nonzero only in the rows isuppz[2*i]
+// through isuppz[2*i+1]. isuppz must have length at least 2*nzc. z and isuppz
+// are not referenced if jobz == lapack.EVNone.
+//
+// If tryrac is true, Dstemr checks whether the tridiagonal matrix defines its
+// eigenvalues to high relative accuracy and, if so, computes them to high
+// relative accuracy. This requires more work.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). lwork must be at least max(1,18*n) and liwork at least
+// max(1,10*n) if jobz == lapack.EVCompute, and lwork must be at least
+// max(1,12*n) and liwork at least max(1,8*n) if jobz == lapack.EVNone. If
+// lwork == -1 or liwork == -1, instead of computing the eigendecomposition the
+// minimum workspace sizes are stored in work[0] and iwork[0].
+//
+// Dstemr returns the number of computed eigenvalues m and whether the
+// computation succeeded.
+func (impl Implementation) Dstemr(jobz lapack.EVJob, rng lapack.EVRange, n int, d, e []float64, vl, vu float64, il, iu int, w, z []float64, ldz, nzc int, isuppz []int, tryrac bool, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && n > 0 && vl >= vu:
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || max(0, n-1) < il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || n <= iu):
+		panic(badIu)
+	case nzc < -1:
+		panic(badNzc)
+	case ldz < 1, wantz && nzc != -1 && ldz < nzc:
+		panic(badLdZ)
+	}
+
+	// Compute the workspace requirements. Dstemr needs work of length 6*n
+	// and iwork of length 3*n, Dlarre needs work of length 6*n and iwork of
+	// length 5*n, and Dlarrv needs work of length 12*n and iwork of length
+	// 7*n.
+	lwmin := max(1, 12*n)
+	liwmin := max(1, 8*n)
+	if wantz {
+		lwmin = max(1, 18*n)
+		liwmin = max(1, 10*n)
+	}
+	lquery := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !lquery:
+		panic(badLWork)
+	case liwork < liwmin && !lquery:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lquery {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+	}
+
+	var nzcmin int
+	if wantz && n > 0 && (!lquery || nzc == -1) {
+		switch rng {
+		case lapack.EVRangeAll:
+			nzcmin = n
+		case lapack.EVRangeValue:
+			switch {
+			case len(d) < n:
+				panic(shortD)
+			case len(e) < n-1:
+				panic(shortE)
+			}
+			nzcmin, _, _ = impl.Dlarrc(true, n, vl, vu, d, e, dlamchS)
+		case lapack.EVRangeIndex:
+			nzcmin = iu - il + 1
+		}
+	}
+	if nzc == -1 {
+		return nzcmin, true
+	}
+	if lquery {
+		return 0, true
+	}
+	if nzc < nzcmin {
+		panic(badNzc)
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		iwork[0] = 1
+		return 0, true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n:
+		panic(shortE)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+nzc:
+		panic(shortZ)
+	case wantz && len(isuppz) < 2*nzc:
+		panic(shortIsuppz)
+	}
+
+	wl, wu := vl, vu
+
+	if n == 1 {
+		if rng != lapack.EVRangeValue || (wl < d[0] && wu >= d[0]) {
+			m = 1
+			w[0] = d[0]
+			if wantz {
+				z[0] = 1
+				isuppz[0] = 0
+				isuppz[1] = 0
+			}
+		}
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return m, true
+	}
+
+	bi := blas64.Implementation()
+
+	var nsplit int
+	if n == 2 {
+		r1, r2, cs, sn := impl.Dlaev2(d[0], e[0], d[1])
+		// The eigenvalues returned by Dlaev2 satisfy |r1| >= |r2| but the
+		// following requires r1 >= r2, so correct the order if necessary.
+		laeswap := false
+		if r1 < r2 {
+			r1, r2 = r2, r1
+			laeswap = true
+		}
+		// setSupport sets the support of the eigenvector in the j-th column
+		// of z. At most one of its two elements can be zero.
+		setSupport := func(j int) {
+			isuppz[2*j] = 0
+			isuppz[2*j+1] = 1
+			if z[j] == 0 {
+				isuppz[2*j] = 1
+			}
+			if z[ldz+j] == 0 {
+				isuppz[2*j+1] = 0
+			}
+		}
+		if rng == lapack.EVRangeAll ||
+			(rng == lapack.EVRangeValue && r2 > wl && r2 <= wu) ||
+			(rng == lapack.EVRangeIndex && il == 0) {
+			w[m] = r2
+			if wantz {
+				if laeswap {
+					z[m] = cs
+					z[ldz+m] = sn
+				} else {
+					z[m] = -sn
+					z[ldz+m] = cs
+				}
+				setSupport(m)
+			}
+			m++
+		}
+		if rng == lapack.EVRangeAll ||
+			(rng == lapack.EVRangeValue && r1 > wl && r1 <= wu) ||
+			(rng == lapack.EVRangeIndex && iu == 1) {
+			w[m] = r1
+			if wantz {
+				if laeswap {
+					z[m] = -sn
+					z[ldz+m] = cs
+				} else {
+					z[m] = cs
+					z[ldz+m] = sn
+				}
+				setSupport(m)
+			}
+			m++
+		}
+	} else {
+		// Continue with the general n.
+		gers := work[:2*n]
+		werr := work[2*n : 3*n]
+		wgap := work[3*n : 4*n]
+		dcopy := work[4*n : 5*n]
+		e2 := work[5*n : 6*n]
+		wrk := work[6*n:]
+		isplit := iwork[:n]
+		iblock := iwork[n : 2*n]
+		indexw := iwork[2*n : 3*n]
+		iwrk := iwork[3*n:]
+
+		// Scale the matrix to the allowable range, if necessary. The
+		// allowable range is related to pivmin. The preference for scaling
+		// small values up is heuristic, matrices are not expected to be
+		// close to the rmax threshold.
+		scale := 1.0
+		tnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+		if tnrm > 0 && tnrm < rmin {
+			scale = rmin / tnrm
+		} else if tnrm > rmax {
+			scale = rmax / tnrm
+		}
+		if scale != 1 {
+			bi.Dscal(n, scale, d, 1)
+			bi.Dscal(n-1, scale, e, 1)
+			tnrm *= scale
+			if rng == lapack.EVRangeValue {
+				// If the eigenvalues in an interval have to be found,
+				// scale (wl,wu] accordingly.
+				wl *= scale
+				wu *= scale
+			}
+		}
+
+		// Compute the desired eigenvalues of the tridiagonal after
+		// splitting into smaller blocks if the corresponding off-diagonal
+		// elements are small. thresh is the splitting parameter for
+		// Dlarre. A negative thresh forces the splitting criterion based on
+		// the size of the off-diagonal elements, and a positive thresh
+		// switches to splitting which preserves relative accuracy.
+		thresh := -eps
+		if tryrac && impl.Dlarrr(n, d, e) {
+			thresh = eps
+		} else {
+			// Relative accuracy is desired but T does not guarantee it.
+			tryrac = false
+		}
+
+		if tryrac {
+			// Copy the original diagonal, needed to guarantee relative
+			// accuracy.
+			copy(dcopy, d[:n])
+		}
+		// Store the squares of the off-diagonal elements of T.
+		for j := 0; j < n-1; j++ {
+			e2[j] = e[j] * e[j]
+		}
+
+		// Set the tolerance parameters for bisection.
+		rtol1 := 4 * eps
+		rtol2 := 4 * eps
+		if wantz {
+			// Dlarre computes the eigenvalues to less than full precision.
+			// Dlarrv will refine the eigenvalue approximations, so less
+			// accurate initial bisection in Dlarre is needed.
+			rtol1 = math.Sqrt(eps)
+			rtol2 = math.Max(math.Sqrt(eps)*5e-3, 4*eps)
+		}
+		var pivmin float64
+		wl, wu, nsplit, m, pivmin, ok = impl.Dlarre(rng, n, wl, wu, il, iu, d, e, e2, rtol1, rtol2, thresh, isplit, w, werr, wgap, iblock, indexw, gers, wrk, iwrk)
+		if !ok {
+			return m, false
+		}
+		if wantz && m > nzc {
+			// The Sturm count in (vl,vu] used to validate nzc can in rare
+			// cases be smaller than the number of eigenvalues found.
+			return m, false
+		}
+		// All desired eigenvalues are contained in (wl,wu].
+
+		if wantz {
+			// Compute the eigenvectors corresponding to the computed
+			// eigenvalues.
+			const minrgp = 1e-3
+			ok = impl.Dlarrv(n, wl, wu, d, e, pivmin, isplit, m, minrgp, rtol1, rtol2, w, werr, wgap, iblock, indexw, gers, z, ldz, isuppz, wrk, iwrk)
+			if !ok {
+				return m, false
+			}
+		} else {
+			// Dlarre computes the eigenvalues of the shifted root
+			// representations, so apply the corresponding shifts to obtain
+			// the eigenvalues of the original matrix.
+			for j := 0; j < m; j++ {
+				w[j] += e[isplit[iblock[j]]]
+			}
+		}
+
+		if tryrac && m > 0 {
+			// Refine the computed eigenvalues so that they are relatively
+			// accurate with respect to the original matrix T.
+			ibegin := 0
+			wbegin := 0
+			for jblk := 0; jblk <= iblock[m-1]; jblk++ {
+				iend := isplit[jblk]
+				in := iend - ibegin + 1
+				wend := wbegin - 1
+				// Check if any eigenvalues have to be refined in this
+				// block.
+				for wend < m-1 && iblock[wend+1] == jblk {
+					wend++
+				}
+				if wend < wbegin {
+					ibegin = iend + 1
+					continue
+				}
+				offset := indexw[wbegin]
+				ifirst := indexw[wbegin]
+				ilast := indexw[wend]
+				impl.Dlarrj(in, dcopy[ibegin:], e2[ibegin:], ifirst, ilast, 4*eps, offset, w[wbegin:], werr[wbegin:], wrk, iwrk, pivmin, tnrm)
+				ibegin = iend + 1
+				wbegin = wend + 1
+			}
+		}
+
+		// If the matrix was scaled, then rescale the eigenvalues
+		// appropriately.
+		if scale != 1 {
+			bi.Dscal(m, 1/scale, w, 1)
+		}
+	}
+
+	// If the eigenvalues are not in increasing order, then sort them,
+	// possibly along with the eigenvectors.
+	if nsplit > 1 || n == 2 {
+		if !wantz {
+			impl.Dlasrt(lapack.SortIncreasing, m, w)
+		} else {
+			// Use selection sort to minimize swaps of eigenvectors.
+			for j := 0; j < m-1; j++ {
+				i := -1
+				tmp := w[j]
+				for jj := j + 1; jj < m; jj++ {
+					if w[jj] < tmp {
+						i = jj
+						tmp = w[jj]
+					}
+				}
+				if i != -1 {
+					w[i] = w[j]
+					w[j] = tmp
+					bi.Dswap(n, z[i:], ldz, z[j:], ldz)
+					isuppz[2*i], isuppz[2*j] = isuppz[2*j], isuppz[2*i]
+					isuppz[2*i+1], isuppz[2*j+1] = isuppz[2*j+1], isuppz[2*i+1]
+				}
+			}
+		}
+	}
+
+	work[0] = float64(lwmin)
+	iwork[0] = liwmin
+	return m, true
+}
@@ -0,0 +1,181 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A. If the eigenvectors are requested, it uses the divide
+// and conquer algorithm which is considerably faster than the implicit QL or
+// QR method used by Dsyev for large matrices.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Dsyevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). If n <= 1, lwork and liwork must be at least 1.
+// Otherwise, if jobz == lapack.EVNone, lwork must be at least 2*n+1 and liwork
+// at least 1, and if jobz == lapack.EVCompute,
+//
+//	lwork >= 1 + 6*n + 2*n*n,
+//	liwork >= 3 + 5*n.
+//
+// The amount of blocking in the reduction to tridiagonal form is limited by
+// the usable length. If lwork == -1 or liwork == -1, instead of computing
+// Dsyevd the optimal work length is stored into work[0] and the minimum
+// iwork length is stored into iwork[0].
+//
+// Dsyevd returns whether all eigenvalues were found.
+func (impl Implementation) Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	wantz := jobz == lapack.EVCompute
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Compute the workspace requirements.
+	var lwmin, liwmin int
+	switch {
+	case n <= 1:
+		lwmin = 1
+		liwmin = 1
+	case wantz:
+		lwmin = 1 + 6*n + 2*n*n
+		liwmin = 3 + 5*n
+	default:
+		lwmin = 2*n + 1
+		liwmin = 1
+	}
+	lquery := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !lquery:
+		panic(badLWork)
+	case liwork < liwmin && !lquery:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		iwork[0] = 1
+		return true
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	lopt := max(lwmin, 2*n+n*nb)
+	if lquery {
+		work[0] = float64(lopt)
+		iwork[0] = liwmin
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	if n == 1 {
+		w[0] = a[0]
+		if wantz {
+			a[0] = 1
+		}
+		work[0] = 1
+		iwork[0] = 1
+		return true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+	}
+
+	// Reduce the symmetric matrix to tridiagonal form.
+	inde := 0
+	indtau := inde + n
+	indwrk := indtau + n
+	llwork := lwork - indwrk
+	indwk2 := indwrk + n*n
+	llwrk2 := lwork - indwk2
+	impl.Dsytrd(uplo, n, a, lda, w, work[inde:], work[indtau:], work[indwrk:], llwork)
+
+	// For eigenvalues only, call Dsterf. For eigenvectors, first call Dstedc
+	// to compute the eigenvectors of the tridiagonal matrix, then generate
+	// the orthogonal matrix used in the reduction and multiply the two.
+	if !wantz {
+		ok = impl.Dsterf(n, w, work[inde:])
+	} else {
+		ok = impl.Dstedc(lapack.EVTridiag, n, w, work[inde:], work[indwrk:], n, work[indwk2:], llwrk2, iwork, liwork)
+		if ok {
+			impl.Dorgtr(uplo, n, a, lda, work[indtau:], work[indwk2:], llwrk2)
+			bi := blas64.Implementation()
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n,
+				1, a, lda, work[indwrk:], n,
+				0, work[indwk2:], n)
+			impl.Dlacpy(blas.All, n, n, work[indwk2:], n, a, lda)
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(n, 1/sigma, w, 1)
+	}
+	work[0] = float64(lopt)
+	iwork[0] = liwmin
+	return true
+}
@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevr computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric matrix A using the method of multiple relatively robust
+// representations (MRRR). A is first reduced to tridiagonal form T by Dsytrd,
+// the selected eigenpairs of T are computed by Dstemr and the eigenvectors are
+// transformed back to those of A. Whenever possible, Dsyevr computes the
+// eigenvalues of T to high relative accuracy.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	lapack.EVRangeAll:   all eigenvalues,
+//	lapack.EVRangeValue: the eigenvalues in the half-open interval (vl,vu],
+//	lapack.EVRangeIndex: the il-th through iu-th eigenvalues with zero-based
+//	                     indices in ascending order.
+//
+// If rng == lapack.EVRangeValue, vl must be less than vu. If
+// rng == lapack.EVRangeIndex, il and iu must satisfy 0 <= il <= iu < n if
+// n > 0, and il == 0 and iu == -1 if n == 0.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On return, the specified triangular region of a
+// is overwritten.
+//
+// On return, the first m elements of w contain the selected eigenvalues in
+// ascending order. w must have length at least n.
+//
+// If jobz == lapack.EVCompute, the first m columns of z contain the
+// orthonormal eigenvectors of A corresponding to the selected eigenvalues,
+// with the i-th column holding the eigenvector associated with w[i]. z has n
+// rows and must have at least iu-il+1 columns if rng == lapack.EVRangeIndex,
+// and n columns otherwise, and ldz must be at least that number of columns. z
+// is not referenced if jobz == lapack.EVNone.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). lwork must be at least max(1,26*n) and liwork at least
+// max(1,12*n). The amount of blocking is limited by the usable length. If
+// lwork == -1 or liwork == -1, instead of computing Dsyevr the optimal work
+// length is stored into work[0] and the minimum iwork length into iwork[0].
+//
+// Dsyevr returns the number of computed eigenvalues m and whether the
+// computation succeeded.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case rng == lapack.EVRangeValue && n > 0 && vl >= vu:
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && (il < 0 || max(0, n-1) < il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || n <= iu):
+		panic(badIu)
+	case ldz < 1, wantz && ldz < ncols:
+		panic(badLdZ)
+	}
+
+	lwmin := max(1, 26*n)
+	liwmin := max(1, 12*n)
+	lquery := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !lquery:
+		panic(badLWork)
+	case liwork < liwmin && !lquery:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		iwork[0] = 1
+		return 0, true
+	}
+
+	nb := max(impl.Ilaenv(1, "DSYTRD", string(uplo), n, -1, -1, -1),
+		impl.Ilaenv(1, "DORMQR", "LN", n-1, ncols, n-1, -1))
+	lopt := max(lwmin, (nb+1)*n)
+	if lquery {
+		work[0] = float64(lopt)
+		iwork[0] = liwmin
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+ncols:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if rng != lapack.EVRangeValue || (vl < a[0] && vu >= a[0]) {
+			m = 1
+			w[0] = a[0]
+			if wantz {
+				z[0] = 1
+			}
+		}
+		work[0] = float64(lopt)
+		iwork[0] = liwmin
+		return m, true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale the matrix to the allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	vll, vuu := vl, vu
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+		if rng == lapack.EVRangeValue {
+			vll *= sigma
+			vuu *= sigma
+		}
+	}
+
+	// Reduce the symmetric matrix to tridiagonal form.
+	indtau := 0
+	indd := indtau + n
+	inde := indd + n
+	indwk := inde + n
+	llwork := lwork - indwk
+	impl.Dsytrd(uplo, n, a, lda, work[indd:inde], work[inde:indwk], work[indtau:indd], work[indwk:], llwork)
+
+	// Compute the selected eigenpairs of the tridiagonal matrix. The support
+	// of the eigenvectors is stored at the beginning of iwork.
+	isuppz := iwork[:2*n]
+	m, ok = impl.Dstemr(jobz, rng, n, work[indd:inde], work[inde:indwk], vll, vuu, il, iu, w, z, ldz, ncols, isuppz, true, work[indwk:], llwork, iwork[2*n:], liwork-2*n)
+	if !ok {
+		return m, false
+	}
+
+	// Apply the orthogonal matrix used in the reduction to tridiagonal form
+	// to the eigenvectors of T.
+	if wantz && m > 0 {
+		tau := work[indtau : indtau+n-1]
+		if uplo == blas.Lower {
+			// Q = H_0 * H_1 * ... * H_{n-2} is defined by the QR
+			// factorization of A[1:n,0:n-1].
+			impl.Dormqr(blas.Left, blas.NoTrans, n-1, m, n-1, a[lda:], lda, tau, z[ldz:], ldz, work[indwk:], llwork)
+		} else {
+			// Q = H_{n-2} * ... * H_1 * H_0 where the vector defining H_i
+			// is stored in A[0:i,i+1] with a unit element at A[i,i+1].
+			for i := 0; i < n-1; i++ {
+				aii := a[i*lda+i+1]
+				a[i*lda+i+1] = 1
+				impl.Dlarf(blas.Left, i+1, m, a[i+1:], lda, tau[i], z, ldz, work[indwk:])
+				a[i*lda+i+1] = aii
+			}
+		}
+	}
+
+	// If the matrix was scaled, then rescale the eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+	work[0] = float64(lopt)
+	iwork[0] = liwmin
+	return m, true
+}
@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dsytrf computes the factorization of a real symmetric n×n matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//
+//	A = U*D*Uᵀ  if uplo == blas.Upper, or
+//	A = L*D*Lᵀ  if uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks.
+//
+// On return, A contains the block diagonal matrix D and the multipliers used
+// to obtain the factor U or L.
+//
+// If uplo == blas.Upper, then
+//
+//	U = P[n-1]*U[n-1]* ... *P[k]*U[k]* ...,
+//
+// where k decreases from n-1 to 0 in steps of 1 or 2, and D is a block
+// diagonal matrix with 1×1 and 2×2 diagonal blocks D[k]. P[k] is a permutation
+// matrix as defined by ipiv[k], and U[k] is a unit upper triangular matrix,
+// such that if the diagonal block D[k] is of order s (s = 1 or 2), then
+//
+//	       (   I    v    0   )   k-s
+//	U[k] = (   0    I    0   )   s
+//	       (   0    0    I   )   n-k-1
+//	          k-s   s  n-k-1
+//
+// If s = 1, D[k] overwrites A[k,k], and v overwrites A[:k,k].
+// If s = 2, the upper triangle of D[k] overwrites A[k-1,k-1], A[k-1,k] and
+// A[k,k], and v overwrites A[:k-1,k-1:k+1].
+//
+// If uplo == blas.Lower, then
+//
+//	L = P[0]*L[0]* ... *P[k]*L[k]* ...,
+//
+// where k increases from 0 to n-1 in steps of 1 or 2, and D is a block
+// diagonal matrix with 1×1 and 2×2 diagonal blocks D[k]. P[k] is a permutation
+// matrix as defined by ipiv[k], and L[k] is a unit lower triangular matrix,
+// such that if the diagonal block D[k] is of order s (s = 1 or 2), then
+//
+//	       (   I    0     0   )  k
+//	L[k] = (   0    I     0   )  s
+//	       (   0    v     I   )  n-k-s
+//	           k    s  n-k-s
+//
+// If s = 1, D[k] overwrites A[k,k], and v overwrites A[k+1:,k].
+// If s = 2, the lower triangle of D[k] overwrites A[k,k], A[k+1,k] and
+// A[k+1,k+1], and v overwrites A[k+2:,k:k+2].
+//
+// On return, ipiv contains details of the interchanges and the block structure
+// of D. If ipiv[k] >= 0, then rows and columns k and ipiv[k] were interchanged
+// and D[k,k] is a 1×1 diagonal block. If uplo == blas.Upper and
+// ipiv[k] = ipiv[k-1] < 0, then rows and columns k-1 and -ipiv[k]-1 were
+// interchanged and D[k-1:k+1,k-1:k+1] is a 2×2 diagonal block. If
+// uplo == blas.Lower and ipiv[k] = ipiv[k+1] < 0, then rows and columns k+1
+// and -ipiv[k]-1 were interchanged and D[k:k+2,k:k+2] is a 2×2 diagonal
+// block. ipiv must have length n, otherwise Dsytrf will panic.
+//
+// work must have length at least max(1,lwork), and lwork must be at least 1,
+// otherwise Dsytrf will panic. For optimum performance lwork should be at
+// least n*nb, where nb is the optimal block size.
+//
+// If lwork == -1, instead of performing Dsytrf, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// Dsytrf returns whether D is nonsingular. If ok is false, the factorization
+// has been completed, but the block diagonal matrix D is exactly singular and
+// division by zero will occur if it is used to solve a system of equations.
+func (impl Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Determine the block size.
+	nb := impl.Ilaenv(1, "DSYTRF", string(uplo), n, -1, -1, -1)
+	lwkopt := max(1, n*nb)
+
+	if lwork == -1 {
+		work[0] = float64(lwkopt)
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < n {
+		if lwork < n*nb {
+			nb = max(lwork/n, 1)
+			nbmin = max(2, impl.Ilaenv(2, "DSYTRF", string(uplo), n, -1, -1, -1))
+		}
+	}
+	if nb < nbmin {
+		nb = n
+	}
+	// The workspace matrix W used by Dlasyf is n×nb.
+	ldw := nb
+
+	ok = true
+	if uplo == blas.Upper {
+		// Factorize A as U*D*Uᵀ using the upper triangle of A.
+		//
+		// k is the number of leading rows and columns of A that have not
+		// been factorized yet. It decreases from n to 0 in steps of kb,
+		// the size of the block factorized by Dlasyf or Dsytf2.
+		for k := n; k > 0; {
+			var (
+				kb    int
+				iinfo bool
+			)
+			if k > nb {
+				// Factorize columns k-kb:k of A and use blocked
+				// code to update columns :k-kb.
+				kb, iinfo = impl.Dlasyf(uplo, k, nb, a, lda, ipiv[:k], work, ldw)
+			} else {
+				// Use unblocked code to factorize columns :k of A.
+				iinfo = impl.Dsytf2(uplo, k, a, lda, ipiv[:k])
+				kb = k
+			}
+			ok = ok && iinfo
+			k -= kb
+		}
+		work[0] = float64(lwkopt)
+		return ok
+	}
+
+	// Factorize A as L*D*Lᵀ using the lower triangle of A.
+	//
+	// k is the index of the first row and column of A that has not been
+	// factorized yet. It increases from 0 to n in steps of kb, the size of
+	// the block factorized by Dlasyf or Dsytf2.
+	for k := 0; k < n; {
+		var (
+			kb    int
+			iinfo bool
+		)
+		if k < n-nb {
+			// Factorize columns k:k+kb of A and use blocked code to
+			// update columns k+kb:.
+			kb, iinfo = impl.Dlasyf(uplo, n-k, nb, a[k*lda+k:], lda, ipiv[k:], work, ldw)
+		} else {
+			// Use unblocked code to factorize columns k: of A.
+			iinfo = impl.Dsytf2(uplo, n-k, a[k*lda+k:], lda, ipiv[k:])
+			kb = n - k
+		}
+		ok = ok && iinfo
+		// Adjust ipiv.
+		for j := k; j < k+kb; j++ {
+			if ipiv[j] >= 0 {
+				ipiv[j] += k
+			} else {
+				ipiv[j] -= k
+			}
+		}
+		k += kb
+	}
+	work[0] = float64(lwkopt)
+	return ok
+}
@@ -0,0 +1,188 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dsytri computes the inverse of a real symmetric indefinite n×n matrix A
+// using the factorization
+//
+//	A = U*D*Uᵀ  if uplo == blas.Upper, or
+//	A = L*D*Lᵀ  if uplo == blas.Lower,
+//
+// computed by Dsytrf. a and ipiv contain the details of the factorization as
+// returned by Dsytrf.
+//
+// On return, if ok is true, the upper or lower triangle of A, depending on
+// uplo, contains the corresponding triangle of the symmetric inverse of the
+// original matrix. If ok is false, D is exactly singular, the inverse could
+// not be computed and A is not modified.
+//
+// work is a temporary data slice of length at least n and Dsytri will panic
+// otherwise.
+func (Implementation) Dsytri(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < n:
+		panic(shortWork)
+	}
+
+	// Check that the diagonal matrix D is nonsingular.
+	for i := 0; i < n; i++ {
+		if ipiv[i] >= 0 && a[i*lda+i] == 0 {
+			return false
+		}
+	}
+
+	bi := blas64.Implementation()
+
+	if uplo == blas.Upper {
+		// Compute inv(A) from the factorization A = U*D*Uᵀ.
+		//
+		// k is the main loop index, increasing from 0 to n-1 in steps
+		// of 1 or 2, depending on the size of the diagonal blocks.
+		for k := 0; k < n; {
+			var kstep int
+			if ipiv[k] >= 0 {
+				// 1×1 diagonal block.
+				//
+				// Invert the diagonal block.
+				a[k*lda+k] = 1 / a[k*lda+k]
+				// Compute column k of the inverse.
+				if k > 0 {
+					bi.Dcopy(k, a[k:], lda, work, 1)
+					bi.Dsymv(uplo, k, -1, a, lda, work, 1, 0, a[k:], lda)
+					a[k*lda+k] -= bi.Ddot(k, work, 1, a[k:], lda)
+				}
+				kstep = 1
+			} else {
+				// 2×2 diagonal block.
+				//
+				// Invert the diagonal block.
+				t := math.Abs(a[k*lda+k+1])
+				ak := a[k*lda+k] / t
+				akp1 := a[(k+1)*lda+k+1] / t
+				akkp1 := a[k*lda+k+1] / t
+				d := t * (ak*akp1 - 1)
+				a[k*lda+k] = akp1 / d
+				a[(k+1)*lda+k+1] = ak / d
+				a[k*lda+k+1] = -akkp1 / d
+				// Compute columns k and k+1 of the inverse.
+				if k > 0 {
+					bi.Dcopy(k, a[k:], lda, work, 1)
+					bi.Dsymv(uplo, k, -1, a, lda, work, 1, 0, a[k:], lda)
+					a[k*lda+k] -= bi.Ddot(k, work, 1, a[k:], lda)
+					a[k*lda+k+1] -= bi.Ddot(k, a[k:], lda, a[k+1:], lda)
+					bi.Dcopy(k, a[k+1:], lda, work, 1)
+					bi.Dsymv(uplo, k, -1, a, lda, work, 1, 0, a[k+1:], lda)
+					a[(k+1)*lda+k+1] -= bi.Ddot(k, work, 1, a[k+1:], lda)
+				}
+				kstep = 2
+			}
+
+			// Interchange rows and columns k and kp in the leading
+			// submatrix A[:k+1,:k+1].
+			kp := ipiv[k]
+			if kp < 0 {
+				kp = -kp - 1
+			}
+			if kp != k {
+				bi.Dswap(kp, a[k:], lda, a[kp:], lda)
+				bi.Dswap(k-kp-1, a[(kp+1)*lda+k:], lda, a[kp*lda+kp+1:], 1)
+				a[k*lda+k], a[kp*lda+kp] = a[kp*lda+kp], a[k*lda+k]
+				if kstep == 2 {
+					a[k*lda+k+1], a[kp*lda+k+1] = a[kp*lda+k+1], a[k*lda+k+1]
+				}
+			}
+			k += kstep
+		}
+		return true
+	}
+
+	// Compute inv(A) from the factorization A = L*D*Lᵀ.
+	//
+	// k is the main loop index, decreasing from n-1 to 0 in steps of 1 or
+	// 2, depending on the size of the diagonal blocks.
+	for k := n - 1; k >= 0; {
+		var kstep int
+		if ipiv[k] >= 0 {
+			// 1×1 diagonal block.
+			//
+			// Invert the diagonal block.
+			a[k*lda+k] = 1 / a[k*lda+k]
+			// Compute column k of the inverse.
+			if k < n-1 {
+				bi.Dcopy(n-k-1, a[(k+1)*lda+k:], lda, work, 1)
+				bi.Dsymv(uplo, n-k-1, -1, a[(k+1)*lda+k+1:], lda, work, 1, 0, a[(k+1)*lda+k:], lda)
+				a[k*lda+k] -= bi.Ddot(n-k-1, work, 1, a[(k+1)*lda+k:], lda)
+			}
+			kstep = 1
+		} else {
+			// 2×2 diagonal block.
+			//
+			// Invert the diagonal block.
+			t := math.Abs(a[k*lda+k-1])
+			ak := a[(k-1)*lda+k-1] / t
+			akp1 := a[k*lda+k] / t
+			akkp1 := a[k*lda+k-1] / t
+			d := t * (ak*akp1 - 1)
+			a[(k-1)*lda+k-1] = akp1 / d
+			a[k*lda+k] = ak / d
+			a[k*lda+k-1] = -akkp1 / d
+			// Compute columns k-1 and k of the inverse.
+			if k < n-1 {
+				bi.Dcopy(n-k-1, a[(k+1)*lda+k:], lda, work, 1)
+				bi.Dsymv(uplo, n-k-1, -1, a[(k+1)*lda+k+1:], lda, work, 1, 0, a[(k+1)*lda+k:], lda)
+				a[k*lda+k] -= bi.Ddot(n-k-1, work, 1, a[(k+1)*lda+k:], lda)
+				a[k*lda+k-1] -= bi.Ddot(n-k-1, a[(k+1)*lda+k:], lda, a[(k+1)*lda+k-1:], lda)
+				bi.Dcopy(n-k-1, a[(k+1)*lda+k-1:], lda, work, 1)
+				bi.Dsymv(uplo, n-k-1, -1, a[(k+1)*lda+k+1:], lda, work, 1, 0, a[(k+1)*lda+k-1:], lda)
+				a[(k-1)*lda+k-1] -= bi.Ddot(n-k-1, work, 1, a[(k+1)*lda+k-1:], lda)
+			}
+			kstep = 2
+		}
+
+		// Interchange rows and columns k and kp in the trailing
+		// submatrix A[k-kstep+1:,k-kstep+1:].
+		kp := ipiv[k]
+		if kp < 0 {
+			kp = -kp - 1
+		}
+		if kp != k {
+			if kp < n-1 {
+				bi.Dswap(n-kp-1, a[(kp+1)*lda+k:], lda, a[(kp+1)*lda+kp:], lda)
+			}
+			bi.Dswap(kp-k-1, a[(k+1)*lda+k:], lda, a[kp*lda+k+1:], 1)
+			a[k*lda+k], a[kp*lda+kp] = a[kp*lda+kp], a[k*lda+k]
+			if kstep == 2 {
+				a[k*lda+k-1], a[kp*lda+k-1] = a[kp*lda+k-1], a[k*lda+k-1]
+			}
+		}
+		k -= kstep
+	}
+	return true
+}
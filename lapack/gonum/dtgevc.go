@@ -0,0 +1,729 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtgevc computes some or all of the right and/or left eigenvectors of a pair
+// of n×n real matrices (S,P), where S is quasi-triangular and P is upper
+// triangular. Matrix pairs of this type are produced by the generalized Schur
+// factorization of a matrix pair (A,B):
+//
+//	A = Q*S*Zᵀ,  B = Q*P*Zᵀ,
+//
+// as computed by Dgghrd followed by Dhgeqz.
+//
+// The right eigenvector x and the left eigenvector y of (S,P) corresponding
+// to an eigenvalue w are defined by:
+//
+//	S*x = w*P*x,  yᴴ*S = w*yᴴ*P,
+//
+// The eigenvalues are not input to this routine, but are computed directly
+// from the diagonal blocks of S and P.
+//
+// This routine returns the matrices X and/or Y of right and left eigenvectors
+// of (S,P), or the products Z*X and/or Q*Y, where Z and Q are input matrices.
+// If Q and Z are the orthogonal factors from the generalized Schur
+// factorization of a matrix pair (A,B), then Z*X and Q*Y are the matrices of
+// right and left eigenvectors of (A,B).
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Dtgevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors will be
+// computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and multiplied from left by the matrices in VR and/or VL.
+// If howmny == lapack.EVSelected, right and/or left eigenvectors will be
+// computed as indicated by selected.
+// For other values of howmny, Dtgevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise.
+// If w_j is a real eigenvalue, the corresponding real eigenvector will be
+// computed if selected[j] is true.
+// If w_j and w_{j+1} are a complex conjugate pair of eigenvalues, the
+// corresponding complex eigenvector is computed if either selected[j] or
+// selected[j+1] is true.
+//
+// S must be in the generalized Schur canonical form as returned by Dhgeqz,
+// that is, S is block upper triangular with 1×1 and 2×2 diagonal blocks, and
+// the 2×2 diagonal blocks of P corresponding to 2×2 blocks of S must be in
+// positive diagonal form. Otherwise Dtgevc will panic.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or
+// lapack.EVAllMulQ, mm must be at least n. If howmny is lapack.EVSelected,
+// mm must be large enough to store the selected eigenvectors. Each selected
+// real eigenvector occupies one column and each selected complex eigenvector
+// occupies two columns. If mm is not sufficiently large, Dtgevc will panic.
+//
+// On entry, if howmny is lapack.EVAllMulQ, it is assumed that VL (if side is
+// lapack.EVLeft or lapack.EVBoth) contains an n×n matrix Q, and that VR (if
+// side is lapack.EVRight or lapack.EVBoth) contains an n×n matrix Z. Q and Z
+// are typically the orthogonal matrices of left and right Schur vectors
+// returned by Dhgeqz.
+//
+// On return, if side is lapack.EVLeft or lapack.EVBoth, VL will contain:
+//
+//	if howmny == lapack.EVAll,      the matrix Y of left eigenvectors of (S,P),
+//	if howmny == lapack.EVAllMulQ,  the matrix Q*Y,
+//	if howmny == lapack.EVSelected, the left eigenvectors of (S,P) specified by
+//	                                selected, stored consecutively in the
+//	                                columns of VL, in the same order as their
+//	                                eigenvalues.
+//
+// VL is not referenced if side == lapack.EVRight.
+//
+// On return, if side is lapack.EVRight or lapack.EVBoth, VR will contain:
+//
+//	if howmny == lapack.EVAll,      the matrix X of right eigenvectors of (S,P),
+//	if howmny == lapack.EVAllMulQ,  the matrix Z*X,
+//	if howmny == lapack.EVSelected, the right eigenvectors of (S,P) specified
+//	                                by selected, stored consecutively in the
+//	                                columns of VR, in the same order as their
+//	                                eigenvalues.
+//
+// VR is not referenced if side == lapack.EVLeft.
+//
+// Complex eigenvectors corresponding to a complex eigenvalue are stored in VL
+// and VR in two consecutive columns, the first holding the real part, and the
+// second the imaginary part.
+//
+// Each eigenvector will be scaled so the largest component has
+// |real part| + |imag. part| = 1. If both S[j,j] and P[j,j] are zero, the
+// matrix pair is singular and the j-th eigenvector is set to the j-th unit
+// vector, or, if howmny == lapack.EVAllMulQ, the j-th column of Q or Z is
+// left unchanged.
+//
+// work must have length at least 6*n, otherwise Dtgevc will panic.
+//
+// Dtgevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors. If ok is false, a 2×2 block of (S,P) corresponding to a
+// complex conjugate pair of eigenvalues was found to have real eigenvalues and
+// the eigenvectors could not be computed.
+//
+// Dtgevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool) {
+	bothv := side == lapack.EVBoth
+	rightv := side == lapack.EVRight || bothv
+	leftv := side == lapack.EVLeft || bothv
+	switch {
+	case !rightv && !leftv:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case lds < max(1, n):
+		panic(badLdS)
+	case ldp < max(1, n):
+		panic(badLdP)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1, leftv && ldvl < mm:
+		panic(badLdVL)
+	case ldvr < 1, rightv && ldvr < mm:
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(s) < (n-1)*lds+n:
+		panic(shortS)
+	case len(p) < (n-1)*ldp+n:
+		panic(shortP)
+	case howmny == lapack.EVSelected && len(selected) != n:
+		panic(badLenSelected)
+	case len(work) < 6*n:
+		panic(shortWork)
+	}
+
+	// Count the number of eigenvectors to be computed.
+	ilall := howmny != lapack.EVSelected
+	ilback := howmny == lapack.EVAllMulQ
+	if ilall {
+		m = n
+	} else {
+		for j := 0; j < n; {
+			if j < n-1 && s[(j+1)*lds+j] != 0 {
+				if selected[j] || selected[j+1] {
+					m += 2
+				}
+				j += 2
+			} else {
+				if selected[j] {
+					m++
+				}
+				j++
+			}
+		}
+	}
+	if mm < m {
+		panic(badMm)
+	}
+	switch {
+	case leftv && len(vl) < (n-1)*ldvl+mm:
+		panic(shortVL)
+	case rightv && len(vr) < (n-1)*ldvr+mm:
+		panic(shortVR)
+	}
+
+	// Check the 2×2 blocks.
+	for j := 0; j < n-1; j++ {
+		if s[(j+1)*lds+j] == 0 {
+			continue
+		}
+		if p[j*ldp+j] == 0 || p[(j+1)*ldp+j+1] == 0 || p[j*ldp+j+1] != 0 {
+			panic(notGenSchur)
+		}
+		if j < n-2 && s[(j+2)*lds+j+1] != 0 {
+			panic(notGenSchur)
+		}
+	}
+
+	const (
+		safmin = dlamchS
+		ulp    = dlamchP
+	)
+	var (
+		small  = safmin * float64(n) / ulp
+		big    = 1 / small
+		bignum = 1 / (safmin * float64(n))
+	)
+
+	// Compute the 1-norm of each column of the strictly upper triangular
+	// part of S and P to check for possible overflow in the triangular
+	// solver.
+	anorm := math.Abs(s[0])
+	if n > 1 {
+		anorm += math.Abs(s[lds])
+	}
+	bnorm := math.Abs(p[0])
+	work[0] = 0
+	work[n] = 0
+	for j := 1; j < n; j++ {
+		var temp, temp2 float64
+		iend := j
+		if s[j*lds+j-1] != 0 {
+			iend = j - 1
+		}
+		for i := 0; i < iend; i++ {
+			temp += math.Abs(s[i*lds+j])
+		}
+		for i := 0; i < j; i++ {
+			temp2 += math.Abs(p[i*ldp+j])
+		}
+		work[j] = temp
+		work[n+j] = temp2
+		for i := iend; i < min(j+2, n); i++ {
+			temp += math.Abs(s[i*lds+j])
+			temp2 += math.Abs(p[i*ldp+j])
+		}
+		anorm = math.Max(anorm, temp)
+		bnorm = math.Max(bnorm, temp2)
+	}
+	ascale := 1 / math.Max(anorm, safmin)
+	bscale := 1 / math.Max(bnorm, safmin)
+
+	// realCoef computes the coefficients a and b in (a*S - b*P)*x = 0 for
+	// the real eigenvalue at je, scaled to avoid underflow.
+	realCoef := func(je int) (acoef, bcoefr float64) {
+		temp := 1 / math.Max(math.Max(math.Abs(s[je*lds+je])*ascale, math.Abs(p[je*ldp+je])*bscale), safmin)
+		salfar := (temp * s[je*lds+je]) * ascale
+		sbeta := (temp * p[je*ldp+je]) * bscale
+		acoef = sbeta * ascale
+		bcoefr = salfar * bscale
+
+		scale := 1.0
+		lsa := math.Abs(sbeta) >= safmin && math.Abs(acoef) < small
+		lsb := math.Abs(salfar) >= safmin && math.Abs(bcoefr) < small
+		if lsa {
+			scale = (small / math.Abs(sbeta)) * math.Min(anorm, big)
+		}
+		if lsb {
+			scale = math.Max(scale, (small/math.Abs(salfar))*math.Min(bnorm, big))
+		}
+		if lsa || lsb {
+			scale = math.Min(scale, 1/(safmin*math.Max(1, math.Max(math.Abs(acoef), math.Abs(bcoefr)))))
+			if lsa {
+				acoef = ascale * (scale * sbeta)
+			} else {
+				acoef *= scale
+			}
+			if lsb {
+				bcoefr = bscale * (scale * salfar)
+			} else {
+				bcoefr *= scale
+			}
+		}
+		return acoef, bcoefr
+	}
+
+	// complexCoef scales the coefficients a and b = bcoefr + i*bcoefi of
+	// a complex eigenvalue to avoid over- and underflow.
+	complexCoef := func(acoef, bcoefr, bcoefi float64) (float64, float64, float64) {
+		acoefa := math.Abs(acoef)
+		bcoefa := math.Abs(bcoefr) + math.Abs(bcoefi)
+		scale := 1.0
+		if acoefa*ulp < safmin && acoefa >= safmin {
+			scale = (safmin / ulp) / acoefa
+		}
+		if bcoefa*ulp < safmin && bcoefa >= safmin {
+			scale = math.Max(scale, (safmin/ulp)/bcoefa)
+		}
+		if safmin*acoefa > ascale {
+			scale = ascale / (safmin * acoefa)
+		}
+		if safmin*bcoefa > bscale {
+			scale = math.Min(scale, bscale/(safmin*bcoefa))
+		}
+		if scale != 1 {
+			acoef *= scale
+			bcoefr *= scale
+			bcoefi *= scale
+		}
+		return acoef, bcoefr, bcoefi
+	}
+
+	bi := blas64.Implementation()
+	var bdiag [2]float64
+	var sum, x [4]float64
+
+	if leftv {
+		// Compute left eigenvectors.
+		ieig := -1
+		ilcplx := false
+		for je := 0; je < n; je++ {
+			// Skip this iteration if (a) howmny is lapack.EVSelected
+			// and selected[je] is false, or (b) this would be the
+			// second of a complex pair.
+			if ilcplx {
+				ilcplx = false
+				continue
+			}
+			nw := 1
+			if je < n-1 && s[(je+1)*lds+je] != 0 {
+				ilcplx = true
+				nw = 2
+			}
+			if !ilall {
+				if ilcplx && !selected[je] && !selected[je+1] {
+					continue
+				}
+				if !ilcplx && !selected[je] {
+					continue
+				}
+			}
+
+			// Decide if (a) singular pencil, (b) real eigenvalue, or
+			// (c) complex eigenvalue.
+			if !ilcplx && math.Abs(s[je*lds+je]) <= safmin && math.Abs(p[je*ldp+je]) <= safmin {
+				// Singular matrix pencil: return unit eigenvector.
+				ieig++
+				if !ilback {
+					for jr := 0; jr < n; jr++ {
+						vl[jr*ldvl+ieig] = 0
+					}
+					vl[ieig*ldvl+ieig] = 1
+				}
+				continue
+			}
+
+			// Clear vector.
+			for jr := 2 * n; jr < (2+nw)*n; jr++ {
+				work[jr] = 0
+			}
+
+			// Compute coefficients in (a*S - b*P)ᵀ*y = 0, where a is
+			// acoef and b is bcoefr + i*bcoefi.
+			var acoef, bcoefr, bcoefi, xmax float64
+			if !ilcplx {
+				// Real eigenvalue.
+				acoef, bcoefr = realCoef(je)
+
+				// First component is 1.
+				work[2*n+je] = 1
+				xmax = 1
+			} else {
+				// Complex eigenvalue.
+				acoef, _, bcoefr, _, bcoefi = impl.Dlag2(s[je*lds+je:], lds, p[je*ldp+je:], ldp)
+				bcoefi = -bcoefi
+				if bcoefi == 0 {
+					return m, false
+				}
+				acoef, bcoefr, bcoefi = complexCoef(acoef, bcoefr, bcoefi)
+
+				// Compute first two components of eigenvector.
+				temp := acoef * s[(je+1)*lds+je]
+				temp2r := acoef*s[je*lds+je] - bcoefr*p[je*ldp+je]
+				temp2i := -bcoefi * p[je*ldp+je]
+				if math.Abs(temp) > math.Abs(temp2r)+math.Abs(temp2i) {
+					work[2*n+je] = 1
+					work[3*n+je] = 0
+					work[2*n+je+1] = -temp2r / temp
+					work[3*n+je+1] = -temp2i / temp
+				} else {
+					work[2*n+je+1] = 1
+					work[3*n+je+1] = 0
+					temp = acoef * s[je*lds+je+1]
+					work[2*n+je] = (bcoefr*p[(je+1)*ldp+je+1] - acoef*s[(je+1)*lds+je+1]) / temp
+					work[3*n+je] = bcoefi * p[(je+1)*ldp+je+1] / temp
+				}
+				xmax = math.Max(math.Abs(work[2*n+je])+math.Abs(work[3*n+je]),
+					math.Abs(work[2*n+je+1])+math.Abs(work[3*n+je+1]))
+			}
+			acoefa := math.Abs(acoef)
+			bcoefa := math.Abs(bcoefr) + math.Abs(bcoefi)
+			dmin := math.Max(math.Max(ulp*acoefa*anorm, ulp*bcoefa*bnorm), safmin)
+
+			// Triangular solve of (a*S - b*P)ᵀ*y = 0, rowwise in
+			// (a*S - b*P)ᵀ, or columnwise in (a*S - b*P).
+			il2by2 := false
+			for j := je + nw; j < n; j++ {
+				if il2by2 {
+					il2by2 = false
+					continue
+				}
+				na := 1
+				bdiag[0] = p[j*ldp+j]
+				if j < n-1 && s[(j+1)*lds+j] != 0 {
+					il2by2 = true
+					bdiag[1] = p[(j+1)*ldp+j+1]
+					na = 2
+				}
+
+				// Check whether scaling is necessary for dot products.
+				xscale := 1 / math.Max(1, xmax)
+				temp := math.Max(math.Max(work[j], work[n+j]), acoefa*work[j]+bcoefa*work[n+j])
+				if il2by2 {
+					temp = math.Max(temp, math.Max(math.Max(work[j+1], work[n+j+1]), acoefa*work[j+1]+bcoefa*work[n+j+1]))
+				}
+				if temp > bignum*xscale {
+					for jw := 0; jw < nw; jw++ {
+						bi.Dscal(j-je, xscale, work[(jw+2)*n+je:], 1)
+					}
+					xmax *= xscale
+				}
+
+				// Compute dot products
+				//
+				//	      j-1
+				//	sum = sum  conj(a*S[k,j] - b*P[k,j])*x[k]
+				//	      k=je
+				//
+				// To reduce the op count, this is done as
+				//
+				//	a*conj(sum S[k,j]*x[k]) - b*conj(sum P[k,j]*x[k])
+				//
+				// which may cause underflow problems if S or P
+				// are close to underflow.
+				for ja := 0; ja < na; ja++ {
+					var sums, sump [2]float64
+					for jw := 0; jw < nw; jw++ {
+						xw := work[(jw+2)*n+je : (jw+2)*n+j]
+						sums[jw] = bi.Ddot(j-je, s[je*lds+j+ja:], lds, xw, 1)
+						sump[jw] = bi.Ddot(j-je, p[je*ldp+j+ja:], ldp, xw, 1)
+					}
+					if ilcplx {
+						sum[ja*2] = -acoef*sums[0] + bcoefr*sump[0] - bcoefi*sump[1]
+						sum[ja*2+1] = -acoef*sums[1] + bcoefr*sump[1] + bcoefi*sump[0]
+					} else {
+						sum[ja*2] = -acoef*sums[0] + bcoefr*sump[0]
+					}
+				}
+
+				// Solve (a*S - b*P)ᵀ*y = sum with scaling and
+				// perturbation of the denominator.
+				scale, xnorm, _ := impl.Dlaln2(true, na, nw, dmin, acoef, s[j*lds+j:], lds, bdiag[0], bdiag[1], sum[:], 2, bcoefr, bcoefi, x[:], 2)
+				for ja := 0; ja < na; ja++ {
+					for jw := 0; jw < nw; jw++ {
+						work[(jw+2)*n+j+ja] = x[ja*2+jw]
+					}
+				}
+				if scale < 1 {
+					for jw := 0; jw < nw; jw++ {
+						bi.Dscal(j-je, scale, work[(jw+2)*n+je:], 1)
+					}
+					xmax *= scale
+				}
+				xmax = math.Max(xmax, xnorm)
+			}
+
+			// Copy eigenvector to VL, back transforming if howmny is
+			// lapack.EVAllMulQ.
+			ieig++
+			var ibeg int
+			if ilback {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dgemv(blas.NoTrans, n, n-je, 1, vl[je:], ldvl, work[(jw+2)*n+je:], 1, 0, work[(jw+4)*n:], 1)
+				}
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+4)*n:], 1, vl[je+jw:], ldvl)
+				}
+				ibeg = 0
+			} else {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+2)*n:], 1, vl[ieig+jw:], ldvl)
+				}
+				ibeg = je
+			}
+
+			// Scale eigenvector.
+			xmax = 0
+			for j := ibeg; j < n; j++ {
+				v := math.Abs(vl[j*ldvl+ieig])
+				if ilcplx {
+					v += math.Abs(vl[j*ldvl+ieig+1])
+				}
+				xmax = math.Max(xmax, v)
+			}
+			if xmax > safmin {
+				xscale := 1 / xmax
+				for jw := 0; jw < nw; jw++ {
+					bi.Dscal(n-ibeg, xscale, vl[ibeg*ldvl+ieig+jw:], ldvl)
+				}
+			}
+			ieig += nw - 1
+		}
+	}
+
+	if rightv {
+		// Compute right eigenvectors.
+		ieig := m
+		ilcplx := false
+		for je := n - 1; je >= 0; je-- {
+			// Skip this iteration if (a) howmny is lapack.EVSelected
+			// and selected[je] is false, or (b) this would be the
+			// second of a complex pair. If this is a complex pair, the
+			// 2×2 diagonal block corresponding to the eigenvalue is in
+			// rows/columns je-1:je+1.
+			if ilcplx {
+				ilcplx = false
+				continue
+			}
+			nw := 1
+			if je > 0 && s[je*lds+je-1] != 0 {
+				ilcplx = true
+				nw = 2
+			}
+			if !ilall {
+				if ilcplx && !selected[je] && !selected[je-1] {
+					continue
+				}
+				if !ilcplx && !selected[je] {
+					continue
+				}
+			}
+
+			// Decide if (a) singular pencil, (b) real eigenvalue, or
+			// (c) complex eigenvalue.
+			if !ilcplx && math.Abs(s[je*lds+je]) <= safmin && math.Abs(p[je*ldp+je]) <= safmin {
+				// Singular matrix pencil: return unit eigenvector.
+				ieig--
+				if !ilback {
+					for jr := 0; jr < n; jr++ {
+						vr[jr*ldvr+ieig] = 0
+					}
+					vr[ieig*ldvr+ieig] = 1
+				}
+				continue
+			}
+
+			// Clear vector.
+			for jr := 2 * n; jr < (2+nw)*n; jr++ {
+				work[jr] = 0
+			}
+
+			// Compute coefficients in (a*S - b*P)*x = 0, where a is
+			// acoef and b is bcoefr + i*bcoefi.
+			var acoef, bcoefr, bcoefi, xmax float64
+			if !ilcplx {
+				// Real eigenvalue.
+				acoef, bcoefr = realCoef(je)
+
+				// First component is 1.
+				work[2*n+je] = 1
+				xmax = 1
+
+				// Compute contribution from column je of S and P
+				// to sum.
+				for jr := 0; jr < je; jr++ {
+					work[2*n+jr] = bcoefr*p[jr*ldp+je] - acoef*s[jr*lds+je]
+				}
+			} else {
+				// Complex eigenvalue.
+				acoef, _, bcoefr, _, bcoefi = impl.Dlag2(s[(je-1)*lds+je-1:], lds, p[(je-1)*ldp+je-1:], ldp)
+				if bcoefi == 0 {
+					return m, false
+				}
+				acoef, bcoefr, bcoefi = complexCoef(acoef, bcoefr, bcoefi)
+
+				// Compute first two components of eigenvector and
+				// contribution to sums.
+				temp := acoef * s[je*lds+je-1]
+				temp2r := acoef*s[je*lds+je] - bcoefr*p[je*ldp+je]
+				temp2i := -bcoefi * p[je*ldp+je]
+				if math.Abs(temp) >= math.Abs(temp2r)+math.Abs(temp2i) {
+					work[2*n+je] = 1
+					work[3*n+je] = 0
+					work[2*n+je-1] = -temp2r / temp
+					work[3*n+je-1] = -temp2i / temp
+				} else {
+					work[2*n+je-1] = 1
+					work[3*n+je-1] = 0
+					temp = acoef * s[(je-1)*lds+je]
+					work[2*n+je] = (bcoefr*p[(je-1)*ldp+je-1] - acoef*s[(je-1)*lds+je-1]) / temp
+					work[3*n+je] = bcoefi * p[(je-1)*ldp+je-1] / temp
+				}
+				xmax = math.Max(math.Abs(work[2*n+je])+math.Abs(work[3*n+je]),
+					math.Abs(work[2*n+je-1])+math.Abs(work[3*n+je-1]))
+
+				// Compute contribution from columns je and je-1 of S
+				// and P to the sums.
+				creala := acoef * work[2*n+je-1]
+				cimaga := acoef * work[3*n+je-1]
+				crealb := bcoefr*work[2*n+je-1] - bcoefi*work[3*n+je-1]
+				cimagb := bcoefi*work[2*n+je-1] + bcoefr*work[3*n+je-1]
+				cre2a := acoef * work[2*n+je]
+				cim2a := acoef * work[3*n+je]
+				cre2b := bcoefr*work[2*n+je] - bcoefi*work[3*n+je]
+				cim2b := bcoefi*work[2*n+je] + bcoefr*work[3*n+je]
+				for jr := 0; jr < je-1; jr++ {
+					work[2*n+jr] = -creala*s[jr*lds+je-1] + crealb*p[jr*ldp+je-1] - cre2a*s[jr*lds+je] + cre2b*p[jr*ldp+je]
+					work[3*n+jr] = -cimaga*s[jr*lds+je-1] + cimagb*p[jr*ldp+je-1] - cim2a*s[jr*lds+je] + cim2b*p[jr*ldp+je]
+				}
+			}
+			acoefa := math.Abs(acoef)
+			bcoefa := math.Abs(bcoefr) + math.Abs(bcoefi)
+			dmin := math.Max(math.Max(ulp*acoefa*anorm, ulp*bcoefa*bnorm), safmin)
+
+			// Columnwise triangular solve of (a*S - b*P)*x = 0.
+			il2by2 := false
+			for j := je - nw; j >= 0; j-- {
+				// If a 2×2 block is in position j-1:j+1, wait until
+				// next iteration to process it (when it will be
+				// j:j+2).
+				if !il2by2 && j > 0 && s[j*lds+j-1] != 0 {
+					il2by2 = true
+					continue
+				}
+				bdiag[0] = p[j*ldp+j]
+				na := 1
+				if il2by2 {
+					na = 2
+					bdiag[1] = p[(j+1)*ldp+j+1]
+				}
+
+				// Compute x[j] (and x[j+1], if 2×2 block).
+				for ja := 0; ja < na; ja++ {
+					for jw := 0; jw < nw; jw++ {
+						x[ja*2+jw] = work[(jw+2)*n+j+ja]
+					}
+				}
+				scale, xnorm, _ := impl.Dlaln2(false, na, nw, dmin, acoef, s[j*lds+j:], lds, bdiag[0], bdiag[1], x[:], 2, bcoefr, bcoefi, sum[:], 2)
+				if scale < 1 {
+					for jw := 0; jw < nw; jw++ {
+						bi.Dscal(je+1, scale, work[(jw+2)*n:], 1)
+					}
+				}
+				xmax = math.Max(scale*xmax, xnorm)
+				for ja := 0; ja < na; ja++ {
+					for jw := 0; jw < nw; jw++ {
+						work[(jw+2)*n+j+ja] = sum[ja*2+jw]
+					}
+				}
+
+				// w = w + x[j]*(a*S[:,j] - b*P[:,j]) with scaling.
+				if j > 0 {
+					// Check whether scaling is necessary for sum.
+					xscale := 1 / math.Max(1, xmax)
+					temp := acoefa*work[j] + bcoefa*work[n+j]
+					if il2by2 {
+						temp = math.Max(temp, acoefa*work[j+1]+bcoefa*work[n+j+1])
+					}
+					temp = math.Max(temp, math.Max(acoefa, bcoefa))
+					if temp > bignum*xscale {
+						for jw := 0; jw < nw; jw++ {
+							bi.Dscal(je+1, xscale, work[(jw+2)*n:], 1)
+						}
+						xmax *= xscale
+					}
+
+					// Compute the contributions of the
+					// off-diagonals of column j (and j+1, if 2×2
+					// block) of S and P to the sums.
+					for ja := 0; ja < na; ja++ {
+						if ilcplx {
+							creala := acoef * work[2*n+j+ja]
+							cimaga := acoef * work[3*n+j+ja]
+							crealb := bcoefr*work[2*n+j+ja] - bcoefi*work[3*n+j+ja]
+							cimagb := bcoefi*work[2*n+j+ja] + bcoefr*work[3*n+j+ja]
+							for jr := 0; jr < j; jr++ {
+								work[2*n+jr] += -creala*s[jr*lds+j+ja] + crealb*p[jr*ldp+j+ja]
+								work[3*n+jr] += -cimaga*s[jr*lds+j+ja] + cimagb*p[jr*ldp+j+ja]
+							}
+						} else {
+							creala := acoef * work[2*n+j+ja]
+							crealb := bcoefr * work[2*n+j+ja]
+							for jr := 0; jr < j; jr++ {
+								work[2*n+jr] += -creala*s[jr*lds+j+ja] + crealb*p[jr*ldp+j+ja]
+							}
+						}
+					}
+				}
+				il2by2 = false
+			}
+
+			// Copy eigenvector to VR, back transforming if howmny is
+			// lapack.EVAllMulQ.
+			ieig -= nw
+			var iend int
+			if ilback {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dgemv(blas.NoTrans, n, je+1, 1, vr, ldvr, work[(jw+2)*n:], 1, 0, work[(jw+4)*n:], 1)
+				}
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+4)*n:], 1, vr[ieig+jw:], ldvr)
+				}
+				iend = n
+			} else {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+2)*n:], 1, vr[ieig+jw:], ldvr)
+				}
+				iend = je + 1
+			}
+
+			// Scale eigenvector.
+			xmax = 0
+			for j := 0; j < iend; j++ {
+				v := math.Abs(vr[j*ldvr+ieig])
+				if ilcplx {
+					v += math.Abs(vr[j*ldvr+ieig+1])
+				}
+				xmax = math.Max(xmax, v)
+			}
+			if xmax > safmin {
+				xscale := 1 / xmax
+				for jw := 0; jw < nw; jw++ {
+					bi.Dscal(iend, xscale, vr[ieig+jw:], ldvr)
+				}
+			}
+		}
+	}
+
+	return m, true
+}
@@ -0,0 +1,185 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtrsyl solves the real Sylvester matrix equation
+//
+//	op(A)*X + isgn*X*op(B) = scale*C
+//
+// where op(A) = A or Aᵀ, op(B) = B or Bᵀ, A is an m×m matrix and B is an n×n
+// matrix, both in Schur canonical form, that is, block upper triangular with
+// 1×1 and 2×2 diagonal blocks where each 2×2 diagonal block has its diagonal
+// elements equal and its off-diagonal elements of opposite sign. Such matrices
+// are returned by Dhseqr.
+//
+// trana and tranb specify op(A) and op(B). blas.ConjTrans is treated as
+// blas.Trans. isgn must be 1 or -1, otherwise Dtrsyl will panic.
+//
+// On entry, c contains the m×n right-hand side matrix C. On return, it is
+// overwritten by the solution X.
+//
+// scale is a factor, 0 < scale <= 1, chosen to prevent overflow in the
+// solution. If ok is false, op(A) and -isgn*op(B) have common or very close
+// eigenvalues and perturbed values were used to solve the equation, in which
+// case the solution may be inaccurate.
+//
+// Dtrsyl is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool) {
+	switch {
+	case trana != blas.NoTrans && trana != blas.Trans && trana != blas.ConjTrans:
+		panic(badTrans)
+	case tranb != blas.NoTrans && tranb != blas.Trans && tranb != blas.ConjTrans:
+		panic(badTrans)
+	case isgn != 1 && isgn != -1:
+		panic(badIsgn)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	notrna := trana == blas.NoTrans
+	notrnb := tranb == blas.NoTrans
+
+	// Set constants to control overflow.
+	eps := dlamchP
+	smlnum := dlamchS * float64(m*n) / eps
+	bignum := 1 / smlnum
+	smin := math.Max(smlnum, eps*impl.Dlange(lapack.MaxAbs, m, m, a, lda, nil))
+	smin = math.Max(smin, eps*impl.Dlange(lapack.MaxAbs, n, n, b, ldb, nil))
+	sgn := float64(isgn)
+
+	// The diagonal blocks of X are determined in the order in which the
+	// blocks of op(A) and op(B) they depend on have been solved. op(A) is
+	// upper quasi-triangular if trana is blas.NoTrans, so the block rows of X
+	// are computed from the bottom up, and lower quasi-triangular otherwise,
+	// so they are computed from the top down. Similarly, op(B) is upper
+	// quasi-triangular if tranb is blas.NoTrans, so the block columns of X are
+	// computed from left to right, and from right to left otherwise.
+	ablocks := schurBlocks(m, a, lda)
+	if notrna {
+		slices.Reverse(ablocks)
+	}
+	bblocks := schurBlocks(n, b, ldb)
+	if !notrnb {
+		slices.Reverse(bblocks)
+	}
+
+	bi := blas64.Implementation()
+	// dotA returns op(A)[k,:]*X[:,l] over the rows of X that have already been
+	// computed.
+	dotA := func(k, k1, k2, l int) float64 {
+		if notrna {
+			if k2+1 >= m {
+				return 0
+			}
+			return bi.Ddot(m-k2-1, a[k*lda+k2+1:], 1, c[(k2+1)*ldc+l:], ldc)
+		}
+		return bi.Ddot(k1, a[k:], lda, c[l:], ldc)
+	}
+	// dotB returns X[k,:]*op(B)[:,l] over the columns of X that have already
+	// been computed.
+	dotB := func(k, l, l1, l2 int) float64 {
+		if notrnb {
+			return bi.Ddot(l1, c[k*ldc:], 1, b[l:], ldb)
+		}
+		if l2+1 >= n {
+			return 0
+		}
+		return bi.Ddot(n-l2-1, c[k*ldc+l2+1:], 1, b[l*ldb+l2+1:], 1)
+	}
+
+	scale = 1
+	ok = true
+	var vec, x [4]float64 // 2×2 row-major matrices.
+	for _, lb := range bblocks {
+		l1 := lb
+		l2 := lb
+		if lb+1 < n && b[(lb+1)*ldb+lb] != 0 {
+			l2++
+		}
+		for _, kb := range ablocks {
+			k1 := kb
+			k2 := kb
+			if kb+1 < m && a[(kb+1)*lda+kb] != 0 {
+				k2++
+			}
+			n1 := k2 - k1 + 1
+			n2 := l2 - l1 + 1
+			for i := 0; i < n1; i++ {
+				for j := 0; j < n2; j++ {
+					k := k1 + i
+					l := l1 + j
+					vec[i*2+j] = c[k*ldc+l] - (dotA(k, k1, k2, l) + sgn*dotB(k, l, l1, l2))
+				}
+			}
+
+			scaloc := 1.0
+			if n1 == 1 && n2 == 1 {
+				a11 := a[k1*lda+k1] + sgn*b[l1*ldb+l1]
+				da11 := math.Abs(a11)
+				if da11 <= smin {
+					a11 = smin
+					da11 = smin
+					ok = false
+				}
+				db := math.Abs(vec[0])
+				if da11 < 1 && db > 1 && db > bignum*da11 {
+					scaloc = 1 / db
+				}
+				x[0] = vec[0] * scaloc / a11
+			} else {
+				var xok bool
+				scaloc, _, xok = impl.Dlasy2(!notrna, !notrnb, isgn, n1, n2,
+					a[k1*lda+k1:], lda, b[l1*ldb+l1:], ldb, vec[:], 2, x[:], 2)
+				if !xok {
+					ok = false
+				}
+			}
+
+			if scaloc != 1 {
+				for i := 0; i < m; i++ {
+					bi.Dscal(n, scaloc, c[i*ldc:], 1)
+				}
+				scale *= scaloc
+			}
+			for i := 0; i < n1; i++ {
+				for j := 0; j < n2; j++ {
+					c[(k1+i)*ldc+l1+j] = x[i*2+j]
+				}
+			}
+		}
+	}
+	return scale, ok
+}
@@ -14,16 +14,20 @@ const (
 	badEVComp           = "lapack: bad EVComp"
 	badEVHowMany        = "lapack: bad EVHowMany"
 	badEVJob            = "lapack: bad EVJob"
+	badEVRange          = "lapack: bad EVRange"
 	badEVSide           = "lapack: bad EVSide"
 	badGSVDJob          = "lapack: bad GSVDJob"
 	badGenOrtho         = "lapack: bad GenOrtho"
 	badLeftEVJob        = "lapack: bad LeftEVJob"
+	badLeftSVJob        = "lapack: bad LeftSVJob"
 	badMatrixType       = "lapack: bad MatrixType"
 	badMaximizeNormXJob = "lapack: bad MaximizeNormXJob"
 	badNorm             = "lapack: bad Norm"
 	badOrthoComp        = "lapack: bad OrthoComp"
 	badPivot            = "lapack: bad Pivot"
 	badRightEVJob       = "lapack: bad RightEVJob"
+	badRightSVJob       = "lapack: bad RightSVJob"
+	badSVDComp          = "lapack: bad SVDComp"
 	badSVDJob           = "lapack: bad SVDJob"
 	badSchurComp        = "lapack: bad SchurComp"
 	badSchurJob         = "lapack: bad SchurJob"
@@ -36,14 +40,29 @@ const (
 	bothSVDOver         = "lapack: both jobU and jobVT are lapack.SVDOverwrite"
 
 	// Panic strings for bad numerical and string values.
+	badB1       = "lapack: b1 out of range"
+	badBn       = "lapack: bn out of range"
+	badClend    = "lapack: clend out of range"
+	badClstrt   = "lapack: clstrt out of range"
+	badCutpnt   = "lapack: cutpnt out of range"
+	badDtrd1    = "lapack: bad dtrd1"
+	badDtrd2    = "lapack: bad dtrd2"
+	badI        = "lapack: i out of range"
+	badIfirst   = "lapack: ifirst out of range"
 	badIfst     = "lapack: ifst out of range"
 	badIhi      = "lapack: ihi out of range"
 	badIhiz     = "lapack: ihiz out of range"
+	badIjob     = "lapack: bad ijob value"
+	badIl       = "lapack: il out of range"
+	badIlast    = "lapack: ilast out of range"
 	badIlo      = "lapack: ilo out of range"
 	badIloz     = "lapack: iloz out of range"
 	badIlst     = "lapack: ilst out of range"
 	badIsave    = "lapack: bad isave value"
+	badIsgn     = "lapack: invalid value of isgn"
 	badIspec    = "lapack: bad ispec value"
+	badIu       = "lapack: iu out of range"
+	badIw       = "lapack: iw out of range"
 	badJ1       = "lapack: j1 out of range"
 	badJpvt     = "lapack: bad element of jpvt"
 	badK1       = "lapack: k1 out of range"
@@ -51,16 +70,25 @@ const (
 	badKacc22   = "lapack: invalid value of kacc22"
 	badKbot     = "lapack: kbot out of range"
 	badKtop     = "lapack: ktop out of range"
+	badLIWork   = "lapack: insufficient declared iwork length"
 	badLWork    = "lapack: insufficient declared workspace length"
+	badM        = "lapack: m out of range"
 	badMm       = "lapack: mm out of range"
 	badN1       = "lapack: bad value of n1"
 	badN2       = "lapack: bad value of n2"
 	badNa       = "lapack: bad value of na"
 	badName     = "lapack: bad name"
 	badNh       = "lapack: bad value of nh"
+	badNsplit   = "lapack: nsplit out of range"
 	badNw       = "lapack: bad value of nw"
+	badNzc      = "lapack: nzc out of range"
+	badOffset   = "lapack: offset out of range"
 	badPp       = "lapack: bad value of pp"
+	badR        = "lapack: r out of range"
 	badShifts   = "lapack: bad shifts"
+	badSqre     = "lapack: bad sqre"
+	badVlVu     = "lapack: vl >= vu"
+	ctolLE1     = "lapack: ctol <= 1"
 	i0LT0       = "lapack: i0 < 0"
 	kGTM        = "lapack: k > m"
 	kGTN        = "lapack: k > n"
@@ -71,23 +99,35 @@ const (
 	kuLT0       = "lapack: ku < 0"
 	mGTN        = "lapack: m > n"
 	mLT0        = "lapack: m < 0"
+	minpLT0     = "lapack: minp < 0"
 	mmLT0       = "lapack: mm < 0"
+	mmaxLTMinp  = "lapack: mmax < minp"
+	mvLT0       = "lapack: mv < 0"
+	msubLT1     = "lapack: msub < 1"
 	n0LT0       = "lapack: n0 < 0"
+	n1LT0       = "lapack: n1 < 0"
+	n2LT0       = "lapack: n2 < 0"
 	nGTM        = "lapack: n > m"
+	nGTMPlusP   = "lapack: n > m+p"
 	nLT0        = "lapack: n < 0"
 	nLT1        = "lapack: n < 1"
+	nLTK        = "lapack: n < k"
 	nLTM        = "lapack: n < m"
 	nanCFrom    = "lapack: cfrom is NaN"
 	nanCTo      = "lapack: cto is NaN"
 	nbGTM       = "lapack: nb > m"
 	nbGTN       = "lapack: nb > n"
 	nbLT0       = "lapack: nb < 0"
+	nbLT2       = "lapack: nb < 2"
 	nccLT0      = "lapack: ncc < 0"
 	ncvtLT0     = "lapack: ncvt < 0"
 	negANorm    = "lapack: anorm < 0"
 	negZ        = "lapack: negative z value"
 	nhLT0       = "lapack: nh < 0"
+	nlLT1       = "lapack: nl < 1"
+	notGenSchur = "lapack: matrix pair is not in generalized Schur form"
 	notIsolated = "lapack: block is not isolated"
+	nrLT1       = "lapack: nr < 1"
 	nrhsLT0     = "lapack: nrhs < 0"
 	nruLT0      = "lapack: nru < 0"
 	nshftsLT0   = "lapack: nshfts < 0"
@@ -95,12 +135,16 @@ const (
 	nvLT0       = "lapack: nv < 0"
 	offsetGTM   = "lapack: offset > m"
 	offsetLT0   = "lapack: offset < 0"
+	pGTN        = "lapack: p > n"
 	pLT0        = "lapack: p < 0"
 	recurLT0    = "lapack: recur < 0"
+	smlsizLT1   = "lapack: smlsiz < 1"
 	zeroCFrom   = "lapack: zero cfrom"
 
 	// Panic strings for bad slice lengths.
 	badLenAlpha    = "lapack: bad length of alpha"
+	badLenAlphai   = "lapack: bad length of alphai"
+	badLenAlphar   = "lapack: bad length of alphar"
 	badLenBeta     = "lapack: bad length of beta"
 	badLenIpiv     = "lapack: bad length of ipiv"
 	badLenJpiv     = "lapack: bad length of jpiv"
@@ -111,48 +155,80 @@ const (
 	badLenSi       = "lapack: bad length of si"
 	badLenSr       = "lapack: bad length of sr"
 	badLenTau      = "lapack: bad length of tau"
+	badLenW        = "lapack: bad length of w"
 	badLenWi       = "lapack: bad length of wi"
 	badLenWr       = "lapack: bad length of wr"
 
 	// Panic strings for insufficient slice lengths.
-	shortA     = "lapack: insufficient length of a"
-	shortAB    = "lapack: insufficient length of ab"
-	shortAuxv  = "lapack: insufficient length of auxv"
-	shortB     = "lapack: insufficient length of b"
-	shortC     = "lapack: insufficient length of c"
-	shortCNorm = "lapack: insufficient length of cnorm"
-	shortD     = "lapack: insufficient length of d"
-	shortDL    = "lapack: insufficient length of dl"
-	shortDU    = "lapack: insufficient length of du"
-	shortE     = "lapack: insufficient length of e"
-	shortF     = "lapack: insufficient length of f"
-	shortH     = "lapack: insufficient length of h"
-	shortIWork = "lapack: insufficient length of iwork"
-	shortIsgn  = "lapack: insufficient length of isgn"
-	shortQ     = "lapack: insufficient length of q"
-	shortRHS   = "lapack: insufficient length of rhs"
-	shortS     = "lapack: insufficient length of s"
-	shortScale = "lapack: insufficient length of scale"
-	shortT     = "lapack: insufficient length of t"
-	shortTau   = "lapack: insufficient length of tau"
-	shortTauP  = "lapack: insufficient length of tauP"
-	shortTauQ  = "lapack: insufficient length of tauQ"
-	shortU     = "lapack: insufficient length of u"
-	shortV     = "lapack: insufficient length of v"
-	shortVL    = "lapack: insufficient length of vl"
-	shortVR    = "lapack: insufficient length of vr"
-	shortVT    = "lapack: insufficient length of vt"
-	shortVn1   = "lapack: insufficient length of vn1"
-	shortVn2   = "lapack: insufficient length of vn2"
-	shortW     = "lapack: insufficient length of w"
-	shortWH    = "lapack: insufficient length of wh"
-	shortWV    = "lapack: insufficient length of wv"
-	shortWi    = "lapack: insufficient length of wi"
-	shortWork  = "lapack: insufficient length of work"
-	shortWr    = "lapack: insufficient length of wr"
-	shortX     = "lapack: insufficient length of x"
-	shortY     = "lapack: insufficient length of y"
-	shortZ     = "lapack: insufficient length of z"
+	shortA      = "lapack: insufficient length of a"
+	shortAB     = "lapack: insufficient length of ab"
+	shortAuxv   = "lapack: insufficient length of auxv"
+	shortB      = "lapack: insufficient length of b"
+	shortC      = "lapack: insufficient length of c"
+	shortCNorm  = "lapack: insufficient length of cnorm"
+	shortColtyp = "lapack: insufficient length of coltyp"
+	shortCtot   = "lapack: insufficient length of ctot"
+	shortD      = "lapack: insufficient length of d"
+	shortDL     = "lapack: insufficient length of dl"
+	shortDU     = "lapack: insufficient length of du"
+	shortDelta  = "lapack: insufficient length of delta"
+	shortDlamda = "lapack: insufficient length of dlamda"
+	shortDplus  = "lapack: insufficient length of dplus"
+	shortE      = "lapack: insufficient length of e"
+	shortE2     = "lapack: insufficient length of e2"
+	shortF      = "lapack: insufficient length of f"
+	shortGers   = "lapack: insufficient length of gers"
+	shortH      = "lapack: insufficient length of h"
+	shortIWork  = "lapack: insufficient length of iwork"
+	shortIblock = "lapack: insufficient length of iblock"
+	shortIndex  = "lapack: insufficient length of index"
+	shortIndexw = "lapack: insufficient length of indexw"
+	shortIndx   = "lapack: insufficient length of indx"
+	shortIndxc  = "lapack: insufficient length of indxc"
+	shortIndxp  = "lapack: insufficient length of indxp"
+	shortIndxq  = "lapack: insufficient length of indxq"
+	shortInode  = "lapack: insufficient length of inode"
+	shortIsgn   = "lapack: insufficient length of isgn"
+	shortIsplit = "lapack: insufficient length of isplit"
+	shortIsuppz = "lapack: insufficient length of isuppz"
+	shortL      = "lapack: insufficient length of l"
+	shortLD     = "lapack: insufficient length of ld"
+	shortLLD    = "lapack: insufficient length of lld"
+	shortLplus  = "lapack: insufficient length of lplus"
+	shortNab    = "lapack: insufficient length of nab"
+	shortNdiml  = "lapack: insufficient length of ndiml"
+	shortNdimr  = "lapack: insufficient length of ndimr"
+	shortNval   = "lapack: insufficient length of nval"
+	shortP      = "lapack: insufficient length of p"
+	shortQ      = "lapack: insufficient length of q"
+	shortQ2     = "lapack: insufficient length of q2"
+	shortRHS    = "lapack: insufficient length of rhs"
+	shortRWork  = "lapack: insufficient length of rwork"
+	shortS      = "lapack: insufficient length of s"
+	shortSVA    = "lapack: insufficient length of sva"
+	shortScale  = "lapack: insufficient length of scale"
+	shortT      = "lapack: insufficient length of t"
+	shortTau    = "lapack: insufficient length of tau"
+	shortTauP   = "lapack: insufficient length of tauP"
+	shortTauQ   = "lapack: insufficient length of tauQ"
+	shortU      = "lapack: insufficient length of u"
+	shortV      = "lapack: insufficient length of v"
+	shortVL     = "lapack: insufficient length of vl"
+	shortVR     = "lapack: insufficient length of vr"
+	shortVT     = "lapack: insufficient length of vt"
+	shortVn1    = "lapack: insufficient length of vn1"
+	shortVn2    = "lapack: insufficient length of vn2"
+	shortW      = "lapack: insufficient length of w"
+	shortWH     = "lapack: insufficient length of wh"
+	shortWV     = "lapack: insufficient length of wv"
+	shortWerr   = "lapack: insufficient length of werr"
+	shortWgap   = "lapack: insufficient length of wgap"
+	shortWi     = "lapack: insufficient length of wi"
+	shortWork   = "lapack: insufficient length of work"
+	shortWr     = "lapack: insufficient length of wr"
+	shortX      = "lapack: insufficient length of x"
+	shortY      = "lapack: insufficient length of y"
+	shortZ      = "lapack: insufficient length of z"
 
 	// Panic strings for bad leading dimensions of matrices.
 	badLdA    = "lapack: bad leading dimension of A"
@@ -160,7 +236,9 @@ const (
 	badLdC    = "lapack: bad leading dimension of C"
 	badLdF    = "lapack: bad leading dimension of F"
 	badLdH    = "lapack: bad leading dimension of H"
+	badLdP    = "lapack: bad leading dimension of P"
 	badLdQ    = "lapack: bad leading dimension of Q"
+	badLdS    = "lapack: bad leading dimension of S"
 	badLdT    = "lapack: bad leading dimension of T"
 	badLdU    = "lapack: bad leading dimension of U"
 	badLdV    = "lapack: bad leading dimension of V"
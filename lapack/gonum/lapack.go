@@ -2,6 +2,8 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:generate ./single_precision.bash
+
 package gonum
 
 import "gonum.org/v1/gonum/lapack"
@@ -11,7 +13,11 @@ import "gonum.org/v1/gonum/lapack"
 // this code is in pure Go, the underlying BLAS implementation may not be.
 type Implementation struct{}
 
-var _ lapack.Float64 = Implementation{}
+var (
+	_ lapack.Float64    = Implementation{}
+	_ lapack.Float32    = Implementation{}
+	_ lapack.Complex128 = Implementation{}
+)
 
 func abs(a int) int {
 	if a < 0 {
@@ -20,6 +26,19 @@ func abs(a int) int {
 	return a
 }
 
+// schurBlocks returns the indices of the first rows of the diagonal blocks of
+// the n×n matrix t in Schur canonical form, in increasing order.
+func schurBlocks[T float32 | float64](n int, t []T, ldt int) []int {
+	blocks := make([]int, 0, n)
+	for k := 0; k < n; k++ {
+		blocks = append(blocks, k)
+		if k+1 < n && t[(k+1)*ldt+k] != 0 {
+			k++
+		}
+	}
+	return blocks
+}
+
 const (
 	// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
 	dlamchE = 0x1p-53
@@ -62,3 +81,29 @@ const (
 	dssml = 0x1p537
 	dsbig = 0x1p-538
 )
+
+const (
+	// slamchE is the machine epsilon for float32. For IEEE this is 2^{-24}.
+	slamchE float32 = 0x1p-24
+
+	// slamchB is the radix of the machine (the base of the number system).
+	slamchB float32 = 2
+
+	// slamchP is base * eps.
+	slamchP float32 = slamchB * slamchE
+
+	// slamchS is the "safe minimum" for float32, the smallest normal number.
+	// For IEEE this is 2^{-126}.
+	slamchS float32 = 0x1p-126
+
+	// Blue's scaling constants for float32, as above with expmin = -125,
+	// expmax = 128 and digits = 24.
+	//  stsml = 2^ceil((-125-1)/2) = 2^{-63}
+	//  stbig = 2^floor((128-24+1)/2) = 2^{52}
+	//  sssml = 2^{-floor((-125-24)/2)} = 2^{75}
+	//  ssbig = 2^{-ceil((128+24-1)/2)} = 2^{-76}
+	stsml float32 = 0x1p-63
+	stbig float32 = 0x1p52
+	sssml float32 = 0x1p75
+	ssbig float32 = 0x1p-76
+)
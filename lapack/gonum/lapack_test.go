@@ -12,16 +12,46 @@ import (
 
 var impl = Implementation{}
 
+func TestDbdsdc(t *testing.T) {
+	t.Parallel()
+	testlapack.DbdsdcTest(t, impl)
+}
+
 func TestDbdsqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DbdsqrTest(t, impl)
 }
 
+func TestDhgeqz(t *testing.T) {
+	t.Parallel()
+	testlapack.DhgeqzTest(t, impl)
+}
+
 func TestDhseqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DhseqrTest(t, impl)
 }
 
+func TestDgbcon(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbconTest(t, impl)
+}
+
+func TestDgbtf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgbtf2Test(t, impl)
+}
+
+func TestDgbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrfTest(t, impl)
+}
+
+func TestDgbtrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrsTest(t, impl)
+}
+
 func TestDgebak(t *testing.T) {
 	t.Parallel()
 	testlapack.DgebakTest(t, impl)
@@ -107,11 +137,24 @@ func TestDgeqrf(t *testing.T) {
 	testlapack.DgeqrfTest(t, impl)
 }
 
+func TestTiledDgeqrf(t *testing.T) {
+	t.Parallel()
+	for _, impl := range []Tiled{{Workers: 4, TileSize: 7}, {Workers: 3, TileSize: 32}} {
+		testlapack.DgeqrfTest(t, impl)
+	}
+}
+
 func TestDgerqf(t *testing.T) {
 	t.Parallel()
 	testlapack.DgerqfTest(t, impl)
 }
 
+func TestDgesdd(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	testlapack.DgesddTest(t, impl, tol)
+}
+
 func TestDgesv(t *testing.T) {
 	t.Parallel()
 	testlapack.DgesvTest(t, impl)
@@ -123,6 +166,12 @@ func TestDgesvd(t *testing.T) {
 	testlapack.DgesvdTest(t, impl, tol)
 }
 
+func TestDgesvj(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	testlapack.DgesvjTest(t, impl, tol)
+}
+
 func TestDgetc2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgetc2Test(t, impl)
@@ -143,16 +192,48 @@ func TestDgetrf(t *testing.T) {
 	testlapack.DgetrfTest(t, impl)
 }
 
+func TestTiledDgetrf(t *testing.T) {
+	t.Parallel()
+	for _, impl := range []Tiled{{Workers: 4, TileSize: 7}, {Workers: 3, TileSize: 32}} {
+		testlapack.DgetrfTest(t, impl)
+	}
+}
+
 func TestDgetrs(t *testing.T) {
 	t.Parallel()
 	testlapack.DgetrsTest(t, impl)
 }
 
+func TestDggev(t *testing.T) {
+	t.Parallel()
+	testlapack.DggevTest(t, impl)
+}
+
+func TestDggglm(t *testing.T) {
+	t.Parallel()
+	testlapack.DggglmTest(t, impl)
+}
+
 func TestDgghrd(t *testing.T) {
 	t.Parallel()
 	testlapack.DgghrdTest(t, impl)
 }
 
+func TestDgglse(t *testing.T) {
+	t.Parallel()
+	testlapack.DgglseTest(t, impl)
+}
+
+func TestDggqrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DggqrfTest(t, impl)
+}
+
+func TestDggrqf(t *testing.T) {
+	t.Parallel()
+	testlapack.DggrqfTest(t, impl)
+}
+
 func TestDggsvd3(t *testing.T) {
 	t.Parallel()
 	testlapack.Dggsvd3Test(t, impl)
@@ -518,6 +599,11 @@ func TestDormr2(t *testing.T) {
 	testlapack.Dormr2Test(t, impl)
 }
 
+func TestDormrq(t *testing.T) {
+	t.Parallel()
+	testlapack.DormrqTest(t, impl)
+}
+
 func TestDorm2r(t *testing.T) {
 	t.Parallel()
 	testlapack.Dorm2rTest(t, impl)
@@ -558,6 +644,13 @@ func TestDpotrf(t *testing.T) {
 	testlapack.DpotrfTest(t, impl)
 }
 
+func TestTiledDpotrf(t *testing.T) {
+	t.Parallel()
+	for _, impl := range []Tiled{{Workers: 4, TileSize: 7}, {Workers: 3, TileSize: 32}} {
+		testlapack.DpotrfTest(t, impl)
+	}
+}
+
 func TestDpotri(t *testing.T) {
 	t.Parallel()
 	testlapack.DpotriTest(t, impl)
@@ -603,6 +696,31 @@ func TestDrscl(t *testing.T) {
 	testlapack.DrsclTest(t, impl)
 }
 
+func TestDsbev(t *testing.T) {
+	t.Parallel()
+	testlapack.DsbevTest(t, impl)
+}
+
+func TestDsbevx(t *testing.T) {
+	t.Parallel()
+	testlapack.DsbevxTest(t, impl)
+}
+
+func TestDsbtrd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsbtrdTest(t, impl)
+}
+
+func TestDstedc(t *testing.T) {
+	t.Parallel()
+	testlapack.DstedcTest(t, impl)
+}
+
+func TestDstemr(t *testing.T) {
+	t.Parallel()
+	testlapack.DstemrTest(t, impl)
+}
+
 func TestDsteqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DsteqrTest(t, impl)
@@ -613,11 +731,26 @@ func TestDsterf(t *testing.T) {
 	testlapack.DsterfTest(t, impl)
 }
 
+func TestDsycon(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyconTest(t, impl)
+}
+
 func TestDsyev(t *testing.T) {
 	t.Parallel()
 	testlapack.DsyevTest(t, impl)
 }
 
+func TestDsyevd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevdTest(t, impl)
+}
+
+func TestDsyevr(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevrTest(t, impl)
+}
+
 func TestDsytd2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dsytd2Test(t, impl)
@@ -628,11 +761,36 @@ func TestDsytrd(t *testing.T) {
 	testlapack.DsytrdTest(t, impl)
 }
 
+func TestDsytf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dsytf2Test(t, impl)
+}
+
+func TestDsytrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrfTest(t, impl)
+}
+
+func TestDsytri(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytriTest(t, impl)
+}
+
+func TestDsytrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrsTest(t, impl)
+}
+
 func TestDtgsja(t *testing.T) {
 	t.Parallel()
 	testlapack.DtgsjaTest(t, impl)
 }
 
+func TestDtgevc(t *testing.T) {
+	t.Parallel()
+	testlapack.DtgevcTest(t, impl)
+}
+
 func TestDtbtrs(t *testing.T) {
 	t.Parallel()
 	testlapack.DtbtrsTest(t, impl)
@@ -653,6 +811,11 @@ func TestDtrexc(t *testing.T) {
 	testlapack.DtrexcTest(t, impl)
 }
 
+func TestDtrsyl(t *testing.T) {
+	t.Parallel()
+	testlapack.DtrsylTest(t, impl)
+}
+
 func TestDtrti2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dtrti2Test(t, impl)
@@ -677,3 +840,83 @@ func TestIladlr(t *testing.T) {
 	t.Parallel()
 	testlapack.IladlrTest(t, impl)
 }
+
+func TestZdrscl(t *testing.T) {
+	t.Parallel()
+	testlapack.ZdrsclTest(t, impl)
+}
+
+func TestZgecon(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgeconTest(t, impl)
+}
+
+func TestZgeev(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgeevTest(t, impl)
+}
+
+func TestZgeqrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgeqrfTest(t, impl)
+}
+
+func TestZgesvd(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgesvdTest(t, impl)
+}
+
+func TestZgetrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgetrfTest(t, impl)
+}
+
+func TestZgetrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgetrsTest(t, impl)
+}
+
+func TestZheev(t *testing.T) {
+	t.Parallel()
+	testlapack.ZheevTest(t, impl)
+}
+
+func TestZlantr(t *testing.T) {
+	t.Parallel()
+	testlapack.ZlantrTest(t, impl)
+}
+
+func TestZlatrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZlatrsTest(t, impl)
+}
+
+func TestZpocon(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpoconTest(t, impl)
+}
+
+func TestZpotrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpotrfTest(t, impl)
+}
+
+func TestZpotrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpotrsTest(t, impl)
+}
+
+func TestZtrcon(t *testing.T) {
+	t.Parallel()
+	testlapack.ZtrconTest(t, impl)
+}
+
+func TestZungqr(t *testing.T) {
+	t.Parallel()
+	testlapack.ZungqrTest(t, impl)
+}
+
+func TestZunmqr(t *testing.T) {
+	t.Parallel()
+	testlapack.ZunmqrTest(t, impl)
+}
@@ -0,0 +1,66 @@
+#!/usr/bin/env bash
+
+# Copyright ©2026 The Gonum Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+# single_precision.bash generates the float32 LAPACK routines from their
+# float64 counterparts. Each d*.go file is translated into the corresponding
+# s*.go file, and iladlc.go and iladlr.go into ilaslc.go and ilaslr.go.
+
+set -e
+
+WARNINGF32='//\
+// Float32 implementations are autogenerated. A subset of them is tested by\
+// running the float64 test drivers in single_precision_test.go against them.\
+'
+
+# Names of the LAPACK routines and BLAS routines called by them that are
+# renamed from D to S.
+ROUTINES=$(grep -ho '^func (\(impl \)\?Implementation) [Dd][a-zA-Z0-9]*' d*.go | sed 's/.* //' | sort -u)
+BLAS='Dasum Daxpy Dcopy Ddot Dgemm Dgemv Dger Dnrm2 Drot Drotg Drotm Dscal Dswap Dsymv Dsyr Dsyr2 Dsyr2k Dsyrk Dtbmv Dtbsv Dtrmm Dtrmv Dtrsm Dtrsv'
+
+RENAME=()
+for name in $ROUTINES $BLAS dlamchE dlamchB dlamchP dlamchS dtsml dtbig dssml dsbig dlapy3 dggevNormalize dbdsdcVectors dgesddTall dgesddWide dgesvjRotate dscalsb; do
+	prefix=S
+	if [ "${name:0:1}" == "d" ]; then
+		prefix=s
+	fi
+	RENAME+=(-e "s/\b${name}\b/${prefix}${name:1}/g")
+done
+RENAME+=(-e 's/\bIdamax\b/Isamax/g' -e 's/\bIladlc\b/Ilaslc/g' -e 's/\bIladlr\b/Ilaslr/g')
+
+generate() {
+	local src=$1
+	local dst=$2
+	echo Generating "$dst"
+	echo -e '// Code generated by "go generate gonum.org/v1/gonum/lapack/gonum"; DO NOT EDIT.\n' > "$dst"
+	gofmt -r 'float64 -> float32' "$src" \
+	| gofmt -r 'f64.L1Norm -> f32.L1Norm' \
+	| gofmt -r 'f64.L1NormInc -> f32.L1NormInc' \
+	| gofmt -r 'blas64.Implementation -> blas32.Implementation' \
+	| gofmt -r 'blas.DrotmParams -> blas.SrotmParams' \
+	| gofmt -r 'math.MaxFloat64 -> math.MaxFloat32' \
+	\
+	| sed "${RENAME[@]}" \
+	| sed -e "s|^\(func (\(impl \)\?Implementation) \)\([SI]\)\(.*\)\$|$WARNINGF32\1\3\4|" \
+	      -e 's_"D\([A-Z0-9]*\)"_"S\1"_g' \
+	      -e 's_^\(\s*[a-zA-Z0-9]* := \)\(-\?[0-9]*\.[0-9]*\)$_\1float32(\2)_' \
+	      -e '/^const noSVDO = /d' \
+	      -e 's_sort\.Float64s(\(.*\))_slices.Sort(\1)_' \
+	      -e 's_sort\.Sort(sort\.Reverse(sort\.Float64Slice(\(.*\))))_slices.SortFunc(\1, func(a, b float32) int { return cmp.Compare(b, a) })_' \
+	      -e 's_"gonum.org/v1/gonum/blas/blas64"_"gonum.org/v1/gonum/blas/blas32"_' \
+	      -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
+	      -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
+	>> "$dst"
+	go tool golang.org/x/tools/cmd/goimports -w "$dst"
+}
+
+for src in d*.go; do
+	if [ "$src" == "doc.go" ]; then
+		continue
+	fi
+	generate "$src" "s${src:1}"
+done
+generate iladlc.go ilaslc.go
+generate iladlr.go ilaslr.go
@@ -0,0 +1,784 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/testlapack"
+)
+
+// The float32 routines are generated from the float64 routines, so the tests
+// below check that a representative set of them agrees with their float64
+// counterparts to within single precision when applied to the same data.
+
+// singleTol is the tolerance for relative differences between the results of
+// float32 and float64 routines applied to n×n matrices.
+func singleTol(n int) float64 {
+	return 100 * float64(n) * float64(slamchE)
+}
+
+// singleData returns a random slice of float64 values of length n that are
+// exactly representable as float32 and its float32 copy.
+func singleData(n int, rnd *rand.Rand) ([]float64, []float32) {
+	d := make([]float64, n)
+	s := make([]float32, n)
+	for i := range d {
+		s[i] = float32(rnd.NormFloat64())
+		d[i] = float64(s[i])
+	}
+	return d, s
+}
+
+// singleRelDiff returns the largest difference between corresponding
+// elements of d and s relative to the largest absolute value in d.
+func singleRelDiff(d []float64, s []float32) float64 {
+	var diff, norm float64
+	for i, v := range d {
+		diff = math.Max(diff, math.Abs(v-float64(s[i])))
+		norm = math.Max(norm, math.Abs(v))
+	}
+	if norm == 0 {
+		return diff
+	}
+	return diff / norm
+}
+
+func TestSingleAgainstDouble(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 33, 70} {
+		name := fmt.Sprintf("n=%d", n)
+		tol := singleTol(n)
+
+		// LU factorization of a diagonally dominant matrix.
+		d, s := singleData(n*n, rnd)
+		for i := 0; i < n; i++ {
+			s[i*n+i] += float32(n)
+			d[i*n+i] = float64(s[i*n+i])
+		}
+		ipivD := make([]int, n)
+		ipivS := make([]int, n)
+		okD := impl.Dgetrf(n, n, d, n, ipivD)
+		okS := impl.Sgetrf(n, n, s, n, ipivS)
+		if okD != okS {
+			t.Errorf("%s: Sgetrf ok mismatch", name)
+		}
+		if diff := singleRelDiff(d, s); diff > tol {
+			t.Errorf("%s: Sgetrf differs from Dgetrf by %v", name, diff)
+		}
+
+		// Cholesky factorization of a positive definite matrix.
+		d, s = singleData(n*n, rnd)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				s[i*n+j] = s[j*n+i]
+				d[i*n+j] = d[j*n+i]
+			}
+			s[i*n+i] = float32(math.Abs(float64(s[i*n+i]))) + float32(n)
+			d[i*n+i] = float64(s[i*n+i])
+		}
+		okD = impl.Dpotrf(blas.Upper, n, d, n)
+		okS = impl.Spotrf(blas.Upper, n, s, n)
+		if !okD || !okS {
+			t.Errorf("%s: unexpected Cholesky failure", name)
+		} else if diff := singleRelDiff(d, s); diff > tol {
+			t.Errorf("%s: Spotrf differs from Dpotrf by %v", name, diff)
+		}
+
+		// QR factorization.
+		d, s = singleData(n*n, rnd)
+		tauD := make([]float64, n)
+		tauS := make([]float32, n)
+		impl.Dgeqrf(n, n, d, n, tauD, make([]float64, n), n)
+		impl.Sgeqrf(n, n, s, n, tauS, make([]float32, n), n)
+		if diff := singleRelDiff(d, s); diff > tol {
+			t.Errorf("%s: Sgeqrf differs from Dgeqrf by %v", name, diff)
+		}
+
+		// Singular values.
+		d, s = singleData(n*n, rnd)
+		svD := make([]float64, n)
+		svS := make([]float32, n)
+		workD := make([]float64, 1)
+		impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, n, n, d, n, svD, nil, 1, nil, 1, workD, -1)
+		workD = make([]float64, int(workD[0]))
+		workS := make([]float32, 1)
+		impl.Sgesvd(lapack.SVDNone, lapack.SVDNone, n, n, s, n, svS, nil, 1, nil, 1, workS, -1)
+		workS = make([]float32, int(workS[0]))
+		okD = impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, n, n, d, n, svD, nil, 1, nil, 1, workD, len(workD))
+		okS = impl.Sgesvd(lapack.SVDNone, lapack.SVDNone, n, n, s, n, svS, nil, 1, nil, 1, workS, len(workS))
+		if !okD || !okS {
+			t.Errorf("%s: unexpected SVD failure", name)
+		} else if diff := singleRelDiff(svD, svS); diff > tol {
+			t.Errorf("%s: Sgesvd singular values differ from Dgesvd by %v", name, diff)
+		}
+
+		// Symmetric eigenvalues.
+		d, s = singleData(n*n, rnd)
+		wD := make([]float64, n)
+		wS := make([]float32, n)
+		workD = make([]float64, 1)
+		impl.Dsyev(lapack.EVNone, blas.Upper, n, d, n, wD, workD, -1)
+		workD = make([]float64, int(workD[0]))
+		workS = make([]float32, 1)
+		impl.Ssyev(lapack.EVNone, blas.Upper, n, s, n, wS, workS, -1)
+		workS = make([]float32, int(workS[0]))
+		okD = impl.Dsyev(lapack.EVNone, blas.Upper, n, d, n, wD, workD, len(workD))
+		okS = impl.Ssyev(lapack.EVNone, blas.Upper, n, s, n, wS, workS, len(workS))
+		if !okD || !okS {
+			t.Errorf("%s: unexpected eigenvalue failure", name)
+		} else if diff := singleRelDiff(wD, wS); diff > tol {
+			t.Errorf("%s: Ssyev eigenvalues differ from Dsyev by %v", name, diff)
+		}
+
+		// Symmetric eigendecomposition by divide and conquer.
+		d, s = singleData(n*n, rnd)
+		lwork := 1 + 6*n + 2*n*n
+		liwork := 3 + 5*n
+		okD = impl.Dsyevd(lapack.EVCompute, blas.Lower, n, d, n, wD, make([]float64, lwork), lwork, make([]int, liwork), liwork)
+		okS = impl.Ssyevd(lapack.EVCompute, blas.Lower, n, s, n, wS, make([]float32, lwork), lwork, make([]int, liwork), liwork)
+		if !okD || !okS {
+			t.Errorf("%s: unexpected divide and conquer failure", name)
+		} else if diff := singleRelDiff(wD, wS); diff > tol {
+			t.Errorf("%s: Ssyevd eigenvalues differ from Dsyevd by %v", name, diff)
+		}
+
+		// Selected symmetric eigenpairs by MRRR.
+		d, s = singleData(n*n, rnd)
+		lwork = 26 * n
+		liwork = 12 * n
+		iu := n / 2
+		zD := make([]float64, n*(iu+1))
+		zS := make([]float32, n*(iu+1))
+		mD, okD := impl.Dsyevr(lapack.EVCompute, lapack.EVRangeIndex, blas.Upper, n, d, n, 0, 0, 0, iu, wD, zD, iu+1, make([]float64, lwork), lwork, make([]int, liwork), liwork)
+		mS, okS := impl.Ssyevr(lapack.EVCompute, lapack.EVRangeIndex, blas.Upper, n, s, n, 0, 0, 0, iu, wS, zS, iu+1, make([]float32, lwork), lwork, make([]int, liwork), liwork)
+		if !okD || !okS || mD != iu+1 || mS != iu+1 {
+			t.Errorf("%s: unexpected MRRR failure", name)
+		} else if diff := singleRelDiff(wD[:mD], wS[:mS]); diff > tol {
+			t.Errorf("%s: Ssyevr eigenvalues differ from Dsyevr by %v", name, diff)
+		}
+	}
+}
+
+// single is a test adapter that implements the float64 routines used by the
+// testlapack drivers by calling the corresponding float32 routines on copies
+// of the arguments rounded to float32. The remaining float64 routines, which
+// the drivers also use to check the results, are those of Implementation.
+type single struct {
+	Implementation
+}
+
+// Epsilon returns the float32 machine epsilon so that the testlapack drivers
+// scale their tolerances to single precision.
+func (single) Epsilon() float64 { return float64(slamchE) }
+
+// SafeMin returns the float32 safe minimum so that the testlapack drivers
+// generate scaled matrices that are representable in single precision.
+func (single) SafeMin() float64 { return float64(slamchS) }
+
+// toSingle returns a copy of s rounded to float32.
+func toSingle(s []float64) []float32 {
+	if s == nil {
+		return nil
+	}
+	s32 := make([]float32, len(s))
+	for i, v := range s {
+		s32[i] = float32(v)
+	}
+	return s32
+}
+
+// fromSingle copies the elements of s32 to s that differ from the elements of
+// s rounded to float32, so that elements not written by a float32 routine
+// keep their original float64 values.
+func fromSingle(s []float64, s32 []float32) {
+	for i, v := range s32 {
+		if v != float32(s[i]) {
+			s[i] = float64(v)
+		}
+	}
+}
+
+func (impl single) Dgetf2(m, n int, a []float64, lda int, ipiv []int) bool {
+	a32 := toSingle(a)
+	r := impl.Implementation.Sgetf2(m, n, a32, lda, ipiv)
+	fromSingle(a, a32)
+	return r
+}
+
+func (impl single) Dgetrf(m, n int, a []float64, lda int, ipiv []int) bool {
+	a32 := toSingle(a)
+	r := impl.Implementation.Sgetrf(m, n, a32, lda, ipiv)
+	fromSingle(a, a32)
+	return r
+}
+
+func (impl single) Dgetri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) bool {
+	a32 := toSingle(a)
+	work32 := toSingle(work)
+	r := impl.Implementation.Sgetri(n, a32, lda, ipiv, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	a32 := toSingle(a)
+	b32 := toSingle(b)
+	impl.Implementation.Sgetrs(trans, n, nrhs, a32, lda, ipiv, b32, ldb)
+	fromSingle(a, a32)
+	fromSingle(b, b32)
+}
+
+func (impl single) Dpotf2(ul blas.Uplo, n int, a []float64, lda int) bool {
+	a32 := toSingle(a)
+	r := impl.Implementation.Spotf2(ul, n, a32, lda)
+	fromSingle(a, a32)
+	return r
+}
+
+func (impl single) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) bool {
+	a32 := toSingle(a)
+	r := impl.Implementation.Spotrf(ul, n, a32, lda)
+	fromSingle(a, a32)
+	return r
+}
+
+func (impl single) Dpotri(uplo blas.Uplo, n int, a []float64, lda int) bool {
+	a32 := toSingle(a)
+	r := impl.Implementation.Spotri(uplo, n, a32, lda)
+	fromSingle(a, a32)
+	return r
+}
+
+func (impl single) Dpotrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int) {
+	a32 := toSingle(a)
+	b32 := toSingle(b)
+	impl.Implementation.Spotrs(uplo, n, nrhs, a32, lda, b32, ldb)
+	fromSingle(a, a32)
+	fromSingle(b, b32)
+}
+
+func (impl single) Dgeqr2(m, n int, a []float64, lda int, tau, work []float64) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sgeqr2(m, n, a32, lda, tau32, work32)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sgeqrf(m, n, a32, lda, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dgelq2(m, n int, a []float64, lda int, tau, work []float64) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sgelq2(m, n, a32, lda, tau32, work32)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dgelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sgelqf(m, n, a32, lda, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dorg2r(m, n, k int, a []float64, lda int, tau []float64, work []float64) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sorg2r(m, n, k, a32, lda, tau32, work32)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dorgqr(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sorgqr(m, n, k, a32, lda, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dorm2r(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	c32 := toSingle(c)
+	work32 := toSingle(work)
+	impl.Implementation.Sorm2r(side, trans, m, n, k, a32, lda, tau32, c32, ldc, work32)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(c, c32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	c32 := toSingle(c)
+	work32 := toSingle(work)
+	impl.Implementation.Sormqr(side, trans, m, n, k, a32, lda, tau32, c32, ldc, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(c, c32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dorgl2(m, n, k int, a []float64, lda int, tau, work []float64) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sorgl2(m, n, k, a32, lda, tau32, work32)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dorglq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sorglq(m, n, k, a32, lda, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dorml2(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	c32 := toSingle(c)
+	work32 := toSingle(work)
+	impl.Implementation.Sorml2(side, trans, m, n, k, a32, lda, tau32, c32, ldc, work32)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(c, c32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	c32 := toSingle(c)
+	work32 := toSingle(work)
+	impl.Implementation.Sormlq(side, trans, m, n, k, a32, lda, tau32, c32, ldc, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(c, c32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dgels(trans blas.Transpose, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool {
+	a32 := toSingle(a)
+	b32 := toSingle(b)
+	work32 := toSingle(work)
+	r := impl.Implementation.Sgels(trans, m, n, nrhs, a32, lda, b32, ldb, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(b, b32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) bool {
+	a32 := toSingle(a)
+	r := impl.Implementation.Strtri(uplo, diag, n, a32, lda)
+	fromSingle(a, a32)
+	return r
+}
+
+func (impl single) Dsytrd(uplo blas.Uplo, n int, a []float64, lda int, d, e, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	d32 := toSingle(d)
+	e32 := toSingle(e)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Ssytrd(uplo, n, a32, lda, d32, e32, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(d, d32)
+	fromSingle(e, e32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dsterf(n int, d, e []float64) bool {
+	d32 := toSingle(d)
+	e32 := toSingle(e)
+	r := impl.Implementation.Ssterf(n, d32, e32)
+	fromSingle(d, d32)
+	fromSingle(e, e32)
+	return r
+}
+
+func (impl single) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64) bool {
+	d32 := toSingle(d)
+	e32 := toSingle(e)
+	z32 := toSingle(z)
+	work32 := toSingle(work)
+	r := impl.Implementation.Ssteqr(compz, n, d32, e32, z32, ldz, work32)
+	fromSingle(d, d32)
+	fromSingle(e, e32)
+	fromSingle(z, z32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dsyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) bool {
+	a32 := toSingle(a)
+	w32 := toSingle(w)
+	work32 := toSingle(work)
+	r := impl.Implementation.Ssyev(jobz, uplo, n, a32, lda, w32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(w, w32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) bool {
+	a32 := toSingle(a)
+	w32 := toSingle(w)
+	work32 := toSingle(work)
+	r := impl.Implementation.Ssyevd(jobz, uplo, n, a32, lda, w32, work32, lwork, iwork, liwork)
+	fromSingle(a, a32)
+	fromSingle(w, w32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) bool {
+	a32 := toSingle(a)
+	s32 := toSingle(s)
+	u32 := toSingle(u)
+	vt32 := toSingle(vt)
+	work32 := toSingle(work)
+	r := impl.Implementation.Sgesvd(jobU, jobVT, m, n, a32, lda, s32, u32, ldu, vt32, ldvt, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(s, s32)
+	fromSingle(u, u32)
+	fromSingle(vt, vt32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dgeqp3(m, n int, a []float64, lda int, jpvt []int, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sgeqp3(m, n, a32, lda, jpvt, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dpbtrf(uplo blas.Uplo, n, kd int, ab []float64, ldab int) bool {
+	ab32 := toSingle(ab)
+	r := impl.Implementation.Spbtrf(uplo, n, kd, ab32, ldab)
+	fromSingle(ab, ab32)
+	return r
+}
+
+func (impl single) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) bool {
+	ab32 := toSingle(ab)
+	r := impl.Implementation.Sgbtrf(m, n, kl, ku, ab32, ldab, ipiv)
+	fromSingle(ab, ab32)
+	return r
+}
+
+func (impl single) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	ab32 := toSingle(ab)
+	b32 := toSingle(b)
+	impl.Implementation.Sgbtrs(trans, n, kl, ku, nrhs, ab32, ldab, ipiv, b32, ldb)
+	fromSingle(ab, ab32)
+	fromSingle(b, b32)
+}
+
+func (impl single) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) bool {
+	a32 := toSingle(a)
+	work32 := toSingle(work)
+	r := impl.Implementation.Ssytrf(uplo, n, a32, lda, ipiv, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(work, work32)
+	return r
+}
+
+func (impl single) Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	a32 := toSingle(a)
+	b32 := toSingle(b)
+	impl.Implementation.Ssytrs(uplo, n, nrhs, a32, lda, ipiv, b32, ldb)
+	fromSingle(a, a32)
+	fromSingle(b, b32)
+}
+
+func (impl single) Dgehrd(n, ilo, ihi int, a []float64, lda int, tau, work []float64, lwork int) {
+	a32 := toSingle(a)
+	tau32 := toSingle(tau)
+	work32 := toSingle(work)
+	impl.Implementation.Sgehrd(n, ilo, ihi, a32, lda, tau32, work32, lwork)
+	fromSingle(a, a32)
+	fromSingle(tau, tau32)
+	fromSingle(work, work32)
+}
+
+func (impl single) Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (float64, bool) {
+	a32 := toSingle(a)
+	b32 := toSingle(b)
+	c32 := toSingle(c)
+	r0, r1 := impl.Implementation.Strsyl(trana, tranb, isgn, m, n, a32, lda, b32, ldb, c32, ldc)
+	fromSingle(a, a32)
+	fromSingle(b, b32)
+	fromSingle(c, c32)
+	return float64(r0), r1
+}
+
+func (impl single) Dgtsv(n, nrhs int, dl, d, du []float64, b []float64, ldb int) bool {
+	dl32 := toSingle(dl)
+	d32 := toSingle(d)
+	du32 := toSingle(du)
+	b32 := toSingle(b)
+	r := impl.Implementation.Sgtsv(n, nrhs, dl32, d32, du32, b32, ldb)
+	fromSingle(dl, dl32)
+	fromSingle(d, d32)
+	fromSingle(du, du32)
+	fromSingle(b, b32)
+	return r
+}
+
+func (impl single) Dpttrf(n int, d, e []float64) bool {
+	d32 := toSingle(d)
+	e32 := toSingle(e)
+	r := impl.Implementation.Spttrf(n, d32, e32)
+	fromSingle(d, d32)
+	fromSingle(e, e32)
+	return r
+}
+
+func (impl single) Dpttrs(n, nrhs int, d, e []float64, b []float64, ldb int) {
+	d32 := toSingle(d)
+	e32 := toSingle(e)
+	b32 := toSingle(b)
+	impl.Implementation.Spttrs(n, nrhs, d32, e32, b32, ldb)
+	fromSingle(d, d32)
+	fromSingle(e, e32)
+	fromSingle(b, b32)
+}
+
+func TestSgbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrfTest(t, single{})
+}
+
+func TestSgbtrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrsTest(t, single{})
+}
+
+func TestSgehrd(t *testing.T) {
+	t.Parallel()
+	testlapack.DgehrdTest(t, single{})
+}
+
+func TestSgelq2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgelq2Test(t, single{})
+}
+
+func TestSgelqf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgelqfTest(t, single{})
+}
+
+func TestSgels(t *testing.T) {
+	t.Parallel()
+	testlapack.DgelsTest(t, single{})
+}
+
+func TestSgeqp3(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgeqp3Test(t, single{})
+}
+
+func TestSgeqr2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgeqr2Test(t, single{})
+}
+
+func TestSgeqrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgeqrfTest(t, single{})
+}
+
+func TestSgesvd(t *testing.T) {
+	t.Parallel()
+	testlapack.DgesvdTest(t, single{}, 1e-13)
+}
+
+func TestSgetf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dgetf2Test(t, single{})
+}
+
+func TestSgetrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgetrfTest(t, single{})
+}
+
+func TestSgetri(t *testing.T) {
+	t.Parallel()
+	testlapack.DgetriTest(t, single{})
+}
+
+func TestSgetrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgetrsTest(t, single{})
+}
+
+func TestSgtsv(t *testing.T) {
+	t.Parallel()
+	testlapack.DgtsvTest(t, single{})
+}
+
+func TestSorg2r(t *testing.T) {
+	t.Parallel()
+	testlapack.Dorg2rTest(t, single{})
+}
+
+func TestSorgl2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dorgl2Test(t, single{})
+}
+
+func TestSorglq(t *testing.T) {
+	t.Parallel()
+	testlapack.DorglqTest(t, single{})
+}
+
+func TestSorgqr(t *testing.T) {
+	t.Parallel()
+	testlapack.DorgqrTest(t, single{})
+}
+
+func TestSorm2r(t *testing.T) {
+	t.Parallel()
+	testlapack.Dorm2rTest(t, single{})
+}
+
+func TestSorml2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dorml2Test(t, single{})
+}
+
+func TestSormlq(t *testing.T) {
+	t.Parallel()
+	testlapack.DormlqTest(t, single{})
+}
+
+func TestSormqr(t *testing.T) {
+	t.Parallel()
+	testlapack.DormqrTest(t, single{})
+}
+
+func TestSpbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DpbtrfTest(t, single{})
+}
+
+func TestSpotf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dpotf2Test(t, single{})
+}
+
+func TestSpotrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DpotrfTest(t, single{})
+}
+
+func TestSpotri(t *testing.T) {
+	t.Parallel()
+	testlapack.DpotriTest(t, single{})
+}
+
+func TestSpotrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DpotrsTest(t, single{})
+}
+
+func TestSpttrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DpttrfTest(t, single{})
+}
+
+func TestSpttrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DpttrsTest(t, single{})
+}
+
+func TestSsteqr(t *testing.T) {
+	t.Parallel()
+	testlapack.DsteqrTest(t, single{})
+}
+
+func TestSsterf(t *testing.T) {
+	t.Parallel()
+	testlapack.DsterfTest(t, single{})
+}
+
+func TestSsyev(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevTest(t, single{})
+}
+
+func TestSsyevd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevdTest(t, single{})
+}
+
+func TestSsytrd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrdTest(t, single{})
+}
+
+func TestSsytrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrfTest(t, single{})
+}
+
+func TestSsytrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrsTest(t, single{})
+}
+
+func TestStrsyl(t *testing.T) {
+	t.Parallel()
+	testlapack.DtrsylTest(t, single{})
+}
+
+func TestStrtri(t *testing.T) {
+	t.Parallel()
+	testlapack.DtrtriTest(t, single{})
+}
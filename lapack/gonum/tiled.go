@@ -0,0 +1,536 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// defaultTileSize is the tile size used by Tiled if TileSize is not positive.
+const defaultTileSize = 128
+
+// Tiled is a LAPACK implementation that computes the Cholesky, LU and QR
+// factorizations of large matrices using tiled algorithms. The matrix is
+// partitioned into square tiles and each factorization is expressed as a
+// directed acyclic graph of tasks that operate on the tiles. The tasks are
+// executed concurrently by a pool of worker goroutines as soon as the tiles
+// they depend on are ready, so that the factorization of a panel overlaps with
+// the update of the trailing matrix.
+//
+// The tiled algorithms perform the same operations as the blocked algorithms of
+// Implementation in a different order, and so the computed factors have the
+// same accuracy. Dgetrf computes the same pivots as the blocked algorithm up to
+// the effect of rounding, and Dgeqrf stores the factorization in the same form
+// as Dgeqr2.
+//
+// All other routines, as well as the factorizations of matrices that are too
+// small to benefit from tiling, are computed by the embedded Implementation.
+//
+// A Tiled value can be used as the LAPACK implementation of the lapack64
+// package:
+//
+//	lapack64.Use(gonum.Tiled{Workers: 16})
+type Tiled struct {
+	Implementation
+
+	// Workers is the maximum number of goroutines that execute tile
+	// tasks concurrently. If Workers is not positive, runtime.GOMAXPROCS(0)
+	// workers are used.
+	Workers int
+
+	// TileSize is the number of rows and columns of a tile. If TileSize is
+	// not positive, a default tile size is used.
+	TileSize int
+}
+
+var (
+	_ lapack.Float64 = Tiled{}
+	_ lapack.Float32 = Tiled{}
+)
+
+// workers returns the number of worker goroutines to use.
+func (impl Tiled) workers() int {
+	if impl.Workers > 0 {
+		return impl.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// tileSize returns the tile size to use.
+func (impl Tiled) tileSize() int {
+	if impl.TileSize > 0 {
+		return impl.TileSize
+	}
+	return defaultTileSize
+}
+
+// useTiles returns whether a factorization of a matrix with mn = min(m,n)
+// should use the tiled algorithm.
+func (impl Tiled) useTiles(mn int) bool {
+	return impl.workers() > 1 && mn > 2*impl.tileSize()
+}
+
+// Dpotrf computes the Cholesky factorization of the n×n symmetric positive
+// definite matrix A. See Implementation.Dpotrf for a description of the
+// parameters.
+//
+// Dpotrf returns whether A is positive definite. If it returns false, the
+// contents of a are unspecified.
+func (impl Tiled) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	if !impl.useTiles(n) {
+		return impl.Implementation.Dpotrf(ul, n, a, lda)
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	bi := blas64.Implementation()
+	nb := impl.tileSize()
+	nt := (n + nb - 1) / nb
+	tile := func(i, j int) []float64 {
+		return a[i*nb*lda+j*nb:]
+	}
+	size := func(i int) int {
+		return min(nb, n-i*nb)
+	}
+	key := func(i, j int) int {
+		return i*nt + j
+	}
+
+	// failed is set when a diagonal tile is found not to be positive definite.
+	// All tasks that have not started by then return immediately.
+	var failed atomic.Bool
+	var g taskGraph
+	for k := 0; k < nt; k++ {
+		kb := size(k)
+		g.insert(func() {
+			if failed.Load() {
+				return
+			}
+			if !impl.Implementation.Dpotrf(ul, kb, tile(k, k), lda) {
+				failed.Store(true)
+			}
+		}, nil, []int{key(k, k)})
+		for i := k + 1; i < nt; i++ {
+			ib := size(i)
+			if ul == blas.Upper {
+				// U[k,i] = U[k,k]^{-T} * A[k,i].
+				g.insert(func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dtrsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit, kb, ib,
+						1, tile(k, k), lda, tile(k, i), lda)
+				}, []int{key(k, k)}, []int{key(k, i)})
+			} else {
+				// L[i,k] = A[i,k] * L[k,k]^{-T}.
+				g.insert(func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dtrsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, ib, kb,
+						1, tile(k, k), lda, tile(i, k), lda)
+				}, []int{key(k, k)}, []int{key(i, k)})
+			}
+		}
+		for i := k + 1; i < nt; i++ {
+			ib := size(i)
+			if ul == blas.Upper {
+				// A[i,i] -= U[k,i]ᵀ * U[k,i].
+				g.insert(func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dsyrk(blas.Upper, blas.Trans, ib, kb,
+						-1, tile(k, i), lda, 1, tile(i, i), lda)
+				}, []int{key(k, i)}, []int{key(i, i)})
+				// A[j,i] -= U[k,j]ᵀ * U[k,i] for k < j < i.
+				for j := k + 1; j < i; j++ {
+					jb := size(j)
+					g.insert(func() {
+						if failed.Load() {
+							return
+						}
+						bi.Dgemm(blas.Trans, blas.NoTrans, jb, ib, kb,
+							-1, tile(k, j), lda, tile(k, i), lda,
+							1, tile(j, i), lda)
+					}, []int{key(k, j), key(k, i)}, []int{key(j, i)})
+				}
+			} else {
+				// A[i,i] -= L[i,k] * L[i,k]ᵀ.
+				g.insert(func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dsyrk(blas.Lower, blas.NoTrans, ib, kb,
+						-1, tile(i, k), lda, 1, tile(i, i), lda)
+				}, []int{key(i, k)}, []int{key(i, i)})
+				// A[i,j] -= L[i,k] * L[j,k]ᵀ for k < j < i.
+				for j := k + 1; j < i; j++ {
+					jb := size(j)
+					g.insert(func() {
+						if failed.Load() {
+							return
+						}
+						bi.Dgemm(blas.NoTrans, blas.Trans, ib, jb, kb,
+							-1, tile(i, k), lda, tile(j, k), lda,
+							1, tile(i, j), lda)
+					}, []int{key(i, k), key(j, k)}, []int{key(i, j)})
+				}
+			}
+		}
+	}
+	g.run(impl.workers())
+	return !failed.Load()
+}
+
+// Dgetrf computes the LU decomposition of an m×n matrix A using partial
+// pivoting with row interchanges. See Implementation.Dgetrf for a description
+// of the parameters.
+//
+// Dgetrf returns whether the matrix A is nonsingular. The LU decomposition
+// will be computed regardless of the singularity of A, but the result should
+// not be used to solve a system of equation.
+func (impl Tiled) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	if !impl.useTiles(mn) {
+		return impl.Implementation.Dgetrf(m, n, a, lda, ipiv)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+	nb := impl.tileSize()
+	mt := (m + nb - 1) / nb
+	nt := (n + nb - 1) / nb
+	kt := (mn + nb - 1) / nb
+	key := func(i, j int) int {
+		return i*nt + j
+	}
+	// column returns the keys of the tiles in the column j of tiles starting
+	// from the row k of tiles.
+	column := func(k, j int) []int {
+		keys := make([]int, 0, mt-k)
+		for i := k; i < mt; i++ {
+			keys = append(keys, key(i, j))
+		}
+		return keys
+	}
+
+	// singular is set by the panel factorizations if an exact zero pivot is
+	// encountered.
+	var singular atomic.Bool
+	var g taskGraph
+	for k := 0; k < kt; k++ {
+		// The panel is formed by the columns j0:j0+kb of the rows j0:m. The
+		// panel factorization writes all tiles in the column k of tiles from
+		// the row k downwards and the corresponding elements of ipiv.
+		j0 := k * nb
+		kb := min(nb, mn-j0)
+		panel := column(k, k)
+		g.insert(func() {
+			if !impl.Dgetf2(m-j0, kb, a[j0*lda+j0:], lda, ipiv[j0:j0+kb]) {
+				singular.Store(true)
+			}
+			for i := j0; i < j0+kb; i++ {
+				ipiv[i] += j0
+			}
+			// If m < n, the last panel may be narrower than its column of
+			// tiles. The remaining columns of the tile are updated here.
+			if cb := min(nb, n-j0) - kb; cb > 0 {
+				impl.Dlaswp(cb, a[j0+kb:], lda, j0, j0+kb-1, ipiv[:j0+kb], 1)
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, kb, cb,
+					1, a[j0*lda+j0:], lda, a[j0*lda+j0+kb:], lda)
+			}
+		}, nil, panel)
+
+		// The row interchanges of the panel are applied to all other columns
+		// of tiles. The pivot indices are read through the diagonal tile of
+		// the panel.
+		for j := 0; j < nt; j++ {
+			if j == k {
+				continue
+			}
+			c0 := j * nb
+			cb := min(nb, n-c0)
+			if j < k {
+				g.insert(func() {
+					impl.Dlaswp(cb, a[c0:], lda, j0, j0+kb-1, ipiv[:j0+kb], 1)
+				}, []int{key(k, k)}, column(k, j))
+				continue
+			}
+			// U[k,j] = L[k,k]^{-1} * P * A[k,j].
+			g.insert(func() {
+				impl.Dlaswp(cb, a[c0:], lda, j0, j0+kb-1, ipiv[:j0+kb], 1)
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, kb, cb,
+					1, a[j0*lda+j0:], lda, a[j0*lda+c0:], lda)
+			}, []int{key(k, k)}, column(k, j))
+			// A[i,j] -= L[i,k] * U[k,j].
+			for i := k + 1; i < mt; i++ {
+				r0 := i * nb
+				rb := min(nb, m-r0)
+				g.insert(func() {
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, rb, cb, kb,
+						-1, a[r0*lda+j0:], lda, a[j0*lda+c0:], lda,
+						1, a[r0*lda+c0:], lda)
+				}, []int{key(i, k), key(k, j)}, []int{key(i, j)})
+			}
+		}
+	}
+	g.run(impl.workers())
+	return !singular.Load()
+}
+
+// Dgeqrf computes the QR factorization of the m×n matrix A. See
+// Implementation.Dgeqrf for a description of the parameters.
+//
+// The tiled algorithm needs a larger workspace than the blocked algorithm. If
+// lwork is smaller than the optimal length returned by a workspace query, the
+// factorization is computed by Implementation.Dgeqrf.
+func (impl Tiled) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	mn := min(m, n)
+	if !impl.useTiles(mn) {
+		impl.Implementation.Dgeqrf(m, n, a, lda, tau, work, lwork)
+		return
+	}
+
+	nb := impl.tileSize()
+	mt := (m + nb - 1) / nb
+	nt := (n + nb - 1) / nb
+	kt := (mn + nb - 1) / nb
+	// The triangular factors of the block reflectors of the panels are
+	// stored in the first kt nb×nb blocks of work, followed by an nb×nb
+	// workspace block for each column of tiles.
+	lopt := (kt + nt) * nb * nb
+	if lwork == -1 {
+		work[0] = float64(lopt)
+		return
+	}
+	if lwork < lopt {
+		impl.Implementation.Dgeqrf(m, n, a, lda, tau, work, lwork)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) != mn:
+		panic(badLenTau)
+	}
+
+	ldt := nb
+	tfac := func(k int) []float64 {
+		return work[k*nb*nb : (k+1)*nb*nb]
+	}
+	wblock := func(j int) []float64 {
+		return work[(kt+j)*nb*nb : (kt+j+1)*nb*nb]
+	}
+	key := func(i, j int) int {
+		return i*nt + j
+	}
+	// tkey returns the key of the triangular factor of the panel k.
+	tkey := func(k int) int {
+		return mt*nt + k
+	}
+	column := func(k, j int) []int {
+		keys := make([]int, 0, mt-k)
+		for i := k; i < mt; i++ {
+			keys = append(keys, key(i, j))
+		}
+		return keys
+	}
+
+	var g taskGraph
+	for k := 0; k < kt; k++ {
+		// The panel is formed by the columns j0:j0+kb of the rows j0:m.
+		j0 := k * nb
+		kb := min(nb, mn-j0)
+		panel := column(k, k)
+		g.insert(func() {
+			impl.Dgeqr2(m-j0, kb, a[j0*lda+j0:], lda, tau[j0:j0+kb], wblock(k))
+			if j0+kb == n {
+				return
+			}
+			impl.Dlarft(lapack.Forward, lapack.ColumnWise, m-j0, kb,
+				a[j0*lda+j0:], lda, tau[j0:], tfac(k), ldt)
+			// If m < n, the last panel may be narrower than its column of
+			// tiles. The remaining columns of the tile are updated here.
+			if cb := min(nb, n-j0) - kb; cb > 0 {
+				impl.Dlarfb(blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
+					m-j0, cb, kb,
+					a[j0*lda+j0:], lda,
+					tfac(k), ldt,
+					a[j0*lda+j0+kb:], lda,
+					wblock(k), ldt)
+			}
+		}, nil, append(panel, tkey(k)))
+
+		// Apply Hᵀ to the columns of tiles to the right of the panel.
+		reads := append(panel, tkey(k))
+		for j := k + 1; j < nt; j++ {
+			c0 := j * nb
+			cb := min(nb, n-c0)
+			g.insert(func() {
+				impl.Dlarfb(blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
+					m-j0, cb, kb,
+					a[j0*lda+j0:], lda,
+					tfac(k), ldt,
+					a[j0*lda+c0:], lda,
+					wblock(j), ldt)
+			}, reads, column(k, j))
+		}
+	}
+	g.run(impl.workers())
+	work[0] = float64(lopt)
+}
+
+// taskGraph is a directed acyclic graph of tasks. Tasks are inserted in the
+// order of a sequential execution together with the keys of the data they
+// read and write, and the dependencies between the tasks are derived from
+// the order of the accesses to the same data.
+type taskGraph struct {
+	tasks []*task
+	data  map[int]*dataAccess
+}
+
+// task is a node of a taskGraph.
+type task struct {
+	fn    func()
+	succ  []*task
+	npred atomic.Int32
+}
+
+// dataAccess records the last task that wrote a datum and the tasks that
+// read it since.
+type dataAccess struct {
+	writer  *task
+	readers []*task
+}
+
+// insert adds a task that executes fn to the graph. The task is executed after
+// all previously inserted tasks that write data in reads or read or write data
+// in writes.
+func (g *taskGraph) insert(fn func(), reads, writes []int) {
+	if g.data == nil {
+		g.data = make(map[int]*dataAccess)
+	}
+	t := &task{fn: fn}
+	for _, k := range reads {
+		acc := g.access(k)
+		g.depend(t, acc.writer)
+		acc.readers = append(acc.readers, t)
+	}
+	for _, k := range writes {
+		acc := g.access(k)
+		g.depend(t, acc.writer)
+		for _, r := range acc.readers {
+			g.depend(t, r)
+		}
+		acc.writer = t
+		acc.readers = acc.readers[:0]
+	}
+	g.tasks = append(g.tasks, t)
+}
+
+// access returns the access record for the datum with key k.
+func (g *taskGraph) access(k int) *dataAccess {
+	acc, ok := g.data[k]
+	if !ok {
+		acc = &dataAccess{}
+		g.data[k] = acc
+	}
+	return acc
+}
+
+// depend adds an edge from pred to t, unless pred is nil, t itself or already
+// a predecessor of t.
+func (g *taskGraph) depend(t, pred *task) {
+	if pred == nil || pred == t {
+		return
+	}
+	// t is the most recently inserted task, so an existing edge from pred
+	// to t is the last successor of pred.
+	if n := len(pred.succ); n > 0 && pred.succ[n-1] == t {
+		return
+	}
+	pred.succ = append(pred.succ, t)
+	t.npred.Add(1)
+}
+
+// run executes the tasks of the graph using the given number of worker
+// goroutines and returns when all tasks have completed.
+func (g *taskGraph) run(workers int) {
+	if len(g.tasks) == 0 {
+		return
+	}
+	ready := make(chan *task, len(g.tasks))
+	for _, t := range g.tasks {
+		if t.npred.Load() == 0 {
+			ready <- t
+		}
+	}
+	done := make(chan struct{})
+	remaining := int32(len(g.tasks))
+	var completed atomic.Int32
+	for w := 0; w < min(workers, len(g.tasks)); w++ {
+		go func() {
+			for t := range ready {
+				t.fn()
+				for _, s := range t.succ {
+					if s.npred.Add(-1) == 0 {
+						ready <- s
+					}
+				}
+				if completed.Add(1) == remaining {
+					close(done)
+				}
+			}
+		}()
+	}
+	<-done
+	close(ready)
+}
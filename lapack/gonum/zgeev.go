@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zgeev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n complex nonsymmetric matrix A.
+//
+// The right eigenvector v_j of A corresponding to an eigenvalue λ_j
+// is defined by
+//
+//	A v_j = λ_j v_j,
+//
+// and the left eigenvector u_j corresponding to an eigenvalue λ_j is defined by
+//
+//	u_jᴴ A = λ_j u_jᴴ,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, A will be overwritten and the left and right eigenvectors will be
+// stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues,
+//
+//	u_j = VL[:,j],
+//	v_j = VR[:,j].
+//
+// The computed eigenvectors are normalized to have Euclidean norm equal to 1
+// and largest component real.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Zgeev will panic.
+//
+// w contains the computed eigenvalues and must have length n, otherwise Zgeev
+// will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,2*n).
+// For good performance, lwork must generally be larger. On return, optimal
+// value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Zgeev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// rwork must have length at least 2*n, otherwise Zgeev will panic.
+//
+// On return, first is the index of the first valid eigenvalue. If first == 0,
+// all eigenvalues and eigenvectors have been computed. If first is positive,
+// Zgeev failed to compute all the eigenvalues, no eigenvectors have been
+// computed and w[first:] contains those eigenvalues which have converged.
+func (impl Implementation) Zgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) (first int) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	minwrk := max(1, 2*n)
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(badLdVL)
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(badLdVR)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < lwork:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	maxwrk := minwrk
+	if wantvl || wantvr {
+		impl.Zunghr(n, 0, n-1, a, lda, nil, work, -1)
+		maxwrk = max(maxwrk, n+int(real(work[0])))
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(maxwrk), 0)
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) != n:
+		panic(badLenW)
+	case len(vl) < (n-1)*ldvl+n && wantvl:
+		panic(shortVL)
+	case len(vr) < (n-1)*ldvr+n && wantvr:
+		panic(shortVR)
+	case len(rwork) < 2*n:
+		panic(shortRWork)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(dlamchS) / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := impl.Zlange(lapack.MaxAbs, n, n, a, lda, nil)
+	var scalea bool
+	var cscale float64
+	if 0 < anrm && anrm < smlnum {
+		scalea = true
+		cscale = smlnum
+	} else if anrm > bignum {
+		scalea = true
+		cscale = bignum
+	}
+	if scalea {
+		impl.Zlascl(lapack.General, 0, 0, anrm, cscale, n, n, a, lda)
+	}
+
+	// Balance the matrix.
+	workbal := rwork[:n]
+	ilo, ihi := impl.Zgebal(lapack.PermuteScale, n, a, lda, workbal)
+
+	// Reduce to upper Hessenberg form.
+	iwrk := n
+	tau := work[:n-1]
+	impl.Zgehd2(n, ilo, ihi, a, lda, tau, work[iwrk:])
+
+	// The eigenvalues isolated by balancing are on the diagonal of A.
+	for i := 0; i < ilo; i++ {
+		w[i] = a[i*lda+i]
+	}
+	for i := ihi + 1; i < n; i++ {
+		w[i] = a[i*lda+i]
+	}
+
+	var side lapack.EVSide
+	if wantvl {
+		side = lapack.EVLeft
+		// Copy Householder vectors to VL.
+		for i := 0; i < n; i++ {
+			copy(vl[i*ldvl:i*ldvl+i+1], a[i*lda:i*lda+i+1])
+		}
+		// Generate unitary matrix in VL.
+		impl.Zunghr(n, ilo, ihi, vl, ldvl, tau, work[iwrk:], lwork-iwrk)
+		// Perform QR iteration, accumulating Schur vectors in VL.
+		first = impl.Zlahqr(true, true, n, ilo, ihi, a, lda, w[:ihi+1], 0, n-1, vl, ldvl)
+		if wantvr {
+			// Want left and right eigenvectors.
+			// Copy Schur vectors to VR.
+			side = lapack.EVBoth
+			for i := 0; i < n; i++ {
+				copy(vr[i*ldvr:i*ldvr+n], vl[i*ldvl:i*ldvl+n])
+			}
+		}
+	} else if wantvr {
+		side = lapack.EVRight
+		// Copy Householder vectors to VR.
+		for i := 0; i < n; i++ {
+			copy(vr[i*ldvr:i*ldvr+i+1], a[i*lda:i*lda+i+1])
+		}
+		// Generate unitary matrix in VR.
+		impl.Zunghr(n, ilo, ihi, vr, ldvr, tau, work[iwrk:], lwork-iwrk)
+		// Perform QR iteration, accumulating Schur vectors in VR.
+		first = impl.Zlahqr(true, true, n, ilo, ihi, a, lda, w[:ihi+1], 0, n-1, vr, ldvr)
+	} else {
+		// Compute eigenvalues only.
+		first = impl.Zlahqr(false, false, n, ilo, ihi, a, lda, w[:ihi+1], 0, n-1, nil, 1)
+	}
+
+	if first > 0 {
+		if scalea {
+			// Undo scaling.
+			impl.Zlascl(lapack.General, 0, 0, cscale, anrm, n-first, 1, w[first:], 1)
+			impl.Zlascl(lapack.General, 0, 0, cscale, anrm, ilo, 1, w, 1)
+		}
+		work[0] = complex(float64(maxwrk), 0)
+		return first
+	}
+
+	if wantvl || wantvr {
+		// Compute left and/or right eigenvectors.
+		impl.Ztrevc(side, lapack.EVAllMulQ, nil, n, a, lda, vl, ldvl, vr, ldvr, n, work, rwork[n:])
+	}
+	bi := cblas128.Implementation()
+	bi64 := blas64.Implementation()
+	if wantvl {
+		// Undo balancing of left eigenvectors.
+		impl.Zgebak(lapack.PermuteScale, lapack.EVLeft, n, ilo, ihi, workbal, n, vl, ldvl)
+		// Normalize left eigenvectors and make largest component real.
+		for i := 0; i < n; i++ {
+			scl := 1 / bi.Dznrm2(n, vl[i:], ldvl)
+			bi.Zdscal(n, scl, vl[i:], ldvl)
+			for k := 0; k < n; k++ {
+				vk := vl[k*ldvl+i]
+				rwork[n+k] = real(vk)*real(vk) + imag(vk)*imag(vk)
+			}
+			k := bi64.Idamax(n, rwork[n:2*n], 1)
+			tmp := cmplx.Conj(vl[k*ldvl+i]) / complex(math.Sqrt(rwork[n+k]), 0)
+			bi.Zscal(n, tmp, vl[i:], ldvl)
+			vl[k*ldvl+i] = complex(real(vl[k*ldvl+i]), 0)
+		}
+	}
+	if wantvr {
+		// Undo balancing of right eigenvectors.
+		impl.Zgebak(lapack.PermuteScale, lapack.EVRight, n, ilo, ihi, workbal, n, vr, ldvr)
+		// Normalize right eigenvectors and make largest component real.
+		for i := 0; i < n; i++ {
+			scl := 1 / bi.Dznrm2(n, vr[i:], ldvr)
+			bi.Zdscal(n, scl, vr[i:], ldvr)
+			for k := 0; k < n; k++ {
+				vk := vr[k*ldvr+i]
+				rwork[n+k] = real(vk)*real(vk) + imag(vk)*imag(vk)
+			}
+			k := bi64.Idamax(n, rwork[n:2*n], 1)
+			tmp := cmplx.Conj(vr[k*ldvr+i]) / complex(math.Sqrt(rwork[n+k]), 0)
+			bi.Zscal(n, tmp, vr[i:], ldvr)
+			vr[k*ldvr+i] = complex(real(vr[k*ldvr+i]), 0)
+		}
+	}
+
+	if scalea {
+		// Undo scaling.
+		impl.Zlascl(lapack.General, 0, 0, cscale, anrm, n, 1, w, 1)
+	}
+
+	work[0] = complex(float64(maxwrk), 0)
+	return first
+}
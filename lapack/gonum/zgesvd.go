@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zgesvd computes the singular value decomposition of the complex input matrix
+// A.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᴴ
+//
+// where Sigma is an m×n real diagonal matrix containing the singular values of
+// A, U is an m×m unitary matrix and V is an n×n unitary matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//
+//	jobU == lapack.SVDAll       All m columns of U are returned in u
+//	jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//	jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
+//	jobU == lapack.SVDNone      The columns of U are not computed.
+//
+// The behavior is the same for jobVT and the rows of Vᴴ. At most one of jobU
+// and jobVT can equal lapack.SVDOverwrite, and Zgesvd will panic otherwise.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Zgesvd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if either job is lapack.SVDOverwrite.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
+// not used.
+//
+// vt contains the right singular vectors on exit, stored row-wise. If
+// jobVT == lapack.SVDAll, vt is of size n×n. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
+// not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least 2*min(m,n)+max(m,n). If lwork == -1,
+// instead of performing Zgesvd, the optimal work length will be stored into
+// work[0]. Zgesvd will panic if the working memory has insufficient storage.
+//
+// rwork is temporary storage and must have length at least 5*min(m,n),
+// otherwise Zgesvd will panic.
+//
+// Zgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuas := wantua || wantus
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(badSVDJob)
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(badSVDJob)
+	}
+
+	if wantuo && wantvo {
+		panic(bothSVDOver)
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = 2*minmn + max(m, n)
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(badLdU)
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// The number of columns of U and rows of Vᴴ that are generated.
+	ncu := minmn
+	if wantua {
+		ncu = m
+	}
+	nrvt := minmn
+	if wantva {
+		nrvt = n
+	}
+
+	// Compute optimal workspace size for the generation of the singular
+	// vectors.
+	lworkopt := max(m, n)
+	if wantuas || wantuo {
+		impl.Zungbr(lapack.GenerateQ, m, ncu, n, a, lda, nil, work, -1)
+		lworkopt = max(lworkopt, int(real(work[0])))
+	}
+	if wantvas || wantvo {
+		impl.Zungbr(lapack.GeneratePT, nrvt, n, m, a, lda, nil, work, -1)
+		lworkopt = max(lworkopt, int(real(work[0])))
+	}
+	lworkopt += 2 * minmn
+	if lwork == -1 {
+		work[0] = complex(float64(lworkopt), 0)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
+		panic(shortU)
+	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
+		panic(shortVT)
+	case len(rwork) < 5*minmn:
+		panic(shortRWork)
+	}
+
+	// Get machine constants.
+	eps := dlamchP
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum, bignum].
+	anrm := impl.Zlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		impl.Zlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		impl.Zlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+
+	// Reduce A to real bidiagonal form. The off-diagonal elements are stored
+	// in rwork[0:minmn-1].
+	itauq := 0
+	itaup := itauq + minmn
+	iwork := itaup + minmn
+	ie := 0
+	irwork := ie + minmn
+	impl.Zgebd2(m, n, a, lda, s, rwork[ie:], work[itauq:], work[itaup:], work[iwork:])
+
+	// Generate the requested singular vectors. Copies of the reflectors are
+	// made before a is overwritten.
+	if wantuas {
+		// Copy the reflectors defining Q in the lower trapezoid of A to U
+		// and generate the left bidiagonalizing vectors.
+		for i := 0; i < m; i++ {
+			for j := 0; j <= min(i, n-1); j++ {
+				u[i*ldu+j] = a[i*lda+j]
+			}
+		}
+		impl.Zungbr(lapack.GenerateQ, m, ncu, n, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+	}
+	if wantvas {
+		// Copy the reflectors defining Pᴴ in the upper trapezoid of A to
+		// Vᴴ and generate the right bidiagonalizing vectors.
+		for i := 0; i < minmn; i++ {
+			for j := i; j < n; j++ {
+				vt[i*ldvt+j] = a[i*lda+j]
+			}
+		}
+		impl.Zungbr(lapack.GeneratePT, nrvt, n, m, vt, ldvt, work[itaup:], work[iwork:], lwork-iwork)
+	}
+	if wantuo {
+		impl.Zungbr(lapack.GenerateQ, m, minmn, n, a, lda, work[itauq:], work[iwork:], lwork-iwork)
+	}
+	if wantvo {
+		impl.Zungbr(lapack.GeneratePT, minmn, n, m, a, lda, work[itaup:], work[iwork:], lwork-iwork)
+	}
+
+	// Perform bidiagonal QR iteration, computing the left singular vectors in
+	// U, or A if overwritten, and the right singular vectors in Vᴴ, or A if
+	// overwritten.
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	var nru, ncvt int
+	uu, lduu := u, ldu
+	vv, ldvv := vt, ldvt
+	switch {
+	case wantuas:
+		nru = m
+	case wantuo:
+		nru = m
+		uu, lduu = a, lda
+	}
+	switch {
+	case wantvas:
+		ncvt = n
+	case wantvo:
+		ncvt = n
+		vv, ldvv = a, lda
+	}
+	if nru == 0 {
+		lduu = 1
+	}
+	if ncvt == 0 {
+		ldvv = 1
+	}
+	ok = impl.Zbdsqr(uplo, minmn, ncvt, nru, 0, s, rwork[ie:], vv, ldvv, uu, lduu, nil, 1, rwork[irwork:])
+
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			impl.Dlascl(lapack.General, 0, 0, bignum, anrm, 1, minmn, s, minmn)
+		}
+		if anrm < smlnum {
+			impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, 1, minmn, s, minmn)
+		}
+	}
+	work[0] = complex(float64(lworkopt), 0)
+	return ok
+}
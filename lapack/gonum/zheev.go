@@ -0,0 +1,147 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zheev computes all eigenvalues and, optionally, the eigenvectors of a complex
+// Hermitian matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Zheev will panic otherwise.
+//
+// On entry, a contains the elements of the Hermitian matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,2*n-1), and Zheev will panic otherwise. The amount of
+// blocking is limited by the usable length. If lwork == -1, instead of
+// computing Zheev the optimal work length is stored into work[0].
+//
+// rwork is temporary storage and must have length at least max(1,3*n-2),
+// otherwise Zheev will panic.
+//
+// Zheev returns whether the eigenvalue algorithm converged.
+func (impl Implementation) Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, 2*n-1) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	nb := impl.Ilaenv(1, "ZHETRD", string(uplo), n, -1, -1, -1)
+	lworkopt := max(1, (nb+1)*n)
+	if lwork == -1 {
+		work[0] = complex(float64(lworkopt), 0)
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case len(rwork) < max(1, 3*n-2):
+		panic(shortRWork)
+	}
+
+	if n == 1 {
+		w[0] = real(a[0])
+		work[0] = 1
+		if jobz == lapack.EVCompute {
+			a[0] = 1
+		}
+		return true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale matrix to allowable range, if necessary.
+	var anrm float64
+	for i := 0; i < n; i++ {
+		jmin, jmax := i, n
+		if uplo == blas.Lower {
+			jmin, jmax = 0, i+1
+		}
+		for j := jmin; j < jmax; j++ {
+			v := cmplx.Abs(a[i*lda+j])
+			if v > anrm || math.IsNaN(v) {
+				anrm = v
+			}
+		}
+	}
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Zlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+	}
+
+	// Reduce A to tridiagonal form. The off-diagonal elements are stored in
+	// rwork[0:n-1] and the scalar factors of the elementary reflectors in
+	// work[0:n-1].
+	e := rwork[:n-1]
+	tau := work[:n-1]
+	impl.Zhetd2(uplo, n, a, lda, w, e, tau)
+
+	// For eigenvalues only, call Dsterf. For eigenvectors, first call Zungtr
+	// to generate the unitary matrix, then call Zsteqr.
+	if jobz == lapack.EVNone {
+		ok = impl.Dsterf(n, w, e)
+	} else {
+		impl.Zungtr(uplo, n, a, lda, tau, work[n:], lwork-n)
+		ok = impl.Zsteqr(lapack.EVComp(jobz), n, w, e, a, lda, rwork[n-1:])
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(n, 1/sigma, w, 1)
+	}
+	work[0] = complex(float64(lworkopt), 0)
+	return true
+}
@@ -0,0 +1,32 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math/cmplx"
+
+// Zlacgv conjugates the n elements of the complex vector x.
+//
+// Zlacgv is an internal routine. It is exported for testing purposes.
+func (Implementation) Zlacgv(n int, x []complex128, incX int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX <= 0:
+		panic(badIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if len(x) < 1+(n-1)*incX {
+		panic(shortX)
+	}
+
+	for i := 0; i < n; i++ {
+		x[i*incX] = cmplx.Conj(x[i*incX])
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlacn2 estimates the 1-norm of an n×n complex matrix A using sequential
+// updates with matrix-vector products provided externally.
+//
+// Zlacn2 is called sequentially and it returns the value of est and kase to be
+// used on the next call.
+// On the initial call, kase must be 0.
+// In between calls, x must be overwritten by
+//
+//	A * X    if kase was returned as 1,
+//	Aᴴ * X   if kase was returned as 2,
+//
+// and all other parameters must not be changed.
+// On the final return, kase is returned as 0, v contains A*W where W is a
+// vector, and est = norm(V)/norm(W) is a lower bound for 1-norm of A.
+//
+// v and x must both have length n and n must be at least 1, otherwise Zlacn2
+// will panic. isave is used for temporary storage.
+//
+// Zlacn2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlacn2(n int, v, x []complex128, est float64, kase int, isave *[3]int) (float64, int) {
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case len(v) < n:
+		panic(shortV)
+	case len(x) < n:
+		panic(shortX)
+	case isave[0] < 0 || 5 < isave[0]:
+		panic(badIsave)
+	case isave[0] == 0 && kase != 0:
+		panic(badIsave)
+	}
+
+	const itmax = 5
+	bi := cblas128.Implementation()
+
+	if kase == 0 {
+		for i := 0; i < n; i++ {
+			x[i] = complex(1/float64(n), 0)
+		}
+		kase = 1
+		isave[0] = 1
+		return est, kase
+	}
+	switch isave[0] {
+	case 1:
+		if n == 1 {
+			v[0] = x[0]
+			est = cmplx.Abs(v[0])
+			kase = 0
+			return est, kase
+		}
+		est = dzsum1(x[:n])
+		zlacn2Sign(x[:n])
+		kase = 2
+		isave[0] = 2
+		return est, kase
+	case 2:
+		isave[1] = izmax1(x[:n])
+		isave[2] = 2
+		for i := 0; i < n; i++ {
+			x[i] = 0
+		}
+		x[isave[1]] = 1
+		kase = 1
+		isave[0] = 3
+		return est, kase
+	case 3:
+		bi.Zcopy(n, x, 1, v, 1)
+		estold := est
+		est = dzsum1(v[:n])
+		if est > estold {
+			zlacn2Sign(x[:n])
+			kase = 2
+			isave[0] = 4
+			return est, kase
+		}
+	case 4:
+		jlast := isave[1]
+		isave[1] = izmax1(x[:n])
+		if cmplx.Abs(x[jlast]) != cmplx.Abs(x[isave[1]]) && isave[2] < itmax {
+			isave[2] += 1
+			for i := 0; i < n; i++ {
+				x[i] = 0
+			}
+			x[isave[1]] = 1
+			kase = 1
+			isave[0] = 3
+			return est, kase
+		}
+	case 5:
+		tmp := 2 * dzsum1(x[:n]) / float64(3*n)
+		if tmp > est {
+			bi.Zcopy(n, x, 1, v, 1)
+			est = tmp
+		}
+		kase = 0
+		return est, kase
+	}
+	// Iteration complete. Final stage
+	altsgn := 1.0
+	for i := 0; i < n; i++ {
+		x[i] = complex(altsgn*(1+float64(i)/float64(n-1)), 0)
+		altsgn *= -1
+	}
+	kase = 1
+	isave[0] = 5
+	return est, kase
+}
+
+// zlacn2Sign replaces each element of x by its complex sign x[i]/|x[i]|, or
+// by 1 if |x[i]| is not greater than the safe minimum.
+func zlacn2Sign(x []complex128) {
+	for i, xi := range x {
+		absxi := cmplx.Abs(xi)
+		if absxi > dlamchS {
+			x[i] = xi / complex(absxi, 0)
+		} else {
+			x[i] = 1
+		}
+	}
+}
+
+// dzsum1 returns the sum of the absolute values of the elements of x. Unlike
+// Dzasum, it uses the true absolute value |x[i]| and not |Re(x[i])|+|Im(x[i])|.
+func dzsum1(x []complex128) float64 {
+	var sum float64
+	for _, xi := range x {
+		sum += cmplx.Abs(xi)
+	}
+	return sum
+}
+
+// izmax1 returns the index of the first element of x with the largest
+// absolute value. Unlike Izamax, it uses the true absolute value |x[i]| and
+// not |Re(x[i])|+|Im(x[i])|.
+func izmax1(x []complex128) int {
+	var imax int
+	var dmax float64
+	for i, xi := range x {
+		if absxi := cmplx.Abs(xi); absxi > dmax {
+			imax = i
+			dmax = absxi
+		}
+	}
+	return imax
+}
@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlantr returns the value of the specified norm of an m×n trapezoidal
+// complex matrix A:
+//
+//	lapack.MaxAbs:       the maximum absolute value of any element.
+//	lapack.MaxColumnSum: the maximum column sum of the absolute values of the elements (1-norm).
+//	lapack.MaxRowSum:    the maximum row sum of the absolute values of the elements (infinity-norm).
+//	lapack.Frobenius:    the square root of the sum of the squares of the elements (Frobenius norm).
+//
+// A is upper trapezoidal if uplo == blas.Upper and lower trapezoidal if
+// uplo == blas.Lower. If diag == blas.Unit, the diagonal elements of A are
+// assumed to be 1 and are not referenced.
+//
+// If norm == lapack.MaxColumnSum, work must have length at least n, otherwise
+// work is unused.
+func (impl Implementation) Zlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(badDiag)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	minmn := min(m, n)
+	if minmn == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(shortWork)
+	}
+
+	// The columns of the off-diagonal part of row i of A are [jlo, jhi).
+	unit := diag == blas.Unit
+	offDiag := func(i int) (jlo, jhi int) {
+		if uplo == blas.Upper {
+			return min(i+1, n), n
+		}
+		return 0, min(i, n)
+	}
+
+	switch norm {
+	case lapack.MaxAbs:
+		var value float64
+		if unit {
+			value = 1
+		}
+		for i := 0; i < m; i++ {
+			jlo, jhi := offDiag(i)
+			if !unit && i < minmn {
+				if uplo == blas.Upper {
+					jlo = i
+				} else {
+					jhi = i + 1
+				}
+			}
+			for j := jlo; j < jhi; j++ {
+				v := cmplx.Abs(a[i*lda+j])
+				if math.IsNaN(v) {
+					return v
+				}
+				value = math.Max(value, v)
+			}
+		}
+		return value
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			work[j] = 0
+		}
+		for i := 0; i < m; i++ {
+			if i < minmn {
+				if unit {
+					work[i] += 1
+				} else {
+					work[i] += cmplx.Abs(a[i*lda+i])
+				}
+			}
+			jlo, jhi := offDiag(i)
+			for j := jlo; j < jhi; j++ {
+				work[j] += cmplx.Abs(a[i*lda+j])
+			}
+		}
+		var value float64
+		for _, v := range work[:n] {
+			if math.IsNaN(v) {
+				return v
+			}
+			value = math.Max(value, v)
+		}
+		return value
+	case lapack.MaxRowSum:
+		var value float64
+		for i := 0; i < m; i++ {
+			var sum float64
+			if i < minmn {
+				if unit {
+					sum = 1
+				} else {
+					sum = cmplx.Abs(a[i*lda+i])
+				}
+			}
+			jlo, jhi := offDiag(i)
+			for j := jlo; j < jhi; j++ {
+				sum += cmplx.Abs(a[i*lda+j])
+			}
+			if math.IsNaN(sum) {
+				return sum
+			}
+			value = math.Max(value, sum)
+		}
+		return value
+	default:
+		// lapack.Frobenius
+		scale := 0.0
+		sum := 1.0
+		if unit {
+			scale = 1
+			sum = float64(minmn)
+		}
+		var x [2]float64
+		for i := 0; i < m; i++ {
+			jlo, jhi := offDiag(i)
+			if !unit && i < minmn {
+				if uplo == blas.Upper {
+					jlo = i
+				} else {
+					jhi = i + 1
+				}
+			}
+			for j := jlo; j < jhi; j++ {
+				aij := a[i*lda+j]
+				x[0], x[1] = real(aij), imag(aij)
+				scale, sum = impl.Dlassq(2, x[:], 1, scale, sum)
+			}
+		}
+		return scale * math.Sqrt(sum)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlarf applies a complex elementary reflector H to an m×n complex matrix C:
+//
+//	C = H * C  if side == blas.Left
+//	C = C * H  if side == blas.Right
+//
+// H is represented in the form
+//
+//	H = I - tau * v * vᴴ
+//
+// where tau is a complex scalar and v is a complex vector. To apply Hᴴ, supply
+// the conjugate of tau instead of tau.
+//
+// work must have length at least n if side == blas.Left and
+// at least m if side == blas.Right.
+//
+// Zlarf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlarf(side blas.Side, m, n int, v []complex128, incv int, tau complex128, c []complex128, ldc int, work []complex128) {
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(badSide)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case incv == 0:
+		panic(zeroIncV)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	applyleft := side == blas.Left
+	lenV := n
+	if applyleft {
+		lenV = m
+	}
+
+	switch {
+	case len(v) < 1+(lenV-1)*abs(incv):
+		panic(shortV)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case (applyleft && len(work) < n) || (!applyleft && len(work) < m):
+		panic(shortWork)
+	}
+
+	lastv := -1 // last non-zero element of v
+	lastc := -1 // last non-zero row/column of C
+	if tau != 0 {
+		if applyleft {
+			lastv = m - 1
+		} else {
+			lastv = n - 1
+		}
+		var i int
+		if incv > 0 {
+			i = lastv * incv
+		}
+		// Look for the last non-zero row in v.
+		for lastv >= 0 && v[i] == 0 {
+			lastv--
+			i -= incv
+		}
+		if applyleft {
+			// Scan for the last non-zero column in C[0:lastv, :]
+			lastc = impl.Ilazlc(lastv+1, n, c, ldc)
+		} else {
+			// Scan for the last non-zero row in C[:, 0:lastv]
+			lastc = impl.Ilazlr(m, lastv+1, c, ldc)
+		}
+	}
+	if lastv == -1 || lastc == -1 {
+		return
+	}
+	bi := cblas128.Implementation()
+	if applyleft {
+		// Form H * C
+		// w[0:lastc+1] = c[0:lastv+1, 0:lastc+1]ᴴ * v[0:lastv+1]
+		bi.Zgemv(blas.ConjTrans, lastv+1, lastc+1, 1, c, ldc, v, incv, 0, work, 1)
+		// c[0:lastv+1, 0:lastc+1] -= tau * v[0:lastv+1] * w[0:lastc+1]ᴴ
+		bi.Zgerc(lastv+1, lastc+1, -tau, v, incv, work, 1, c, ldc)
+	} else {
+		// Form C * H
+		// w[0:lastc+1] = c[0:lastc+1, 0:lastv+1] * v[0:lastv+1]
+		bi.Zgemv(blas.NoTrans, lastc+1, lastv+1, 1, c, ldc, v, incv, 0, work, 1)
+		// c[0:lastc+1, 0:lastv+1] -= tau * w[0:lastc+1] * v[0:lastv+1]ᴴ
+		bi.Zgerc(lastc+1, lastv+1, -tau, work, 1, v, incv, c, ldc)
+	}
+}
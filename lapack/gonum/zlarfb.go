@@ -0,0 +1,453 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlarfb applies a complex block reflector to a complex matrix.
+//
+// In the call to Zlarfb, the m×n c is multiplied by the implicitly defined matrix h as follows:
+//
+//	c = h * c   if side == Left and trans == NoTrans
+//	c = c * h   if side == Right and trans == NoTrans
+//	c = hᴴ * c  if side == Left and trans == ConjTrans
+//	c = c * hᴴ  if side == Right and trans == ConjTrans
+//
+// h is a product of elementary reflectors. direct sets the direction of multiplication
+//
+//	h = h_1 * h_2 * ... * h_k    if direct == Forward
+//	h = h_k * h_k-1 * ... * h_1  if direct == Backward
+//
+// The combination of direct and store defines the orientation of the elementary
+// reflectors. In all cases the ones on the diagonal are implicitly represented.
+//
+// If direct == lapack.Forward and store == lapack.ColumnWise
+//
+//	V = [ 1        ]
+//	    [v1   1    ]
+//	    [v1  v2   1]
+//	    [v1  v2  v3]
+//	    [v1  v2  v3]
+//
+// If direct == lapack.Forward and store == lapack.RowWise
+//
+//	V = [ 1  v1  v1  v1  v1]
+//	    [     1  v2  v2  v2]
+//	    [         1  v3  v3]
+//
+// If direct == lapack.Backward and store == lapack.ColumnWise
+//
+//	V = [v1  v2  v3]
+//	    [v1  v2  v3]
+//	    [ 1  v2  v3]
+//	    [     1  v3]
+//	    [         1]
+//
+// If direct == lapack.Backward and store == lapack.RowWise
+//
+//	V = [v1  v1   1        ]
+//	    [v2  v2  v2   1    ]
+//	    [v3  v3  v3  v3   1]
+//
+// An elementary reflector can be explicitly constructed by extracting the
+// corresponding elements of v, placing a 1 where the diagonal would be, and
+// placing zeros in the remaining elements.
+//
+// t is a k×k matrix containing the block reflector, and this function will panic
+// if t is not of sufficient size. See Zlarft for more information.
+//
+// work is a temporary storage matrix with stride ldwork.
+// work must be of size at least n×k side == Left and m×k if side == Right, and
+// this function will panic if this size is not met.
+//
+// Zlarfb is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlarfb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []complex128, ldv int, t []complex128, ldt int, c []complex128, ldc int, work []complex128, ldwork int) {
+	nv := m
+	if side == blas.Right {
+		nv = n
+	}
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.ConjTrans && trans != blas.NoTrans:
+		panic(badTrans)
+	case direct != lapack.Forward && direct != lapack.Backward:
+		panic(badDirect)
+	case store != lapack.ColumnWise && store != lapack.RowWise:
+		panic(badStoreV)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case store == lapack.ColumnWise && ldv < max(1, k):
+		panic(badLdV)
+	case store == lapack.RowWise && ldv < max(1, nv):
+		panic(badLdV)
+	case ldt < max(1, k):
+		panic(badLdT)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case ldwork < max(1, k):
+		panic(badLdWork)
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	nw := n
+	if side == blas.Right {
+		nw = m
+	}
+	switch {
+	case store == lapack.ColumnWise && len(v) < (nv-1)*ldv+k:
+		panic(shortV)
+	case store == lapack.RowWise && len(v) < (k-1)*ldv+nv:
+		panic(shortV)
+	case len(t) < (k-1)*ldt+k:
+		panic(shortT)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < (nw-1)*ldwork+k:
+		panic(shortWork)
+	}
+
+	bi := cblas128.Implementation()
+
+	transt := blas.ConjTrans
+	if trans == blas.ConjTrans {
+		transt = blas.NoTrans
+	}
+	if store == lapack.ColumnWise {
+		if direct == lapack.Forward {
+			// V1 is the first k rows of C. V2 is the remaining rows.
+			if side == blas.Left {
+				// W = Cᴴ V = C1ᴴ V1 + C2ᴴ V2 (stored in work).
+
+				// W = C1.
+				for j := 0; j < k; j++ {
+					bi.Zcopy(n, c[j*ldc:], 1, work[j:], ldwork)
+					impl.Zlacgv(n, work[j:], ldwork)
+				}
+				// W = W * V1.
+				bi.Ztrmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit,
+					n, k, 1,
+					v, ldv,
+					work, ldwork)
+				if m > k {
+					// W = W + C2ᴴ V2.
+					bi.Zgemm(blas.ConjTrans, blas.NoTrans, n, k, m-k,
+						1, c[k*ldc:], ldc, v[k*ldv:], ldv,
+						1, work, ldwork)
+				}
+				// W = W * Tᴴ or W * T.
+				bi.Ztrmm(blas.Right, blas.Upper, transt, blas.NonUnit, n, k,
+					1, t, ldt,
+					work, ldwork)
+				// C -= V * Wᴴ.
+				if m > k {
+					// C2 -= V2 * Wᴴ.
+					bi.Zgemm(blas.NoTrans, blas.ConjTrans, m-k, n, k,
+						-1, v[k*ldv:], ldv, work, ldwork,
+						1, c[k*ldc:], ldc)
+				}
+				// W *= V1ᴴ.
+				bi.Ztrmm(blas.Right, blas.Lower, blas.ConjTrans, blas.Unit, n, k,
+					1, v, ldv,
+					work, ldwork)
+				// C1 -= Wᴴ.
+				for i := 0; i < n; i++ {
+					for j := 0; j < k; j++ {
+						c[j*ldc+i] -= cmplx.Conj(work[i*ldwork+j])
+					}
+				}
+				return
+			}
+			// Form C = C * H or C * Hᴴ, where C = (C1 C2).
+
+			// W = C1.
+			for i := 0; i < k; i++ {
+				bi.Zcopy(m, c[i:], ldc, work[i:], ldwork)
+			}
+			// W *= V1.
+			bi.Ztrmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, m, k,
+				1, v, ldv,
+				work, ldwork)
+			if n > k {
+				bi.Zgemm(blas.NoTrans, blas.NoTrans, m, k, n-k,
+					1, c[k:], ldc, v[k*ldv:], ldv,
+					1, work, ldwork)
+			}
+			// W *= T or Tᴴ.
+			bi.Ztrmm(blas.Right, blas.Upper, trans, blas.NonUnit, m, k,
+				1, t, ldt,
+				work, ldwork)
+			if n > k {
+				bi.Zgemm(blas.NoTrans, blas.ConjTrans, m, n-k, k,
+					-1, work, ldwork, v[k*ldv:], ldv,
+					1, c[k:], ldc)
+			}
+			// C -= W * Vᴴ.
+			bi.Ztrmm(blas.Right, blas.Lower, blas.ConjTrans, blas.Unit, m, k,
+				1, v, ldv,
+				work, ldwork)
+			// C -= W.
+			for i := 0; i < m; i++ {
+				for j := 0; j < k; j++ {
+					c[i*ldc+j] -= work[i*ldwork+j]
+				}
+			}
+			return
+		}
+		// V = (V1)
+		//   = (V2) (last k rows)
+		// Where V2 is unit upper triangular.
+		if side == blas.Left {
+			// Form H * C or
+			// W = Cᴴ V.
+
+			// W = C2ᴴ.
+			for j := 0; j < k; j++ {
+				bi.Zcopy(n, c[(m-k+j)*ldc:], 1, work[j:], ldwork)
+				impl.Zlacgv(n, work[j:], ldwork)
+			}
+			// W *= V2.
+			bi.Ztrmm(blas.Right, blas.Upper, blas.NoTrans, blas.Unit, n, k,
+				1, v[(m-k)*ldv:], ldv,
+				work, ldwork)
+			if m > k {
+				// W += C1ᴴ * V1.
+				bi.Zgemm(blas.ConjTrans, blas.NoTrans, n, k, m-k,
+					1, c, ldc, v, ldv,
+					1, work, ldwork)
+			}
+			// W *= T or Tᴴ.
+			bi.Ztrmm(blas.Right, blas.Lower, transt, blas.NonUnit, n, k,
+				1, t, ldt,
+				work, ldwork)
+			// C -= V * Wᴴ.
+			if m > k {
+				bi.Zgemm(blas.NoTrans, blas.ConjTrans, m-k, n, k,
+					-1, v, ldv, work, ldwork,
+					1, c, ldc)
+			}
+			// W *= V2ᴴ.
+			bi.Ztrmm(blas.Right, blas.Upper, blas.ConjTrans, blas.Unit, n, k,
+				1, v[(m-k)*ldv:], ldv,
+				work, ldwork)
+			// C2 -= Wᴴ.
+			for i := 0; i < n; i++ {
+				for j := 0; j < k; j++ {
+					c[(m-k+j)*ldc+i] -= cmplx.Conj(work[i*ldwork+j])
+				}
+			}
+			return
+		}
+		// Form C * H or C * Hᴴ where C = (C1 C2).
+		// W = C * V.
+
+		// W = C2.
+		for j := 0; j < k; j++ {
+			bi.Zcopy(m, c[n-k+j:], ldc, work[j:], ldwork)
+		}
+
+		// W = W * V2.
+		bi.Ztrmm(blas.Right, blas.Upper, blas.NoTrans, blas.Unit, m, k,
+			1, v[(n-k)*ldv:], ldv,
+			work, ldwork)
+		if n > k {
+			bi.Zgemm(blas.NoTrans, blas.NoTrans, m, k, n-k,
+				1, c, ldc, v, ldv,
+				1, work, ldwork)
+		}
+		// W *= T or Tᴴ.
+		bi.Ztrmm(blas.Right, blas.Lower, trans, blas.NonUnit, m, k,
+			1, t, ldt,
+			work, ldwork)
+		// C -= W * Vᴴ.
+		if n > k {
+			// C1 -= W * V1ᴴ.
+			bi.Zgemm(blas.NoTrans, blas.ConjTrans, m, n-k, k,
+				-1, work, ldwork, v, ldv,
+				1, c, ldc)
+		}
+		// W *= V2ᴴ.
+		bi.Ztrmm(blas.Right, blas.Upper, blas.ConjTrans, blas.Unit, m, k,
+			1, v[(n-k)*ldv:], ldv,
+			work, ldwork)
+		// C2 -= W.
+		for i := 0; i < m; i++ {
+			for j := 0; j < k; j++ {
+				c[i*ldc+n-k+j] -= work[i*ldwork+j]
+			}
+		}
+		return
+	}
+	// Store = Rowwise.
+	if direct == lapack.Forward {
+		// V = (V1 V2) where v1 is unit upper triangular.
+		if side == blas.Left {
+			// Form H * C or Hᴴ * C where C = (C1; C2).
+			// W = Cᴴ * Vᴴ.
+
+			// W = C1ᴴ.
+			for j := 0; j < k; j++ {
+				bi.Zcopy(n, c[j*ldc:], 1, work[j:], ldwork)
+				impl.Zlacgv(n, work[j:], ldwork)
+			}
+			// W *= V1ᴴ.
+			bi.Ztrmm(blas.Right, blas.Upper, blas.ConjTrans, blas.Unit, n, k,
+				1, v, ldv,
+				work, ldwork)
+			if m > k {
+				bi.Zgemm(blas.ConjTrans, blas.ConjTrans, n, k, m-k,
+					1, c[k*ldc:], ldc, v[k:], ldv,
+					1, work, ldwork)
+			}
+			// W *= T or Tᴴ.
+			bi.Ztrmm(blas.Right, blas.Upper, transt, blas.NonUnit, n, k,
+				1, t, ldt,
+				work, ldwork)
+			// C -= Vᴴ * Wᴴ.
+			if m > k {
+				bi.Zgemm(blas.ConjTrans, blas.ConjTrans, m-k, n, k,
+					-1, v[k:], ldv, work, ldwork,
+					1, c[k*ldc:], ldc)
+			}
+			// W *= V1.
+			bi.Ztrmm(blas.Right, blas.Upper, blas.NoTrans, blas.Unit, n, k,
+				1, v, ldv,
+				work, ldwork)
+			// C1 -= Wᴴ.
+			for i := 0; i < n; i++ {
+				for j := 0; j < k; j++ {
+					c[j*ldc+i] -= cmplx.Conj(work[i*ldwork+j])
+				}
+			}
+			return
+		}
+		// Form C * H or C * Hᴴ where C = (C1 C2).
+		// W = C * Vᴴ.
+
+		// W = C1.
+		for j := 0; j < k; j++ {
+			bi.Zcopy(m, c[j:], ldc, work[j:], ldwork)
+		}
+		// W *= V1ᴴ.
+		bi.Ztrmm(blas.Right, blas.Upper, blas.ConjTrans, blas.Unit, m, k,
+			1, v, ldv,
+			work, ldwork)
+		if n > k {
+			bi.Zgemm(blas.NoTrans, blas.ConjTrans, m, k, n-k,
+				1, c[k:], ldc, v[k:], ldv,
+				1, work, ldwork)
+		}
+		// W *= T or Tᴴ.
+		bi.Ztrmm(blas.Right, blas.Upper, trans, blas.NonUnit, m, k,
+			1, t, ldt,
+			work, ldwork)
+		// C -= W * V.
+		if n > k {
+			bi.Zgemm(blas.NoTrans, blas.NoTrans, m, n-k, k,
+				-1, work, ldwork, v[k:], ldv,
+				1, c[k:], ldc)
+		}
+		// W *= V1.
+		bi.Ztrmm(blas.Right, blas.Upper, blas.NoTrans, blas.Unit, m, k,
+			1, v, ldv,
+			work, ldwork)
+		// C1 -= W.
+		for i := 0; i < m; i++ {
+			for j := 0; j < k; j++ {
+				c[i*ldc+j] -= work[i*ldwork+j]
+			}
+		}
+		return
+	}
+	// V = (V1 V2) where V2 is the last k columns and is lower unit triangular.
+	if side == blas.Left {
+		// Form H * C or Hᴴ C where C = (C1 ; C2).
+		// W = Cᴴ * Vᴴ.
+
+		// W = C2ᴴ.
+		for j := 0; j < k; j++ {
+			bi.Zcopy(n, c[(m-k+j)*ldc:], 1, work[j:], ldwork)
+			impl.Zlacgv(n, work[j:], ldwork)
+		}
+		// W *= V2ᴴ.
+		bi.Ztrmm(blas.Right, blas.Lower, blas.ConjTrans, blas.Unit, n, k,
+			1, v[m-k:], ldv,
+			work, ldwork)
+		if m > k {
+			bi.Zgemm(blas.ConjTrans, blas.ConjTrans, n, k, m-k,
+				1, c, ldc, v, ldv,
+				1, work, ldwork)
+		}
+		// W *= T or Tᴴ.
+		bi.Ztrmm(blas.Right, blas.Lower, transt, blas.NonUnit, n, k,
+			1, t, ldt,
+			work, ldwork)
+		// C -= Vᴴ * Wᴴ.
+		if m > k {
+			bi.Zgemm(blas.ConjTrans, blas.ConjTrans, m-k, n, k,
+				-1, v, ldv, work, ldwork,
+				1, c, ldc)
+		}
+		// W *= V2.
+		bi.Ztrmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, n, k,
+			1, v[m-k:], ldv,
+			work, ldwork)
+		// C2 -= Wᴴ.
+		for i := 0; i < n; i++ {
+			for j := 0; j < k; j++ {
+				c[(m-k+j)*ldc+i] -= cmplx.Conj(work[i*ldwork+j])
+			}
+		}
+		return
+	}
+	// Form C * H or C * Hᴴ where C = (C1 C2).
+	// W = C * Vᴴ.
+	// W = C2.
+	for j := 0; j < k; j++ {
+		bi.Zcopy(m, c[n-k+j:], ldc, work[j:], ldwork)
+	}
+	// W *= V2ᴴ.
+	bi.Ztrmm(blas.Right, blas.Lower, blas.ConjTrans, blas.Unit, m, k,
+		1, v[n-k:], ldv,
+		work, ldwork)
+	if n > k {
+		bi.Zgemm(blas.NoTrans, blas.ConjTrans, m, k, n-k,
+			1, c, ldc, v, ldv,
+			1, work, ldwork)
+	}
+	// W *= T or Tᴴ.
+	bi.Ztrmm(blas.Right, blas.Lower, trans, blas.NonUnit, m, k,
+		1, t, ldt,
+		work, ldwork)
+	// C -= W * V.
+	if n > k {
+		bi.Zgemm(blas.NoTrans, blas.NoTrans, m, n-k, k,
+			-1, work, ldwork, v, ldv,
+			1, c, ldc)
+	}
+	// W *= V2.
+	bi.Ztrmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, m, k,
+		1, v[n-k:], ldv,
+		work, ldwork)
+	// C1 -= W.
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			c[i*ldc+n-k+j] -= work[i*ldwork+j]
+		}
+	}
+}
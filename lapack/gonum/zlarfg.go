@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlarfg generates a complex elementary reflector H of order n such that
+//
+//	Hᴴ * (alpha) = (beta)
+//	     (    x)   (   0)
+//	Hᴴ * H = I
+//
+// where alpha and beta are scalars, with beta real, and x is an (n-1)-element
+// complex vector. H is represented in the form
+//
+//	H = I - tau * (1; v) * (1 vᴴ)
+//
+// where tau is a complex scalar and v is a complex (n-1)-vector. Note that H is
+// not Hermitian.
+//
+// If the elements of x are all zero and alpha is real, then tau = 0 and H is
+// taken to be the identity matrix. Otherwise 1 ≤ real(tau) ≤ 2 and
+// |tau-1| ≤ 1.
+//
+// On entry, x contains the vector x, on exit it contains v. The returned beta
+// has zero imaginary part.
+//
+// Zlarfg is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlarfg(n int, alpha complex128, x []complex128, incX int) (beta, tau complex128) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX <= 0:
+		panic(badIncX)
+	}
+
+	if n == 0 {
+		return alpha, 0
+	}
+
+	if len(x) < 1+(n-2)*incX {
+		panic(shortX)
+	}
+
+	bi := cblas128.Implementation()
+
+	var xnorm float64
+	if n > 1 {
+		xnorm = bi.Dznrm2(n-1, x, incX)
+	}
+	alphr := real(alpha)
+	alphi := imag(alpha)
+	if xnorm == 0 && alphi == 0 {
+		return alpha, 0
+	}
+	b := -math.Copysign(dlapy3(alphr, alphi, xnorm), alphr)
+	safmin := dlamchS / dlamchE
+	knt := 0
+	if math.Abs(b) < safmin {
+		// xnorm and beta may be inaccurate, scale x and recompute.
+		rsafmn := 1 / safmin
+		for {
+			knt++
+			if n > 1 {
+				bi.Zdscal(n-1, rsafmn, x, incX)
+			}
+			b *= rsafmn
+			alphr *= rsafmn
+			alphi *= rsafmn
+			if math.Abs(b) >= safmin || knt >= 20 {
+				break
+			}
+		}
+		if n > 1 {
+			xnorm = bi.Dznrm2(n-1, x, incX)
+		}
+		alpha = complex(alphr, alphi)
+		b = -math.Copysign(dlapy3(alphr, alphi, xnorm), alphr)
+	}
+	tau = complex((b-alphr)/b, -alphi/b)
+	if n > 1 {
+		bi.Zscal(n-1, 1/(alpha-complex(b, 0)), x, incX)
+	}
+	for j := 0; j < knt; j++ {
+		b *= safmin
+	}
+	return complex(b, 0), tau
+}
@@ -0,0 +1,478 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlatrs solves a triangular system of equations scaled to prevent overflow. It
+// solves
+//
+//	A * x = scale * b  if trans == blas.NoTrans
+//	Aᵀ * x = scale * b if trans == blas.Trans
+//	Aᴴ * x = scale * b if trans == blas.ConjTrans
+//
+// where the scale s is set for numeric stability.
+//
+// A is an n×n complex triangular matrix. On entry, the slice x contains the
+// values of b, and on exit it contains the solution vector x.
+//
+// If normin == true, cnorm is an input and cnorm[j] contains the norm of the
+// off-diagonal part of the j^th column of A. If trans == blas.NoTrans, cnorm[j]
+// must be greater than or equal to the infinity norm, and greater than or equal
+// to the one-norm otherwise. If normin == false, then cnorm is treated as an
+// output, and is set to contain the 1-norm of the off-diagonal part of the j^th
+// column of A, where the absolute value of an element z is |Re(z)|+|Im(z)|.
+//
+// Zlatrs is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlatrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, normin bool, n int, a []complex128, lda int, x []complex128, cnorm []float64) (scale float64) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(badDiag)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(x) < n:
+		panic(shortX)
+	case len(cnorm) < n:
+		panic(shortCNorm)
+	}
+
+	upper := uplo == blas.Upper
+	nonUnit := diag == blas.NonUnit
+
+	smlnum := dlamchS / dlamchP
+	bignum := 1 / smlnum
+	scale = 1
+
+	bi := cblas128.Implementation()
+
+	if !normin {
+		if upper {
+			cnorm[0] = 0
+			for j := 1; j < n; j++ {
+				cnorm[j] = bi.Dzasum(j, a[j:], lda)
+			}
+		} else {
+			for j := 0; j < n-1; j++ {
+				cnorm[j] = bi.Dzasum(n-j-1, a[(j+1)*lda+j:], lda)
+			}
+			cnorm[n-1] = 0
+		}
+	}
+	// Scale the column norms by tscal if the maximum element in cnorm is
+	// greater than bignum/2.
+	imax := blas64.Implementation().Idamax(n, cnorm, 1)
+	var tscal float64
+	if cnorm[imax] <= bignum/2 {
+		tscal = 1
+	} else {
+		tmax := cnorm[imax]
+		// Avoid NaN generation if entries in cnorm exceed the overflow
+		// threshold.
+		if tmax <= math.MaxFloat64 {
+			// Case 1: All entries in cnorm are valid floating-point numbers.
+			tscal = 0.5 / (smlnum * tmax)
+			blas64.Implementation().Dscal(n, tscal, cnorm, 1)
+		} else {
+			// Case 2: At least one column norm of A cannot be represented as
+			// floating-point number. Find the off-diagonal entry A[i,j] with
+			// the largest absolute value of its real and imaginary parts. If
+			// this entry is not +/- Infinity, use this value as tscal.
+			tmax = 0
+			for j := 0; j < n; j++ {
+				ilo, ihi := j+1, n
+				if upper {
+					ilo, ihi = 0, j
+				}
+				for i := ilo; i < ihi; i++ {
+					aij := a[i*lda+j]
+					tmax = math.Max(tmax, math.Max(math.Abs(real(aij)), math.Abs(imag(aij))))
+				}
+			}
+			if tmax <= math.MaxFloat64 {
+				tscal = 1 / (smlnum * tmax)
+				for j := 0; j < n; j++ {
+					if cnorm[j] <= math.MaxFloat64 {
+						cnorm[j] *= tscal
+					} else {
+						// Recompute the 1-norm without introducing Infinity
+						// in the summation.
+						cnorm[j] = 0
+						ilo, ihi := j+1, n
+						if upper {
+							ilo, ihi = 0, j
+						}
+						for i := ilo; i < ihi; i++ {
+							aij := a[i*lda+j]
+							cnorm[j] += 2 * tscal * (math.Abs(real(aij)/2) + math.Abs(imag(aij)/2))
+						}
+					}
+				}
+			} else {
+				// At least one entry of A is not a valid floating-point
+				// entry. Rely on Ztrsv to propagate Inf and NaN.
+				bi.Ztrsv(uplo, trans, diag, n, a, lda, x, 1)
+				return scale
+			}
+		}
+	}
+
+	// Compute a bound on the computed solution vector to see if bi.Ztrsv can
+	// be used.
+	var xmax float64
+	for _, xj := range x[:n] {
+		xmax = math.Max(xmax, cabs2(xj))
+	}
+	xbnd := xmax
+	var grow float64
+	var jfirst, jlast, jinc int
+	if trans == blas.NoTrans {
+		if upper {
+			jfirst = n - 1
+			jlast = -1
+			jinc = -1
+		} else {
+			jfirst = 0
+			jlast = n
+			jinc = 1
+		}
+		// Compute the growth in A * x = b.
+		if tscal != 1 {
+			grow = 0
+			goto Solve
+		}
+		if nonUnit {
+			grow = 0.5 / math.Max(xbnd, smlnum)
+			xbnd = grow
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				tjj := cabs1(a[j*lda+j])
+				if tjj >= smlnum {
+					xbnd = math.Min(xbnd, math.Min(1, tjj)*grow)
+				} else {
+					xbnd = 0
+				}
+				if tjj+cnorm[j] >= smlnum {
+					grow *= tjj / (tjj + cnorm[j])
+				} else {
+					grow = 0
+				}
+			}
+			grow = xbnd
+		} else {
+			grow = math.Min(1, 0.5/math.Max(xbnd, smlnum))
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				grow *= 1 / (1 + cnorm[j])
+			}
+		}
+	} else {
+		if upper {
+			jfirst = 0
+			jlast = n
+			jinc = 1
+		} else {
+			jfirst = n - 1
+			jlast = -1
+			jinc = -1
+		}
+		// Compute the growth in Aᵀ * x = b or Aᴴ * x = b.
+		if tscal != 1 {
+			grow = 0
+			goto Solve
+		}
+		if nonUnit {
+			grow = 0.5 / math.Max(xbnd, smlnum)
+			xbnd = grow
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				xj := 1 + cnorm[j]
+				grow = math.Min(grow, xbnd/xj)
+				tjj := cabs1(a[j*lda+j])
+				if tjj >= smlnum {
+					if xj > tjj {
+						xbnd *= tjj / xj
+					}
+				} else {
+					xbnd = 0
+				}
+			}
+			grow = math.Min(grow, xbnd)
+		} else {
+			grow = math.Min(1, 0.5/math.Max(xbnd, smlnum))
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				grow /= 1 + cnorm[j]
+			}
+		}
+	}
+
+Solve:
+	if grow*tscal > smlnum {
+		// Use the Level 2 BLAS solve if the reciprocal of the bound on
+		// elements of X is not too small.
+		bi.Ztrsv(uplo, trans, diag, n, a, lda, x, 1)
+		if tscal != 1 {
+			blas64.Implementation().Dscal(n, 1/tscal, cnorm, 1)
+		}
+		return scale
+	}
+
+	// Use a Level 1 BLAS solve, scaling intermediate results.
+	if xmax > bignum/2 {
+		// Scale x so that its components are less than or equal to bignum
+		// in absolute value.
+		scale = (bignum / 2) / xmax
+		bi.Zdscal(n, scale, x, 1)
+		xmax = bignum
+	} else {
+		xmax *= 2
+	}
+
+	if trans == blas.NoTrans {
+		// Solve A * x = b.
+		for j := jfirst; j != jlast; j += jinc {
+			// Compute x[j] = b[j] / A[j,j], scaling x if necessary.
+			xj := cabs1(x[j])
+			var tjjs complex128
+			if nonUnit {
+				tjjs = a[j*lda+j] * complex(tscal, 0)
+			} else {
+				tjjs = complex(tscal, 0)
+				if tscal == 1 {
+					goto Skip1
+				}
+			}
+			if tjj := cabs1(tjjs); tjj > smlnum {
+				// abs(A[j,j]) > smlnum.
+				if tjj < 1 && xj > tjj*bignum {
+					// Scale x by 1/b[j].
+					rec := 1 / xj
+					bi.Zdscal(n, rec, x, 1)
+					scale *= rec
+					xmax *= rec
+				}
+				x[j] /= tjjs
+				xj = cabs1(x[j])
+			} else if tjj > 0 {
+				// 0 < abs(A[j,j]) <= smlnum.
+				if xj > tjj*bignum {
+					// Scale x by (1/abs(x[j]))*abs(A[j,j])*bignum to avoid
+					// overflow when dividing by A[j,j].
+					rec := (tjj * bignum) / xj
+					if cnorm[j] > 1 {
+						// Scale by 1/cnorm[j] to avoid overflow when
+						// multiplying x[j] times column j.
+						rec /= cnorm[j]
+					}
+					bi.Zdscal(n, rec, x, 1)
+					scale *= rec
+					xmax *= rec
+				}
+				x[j] /= tjjs
+				xj = cabs1(x[j])
+			} else {
+				// A[j,j] = 0: Set x[0:n] = 0, x[j] = 1, and scale = 0, and
+				// compute a solution to A*x = 0.
+				for i := range x[:n] {
+					x[i] = 0
+				}
+				x[j] = 1
+				xj = 1
+				scale = 0
+				xmax = 0
+			}
+		Skip1:
+			// Scale x if necessary to avoid overflow when adding a multiple
+			// of column j of A.
+			if xj > 1 {
+				rec := 1 / xj
+				if cnorm[j] > (bignum-xmax)*rec {
+					// Scale x by 1/(2*abs(x[j])).
+					rec *= 0.5
+					bi.Zdscal(n, rec, x, 1)
+					scale *= rec
+				}
+			} else if xj*cnorm[j] > bignum-xmax {
+				// Scale x by 1/2.
+				bi.Zdscal(n, 0.5, x, 1)
+				scale *= 0.5
+			}
+			if upper {
+				if j > 0 {
+					// Compute the update
+					//  x[0:j] -= x[j] * A[0:j,j]
+					bi.Zaxpy(j, -x[j]*complex(tscal, 0), a[j:], lda, x, 1)
+					i := bi.Izamax(j, x, 1)
+					xmax = cabs1(x[i])
+				}
+			} else {
+				if j < n-1 {
+					// Compute the update
+					//  x[j+1:n] -= x[j] * A[j+1:n,j]
+					bi.Zaxpy(n-j-1, -x[j]*complex(tscal, 0), a[(j+1)*lda+j:], lda, x[j+1:], 1)
+					i := j + 1 + bi.Izamax(n-j-1, x[j+1:], 1)
+					xmax = cabs1(x[i])
+				}
+			}
+		}
+	} else {
+		// Solve Aᵀ * x = b or Aᴴ * x = b.
+		conj := trans == blas.ConjTrans
+		for j := jfirst; j != jlast; j += jinc {
+			// Compute x[j] = b[j] - sum A[k,j]*x[k] or
+			// x[j] = b[j] - sum conj(A[k,j])*x[k], k ≠ j.
+			xj := cabs1(x[j])
+			uscal := complex(tscal, 0)
+			rec := 1 / math.Max(xmax, 1)
+			var tjjs complex128
+			if cnorm[j] > (bignum-xj)*rec {
+				// If x[j] could overflow, scale x by 1/(2*xmax).
+				rec *= 0.5
+				if nonUnit {
+					tjjs = a[j*lda+j] * complex(tscal, 0)
+					if conj {
+						tjjs = cmplx.Conj(tjjs)
+					}
+				} else {
+					tjjs = complex(tscal, 0)
+				}
+				if tjj := cabs1(tjjs); tjj > 1 {
+					// Divide by A[j,j] when scaling x if A[j,j] > 1.
+					rec = math.Min(1, rec*tjj)
+					uscal /= tjjs
+				}
+				if rec < 1 {
+					bi.Zdscal(n, rec, x, 1)
+					scale *= rec
+					xmax *= rec
+				}
+			}
+
+			var csumj complex128
+			if uscal == 1 {
+				// If the scaling needed for A in the dot product is 1,
+				// call Zdotu or Zdotc to perform the dot product.
+				switch {
+				case upper && conj:
+					csumj = bi.Zdotc(j, a[j:], lda, x, 1)
+				case upper:
+					csumj = bi.Zdotu(j, a[j:], lda, x, 1)
+				case j < n-1 && conj:
+					csumj = bi.Zdotc(n-j-1, a[(j+1)*lda+j:], lda, x[j+1:], 1)
+				case j < n-1:
+					csumj = bi.Zdotu(n-j-1, a[(j+1)*lda+j:], lda, x[j+1:], 1)
+				}
+			} else {
+				// Otherwise, use in-line code for the dot product.
+				ilo, ihi := j+1, n
+				if upper {
+					ilo, ihi = 0, j
+				}
+				for i := ilo; i < ihi; i++ {
+					aij := a[i*lda+j]
+					if conj {
+						aij = cmplx.Conj(aij)
+					}
+					csumj += (aij * uscal) * x[i]
+				}
+			}
+
+			if uscal == complex(tscal, 0) {
+				// Compute x[j] := (x[j] - csumj) / A[j,j] if 1/A[j,j] was
+				// not used to scale the dot product.
+				x[j] -= csumj
+				xj := cabs1(x[j])
+				if nonUnit {
+					tjjs = a[j*lda+j] * complex(tscal, 0)
+					if conj {
+						tjjs = cmplx.Conj(tjjs)
+					}
+				} else {
+					tjjs = complex(tscal, 0)
+					if tscal == 1 {
+						goto Skip2
+					}
+				}
+				if tjj := cabs1(tjjs); tjj > smlnum {
+					// abs(A[j,j]) > smlnum.
+					if tjj < 1 && xj > tjj*bignum {
+						// Scale x by 1/abs(x[j]).
+						rec = 1 / xj
+						bi.Zdscal(n, rec, x, 1)
+						scale *= rec
+						xmax *= rec
+					}
+					x[j] /= tjjs
+				} else if tjj > 0 {
+					// 0 < abs(A[j,j]) <= smlnum.
+					if xj > tjj*bignum {
+						// Scale x by (1/abs(x[j]))*abs(A[j,j])*bignum.
+						rec = (tjj * bignum) / xj
+						bi.Zdscal(n, rec, x, 1)
+						scale *= rec
+						xmax *= rec
+					}
+					x[j] /= tjjs
+				} else {
+					// A[j,j] = 0: Set x[0:n] = 0, x[j] = 1, and scale = 0,
+					// and compute a solution to Aᵀ*x = 0 or Aᴴ*x = 0.
+					for i := range x[:n] {
+						x[i] = 0
+					}
+					x[j] = 1
+					scale = 0
+					xmax = 0
+				}
+			} else {
+				// Compute x[j] := x[j] / A[j,j] - csumj if the dot product
+				// has already been divided by 1/A[j,j].
+				x[j] = x[j]/tjjs - csumj
+			}
+		Skip2:
+			xmax = math.Max(xmax, cabs1(x[j]))
+		}
+	}
+	scale /= tscal
+	if tscal != 1 {
+		blas64.Implementation().Dscal(n, 1/tscal, cnorm, 1)
+	}
+	return scale
+}
+
+// cabs2 returns |real(z)/2|+|imag(z)/2|.
+func cabs2(z complex128) float64 {
+	return math.Abs(real(z)/2) + math.Abs(imag(z)/2)
+}
@@ -0,0 +1,307 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Ztrevc computes some or all of the right and/or left eigenvectors of an n×n
+// complex upper triangular matrix T. Matrices of this type are produced by the
+// Schur factorization of a complex general matrix A
+//
+//	A = Q T Qᴴ,
+//
+// as computed by Zlahqr.
+//
+// The right eigenvector x of T corresponding to an eigenvalue λ is defined by
+//
+//	T x = λ x,
+//
+// and the left eigenvector y is defined by
+//
+//	yᴴ T = λ yᴴ.
+//
+// The eigenvalues are read directly from the diagonal of T.
+//
+// This routine returns the matrices X and/or Y of right and left eigenvectors
+// of T, or the products Q*X and/or Q*Y, where Q is an input matrix. If Q is the
+// unitary factor that reduces a matrix A to Schur form T, then Q*X and Q*Y are
+// the matrices of right and left eigenvectors of A.
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Ztrevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors will be
+// computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and multiplied from left by the matrices in VR and/or VL.
+// If howmny == lapack.EVSelected, right and/or left eigenvectors will be
+// computed as indicated by selected.
+// For other values of howmny, Ztrevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise. The
+// eigenvector corresponding to the j-th eigenvalue will be computed if
+// selected[j] is true.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or
+// lapack.EVAllMulQ, mm must be at least n. If howmny is lapack.EVSelected, mm
+// must be at least the number of selected eigenvectors. If mm is not
+// sufficiently large, Ztrevc will panic.
+//
+// On entry, if howmny is lapack.EVAllMulQ, it is assumed that VL (if side
+// is lapack.EVLeft or lapack.EVBoth) contains an n×n matrix QL, and that VR
+// (if side is lapack.EVRight or lapack.EVBoth) contains an n×n matrix QR. QL
+// and QR are typically the unitary matrix Q of Schur vectors returned by
+// Zlahqr.
+//
+// On return, VL and VR contain the requested eigenvectors, or their products
+// with QL and QR, stored in their columns in the same order as their
+// eigenvalues. Each eigenvector will be normalized so that the element of
+// largest magnitude has magnitude 1. Here the magnitude of a complex number
+// (x,y) is taken to be |x| + |y|.
+//
+// T is used as read-only.
+//
+// work must have length at least n and rwork must have length at least n,
+// otherwise Ztrevc will panic.
+//
+// Ztrevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors.
+//
+// Ztrevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Ztrevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr int, mm int, work []complex128, rwork []float64) (m int) {
+	bothv := side == lapack.EVBoth
+	rightv := side == lapack.EVRight || bothv
+	leftv := side == lapack.EVLeft || bothv
+	switch {
+	case !rightv && !leftv:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case ldt < max(1, n):
+		panic(badLdT)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1:
+		panic(badLdVL)
+	case ldvr < 1:
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(t) < (n-1)*ldt+n:
+		panic(shortT)
+	case len(work) < n:
+		panic(shortWork)
+	case len(rwork) < n:
+		panic(shortRWork)
+	}
+
+	somev := howmny == lapack.EVSelected
+	if somev {
+		if len(selected) != n {
+			panic(badLenSelected)
+		}
+		// Set m to the number of columns required to store the selected
+		// eigenvectors.
+		for _, sel := range selected {
+			if sel {
+				m++
+			}
+		}
+	} else {
+		m = n
+	}
+	if mm < m {
+		panic(badMm)
+	}
+
+	// Quick return if no eigenvectors were selected.
+	if m == 0 {
+		return 0
+	}
+
+	switch {
+	case leftv && ldvl < mm:
+		panic(badLdVL)
+	case leftv && len(vl) < (n-1)*ldvl+mm:
+		panic(shortVL)
+	case rightv && ldvr < mm:
+		panic(badLdVR)
+	case rightv && len(vr) < (n-1)*ldvr+mm:
+		panic(shortVR)
+	}
+
+	// Set the constants to control overflow.
+	ulp := dlamchP
+	smlnum := float64(n) / ulp * dlamchS
+	bignum := (1 - ulp) / smlnum
+
+	// Compute 1-norm of each column of strictly upper triangular part of T
+	// to control overflow in triangular solver.
+	norms := rwork[:n]
+	norms[0] = 0
+	for j := 1; j < n; j++ {
+		var cn float64
+		for i := 0; i < j; i++ {
+			cn += cabs1(t[i*ldt+j])
+		}
+		norms[j] = cn
+	}
+
+	bi := cblas128.Implementation()
+
+	if rightv {
+		// Compute right eigenvectors.
+		is := m - 1
+		for ki := n - 1; ki >= 0; ki-- {
+			if somev && !selected[ki] {
+				continue
+			}
+			lambda := t[ki*ldt+ki]
+			smin := math.Max(ulp*cabs1(lambda), smlnum)
+
+			// Form the right-hand side.
+			x := work[:ki+1]
+			x[ki] = 1
+			for k := 0; k < ki; k++ {
+				x[k] = -t[k*ldt+ki]
+			}
+
+			// Solve the upper triangular system
+			//  [ T[:ki,:ki] - λ*I ] * x = scale * work,
+			// with the scaling absorbed into x[ki].
+			for j := ki - 1; j >= 0; j-- {
+				tjj := t[j*ldt+j] - lambda
+				if cabs1(tjj) < smin {
+					tjj = complex(smin, 0)
+				}
+				// Scale x to avoid overflow when dividing by
+				// the diagonal element.
+				xj := cabs1(x[j])
+				if ajj := cabs1(tjj); ajj < 1 && xj > ajj*bignum {
+					bi.Zdscal(ki+1, 1/xj, x, 1)
+				}
+				x[j] /= tjj
+				// Scale x to avoid overflow when updating the
+				// right-hand side.
+				xj = cabs1(x[j])
+				if xj > 1 && norms[j] > bignum/xj {
+					bi.Zdscal(ki+1, 1/xj, x, 1)
+				}
+				bi.Zaxpy(j, -x[j], t[j:], ldt, x, 1)
+			}
+
+			if howmny != lapack.EVAllMulQ {
+				// Copy the vector x to VR and normalize.
+				for k := 0; k <= ki; k++ {
+					vr[k*ldvr+is] = x[k]
+				}
+				for k := ki + 1; k < n; k++ {
+					vr[k*ldvr+is] = 0
+				}
+				ii := bi.Izamax(ki+1, vr[is:], ldvr)
+				bi.Zdscal(ki+1, 1/cabs1(vr[ii*ldvr+is]), vr[is:], ldvr)
+			} else {
+				// Version 1: back-transform each vector with
+				// Gemv, Q*x.
+				if ki > 0 {
+					bi.Zgemv(blas.NoTrans, n, ki, 1, vr, ldvr, x, 1, x[ki], vr[ki:], ldvr)
+				}
+				ii := bi.Izamax(n, vr[ki:], ldvr)
+				bi.Zdscal(n, 1/cabs1(vr[ii*ldvr+ki]), vr[ki:], ldvr)
+			}
+			is--
+		}
+	}
+
+	if leftv {
+		// Compute left eigenvectors.
+		is := 0
+		for ki := 0; ki < n; ki++ {
+			if somev && !selected[ki] {
+				continue
+			}
+			lambda := t[ki*ldt+ki]
+			smin := math.Max(ulp*cabs1(lambda), smlnum)
+
+			// Form the right-hand side.
+			x := work[:n]
+			x[ki] = 1
+			for k := ki + 1; k < n; k++ {
+				x[k] = -cmplx.Conj(t[ki*ldt+k])
+			}
+
+			// Solve the conjugate-transposed upper triangular system
+			//  [ T[ki+1:,ki+1:] - λ*I ]ᴴ * x = scale * work,
+			// with the scaling absorbed into x[ki].
+			vmax := 1.0
+			vcrit := bignum
+			for j := ki + 1; j < n; j++ {
+				// Scale x to avoid overflow when forming the
+				// right-hand side element by inner product.
+				if norms[j] > vcrit {
+					bi.Zdscal(n-ki, 1/vmax, x[ki:], 1)
+					vmax = 1
+					vcrit = bignum
+				}
+				x[j] -= bi.Zdotc(j-ki-1, t[(ki+1)*ldt+j:], ldt, x[ki+1:], 1)
+
+				tjj := cmplx.Conj(t[j*ldt+j] - lambda)
+				if cabs1(tjj) < smin {
+					tjj = complex(smin, 0)
+				}
+				// Scale x to avoid overflow when dividing by
+				// the diagonal element.
+				xj := cabs1(x[j])
+				if ajj := cabs1(tjj); ajj < 1 && xj > ajj*bignum {
+					bi.Zdscal(n-ki, 1/xj, x[ki:], 1)
+					vmax /= xj
+				}
+				x[j] /= tjj
+				vmax = math.Max(vmax, cabs1(x[j]))
+				vcrit = bignum / vmax
+			}
+
+			if howmny != lapack.EVAllMulQ {
+				// Copy the vector x to VL and normalize.
+				for k := 0; k < ki; k++ {
+					vl[k*ldvl+is] = 0
+				}
+				for k := ki; k < n; k++ {
+					vl[k*ldvl+is] = x[k]
+				}
+				ii := bi.Izamax(n-ki, vl[ki*ldvl+is:], ldvl) + ki
+				bi.Zdscal(n-ki, 1/cabs1(vl[ii*ldvl+is]), vl[ki*ldvl+is:], ldvl)
+			} else {
+				// Version 1: back-transform each vector with
+				// Gemv, Q*x.
+				if ki < n-1 {
+					bi.Zgemv(blas.NoTrans, n, n-ki-1, 1, vl[ki+1:], ldvl, x[ki+1:], 1, x[ki], vl[ki:], ldvl)
+				}
+				ii := bi.Izamax(n, vl[ki:], ldvl)
+				bi.Zdscal(n, 1/cabs1(vl[ii*ldvl+ki]), vl[ki:], ldvl)
+			}
+			is++
+		}
+	}
+	return m
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zungl2 generates an m×n complex matrix Q with orthonormal rows defined as the
+// first m rows of a product of k elementary reflectors of order n
+//
+//	Q = H_{k-1}ᴴ * ... * H_0ᴴ
+//
+// On entry, tau and the first k rows of A must contain the scalar factors and
+// the conjugated vectors, respectively, which define the elementary reflectors
+// H_i = I - tau[i] * v * vᴴ, i=0,...,k-1, as returned by Zgebd2. On return, A
+// contains the matrix Q.
+//
+// tau must have length at least k, work must have length at least m, and it
+// must hold that 0 <= k <= m <= n, otherwise Zungl2 will panic.
+//
+// Zungl2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zungl2(m, n, k int, a []complex128, lda int, tau, work []complex128) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(nLTM)
+	case k < 0:
+		panic(kLT0)
+	case k > m:
+		panic(kGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	if m == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(work) < m:
+		panic(shortWork)
+	}
+
+	bi := cblas128.Implementation()
+
+	if k < m {
+		for i := k; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+		for j := k; j < m; j++ {
+			a[j*lda+j] = 1
+		}
+	}
+	for i := k - 1; i >= 0; i-- {
+		// Apply H_iᴴ to A[i:m, i:n] from the right.
+		if i < n-1 {
+			impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+			if i < m-1 {
+				a[i*lda+i] = 1
+				impl.Zlarf(blas.Right, m-i-1, n-i, a[i*lda+i:], 1, cmplx.Conj(tau[i]), a[(i+1)*lda+i:], lda, work)
+			}
+			bi.Zscal(n-i-1, -tau[i], a[i*lda+i+1:], 1)
+			impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+		}
+		a[i*lda+i] = 1 - cmplx.Conj(tau[i])
+		for l := 0; l < i; l++ {
+			a[i*lda+l] = 0
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Zunm2r multiplies a general complex matrix C by a unitary matrix from a QR
+// factorization determined by Zgeqrf.
+//
+//	C = Q * C   if side == blas.Left and trans == blas.NoTrans
+//	C = Qᴴ * C  if side == blas.Left and trans == blas.ConjTrans
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans
+//	C = C * Qᴴ  if side == blas.Right and trans == blas.ConjTrans
+//
+// If side == blas.Left, a is a matrix of size m×k, and if side == blas.Right
+// a is of size n×k.
+//
+// tau contains the Householder factors and must have length k and this function
+// will panic otherwise.
+//
+// work is temporary storage of length at least n if side == blas.Left
+// and at least m if side == blas.Right and this function will panic otherwise.
+//
+// Zunm2r is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zunm2r(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128) {
+	left := side == blas.Left
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.ConjTrans && trans != blas.NoTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, k):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case left && len(a) < (m-1)*lda+k:
+		panic(shortA)
+	case !left && len(a) < (n-1)*lda+k:
+		panic(shortA)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(tau) != k:
+		panic(badLenTau)
+	case left && len(work) < n:
+		panic(shortWork)
+	case !left && len(work) < m:
+		panic(shortWork)
+	}
+
+	notrans := trans == blas.NoTrans
+	// apply applies H_i or H_iᴴ to C.
+	apply := func(i int) {
+		taui := tau[i]
+		if !notrans {
+			taui = cmplx.Conj(taui)
+		}
+		aii := a[i*lda+i]
+		a[i*lda+i] = 1
+		if left {
+			impl.Zlarf(side, m-i, n, a[i*lda+i:], lda, taui, c[i*ldc:], ldc, work)
+		} else {
+			impl.Zlarf(side, m, n-i, a[i*lda+i:], lda, taui, c[i:], ldc, work)
+		}
+		a[i*lda+i] = aii
+	}
+	if left == notrans {
+		for i := k - 1; i >= 0; i-- {
+			apply(i)
+		}
+		return
+	}
+	for i := 0; i < k; i++ {
+		apply(i)
+	}
+}
@@ -2,25 +2,49 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:generate ./single_precision.bash
+
 package lapack
 
 import "gonum.org/v1/gonum/blas"
 
 // Complex128 defines the public complex128 LAPACK API supported by gonum/lapack.
-type Complex128 interface{}
+type Complex128 interface {
+	Zgecon(norm MatrixNorm, n int, a []complex128, lda int, anorm float64, work []complex128, rwork []float64) float64
+	Zgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) (first int)
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zgesvd(jobU, jobVT SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool)
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool)
+	Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+	Zheev(jobz EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool)
+	Zlange(norm MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+	Zpocon(uplo blas.Uplo, n int, a []complex128, lda int, anorm float64, work []complex128, rwork []float64) float64
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+	Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+	Ztrcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int, work []complex128, rwork []float64) float64
+	Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
 
 // Float64 defines the public float64 LAPACK API supported by gonum/lapack.
 type Float64 interface {
+	Dgbcon(norm MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
 	Dgecon(norm MatrixNorm, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64
 	Dgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float64, lda int, wr, wi []float64, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int)
 	Dgels(trans blas.Transpose, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool
 	Dgelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
 	Dgeqp3(m, n int, a []float64, lda int, jpvt []int, tau, work []float64, lwork int)
 	Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
+	Dgesdd(jobz SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
 	Dgesvd(jobU, jobVT SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool)
+	Dgesvj(joba MatrixType, jobu LeftSVJob, jobv RightSVJob, m, n int, a []float64, lda int, sva []float64, mv int, v []float64, ldv int, work []float64, lwork int) (ok bool)
 	Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool)
 	Dgetri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
 	Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+	Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) (ok bool)
+	Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) (ok bool)
 	Dggsvd3(jobU, jobV, jobQ GSVDJob, m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int) (k, l int, ok bool)
 	Dlantr(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float64, lda int, work []float64) float64
 	Dlange(norm MatrixNorm, m, n int, a []float64, lda int, work []float64) float64
@@ -39,13 +63,65 @@ type Float64 interface {
 	Dpotri(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
 	Dpotrs(ul blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int)
 	Dpstrf(uplo blas.Uplo, n int, a []float64, lda int, piv []int, tol float64, work []float64) (rank int, ok bool)
+	Dsbev(jobz EVJob, uplo blas.Uplo, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64) (ok bool)
+	Dsbevx(jobz EVJob, rng EVRange, uplo blas.Uplo, n, kd int, ab []float64, ldab int, q []float64, ldq int, vl, vu float64, il, iu int, w, z []float64, ldz int, work []float64, iwork []int) (m int, ok bool)
 	Dsyev(jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool)
+	Dsyevd(jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsyevr(jobz EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
 	Dtbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool)
 	Dtrcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int, work []float64, iwork []int) float64
 	Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool)
 	Dtrtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool)
 }
 
+// Float32 defines the public float32 LAPACK API supported by gonum/lapack.
+type Float32 interface {
+	Sgbcon(norm MatrixNorm, n, kl, ku int, ab []float32, ldab int, ipiv []int, anorm float32, work []float32, iwork []int) float32
+	Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []int) (ok bool)
+	Sgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int, b []float32, ldb int)
+	Sgecon(norm MatrixNorm, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32
+	Sgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float32, lda int, wr, wi []float32, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) (first int)
+	Sgels(trans blas.Transpose, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool
+	Sgelqf(m, n int, a []float32, lda int, tau, work []float32, lwork int)
+	Sgeqp3(m, n int, a []float32, lda int, jpvt []int, tau, work []float32, lwork int)
+	Sgeqrf(m, n int, a []float32, lda int, tau, work []float32, lwork int)
+	Sgesdd(jobz SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []int) (ok bool)
+	Sgesvd(jobU, jobVT SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int) (ok bool)
+	Sgesvj(joba MatrixType, jobu LeftSVJob, jobv RightSVJob, m, n int, a []float32, lda int, sva []float32, mv int, v []float32, ldv int, work []float32, lwork int) (ok bool)
+	Sgetrf(m, n int, a []float32, lda int, ipiv []int) (ok bool)
+	Sgetri(n int, a []float32, lda int, ipiv []int, work []float32, lwork int) (ok bool)
+	Sgetrs(trans blas.Transpose, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int)
+	Sggglm(n, m, p int, a []float32, lda int, b []float32, ldb int, d, x, y, work []float32, lwork int) (ok bool)
+	Sgglse(m, n, p int, a []float32, lda int, b []float32, ldb int, c, d, x, work []float32, lwork int) (ok bool)
+	Sggsvd3(jobU, jobV, jobQ GSVDJob, m, n, p int, a []float32, lda int, b []float32, ldb int, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, lwork int, iwork []int) (k, l int, ok bool)
+	Slantr(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float32, lda int, work []float32) float32
+	Slange(norm MatrixNorm, m, n int, a []float32, lda int, work []float32) float32
+	Slansy(norm MatrixNorm, uplo blas.Uplo, n int, a []float32, lda int, work []float32) float32
+	Slapmr(forward bool, m, n int, x []float32, ldx int, k []int)
+	Slapmt(forward bool, m, n int, x []float32, ldx int, k []int)
+	Sorgqr(m, n, k int, a []float32, lda int, tau, work []float32, lwork int)
+	Sormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int)
+	Sorglq(m, n, k int, a []float32, lda int, tau, work []float32, lwork int)
+	Sormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int)
+	Spbcon(uplo blas.Uplo, n, kd int, ab []float32, ldab int, anorm float32, work []float32, iwork []int) float32
+	Spbtrf(uplo blas.Uplo, n, kd int, ab []float32, ldab int) (ok bool)
+	Spbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int)
+	Spocon(uplo blas.Uplo, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32
+	Spotrf(ul blas.Uplo, n int, a []float32, lda int) (ok bool)
+	Spotri(ul blas.Uplo, n int, a []float32, lda int) (ok bool)
+	Spotrs(ul blas.Uplo, n, nrhs int, a []float32, lda int, b []float32, ldb int)
+	Spstrf(uplo blas.Uplo, n int, a []float32, lda int, piv []int, tol float32, work []float32) (rank int, ok bool)
+	Ssbev(jobz EVJob, uplo blas.Uplo, n, kd int, ab []float32, ldab int, w, z []float32, ldz int, work []float32) (ok bool)
+	Ssbevx(jobz EVJob, rng EVRange, uplo blas.Uplo, n, kd int, ab []float32, ldab int, q []float32, ldq int, vl, vu float32, il, iu int, w, z []float32, ldz int, work []float32, iwork []int) (m int, ok bool)
+	Ssyev(jobz EVJob, uplo blas.Uplo, n int, a []float32, lda int, w, work []float32, lwork int) (ok bool)
+	Ssyevd(jobz EVJob, uplo blas.Uplo, n int, a []float32, lda int, w, work []float32, lwork int, iwork []int, liwork int) (ok bool)
+	Ssyevr(jobz EVJob, rng EVRange, uplo blas.Uplo, n int, a []float32, lda int, vl, vu float32, il, iu int, w, z []float32, ldz int, work []float32, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Stbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool)
+	Strcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int, work []float32, iwork []int) float32
+	Strtri(uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int) (ok bool)
+	Strtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool)
+}
+
 // Direct specifies the direction of the multiplication for the Householder matrix.
 type Direct byte
 
@@ -124,6 +200,26 @@ const (
 	SVDNone      SVDJob = 'N' // Do not compute singular vectors.
 )
 
+// LeftSVJob specifies whether and how the left singular vectors are computed
+// in Dgesvj.
+type LeftSVJob byte
+
+const (
+	LeftSVCompute LeftSVJob = 'U' // Compute the left singular vectors.
+	LeftSVTol     LeftSVJob = 'C' // Compute the left singular vectors to a given orthogonality tolerance.
+	LeftSVNone    LeftSVJob = 'N' // Do not compute the left singular vectors.
+)
+
+// RightSVJob specifies whether and how the right singular vectors are computed
+// in Dgesvj.
+type RightSVJob byte
+
+const (
+	RightSVCompute RightSVJob = 'V' // Compute the right singular vectors.
+	RightSVApply   RightSVJob = 'A' // Apply the rotations to the matrix in the argument.
+	RightSVNone    RightSVJob = 'N' // Do not compute the right singular vectors.
+)
+
 // GSVDJob specifies the singular vector computation type for Generalized SVD.
 type GSVDJob byte
 
@@ -144,7 +240,8 @@ const (
 	EVCompNone EVComp = 'N' // Do not compute eigenvectors.
 )
 
-// EVJob specifies whether eigenvectors are computed in Dsyev.
+// EVJob specifies whether eigenvectors are computed in Dsyev, Dsyevd, Dsyevr,
+// Dsbev and Dsbevx.
 type EVJob byte
 
 const (
@@ -230,7 +327,8 @@ const (
 	NormalizedNullVector MaximizeNormXJob = 2 // Compute an approximate null-vector e of Z, normalize e and solve Z*x=±e-f.
 )
 
-// OrthoComp specifies whether and how the orthogonal matrix is computed in Dgghrd.
+// OrthoComp specifies whether and how the orthogonal matrix is computed in Dgghrd
+// and Dsbtrd.
 type OrthoComp byte
 
 const (
@@ -238,3 +336,21 @@ const (
 	OrthoExplicit OrthoComp = 'I' // The orthogonal matrix is formed explicitly and returned in the argument.
 	OrthoPostmul  OrthoComp = 'V' // The orthogonal matrix is post-multiplied into the matrix stored in the argument on entry.
 )
+
+// EVRange specifies which eigenvalues are computed in Dstemr, Dsyevr and
+// Dsbevx.
+type EVRange byte
+
+const (
+	EVRangeAll   EVRange = 'A' // Compute all eigenvalues.
+	EVRangeValue EVRange = 'V' // Compute the eigenvalues in the half-open interval (vl,vu].
+	EVRangeIndex EVRange = 'I' // Compute the eigenvalues with indices il through iu.
+)
+
+// SVDComp specifies whether and how the singular vectors are computed in Dbdsdc.
+type SVDComp byte
+
+const (
+	SVDCompNone     SVDComp = 'N' // Do not compute singular vectors.
+	SVDCompExplicit SVDComp = 'I' // Compute the singular vectors of the bidiagonal matrix.
+)
@@ -0,0 +1,349 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack128
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+var lapack128 lapack.Complex128 = gonum.Implementation{}
+
+// Use sets the LAPACK complex128 implementation to be used by subsequent BLAS calls.
+// The default implementation is native.Implementation.
+func Use(l lapack.Complex128) {
+	lapack128 = l
+}
+
+// Potrf computes the Cholesky factorization of a.
+// The factorization has the form
+//
+//	A = Uᴴ * U  if a.Uplo == blas.Upper, or
+//	A = L * Lᴴ  if a.Uplo == blas.Lower,
+//
+// where U is an upper triangular matrix and L is lower triangular.
+// The triangular matrix is returned in t, and the underlying data between
+// a and t is shared. The returned bool indicates whether a is positive
+// definite and the factorization could be finished.
+func Potrf(a cblas128.Hermitian) (t cblas128.Triangular, ok bool) {
+	ok = lapack128.Zpotrf(a.Uplo, a.N, a.Data, max(1, a.Stride))
+	t.Uplo = a.Uplo
+	t.N = a.N
+	t.Data = a.Data
+	t.Stride = a.Stride
+	t.Diag = blas.NonUnit
+	return
+}
+
+// Potrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix and B is an n×nrhs matrix, using the
+// Cholesky factorization A = Uᴴ*U or A = L*Lᴴ. t contains the corresponding
+// triangular factor as returned by Potrf. On entry, B contains the right-hand
+// side matrix B, on return it contains the solution matrix X.
+func Potrs(t cblas128.Triangular, b cblas128.General) {
+	lapack128.Zpotrs(t.Uplo, t.N, b.Cols, t.Data, max(1, t.Stride), b.Data, max(1, b.Stride))
+}
+
+// Pocon estimates the reciprocal of the condition number of a Hermitian
+// positive-definite matrix A given the Cholesky decomposition of A. The
+// condition number computed is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Pocon will panic otherwise.
+//
+// rwork is a temporary data slice of length at least n and Pocon will panic otherwise.
+func Pocon(a cblas128.Hermitian, anorm float64, work []complex128, rwork []float64) float64 {
+	return lapack128.Zpocon(a.Uplo, a.N, a.Data, max(1, a.Stride), anorm, work, rwork)
+}
+
+// Gecon estimates the reciprocal of the condition number of the n×n matrix A
+// given the LU decomposition of the matrix. The condition number computed may
+// be based on the 1-norm or the ∞-norm.
+//
+// a contains the result of the LU decomposition of A as computed by Getrf.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Gecon will panic otherwise.
+//
+// rwork is a temporary data slice of length at least 2*n and Gecon will panic otherwise.
+func Gecon(norm lapack.MatrixNorm, a cblas128.General, anorm float64, work []complex128, rwork []float64) float64 {
+	return lapack128.Zgecon(norm, a.Cols, a.Data, max(1, a.Stride), anorm, work, rwork)
+}
+
+// Geev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n complex nonsymmetric matrix A.
+//
+// The right eigenvector v_j of A corresponding to an eigenvalue λ_j
+// is defined by
+//
+//	A v_j = λ_j v_j,
+//
+// and the left eigenvector u_j corresponding to an eigenvalue λ_j is defined by
+//
+//	u_jᴴ A = λ_j u_jᴴ,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, A will be overwritten and the left and right eigenvectors will be
+// stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues. The computed eigenvectors are normalized to
+// have Euclidean norm equal to 1 and largest component real.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Geev will panic.
+//
+// On return, w will contain the computed eigenvalues. w must have length n,
+// and Geev will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,2*n).
+// For good performance, lwork must generally be larger. On return, optimal
+// value of lwork will be stored in work[0]. rwork must have length at least
+// 2*n.
+//
+// If lwork == -1, instead of performing Geev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// On return, first will be the index of the first valid eigenvalue.
+// If first == 0, all eigenvalues and eigenvectors have been computed.
+// If first is positive, Geev failed to compute all the eigenvalues, no
+// eigenvectors have been computed and w[first:] contains those eigenvalues
+// which have converged.
+func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a cblas128.General, w []complex128, vl, vr cblas128.General, work []complex128, lwork int, rwork []float64) (first int) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack128: matrix not square")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack128: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack128: bad size of VR")
+	}
+	return lapack128.Zgeev(jobvl, jobvr, n, a.Data, max(1, a.Stride), w, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork, rwork)
+}
+
+// Geqrf computes the QR factorization of the m×n matrix A using a blocked
+// algorithm. A is modified to contain the information to construct Q and R.
+// The upper triangle of a contains the matrix R. The lower triangular elements
+// (not including the diagonal) contain the elementary reflectors. tau is modified
+// to contain the reflector scales. tau must have length min(m,n), and
+// this function will panic otherwise.
+//
+// The ith elementary reflector can be explicitly constructed by first extracting
+// the
+//
+//	v[j] = 0           j < i
+//	v[j] = 1           j == i
+//	v[j] = a[j*lda+i]  j > i
+//
+// and computing H_i = I - tau[i] * v * vᴴ.
+//
+// The unitary matrix Q can be constructed from a product of these elementary
+// reflectors, Q = H_0 * H_1 * ... * H_{k-1}, where k = min(m,n).
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n and this function will panic otherwise.
+// Geqrf is a blocked QR factorization, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Geqrf,
+// the optimal work length will be stored into work[0].
+func Geqrf(a cblas128.General, tau, work []complex128, lwork int) {
+	lapack128.Zgeqrf(a.Rows, a.Cols, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Gesvd computes the singular value decomposition of the input matrix A.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᴴ
+//
+// where Sigma is an m×n real diagonal matrix containing the singular values of
+// A, U is an m×m unitary matrix and V is an n×n unitary matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//
+//	jobU == lapack.SVDAll       All m columns of U are returned in u
+//	jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//	jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
+//	jobU == lapack.SVDNone      The columns of U are not computed.
+//
+// The behavior is the same for jobVT and the rows of Vᴴ. At most one of jobU
+// and jobVT can equal lapack.SVDOverwrite, and Gesvd will panic otherwise.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesvd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if either job is lapack.SVDOverwrite.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored columnwise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
+// not used.
+//
+// vt contains the right singular vectors on exit, stored rowwise. If
+// jobVT == lapack.SVDAll, vt is of size n×n. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
+// not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least 2*min(m,n)+max(m,n). If lwork == -1,
+// instead of performing Gesvd, the optimal work length will be stored into
+// work[0]. Gesvd will panic if the working memory has insufficient storage.
+// rwork must have length at least 5*min(m,n).
+//
+// Gesvd returns whether the decomposition successfully completed.
+func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt cblas128.General, s []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	return lapack128.Zgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork, rwork)
+}
+
+// Getrf computes the LU decomposition of an m×n matrix A using partial
+// pivoting with row interchanges.
+//
+// The LU decomposition is a factorization of A into
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a lower triangular with unit diagonal
+// elements (lower trapezoidal if m > n), and U is upper triangular (upper
+// trapezoidal if m < n).
+//
+// On entry, a contains the matrix A. On return, L and U are stored in place
+// into a, and P is represented by ipiv.
+//
+// ipiv contains a sequence of row swaps. It indicates that row i of the matrix
+// was interchanged with ipiv[i]. ipiv must have length min(m,n), and Getrf will
+// panic otherwise. ipiv is zero-indexed.
+//
+// Getrf returns whether the matrix A is nonsingular. The LU decomposition will
+// be computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equation.
+func Getrf(a cblas128.General, ipiv []int) bool {
+	return lapack128.Zgetrf(a.Rows, a.Cols, a.Data, max(1, a.Stride), ipiv)
+}
+
+// Getrs solves a system of equations using an LU factorization.
+// The system of equations solved is
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans
+//	Aᴴ * X = B  if trans == blas.ConjTrans
+//
+// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Getrf. ipiv is zero-indexed.
+func Getrs(trans blas.Transpose, a cblas128.General, b cblas128.General, ipiv []int) {
+	lapack128.Zgetrs(trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
+// Heev computes the eigenvalues and, optionally, the eigenvectors of a
+// complex Hermitian matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Heev will panic otherwise.
+//
+// On entry, a contains the elements of the Hermitian matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// Work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 2*n-1, and Heev will panic otherwise. If lwork == -1, instead of
+// computing Heev the optimal work length is stored into work[0]. rwork must
+// have length at least max(1,3*n-2).
+func Heev(jobz lapack.EVJob, a cblas128.Hermitian, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	return lapack128.Zheev(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork, rwork)
+}
+
+// Lange computes the matrix norm of the general m×n matrix A. The input norm
+// specifies the norm computed.
+//
+//	lapack.MaxAbs: the maximum absolute value of an element.
+//	lapack.MaxColumnSum: the maximum column sum of the absolute values of the entries.
+//	lapack.MaxRowSum: the maximum row sum of the absolute values of the entries.
+//	lapack.Frobenius: the square root of the sum of the squares of the entries.
+//
+// If norm == lapack.MaxColumnSum, work must be of length n, and this function will panic otherwise.
+// There are no restrictions on work for the other matrix norms.
+func Lange(norm lapack.MatrixNorm, a cblas128.General, work []float64) float64 {
+	return lapack128.Zlange(norm, a.Rows, a.Cols, a.Data, max(1, a.Stride), work)
+}
+
+// Ungqr generates an m×n matrix Q with orthonormal columns defined by the
+// product of elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}
+//
+// as computed by Geqrf.
+//
+// k is determined by the length of tau.
+//
+// The length of work must be at least n and it also must be that 0 <= k <= n
+// and 0 <= n <= m.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n, and the amount of blocking is limited by the usable
+// length. If lwork == -1, instead of computing Ungqr the optimal work length
+// is stored into work[0].
+//
+// Ungqr will panic if the conditions on input values are not met.
+func Ungqr(a cblas128.General, tau []complex128, work []complex128, lwork int) {
+	lapack128.Zungqr(a.Rows, a.Cols, len(tau), a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Unmqr multiplies an m×n matrix C by a unitary matrix Q as
+//
+//	C = Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//	C = Qᴴ * C  if side == blas.Left  and trans == blas.ConjTrans,
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans,
+//	C = C * Qᴴ  if side == blas.Right and trans == blas.ConjTrans,
+//
+// where Q is defined as the product of k elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// k is determined by the length of tau.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. Geqrf returns A and tau
+// in the required form.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n if side == blas.Left and lwork >= m if side ==
+// blas.Right, and this function will panic otherwise. Larger values of lwork
+// will generally give better performance. On return, work[0] will contain the
+// optimal value of lwork.
+//
+// If lwork is -1, instead of performing Unmqr, the optimal workspace size will
+// be stored into work[0].
+func Unmqr(side blas.Side, trans blas.Transpose, a cblas128.General, tau []complex128, c cblas128.General, work []complex128, lwork int) {
+	lapack128.Zunmqr(side, trans, c.Rows, c.Cols, len(tau), a.Data, max(1, a.Stride), tau, c.Data, max(1, c.Stride), work, lwork)
+}
+
+// Trcon estimates the reciprocal of the condition number of a triangular matrix A.
+// The condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// work is a temporary data slice of length at least 2*n and Trcon will panic otherwise.
+//
+// rwork is a temporary data slice of length at least n and Trcon will panic otherwise.
+func Trcon(norm lapack.MatrixNorm, a cblas128.Triangular, work []complex128, rwork []float64) float64 {
+	return lapack128.Ztrcon(norm, a.Uplo, a.Diag, a.N, a.Data, max(1, a.Stride), work, rwork)
+}
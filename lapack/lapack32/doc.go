@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lapack32 provides a set of convenient wrapper functions for LAPACK
+// calls, as specified in the netlib standard (www.netlib.org), operating on
+// float32 data.
+//
+// The API mirrors that of the lapack64 package, with the routines backed by
+// the single precision routines of the native Go implementation by default.
+// The Use function can be used to set an alternative implementation.
+//
+// The float32 wrappers are generated from the lapack64 package and are not
+// directly tested.
+package lapack32 // import "gonum.org/v1/gonum/lapack/lapack32"
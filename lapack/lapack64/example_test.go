@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/lapack/gonum"
+	"gonum.org/v1/gonum/lapack/lapack64"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleUse() {
+	// Register the tiled implementation so that the Cholesky, LU and QR
+	// factorizations computed by the mat package use up to four goroutines.
+	// Matrices whose smaller dimension is more than twice the tile size
+	// are factorized using tiles.
+	lapack64.Use(gonum.Tiled{Workers: 4, TileSize: 32})
+	defer lapack64.Use(gonum.Implementation{})
+
+	// Construct a 200×200 symmetric positive definite matrix.
+	const n = 200
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tmp := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			tmp.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var a mat.SymDense
+	a.SymOuterK(1, tmp)
+	for i := 0; i < n; i++ {
+		a.SetSym(i, i, a.At(i, i)+n)
+	}
+
+	// Compute the Cholesky factorization and check that it reconstructs a.
+	var chol mat.Cholesky
+	if ok := chol.Factorize(&a); !ok {
+		fmt.Println("a matrix is not positive definite.")
+		return
+	}
+	var u mat.TriDense
+	chol.UTo(&u)
+	var utu mat.Dense
+	utu.Mul(u.T(), &u)
+	fmt.Printf("|Uᵀ*U - A| < 1e-10: %t\n", mat.EqualApprox(&utu, &a, 1e-10))
+
+	// Output:
+	// |Uᵀ*U - A| < 1e-10: true
+}
@@ -166,6 +166,56 @@ func Pstrf(a blas64.Symmetric, piv []int, tol float64, work []float64) (t blas64
 	return t, rank, ok
 }
 
+// Gbcon estimates the reciprocal of the condition number of the n×n band
+// matrix A given the LU decomposition of the matrix computed by Gbtrf. The
+// condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Gbcon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Gbcon will panic otherwise.
+func Gbcon(norm lapack.MatrixNorm, a blas64.Band, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return lapack64.Dgbcon(norm, a.Cols, a.KL, a.KU-a.KL, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
+// Gbtrf computes the LU factorization of an m×n band matrix A using partial
+// pivoting with row interchanges. The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements and U is upper triangular.
+//
+// On entry, a contains the band matrix A with a.KL sub-diagonals and
+// a.KU-a.KL super-diagonals. The last a.KL super-diagonals of a need not be set
+// on entry and are used to store the fill-in resulting from the row
+// interchanges. On return, a contains the factors L and U in the form
+// described in the documentation for the Dgbtrf function in the gonum
+// package, and ipiv contains the row interchanges. a.KU must be at least
+// a.KL and ipiv must have length min(m,n), otherwise Gbtrf will panic.
+//
+// Gbtrf returns whether the matrix A is nonsingular. The LU factorization is
+// computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equations.
+func Gbtrf(a blas64.Band, ipiv []int) (ok bool) {
+	return lapack64.Dgbtrf(a.Rows, a.Cols, a.KL, a.KU-a.KL, a.Data, max(1, a.Stride), ipiv)
+}
+
+// Gbtrs solves a system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// with an n×n band matrix A using the LU factorization and row interchanges
+// computed by Gbtrf.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+func Gbtrs(trans blas.Transpose, a blas64.Band, ipiv []int, b blas64.General) {
+	lapack64.Dgbtrs(trans, a.Cols, a.KL, a.KU-a.KL, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Gecon estimates the reciprocal of the condition number of the n×n matrix A
 // given the LU decomposition of the matrix. The condition number computed may
 // be based on the 1-norm or the ∞-norm.
@@ -304,6 +354,52 @@ func Gelqf(a blas64.General, tau, work []float64, lwork int) {
 	lapack64.Dgelqf(a.Rows, a.Cols, a.Data, max(1, a.Stride), tau, work, lwork)
 }
 
+// Gesdd computes the singular value decomposition of the input matrix A using
+// a divide and conquer method.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively. If the singular vectors are requested, Gesdd is usually much
+// faster than Gesvd for large matrices.
+//
+// jobz specifies the singular vectors that are computed:
+//
+//	jobz == lapack.SVDAll       All m columns of U and all n rows of Vᵀ are
+//	                            returned in u and vt.
+//	jobz == lapack.SVDStore     The first min(m,n) columns of U and the first
+//	                            min(m,n) rows of Vᵀ are returned in u and vt.
+//	jobz == lapack.SVDOverwrite If m >= n, the first n columns of U are written
+//	                            into a and all n rows of Vᵀ are returned in vt.
+//	                            Otherwise, all m columns of U are returned in u
+//	                            and the first m rows of Vᵀ are written into a.
+//	jobz == lapack.SVDNone      No singular vectors are computed.
+//
+// On entry, a contains the data for the m×n matrix A. On return, if jobz is
+// not lapack.SVDOverwrite, the contents of A are destroyed.
+//
+// s must have length at least min(m,n) and on return it contains the singular
+// values in decreasing order.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. Let mn = min(m,n) and mx = max(m,n). lwork must be at least
+//
+//	3*mn + max(mx, 4*mn)            if jobz == lapack.SVDNone,
+//	4*mn*mn + 8*mn + mx             if jobz == lapack.SVDAll or lapack.SVDStore,
+//	4*mn*mn + 8*mn + mx + m*n       if jobz == lapack.SVDOverwrite.
+//
+// If lwork == -1, instead of performing Gesdd, the optimal work length will
+// be stored into work[0]. iwork must have length at least 8*min(m,n).
+//
+// Gesdd returns whether the decomposition successfully completed.
+func Gesdd(jobz lapack.SVDJob, a, u, vt blas64.General, s, work []float64, lwork int, iwork []int) (ok bool) {
+	return lapack64.Dgesdd(jobz, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork, iwork)
+}
+
 // Gesvd computes the singular value decomposition of the input matrix A.
 //
 // The singular value decomposition is
@@ -354,6 +450,58 @@ func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt blas64.General, s, work []float64
 	return lapack64.Dgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork)
 }
 
+// Gesvj computes the singular value decomposition of an m×n matrix A with
+// m >= n using the one-sided Jacobi method.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an n×n diagonal matrix containing the singular values of A,
+// U is an m×n matrix with orthonormal columns and V is an n×n orthogonal
+// matrix. Gesvj is slower than Gesvd and Gesdd, but it computes the singular
+// values to high relative accuracy if A = B*D with a diagonal matrix D and a
+// well-conditioned matrix B.
+//
+// joba specifies whether A is a general, an upper triangular or a lower
+// triangular matrix. If A is triangular, the elements outside the triangle
+// must be zero.
+//
+// jobu specifies whether the left singular vectors are computed:
+//
+//	jobu == lapack.LeftSVCompute The left singular vectors corresponding to
+//	                             the non-zero singular values are written into
+//	                             the leading columns of a.
+//	jobu == lapack.LeftSVTol     As for lapack.LeftSVCompute, but the columns
+//	                             are orthogonalized to within the tolerance
+//	                             ctol*eps where ctol > 1 is given in work[0] on
+//	                             entry.
+//	jobu == lapack.LeftSVNone    The left singular vectors are not computed and
+//	                             the contents of a are destroyed.
+//
+// jobv specifies whether the right singular vectors are computed:
+//
+//	jobv == lapack.RightSVCompute The n×n matrix V is returned in v.
+//	jobv == lapack.RightSVApply   v is overwritten by the product of the
+//	                              v.Rows×n matrix stored in it on entry and V.
+//	jobv == lapack.RightSVNone    V is not computed and v is not referenced.
+//
+// sva must have length n. On return it contains the singular values of A
+// scaled by 1/work[0] in decreasing order.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(6,m+n). If lwork == -1, instead of
+// performing Gesvj, the minimum work length will be stored into work[0].
+// On return, work[0] is the scaling factor of the singular values, work[1] is
+// the number of non-zero singular values and work[2] is the number of singular
+// values larger than the underflow threshold. See the documentation of Dgesvj
+// in gonum.org/v1/gonum/lapack/gonum for the other elements of work.
+//
+// Gesvj returns whether the iteration converged.
+func Gesvj(joba lapack.MatrixType, jobu lapack.LeftSVJob, jobv lapack.RightSVJob, a blas64.General, sva []float64, v blas64.General, work []float64, lwork int) (ok bool) {
+	return lapack64.Dgesvj(joba, jobu, jobv, a.Rows, a.Cols, a.Data, max(1, a.Stride), sva, v.Rows, v.Data, max(1, v.Stride), work, lwork)
+}
+
 // Getrf computes the LU decomposition of an m×n matrix A using partial
 // pivoting with row interchanges.
 //
@@ -412,6 +560,56 @@ func Getrs(trans blas.Transpose, a blas64.General, b blas64.General, ipiv []int)
 	lapack64.Dgetrs(trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
 }
 
+// Ggglm solves the general Gauss-Markov linear model (GLM) problem
+//
+//	minimize |y|_2  subject to  d = A*x + B*y
+//	   x
+//
+// where A is an n×m matrix, B is an n×p matrix, and d is a vector of length n.
+// It is assumed that m <= n <= m+p, rank(A) = m and rank([A B]) = n, so that
+// there is a unique solution x and a minimal 2-norm solution y. If B is
+// square and nonsingular, the problem is equivalent to the weighted linear
+// least squares problem
+//
+//	minimize |B⁻¹*(d - A*x)|_2.
+//	   x
+//
+// On return, A and B are overwritten and d is destroyed. x must have length m
+// and y must have length p.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// n+m+p, or 1 if n == 0, otherwise Ggglm will panic. For optimum performance
+// lwork should be larger. If lwork == -1, instead of performing Ggglm, only the
+// optimal value of lwork will be stored in work[0].
+//
+// Ggglm returns false if A or [A B] does not have full rank, in which case the
+// solution cannot be computed.
+func Ggglm(a, b blas64.General, d, x, y, work []float64, lwork int) (ok bool) {
+	return lapack64.Dggglm(a.Rows, a.Cols, b.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), d, x, y, work, lwork)
+}
+
+// Gglse solves the linear equality-constrained least squares (LSE) problem
+//
+//	minimize |c - A*x|_2  subject to  B*x = d
+//
+// where A is an m×n matrix, B is a p×n matrix, c is a vector of length m and d
+// is a vector of length p. It is assumed that p <= n <= m+p, rank(B) = p and
+// rank([A; B]) = n, so that the problem has a unique solution x of length n.
+//
+// On return, A and B are overwritten and d is destroyed. The residual sum of
+// squares for the solution is given by the sum of squares of elements n-p:m of c.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// m+n+p, or 1 if n == 0, otherwise Gglse will panic. For optimum performance
+// lwork should be larger. If lwork == -1, instead of performing Gglse, only the
+// optimal value of lwork will be stored in work[0].
+//
+// Gglse returns false if B or [A; B] does not have full rank, in which case the
+// solution cannot be computed.
+func Gglse(a, b blas64.General, c, d, x, work []float64, lwork int) (ok bool) {
+	return lapack64.Dgglse(a.Rows, a.Cols, b.Rows, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c, d, x, work, lwork)
+}
+
 // Ggsvd3 computes the generalized singular value decomposition (GSVD)
 // of an m×n matrix A and p×n matrix B:
 //
@@ -799,6 +997,93 @@ func Syev(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int) (
 	return lapack64.Dsyev(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork)
 }
 
+// Syevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A using the divide and conquer algorithm.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Syevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. If n <= 1, lwork and liwork must be at least 1. Otherwise, if
+// jobz == lapack.EVNone, lwork must be at least 2*n+1 and liwork at least 1,
+// and if jobz == lapack.EVCompute, lwork must be at least 1+6*n+2*n*n and
+// liwork at least 3+5*n. If lwork == -1 or liwork == -1, instead of computing
+// Syevd the optimal work length is stored into work[0] and the minimum iwork
+// length into iwork[0].
+func Syevd(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	return lapack64.Dsyevd(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork, iwork, liwork)
+}
+
+// Syevr computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric matrix A using the method of multiple relatively robust
+// representations.
+//
+// rng specifies whether all eigenvalues, the eigenvalues in the half-open
+// interval (vl,vu] or the eigenvalues with zero-based indices il through iu in
+// ascending order are computed. On return, the first m elements of w contain
+// the selected eigenvalues in ascending order and, if jobz ==
+// lapack.EVCompute, the first m columns of z contain the corresponding
+// orthonormal eigenvectors. z must have at least iu-il+1 columns if rng ==
+// lapack.EVRangeIndex and n columns otherwise. On return, the specified
+// triangular region of a is overwritten.
+//
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. lwork must be at least max(1,26*n) and liwork at least
+// max(1,12*n). If lwork == -1 or liwork == -1, instead of computing Syevr the
+// optimal work length is stored into work[0] and the minimum iwork length into
+// iwork[0].
+//
+// Syevr returns the number of computed eigenvalues and whether the computation
+// succeeded.
+func Syevr(jobz lapack.EVJob, rng lapack.EVRange, a blas64.Symmetric, vl, vu float64, il, iu int, w []float64, z blas64.General, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	return lapack64.Dsyevr(jobz, rng, a.Uplo, a.N, a.Data, max(1, a.Stride), vl, vu, il, iu, w, z.Data, max(1, z.Stride), work, lwork, iwork, liwork)
+}
+
+// Sbev computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric band matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have
+// length at least n.
+//
+// If jobz == lapack.EVCompute, z contains the orthonormal eigenvectors of A on
+// return, with the i-th column holding the eigenvector associated with w[i].
+// On return, the band of a is overwritten.
+//
+// work must have length at least max(1,3*n-2).
+//
+// Sbev returns whether the computation succeeded.
+func Sbev(jobz lapack.EVJob, a blas64.SymmetricBand, w []float64, z blas64.General, work []float64) (ok bool) {
+	return lapack64.Dsbev(jobz, a.Uplo, a.N, a.K, a.Data, max(1, a.Stride), w, z.Data, max(1, z.Stride), work)
+}
+
+// Sbevx computes selected eigenvalues and, optionally, eigenvectors of a real
+// symmetric band matrix A.
+//
+// rng specifies whether all eigenvalues, the eigenvalues in the half-open
+// interval (vl,vu] or the eigenvalues with zero-based indices il through iu in
+// ascending order are computed. On return, the first m elements of w contain
+// the selected eigenvalues in ascending order and, if jobz ==
+// lapack.EVCompute, the first m columns of z contain the corresponding
+// orthonormal eigenvectors. z must have at least iu-il+1 columns if rng ==
+// lapack.EVRangeIndex and n columns otherwise. On return, the band of a is
+// overwritten.
+//
+// If jobz == lapack.EVCompute, the n×n matrix q is used as workspace for the
+// orthogonal matrix used in the reduction to tridiagonal form.
+//
+// work must have length at least max(1,20*n) and iwork at least max(1,12*n).
+//
+// Sbevx returns the number of computed eigenvalues and whether the computation
+// succeeded.
+func Sbevx(jobz lapack.EVJob, rng lapack.EVRange, a blas64.SymmetricBand, q blas64.General, vl, vu float64, il, iu int, w []float64, z blas64.General, work []float64, iwork []int) (m int, ok bool) {
+	return lapack64.Dsbevx(jobz, rng, a.Uplo, a.N, a.K, a.Data, max(1, a.Stride), q.Data, max(1, q.Stride), vl, vu, il, iu, w, z.Data, max(1, z.Stride), work, iwork)
+}
+
 // Tbtrs solves a triangular system of the form
 //
 //	A * X = B   if trans == blas.NoTrans
@@ -906,3 +1191,314 @@ func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a blas64.General, wr,
 	}
 	return lapack64.Dgeev(jobvl, jobvr, n, a.Data, max(1, a.Stride), wr, wi, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
 }
+
+// Gehrd reduces the block A[ilo:ihi+1,ilo:ihi+1] of the n×n general matrix A
+// to upper Hessenberg form H by an orthogonal similarity transformation
+// Qᵀ * A * Q = H.
+//
+// On return, the upper triangle and the first subdiagonal of A will be
+// overwritten with the upper Hessenberg matrix H, and the elements below the
+// first subdiagonal, with the slice tau, represent the orthogonal matrix Q as a
+// product of elementary reflectors. Q can be formed explicitly using Orghr.
+//
+// It must hold that 0 <= ilo <= ihi < n if n > 0, and ilo == 0 and ihi == -1
+// if n == 0, otherwise Gehrd will panic. tau must have length n-1 if n > 0.
+//
+// work must have length at least lwork and lwork must be at least max(1,n),
+// otherwise Gehrd will panic. On return, work[0] contains the optimal value of
+// lwork. If lwork == -1, instead of performing Gehrd, only the optimal value of
+// lwork will be stored in work[0].
+//
+// Dgehrd is not part of the lapack.Float64 interface and so calls to Gehrd are
+// always executed by the Gonum implementation.
+func Gehrd(a blas64.General, ilo, ihi int, tau, work []float64, lwork int) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	gonum.Implementation{}.Dgehrd(a.Rows, ilo, ihi, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Hseqr computes the eigenvalues of an n×n upper Hessenberg matrix H and,
+// optionally, the matrices T and Z from the Schur decomposition
+//
+//	H = Z T Zᵀ,
+//
+// where T is an n×n upper quasi-triangular matrix (the Schur form), and Z is
+// the n×n orthogonal matrix of Schur vectors.
+//
+// If job is lapack.EigenvaluesAndSchur, on return h will contain T. If
+// compz is lapack.SchurOrig, z must contain on entry an orthogonal matrix Q
+// and on return it will be overwritten by Q*Z. If compz is lapack.SchurHess,
+// z will be overwritten by Z. If compz is lapack.SchurNone, z is not
+// referenced.
+//
+// The eigenvalues are returned in wr and wi in the same order as on the
+// diagonal of T. wr and wi must have length n.
+//
+// work must have length at least lwork and lwork must be at least max(1,n),
+// otherwise Hseqr will panic. If lwork == -1, instead of performing Hseqr,
+// only the optimal value of lwork will be stored in work[0].
+//
+// If unconverged is positive, Hseqr failed to compute all the eigenvalues.
+// See the documentation of gonum.Implementation.Dhseqr for details.
+//
+// Dhseqr is not part of the lapack.Float64 interface and so calls to Hseqr are
+// always executed by the Gonum implementation.
+func Hseqr(job lapack.SchurJob, compz lapack.SchurComp, h blas64.General, ilo, ihi int, wr, wi []float64, z blas64.General, work []float64, lwork int) (unconverged int) {
+	n := h.Rows
+	if h.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if compz != lapack.SchurNone && (z.Rows != n || z.Cols != n) {
+		panic("lapack64: bad size of Z")
+	}
+	return gonum.Implementation{}.Dhseqr(job, compz, n, ilo, ihi, h.Data, max(1, h.Stride), wr, wi, z.Data, max(1, z.Stride), work, lwork)
+}
+
+// Orghr generates the n×n orthogonal matrix Q which is defined as the product
+// of ihi-ilo elementary reflectors as returned by Gehrd.
+//
+// On entry, a and tau must contain the elementary reflectors as returned by
+// Gehrd, and ilo and ihi must have the same values as in the call to Gehrd.
+// On return, a is overwritten by Q.
+//
+// work must have length at least max(1,lwork) and lwork must be at least
+// ihi-ilo. On return, work[0] will contain the optimal value of lwork. If
+// lwork == -1, instead of performing Orghr, only the optimal value of lwork
+// will be stored into work[0].
+//
+// Dorghr is not part of the lapack.Float64 interface and so calls to Orghr are
+// always executed by the Gonum implementation.
+func Orghr(a blas64.General, ilo, ihi int, tau, work []float64, lwork int) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	gonum.Implementation{}.Dorghr(a.Rows, ilo, ihi, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Trexc reorders the real Schur factorization of an n×n real matrix
+//
+//	A = Q*T*Qᵀ
+//
+// so that the diagonal block of T with row index ifst is moved to row ilst.
+//
+// On entry, T must be in Schur canonical form. On return, T will be reordered
+// by an orthogonal similarity transformation Z as Zᵀ*T*Z, and will be again in
+// Schur canonical form. If compq is lapack.UpdateSchur, on return the matrix
+// Q of Schur vectors will be updated by post-multiplying it with Z. If compq
+// is lapack.UpdateSchurNone, q is not referenced.
+//
+// If ifst points to the second row of a 2×2 block, ifstOut will point to the
+// first row, otherwise it will be equal to ifst. ilstOut will point to the
+// first row of the block in its final position.
+//
+// If ok is false, two adjacent blocks were too close to swap because the
+// problem is very ill-conditioned. T may have been partially reordered.
+//
+// work must have length at least n, otherwise Trexc will panic.
+//
+// Dtrexc is not part of the lapack.Float64 interface and so calls to Trexc are
+// always executed by the Gonum implementation.
+func Trexc(compq lapack.UpdateSchurComp, t, q blas64.General, ifst, ilst int, work []float64) (ifstOut, ilstOut int, ok bool) {
+	n := t.Rows
+	if t.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if compq == lapack.UpdateSchur && (q.Rows != n || q.Cols != n) {
+		panic("lapack64: bad size of Q")
+	}
+	return gonum.Implementation{}.Dtrexc(compq, n, t.Data, max(1, t.Stride), q.Data, max(1, q.Stride), ifst, ilst, work)
+}
+
+// Trsyl solves the real Sylvester matrix equation
+//
+//	op(A)*X + isgn*X*op(B) = scale*C
+//
+// where A is an m×m matrix and B is an n×n matrix, both in Schur canonical
+// form, and C is an m×n matrix. On return, C is overwritten by the solution X.
+//
+// isgn must be 1 or -1. scale is a factor, 0 < scale <= 1, chosen to prevent
+// overflow in the solution. If ok is false, op(A) and -isgn*op(B) have common
+// or very close eigenvalues and perturbed values were used to solve the
+// equation.
+//
+// Dtrsyl is not part of the lapack.Float64 interface and so calls to Trsyl are
+// always executed by the Gonum implementation.
+func Trsyl(trana, tranb blas.Transpose, isgn int, a, b, c blas64.General) (scale float64, ok bool) {
+	m := a.Rows
+	n := b.Rows
+	if a.Cols != m || b.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if c.Rows != m || c.Cols != n {
+		panic("lapack64: bad size of C")
+	}
+	return gonum.Implementation{}.Dtrsyl(trana, tranb, isgn, m, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c.Data, max(1, c.Stride))
+}
+
+// Ggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors for a pair of n×n real nonsymmetric matrices
+// (A,B).
+//
+// The right eigenvector v_j corresponding to the eigenvalue λ_j of (A,B)
+// satisfies
+//
+//	A * v_j = λ_j * B * v_j,
+//
+// and the left eigenvector u_j corresponding to the eigenvalue λ_j of (A,B)
+// satisfies
+//
+//	u_jᴴ * A = λ_j * u_jᴴ * B,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// The eigenvalues are returned as pairs (alpha,beta) with
+// alpha_j = alphar[j] + i*alphai[j] and beta_j = beta[j], so that
+// λ_j = alpha_j / beta_j. beta_j may be zero, in which case the eigenvalue is
+// infinite. alphar, alphai and beta must have length n.
+//
+// On return, A and B will be overwritten and the left and right eigenvectors
+// will be stored, respectively, in the columns of the n×n matrices VL and VR
+// in the same order as their eigenvalues. If the j-th eigenvalue is real, then
+//
+//	u_j = VL[:,j],
+//	v_j = VR[:,j],
+//
+// and if it is not real, then j and j+1 form a complex conjugate pair and the
+// eigenvectors can be recovered as
+//
+//	u_j     = VL[:,j] + i*VL[:,j+1],
+//	u_{j+1} = VL[:,j] - i*VL[:,j+1],
+//	v_j     = VR[:,j] + i*VR[:,j+1],
+//	v_{j+1} = VR[:,j] - i*VR[:,j+1],
+//
+// where i is the imaginary unit. Each eigenvector is scaled so the largest
+// component has |real part| + |imag. part| = 1.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Ggev will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n),
+// otherwise Ggev will panic. For good performance, lwork must generally be
+// larger. On return, optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Ggev, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// On return, first is the index of the first valid eigenvalue. If first == 0,
+// all eigenvalues have been computed. If first is positive, Ggev failed to
+// compute all the eigenvalues, no eigenvectors have been computed and
+// alphar[first:], alphai[first:] and beta[first:] contain those eigenvalues
+// which have converged. ok is false if first is positive or if the
+// computation of the eigenvectors failed.
+//
+// Dggev is not part of the lapack.Float64 interface and so calls to Ggev are
+// always executed by the Gonum implementation.
+func Ggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a, b blas64.General, alphar, alphai, beta []float64, vl, vr blas64.General, work []float64, lwork int) (first int, ok bool) {
+	n := a.Rows
+	if a.Cols != n || b.Rows != n || b.Cols != n {
+		panic("lapack64: bad size of A or B")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack64: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack64: bad size of VR")
+	}
+	return gonum.Implementation{}.Dggev(jobvl, jobvr, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
+}
+
+// Sytrf computes the Bunch-Kaufman factorization of a real symmetric matrix A
+// in the form
+//
+//	A = U*D*Uᵀ  if a.Uplo == blas.Upper, or
+//	A = L*D*Lᵀ  if a.Uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks.
+//
+// On return, a contains the block diagonal matrix D and the multipliers used to
+// obtain the factor U or L, and ipiv contains details of the interchanges and
+// the block structure of D. See the documentation of the Gonum Dsytrf for the
+// details of the storage format. ipiv must have length n, and Sytrf will panic
+// otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least 1,
+// otherwise Sytrf will panic. If lwork == -1, instead of performing Sytrf, the
+// optimal work length will be stored into work[0].
+//
+// Sytrf returns whether D is nonsingular. The factorization is computed
+// regardless of the singularity of D, but it should not be used to solve a
+// system of equations if ok is false.
+//
+// Dsytrf is not part of the lapack.Float64 interface and so calls to Sytrf are
+// always executed by the Gonum implementation.
+func Sytrf(a blas64.Symmetric, ipiv []int, work []float64, lwork int) (ok bool) {
+	return gonum.Implementation{}.Dsytrf(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, work, lwork)
+}
+
+// Sytrs solves a system of n linear equations A*X = B where A is an n×n
+// symmetric matrix and B is an n×nrhs matrix, using the factorization
+// A = U*D*Uᵀ or A = L*D*Lᵀ computed by Sytrf. a and ipiv contain the details
+// of the factorization as returned by Sytrf. On entry, B contains the
+// right-hand side matrix B, on return it contains the solution matrix X.
+//
+// Dsytrs is not part of the lapack.Float64 interface and so calls to Sytrs are
+// always executed by the Gonum implementation.
+func Sytrs(a blas64.Symmetric, ipiv []int, b blas64.General) {
+	gonum.Implementation{}.Dsytrs(a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
+// Sycon estimates the reciprocal of the condition number in the 1-norm of a
+// real symmetric matrix A using the factorization A = U*D*Uᵀ or A = L*D*Lᵀ
+// computed by Sytrf.
+//
+// anorm is the 1-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Sycon will panic
+// otherwise.
+//
+// iwork is a temporary data slice of length at least n and Sycon will panic
+// otherwise.
+//
+// Dsycon is not part of the lapack.Float64 interface and so calls to Sycon are
+// always executed by the Gonum implementation.
+func Sycon(a blas64.Symmetric, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return gonum.Implementation{}.Dsycon(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
+// Sytri computes the inverse of a real symmetric indefinite matrix A using the
+// factorization A = U*D*Uᵀ or A = L*D*Lᵀ computed by Sytrf. On return, the
+// triangle of a specified by a.Uplo contains the corresponding triangle of the
+// inverse of A.
+//
+// work is a temporary data slice of length at least n and Sytri will panic
+// otherwise.
+//
+// Sytri returns whether the inverse was computed successfully. If D is
+// singular, the inverse is not computed and a is not modified.
+//
+// Dsytri is not part of the lapack.Float64 interface and so calls to Sytri are
+// always executed by the Gonum implementation.
+func Sytri(a blas64.Symmetric, ipiv []int, work []float64) (ok bool) {
+	return gonum.Implementation{}.Dsytri(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, work)
+}
+
+// Lartg generates a plane rotation so that
+//
+//	[ cs sn] * [f] = [r]
+//	[-sn cs]   [g] = [0]
+//
+// where cs*cs + sn*sn = 1. Lartg is a more accurate version of BLAS Drotg that
+// uses scaling to avoid overflow or underflow. See the documentation of the
+// Gonum Dlartg for the choice of signs.
+//
+// Dlartg is not part of the lapack.Float64 interface and so calls to Lartg are
+// always executed by the Gonum implementation.
+func Lartg(f, g float64) (cs, sn, r float64) {
+	return gonum.Implementation{}.Dlartg(f, g)
+}
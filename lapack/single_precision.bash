@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+
+# Copyright ©2026 The Gonum Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+set -e
+
+# Names of the LAPACK routines that are renamed from D to S.
+ROUTINES=$(grep -ho '^func (\(impl \)\?Implementation) D[a-zA-Z0-9]*' gonum/d*.go | sed 's/.* //' | sort -u)
+
+RENAME=()
+for name in $ROUTINES Drotg; do
+	RENAME+=(-e "s/\b${name}\b/S${name:1}/g")
+done
+
+echo Generating lapack32/lapack32.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/lapack"; DO NOT EDIT.\n' > lapack32/lapack32.go
+cat lapack64/lapack64.go \
+| gofmt -r 'float64 -> float32' \
+\
+| sed "${RENAME[@]}" \
+| sed -e 's/blas64/blas32/g' \
+      -e 's/lapack64/lapack32/g' \
+      -e 's/Float64/Float32/g' \
+      -e 's/float64/float32/g' \
+      -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
+\
+>> lapack32/lapack32.go
+gofmt -w lapack32/lapack32.go
@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// This file contains helpers for testing the complex128 LAPACK routines.
+
+// nanGeneral128 allocates a new r×c complex general matrix filled with NaN
+// values.
+func nanGeneral128(r, c, stride int) cblas128.General {
+	if r < 0 || c < 0 {
+		panic("bad matrix size")
+	}
+	if r == 0 || c == 0 {
+		return cblas128.General{Rows: r, Cols: c, Stride: max(1, stride)}
+	}
+	if stride < c {
+		panic("bad stride")
+	}
+	data := make([]complex128, (r-1)*stride+c)
+	for i := range data {
+		data[i] = cmplx.NaN()
+	}
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   data,
+	}
+}
+
+// randomGeneral128 allocates a new r×c complex general matrix filled with
+// random numbers whose real and imaginary parts are normally distributed.
+// Out-of-range elements are filled with NaN values.
+func randomGeneral128(r, c, stride int, rnd *rand.Rand) cblas128.General {
+	ans := nanGeneral128(r, c, stride)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			ans.Data[i*ans.Stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	return ans
+}
+
+// randomHermitian128 allocates a new n×n complex Hermitian matrix filled with
+// random numbers. Both triangles of the matrix are set and out-of-range
+// elements are filled with NaN values.
+func randomHermitian128(n, stride int, rnd *rand.Rand) cblas128.General {
+	ans := nanGeneral128(n, n, stride)
+	for i := 0; i < n; i++ {
+		ans.Data[i*ans.Stride+i] = complex(rnd.NormFloat64(), 0)
+		for j := i + 1; j < n; j++ {
+			v := complex(rnd.NormFloat64(), rnd.NormFloat64())
+			ans.Data[i*ans.Stride+j] = v
+			ans.Data[j*ans.Stride+i] = cmplx.Conj(v)
+		}
+	}
+	return ans
+}
+
+// randomHPD128 allocates a new n×n complex Hermitian positive definite matrix
+// Bᴴ * B + n * I where B is a random matrix. Both triangles of the matrix are
+// set and out-of-range elements are filled with NaN values.
+func randomHPD128(n, stride int, rnd *rand.Rand) cblas128.General {
+	b := randomGeneral128(n, n, max(1, n), rnd)
+	ans := nanGeneral128(n, n, stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			ans.Data[i*ans.Stride+j] = 0
+		}
+		ans.Data[i*ans.Stride+i] = complex(float64(n), 0)
+	}
+	if n > 0 {
+		cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, b, b, 1, ans)
+	}
+	// Remove rounding errors from the imaginary part of the diagonal.
+	for i := 0; i < n; i++ {
+		ans.Data[i*ans.Stride+i] = complex(real(ans.Data[i*ans.Stride+i]), 0)
+	}
+	return ans
+}
+
+// randomUnitary returns an n×n random unitary matrix constructed as a product
+// of n Householder reflectors.
+func randomUnitary(n int, rnd *rand.Rand) cblas128.General {
+	q := eye128(n, n)
+	if n == 0 {
+		return q
+	}
+	v := make([]complex128, n)
+	qv := make([]complex128, n)
+	for j := 0; j < n; j++ {
+		var vnorm float64
+		for i := range v {
+			v[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+			vnorm += real(v[i])*real(v[i]) + imag(v[i])*imag(v[i])
+		}
+		// Compute Q * H where H = I - 2 * v * vᴴ / (vᴴ * v).
+		cblas128.Gemv(blas.NoTrans, 1, q, cblas128.Vector{N: n, Inc: 1, Data: v}, 0, cblas128.Vector{N: n, Inc: 1, Data: qv})
+		cblas128.Gerc(complex(-2/vnorm, 0), cblas128.Vector{N: n, Inc: 1, Data: qv}, cblas128.Vector{N: n, Inc: 1, Data: v}, q)
+	}
+	return q
+}
+
+// eye128 returns an n×n complex identity matrix with the given stride.
+func eye128(n, stride int) cblas128.General {
+	ans := cblas128.General{
+		Rows:   n,
+		Cols:   n,
+		Stride: max(1, stride),
+		Data:   make([]complex128, max(0, (n-1)*stride+n)),
+	}
+	for i := 0; i < n; i++ {
+		ans.Data[i*ans.Stride+i] = 1
+	}
+	return ans
+}
+
+// cloneGeneral128 allocates and returns an exact copy of the given complex
+// general matrix.
+func cloneGeneral128(a cblas128.General) cblas128.General {
+	c := a
+	c.Data = make([]complex128, len(a.Data))
+	copy(c.Data, a.Data)
+	return c
+}
+
+// copyGeneral128 copies the elements of src into dst. The matrices must have
+// the same dimensions.
+func copyGeneral128(dst, src cblas128.General) {
+	if dst.Rows != src.Rows || dst.Cols != src.Cols {
+		panic("bad input")
+	}
+	if src.Cols == 0 {
+		return
+	}
+	for i := 0; i < src.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+dst.Cols], src.Data[i*src.Stride:i*src.Stride+src.Cols])
+	}
+}
+
+// zeroGeneral128 returns an m×n complex matrix of zeros.
+func zeroGeneral128(m, n int) cblas128.General {
+	return cblas128.General{
+		Rows:   m,
+		Cols:   n,
+		Stride: max(1, n),
+		Data:   make([]complex128, m*n),
+	}
+}
+
+// distGeneral128 returns the largest absolute difference between the
+// corresponding elements of the complex matrices A and B. If A or B contains
+// NaN elements, distGeneral128 will return +inf.
+func distGeneral128(a, b cblas128.General) float64 {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		panic("bad input")
+	}
+	var dist float64
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			d := cmplx.Abs(a.Data[i*a.Stride+j] - b.Data[i*b.Stride+j])
+			if math.IsNaN(d) {
+				return math.Inf(1)
+			}
+			dist = math.Max(dist, d)
+		}
+	}
+	return dist
+}
+
+// normGeneral128 returns the largest absolute value of the elements of the
+// complex matrix A.
+func normGeneral128(a cblas128.General) float64 {
+	return distGeneral128(a, zeroGeneral128(a.Rows, a.Cols))
+}
+
+// mulGeneral128 returns op(A) * op(B) as a new matrix.
+func mulGeneral128(tA, tB blas.Transpose, a, b cblas128.General) cblas128.General {
+	m, k := a.Rows, a.Cols
+	if tA != blas.NoTrans {
+		m, k = k, m
+	}
+	n := b.Cols
+	if tB != blas.NoTrans {
+		n = b.Rows
+	}
+	c := zeroGeneral128(m, n)
+	if m == 0 || n == 0 || k == 0 {
+		return c
+	}
+	cblas128.Gemm(tA, tB, 1, a, b, 0, c)
+	return c
+}
+
+// residualUnitary returns the residual
+//
+//	|I - Q * Qᴴ|  if m < n or (m == n and rowwise == true),
+//	|I - Qᴴ * Q|  otherwise,
+//
+// where |⋅| is the largest absolute value of the matrix elements. It can be
+// used to check that the matrix Q has orthonormal rows or columns.
+func residualUnitary(q cblas128.General, rowwise bool) float64 {
+	m, n := q.Rows, q.Cols
+	if m == 0 || n == 0 {
+		return 0
+	}
+	var p cblas128.General
+	if m < n || (m == n && rowwise) {
+		p = mulGeneral128(blas.NoTrans, blas.ConjTrans, q, q)
+	} else {
+		p = mulGeneral128(blas.ConjTrans, blas.NoTrans, q, q)
+	}
+	return distGeneral128(p, eye128(p.Rows, p.Cols))
+}
+
+// constructQ128 returns the m×m unitary matrix Q defined by the first k
+// elementary reflectors stored in the columns of the m×n matrix A below the
+// diagonal as computed by Zgeqrf,
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}  with  H_i = I - tau[i] * v_i * v_iᴴ.
+func constructQ128(m, n, k int, a []complex128, lda int, tau []complex128) cblas128.General {
+	q := eye128(m, m)
+	if m == 0 {
+		return q
+	}
+	v := make([]complex128, m)
+	work := make([]complex128, m)
+	for i := 0; i < k; i++ {
+		for l := range v {
+			v[l] = 0
+		}
+		v[i] = 1
+		for l := i + 1; l < m; l++ {
+			v[l] = a[l*lda+i]
+		}
+		// Compute Q * H_i.
+		cblas128.Gemv(blas.NoTrans, 1, q, cblas128.Vector{N: m, Inc: 1, Data: v}, 0, cblas128.Vector{N: m, Inc: 1, Data: work})
+		cblas128.Gerc(-tau[i], cblas128.Vector{N: m, Inc: 1, Data: work}, cblas128.Vector{N: m, Inc: 1, Data: v}, q)
+	}
+	return q
+}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dbdsdcer interface {
+	Dbdsqrer
+	Dbdsdc(uplo blas.Uplo, compq lapack.SVDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool)
+}
+
+func DbdsdcTest(t *testing.T, impl Dbdsdcer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 10, 25, 26, 27, 50, 51, 64, 100, 133} {
+		for _, ld := range []int{max(1, n), n + 5} {
+			for typ := 0; typ <= 11; typ++ {
+				d, e := dstedcTridiag(n, typ, rnd)
+				for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+					for _, compq := range []lapack.SVDComp{lapack.SVDCompNone, lapack.SVDCompExplicit} {
+						dbdsdcTest(t, impl, uplo, compq, n, typ, d, e, ld)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dbdsdcTest(t *testing.T, impl Dbdsdcer, uplo blas.Uplo, compq lapack.SVDComp, n, typ int, d, e []float64, ld int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%c,compq=%c,n=%d,ld=%d,type=%d", uplo, compq, n, ld, typ)
+
+	dGot := make([]float64, n)
+	copy(dGot, d)
+	eGot := make([]float64, len(e))
+	copy(eGot, e)
+	u := nanGeneral(n, n, ld)
+	vt := nanGeneral(n, n, ld)
+	lwork := 4 * n
+	if compq == lapack.SVDCompExplicit {
+		lwork = 3*n*n + 4*n
+	}
+	work := nanSlice(lwork)
+	iwork := make([]int, 8*n)
+	for i := range iwork {
+		iwork[i] = -1
+	}
+
+	ok := impl.Dbdsdc(uplo, compq, n, dGot, eGot, u.Data, u.Stride, vt.Data, vt.Stride, work, iwork)
+	if !ok {
+		t.Errorf("%v: Dbdsdc failed", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(dGot))) {
+		t.Errorf("%v: singular values are not sorted in decreasing order", name)
+	}
+	for _, s := range dGot {
+		if s < 0 {
+			t.Errorf("%v: negative singular value", name)
+			break
+		}
+	}
+
+	// Compare the singular values with those computed by Dbdsqr.
+	dWant := make([]float64, n)
+	copy(dWant, d)
+	eWant := make([]float64, len(e))
+	copy(eWant, e)
+	if !impl.Dbdsqr(uplo, n, 0, 0, 0, dWant, eWant, nil, 1, nil, 1, nil, 1, make([]float64, 4*n)) {
+		t.Errorf("%v: computing reference singular values using Dbdsqr failed", name)
+		return
+	}
+	var diff float64
+	for i := range dGot {
+		diff = math.Max(diff, math.Abs(dGot[i]-dWant[i]))
+	}
+	dMax := math.Max(dlamchS, dWant[0])
+	if diff > tol*float64(n)*dMax {
+		t.Errorf("%v: singular value mismatch with Dbdsqr; |dGot-dWant|/|dWant|=%v", name, diff/dMax)
+	}
+
+	if compq == lapack.SVDCompNone {
+		return
+	}
+
+	// Check that U and VT are orthogonal.
+	if resid := residualOrthogonal(u, false); resid > tol*float64(n) {
+		t.Errorf("%v: U is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	if resid := residualOrthogonal(vt, true); resid > tol*float64(n) {
+		t.Errorf("%v: VT is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+
+	// Check that B - U S VT is small relative to B.
+	b := constructBidiagonal(uplo, n, d, e)
+	bnorm := dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride)
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		bi.Dger(n, n, -dGot[i], u.Data[i:], u.Stride, vt.Data[i*vt.Stride:], 1, b.Data, b.Stride)
+	}
+	rnorm := dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride)
+	resid := rnorm / math.Max(dlamchS, bnorm) / float64(n)
+	if bnorm == 0 {
+		resid = rnorm
+	}
+	if resid > tol {
+		t.Errorf("%v: unexpected result; |B - U S VT|/(|B| n)=%v", name, resid)
+	}
+}
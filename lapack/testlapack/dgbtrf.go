@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtf2er interface {
+	Dgbtf2(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+type Dgbtrfer interface {
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+// Dgbtf2Test tests Dgbtf2 by checking that the computed LU factorization of a
+// random band matrix A reproduces A.
+func Dgbtf2Test(t *testing.T, impl Dgbtf2er) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+			for _, kl := range []int{0, 1, 2, 3, 7} {
+				for _, ku := range []int{0, 1, 2, 3, 7} {
+					for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 3} {
+						dgbtrfTest(t, impl.Dgbtf2, epsilon(impl), rnd, m, n, kl, ku, ldab)
+					}
+				}
+			}
+		}
+	}
+}
+
+// DgbtrfTest tests Dgbtrf by checking that the computed LU factorization of a
+// random band matrix A reproduces A.
+func DgbtrfTest(t *testing.T, impl Dgbtrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+			for _, kl := range []int{0, 1, 2, 3, 7} {
+				for _, ku := range []int{0, 1, 2, 3, 7} {
+					for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 3} {
+						dgbtrfTest(t, impl.Dgbtrf, epsilon(impl), rnd, m, n, kl, ku, ldab)
+					}
+				}
+			}
+		}
+	}
+	// Check matrices that are large enough for the blocked code to be used.
+	for _, test := range []struct {
+		m, n, kl, ku int
+	}{
+		{150, 150, 32, 65},
+		{150, 150, 40, 70},
+		{200, 200, 64, 100},
+		{120, 180, 45, 80},
+		{180, 120, 45, 80},
+		{250, 250, 70, 66},
+	} {
+		m, n, kl, ku := test.m, test.n, test.kl, test.ku
+		for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 1 + 5} {
+			dgbtrfTest(t, impl.Dgbtrf, epsilon(impl), rnd, m, n, kl, ku, ldab)
+		}
+	}
+}
+
+func dgbtrfTest(t *testing.T, dgbtrf func(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) bool, eps float64, rnd *rand.Rand, m, n, kl, ku, ldab int) {
+	const tol = 30
+
+	name := fmt.Sprintf("m=%v,n=%v,kl=%v,ku=%v,ldab=%v", m, n, kl, ku, ldab)
+
+	ab := randBand(m, n, kl, ku, ldab, rnd)
+	a := bandToGeneral(m, n, kl, ku, ab, ldab)
+
+	mn := min(m, n)
+	ipiv := make([]int, mn)
+	ok := dgbtrf(m, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+	if mn == 0 {
+		return
+	}
+
+	for j, p := range ipiv {
+		if p < j || min(m-1, j+kl) < p {
+			t.Errorf("%v: invalid pivot ipiv[%v]=%v", name, j, p)
+			return
+		}
+	}
+
+	// Reconstruct A from its factorization and compute the residual
+	//  |P*L*U - A|_1 / (n * |A|_1 * eps).
+	lu := reconstructBandLU(m, n, kl, ku, ab, ldab, ipiv)
+	anorm := dlange(lapack.MaxColumnSum, m, n, a.Data, a.Stride)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			lu.Data[i*lu.Stride+j] -= a.Data[i*a.Stride+j]
+		}
+	}
+	resid := dlange(lapack.MaxColumnSum, m, n, lu.Data, lu.Stride)
+	if anorm > 0 {
+		resid /= anorm
+	}
+	resid /= float64(n) * eps
+	if resid > tol || math.IsNaN(resid) {
+		t.Errorf("%v: unexpected residual |P*L*U - A|, got %v", name, resid)
+	}
+}
+
+// randBand returns the storage of a random m×n band matrix with kl
+// sub-diagonals and ku super-diagonals suitable for use by Dgbtrf. The
+// elements outside the band and the elements reserved for fill-in are set to
+// NaN.
+func randBand(m, n, kl, ku, ldab int, rnd *rand.Rand) []float64 {
+	ab := nanSlice(max(0, min(m, n+kl)) * ldab)
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			ab[i*ldab+kl+j-i] = rnd.NormFloat64()
+		}
+	}
+	return ab
+}
+
+// bandToGeneral returns the dense representation of the m×n band matrix with
+// kl sub-diagonals and ku super-diagonals stored in ab.
+func bandToGeneral(m, n, kl, ku int, ab []float64, ldab int) blas64.General {
+	a := zeros(m, n, max(1, n))
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			a.Data[i*a.Stride+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	return a
+}
+
+// reconstructBandLU returns the dense m×n matrix P*L*U given the band LU
+// factorization computed by Dgbtrf.
+func reconstructBandLU(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) blas64.General {
+	mn := min(m, n)
+	// Extract the upper triangular factor U.
+	a := zeros(m, n, max(1, n))
+	for i := 0; i < mn; i++ {
+		for j := i; j < min(n, i+kl+ku+1); j++ {
+			a.Data[i*a.Stride+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	// Apply L_j and P_j in reverse order.
+	bi := blas64.Implementation()
+	for j := mn - 1; j >= 0; j-- {
+		for i := j + 1; i <= min(m-1, j+kl); i++ {
+			bi.Daxpy(n, ab[i*ldab+kl+j-i], a.Data[j*a.Stride:], 1, a.Data[i*a.Stride:], 1)
+		}
+		if p := ipiv[j]; p != j {
+			bi.Dswap(n, a.Data[j*a.Stride:], 1, a.Data[p*a.Stride:], 1)
+		}
+	}
+	return a
+}
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrser interface {
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
+
+	Dgbtrfer
+}
+
+// DgbtrsTest tests Dgbtrs by checking the residual of the computed solution of
+// a linear system with a random band matrix.
+func DgbtrsTest(t *testing.T, impl Dgbtrser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50, 150} {
+			for _, kl := range []int{0, 1, 2, (n + 1) / 4, 40} {
+				for _, ku := range []int{0, 1, 3, (3*n - 1) / 4, 70} {
+					for _, nrhs := range []int{0, 1, 2, 5} {
+						for _, ldb := range []int{max(1, nrhs), nrhs + 3} {
+							dgbtrsTest(t, impl, rnd, trans, n, kl, ku, nrhs, 2*kl+ku+1, ldb)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrsTest(t *testing.T, impl Dgbtrser, rnd *rand.Rand, trans blas.Transpose, n, kl, ku, nrhs, ldab, ldb int) {
+	const tol = 100
+
+	name := fmt.Sprintf("trans=%v,n=%v,kl=%v,ku=%v,nrhs=%v,ldab=%v,ldb=%v", transToString(trans), n, kl, ku, nrhs, ldab, ldb)
+
+	// Generate a random band matrix and compute its LU factorization.
+	ab := randBand(n, n, kl, ku, ldab, rnd)
+	a := bandToGeneral(n, n, kl, ku, ab, ldab)
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		if _, ok := impl.(Precisioner); ok {
+			// The random matrix may be exactly singular when rounded
+			// to a lower precision.
+			t.Logf("%v: skipping singular test matrix", name)
+			return
+		}
+		t.Fatalf("%v: bad test matrix, Dgbtrf failed", name)
+	}
+	abCopy := make([]float64, len(ab))
+	copy(abCopy, ab)
+
+	// Generate a random right-hand side.
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	bCopy := cloneGeneral(b)
+
+	impl.Dgbtrs(trans, n, kl, ku, nrhs, ab, ldab, ipiv, b.Data, b.Stride)
+
+	if !floats.Same(ab, abCopy) {
+		t.Errorf("%v: unexpected modification of ab", name)
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// Compute the residual
+	//  |op(A)*X - B|_1 / (n * |A|_1 * |X|_1 * eps).
+	x := b
+	r := bCopy
+	blas64.Gemm(trans, blas.NoTrans, 1, a, x, -1, r)
+	resid := dlange(lapack.MaxColumnSum, n, nrhs, r.Data, r.Stride)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride)
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, x.Data, x.Stride)
+	resid /= float64(n) * anorm * xnorm * epsilon(impl)
+	if resid > tol {
+		t.Errorf("%v: unexpected residual |op(A)*X - B|, got %v", name, resid)
+	}
+}
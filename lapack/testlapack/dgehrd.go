@@ -79,7 +79,7 @@ func DgehrdTest(t *testing.T, impl Dgehrder) {
 }
 
 func testDgehrd(t *testing.T, impl Dgehrder, n, ilo, ihi, extra int, optwork bool, rnd *rand.Rand) {
-	const tol = 1e-13
+	tol := scaleTol(impl, 1e-13)
 
 	a := randomGeneral(n, n, n+extra, rnd)
 	aCopy := a
@@ -18,7 +18,7 @@ type Dgelq2er interface {
 }
 
 func Dgelq2Test(t *testing.T, impl Dgelq2er) {
-	const tol = 1e-14
+	tol := scaleTol(impl, 1e-14)
 
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for c, test := range []struct {
@@ -17,7 +17,7 @@ type Dgelqfer interface {
 }
 
 func DgelqfTest(t *testing.T, impl Dgelqfer) {
-	const tol = 1e-12
+	tol := scaleTol(impl, 1e-12)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for c, test := range []struct {
 		m, n, lda int
@@ -174,7 +174,7 @@ func DgelsTest(t *testing.T, impl Dgelser) {
 			} else {
 				blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aMat, B, 0, ans2)
 			}
-			if !floats.EqualApprox(ans.Data, ans2.Data, 1e-12) {
+			if !floats.EqualApprox(ans.Data, ans2.Data, scaleTol(impl, 1e-12)) {
 				t.Errorf("Normal equations not satisfied")
 			}
 		}
@@ -31,9 +31,9 @@ func Dgeqp3Test(t *testing.T, impl Dgeqp3er) {
 }
 
 func dgeqp3Test(t *testing.T, impl Dgeqp3er, rnd *rand.Rand, m, n, lda int) {
-	const (
-		tol = 1e-14
+	tol := scaleTol(impl, 1e-14)
 
+	const (
 		all = iota
 		some
 		none
@@ -30,7 +30,7 @@ func Dgeqr2Test(t *testing.T, impl Dgeqr2er) {
 }
 
 func dgeqr2Test(t *testing.T, impl Dgeqr2er, rnd *rand.Rand, m, n, lda int) {
-	const tol = 1e-14
+	tol := scaleTol(impl, 1e-14)
 
 	name := fmt.Sprintf("m=%d,n=%d,lda=%d", m, n, lda)
 
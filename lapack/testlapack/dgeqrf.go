@@ -17,7 +17,7 @@ type Dgeqrfer interface {
 }
 
 func DgeqrfTest(t *testing.T, impl Dgeqrfer) {
-	const tol = 1e-12
+	tol := scaleTol(impl, 1e-12)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for c, test := range []struct {
 		m, n, lda int
@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesdder interface {
+	Dgesvder
+	Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
+}
+
+func DgesddTest(t *testing.T, impl Dgesdder, tol float64) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 30, 60, 150} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 30, 60, 150} {
+			for _, mtype := range []int{1, 2, 3, 4, 5} {
+				for _, jobz := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dgesddTest(t, impl, jobz, m, n, mtype, wl, tol, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+// dgesddTest tests a Dgesdd implementation on an m×n matrix A generated
+// according to mtype as:
+//   - the zero matrix if mtype == 1,
+//   - the identity matrix if mtype == 2,
+//   - a random matrix with a given condition number and singular values if mtype == 3, 4, or 5.
+//
+// It checks that
+//   - the computed columns of U and rows of Vᵀ are orthonormal,
+//   - U*Sigma*Vᵀ multiply back to A,
+//   - the singular values are non-negative, sorted in decreasing order and
+//     equal to those computed by Dgesvd.
+func dgesddTest(t *testing.T, impl Dgesdder, jobz lapack.SVDJob, m, n, mtype int, wl worklen, tol float64, rnd *rand.Rand) {
+	const tolOrtho = 1e-15
+
+	lda := n + 3
+	ldu := m + 5
+	ldvt := n + 7
+	minmn := min(m, n)
+
+	name := fmt.Sprintf("jobz=%v,m=%v,n=%v,work=%v,mtype=%v", svdJobString(jobz), m, n, wl, mtype)
+
+	a := make([]float64, m*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	var aNorm float64
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 1:
+		// Zero matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+	case 2:
+		// Identity matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+			if i < n {
+				a[i*lda+i] = 1
+			}
+		}
+		aNorm = 1
+	case 3, 4, 5:
+		// Random matrix with singular values spread linearly between
+		// aNorm/cond and aNorm.
+		s := make([]float64, minmn)
+		Dlatm1(s, 4, float64(max(1, minmn)), false, 1, rnd)
+		aNorm = 1
+		if mtype == 4 {
+			aNorm = smlnum
+		}
+		if mtype == 5 {
+			aNorm = bignum
+		}
+		floats.Scale(aNorm, s)
+		Dlagge(m, n, max(0, m-1), max(0, n-1), s, a, lda, rnd, make([]float64, m+n))
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	u := nanSlice(m * ldu)
+	vt := nanSlice(n * ldvt)
+	s := nanSlice(minmn)
+	iwork := make([]int, 8*minmn)
+
+	minwork := 1
+	if minmn > 0 {
+		switch jobz {
+		case lapack.SVDNone:
+			minwork = 3*minmn + max(max(m, n), 4*minmn)
+		case lapack.SVDOverwrite:
+			minwork = 4*minmn*minmn + 8*minmn + max(m, n) + m*n
+		default:
+			minwork = 4*minmn*minmn + 8*minmn + max(m, n)
+		}
+	}
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = minwork
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork)
+		lwork = (int(work[0]) + minwork) / 2
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork)
+		lwork = int(work[0])
+	}
+	work := nanSlice(max(1, lwork))
+
+	ok := impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, len(work), iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if minmn == 0 {
+		return
+	}
+
+	// Check that the singular values are decreasing and non-negative.
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+		t.Errorf("%v: singular values are not decreasing", name)
+	}
+	if floats.Min(s) < 0 {
+		t.Errorf("%v: some singular values are negative", name)
+	}
+
+	// Compare the singular values with those computed by Dgesvd.
+	sWant := make([]float64, minmn)
+	aWant := make([]float64, len(aCopy))
+	copy(aWant, aCopy)
+	lworkWant := max(1, max(5*minmn, 3*minmn+max(m, n)))
+	impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, aWant, lda, sWant, nil, 1, nil, 1, make([]float64, lworkWant), lworkWant)
+	for i := range s {
+		if math.Abs(s[i]-sWant[i]) > tol*float64(minmn)*math.Max(aNorm, 1) && aNorm != smlnum {
+			t.Errorf("%v: singular values differ from Dgesvd\n%v\n%v", name, s, sWant)
+			break
+		}
+	}
+	if jobz == lapack.SVDNone {
+		return
+	}
+
+	// Extract the singular vectors.
+	ucol := minmn
+	if jobz == lapack.SVDAll || (jobz == lapack.SVDOverwrite && m < n) {
+		ucol = m
+	}
+	vrow := minmn
+	if jobz == lapack.SVDAll || (jobz == lapack.SVDOverwrite && m >= n) {
+		vrow = n
+	}
+	if jobz == lapack.SVDOverwrite {
+		if m >= n {
+			u, ldu = a, lda
+		} else {
+			vt, ldvt = a, lda
+		}
+	}
+
+	// Check that U has orthonormal columns and VT has orthonormal rows.
+	q := blas64.General{Rows: m, Cols: ucol, Data: u, Stride: ldu}
+	if resid := residualOrthogonal(q, false); resid > tolOrtho*float64(m) {
+		t.Errorf("%v: columns of U are not orthogonal; resid=%v, want<=%v", name, resid, tolOrtho*float64(m))
+	}
+	q = blas64.General{Rows: vrow, Cols: n, Data: vt, Stride: ldvt}
+	if resid := residualOrthogonal(q, true); resid > tolOrtho*float64(n) {
+		t.Errorf("%v: rows of VT are not orthogonal; resid=%v, want<=%v", name, resid, tolOrtho*float64(n))
+	}
+
+	// Check that U, S and VT multiply back to A.
+	if resid := svdFullResidual(m, n, aNorm, aCopy, lda, u, ldu, s, vt, ldvt); resid > tol {
+		t.Errorf("%v: original matrix not recovered, |A - U*D*VT|=%v", name, resid)
+	}
+}
@@ -22,6 +22,7 @@ type Dgesvder interface {
 }
 
 func DgesvdTest(t *testing.T, impl Dgesvder, tol float64) {
+	tol = scaleTol(impl, tol)
 	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 150, 300} {
 		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 150} {
 			for _, mtype := range []int{1, 2, 3, 4, 5} {
@@ -45,7 +46,7 @@ func DgesvdTest(t *testing.T, impl Dgesvder, tol float64) {
 // Then all combinations of partial SVD results are computed and checked whether
 // they match the full SVD result.
 func dgesvdTest(t *testing.T, impl Dgesvder, m, n, mtype int, tol float64) {
-	const tolOrtho = 1e-15
+	tolOrtho := scaleTol(impl, 1e-15)
 
 	rnd := rand.New(rand.NewPCG(1, 1))
 
@@ -99,10 +100,10 @@ func dgesvdTest(t *testing.T, impl Dgesvder, m, n, mtype int, tol float64) {
 		// Decide scale factor for the singular values based on the matrix type.
 		aNorm = 1
 		if mtype == 4 {
-			aNorm = smlnum
+			aNorm = safeMin(impl) / (dlamchB * epsilon(impl))
 		}
 		if mtype == 5 {
-			aNorm = bignum
+			aNorm = dlamchB * epsilon(impl) / safeMin(impl)
 		}
 		// Scale singular values so that the maximum singular value is
 		// equal to aNorm (we know that the singular values are
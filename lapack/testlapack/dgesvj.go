@@ -0,0 +1,335 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesvjer interface {
+	Dbdsqrer
+	Dgesvder
+	Dgesvj(joba lapack.MatrixType, jobu lapack.LeftSVJob, jobv lapack.RightSVJob, m, n int, a []float64, lda int, sva []float64, mv int, v []float64, ldv int, work []float64, lwork int) (ok bool)
+}
+
+func DgesvjTest(t *testing.T, impl Dgesvjer, tol float64) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 30, 60} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 30, 60} {
+			if n > m {
+				continue
+			}
+			for _, joba := range []lapack.MatrixType{lapack.General, lapack.UpperTri, lapack.LowerTri} {
+				for _, mtype := range []int{1, 2, 3, 4, 5, 6} {
+					dgesvjTest(t, impl, joba, m, n, mtype, tol, rnd)
+				}
+			}
+			dgesvjGradedTest(t, impl, m, n, rnd)
+		}
+	}
+}
+
+// dgesvjTest tests a Dgesvj implementation on an m×n matrix A generated
+// according to mtype as:
+//   - the zero matrix if mtype == 1,
+//   - the identity matrix if mtype == 2,
+//   - a random matrix with a given condition number and singular values if mtype == 3, 4, or 5,
+//   - a random rank-deficient matrix if mtype == 6.
+//
+// If joba is lapack.UpperTri or lapack.LowerTri, the elements of A outside the
+// triangle are set to zero.
+//
+// It checks that
+//   - U has orthonormal columns and V is orthogonal,
+//   - U*Sigma*Vᵀ multiply back to A,
+//   - the singular values are non-negative, sorted in decreasing order and
+//     equal to those computed by Dgesvd,
+//   - the number of non-zero singular values is returned in work[1],
+//   - the results with a given orthogonality tolerance, without singular
+//     vectors and with the rotations applied to a given matrix are
+//     consistent with those of the full decomposition.
+func dgesvjTest(t *testing.T, impl Dgesvjer, joba lapack.MatrixType, m, n, mtype int, tol float64, rnd *rand.Rand) {
+	const tolOrtho = 1e-15
+
+	lda := n + 3
+	ldv := n + 5
+
+	name := fmt.Sprintf("joba=%c,m=%v,n=%v,mtype=%v", joba, m, n, mtype)
+
+	a := make([]float64, m*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 1:
+		// Zero matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+	case 2:
+		// Identity matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+			if i < n {
+				a[i*lda+i] = 1
+			}
+		}
+	case 3, 4, 5:
+		// Random matrix with singular values spread linearly between
+		// aNorm/cond and aNorm.
+		s := make([]float64, n)
+		Dlatm1(s, 4, float64(max(1, n)), false, 1, rnd)
+		aNorm := 1.0
+		if mtype == 4 {
+			aNorm = smlnum
+		}
+		if mtype == 5 {
+			aNorm = bignum
+		}
+		floats.Scale(aNorm, s)
+		Dlagge(m, n, max(0, m-1), max(0, n-1), s, a, lda, rnd, make([]float64, m+n))
+	case 6:
+		// Random matrix with half of its singular values equal to zero.
+		s := make([]float64, n)
+		Dlatm1(s, 4, float64(max(1, n)), false, 1, rnd)
+		for i := (n + 1) / 2; i < n; i++ {
+			s[i] = 0
+		}
+		Dlagge(m, n, max(0, m-1), max(0, n-1), s, a, lda, rnd, make([]float64, m+n))
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if (joba == lapack.UpperTri && i > j) || (joba == lapack.LowerTri && i < j) {
+				a[i*lda+j] = 0
+			}
+		}
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	aNorm := dlange(lapack.MaxColumnSum, m, n, a, lda)
+
+	// Check the workspace query.
+	minwork := max(6, m+n)
+	work := nanSlice(1)
+	impl.Dgesvj(joba, lapack.LeftSVCompute, lapack.RightSVCompute, m, n, a, lda, nil, 0, nil, ldv, work, -1)
+	if int(work[0]) != minwork {
+		t.Errorf("%v: unexpected workspace length; got %v, want %v", name, work[0], minwork)
+	}
+
+	sva := nanSlice(n)
+	v := nanSlice(n * ldv)
+	work = nanSlice(minwork)
+	ok := impl.Dgesvj(joba, lapack.LeftSVCompute, lapack.RightSVCompute, m, n, a, lda, sva, 0, v, ldv, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+	stats := make([]float64, 6)
+	copy(stats, work)
+
+	// Check that the scaled singular values are decreasing and non-negative.
+	if !sort.IsSorted(sort.Reverse(sort.Float64Slice(sva))) {
+		t.Errorf("%v: singular values are not decreasing", name)
+	}
+	if floats.Min(sva) < 0 {
+		t.Errorf("%v: some singular values are negative", name)
+	}
+	s := make([]float64, n)
+	for i, v := range sva {
+		s[i] = work[0] * v
+	}
+
+	// Check the number of non-zero singular values.
+	rank := n
+	for rank > 0 && sva[rank-1] == 0 {
+		rank--
+	}
+	if int(work[1]) != rank {
+		t.Errorf("%v: unexpected number of non-zero singular values; got %v, want %v", name, work[1], rank)
+	}
+	if mtype == 1 && rank != 0 {
+		t.Errorf("%v: unexpected non-zero singular values for zero matrix", name)
+	}
+
+	// Compare the singular values with those computed by Dgesvd.
+	sWant := make([]float64, n)
+	aWant := make([]float64, len(aCopy))
+	copy(aWant, aCopy)
+	lwork := max(5*n, 3*n+m)
+	impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, aWant, lda, sWant, nil, 1, nil, 1, make([]float64, lwork), lwork)
+	if sWant[0] >= dlamchS {
+		for i := range s {
+			if math.Abs(s[i]-sWant[i]) > tol*float64(n)*sWant[0] {
+				t.Errorf("%v: singular values differ from Dgesvd\n%v\n%v", name, s, sWant)
+				break
+			}
+		}
+	}
+
+	// Check that the columns of U corresponding to non-zero singular values
+	// are orthonormal and that V is orthogonal. The columns of U are
+	// orthogonal only up to the convergence threshold sqrt(m)*eps of the
+	// Jacobi method.
+	tolU := 10 * float64(n) * math.Sqrt(float64(m)) * dlamchE
+	q := blas64.General{Rows: m, Cols: rank, Data: a, Stride: lda}
+	if resid := residualOrthogonal(q, false); resid > tolU {
+		t.Errorf("%v: columns of U are not orthogonal; resid=%v, want<=%v", name, resid, tolU)
+	}
+	q = blas64.General{Rows: n, Cols: n, Data: v, Stride: ldv}
+	if resid := residualOrthogonal(q, false); resid > tolOrtho*float64(n) {
+		t.Errorf("%v: V is not orthogonal; resid=%v, want<=%v", name, resid, tolOrtho*float64(n))
+	}
+
+	// Check that U, S and Vᵀ multiply back to A.
+	vt := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			vt[i*n+j] = v[j*ldv+i]
+		}
+	}
+	if resid := svdFullResidual(m, n, aNorm, aCopy, lda, a, lda, s, vt, n); resid > tol {
+		t.Errorf("%v: original matrix not recovered, |A - U*D*VT|=%v", name, resid)
+	}
+
+	// Check that an orthogonality tolerance equal to the default one gives
+	// the same results.
+	if m > 1 {
+		aTol := make([]float64, len(aCopy))
+		copy(aTol, aCopy)
+		svaTol := nanSlice(n)
+		vTol := nanSlice(n * ldv)
+		work := nanSlice(minwork)
+		work[0] = math.Sqrt(float64(m))
+		ok := impl.Dgesvj(joba, lapack.LeftSVTol, lapack.RightSVCompute, m, n, aTol, lda, svaTol, 0, vTol, ldv, work, len(work))
+		if !ok {
+			t.Errorf("%v: unexpected failure with orthogonality tolerance", name)
+		} else if !floats.Same(svaTol, sva) || !floats.Same(aTol, a) || !floats.Same(vTol, v) || !floats.Same(work[:6], stats) {
+			t.Errorf("%v: results with orthogonality tolerance differ", name)
+		}
+	}
+
+	// Check that the singular values do not depend on whether the singular
+	// vectors are computed.
+	aNone := make([]float64, len(aCopy))
+	copy(aNone, aCopy)
+	svaNone := nanSlice(n)
+	work = nanSlice(minwork)
+	ok = impl.Dgesvj(joba, lapack.LeftSVNone, lapack.RightSVNone, m, n, aNone, lda, svaNone, 0, nil, 1, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure without singular vectors", name)
+	} else {
+		for i, v := range svaNone {
+			if math.Abs(work[0]*v-s[i]) > tol*float64(n)*s[0] {
+				t.Errorf("%v: singular values depend on the computed singular vectors\n%v\n%v", name, floats.ScaleTo(make([]float64, n), work[0], svaNone), s)
+				break
+			}
+		}
+	}
+
+	// Check that the rotations applied to a given mv×n matrix X give X*V.
+	for _, mv := range []int{0, 1, n + 2} {
+		ldx := n + 1
+		x := make([]float64, max(0, (mv-1)*ldx+n))
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		xv := make([]float64, mv*n)
+		if mv > 0 {
+			blas64.Implementation().Dgemm(blas.NoTrans, blas.NoTrans, mv, n, n, 1, x, ldx, v, ldv, 0, xv, n)
+		}
+		aApply := make([]float64, len(aCopy))
+		copy(aApply, aCopy)
+		svaApply := nanSlice(n)
+		work := nanSlice(minwork)
+		ok := impl.Dgesvj(joba, lapack.LeftSVNone, lapack.RightSVApply, m, n, aApply, lda, svaApply, mv, x, ldx, work, len(work))
+		if !ok {
+			t.Errorf("%v,mv=%v: unexpected failure when applying rotations", name, mv)
+			continue
+		}
+		if !floats.Same(svaApply, sva) {
+			t.Errorf("%v,mv=%v: singular values differ when applying rotations", name, mv)
+		}
+		for i := 0; i < mv; i++ {
+			for j := 0; j < n; j++ {
+				if math.Abs(x[i*ldx+j]-xv[i*n+j]) > tol*float64(n) {
+					t.Errorf("%v,mv=%v: unexpected result of applying rotations", name, mv)
+					return
+				}
+			}
+		}
+	}
+}
+
+// dgesvjGradedTest checks that Dgesvj computes the singular values of a
+// matrix with badly scaled columns to high relative accuracy. The m×n test
+// matrix is A = Q*B, where Q has orthonormal columns and B is an upper
+// bidiagonal matrix whose columns are graded over many orders of magnitude.
+// The reference singular values of B are computed by Dbdsqr which is accurate
+// to high relative accuracy for bidiagonal matrices.
+func dgesvjGradedTest(t *testing.T, impl Dgesvjer, m, n int, rnd *rand.Rand) {
+	const tol = 1e-12
+
+	if n == 0 {
+		return
+	}
+
+	name := fmt.Sprintf("m=%v,n=%v,graded", m, n)
+
+	// Generate the graded upper bidiagonal matrix B.
+	d := make([]float64, n)
+	e := make([]float64, n-1)
+	for j := range d {
+		scale := math.Pow(10, -40*float64(j)/float64(max(1, n-1)))
+		d[j] = scale * (1 + rnd.Float64())
+		if j > 0 {
+			e[j-1] = scale * (rnd.Float64() - 0.5)
+		}
+	}
+	b := constructBidiagonal(blas.Upper, n, d, e)
+
+	// Compute A = Q*B.
+	lda := n
+	a := make([]float64, m*lda)
+	q := randomOrthogonal(m, rnd)
+	bi := blas64.Implementation()
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, q.Data, q.Stride, b.Data, b.Stride, 0, a, lda)
+
+	sva := nanSlice(n)
+	work := nanSlice(max(6, m+n))
+	ok := impl.Dgesvj(lapack.General, lapack.LeftSVNone, lapack.RightSVNone, m, n, a, lda, sva, 0, nil, 1, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+
+	if !impl.Dbdsqr(blas.Upper, n, 0, 0, 0, d, e, nil, 1, nil, 1, nil, 1, make([]float64, 4*n)) {
+		t.Errorf("%v: computing reference singular values using Dbdsqr failed", name)
+		return
+	}
+	for i, v := range sva {
+		s := work[0] * v
+		if math.Abs(s-d[i]) > tol*d[i] {
+			t.Errorf("%v: singular value %d not computed to high relative accuracy; got %v, want %v", name, i, s, d[i])
+		}
+	}
+}
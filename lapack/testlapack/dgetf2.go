@@ -49,7 +49,7 @@ func Dgetf2Test(t *testing.T, impl Dgetf2er) {
 			ipiv[i] = rnd.Int()
 		}
 		ok := impl.Dgetf2(m, n, a, lda, ipiv)
-		checkPLU(t, ok, m, n, lda, ipiv, a, aCopy, 1e-14, true)
+		checkPLU(t, ok, m, n, lda, ipiv, a, aCopy, scaleTol(impl, 1e-14), true)
 	}
 
 	// Test with singular matrices (random matrices are almost surely non-singular).
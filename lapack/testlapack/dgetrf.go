@@ -60,6 +60,6 @@ func DgetrfTest(t *testing.T, impl Dgetrfer) {
 		aCopy := make([]float64, len(a))
 		copy(aCopy, a)
 		ok := impl.Dgetrf(m, n, a, lda, ipiv)
-		checkPLU(t, ok, m, n, lda, ipiv, a, aCopy, 1e-10, false)
+		checkPLU(t, ok, m, n, lda, ipiv, a, aCopy, scaleTol(impl, 1e-10), false)
 	}
 }
@@ -18,7 +18,7 @@ type Dgetrier interface {
 }
 
 func DgetriTest(t *testing.T, impl Dgetrier) {
-	const tol = 1e-13
+	tol := scaleTol(impl, 1e-13)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	bi := blas64.Implementation()
 	for _, test := range []struct {
@@ -105,7 +105,7 @@ func DgetrsTest(t *testing.T, impl Dgetrser) {
 			}
 			copy(tmp.Data, bCopy)
 			blas64.Gemm(trans, blas.NoTrans, 1, A, X, 0, B)
-			if !floats.EqualApprox(tmp.Data, bCopy, test.tol) {
+			if !floats.EqualApprox(tmp.Data, bCopy, scaleTol(impl, test.tol)) {
 				t.Errorf("Linear solve mismatch. trans = %v, n = %v, nrhs = %v, lda = %v, ldb = %v", trans, n, nrhs, lda, ldb)
 			}
 		}
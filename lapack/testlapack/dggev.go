@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggever interface {
+	Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int, ok bool)
+}
+
+func DggevTest(t *testing.T, impl Dggever) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+		for _, ld := range []int{max(1, n), n + 5} {
+			for _, singular := range []bool{false, true} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					testDggev(t, impl, rnd, n, ld, singular, wl)
+				}
+			}
+		}
+	}
+}
+
+func testDggev(t *testing.T, impl Dggever, rnd *rand.Rand, n, ld int, singular bool, wl worklen) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("Case n=%v,ld=%v,singular=%v,work=%v", n, ld, singular, wl)
+
+	a := randomGeneral(n, n, ld, rnd)
+	b := randomGeneral(n, n, ld, rnd)
+	if singular && n > 1 {
+		// Make B singular by setting its last column equal to its first.
+		for i := 0; i < n; i++ {
+			b.Data[i*b.Stride+n-1] = b.Data[i*b.Stride]
+		}
+	}
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 8*n)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, nil, ld, nil, ld, nil, nil, nil, nil, ld, nil, ld, work, -1)
+		lwork = int(work[0])
+	}
+	work := make([]float64, lwork)
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	alphar := nanSlice(n)
+	alphai := nanSlice(n)
+	beta := nanSlice(n)
+	vl := nanGeneral(n, n, ld)
+	vr := nanGeneral(n, n, ld)
+	first, ok := impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+		alphar, alphai, beta, vl.Data, vl.Stride, vr.Data, vr.Stride, work, len(work))
+	if first != 0 || !ok {
+		t.Errorf("%v: unexpected failure, first=%v, ok=%v", name, first, ok)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	for j := 0; j < n; j++ {
+		if alphai[j] <= 0 {
+			continue
+		}
+		if j == n-1 {
+			t.Errorf("%v: last eigenvalue has positive imaginary part", name)
+			break
+		}
+		l1 := complex(alphar[j], alphai[j]) / complex(beta[j], 0)
+		l2 := complex(alphar[j+1], alphai[j+1]) / complex(beta[j+1], 0)
+		if cmplx.Abs(l2-cmplx.Conj(l1)) > tol*cmplx.Abs(l1) {
+			t.Errorf("%v: eigenvalue %v is not followed by its complex conjugate", name, j)
+		}
+	}
+
+	if resid := residualGenRightEV(a, b, vr, alphar, alphai, beta); resid > tol {
+		t.Errorf("%v: unexpected residual of right eigenvectors; got %v, want <= %v", name, resid, tol)
+	}
+	if resid := residualGenLeftEV(a, b, vl, alphar, alphai, beta); resid > tol {
+		t.Errorf("%v: unexpected residual of left eigenvectors; got %v, want <= %v", name, resid, tol)
+	}
+
+	// Compute only the eigenvalues and check that they match.
+	aCopy = cloneGeneral(a)
+	bCopy = cloneGeneral(b)
+	alpharEV := nanSlice(n)
+	alphaiEV := nanSlice(n)
+	betaEV := nanSlice(n)
+	first, ok = impl.Dggev(lapack.LeftEVNone, lapack.RightEVNone, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+		alpharEV, alphaiEV, betaEV, nil, 1, nil, 1, work, len(work))
+	if first != 0 || !ok {
+		t.Errorf("%v: unexpected failure when computing only eigenvalues, first=%v, ok=%v", name, first, ok)
+		return
+	}
+	var ev []complex128
+	for j := range betaEV {
+		if betaEV[j] > 1e-8 {
+			ev = append(ev, complex(alpharEV[j], alphaiEV[j])/complex(betaEV[j], 0))
+		}
+	}
+	for j := range beta {
+		if beta[j] <= 1e-8 {
+			continue
+		}
+		want := complex(alphar[j], alphai[j]) / complex(beta[j], 0)
+		if found, _ := containsComplex(ev, want, 1e-8*max(1, cmplx.Abs(want))); !found {
+			t.Errorf("%v: eigenvalue %v not found when computing only eigenvalues", name, want)
+		}
+	}
+
+	// Compute only the right eigenvectors and check that they match.
+	aCopy = cloneGeneral(a)
+	bCopy = cloneGeneral(b)
+	vrOnly := nanGeneral(n, n, ld)
+	impl.Dggev(lapack.LeftEVNone, lapack.RightEVCompute, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+		alpharEV, alphaiEV, betaEV, nil, 1, vrOnly.Data, vrOnly.Stride, work, len(work))
+	if !equalApproxGeneral(vrOnly, vr, tol) {
+		t.Errorf("%v: right eigenvectors computed alone do not match", name)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dggglmer interface {
+	Dggglm(n, m, p int, a []float64, lda int, b []float64, ldb int, d, x, y, work []float64, lwork int) bool
+
+	Dgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) bool
+}
+
+func DggglmTest(t *testing.T, impl Dggglmer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 40} {
+		for _, m := range []int{0, 1, 2, 4, 9, 35} {
+			for _, p := range []int{0, 1, 3, 8, 30, 45} {
+				if m > n || n > m+p {
+					continue
+				}
+				for _, ld := range []int{0, 4} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dggglmTest(t, impl, rnd, n, m, p, m+ld, p+ld, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dggglmTest(t *testing.T, impl Dggglmer, rnd *rand.Rand, n, m, p, lda, ldb int, wl worklen) {
+	const tol = 1e-10
+
+	name := fmt.Sprintf("n=%d,m=%d,p=%d,lda=%d,ldb=%d,work=%v", n, m, p, lda, ldb, wl)
+
+	lda = max(1, lda)
+	ldb = max(1, ldb)
+	a := randomGeneral(n, m, lda, rnd)
+	aCopy := cloneGeneral(a)
+	b := randomGeneral(n, p, ldb, rnd)
+	bCopy := cloneGeneral(b)
+	d := randomSlice(n, rnd)
+	dCopy := make([]float64, n)
+	copy(dCopy, d)
+	x := nanSlice(m)
+	y := nanSlice(p)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, n+m+p)
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dggglm(n, m, p, a.Data, a.Stride, b.Data, b.Stride, d, x, y, work, -1)
+		lwork = (int(work[0]) + max(1, n+m+p)) / 2
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dggglm(n, m, p, a.Data, a.Stride, b.Data, b.Stride, d, x, y, work, -1)
+		lwork = int(work[0])
+	}
+	work := randomSlice(lwork, rnd)
+
+	ok := impl.Dggglm(n, m, p, a.Data, a.Stride, b.Data, b.Stride, d, x, y, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		for i, v := range y {
+			if v != 0 {
+				t.Errorf("%v: unexpected y[%d]; got %v, want 0", name, i, v)
+			}
+		}
+		return
+	}
+
+	// Compute the reference solution from the KKT system
+	//  [ A  B*Bᵀ ] [ x ] = [ d ]
+	//  [ 0   Aᵀ  ] [ λ ]   [ 0 ],
+	// with y = Bᵀ*λ.
+	k := m + n
+	kkt := zeros(k, k, k)
+	if p > 0 {
+		bbt := blas64.General{Rows: n, Cols: n, Stride: k, Data: kkt.Data[m:]}
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, bCopy, bCopy, 0, bbt)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			v := aCopy.Data[i*aCopy.Stride+j]
+			kkt.Data[i*k+j] = v
+			kkt.Data[(n+j)*k+m+i] = v
+		}
+	}
+	rhs := make([]float64, k)
+	copy(rhs, dCopy)
+	ipiv := make([]int, k)
+	if !impl.Dgesv(k, 1, kkt.Data, kkt.Stride, ipiv, rhs, 1) {
+		t.Errorf("%v: reference KKT system is singular", name)
+		return
+	}
+	wantX := rhs[:m]
+	if !floats.EqualApprox(x, wantX, tol*floats.Norm(wantX, math.Inf(1))+tol) {
+		t.Errorf("%v: unexpected x; got %v, want %v", name, x, wantX)
+	}
+	wantY := make([]float64, p)
+	if p > 0 {
+		blas64.Gemv(blas.Trans, 1, bCopy, blas64.Vector{N: n, Data: rhs[m:], Inc: 1}, 0, blas64.Vector{N: p, Data: wantY, Inc: 1})
+	}
+	if !floats.EqualApprox(y, wantY, tol*floats.Norm(wantY, math.Inf(1))+tol) {
+		t.Errorf("%v: unexpected y; got %v, want %v", name, y, wantY)
+	}
+
+	// Check that the constraint d = A*x + B*y is satisfied.
+	if m > 0 {
+		blas64.Gemv(blas.NoTrans, 1, aCopy, blas64.Vector{N: m, Data: x, Inc: 1}, -1, blas64.Vector{N: n, Data: dCopy, Inc: 1})
+	} else {
+		floats.Scale(-1, dCopy)
+	}
+	if p > 0 {
+		blas64.Gemv(blas.NoTrans, 1, bCopy, blas64.Vector{N: p, Data: y, Inc: 1}, 1, blas64.Vector{N: n, Data: dCopy, Inc: 1})
+	}
+	if resid := floats.Norm(dCopy, math.Inf(1)); resid > tol {
+		t.Errorf("%v: |A*x + B*y - d|=%v, want<=%v", name, resid, tol)
+	}
+}
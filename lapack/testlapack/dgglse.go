@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dgglser interface {
+	Dgglse(m, n, p int, a []float64, lda int, b []float64, ldb int, c, d, x, work []float64, lwork int) bool
+
+	Dgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) bool
+}
+
+func DgglseTest(t *testing.T, impl Dgglser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 5, 10, 40} {
+		for _, n := range []int{0, 1, 2, 4, 9, 35} {
+			for _, p := range []int{0, 1, 3, 8, 30} {
+				if p > n || n > m+p {
+					continue
+				}
+				for _, ld := range []int{0, 4} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dgglseTest(t, impl, rnd, m, n, p, n+ld, n+ld, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgglseTest(t *testing.T, impl Dgglser, rnd *rand.Rand, m, n, p, lda, ldb int, wl worklen) {
+	const tol = 1e-10
+
+	name := fmt.Sprintf("m=%d,n=%d,p=%d,lda=%d,ldb=%d,work=%v", m, n, p, lda, ldb, wl)
+
+	lda = max(1, lda)
+	ldb = max(1, ldb)
+	a := randomGeneral(m, n, lda, rnd)
+	aCopy := cloneGeneral(a)
+	b := randomGeneral(p, n, ldb, rnd)
+	bCopy := cloneGeneral(b)
+	c := randomSlice(m, rnd)
+	cCopy := make([]float64, m)
+	copy(cCopy, c)
+	d := randomSlice(p, rnd)
+	dCopy := make([]float64, p)
+	copy(dCopy, d)
+	x := nanSlice(n)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, m+n+p)
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dgglse(m, n, p, a.Data, a.Stride, b.Data, b.Stride, c, d, x, work, -1)
+		lwork = (int(work[0]) + max(1, m+n+p)) / 2
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dgglse(m, n, p, a.Data, a.Stride, b.Data, b.Stride, c, d, x, work, -1)
+		lwork = int(work[0])
+	}
+	work := randomSlice(lwork, rnd)
+
+	ok := impl.Dgglse(m, n, p, a.Data, a.Stride, b.Data, b.Stride, c, d, x, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Compute the reference solution from the KKT system
+	//  [ Aᵀ*A Bᵀ ] [ x ] = [ Aᵀ*c ]
+	//  [  B   0  ] [ λ ]   [  d   ].
+	k := n + p
+	kkt := zeros(k, k, k)
+	rhs := make([]float64, k)
+	if m > 0 {
+		ata := blas64.General{Rows: n, Cols: n, Stride: k, Data: kkt.Data}
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, aCopy, aCopy, 0, ata)
+		blas64.Gemv(blas.Trans, 1, aCopy, blas64.Vector{N: m, Data: cCopy, Inc: 1}, 0, blas64.Vector{N: n, Data: rhs, Inc: 1})
+	}
+	for i := 0; i < p; i++ {
+		for j := 0; j < n; j++ {
+			v := bCopy.Data[i*bCopy.Stride+j]
+			kkt.Data[(n+i)*k+j] = v
+			kkt.Data[j*k+n+i] = v
+		}
+		rhs[n+i] = dCopy[i]
+	}
+	ipiv := make([]int, k)
+	if !impl.Dgesv(k, 1, kkt.Data, kkt.Stride, ipiv, rhs, 1) {
+		t.Errorf("%v: reference KKT system is singular", name)
+		return
+	}
+	want := rhs[:n]
+	if !floats.EqualApprox(x, want, tol*floats.Norm(want, math.Inf(1))+tol) {
+		t.Errorf("%v: unexpected solution; got %v, want %v", name, x, want)
+	}
+
+	// Check that the constraint is satisfied.
+	if p > 0 {
+		blas64.Gemv(blas.NoTrans, 1, bCopy, blas64.Vector{N: n, Data: x, Inc: 1}, -1, blas64.Vector{N: p, Data: dCopy, Inc: 1})
+		if resid := floats.Norm(dCopy, math.Inf(1)); resid > tol {
+			t.Errorf("%v: |B*x - d|=%v, want<=%v", name, resid, tol)
+		}
+	}
+
+	// Check that the residual sum of squares is returned in c[n-p:m].
+	if m > 0 {
+		blas64.Gemv(blas.NoTrans, 1, aCopy, blas64.Vector{N: n, Data: x, Inc: 1}, -1, blas64.Vector{N: m, Data: cCopy, Inc: 1})
+		want := floats.Norm(cCopy, 2)
+		got := floats.Norm(c[n-p:], 2)
+		if math.Abs(got-want) > tol*(1+want) {
+			t.Errorf("%v: unexpected residual norm; got %v, want %v", name, got, want)
+		}
+	}
+}
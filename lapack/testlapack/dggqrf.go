@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggqrfer interface {
+	Dggqrf(n, m, p int, a []float64, lda int, taua []float64, b []float64, ldb int, taub, work []float64, lwork int)
+}
+
+func DggqrfTest(t *testing.T, impl Dggqrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 75} {
+		for _, m := range []int{0, 1, 2, 4, 9, 80} {
+			for _, p := range []int{0, 1, 3, 6, 11, 70} {
+				for _, ld := range []int{0, 5} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dggqrfTest(t, impl, rnd, n, m, p, m+ld, p+ld, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dggqrfTest(t *testing.T, impl Dggqrfer, rnd *rand.Rand, n, m, p, lda, ldb int, wl worklen) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("n=%d,m=%d,p=%d,lda=%d,ldb=%d,work=%v", n, m, p, lda, ldb, wl)
+
+	lda = max(1, lda)
+	ldb = max(1, ldb)
+	a := randomGeneral(n, m, lda, rnd)
+	aCopy := cloneGeneral(a)
+	b := randomGeneral(n, p, ldb, rnd)
+	bCopy := cloneGeneral(b)
+	taua := make([]float64, min(n, m))
+	taub := make([]float64, min(n, p))
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, n, m, p)
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dggqrf(n, m, p, a.Data, a.Stride, taua, b.Data, b.Stride, taub, work, -1)
+		lwork = (int(work[0]) + max(1, n, m, p)) / 2
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dggqrf(n, m, p, a.Data, a.Stride, taua, b.Data, b.Stride, taub, work, -1)
+		lwork = int(work[0])
+	}
+	work := randomSlice(lwork, rnd)
+
+	impl.Dggqrf(n, m, p, a.Data, a.Stride, taua, b.Data, b.Stride, taub, work, lwork)
+
+	q := constructQ("QR", n, m, a.Data, a.Stride, taua)
+	if resid := residualOrthogonal(q, false); resid > tol*float64(n) {
+		t.Errorf("%v: Q not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	z := constructQ("RQ", n, p, b.Data, b.Stride, taub)
+	if resid := residualOrthogonal(z, false); resid > tol*float64(p) {
+		t.Errorf("%v: Z not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(p))
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that A = Q*R.
+	if m > 0 {
+		r := zeros(n, m, m)
+		for i := 0; i < n; i++ {
+			for j := i; j < m; j++ {
+				r.Data[i*r.Stride+j] = a.Data[i*a.Stride+j]
+			}
+		}
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, r, -1, aCopy)
+		resid := dlange(lapack.MaxColumnSum, n, m, aCopy.Data, aCopy.Stride)
+		if resid > tol*float64(max(n, m)) {
+			t.Errorf("%v: |Q*R - A|=%v, want<=%v", name, resid, tol*float64(max(n, m)))
+		}
+	}
+
+	// Check that B = Q*T*Z.
+	if p > 0 {
+		tz := zeros(n, p, p)
+		for i := 0; i < n; i++ {
+			for j := max(0, i+p-n); j < p; j++ {
+				tz.Data[i*tz.Stride+j] = b.Data[i*b.Stride+j]
+			}
+		}
+		qt := zeros(n, p, p)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, tz, 0, qt)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, qt, z, -1, bCopy)
+		resid := dlange(lapack.MaxColumnSum, n, p, bCopy.Data, bCopy.Stride)
+		if resid > tol*float64(max(n, p)) {
+			t.Errorf("%v: |Q*T*Z - B|=%v, want<=%v", name, resid, tol*float64(max(n, p)))
+		}
+	}
+}
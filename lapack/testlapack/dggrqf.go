@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggrqfer interface {
+	Dggrqf(m, p, n int, a []float64, lda int, taua []float64, b []float64, ldb int, taub, work []float64, lwork int)
+}
+
+func DggrqfTest(t *testing.T, impl Dggrqfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 75} {
+		for _, p := range []int{0, 1, 2, 4, 9, 80} {
+			for _, n := range []int{0, 1, 3, 6, 11, 70} {
+				for _, ld := range []int{0, 5} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dggrqfTest(t, impl, rnd, m, p, n, n+ld, n+ld, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dggrqfTest(t *testing.T, impl Dggrqfer, rnd *rand.Rand, m, p, n, lda, ldb int, wl worklen) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("m=%d,p=%d,n=%d,lda=%d,ldb=%d,work=%v", m, p, n, lda, ldb, wl)
+
+	lda = max(1, lda)
+	ldb = max(1, ldb)
+	a := randomGeneral(m, n, lda, rnd)
+	aCopy := cloneGeneral(a)
+	b := randomGeneral(p, n, ldb, rnd)
+	bCopy := cloneGeneral(b)
+	taua := make([]float64, min(m, n))
+	taub := make([]float64, min(p, n))
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, n, m, p)
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dggrqf(m, p, n, a.Data, a.Stride, taua, b.Data, b.Stride, taub, work, -1)
+		lwork = (int(work[0]) + max(1, n, m, p)) / 2
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dggrqf(m, p, n, a.Data, a.Stride, taua, b.Data, b.Stride, taub, work, -1)
+		lwork = int(work[0])
+	}
+	work := randomSlice(lwork, rnd)
+
+	impl.Dggrqf(m, p, n, a.Data, a.Stride, taua, b.Data, b.Stride, taub, work, lwork)
+
+	q := constructQ("RQ", m, n, a.Data, a.Stride, taua)
+	if resid := residualOrthogonal(q, false); resid > tol*float64(n) {
+		t.Errorf("%v: Q not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+	z := constructQ("QR", p, n, b.Data, b.Stride, taub)
+	if resid := residualOrthogonal(z, false); resid > tol*float64(p) {
+		t.Errorf("%v: Z not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(p))
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that A = R*Q.
+	if m > 0 {
+		r := zeros(m, n, n)
+		for i := 0; i < m; i++ {
+			for j := max(0, i+n-m); j < n; j++ {
+				r.Data[i*r.Stride+j] = a.Data[i*a.Stride+j]
+			}
+		}
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, r, q, -1, aCopy)
+		resid := dlange(lapack.MaxColumnSum, m, n, aCopy.Data, aCopy.Stride)
+		if resid > tol*float64(max(m, n)) {
+			t.Errorf("%v: |R*Q - A|=%v, want<=%v", name, resid, tol*float64(max(m, n)))
+		}
+	}
+
+	// Check that B = Z*T*Q.
+	if p > 0 {
+		tq := zeros(p, n, n)
+		for i := 0; i < p; i++ {
+			for j := i; j < n; j++ {
+				tq.Data[i*tq.Stride+j] = b.Data[i*b.Stride+j]
+			}
+		}
+		ztq := zeros(p, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, z, tq, 0, ztq)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, ztq, q, -1, bCopy)
+		resid := dlange(lapack.MaxColumnSum, p, n, bCopy.Data, bCopy.Stride)
+		if resid > tol*float64(max(p, n)) {
+			t.Errorf("%v: |Z*T*Q - B|=%v, want<=%v", name, resid, tol*float64(max(p, n)))
+		}
+	}
+}
@@ -31,10 +31,8 @@ func DgtsvTest(t *testing.T, impl Dgtsver) {
 }
 
 func dgtsvTest(t *testing.T, impl Dgtsver, rnd *rand.Rand, n, nrhs, ldb int) {
-	const (
-		tol   = 1e-14
-		extra = 10
-	)
+	const extra = 10
+	tol := scaleTol(impl, 1e-14)
 
 	name := fmt.Sprintf("Case n=%d,nrhs=%d,ldb=%d", n, nrhs, ldb)
 
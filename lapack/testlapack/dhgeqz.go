@@ -0,0 +1,203 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dhgeqzer interface {
+	Dgghrder
+	Dhgeqz(job lapack.SchurJob, compq, compz lapack.OrthoComp, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, work []float64, lwork int) (unconverged int)
+}
+
+func DhgeqzTest(t *testing.T, impl Dhgeqzer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, comp := range []lapack.OrthoComp{lapack.OrthoNone, lapack.OrthoExplicit, lapack.OrthoPostmul} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21} {
+			for _, ld := range []int{max(1, n), n + 5} {
+				for _, singular := range []bool{false, true} {
+					testDhgeqz(t, impl, rnd, comp, n, ld, singular)
+				}
+			}
+		}
+	}
+}
+
+func testDhgeqz(t *testing.T, impl Dhgeqzer, rnd *rand.Rand, comp lapack.OrthoComp, n, ld int, singular bool) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("Case comp=%c,n=%v,ld=%v,singular=%v", comp, n, ld, singular)
+
+	// Generate a random matrix pair (A,B) with B upper triangular and, if
+	// singular is true, with some zero diagonal elements in B so that the
+	// pair has infinite eigenvalues.
+	a := randomGeneral(n, n, ld, rnd)
+	b := randomGeneral(n, n, ld, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			b.Data[i*b.Stride+j] = 0
+		}
+		if singular && i%3 == 1 {
+			b.Data[i*b.Stride+i] = 0
+		}
+	}
+
+	// Reduce (A,B) to generalized upper Hessenberg form (H,T).
+	h := cloneGeneral(a)
+	tt := cloneGeneral(b)
+	q1 := zeros(n, n, ld)
+	z1 := zeros(n, n, ld)
+	impl.Dgghrd(lapack.OrthoExplicit, lapack.OrthoExplicit, n, 0, n-1, h.Data, h.Stride, tt.Data, tt.Stride, q1.Data, q1.Stride, z1.Data, z1.Stride)
+
+	var q, z blas64.General
+	switch comp {
+	case lapack.OrthoNone:
+		q = blas64.General{Stride: 1}
+		z = blas64.General{Stride: 1}
+	case lapack.OrthoExplicit:
+		// Dhgeqz should overwrite q and z with the orthogonal factors.
+		q = randomGeneral(n, n, ld, rnd)
+		z = randomGeneral(n, n, ld, rnd)
+	case lapack.OrthoPostmul:
+		q = cloneGeneral(q1)
+		z = cloneGeneral(z1)
+	}
+
+	s := cloneGeneral(h)
+	p := cloneGeneral(tt)
+	alphar := nanSlice(n)
+	alphai := nanSlice(n)
+	beta := nanSlice(n)
+	work := nanSlice(1)
+	impl.Dhgeqz(lapack.EigenvaluesAndSchur, comp, comp, n, 0, n-1, s.Data, s.Stride, p.Data, p.Stride,
+		alphar, alphai, beta, q.Data, q.Stride, z.Data, z.Stride, work, -1)
+	work = nanSlice(int(work[0]))
+	unconverged := impl.Dhgeqz(lapack.EigenvaluesAndSchur, comp, comp, n, 0, n-1, s.Data, s.Stride, p.Data, p.Stride,
+		alphar, alphai, beta, q.Data, q.Stride, z.Data, z.Stride, work, len(work))
+	if unconverged != 0 {
+		t.Errorf("%v: unexpected failure to converge, unconverged=%v", name, unconverged)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that (S,P) is in generalized Schur canonical form and that
+	// the eigenvalues correspond to its diagonal blocks.
+	if !isUpperHessenberg(s) {
+		t.Errorf("%v: S is not upper Hessenberg", name)
+	}
+	if !isUpperTriangular(p) {
+		t.Errorf("%v: P is not upper triangular", name)
+	}
+	for j := 0; j < n; {
+		if j == n-1 || s.Data[(j+1)*s.Stride+j] == 0 {
+			// Real eigenvalue.
+			if alphar[j] != s.Data[j*s.Stride+j] || alphai[j] != 0 || beta[j] != p.Data[j*p.Stride+j] {
+				t.Errorf("%v: eigenvalue %v does not match diagonal of (S,P)", name, j)
+			}
+			if beta[j] < 0 {
+				t.Errorf("%v: beta[%v] is negative", name, j)
+			}
+			j++
+			continue
+		}
+		// Complex conjugate pair of eigenvalues.
+		if j < n-2 && s.Data[(j+2)*s.Stride+j+1] != 0 {
+			t.Errorf("%v: S has two consecutive non-zero subdiagonal elements at %v", name, j)
+		}
+		p11 := p.Data[j*p.Stride+j]
+		p12 := p.Data[j*p.Stride+j+1]
+		p22 := p.Data[(j+1)*p.Stride+j+1]
+		if p12 != 0 || p11 <= 0 || p22 <= 0 {
+			t.Errorf("%v: 2×2 block of P at %v not in positive diagonal form", name, j)
+		}
+		l1 := complex(alphar[j], alphai[j]) / complex(beta[j], 0)
+		l2 := complex(alphar[j+1], alphai[j+1]) / complex(beta[j+1], 0)
+		if alphai[j] <= 0 || cmplx.Abs(l2-cmplx.Conj(l1)) > tol*cmplx.Abs(l1) {
+			t.Errorf("%v: eigenvalues at %v are not a complex conjugate pair", name, j)
+		}
+		// Check that det(beta*S - alpha*P) = 0 for the 2×2 block.
+		alpha := complex(alphar[j], alphai[j])
+		bt := complex(beta[j], 0)
+		s11 := complex(s.Data[j*s.Stride+j], 0)
+		s12 := complex(s.Data[j*s.Stride+j+1], 0)
+		s21 := complex(s.Data[(j+1)*s.Stride+j], 0)
+		s22 := complex(s.Data[(j+1)*s.Stride+j+1], 0)
+		m11 := bt*s11 - alpha*complex(p11, 0)
+		m22 := bt*s22 - alpha*complex(p22, 0)
+		det := m11*m22 - bt*bt*s12*s21
+		scale := (cmplx.Abs(bt)*(cmplx.Abs(s11)+cmplx.Abs(s12)+cmplx.Abs(s21)+cmplx.Abs(s22)) + cmplx.Abs(alpha)*(p11+p22))
+		if cmplx.Abs(det) > tol*scale*scale {
+			t.Errorf("%v: eigenvalue at %v is not an eigenvalue of 2×2 block, |det|=%v", name, j, cmplx.Abs(det))
+		}
+		j += 2
+	}
+
+	if comp != lapack.OrthoNone {
+		if resid := residualOrthogonal(q, true); resid > tol {
+			t.Errorf("%v: Q is not orthogonal, resid=%v", name, resid)
+		}
+		if resid := residualOrthogonal(z, true); resid > tol {
+			t.Errorf("%v: Z is not orthogonal, resid=%v", name, resid)
+		}
+
+		// Check that (A,B) or (H,T) is equal to (Q*S*Zᵀ,Q*P*Zᵀ).
+		wantA, wantB := h, tt
+		if comp == lapack.OrthoPostmul {
+			wantA, wantB = a, b
+		}
+		aux := zeros(n, n, n)
+		got := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, s, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, z, 0, got)
+		if !equalApproxGeneral(got, wantA, tol*float64(n)) {
+			t.Errorf("%v: Q*S*Zᵀ does not match the input matrix", name)
+		}
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, p, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, z, 0, got)
+		if !equalApproxGeneral(got, wantB, tol*float64(n)) {
+			t.Errorf("%v: Q*P*Zᵀ does not match the input matrix", name)
+		}
+	}
+
+	// Compute only the eigenvalues and compare them with the eigenvalues
+	// computed together with the generalized Schur form.
+	s = cloneGeneral(h)
+	p = cloneGeneral(tt)
+	alpharEV := nanSlice(n)
+	alphaiEV := nanSlice(n)
+	betaEV := nanSlice(n)
+	unconverged = impl.Dhgeqz(lapack.EigenvaluesOnly, lapack.OrthoNone, lapack.OrthoNone, n, 0, n-1, s.Data, s.Stride, p.Data, p.Stride,
+		alpharEV, alphaiEV, betaEV, nil, 1, nil, 1, work, len(work))
+	if unconverged != 0 {
+		t.Errorf("%v: unexpected failure to converge when computing only eigenvalues, unconverged=%v", name, unconverged)
+		return
+	}
+	var ev []complex128
+	for j := range betaEV {
+		if betaEV[j] > 1e-8 {
+			ev = append(ev, complex(alpharEV[j], alphaiEV[j])/complex(betaEV[j], 0))
+		}
+	}
+	for j := range beta {
+		if beta[j] <= 1e-8 {
+			continue
+		}
+		want := complex(alphar[j], alphai[j]) / complex(beta[j], 0)
+		if found, _ := containsComplex(ev, want, 1e-8*math.Max(1, cmplx.Abs(want))); !found {
+			t.Errorf("%v: eigenvalue %v not found when computing only eigenvalues", name, want)
+		}
+	}
+}
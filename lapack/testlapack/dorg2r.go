@@ -17,6 +17,7 @@ type Dorg2rer interface {
 }
 
 func Dorg2rTest(t *testing.T, impl Dorg2rer) {
+	tol := scaleTol(impl, 1e-12)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for ti, test := range []struct {
 		m, n, k, lda int
@@ -65,7 +66,7 @@ func Dorg2rTest(t *testing.T, impl Dorg2rer) {
 	loop:
 		for i := 0; i < m; i++ {
 			for j := 0; j < n; j++ {
-				if !scalar.EqualWithinAbsOrRel(q.Data[i*q.Stride+j], a[i*lda+j], 1e-12, 1e-12) {
+				if !scalar.EqualWithinAbsOrRel(q.Data[i*q.Stride+j], a[i*lda+j], tol, tol) {
 					same = false
 					break loop
 				}
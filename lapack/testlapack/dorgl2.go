@@ -17,6 +17,7 @@ type Dorgl2er interface {
 }
 
 func Dorgl2Test(t *testing.T, impl Dorgl2er) {
+	tol := scaleTol(impl, 1e-12)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for _, test := range []struct {
 		m, n, lda int
@@ -52,7 +53,7 @@ func Dorgl2Test(t *testing.T, impl Dorgl2er) {
 		same := true
 		for i := 0; i < m; i++ {
 			for j := 0; j < n; j++ {
-				if !scalar.EqualWithinAbsOrRel(q.Data[i*q.Stride+j], a[i*lda+j], 1e-12, 1e-12) {
+				if !scalar.EqualWithinAbsOrRel(q.Data[i*q.Stride+j], a[i*lda+j], tol, tol) {
 					same = false
 					break
 				}
@@ -76,7 +76,7 @@ func DorglqTest(t *testing.T, impl Dorglqer) {
 			work[i] = math.NaN()
 		}
 		impl.Dorglq(m, n, k, a, lda, tau, work, len(work))
-		if !floats.EqualApprox(a, aUnblocked, 1e-10) {
+		if !floats.EqualApprox(a, aUnblocked, scaleTol(impl, 1e-10)) {
 			t.Errorf("Q Mismatch. m = %d, n = %d, k = %d, lda = %d", m, n, k, lda)
 		}
 	}
@@ -76,7 +76,7 @@ func DorgqrTest(t *testing.T, impl Dorgqrer) {
 			work[i] = math.NaN()
 		}
 		impl.Dorgqr(m, n, k, a, lda, tau[:k], work, len(work))
-		if !floats.EqualApprox(a, aUnblocked, 1e-10) {
+		if !floats.EqualApprox(a, aUnblocked, scaleTol(impl, 1e-10)) {
 			t.Errorf("Q Mismatch. m = %d, n = %d, k = %d, lda = %d", m, n, k, lda)
 		}
 	}
@@ -130,7 +130,7 @@ func Dorm2rTest(t *testing.T, impl Dorm2rer) {
 				if !floats.Equal(tau, tauCopy) {
 					t.Errorf("tau changed in call")
 				}
-				if !floats.EqualApprox(cMat.Data, c, 1e-14) {
+				if !floats.EqualApprox(cMat.Data, c, scaleTol(impl, 1e-14)) {
 					t.Errorf("Multiplication mismatch.\n Want %v \n got %v.", cMat.Data, c)
 				}
 			}
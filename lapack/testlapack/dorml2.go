@@ -133,7 +133,7 @@ func Dorml2Test(t *testing.T, impl Dorml2er) {
 				if !floats.Equal(tau, tauCopy) {
 					t.Errorf("tau changed in call")
 				}
-				if !floats.EqualApprox(cMat.Data, c, 1e-14) {
+				if !floats.EqualApprox(cMat.Data, c, scaleTol(impl, 1e-14)) {
 					isLeft := side == blas.Left
 					isTrans := trans == blas.Trans
 					t.Errorf("Multiplication mismatch. IsLeft = %v. IsTrans = %v", isLeft, isTrans)
@@ -120,7 +120,7 @@ func DormlqTest(t *testing.T, impl Dormlqer) {
 					work = make([]float64, lwork)
 
 					impl.Dormlq(side, trans, mc, nc, k, a, lda, tau, c, ldc, work, lwork)
-					if !floats.EqualApprox(c, ans, 1e-13) {
+					if !floats.EqualApprox(c, ans, scaleTol(impl, 1e-13)) {
 						t.Errorf("Dormqr and Dorm2r results mismatch")
 					}
 				}
@@ -19,6 +19,7 @@ type Dormqrer interface {
 }
 
 func DormqrTest(t *testing.T, impl Dormqrer) {
+	tol := scaleTol(impl, 1e-12)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for _, side := range []blas.Side{blas.Left, blas.Right} {
 		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
@@ -107,7 +108,7 @@ func DormqrTest(t *testing.T, impl Dormqrer) {
 				}
 				copy(c, cCopy)
 				impl.Dormqr(side, trans, mc, nc, k, a, lda, tau, c, ldc, work, len(work))
-				if !floats.EqualApprox(c, ans, 1e-12) {
+				if !floats.EqualApprox(c, ans, tol) {
 					t.Errorf("Dormqr and Dorm2r mismatch for small work")
 				}
 
@@ -119,7 +120,7 @@ func DormqrTest(t *testing.T, impl Dormqrer) {
 					work[i] = rnd.Float64()
 				}
 				impl.Dormqr(side, trans, mc, nc, k, a, lda, tau, c, ldc, work, len(work))
-				if !floats.EqualApprox(c, ans, 1e-12) {
+				if !floats.EqualApprox(c, ans, tol) {
 					t.Errorf("Dormqr and Dorm2r mismatch for full work")
 					fmt.Println("ccopy")
 					for i := 0; i < mc; i++ {
@@ -145,7 +146,7 @@ func DormqrTest(t *testing.T, impl Dormqrer) {
 					work = make([]float64, 3*mc)
 				}
 				impl.Dormqr(side, trans, mc, nc, k, a, lda, tau, c, ldc, work, len(work))
-				if !floats.EqualApprox(c, ans, 1e-12) {
+				if !floats.EqualApprox(c, ans, tol) {
 					t.Errorf("Dormqr and Dorm2r mismatch for medium work")
 				}
 			}
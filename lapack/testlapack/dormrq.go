@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dormrqer interface {
+	Dormr2er
+	Dormrq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+}
+
+func DormrqTest(t *testing.T, impl Dormrqer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, test := range []struct {
+				common, adim, cdim, lda, ldc int
+			}{
+				{6, 7, 8, 0, 0},
+				{6, 8, 7, 0, 0},
+				{7, 6, 8, 0, 0},
+				{7, 8, 6, 0, 0},
+				{8, 6, 7, 0, 0},
+				{8, 7, 6, 0, 0},
+				{100, 80, 150, 0, 0},
+				{100, 150, 80, 0, 0},
+				{150, 100, 80, 0, 0},
+				{80, 150, 100, 0, 0},
+				{100, 80, 150, 200, 250},
+				{150, 100, 80, 250, 200},
+			} {
+				name := fmt.Sprintf("%s,%s,common=%d,adim=%d,cdim=%d,lda=%d,ldc=%d",
+					sideToString(side), transToString(trans), test.common, test.adim, test.cdim, test.lda, test.ldc)
+				dormrqTest(t, impl, rnd, name, side, trans, test.common, test.adim, test.cdim, test.lda, test.ldc)
+			}
+		}
+	}
+}
+
+func dormrqTest(t *testing.T, impl Dormrqer, rnd *rand.Rand, name string, side blas.Side, trans blas.Transpose, common, adim, cdim, lda, ldc int) {
+	// A is adim×common and its last k rows define Q.
+	ma := adim
+	na := common
+	var mc, nc int
+	if side == blas.Left {
+		mc = common
+		nc = cdim
+	} else {
+		mc = cdim
+		nc = common
+	}
+	if lda == 0 {
+		lda = na
+	}
+	if ldc == 0 {
+		ldc = nc
+	}
+	a := randomSlice(ma*lda, rnd)
+	c := randomSlice(mc*ldc, rnd)
+
+	// Compute the RQ factorization of A.
+	k := min(ma, na)
+	tau := make([]float64, k)
+	work := make([]float64, 1)
+	impl.Dgerqf(ma, na, a, lda, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	impl.Dgerqf(ma, na, a, lda, tau, work, len(work))
+	ar := a[(ma-k)*lda:]
+
+	// Compute the reference result using the unblocked code.
+	want := make([]float64, len(c))
+	copy(want, c)
+	work = make([]float64, max(mc, nc))
+	impl.Dormr2(side, trans, mc, nc, k, ar, lda, tau, want, ldc, work)
+
+	for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+		var lwork int
+		switch wl {
+		case minimumWork:
+			lwork = max(1, nc)
+			if side == blas.Right {
+				lwork = max(1, mc)
+			}
+		case mediumWork:
+			work := make([]float64, 1)
+			impl.Dormrq(side, trans, mc, nc, k, ar, lda, tau, c, ldc, work, -1)
+			lwork = (int(work[0]) + max(mc, nc)) / 2
+		case optimumWork:
+			work := make([]float64, 1)
+			impl.Dormrq(side, trans, mc, nc, k, ar, lda, tau, c, ldc, work, -1)
+			lwork = int(work[0])
+		}
+		got := make([]float64, len(c))
+		copy(got, c)
+		work := randomSlice(lwork, rnd)
+		impl.Dormrq(side, trans, mc, nc, k, ar, lda, tau, got, ldc, work, lwork)
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("%s,work=%v: Dormrq and Dormr2 mismatch", name, wl)
+		}
+	}
+}
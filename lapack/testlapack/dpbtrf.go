@@ -38,7 +38,7 @@ func DpbtrfTest(t *testing.T, impl Dpbtrfer) {
 }
 
 func dpbtrfTest(t *testing.T, impl Dpbtrfer, uplo blas.Uplo, n, kd int, ldab int, rnd *rand.Rand) {
-	const tol = 1e-12
+	tol := scaleTol(impl, 1e-12)
 
 	name := fmt.Sprintf("uplo=%v,n=%v,kd=%v,ldab=%v", string(uplo), n, kd, ldab)
 
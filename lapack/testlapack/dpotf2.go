@@ -65,7 +65,7 @@ func testDpotf2(t *testing.T, impl Dpotf2er, testPos bool, a, ans [][]float64, s
 		t.Errorf("Positive definite mismatch: Want %v, Got %v", testPos, pos)
 		return
 	}
-	if testPos && !floats.EqualApprox(ansFlat, aFlat, 1e-14) {
+	if testPos && !floats.EqualApprox(ansFlat, aFlat, scaleTol(impl, 1e-14)) {
 		t.Errorf("Result mismatch: Want %v, Got  %v", ansFlat, aFlat)
 	}
 }
@@ -18,7 +18,7 @@ type Dpotrfer interface {
 }
 
 func DpotrfTest(t *testing.T, impl Dpotrfer) {
-	const tol = 1e-13
+	tol := scaleTol(impl, 1e-13)
 	rnd := rand.New(rand.NewPCG(1, 1))
 	bi := blas64.Implementation()
 	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
@@ -26,7 +26,7 @@ func DpotriTest(t *testing.T, impl Dpotrier) {
 			// Include small and large sizes to make sure that both
 			// unblocked and blocked paths are taken.
 			ns := []int{0, 1, 2, 3, 4, 5, 10, 25, 31, 32, 33, 63, 64, 65, 127, 128, 129}
-			const tol = 1e-12
+			tol := scaleTol(impl, 1e-12)
 
 			bi := blas64.Implementation()
 			rnd := rand.New(rand.NewPCG(1, 1))
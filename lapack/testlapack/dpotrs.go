@@ -20,7 +20,7 @@ type Dpotrser interface {
 }
 
 func DpotrsTest(t *testing.T, impl Dpotrser) {
-	const tol = 1e-14
+	tol := scaleTol(impl, 1e-14)
 
 	rnd := rand.New(rand.NewPCG(1, 1))
 	bi := blas64.Implementation()
@@ -28,7 +28,7 @@ func DpttrfTest(t *testing.T, impl Dpttrfer) {
 }
 
 func dpttrfTest(t *testing.T, impl Dpttrfer, rnd *rand.Rand, n int) {
-	const tol = 1e-15
+	tol := scaleTol(impl, 1e-15)
 
 	name := fmt.Sprintf("n=%v", n)
 
@@ -31,7 +31,7 @@ func DpttrsTest(t *testing.T, impl Dpttrser) {
 }
 
 func dpttrsTest(t *testing.T, impl Dpttrser, rnd *rand.Rand, n, nrhs, ldb int) {
-	const tol = 1e-15
+	tol := scaleTol(impl, 1e-15)
 
 	name := fmt.Sprintf("n=%v", n)
 
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsbever interface {
+	Dsyever
+	Dsbev(jobz lapack.EVJob, uplo blas.Uplo, n, kd int, ab []float64, ldab int, w, z []float64, ldz int, work []float64) (ok bool)
+}
+
+func DsbevTest(t *testing.T, impl Dsbever) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 33, 64} {
+		for _, kd := range []int{0, 1, 2, (n + 1) / 4, n - 1, n + 2} {
+			if kd < 0 {
+				continue
+			}
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, ldab := range []int{kd + 1, kd + 5} {
+					for _, ldz := range []int{max(1, n), n + 3} {
+						for _, scale := range []float64{1, 1e-300, 1e300} {
+							dsbevTest(t, impl, rnd, uplo, n, kd, ldab, ldz, scale)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsbevTest(t *testing.T, impl Dsbever, rnd *rand.Rand, uplo blas.Uplo, n, kd, ldab, ldz int, scale float64) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%v,n=%v,kd=%v,ldab=%v,ldz=%v,scale=%v", string(uplo), n, kd, ldab, ldz, scale)
+
+	// Generate a random symmetric band matrix A.
+	var ab []float64
+	if n > 0 {
+		ab = make([]float64, (n-1)*ldab+kd+1)
+	}
+	for i := range ab {
+		ab[i] = scale * rnd.NormFloat64()
+	}
+	aGen := symBandToGeneral(uplo, n, kd, ab, ldab)
+
+	// Compute the reference eigenvalues using Dsyev.
+	wWant := make([]float64, n)
+	aCopy := cloneGeneral(aGen)
+	lwork := max(1, 3*n-1)
+	impl.Dsyev(lapack.EVNone, blas.Upper, n, aCopy.Data, aCopy.Stride, wWant, make([]float64, lwork), lwork)
+	var wMax float64
+	if n > 0 {
+		wMax = math.Max(math.Abs(wWant[0]), math.Abs(wWant[n-1]))
+	}
+
+	for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+		name := name + fmt.Sprintf(",jobz=%c", jobz)
+
+		abCopy := make([]float64, len(ab))
+		copy(abCopy, ab)
+		w := nanSlice(n)
+		var z blas64.General
+		if jobz == lapack.EVCompute {
+			z = nanGeneral(n, n, ldz)
+		} else {
+			z.Stride = 1
+		}
+		work := nanSlice(max(1, 3*n-2))
+		ok := impl.Dsbev(jobz, uplo, n, kd, abCopy, ldab, w, z.Data, z.Stride, work)
+		if !ok {
+			t.Errorf("%v: Dsbev failed", name)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+
+		if !sort.Float64sAreSorted(w) {
+			t.Errorf("%v: eigenvalues are not sorted", name)
+		}
+		for i := range w {
+			if math.Abs(w[i]-wWant[i]) > tol*float64(n)*wMax {
+				t.Errorf("%v: eigenvalue %d mismatch; got %v, want %v", name, i, w[i], wWant[i])
+				break
+			}
+		}
+
+		if jobz == lapack.EVNone {
+			continue
+		}
+
+		// Check that the eigenvectors are orthonormal.
+		if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+			t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+		}
+
+		// Check that |A Z - Z W| / (|A| n) is small.
+		if resid := residualEigenSym(aGen, z, w[:n]); resid > tol {
+			t.Errorf("%v: unexpected result; |A Z - Z W|/(|A| n)=%v", name, resid)
+		}
+	}
+}
+
+// residualEigenSym returns |A Z - Z W| / (|A| n) for the n×n symmetric matrix
+// A, the n×m matrix Z of eigenvectors and the m eigenvalues in w.
+func residualEigenSym(a, z blas64.General, w []float64) float64 {
+	n := a.Rows
+	m := len(w)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride)
+	if anorm == 0 {
+		return 0
+	}
+	r := blas64.General{Rows: n, Cols: m, Stride: max(1, m), Data: make([]float64, n*m)}
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			r.Data[i*r.Stride+j] = z.Data[i*z.Stride+j] * w[j]
+		}
+	}
+	zm := blas64.General{Rows: n, Cols: m, Stride: z.Stride, Data: z.Data}
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, zm, -1, r)
+	return dlange(lapack.MaxColumnSum, n, m, r.Data, r.Stride) / anorm / float64(n)
+}
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsbevxer interface {
+	Dsyever
+	Dsbevx(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n, kd int, ab []float64, ldab int, q []float64, ldq int, vl, vu float64, il, iu int, w, z []float64, ldz int, work []float64, iwork []int) (m int, ok bool)
+}
+
+func DsbevxTest(t *testing.T, impl Dsbevxer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, n := range []int{0, 1, 2, 5, 10, 26, 50, 100} {
+			for _, kd := range []int{0, 1, 3, n / 2, n + 1} {
+				for _, ldab := range []int{kd + 1, kd + 4} {
+					for typ := 0; typ < 2; typ++ {
+						for _, rng := range []lapack.EVRange{lapack.EVRangeAll, lapack.EVRangeIndex, lapack.EVRangeValue} {
+							dsbevxTest(t, impl, rng, uplo, n, kd, ldab, typ, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsbevxTest(t *testing.T, impl Dsbevxer, rng lapack.EVRange, uplo blas.Uplo, n, kd, ldab, typ int, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("range=%c,uplo=%v,n=%v,kd=%v,ldab=%v,type=%v", rng, uploToString(uplo), n, kd, ldab, typ)
+
+	// Generate a symmetric band matrix A.
+	var ab []float64
+	if n > 0 {
+		ab = make([]float64, (n-1)*ldab+kd+1)
+	}
+	switch typ {
+	case 0:
+		// Random matrix.
+		for i := range ab {
+			ab[i] = rnd.NormFloat64()
+		}
+	case 1:
+		// Diagonally dominant matrix with a band of width kd and slowly
+		// decaying off-diagonal elements whose eigenvalues are clustered.
+		for i := 0; i < n; i++ {
+			for j := i; j < min(n, i+kd+1); j++ {
+				v := -1 / float64(j-i+1)
+				if i == j {
+					v = 2*float64(kd) + 1
+				}
+				if uplo == blas.Upper {
+					ab[i*ldab+j-i] = v
+				} else {
+					ab[j*ldab+kd+i-j] = v
+				}
+			}
+		}
+	}
+	aGen := symBandToGeneral(uplo, n, kd, ab, ldab)
+
+	// Compute the reference eigenvalues using Dsyev.
+	wWant := make([]float64, n)
+	aCopy := cloneGeneral(aGen)
+	lwork := max(1, 3*n-1)
+	impl.Dsyev(lapack.EVNone, blas.Upper, n, aCopy.Data, aCopy.Stride, wWant, make([]float64, lwork), lwork)
+	var wMax float64
+	if n > 0 {
+		wMax = math.Max(math.Abs(wWant[0]), math.Abs(wWant[n-1]))
+	}
+
+	// Select the eigenvalues to compute. For a range of values the interval
+	// bounds are placed in the middle between two reference eigenvalues that
+	// are well separated.
+	var (
+		vl, vu float64
+		il, iu int
+	)
+	first, last := 0, n-1
+	switch rng {
+	case lapack.EVRangeIndex:
+		if n > 0 {
+			il = rnd.IntN(n)
+			iu = il + rnd.IntN(n-il)
+		} else {
+			iu = -1
+		}
+		first, last = il, iu
+	case lapack.EVRangeValue:
+		if n == 0 {
+			vl, vu = 0, 1
+			break
+		}
+		first = rnd.IntN(n)
+		last = first + rnd.IntN(n-first)
+		gap := 1e-8 * wMax
+		if first > 0 && wWant[first]-wWant[first-1] < gap ||
+			last < n-1 && wWant[last+1]-wWant[last] < gap {
+			// The interval bounds cannot be placed safely.
+			return
+		}
+		vl = wWant[0] - 1
+		if first > 0 {
+			vl = (wWant[first-1] + wWant[first]) / 2
+		}
+		vu = wWant[n-1] + 1
+		if last < n-1 {
+			vu = (wWant[last] + wWant[last+1]) / 2
+		}
+	}
+	mWant := last - first + 1
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = mWant
+	}
+
+	for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+		name := name + fmt.Sprintf(",jobz=%c", jobz)
+
+		abCopy := make([]float64, len(ab))
+		copy(abCopy, ab)
+		ldz := max(1, ncols)
+		w := nanSlice(n)
+		var q, z []float64
+		if jobz == lapack.EVCompute {
+			q = nanSlice(n * n)
+			z = nanSlice(max(0, (n-1)*ldz+ncols))
+		}
+		work := nanSlice(max(1, 20*n))
+		iwork := make([]int, max(1, 12*n))
+
+		m, ok := impl.Dsbevx(jobz, rng, uplo, n, kd, abCopy, ldab, q, max(1, n), vl, vu, il, iu, w, z, ldz, work, iwork)
+		if !ok {
+			t.Errorf("%v: Dsbevx failed", name)
+			continue
+		}
+		if m != mWant {
+			t.Errorf("%v: unexpected number of eigenvalues; got %d, want %d", name, m, mWant)
+			continue
+		}
+		if m == 0 {
+			continue
+		}
+		if !sort.Float64sAreSorted(w[:m]) {
+			t.Errorf("%v: eigenvalues are not sorted", name)
+		}
+		for i := 0; i < m; i++ {
+			if math.Abs(w[i]-wWant[first+i]) > tol*float64(n)*wMax {
+				t.Errorf("%v: eigenvalue %d mismatch; got %v, want %v", name, first+i, w[i], wWant[first+i])
+				break
+			}
+		}
+
+		if jobz == lapack.EVNone {
+			continue
+		}
+
+		// Check that the eigenvectors are orthonormal.
+		zGen := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+		if resid := residualOrthogonal(zGen, false); resid > tol*float64(n) {
+			t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+		}
+
+		// Check that |A Z - Z W| / (|A| n) is small.
+		if resid := residualEigenSym(aGen, zGen, w[:m]); resid > tol {
+			t.Errorf("%v: unexpected result; |A Z - Z W|/(|A| n)=%v", name, resid)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsbtrder interface {
+	Dsbtrd(vect lapack.OrthoComp, uplo blas.Uplo, n, kd int, ab []float64, ldab int, d, e, q []float64, ldq int)
+}
+
+func DsbtrdTest(t *testing.T, impl Dsbtrder) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 33, 64} {
+		for _, kd := range []int{0, 1, 2, 3, (n + 1) / 4, n / 2, n - 1, n + 2} {
+			if kd < 0 {
+				continue
+			}
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, ldab := range []int{kd + 1, kd + 5} {
+					for _, ldq := range []int{max(1, n), n + 3} {
+						dsbtrdTest(t, impl, rnd, uplo, n, kd, ldab, ldq)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsbtrdTest(t *testing.T, impl Dsbtrder, rnd *rand.Rand, uplo blas.Uplo, n, kd, ldab, ldq int) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("uplo=%v,n=%v,kd=%v,ldab=%v,ldq=%v", string(uplo), n, kd, ldab, ldq)
+
+	// Generate a random symmetric band matrix A.
+	var ab []float64
+	if n > 0 {
+		ab = make([]float64, (n-1)*ldab+kd+1)
+	}
+	for i := range ab {
+		ab[i] = rnd.NormFloat64()
+	}
+	aGen := symBandToGeneral(uplo, n, kd, ab, ldab)
+
+	// Reduce A to tridiagonal form and form Q explicitly.
+	abCopy := make([]float64, len(ab))
+	copy(abCopy, ab)
+	d := nanSlice(n)
+	e := nanSlice(max(0, n-1))
+	q := nanGeneral(n, n, ldq)
+	impl.Dsbtrd(lapack.OrthoExplicit, uplo, n, kd, abCopy, ldab, d, e, q.Data, q.Stride)
+	if n == 0 {
+		return
+	}
+
+	// Check that Q is orthogonal.
+	if resid := residualOrthogonal(q, false); resid > tol*float64(n) {
+		t.Errorf("%v: Q is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+
+	// Check that Qᵀ * A * Q = T.
+	aq := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aGen, q, 0, aq)
+	qaq := zeros(n, n, n)
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, q, aq, 0, qaq)
+	for i := 0; i < n; i++ {
+		qaq.Data[i*n+i] -= d[i]
+		if i < n-1 {
+			qaq.Data[i*n+i+1] -= e[i]
+			qaq.Data[(i+1)*n+i] -= e[i]
+		}
+	}
+	anorm := math.Max(1, dlange(lapack.MaxColumnSum, n, n, aGen.Data, aGen.Stride))
+	if resid := dlange(lapack.MaxColumnSum, n, n, qaq.Data, n) / anorm / float64(n); resid > tol {
+		t.Errorf("%v: |Qᵀ*A*Q - T|/(|A|*n)=%v, want<=%v", name, resid, tol)
+	}
+
+	// Check that the tridiagonal matrix does not depend on whether Q is
+	// computed.
+	copy(abCopy, ab)
+	dNone := nanSlice(n)
+	eNone := nanSlice(n - 1)
+	impl.Dsbtrd(lapack.OrthoNone, uplo, n, kd, abCopy, ldab, dNone, eNone, nil, 1)
+	for i := range d {
+		if dNone[i] != d[i] || (i < n-1 && eNone[i] != e[i]) {
+			t.Errorf("%v: T depends on whether Q is computed", name)
+			break
+		}
+	}
+
+	// Check that Q is post-multiplied into the matrix X on entry.
+	x := randomGeneral(n, n, ldq, rnd)
+	xq := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, x, q, 0, xq)
+	copy(abCopy, ab)
+	impl.Dsbtrd(lapack.OrthoPostmul, uplo, n, kd, abCopy, ldab, dNone, eNone, x.Data, x.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if math.Abs(x.Data[i*x.Stride+j]-xq.Data[i*n+j]) > tol*float64(n) {
+				t.Errorf("%v: X*Q mismatch", name)
+				return
+			}
+		}
+	}
+}
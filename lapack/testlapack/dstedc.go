@@ -0,0 +1,257 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstedcer interface {
+	Dsterfer
+	Dsytrder
+	Dorgtrer
+	Dlacpyer
+	Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+}
+
+func DstedcTest(t *testing.T, impl Dstedcer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 10, 25, 26, 27, 50, 51, 64, 100, 133} {
+		for _, ldz := range []int{max(1, n), n + 5} {
+			for typ := 0; typ <= 11; typ++ {
+				d, e := dstedcTridiag(n, typ, rnd)
+				for _, compz := range []lapack.EVComp{lapack.EVCompNone, lapack.EVTridiag, lapack.EVOrig} {
+					dstedcTest(t, impl, compz, n, typ, d, e, ldz, rnd)
+				}
+			}
+		}
+	}
+}
+
+// dstedcTridiag returns the diagonal and off-diagonal of a symmetric
+// tridiagonal test matrix of the given type.
+func dstedcTridiag(n, typ int, rnd *rand.Rand) (d, e []float64) {
+	d = make([]float64, n)
+	e = make([]float64, max(0, n-1))
+	switch typ {
+	case 0:
+		// The zero matrix.
+	case 1:
+		// The identity matrix.
+		for i := range d {
+			d[i] = 1
+		}
+	case 2:
+		// A diagonal matrix with geometrically spaced entries 1, ..., eps
+		// and random signs.
+		for i := range d {
+			d[i] = 1
+			if i > 0 {
+				d[i] = math.Pow(dlamchE, float64(i)/float64(n-1))
+			}
+			if rnd.Float64() < 0.5 {
+				d[i] *= -1
+			}
+		}
+	case 3:
+		// The (-1, 2, -1) matrix which has well separated eigenvalues.
+		for i := range d {
+			d[i] = 2
+		}
+		for i := range e {
+			e[i] = -1
+		}
+	case 4:
+		// The Wilkinson matrix W+ which has pairs of close eigenvalues.
+		for i := range d {
+			d[i] = math.Abs(float64(i) - float64(n-1)/2)
+		}
+		for i := range e {
+			e[i] = 1
+		}
+	case 5:
+		// A matrix with all off-diagonal elements equal to one and a zero
+		// diagonal. Its eigenvalues come in pairs ±λ.
+		for i := range e {
+			e[i] = 1
+		}
+	case 6:
+		// A matrix with many equal eigenvalues obtained by gluing together
+		// copies of the same 2×2 block with tiny off-diagonal elements.
+		for i := range d {
+			d[i] = float64(i % 2)
+		}
+		for i := range e {
+			if i%2 == 0 {
+				e[i] = 1
+			} else {
+				e[i] = dlamchE
+			}
+		}
+	case 7:
+		// A random matrix split into independent blocks by zero
+		// off-diagonal elements.
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			if i%30 != 29 {
+				e[i] = rnd.NormFloat64()
+			}
+		}
+	case 8:
+		// A random matrix scaled close to the overflow threshold.
+		for i := range d {
+			d[i] = rnd.NormFloat64() * math.Sqrt(1/dlamchS) / 10
+		}
+		for i := range e {
+			e[i] = rnd.NormFloat64() * math.Sqrt(1/dlamchS) / 10
+		}
+	case 9:
+		// A random matrix scaled close to the underflow threshold.
+		for i := range d {
+			d[i] = rnd.NormFloat64() * math.Sqrt(dlamchS) * 10
+		}
+		for i := range e {
+			e[i] = rnd.NormFloat64() * math.Sqrt(dlamchS) * 10
+		}
+	case 10:
+		// A random matrix with integer entries which often has repeated
+		// eigenvalues after the rank-one tearing.
+		for i := range d {
+			d[i] = float64(rnd.IntN(3))
+		}
+		for i := range e {
+			e[i] = float64(rnd.IntN(3))
+		}
+	default:
+		// A random symmetric tridiagonal matrix.
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = rnd.NormFloat64()
+		}
+	}
+	return d, e
+}
+
+func dstedcTest(t *testing.T, impl Dstedcer, compz lapack.EVComp, n, typ int, d, e []float64, ldz int, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("compz=%c,n=%d,ldz=%d,type=%d", compz, n, ldz, typ)
+
+	// Construct the dense matrix A whose eigendecomposition is sought. If
+	// compz is lapack.EVOrig, A is a random symmetric matrix and it is reduced
+	// to the tridiagonal matrix that is passed to Dstedc.
+	dGot := make([]float64, n)
+	copy(dGot, d)
+	eGot := make([]float64, len(e))
+	copy(eGot, e)
+	a := make([]float64, n*max(1, n))
+	z := nanGeneral(n, n, ldz)
+	if compz == lapack.EVOrig {
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := rnd.NormFloat64()
+				a[i*n+j] = v
+				a[j*n+i] = v
+			}
+		}
+		tau := make([]float64, max(0, n-1))
+		work := make([]float64, 1)
+		impl.Dsytrd(blas.Upper, n, z.Data, z.Stride, dGot, eGot, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		impl.Dlacpy(blas.All, n, n, a, max(1, n), z.Data, z.Stride)
+		impl.Dsytrd(blas.Upper, n, z.Data, z.Stride, dGot, eGot, tau, work, len(work))
+		impl.Dorgtr(blas.Upper, n, z.Data, z.Stride, tau, work, len(work))
+	} else {
+		for i := 0; i < n; i++ {
+			a[i*n+i] = d[i]
+			if i < n-1 {
+				a[i*n+i+1] = e[i]
+				a[(i+1)*n+i] = e[i]
+			}
+		}
+	}
+	dTri := make([]float64, n)
+	copy(dTri, dGot)
+	eTri := make([]float64, len(eGot))
+	copy(eTri, eGot)
+
+	// Query and allocate the workspace.
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	impl.Dstedc(compz, n, dGot, eGot, z.Data, z.Stride, work, -1, iwork, -1)
+	lwork := int(work[0])
+	liwork := iwork[0]
+	work = nanSlice(lwork)
+	iwork = make([]int, liwork)
+	for i := range iwork {
+		iwork[i] = -1
+	}
+
+	ok := impl.Dstedc(compz, n, dGot, eGot, z.Data, z.Stride, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dstedc failed", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(dGot) {
+		t.Errorf("%v: eigenvalues are not sorted", name)
+	}
+
+	// Compare the eigenvalues with those computed by Dsterf.
+	dWant := make([]float64, n)
+	copy(dWant, dTri)
+	if !impl.Dsterf(n, dWant, eTri) {
+		t.Errorf("%v: computing reference eigenvalues using Dsterf failed", name)
+		return
+	}
+	var diff, dMax float64
+	for i := range dGot {
+		diff = math.Max(diff, math.Abs(dGot[i]-dWant[i]))
+		dMax = math.Max(dMax, math.Max(math.Abs(dGot[i]), math.Abs(dWant[i])))
+	}
+	dMax = math.Max(dlamchS, dMax)
+	if diff > tol*float64(n)*dMax {
+		t.Errorf("%v: eigenvalue mismatch with Dsterf; |dGot-dWant|/|dWant|=%v", name, diff/dMax)
+	}
+
+	if compz == lapack.EVCompNone {
+		return
+	}
+
+	// Check that Z is orthogonal.
+	if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+
+	// Check that A - Z D Zᵀ is small relative to A.
+	anorm := dlansy(lapack.MaxColumnSum, blas.Upper, n, a, n)
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		bi.Dsyr(blas.Upper, n, -dGot[i], z.Data[i:], z.Stride, a, n)
+	}
+	wnorm := dlansy(lapack.MaxColumnSum, blas.Upper, n, a, n)
+	resid := wnorm / math.Max(dlamchS, anorm) / float64(n)
+	if anorm == 0 {
+		resid = wnorm
+	}
+	if resid > tol {
+		t.Errorf("%v: unexpected result; |A - Z D Zᵀ|/(|A| n)=%v", name, resid)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstemrer interface {
+	Dsterfer
+	Dstemr(jobz lapack.EVJob, rng lapack.EVRange, n int, d, e []float64, vl, vu float64, il, iu int, w, z []float64, ldz, nzc int, isuppz []int, tryrac bool, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+}
+
+func DstemrTest(t *testing.T, impl Dstemrer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 10, 25, 26, 50, 51, 100, 133} {
+		for typ := 0; typ <= 11; typ++ {
+			d, e := dstedcTridiag(n, typ, rnd)
+			for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+				for _, tryrac := range []bool{false, true} {
+					for _, rng := range []lapack.EVRange{lapack.EVRangeAll, lapack.EVRangeIndex, lapack.EVRangeValue} {
+						dstemrTest(t, impl, jobz, rng, tryrac, n, typ, d, e, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dstemrTest(t *testing.T, impl Dstemrer, jobz lapack.EVJob, rng lapack.EVRange, tryrac bool, n, typ int, d, e []float64, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("jobz=%c,range=%c,tryrac=%v,n=%d,type=%d", jobz, rng, tryrac, n, typ)
+
+	// Compute the reference eigenvalues using Dsterf.
+	wWant := make([]float64, n)
+	copy(wWant, d)
+	eWant := make([]float64, len(e))
+	copy(eWant, e)
+	if !impl.Dsterf(n, wWant, eWant) {
+		t.Errorf("%v: computing reference eigenvalues using Dsterf failed", name)
+		return
+	}
+	var tnorm float64
+	for i := 0; i < n; i++ {
+		tnorm = math.Max(tnorm, math.Abs(d[i]))
+		if i < n-1 {
+			tnorm = math.Max(tnorm, math.Abs(e[i]))
+		}
+	}
+	wMax := math.Max(dlamchS, tnorm)
+
+	// Select the eigenvalues to compute. For a range of values the interval
+	// bounds are placed in the middle between two reference eigenvalues so
+	// that the expected number of eigenvalues is well defined.
+	var (
+		vl, vu float64
+		il, iu int
+	)
+	first, last := 0, n-1
+	switch rng {
+	case lapack.EVRangeIndex:
+		if n > 0 {
+			il = rnd.IntN(n)
+			iu = il + rnd.IntN(n-il)
+		} else {
+			iu = -1
+		}
+		first, last = il, iu
+	case lapack.EVRangeValue:
+		if n == 0 {
+			vl, vu = 0, 1
+			break
+		}
+		first = rnd.IntN(n)
+		last = first + rnd.IntN(n-first)
+		gap := 1e-8 * wMax
+		if first > 0 && wWant[first]-wWant[first-1] < gap ||
+			last < n-1 && wWant[last+1]-wWant[last] < gap {
+			// The interval bounds cannot be placed safely.
+			return
+		}
+		vl = wWant[0] - 1 - wMax
+		if first > 0 {
+			vl = (wWant[first-1] + wWant[first]) / 2
+		}
+		vu = wWant[n-1] + 1 + wMax
+		if last < n-1 {
+			vu = (wWant[last] + wWant[last+1]) / 2
+		}
+	}
+	mWant := last - first + 1
+
+	// Query the workspace and, if eigenvectors are computed, the number of
+	// columns of z.
+	wantz := jobz == lapack.EVCompute
+	nzc := 0
+	if wantz {
+		nzc = -1
+	}
+	dq := make([]float64, n)
+	copy(dq, d)
+	eq := make([]float64, n)
+	copy(eq, e)
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	nzcGot, _ := impl.Dstemr(jobz, rng, n, dq, eq, vl, vu, il, iu, nil, nil, 1, nzc, nil, tryrac, work, -1, iwork, -1)
+	ldz := 1
+	if wantz {
+		if nzcGot < mWant {
+			t.Errorf("%v: unexpected number of columns of z from query; got %d, want at least %d", name, nzcGot, mWant)
+			return
+		}
+		nzc = nzcGot
+		ldz = max(1, nzc)
+	}
+	lwork := int(work[0])
+	liwork := iwork[0]
+	work = nanSlice(lwork)
+	iwork = make([]int, liwork)
+	for i := range iwork {
+		iwork[i] = -1
+	}
+
+	dGot := make([]float64, n)
+	copy(dGot, d)
+	eGot := make([]float64, n)
+	copy(eGot, e)
+	w := nanSlice(n)
+	var (
+		z      []float64
+		isuppz []int
+	)
+	if wantz {
+		z = nanSlice(max(0, (n-1)*ldz+nzc))
+		isuppz = make([]int, 2*nzc)
+	}
+	m, ok := impl.Dstemr(jobz, rng, n, dGot, eGot, vl, vu, il, iu, w, z, ldz, nzc, isuppz, tryrac, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dstemr failed", name)
+		return
+	}
+	if m != mWant {
+		t.Errorf("%v: unexpected number of eigenvalues; got %d, want %d", name, m, mWant)
+		return
+	}
+	if m == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(w[:m]) {
+		t.Errorf("%v: eigenvalues are not sorted", name)
+	}
+	for i := 0; i < m; i++ {
+		if math.Abs(w[i]-wWant[first+i]) > tol*float64(n)*wMax {
+			t.Errorf("%v: eigenvalue %d mismatch with Dsterf; got %v, want %v", name, first+i, w[i], wWant[first+i])
+			break
+		}
+	}
+
+	if !wantz {
+		return
+	}
+
+	// Check that the eigenvectors are orthonormal.
+	zGen := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+	if resid := residualOrthogonal(zGen, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+	}
+
+	// Check that the elements outside of the support are zero.
+	for j := 0; j < m; j++ {
+		for i := 0; i < n; i++ {
+			if (i < isuppz[2*j] || isuppz[2*j+1] < i) && z[i*ldz+j] != 0 {
+				t.Errorf("%v: nonzero element outside of the support of eigenvector %d", name, j)
+				break
+			}
+		}
+	}
+
+	// Check that |T z_j - w_j z_j| / (|T| n) is small.
+	var resid float64
+	for j := 0; j < m; j++ {
+		for i := 0; i < n; i++ {
+			r := (d[i] - w[j]) * z[i*ldz+j]
+			if i > 0 {
+				r += e[i-1] * z[(i-1)*ldz+j]
+			}
+			if i < n-1 {
+				r += e[i] * z[(i+1)*ldz+j]
+			}
+			resid = math.Max(resid, math.Abs(r))
+		}
+	}
+	resid /= wMax * float64(n)
+	if tnorm == 0 {
+		resid *= wMax
+	}
+	if resid > tol {
+		t.Errorf("%v: unexpected result; |T Z - Z W|/(|T| n)=%v", name, resid)
+	}
+}
@@ -127,7 +127,7 @@ func DsteqrTest(t *testing.T, impl Dsteqrer) {
 					Stride: lda,
 					Data:   a,
 				}
-				if !eigenDecompCorrect(d, truth, V) {
+				if !eigenDecompCorrect(d, truth, V, scaleTol(impl, 1e-8)) {
 					t.Errorf("Eigen reconstruction mismatch. fromFull = %v, n = %v",
 						compz == lapack.EVOrig, n)
 				}
@@ -137,7 +137,7 @@ func DsteqrTest(t *testing.T, impl Dsteqrer) {
 					work[i] = rnd.Float64()
 				}
 				impl.Dsteqr(lapack.EVCompNone, n, dDecomp, eDecomp, aDecomp, lda, work)
-				if !floats.EqualApprox(d, dAns, 1e-8) {
+				if !floats.EqualApprox(d, dAns, scaleTol(impl, 1e-8)) {
 					t.Errorf("Eigenvalue mismatch when eigenvectors not computed")
 				}
 			}
@@ -145,14 +145,15 @@ func DsteqrTest(t *testing.T, impl Dsteqrer) {
 	}
 }
 
-// eigenDecompCorrect returns whether the eigen decomposition is correct.
+// eigenDecompCorrect returns whether the eigen decomposition is correct to
+// within tol.
 // It checks if
 //
 //	A * v ≈ λ * v
 //
 // where the eigenvalues λ are stored in values, and the eigenvectors are stored
 // in the columns of v.
-func eigenDecompCorrect(values []float64, A, V blas64.General) bool {
+func eigenDecompCorrect(values []float64, A, V blas64.General, tol float64) bool {
 	n := A.Rows
 	for i := 0; i < n; i++ {
 		lambda := values[i]
@@ -166,7 +167,7 @@ func eigenDecompCorrect(values []float64, A, V blas64.General) bool {
 		v := blas64.Vector{Inc: 1, Data: vector}
 		ans1 := blas64.Vector{Inc: 1, Data: make([]float64, n)}
 		blas64.Gemv(blas.NoTrans, 1, A, v, 0, ans1)
-		if !floats.EqualApprox(ans1.Data, ans2, 1e-8) {
+		if !floats.EqualApprox(ans1.Data, ans2, tol) {
 			return false
 		}
 	}
@@ -24,7 +24,9 @@ type Dsterfer interface {
 }
 
 func DsterfTest(t *testing.T, impl Dsterfer) {
-	const tol = 1e-14
+	tol := scaleTol(impl, 1e-14)
+	eps := epsilon(impl)
+	sfmin := safeMin(impl)
 
 	// Tests with precomputed eigenvalues.
 	for cas, test := range []struct {
@@ -64,7 +66,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 	rnd := rand.New(rand.NewPCG(1, 1))
 	// Probabilistic tests.
 	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 50} {
-		for typ := 0; typ <= 8; typ++ {
+		for typ := 0; typ <= 10; typ++ {
 			d := make([]float64, n)
 			var e []float64
 			if n > 1 {
@@ -86,7 +88,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 					if i == 0 {
 						d[i] = 1
 					} else {
-						d[i] = 1 - (1-dlamchE)*float64(i)/float64(n-1)
+						d[i] = 1 - (1-eps)*float64(i)/float64(n-1)
 					}
 					if rnd.Float64() < 0.5 {
 						d[i] *= -1
@@ -99,7 +101,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 					if i == 0 {
 						d[i] = 1
 					} else {
-						d[i] = math.Pow(dlamchE, float64(i)/float64(n-1))
+						d[i] = math.Pow(eps, float64(i)/float64(n-1))
 					}
 					if rnd.Float64() < 0.5 {
 						d[i] *= -1
@@ -108,10 +110,10 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 				switch typ {
 				case 4:
 					// Multiply by SQRT(overflow threshold).
-					floats.Scale(math.Sqrt(1/dlamchS), d)
+					floats.Scale(math.Sqrt(1/sfmin), d)
 				case 5:
 					// Multiply by SQRT(underflow threshold).
-					floats.Scale(math.Sqrt(dlamchS), d)
+					floats.Scale(math.Sqrt(sfmin), d)
 				}
 			case 6:
 				// A diagonal matrix with "clustered" entries 1, eps, ..., eps
@@ -120,7 +122,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 					if i == 0 {
 						d[i] = 1
 					} else {
-						d[i] = dlamchE
+						d[i] = eps
 					}
 				}
 				for i := range d {
@@ -133,7 +135,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 				for i := range d {
 					d[i] = rnd.NormFloat64()
 				}
-			case 8:
+			case 8, 9, 10:
 				// Random symmetric tridiagonal matrix.
 				for i := range d {
 					d[i] = rnd.NormFloat64()
@@ -141,6 +143,18 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 				for i := range e {
 					e[i] = rnd.NormFloat64()
 				}
+				switch typ {
+				case 9:
+					// Multiply by SQRT(overflow threshold) so that the
+					// unreduced blocks are scaled down.
+					floats.Scale(math.Sqrt(1/sfmin), d)
+					floats.Scale(math.Sqrt(1/sfmin), e)
+				case 10:
+					// Multiply by SQRT(underflow threshold) so that the
+					// unreduced blocks are scaled up.
+					floats.Scale(math.Sqrt(sfmin), d)
+					floats.Scale(math.Sqrt(sfmin), e)
+				}
 			}
 			eCopy := make([]float64, len(e))
 			copy(eCopy, e)
@@ -190,7 +204,7 @@ func DsterfTest(t *testing.T, impl Dsterfer) {
 				dAbs := math.Max(math.Abs(di), math.Abs(dWant[i]))
 				dMax = math.Max(dMax, dAbs)
 			}
-			dMax = math.Max(dlamchS, dMax)
+			dMax = math.Max(sfmin, dMax)
 			if diff > tol*dMax {
 				t.Errorf("%v: unexpected result; |dGot-dWant|=%v", name, diff)
 			}
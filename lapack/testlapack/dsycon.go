@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyconer interface {
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+
+	Dsytrser
+}
+
+// DsyconTest tests Dsycon by generating a random symmetric indefinite matrix A
+// and checking that the estimated condition number is not too different from
+// the condition number computed via the explicit inverse of A.
+func DsyconTest(t *testing.T, impl Dsyconer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, kind := range []int{0, 1} {
+					dsyconTest(t, impl, rnd, uplo, n, lda, kind)
+				}
+			}
+		}
+	}
+}
+
+func dsyconTest(t *testing.T, impl Dsyconer, rnd *rand.Rand, uplo blas.Uplo, n, lda, kind int) {
+	const ratioThresh = 10
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,kind=%v", string(uplo), n, lda, kind)
+
+	a := randSymIndefinite(n, lda, kind, rnd)
+	aNorm := dlansy(lapack.MaxColumnSum, uplo, n, a, lda)
+
+	// Compute the factorization of A.
+	aFac := make([]float64, len(a))
+	copy(aFac, a)
+	ipiv := make([]int, n)
+	work := make([]float64, max(1, 2*n))
+	ok := impl.Dsytrf(uplo, n, aFac, lda, ipiv, work, len(work))
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dsytrf failed", name)
+	}
+
+	// Compute an estimate of rCond.
+	iwork := make([]int, n)
+	aFacCopy := make([]float64, len(aFac))
+	copy(aFacCopy, aFac)
+	rCondGot := impl.Dsycon(uplo, n, aFac, lda, ipiv, aNorm, work, iwork)
+
+	if !floats.Equal(aFac, aFacCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+
+	// Form the inverse of A to compute a good estimate of the condition number
+	//  rCondWant := 1/(norm(A) * norm(inv(A)))
+	ldinv := max(1, n)
+	aInv := make([]float64, n*ldinv)
+	for i := 0; i < n; i++ {
+		aInv[i*ldinv+i] = 1
+	}
+	impl.Dsytrs(uplo, n, n, aFac, lda, ipiv, aInv, ldinv)
+	aInvNorm := dlange(lapack.MaxColumnSum, n, n, aInv, ldinv)
+	rCondWant := 1.0
+	if aNorm > 0 && aInvNorm > 0 {
+		rCondWant = 1 / aNorm / aInvNorm
+	}
+
+	ratio := rCondTestRatio(rCondGot, rCondWant)
+	if ratio >= ratioThresh {
+		t.Errorf("%v: unexpected value of rcond. got=%v, want=%v (ratio=%v)", name, rCondGot, rCondWant, ratio)
+	}
+}
@@ -90,7 +90,7 @@ func DsyevTest(t *testing.T, impl Dsyever) {
 					Data:   a,
 				}
 
-				if !eigenDecompCorrect(w, orig, V) {
+				if !eigenDecompCorrect(w, orig, V, scaleTol(impl, 1e-8)) {
 					t.Errorf("Decomposition mismatch")
 				}
 
@@ -106,7 +106,7 @@ func DsyevTest(t *testing.T, impl Dsyever) {
 					work[i] = rnd.Float64()
 				}
 				impl.Dsyev(lapack.EVNone, uplo, n, a, lda, w, work, len(work))
-				if !floats.EqualApprox(w, wAns, 1e-8) {
+				if !floats.EqualApprox(w, wAns, scaleTol(impl, 1e-8)) {
 					t.Errorf("Eigenvalue mismatch when vectors not computed")
 				}
 			}
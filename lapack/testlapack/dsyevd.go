@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevder interface {
+	Dsyever
+	Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+}
+
+func DsyevdTest(t *testing.T, impl Dsyevder) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, n := range []int{0, 1, 2, 5, 10, 26, 50, 100, 171} {
+			for _, lda := range []int{max(1, n), n + 7} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					for typ := 0; typ < 3; typ++ {
+						dsyevdTest(t, impl, uplo, n, lda, typ, wl, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsyevdTest(t *testing.T, impl Dsyevder, uplo blas.Uplo, n, lda, typ int, wl worklen, rnd *rand.Rand) {
+	tol := scaleTol(impl, 1e-13)
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,type=%v,work=%v", uploToString(uplo), n, lda, typ, wl)
+
+	// Generate a symmetric matrix A.
+	a := make([]float64, n*lda)
+	switch typ {
+	case 0:
+		// Random matrix.
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+	case 1:
+		// Matrix with eigenvalues 1, 2, ..., n each of which except the
+		// largest is repeated twice.
+		d := make([]float64, n)
+		for i := range d {
+			d[i] = float64((i + 1) / 2)
+		}
+		Dlagsy(n, max(0, n-1), d, a, lda, rnd, make([]float64, 2*n))
+	case 2:
+		// Matrix with geometrically distributed eigenvalues and condition
+		// number 1e10.
+		d := make([]float64, n)
+		Dlatm1(d, 3, 1e10, true, 2, rnd)
+		Dlagsy(n, max(0, n-1), d, a, lda, rnd, make([]float64, 2*n))
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+		name := name + fmt.Sprintf(",jobz=%c", jobz)
+		copy(a, aCopy)
+
+		var lwork, liwork int
+		switch wl {
+		case minimumWork:
+			switch {
+			case n <= 1:
+				lwork, liwork = 1, 1
+			case jobz == lapack.EVCompute:
+				lwork, liwork = 1+6*n+2*n*n, 3+5*n
+			default:
+				lwork, liwork = 2*n+1, 1
+			}
+		case optimumWork:
+			work := make([]float64, 1)
+			iwork := make([]int, 1)
+			impl.Dsyevd(jobz, uplo, n, a, lda, nil, work, -1, iwork, -1)
+			lwork, liwork = int(work[0]), iwork[0]
+		}
+		work := nanSlice(lwork)
+		iwork := make([]int, liwork)
+		w := nanSlice(n)
+
+		ok := impl.Dsyevd(jobz, uplo, n, a, lda, w, work, lwork, iwork, liwork)
+		if !ok {
+			t.Errorf("%v: Dsyevd failed", name)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		if !sort.Float64sAreSorted(w) {
+			t.Errorf("%v: eigenvalues are not sorted", name)
+		}
+
+		// Compare the eigenvalues with those computed by Dsyev.
+		aWant := make([]float64, len(aCopy))
+		copy(aWant, aCopy)
+		wWant := make([]float64, n)
+		work = make([]float64, 1)
+		impl.Dsyev(lapack.EVNone, uplo, n, aWant, lda, wWant, work, -1)
+		work = make([]float64, int(work[0]))
+		impl.Dsyev(lapack.EVNone, uplo, n, aWant, lda, wWant, work, len(work))
+		wMax := math.Max(math.Abs(wWant[0]), math.Abs(wWant[n-1]))
+		for i := range w {
+			if math.Abs(w[i]-wWant[i]) > tol*float64(n)*wMax {
+				t.Errorf("%v: eigenvalue %d mismatch; got %v, want %v", name, i, w[i], wWant[i])
+				break
+			}
+		}
+
+		if jobz == lapack.EVNone {
+			continue
+		}
+
+		// Check that the eigenvectors are orthonormal.
+		z := blas64.General{Rows: n, Cols: n, Stride: lda, Data: a}
+		if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+			t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+		}
+
+		// Check that |A - Z W Zᵀ| / (|A| n) is small.
+		aSym := make([]float64, n*n)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				if uplo == blas.Upper {
+					aSym[i*n+j] = aCopy[i*lda+j]
+				} else {
+					aSym[i*n+j] = aCopy[j*lda+i]
+				}
+			}
+		}
+		anorm := dlansy(lapack.MaxColumnSum, blas.Upper, n, aSym, n)
+		bi := blas64.Implementation()
+		for i := 0; i < n; i++ {
+			bi.Dsyr(blas.Upper, n, -w[i], a[i:], lda, aSym, n)
+		}
+		resid := dlansy(lapack.MaxColumnSum, blas.Upper, n, aSym, n) / anorm / float64(n)
+		if resid > tol {
+			t.Errorf("%v: unexpected result; |A - Z W Zᵀ|/(|A| n)=%v", name, resid)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevrer interface {
+	Dsyever
+	Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+}
+
+func DsyevrTest(t *testing.T, impl Dsyevrer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, n := range []int{0, 1, 2, 5, 10, 26, 50, 100, 171} {
+			for _, lda := range []int{max(1, n), n + 7} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					for typ := 0; typ < 3; typ++ {
+						for _, rng := range []lapack.EVRange{lapack.EVRangeAll, lapack.EVRangeIndex, lapack.EVRangeValue} {
+							dsyevrTest(t, impl, rng, uplo, n, lda, typ, wl, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsyevrTest(t *testing.T, impl Dsyevrer, rng lapack.EVRange, uplo blas.Uplo, n, lda, typ int, wl worklen, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("range=%c,uplo=%v,n=%v,lda=%v,type=%v,work=%v", rng, uploToString(uplo), n, lda, typ, wl)
+
+	// Generate a symmetric matrix A.
+	a := make([]float64, n*lda)
+	switch typ {
+	case 0:
+		// Random matrix.
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+	case 1:
+		// Matrix with eigenvalues 1, 2, ..., n each of which except the
+		// largest is repeated twice.
+		d := make([]float64, n)
+		for i := range d {
+			d[i] = float64((i + 1) / 2)
+		}
+		Dlagsy(n, max(0, n-1), d, a, lda, rnd, make([]float64, 2*n))
+	case 2:
+		// Matrix with geometrically distributed eigenvalues and condition
+		// number 1e10.
+		d := make([]float64, n)
+		Dlatm1(d, 3, 1e10, true, 2, rnd)
+		Dlagsy(n, max(0, n-1), d, a, lda, rnd, make([]float64, 2*n))
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	// Compute the reference eigenvalues using Dsyev.
+	wWant := make([]float64, n)
+	work := make([]float64, 1)
+	impl.Dsyev(lapack.EVNone, uplo, n, a, lda, wWant, work, -1)
+	work = make([]float64, max(1, int(work[0])))
+	impl.Dsyev(lapack.EVNone, uplo, n, a, lda, wWant, work, len(work))
+	var wMax float64
+	if n > 0 {
+		wMax = math.Max(math.Abs(wWant[0]), math.Abs(wWant[n-1]))
+	}
+
+	// Select the eigenvalues to compute. For a range of values the interval
+	// bounds are placed in the middle between two reference eigenvalues that
+	// are well separated.
+	var (
+		vl, vu float64
+		il, iu int
+	)
+	first, last := 0, n-1
+	switch rng {
+	case lapack.EVRangeIndex:
+		if n > 0 {
+			il = rnd.IntN(n)
+			iu = il + rnd.IntN(n-il)
+		} else {
+			iu = -1
+		}
+		first, last = il, iu
+	case lapack.EVRangeValue:
+		if n == 0 {
+			vl, vu = 0, 1
+			break
+		}
+		first = rnd.IntN(n)
+		last = first + rnd.IntN(n-first)
+		gap := 1e-8 * wMax
+		if first > 0 && wWant[first]-wWant[first-1] < gap ||
+			last < n-1 && wWant[last+1]-wWant[last] < gap {
+			// The interval bounds cannot be placed safely.
+			return
+		}
+		vl = wWant[0] - 1
+		if first > 0 {
+			vl = (wWant[first-1] + wWant[first]) / 2
+		}
+		vu = wWant[n-1] + 1
+		if last < n-1 {
+			vu = (wWant[last] + wWant[last+1]) / 2
+		}
+	}
+	mWant := last - first + 1
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = mWant
+	}
+
+	for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+		name := name + fmt.Sprintf(",jobz=%c", jobz)
+		copy(a, aCopy)
+
+		ldz := max(1, ncols)
+		var lwork, liwork int
+		switch wl {
+		case minimumWork:
+			lwork, liwork = max(1, 26*n), max(1, 12*n)
+		case optimumWork:
+			work := make([]float64, 1)
+			iwork := make([]int, 1)
+			impl.Dsyevr(jobz, rng, uplo, n, a, lda, vl, vu, il, iu, nil, nil, ldz, work, -1, iwork, -1)
+			lwork, liwork = int(work[0]), iwork[0]
+		}
+		work := nanSlice(lwork)
+		iwork := make([]int, liwork)
+		w := nanSlice(n)
+		var z []float64
+		if jobz == lapack.EVCompute {
+			z = nanSlice(max(0, (n-1)*ldz+ncols))
+		}
+
+		m, ok := impl.Dsyevr(jobz, rng, uplo, n, a, lda, vl, vu, il, iu, w, z, ldz, work, lwork, iwork, liwork)
+		if !ok {
+			t.Errorf("%v: Dsyevr failed", name)
+			continue
+		}
+		if m != mWant {
+			t.Errorf("%v: unexpected number of eigenvalues; got %d, want %d", name, m, mWant)
+			continue
+		}
+		if m == 0 {
+			continue
+		}
+		if !sort.Float64sAreSorted(w[:m]) {
+			t.Errorf("%v: eigenvalues are not sorted", name)
+		}
+		for i := 0; i < m; i++ {
+			if math.Abs(w[i]-wWant[first+i]) > tol*float64(n)*wMax {
+				t.Errorf("%v: eigenvalue %d mismatch; got %v, want %v", name, first+i, w[i], wWant[first+i])
+				break
+			}
+		}
+
+		if jobz == lapack.EVNone {
+			continue
+		}
+
+		// Check that the eigenvectors are orthonormal.
+		zGen := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+		if resid := residualOrthogonal(zGen, false); resid > tol*float64(n) {
+			t.Errorf("%v: Z is not orthogonal; resid=%v, want<=%v", name, resid, tol*float64(n))
+		}
+
+		// Check that |A Z - Z W| / (|A| n) is small.
+		aGen := blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := aCopy[i*lda+j]
+				if uplo == blas.Lower {
+					v = aCopy[j*lda+i]
+				}
+				aGen.Data[i*n+j] = v
+				aGen.Data[j*n+i] = v
+			}
+		}
+		anorm := dlange(lapack.MaxColumnSum, n, n, aGen.Data, n)
+		r := blas64.General{Rows: n, Cols: m, Stride: m, Data: make([]float64, n*m)}
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				r.Data[i*m+j] = z[i*ldz+j] * w[j]
+			}
+		}
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aGen, zGen, -1, r)
+		resid := dlange(lapack.MaxColumnSum, n, m, r.Data, m) / anorm / float64(n)
+		if resid > tol {
+			t.Errorf("%v: unexpected result; |A Z - Z W|/(|A| n)=%v", name, resid)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Dsytf2er interface {
+	Dsytf2(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) bool
+}
+
+func Dsytf2Test(t *testing.T, impl Dsytf2er) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31} {
+			for _, lda := range []int{max(1, n), n + 4} {
+				for _, kind := range []int{0, 1, 2} {
+					name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,kind=%v", string(uplo), n, lda, kind)
+
+					a := randSymIndefinite(n, lda, kind, rnd)
+					aFac := make([]float64, len(a))
+					copy(aFac, a)
+					ipiv := make([]int, n)
+					ok := impl.Dsytf2(uplo, n, aFac, lda, ipiv)
+					if !ok && kind != 2 {
+						t.Errorf("%v: unexpected singular factor", name)
+					}
+					if ok && kind == 2 && n > 0 {
+						t.Errorf("%v: singular factor not detected", name)
+					}
+
+					checkSytrf(t, name, uplo, n, a, aFac, lda, ipiv, tol)
+				}
+			}
+		}
+	}
+}
@@ -22,7 +22,7 @@ type Dsytrder interface {
 }
 
 func DsytrdTest(t *testing.T, impl Dsytrder) {
-	const tol = 1e-14
+	tol := scaleTol(impl, 1e-14)
 
 	rnd := rand.New(rand.NewPCG(1, 1))
 	for tc, test := range []struct {
@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrfer interface {
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) bool
+}
+
+func DsytrfTest(t *testing.T, impl Dsytrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 65, 100, 129} {
+			for _, lda := range []int{max(1, n), n + 4} {
+				for _, kind := range []int{0, 1, 2} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						testDsytrf(t, impl, rnd, uplo, n, lda, kind, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDsytrf(t *testing.T, impl Dsytrfer, rnd *rand.Rand, uplo blas.Uplo, n, lda, kind int, wl worklen) {
+	tol := scaleTol(impl, 1e-13)
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,kind=%v,work=%v", string(uplo), n, lda, kind, wl)
+
+	a := randSymIndefinite(n, lda, kind, rnd)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = 1
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dsytrf(uplo, n, nil, lda, nil, work, -1)
+		lwork = max(1, int(work[0])/2)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dsytrf(uplo, n, nil, lda, nil, work, -1)
+		lwork = int(work[0])
+	}
+	work := make([]float64, lwork)
+
+	aFac := make([]float64, len(a))
+	copy(aFac, a)
+	ipiv := make([]int, n)
+	ok := impl.Dsytrf(uplo, n, aFac, lda, ipiv, work, lwork)
+	if !ok && kind != 2 {
+		t.Errorf("%v: unexpected singular factor", name)
+	}
+	if ok && kind == 2 && n > 0 {
+		t.Errorf("%v: singular factor not detected", name)
+	}
+
+	checkSytrf(t, name, uplo, n, a, aFac, lda, ipiv, tol)
+}
+
+// checkSytrf checks that ipiv is a valid pivot vector and that the
+// factorization in aFac and ipiv computed by Dsytrf or Dsytf2 reconstructs the
+// symmetric matrix whose triangle is stored in a.
+func checkSytrf(t *testing.T, name string, uplo blas.Uplo, n int, a, aFac []float64, lda int, ipiv []int, tol float64) {
+	t.Helper()
+
+	for k := 0; k < n; {
+		kp := ipiv[k]
+		if kp >= 0 {
+			if kp >= n {
+				t.Errorf("%v: ipiv[%v] out of range", name, k)
+				return
+			}
+			k++
+			continue
+		}
+		kp = -kp - 1
+		if k == n-1 || ipiv[k+1] != ipiv[k] || kp >= n {
+			t.Errorf("%v: invalid 2×2 pivot at %v", name, k)
+			return
+		}
+		k += 2
+	}
+
+	resid := sytrfResidual(uplo, n, a, aFac, lda, ipiv)
+	if resid > tol {
+		t.Errorf("%v: unexpected residual |A - U*D*Uᵀ|/(n*|A|); got %v, want <= %v", name, resid, tol)
+	}
+}
+
+// randSymIndefinite returns the upper and lower triangles of a random n×n
+// symmetric matrix with leading dimension lda. If kind is 0, the elements are
+// normally distributed, if kind is 1, the matrix additionally has a zero
+// diagonal (unless n == 1) which forces the use of 2×2 pivots, and if kind is
+// 2, the matrix is additionally singular with a zero middle row and column.
+func randSymIndefinite(n, lda, kind int, rnd *rand.Rand) []float64 {
+	a := make([]float64, max(0, (n-1)*lda+n))
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.NormFloat64()
+			a[i*lda+j] = v
+			a[j*lda+i] = v
+		}
+		if kind == 1 && n > 1 {
+			a[i*lda+i] = 0
+		}
+	}
+	if kind == 2 && n > 0 {
+		k := n / 2
+		for i := 0; i < n; i++ {
+			a[i*lda+k] = 0
+			a[k*lda+i] = 0
+		}
+	}
+	return a
+}
+
+// sytrfResidual returns the residual
+//
+//	|A - U*D*Uᵀ|_1 / (n * |A|_1)  if uplo == blas.Upper, or
+//	|A - L*D*Lᵀ|_1 / (n * |A|_1)  if uplo == blas.Lower,
+//
+// where the factorization of the symmetric matrix A is given by aFac and ipiv
+// as computed by Dsytrf.
+func sytrfResidual(uplo blas.Uplo, n int, a, aFac []float64, lda int, ipiv []int) float64 {
+	if n == 0 {
+		return 0
+	}
+	m := sytrfReconstruct(uplo, n, aFac, lda, ipiv)
+	anorm := dlansy(lapack.MaxColumnSum, uplo, n, a, lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aij := a[i*lda+j]
+			if (uplo == blas.Upper && i > j) || (uplo == blas.Lower && i < j) {
+				aij = a[j*lda+i]
+			}
+			m.Data[i*m.Stride+j] -= aij
+		}
+	}
+	resid := dlange(lapack.MaxColumnSum, n, n, m.Data, m.Stride)
+	if anorm == 0 {
+		return resid
+	}
+	return resid / anorm / float64(n)
+}
+
+// sytrfReconstruct returns the n×n matrix U*D*Uᵀ or L*D*Lᵀ from the
+// factorization stored in a and ipiv as computed by Dsytrf.
+func sytrfReconstruct(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) blas64.General {
+	m := zeros(n, n, n)
+
+	// blocks holds the first index and the size of each diagonal block.
+	type block struct{ j, s int }
+	var blocks []block
+	for k := 0; k < n; {
+		s := 1
+		if ipiv[k] < 0 {
+			s = 2
+		}
+		blocks = append(blocks, block{k, s})
+		k += s
+	}
+
+	// Form the block diagonal matrix D.
+	for _, b := range blocks {
+		j := b.j
+		m.Data[j*m.Stride+j] = a[j*lda+j]
+		if b.s == 2 {
+			off := a[j*lda+j+1]
+			if uplo == blas.Lower {
+				off = a[(j+1)*lda+j]
+			}
+			m.Data[j*m.Stride+j+1] = off
+			m.Data[(j+1)*m.Stride+j] = off
+			m.Data[(j+1)*m.Stride+j+1] = a[(j+1)*lda+j+1]
+		}
+	}
+
+	// Apply P[k]*U[k] or P[k]*L[k] from both sides starting with the
+	// innermost factor.
+	if uplo == blas.Lower {
+		for i, k := 0, len(blocks)-1; i < k; i, k = i+1, k-1 {
+			blocks[i], blocks[k] = blocks[k], blocks[i]
+		}
+	}
+	for _, b := range blocks {
+		j, s := b.j, b.s
+		// rows are the rows of the multipliers v in columns j:j+s.
+		var r0, r1, kk int
+		if uplo == blas.Upper {
+			r0, r1, kk = 0, j, j
+		} else {
+			r0, r1, kk = j+s, n, j+s-1
+		}
+		// M = U[k]*M*U[k]ᵀ.
+		for i := r0; i < r1; i++ {
+			for c := 0; c < n; c++ {
+				for l := 0; l < s; l++ {
+					m.Data[i*m.Stride+c] += a[i*lda+j+l] * m.Data[(j+l)*m.Stride+c]
+				}
+			}
+		}
+		for r := 0; r < n; r++ {
+			for i := r0; i < r1; i++ {
+				for l := 0; l < s; l++ {
+					m.Data[r*m.Stride+i] += m.Data[r*m.Stride+j+l] * a[i*lda+j+l]
+				}
+			}
+		}
+		// M = P[k]*M*P[k]ᵀ.
+		kp := ipiv[j+s-1]
+		if kp < 0 {
+			kp = -kp - 1
+		}
+		if kp != kk {
+			blas64.Swap(blas64.Vector{N: n, Data: m.Data[kk*m.Stride:], Inc: 1}, blas64.Vector{N: n, Data: m.Data[kp*m.Stride:], Inc: 1})
+			blas64.Swap(blas64.Vector{N: n, Data: m.Data[kk:], Inc: m.Stride}, blas64.Vector{N: n, Data: m.Data[kp:], Inc: m.Stride})
+		}
+	}
+	return m
+}
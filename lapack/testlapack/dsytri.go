@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrier interface {
+	Dsytri(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64) bool
+
+	Dsytrfer
+}
+
+func DsytriTest(t *testing.T, impl Dsytrier) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 65} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, kind := range []int{0, 1, 2} {
+					testDsytri(t, impl, rnd, uplo, n, lda, kind)
+				}
+			}
+		}
+	}
+}
+
+func testDsytri(t *testing.T, impl Dsytrier, rnd *rand.Rand, uplo blas.Uplo, n, lda, kind int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,kind=%v", string(uplo), n, lda, kind)
+
+	a := randSymIndefinite(n, lda, kind, rnd)
+
+	// Compute the factorization of A.
+	aInv := make([]float64, len(a))
+	copy(aInv, a)
+	ipiv := make([]int, n)
+	work := make([]float64, max(1, n))
+	okFac := impl.Dsytrf(uplo, n, aInv, lda, ipiv, work, len(work))
+
+	// Compute the inverse of A.
+	aFac := make([]float64, len(aInv))
+	copy(aFac, aInv)
+	ok := impl.Dsytri(uplo, n, aInv, lda, ipiv, work)
+	if ok != okFac {
+		t.Errorf("%v: unexpected ok; got %v, want %v", name, ok, okFac)
+	}
+	if !ok {
+		if !equalApproxGeneral(blas64.General{Rows: n, Cols: n, Stride: lda, Data: aInv}, blas64.General{Rows: n, Cols: n, Stride: lda, Data: aFac}, 0) {
+			t.Errorf("%v: unexpected modification of A for singular D", name)
+		}
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Fill the full symmetric inverse from its stored triangle.
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if uplo == blas.Upper {
+				aInv[j*lda+i] = aInv[i*lda+j]
+			} else {
+				aInv[i*lda+j] = aInv[j*lda+i]
+			}
+		}
+	}
+
+	// Compute the residual |I - A*inv(A)|_1 / (|A|_1 * |inv(A)|_1 * n).
+	aGen := blas64.General{Rows: n, Cols: n, Stride: lda, Data: a}
+	aInvGen := blas64.General{Rows: n, Cols: n, Stride: lda, Data: aInv}
+	r := eye(n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, aGen, aInvGen, 1, r)
+	resid := dlange(lapack.MaxColumnSum, n, n, r.Data, r.Stride)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a, lda)
+	ainvnorm := dlange(lapack.MaxColumnSum, n, n, aInv, lda)
+	resid /= anorm * ainvnorm * float64(n)
+	if resid > tol {
+		t.Errorf("%v: unexpected residual; got %v, want <= %v", name, resid, tol)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrser interface {
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+
+	Dsytrfer
+}
+
+func DsytrsTest(t *testing.T, impl Dsytrser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 65} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, ld := range []struct{ a, b int }{
+					{max(1, n), max(1, nrhs)},
+					{n + 3, nrhs + 5},
+				} {
+					for _, kind := range []int{0, 1} {
+						testDsytrs(t, impl, rnd, uplo, n, nrhs, ld.a, ld.b, kind)
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDsytrs(t *testing.T, impl Dsytrser, rnd *rand.Rand, uplo blas.Uplo, n, nrhs, lda, ldb, kind int) {
+	tol := scaleTol(impl, 1e-14)
+
+	name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,lda=%v,ldb=%v,kind=%v", string(uplo), n, nrhs, lda, ldb, kind)
+
+	// Generate a random symmetric indefinite matrix A and a random
+	// solution X.
+	a := randSymIndefinite(n, lda, kind, rnd)
+	want := randomGeneral(n, nrhs, ldb, rnd)
+
+	// Compute the right-hand side matrix as A * X.
+	b := nanGeneral(n, nrhs, ldb)
+	aGen := blas64.General{Rows: n, Cols: n, Stride: lda, Data: a}
+	if n > 0 && nrhs > 0 {
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aGen, want, 0, b)
+	}
+	bCopy := cloneGeneral(b)
+
+	// Compute the factorization of A.
+	aFac := make([]float64, len(a))
+	copy(aFac, a)
+	ipiv := make([]int, n)
+	work := make([]float64, 1)
+	impl.Dsytrf(uplo, n, aFac, lda, ipiv, work, -1)
+	work = make([]float64, int(work[0]))
+	ok := impl.Dsytrf(uplo, n, aFac, lda, ipiv, work, len(work))
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dsytrf failed", name)
+	}
+	aFacCopy := make([]float64, len(aFac))
+	copy(aFacCopy, aFac)
+
+	// Solve A * X = B.
+	impl.Dsytrs(uplo, n, nrhs, aFac, lda, ipiv, b.Data, b.Stride)
+
+	if !floats.Equal(aFac, aFacCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !generalOutsideAllNaN(b) {
+		t.Errorf("%v: out-of-range modification of B", name)
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// Compute the residual |B - A*X|_1 / (|A|_1 * |X|_1 * n).
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, aGen, b, 1, bCopy)
+	resid := dlange(lapack.MaxColumnSum, n, nrhs, bCopy.Data, bCopy.Stride)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a, lda)
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, b.Data, b.Stride)
+	resid /= anorm * xnorm * float64(n)
+	if resid > tol {
+		t.Errorf("%v: unexpected residual; got %v, want <= %v", name, resid, tol)
+	}
+}
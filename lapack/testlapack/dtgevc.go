@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtgevcer interface {
+	Dhgeqzer
+	Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool)
+}
+
+func DtgevcTest(t *testing.T, impl Dtgevcer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 17} {
+		for _, ld := range []int{max(1, n), n + 5} {
+			for _, singular := range []bool{false, true} {
+				testDtgevc(t, impl, rnd, n, ld, singular)
+			}
+		}
+	}
+}
+
+func testDtgevc(t *testing.T, impl Dtgevcer, rnd *rand.Rand, n, ld int, singular bool) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("Case n=%v,ld=%v,singular=%v", n, ld, singular)
+
+	// Compute the generalized Schur form (S,P) of a random matrix pair
+	// (A,B) with B upper triangular.
+	a := randomGeneral(n, n, ld, rnd)
+	b := randomGeneral(n, n, ld, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			b.Data[i*b.Stride+j] = 0
+		}
+		if singular && i%3 == 1 {
+			b.Data[i*b.Stride+i] = 0
+		}
+	}
+	s := cloneGeneral(a)
+	p := cloneGeneral(b)
+	q := zeros(n, n, ld)
+	z := zeros(n, n, ld)
+	impl.Dgghrd(lapack.OrthoExplicit, lapack.OrthoExplicit, n, 0, n-1, s.Data, s.Stride, p.Data, p.Stride, q.Data, q.Stride, z.Data, z.Stride)
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	work := make([]float64, max(1, 6*n))
+	unconverged := impl.Dhgeqz(lapack.EigenvaluesAndSchur, lapack.OrthoPostmul, lapack.OrthoPostmul, n, 0, n-1, s.Data, s.Stride, p.Data, p.Stride,
+		alphar, alphai, beta, q.Data, q.Stride, z.Data, z.Stride, work, len(work))
+	if unconverged != 0 {
+		t.Errorf("%v: Dhgeqz failed to converge", name)
+		return
+	}
+
+	// Compute all eigenvectors of (S,P).
+	vl := nanGeneral(n, n, ld)
+	vr := nanGeneral(n, n, ld)
+	m, ok := impl.Dtgevc(lapack.EVBoth, lapack.EVAll, nil, n, s.Data, s.Stride, p.Data, p.Stride, vl.Data, vl.Stride, vr.Data, vr.Stride, n, work)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if m != n {
+		t.Errorf("%v: unexpected number of eigenvectors, got %v, want %v", name, m, n)
+	}
+	if n == 0 {
+		return
+	}
+	if resid := residualGenRightEV(s, p, vr, alphar, alphai, beta); resid > tol {
+		t.Errorf("%v: unexpected residual of right eigenvectors of (S,P); got %v, want <= %v", name, resid, tol)
+	}
+	if resid := residualGenLeftEV(s, p, vl, alphar, alphai, beta); resid > tol {
+		t.Errorf("%v: unexpected residual of left eigenvectors of (S,P); got %v, want <= %v", name, resid, tol)
+	}
+
+	// Compute the back-transformed eigenvectors of (A,B).
+	vlq := cloneGeneral(q)
+	vrz := cloneGeneral(z)
+	_, ok = impl.Dtgevc(lapack.EVBoth, lapack.EVAllMulQ, nil, n, s.Data, s.Stride, p.Data, p.Stride, vlq.Data, vlq.Stride, vrz.Data, vrz.Stride, n, work)
+	if !ok {
+		t.Errorf("%v: unexpected failure with back-transformation", name)
+		return
+	}
+	if resid := residualGenRightEV(a, b, vrz, alphar, alphai, beta); resid > tol*float64(n) {
+		t.Errorf("%v: unexpected residual of right eigenvectors of (A,B); got %v, want <= %v", name, resid, tol*float64(n))
+	}
+	if resid := residualGenLeftEV(a, b, vlq, alphar, alphai, beta); resid > tol*float64(n) {
+		t.Errorf("%v: unexpected residual of left eigenvectors of (A,B); got %v, want <= %v", name, resid, tol*float64(n))
+	}
+
+	// Compute every other eigenvector and compare it with the eigenvectors
+	// computed above.
+	selected := make([]bool, n)
+	var cols []int
+	for j := 0; j < n; {
+		size := 1
+		if j < n-1 && s.Data[(j+1)*s.Stride+j] != 0 {
+			size = 2
+		}
+		if (j/2)%2 == 0 {
+			selected[j+size-1] = true
+			for k := 0; k < size; k++ {
+				cols = append(cols, j+k)
+			}
+		}
+		j += size
+	}
+	vlSel := nanGeneral(n, len(cols), len(cols)+1)
+	vrSel := nanGeneral(n, len(cols), len(cols)+1)
+	m, ok = impl.Dtgevc(lapack.EVBoth, lapack.EVSelected, selected, n, s.Data, s.Stride, p.Data, p.Stride, vlSel.Data, vlSel.Stride, vrSel.Data, vrSel.Stride, len(cols), work)
+	if !ok {
+		t.Errorf("%v: unexpected failure with selected eigenvectors", name)
+		return
+	}
+	if m != len(cols) {
+		t.Errorf("%v: unexpected number of selected eigenvectors, got %v, want %v", name, m, len(cols))
+		return
+	}
+	for k, j := range cols {
+		for i := 0; i < n; i++ {
+			if math.Abs(vlSel.Data[i*vlSel.Stride+k]-vl.Data[i*vl.Stride+j]) > tol {
+				t.Errorf("%v: selected left eigenvector %v does not match", name, j)
+				break
+			}
+		}
+		for i := 0; i < n; i++ {
+			if math.Abs(vrSel.Data[i*vrSel.Stride+k]-vr.Data[i*vr.Stride+j]) > tol {
+				t.Errorf("%v: selected right eigenvector %v does not match", name, j)
+				break
+			}
+		}
+	}
+}
+
+// residualGenRightEV returns the residual
+//
+//	max_j |beta_j*A*v_j - alpha_j*B*v_j| / ((|beta_j|*|A| + |alpha_j|*|B|) * |v_j|)
+//
+// for the right eigenvectors of the matrix pair (A,B) stored in the columns of
+// V as returned by Dggev. All norms are max-abs norms.
+func residualGenRightEV(a, b, v blas64.General, alphar, alphai, beta []float64) float64 {
+	return residualGenEV(blas.NoTrans, a, b, v, alphar, alphai, beta)
+}
+
+// residualGenLeftEV returns the residual
+//
+//	max_j |beta_j*u_jᴴ*A - alpha_j*u_jᴴ*B| / ((|beta_j|*|A| + |alpha_j|*|B|) * |u_j|)
+//
+// for the left eigenvectors of the matrix pair (A,B) stored in the columns of
+// U as returned by Dggev. All norms are max-abs norms.
+func residualGenLeftEV(a, b, u blas64.General, alphar, alphai, beta []float64) float64 {
+	return residualGenEV(blas.Trans, a, b, u, alphar, alphai, beta)
+}
+
+func residualGenEV(trans blas.Transpose, a, b, v blas64.General, alphar, alphai, beta []float64) float64 {
+	n := a.Rows
+	at := func(m blas64.General, i, j int) float64 {
+		if trans == blas.Trans {
+			return m.Data[j*m.Stride+i]
+		}
+		return m.Data[i*m.Stride+j]
+	}
+	anorm := dlange(lapack.MaxAbs, n, n, a.Data, a.Stride)
+	bnorm := dlange(lapack.MaxAbs, n, n, b.Data, b.Stride)
+	x := make([]complex128, n)
+	var resid float64
+	for j := 0; j < n; j++ {
+		if alphai[j] < 0 {
+			// The second eigenvector of a complex conjugate pair
+			// is the conjugate of the first one.
+			continue
+		}
+		var xnorm float64
+		for i := range x {
+			x[i] = complex(v.Data[i*v.Stride+j], 0)
+			if alphai[j] > 0 {
+				x[i] += complex(0, v.Data[i*v.Stride+j+1])
+			}
+			xnorm = math.Max(xnorm, cmplx.Abs(x[i]))
+		}
+		alpha := complex(alphar[j], alphai[j])
+		if trans == blas.Trans {
+			// uᴴ*A = λ*uᴴ*B is equivalent to Aᵀ*u = conj(λ)*Bᵀ*u
+			// for real A and B.
+			alpha = cmplx.Conj(alpha)
+		}
+		bt := complex(beta[j], 0)
+		var rnorm float64
+		for i := 0; i < n; i++ {
+			var ax, bx complex128
+			for k := 0; k < n; k++ {
+				ax += complex(at(a, i, k), 0) * x[k]
+				bx += complex(at(b, i, k), 0) * x[k]
+			}
+			rnorm = math.Max(rnorm, cmplx.Abs(bt*ax-alpha*bx))
+		}
+		denom := (math.Abs(beta[j])*anorm + cmplx.Abs(alpha)*bnorm) * xnorm * float64(n)
+		if denom == 0 {
+			continue
+		}
+		resid = math.Max(resid, rnorm/denom)
+	}
+	return resid
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtrsyler interface {
+	Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool)
+}
+
+func DtrsylTest(t *testing.T, impl Dtrsyler) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trana := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tranb := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, isgn := range []int{1, -1} {
+				for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+					for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 17} {
+						for _, extra := range []int{0, 3} {
+							for cas := 0; cas < 5; cas++ {
+								testDtrsyl(t, impl, trana, tranb, isgn, m, n, extra, rnd)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDtrsyl(t *testing.T, impl Dtrsyler, trana, tranb blas.Transpose, isgn, m, n, extra int, rnd *rand.Rand) {
+	const tol = 100
+
+	name := fmt.Sprintf("trana=%v,tranb=%v,isgn=%v,m=%v,n=%v,extra=%v",
+		transToString(trana), transToString(tranb), isgn, m, n, extra)
+
+	a, _, _ := randomSchurCanonical(m, m+extra, false, rnd)
+	b, _, _ := randomSchurCanonical(n, n+extra, false, rnd)
+	c := randomGeneral(m, n, n+extra, rnd)
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	cCopy := cloneGeneral(c)
+
+	scale, ok := impl.Dtrsyl(trana, tranb, isgn, m, n, a.Data, a.Stride, b.Data, b.Stride, c.Data, c.Stride)
+
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !equalGeneral(b, bCopy) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	if !generalOutsideAllNaN(c) {
+		t.Errorf("%v: out-of-range write to C", name)
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	if scale <= 0 || 1 < scale {
+		t.Errorf("%v: invalid value of scale, want in (0,1], got %v", name, scale)
+	}
+	if !ok {
+		t.Logf("%v: Dtrsyl returned ok=false", name)
+		return
+	}
+
+	// Compute the residual op(A)*X + isgn*X*op(B) - scale*C.
+	x := c
+	r := zeros(m, n, n)
+	blas64.Gemm(trana, blas.NoTrans, 1, a, x, 0, r)
+	blas64.Gemm(blas.NoTrans, tranb, float64(isgn), x, b, 1, r)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			r.Data[i*r.Stride+j] -= scale * cCopy.Data[i*cCopy.Stride+j]
+		}
+	}
+
+	// Check that the residual relative to the size of the data is small.
+	rnorm := dlange(lapack.MaxAbs, m, n, r.Data, r.Stride)
+	anorm := dlange(lapack.MaxAbs, m, m, a.Data, a.Stride)
+	bnorm := dlange(lapack.MaxAbs, n, n, b.Data, b.Stride)
+	xnorm := dlange(lapack.MaxAbs, m, n, x.Data, x.Stride)
+	den := math.Max(dlamchB*epsilon(impl)*float64(max(m, n))*(anorm+bnorm)*xnorm, dlamchS)
+	resid := rnorm / den
+	if resid > tol {
+		t.Errorf("%v: unexpected residual, got %v, want <= %v", name, resid, tol)
+	}
+}
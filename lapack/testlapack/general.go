@@ -1023,6 +1023,23 @@ func distSymBand(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float64, l
 	return dist
 }
 
+// symBandToGeneral returns the n×n symmetric band matrix A with kd diagonals
+// as a dense general matrix.
+func symBandToGeneral(uplo blas.Uplo, n, kd int, ab []float64, ldab int) blas64.General {
+	a := zeros(n, n, max(1, n))
+	for i := 0; i < n; i++ {
+		for j := i; j < min(n, i+kd+1); j++ {
+			v := ab[i*ldab+j-i]
+			if uplo == blas.Lower {
+				v = ab[j*ldab+kd+i-j]
+			}
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	return a
+}
+
 // eye returns an identity matrix of given order and stride.
 func eye(n, stride int) blas64.General {
 	ans := nanGeneral(n, n, stride)
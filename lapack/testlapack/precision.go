@@ -0,0 +1,45 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+// Precisioner is implemented by implementations whose floating point
+// precision is lower than that of float64, such as adapters that run the
+// tests in this package against float32 routines. Tests that support it
+// scale their tolerances by the ratio of the machine epsilon returned by
+// Epsilon to the float64 machine epsilon, and generate scaled test matrices
+// using the safe minimum returned by SafeMin.
+type Precisioner interface {
+	// Epsilon returns the machine epsilon of the implementation.
+	Epsilon() float64
+	// SafeMin returns the smallest normal number of the implementation.
+	SafeMin() float64
+}
+
+// epsilon returns the machine epsilon of impl.
+func epsilon(impl any) float64 {
+	if p, ok := impl.(Precisioner); ok {
+		return p.Epsilon()
+	}
+	return dlamchE
+}
+
+// safeMin returns the safe minimum of impl.
+func safeMin(impl any) float64 {
+	if p, ok := impl.(Precisioner); ok {
+		return p.SafeMin()
+	}
+	return dlamchS
+}
+
+// scaleTol returns the tolerance tol of a test written for a float64
+// implementation scaled to the precision of impl. For implementations of
+// lower precision the tolerance is doubled because the inputs are rounded
+// to that precision before the routine is called.
+func scaleTol(impl any, tol float64) float64 {
+	if _, ok := impl.(Precisioner); !ok {
+		return tol
+	}
+	return 2 * tol * epsilon(impl) / dlamchE
+}
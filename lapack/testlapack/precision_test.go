@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import "testing"
+
+// TestPrecisionFloat64 checks that the tolerances and thresholds used by the
+// tests are unchanged for implementations that are not Precisioners.
+func TestPrecisionFloat64(t *testing.T) {
+	t.Parallel()
+	var impl struct{}
+	if got := epsilon(impl); got != dlamchE {
+		t.Errorf("unexpected epsilon: got %v, want %v", got, dlamchE)
+	}
+	if got := safeMin(impl); got != dlamchS {
+		t.Errorf("unexpected safe minimum: got %v, want %v", got, dlamchS)
+	}
+	for _, tol := range []float64{1e-6, 1e-8, 1e-10, 1e-12, 1e-13, 1e-14, 1e-15} {
+		if got := scaleTol(impl, tol); got != tol {
+			t.Errorf("unexpected scaled tolerance for %v: got %v", tol, got)
+		}
+	}
+	if got := dlamchB * epsilon(impl); got != dlamchP {
+		t.Errorf("unexpected precision: got %v, want %v", got, dlamchP)
+	}
+	if got := safeMin(impl) / (dlamchB * epsilon(impl)); got != smlnum {
+		t.Errorf("unexpected small number: got %v, want %v", got, smlnum)
+	}
+	if got := dlamchB * epsilon(impl) / safeMin(impl); got != bignum {
+		t.Errorf("unexpected big number: got %v, want %v", got, bignum)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+type Zdrscler interface {
+	Zdrscl(n int, a float64, x []complex128, incX int)
+}
+
+func ZdrsclTest(t *testing.T, impl Zdrscler) {
+	for _, test := range []struct {
+		x []complex128
+		a float64
+	}{
+		{
+			x: []complex128{1 + 2i, 2 - 1i, 3, 4i, -5 + 5i},
+			a: 4,
+		},
+		{
+			x: []complex128{1 + 2i, 2 - 1i, 3, 4i, -5 + 5i},
+			a: math.MaxFloat64,
+		},
+		{
+			x: []complex128{1 + 2i, 2 - 1i, 3, 4i, -5 + 5i},
+			a: 1e-307,
+		},
+	} {
+		xcopy := make([]complex128, len(test.x))
+		copy(xcopy, test.x)
+
+		// Check that scaling and scaling back yields approximately x. If
+		// overflow or underflow occurs then the scaling won't match.
+		impl.Zdrscl(len(test.x), test.a, xcopy, 1)
+		if equalComplex128(xcopy, test.x, 0) {
+			t.Errorf("x unchanged during call to Zdrscl. a = %v, x = %v.", test.a, test.x)
+		}
+		impl.Zdrscl(len(test.x), 1/test.a, xcopy, 1)
+		if !equalComplex128(xcopy, test.x, 1e-14) {
+			t.Errorf("x not equal after scaling and unscaling. a = %v, x = %v.", test.a, test.x)
+		}
+	}
+}
+
+// equalComplex128 returns whether the elements of x and y differ by at most
+// tol in absolute value.
+func equalComplex128(x, y []complex128, tol float64) bool {
+	for i := range x {
+		if cmplx.Abs(x[i]-y[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgeconer interface {
+	Zgecon(norm lapack.MatrixNorm, n int, a []complex128, lda int, anorm float64, work []complex128, rwork []float64) float64
+
+	Zgetrser
+	Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+}
+
+func ZgeconTest(t *testing.T, impl Zgeconer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, lda := range []int{max(1, n), n + 3} {
+			zgeconTest(t, impl, rnd, n, lda)
+		}
+	}
+}
+
+func zgeconTest(t *testing.T, impl Zgeconer, rnd *rand.Rand, n, lda int) {
+	const ratioThresh = 10
+
+	a := randomGeneral128(n, n, lda, rnd)
+
+	// Allocate work slices.
+	work := make([]complex128, 2*n)
+	rwork := make([]float64, 2*n)
+
+	// Compute the LU factorization of A.
+	aFac := cloneGeneral128(a)
+	ipiv := make([]int, n)
+	ok := impl.Zgetrf(n, n, aFac.Data, lda, ipiv)
+	if !ok {
+		t.Fatalf("n=%v,lda=%v: bad matrix, Zgetrf failed", n, lda)
+	}
+	aFacCopy := cloneGeneral128(aFac)
+
+	// Compute the inverse A⁻¹ from the LU factorization.
+	aInv := eye128(n, lda)
+	impl.Zgetrs(blas.NoTrans, n, n, aFac.Data, lda, ipiv, aInv.Data, lda)
+
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+		name := fmt.Sprintf("norm=%v,n=%v,lda=%v", string(norm), n, lda)
+
+		// Compute the norm of A and A⁻¹.
+		aNorm := impl.Zlange(norm, n, n, a.Data, lda, rwork)
+		aInvNorm := impl.Zlange(norm, n, n, aInv.Data, lda, rwork)
+
+		// Compute a good estimate of the condition number
+		//  rcondWant := 1/(norm(A) * norm(inv(A)))
+		rcondWant := 1.0
+		if aNorm > 0 && aInvNorm > 0 {
+			rcondWant = 1 / aNorm / aInvNorm
+		}
+
+		// Compute an estimate of rcond using the LU factorization and Zgecon.
+		rcondGot := impl.Zgecon(norm, n, aFac.Data, lda, aNorm, work, rwork)
+		if distGeneral128(aFac, aFacCopy) != 0 {
+			t.Errorf("%v: unexpected modification of aFac", name)
+		}
+
+		ratio := rCondTestRatio(rcondGot, rcondWant)
+		if ratio >= ratioThresh {
+			t.Errorf("%v: unexpected value of rcond; got=%v, want=%v (ratio=%v)",
+				name, rcondGot, rcondWant, ratio)
+		}
+
+		// Check for corner-case values of anorm.
+		for _, anorm := range []float64{0, math.Inf(1), math.NaN()} {
+			rcondGot = impl.Zgecon(norm, n, aFac.Data, lda, anorm, work, rwork)
+			if n == 0 {
+				if rcondGot != 1 {
+					t.Errorf("%v: unexpected rcond when anorm=%v: got=%v, want=1", name, anorm, rcondGot)
+				}
+				continue
+			}
+			if math.IsNaN(anorm) {
+				if !math.IsNaN(rcondGot) {
+					t.Errorf("%v: NaN not propagated when anorm=NaN: got=%v", name, rcondGot)
+				}
+				continue
+			}
+			if rcondGot != 0 {
+				t.Errorf("%v: unexpected rcond when anorm=%v: got=%v, want=0", name, anorm, rcondGot)
+			}
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgeever interface {
+	Zgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) (first int)
+}
+
+func ZgeevTest(t *testing.T, impl Zgeever) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// Reference cases with known eigenvalues.
+	for i, test := range []struct {
+		a    []complex128
+		n    int
+		want []complex128
+	}{
+		{
+			a:    []complex128{2 - 3i},
+			n:    1,
+			want: []complex128{2 - 3i},
+		},
+		{
+			a: []complex128{
+				1, 2i,
+				0, 3,
+			},
+			n:    2,
+			want: []complex128{1, 3},
+		},
+		{
+			// A real rotation with complex conjugate eigenvalues.
+			a: []complex128{
+				0, -1,
+				1, 0,
+			},
+			n:    2,
+			want: []complex128{1i, -1i},
+		},
+		{
+			// The Pauli matrix σ_y.
+			a: []complex128{
+				0, -1i,
+				1i, 0,
+			},
+			n:    2,
+			want: []complex128{1, -1},
+		},
+		{
+			// A circulant matrix with eigenvalues given by the
+			// discrete Fourier transform of its first row.
+			a: []complex128{
+				1, 2, 0, 0,
+				0, 1, 2, 0,
+				0, 0, 1, 2,
+				2, 0, 0, 1,
+			},
+			n:    4,
+			want: []complex128{3, 1 + 2i, -1, 1 - 2i},
+		},
+		{
+			// A matrix whose eigenvalues are isolated by
+			// permutations during balancing.
+			a: []complex128{
+				1i, 0, 0, 0,
+				5, 2, 0, 3,
+				7, 0, 4 + 1i, 0,
+				1, 0, 0, -1,
+			},
+			n:    4,
+			want: []complex128{1i, 2, 4 + 1i, -1},
+		},
+	} {
+		n := test.n
+		a := cblas128.General{Rows: n, Cols: n, Stride: n, Data: test.a}
+		testZgeevJobs(t, impl, fmt.Sprintf("reference case %v", i), a, test.want, 1e-14)
+	}
+
+	// Random matrices A = Q * T * Qᴴ with known eigenvalues, where Q is
+	// unitary and T is upper triangular.
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 40} {
+		for _, lda := range []int{n, n + 5} {
+			lda = max(1, lda)
+			want := make([]complex128, n)
+			tm := zeroGeneral128(n, n)
+			for i := 0; i < n; i++ {
+				want[i] = complex(float64(i+1), rnd.NormFloat64())
+				tm.Data[i*tm.Stride+i] = want[i]
+				for j := i + 1; j < n; j++ {
+					tm.Data[i*tm.Stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64()) / 2
+				}
+			}
+			q := randomUnitary(n, rnd)
+			qt := mulGeneral128(blas.NoTrans, blas.NoTrans, q, tm)
+			a := nanGeneral128(n, n, lda)
+			copyGeneral128(a, mulGeneral128(blas.NoTrans, blas.ConjTrans, qt, q))
+			testZgeevJobs(t, impl, fmt.Sprintf("n=%v,lda=%v", n, lda), a, want, 1e-10)
+		}
+	}
+
+	// Random matrices with unknown eigenvalues.
+	for _, n := range []int{3, 10, 40} {
+		a := randomGeneral128(n, n, n, rnd)
+		testZgeevJobs(t, impl, fmt.Sprintf("random,n=%v", n), a, nil, 0)
+	}
+}
+
+func testZgeevJobs(t *testing.T, impl Zgeever, name string, a cblas128.General, want []complex128, tol float64) {
+	t.Helper()
+	for _, jobvl := range []lapack.LeftEVJob{lapack.LeftEVCompute, lapack.LeftEVNone} {
+		for _, jobvr := range []lapack.RightEVJob{lapack.RightEVCompute, lapack.RightEVNone} {
+			for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+				name := fmt.Sprintf("%v,jobvl=%c,jobvr=%c,work=%v", name, jobvl, jobvr, wl)
+				testZgeev(t, impl, name, jobvl, jobvr, a, want, tol, wl)
+			}
+		}
+	}
+}
+
+func testZgeev(t *testing.T, impl Zgeever, name string, jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a cblas128.General, want []complex128, tol float64, wl worklen) {
+	t.Helper()
+
+	const residTol = 1e-12
+
+	n := a.Rows
+	lda := a.Stride
+
+	var vl, vr cblas128.General
+	if jobvl == lapack.LeftEVCompute {
+		vl = nanGeneral128(n, n, n)
+	} else {
+		vl.Stride = 1
+	}
+	if jobvr == lapack.RightEVCompute {
+		vr = nanGeneral128(n, n, n)
+	} else {
+		vr.Stride = 1
+	}
+
+	minwork := max(1, 2*n)
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = minwork
+	case mediumWork, optimumWork:
+		work := make([]complex128, 1)
+		impl.Zgeev(jobvl, jobvr, n, nil, lda, nil, nil, vl.Stride, nil, vr.Stride, work, -1, nil)
+		lwork = int(real(work[0]))
+		if wl == mediumWork {
+			lwork = (lwork + minwork) / 2
+		}
+		lwork = max(minwork, lwork)
+	}
+
+	aCopy := cloneGeneral128(a)
+	w := make([]complex128, n)
+	work := make([]complex128, lwork)
+	rwork := make([]float64, 2*n)
+	first := impl.Zgeev(jobvl, jobvr, n, a.Data, lda, w, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork, rwork)
+	copy(a.Data, aCopy.Data)
+	if first > 0 {
+		t.Errorf("%v: unexpected failure, first=%v", name, first)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if want != nil {
+		// Match each expected eigenvalue with the closest computed one.
+		used := make([]bool, n)
+		for _, wi := range want {
+			best := -1
+			for j, wj := range w {
+				if used[j] {
+					continue
+				}
+				if best < 0 || cmplx.Abs(wj-wi) < cmplx.Abs(w[best]-wi) {
+					best = j
+				}
+			}
+			used[best] = true
+			if cmplx.Abs(w[best]-wi) > tol*math.Max(1, cmplx.Abs(wi)) {
+				t.Errorf("%v: unexpected eigenvalue, got %v, want %v", name, w[best], wi)
+			}
+		}
+	}
+
+	anorm := max(1, normGeneral128(aCopy))
+	if jobvr == lapack.RightEVCompute {
+		// Check that A * VR = VR * W.
+		av := mulGeneral128(blas.NoTrans, blas.NoTrans, aCopy, vr)
+		vw := zeroGeneral128(n, n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				vw.Data[i*vw.Stride+j] = vr.Data[i*vr.Stride+j] * w[j]
+			}
+		}
+		if d := distGeneral128(av, vw); d > residTol*anorm*float64(n) {
+			t.Errorf("%v: |A*VR - VR*W| = %v", name, d)
+		}
+		checkZgeevNormalized(t, name, "right", vr)
+	}
+	if jobvl == lapack.LeftEVCompute {
+		// Check that VLᴴ * A = W * VLᴴ.
+		va := mulGeneral128(blas.ConjTrans, blas.NoTrans, vl, aCopy)
+		wv := zeroGeneral128(n, n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				wv.Data[i*wv.Stride+j] = w[i] * cmplx.Conj(vl.Data[j*vl.Stride+i])
+			}
+		}
+		if d := distGeneral128(va, wv); d > residTol*anorm*float64(n) {
+			t.Errorf("%v: |VLᴴ*A - W*VLᴴ| = %v", name, d)
+		}
+		checkZgeevNormalized(t, name, "left", vl)
+	}
+}
+
+// checkZgeevNormalized checks that the columns of v have unit Euclidean norm
+// and that their component of largest magnitude is real.
+func checkZgeevNormalized(t *testing.T, name, side string, v cblas128.General) {
+	t.Helper()
+
+	const tol = 1e-13
+
+	n := v.Rows
+	for j := 0; j < n; j++ {
+		var nrm, amax float64
+		for i := 0; i < n; i++ {
+			a := cmplx.Abs(v.Data[i*v.Stride+j])
+			nrm += a * a
+			amax = math.Max(amax, a)
+		}
+		if math.Abs(math.Sqrt(nrm)-1) > tol {
+			t.Errorf("%v: %v eigenvector %v not normalized, |v| = %v", name, side, j, math.Sqrt(nrm))
+		}
+		// Components of equal magnitude may tie for the largest one, so
+		// look for a real component among them.
+		var found bool
+		for i := 0; i < n; i++ {
+			vij := v.Data[i*v.Stride+j]
+			if cmplx.Abs(vij) >= amax-tol && imag(vij) == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%v: largest component of %v eigenvector %v not real", name, side, j)
+		}
+	}
+}
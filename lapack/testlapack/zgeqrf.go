@@ -0,0 +1,112 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgeqrfer interface {
+	Zgeqr2(m, n int, a []complex128, lda int, tau, work []complex128)
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+}
+
+func ZgeqrfTest(t *testing.T, impl Zgeqrfer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{0, 0, 0},
+		{0, 5, 0},
+		{5, 0, 0},
+		{1, 1, 0},
+		{10, 5, 0},
+		{5, 10, 0},
+		{10, 10, 0},
+		{150, 5, 0},
+		{3, 200, 0},
+		{100, 100, 0},
+		{150, 100, 0},
+		{100, 150, 0},
+		{10, 5, 20},
+		{5, 10, 20},
+		{150, 100, 120},
+		{100, 150, 160},
+	} {
+		m := test.m
+		n := test.n
+		lda := test.lda
+		if lda == 0 {
+			lda = max(1, n)
+		}
+		k := min(m, n)
+
+		a := randomGeneral128(m, n, lda, rnd)
+		aCopy := cloneGeneral128(a)
+
+		// Compute the expected result using the unblocked algorithm.
+		want := cloneGeneral128(a)
+		tauWant := make([]complex128, k)
+		impl.Zgeqr2(m, n, want.Data, lda, tauWant, make([]complex128, n))
+
+		name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+		checkZQR(t, name+",unblocked", want, tauWant, aCopy, tol)
+
+		for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+			name := fmt.Sprintf("%v,work=%v", name, wl)
+			copy(a.Data, aCopy.Data)
+
+			var lwork int
+			switch wl {
+			case minimumWork:
+				lwork = max(1, n)
+			case mediumWork:
+				work := make([]complex128, 1)
+				impl.Zgeqrf(m, n, a.Data, lda, nil, work, -1)
+				lwork = max(1, n, int(real(work[0]))-2*n)
+			case optimumWork:
+				work := make([]complex128, 1)
+				impl.Zgeqrf(m, n, a.Data, lda, nil, work, -1)
+				lwork = max(1, n, int(real(work[0])))
+			}
+			work := make([]complex128, lwork)
+			tau := make([]complex128, k)
+
+			impl.Zgeqrf(m, n, a.Data, lda, tau, work, lwork)
+			if d := distGeneral128(a, want); d > tol*max(1, normGeneral128(want)) {
+				t.Errorf("%v: result differs from unblocked result by %v", name, d)
+			}
+			checkZQR(t, name, a, tau, aCopy, tol)
+		}
+	}
+}
+
+// checkZQR checks that the QR factorization of the m×n matrix A stored in
+// factorized and tau satisfies A = Q * R with a unitary Q.
+func checkZQR(t *testing.T, name string, factorized cblas128.General, tau []complex128, original cblas128.General, tol float64) {
+	t.Helper()
+
+	m, n := factorized.Rows, factorized.Cols
+	q := constructQ128(m, n, min(m, n), factorized.Data, factorized.Stride, tau)
+	if resid := residualUnitary(q, false); resid > tol*float64(max(1, m)) {
+		t.Errorf("%v: Q is not unitary, |I - Qᴴ*Q| = %v", name, resid)
+	}
+	r := zeroGeneral128(m, n)
+	for i := 0; i < m; i++ {
+		for j := i; j < n; j++ {
+			r.Data[i*r.Stride+j] = factorized.Data[i*factorized.Stride+j]
+		}
+	}
+	qr := mulGeneral128(blas.NoTrans, blas.NoTrans, q, r)
+	if d := distGeneral128(qr, original); d > tol*float64(max(1, m))*max(1, normGeneral128(original)) {
+		t.Errorf("%v: Q*R does not match A, |Q*R - A| = %v", name, d)
+	}
+}
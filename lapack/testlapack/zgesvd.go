@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgesvder interface {
+	Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool)
+}
+
+func ZgesvdTest(t *testing.T, impl Zgesvder) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// Reference cases with known singular values.
+	for _, test := range []struct {
+		a    []complex128
+		m, n int
+		want []float64
+	}{
+		{
+			a:    []complex128{3 + 4i},
+			m:    1,
+			n:    1,
+			want: []float64{5},
+		},
+		{
+			a: []complex128{
+				0, 2i,
+				-3, 0,
+			},
+			m:    2,
+			n:    2,
+			want: []float64{3, 2},
+		},
+		{
+			a: []complex128{
+				1, 1i,
+				1i, -1,
+			},
+			m:    2,
+			n:    2,
+			want: []float64{2, 0},
+		},
+		{
+			a:    []complex128{1i, 1, -1i, 1},
+			m:    1,
+			n:    4,
+			want: []float64{2},
+		},
+		{
+			a:    []complex128{1i, 1, -1i, 1},
+			m:    4,
+			n:    1,
+			want: []float64{2},
+		},
+	} {
+		a := cblas128.General{Rows: test.m, Cols: test.n, Stride: test.n, Data: test.a}
+		for _, jobU := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+			for _, jobVT := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+				if jobU == lapack.SVDOverwrite && jobVT == lapack.SVDOverwrite {
+					continue
+				}
+				name := fmt.Sprintf("reference,m=%v,n=%v,jobU=%c,jobVT=%c", test.m, test.n, jobU, jobVT)
+				testZgesvd(t, impl, name, jobU, jobVT, a, test.want, 1e-14)
+			}
+		}
+	}
+
+	// Random matrices A = U * Σ * Vᴴ with known singular values.
+	for _, mn := range [][2]int{
+		{0, 0}, {0, 3}, {3, 0},
+		{1, 1}, {1, 5}, {5, 1},
+		{2, 2}, {3, 5}, {5, 3},
+		{10, 10}, {10, 25}, {25, 10},
+		{40, 40},
+	} {
+		m, n := mn[0], mn[1]
+		minmn := min(m, n)
+		for _, lda := range []int{n, n + 5} {
+			lda = max(1, lda)
+			for _, jobU := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+				for _, jobVT := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+					if jobU == lapack.SVDOverwrite && jobVT == lapack.SVDOverwrite {
+						continue
+					}
+					name := fmt.Sprintf("m=%v,n=%v,lda=%v,jobU=%c,jobVT=%c", m, n, lda, jobU, jobVT)
+					want := make([]float64, minmn)
+					for i := range want {
+						want[i] = rnd.Float64() + 0.1
+					}
+					// Include a repeated singular value.
+					if minmn > 2 {
+						want[1] = want[0]
+					}
+					u := randomUnitary(m, rnd)
+					v := randomUnitary(n, rnd)
+					us := zeroGeneral128(m, n)
+					for i := 0; i < m; i++ {
+						for j := 0; j < minmn; j++ {
+							us.Data[i*us.Stride+j] = u.Data[i*u.Stride+j] * complex(want[j], 0)
+						}
+					}
+					a := nanGeneral128(m, n, lda)
+					copyGeneral128(a, mulGeneral128(blas.NoTrans, blas.ConjTrans, us, v))
+					sort.Sort(sort.Reverse(sort.Float64Slice(want)))
+					testZgesvd(t, impl, name, jobU, jobVT, a, want, 1e-13)
+				}
+			}
+		}
+	}
+
+	// Random matrices with unknown singular values.
+	for _, mn := range [][2]int{{4, 4}, {8, 20}, {20, 8}} {
+		m, n := mn[0], mn[1]
+		name := fmt.Sprintf("random,m=%v,n=%v", m, n)
+		a := randomGeneral128(m, n, n, rnd)
+		testZgesvd(t, impl, name, lapack.SVDAll, lapack.SVDAll, a, nil, 1e-13)
+	}
+}
+
+func testZgesvd(t *testing.T, impl Zgesvder, name string, jobU, jobVT lapack.SVDJob, a cblas128.General, want []float64, tol float64) {
+	t.Helper()
+
+	m, n := a.Rows, a.Cols
+	lda := a.Stride
+	minmn := min(m, n)
+
+	var ucol, vtrow int
+	switch jobU {
+	case lapack.SVDAll:
+		ucol = m
+	case lapack.SVDStore:
+		ucol = minmn
+	}
+	switch jobVT {
+	case lapack.SVDAll:
+		vtrow = n
+	case lapack.SVDStore:
+		vtrow = minmn
+	}
+	ldu := max(1, ucol)
+	ldvt := max(1, n)
+
+	work := make([]complex128, 1)
+	impl.Zgesvd(jobU, jobVT, m, n, a.Data, lda, nil, nil, ldu, nil, ldvt, work, -1, nil)
+	for _, wl := range []worklen{minimumWork, optimumWork} {
+		name := fmt.Sprintf("%v,work=%v", name, wl)
+		lwork := 1
+		if minmn > 0 {
+			lwork = 2*minmn + max(m, n)
+		}
+		if wl == optimumWork {
+			lwork = max(lwork, int(real(work[0])))
+		}
+		aWork := cloneGeneral128(a)
+		s := make([]float64, minmn)
+		u := nanGeneral128(m, ucol, ldu)
+		vt := nanGeneral128(vtrow, n, ldvt)
+		ok := impl.Zgesvd(jobU, jobVT, m, n, aWork.Data, lda, s, u.Data, ldu, vt.Data, ldvt, make([]complex128, lwork), lwork, make([]float64, 5*minmn))
+		if !ok {
+			t.Errorf("%v: unexpected failure", name)
+			continue
+		}
+		if minmn == 0 {
+			continue
+		}
+
+		for i := 0; i < minmn-1; i++ {
+			if s[i] < s[i+1] {
+				t.Errorf("%v: singular values not sorted in decreasing order", name)
+				break
+			}
+		}
+		if s[minmn-1] < 0 {
+			t.Errorf("%v: negative singular value", name)
+		}
+		anorm := max(1, normGeneral128(a))
+		for i := range want {
+			if math.Abs(s[i]-want[i]) > tol*anorm*float64(max(m, n)) {
+				t.Errorf("%v: unexpected singular value %v: got %v, want %v", name, i, s[i], want[i])
+			}
+		}
+
+		// Collect the singular vectors from where they were stored.
+		switch jobU {
+		case lapack.SVDOverwrite:
+			u = cblas128.General{Rows: m, Cols: minmn, Stride: lda, Data: aWork.Data}
+		case lapack.SVDNone:
+			u = cblas128.General{}
+		}
+		switch jobVT {
+		case lapack.SVDOverwrite:
+			vt = cblas128.General{Rows: minmn, Cols: n, Stride: lda, Data: aWork.Data}
+		case lapack.SVDNone:
+			vt = cblas128.General{}
+		}
+
+		if u.Rows > 0 {
+			if resid := residualUnitary(u, false); resid > tol*float64(m) {
+				t.Errorf("%v: columns of U not orthonormal, |I - Uᴴ*U| = %v", name, resid)
+			}
+		}
+		if vt.Rows > 0 {
+			if resid := residualUnitary(vt, true); resid > tol*float64(n) {
+				t.Errorf("%v: rows of Vᴴ not orthonormal, |I - Vᴴ*V| = %v", name, resid)
+			}
+		}
+		if u.Rows == 0 || vt.Rows == 0 {
+			continue
+		}
+
+		// Check that A = U * Σ * Vᴴ using the first min(m,n) singular
+		// vectors.
+		us := zeroGeneral128(m, minmn)
+		for i := 0; i < m; i++ {
+			for j := 0; j < minmn; j++ {
+				us.Data[i*us.Stride+j] = u.Data[i*u.Stride+j] * complex(s[j], 0)
+			}
+		}
+		vtk := cblas128.General{Rows: minmn, Cols: n, Stride: vt.Stride, Data: vt.Data}
+		usvt := mulGeneral128(blas.NoTrans, blas.NoTrans, us, vtk)
+		if d := distGeneral128(usvt, a); d > tol*anorm*float64(max(m, n)) {
+			t.Errorf("%v: |A - U*Σ*Vᴴ| = %v", name, d)
+		}
+	}
+}
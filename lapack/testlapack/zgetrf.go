@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgetrfer interface {
+	Zgetf2(m, n int, a []complex128, lda int, ipiv []int) bool
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) bool
+}
+
+func ZgetrfTest(t *testing.T, impl Zgetrfer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{0, 0, 0},
+		{0, 5, 0},
+		{5, 0, 0},
+		{1, 1, 0},
+		{10, 5, 0},
+		{5, 10, 0},
+		{10, 10, 0},
+		{150, 5, 0},
+		{3, 200, 0},
+		{150, 100, 0},
+		{100, 150, 0},
+		{200, 200, 0},
+		{10, 5, 20},
+		{5, 10, 20},
+		{150, 100, 120},
+		{100, 150, 160},
+	} {
+		m := test.m
+		n := test.n
+		lda := test.lda
+		if lda == 0 {
+			lda = max(1, n)
+		}
+		for _, blocked := range []bool{false, true} {
+			name := fmt.Sprintf("m=%v,n=%v,lda=%v,blocked=%v", m, n, lda, blocked)
+
+			a := randomGeneral128(m, n, lda, rnd)
+			aCopy := cloneGeneral128(a)
+			ipiv := make([]int, min(m, n))
+			for i := range ipiv {
+				ipiv[i] = -1
+			}
+
+			var ok bool
+			if blocked {
+				ok = impl.Zgetrf(m, n, a.Data, lda, ipiv)
+			} else {
+				ok = impl.Zgetf2(m, n, a.Data, lda, ipiv)
+			}
+			if !ok {
+				t.Errorf("%v: unexpected singular matrix", name)
+				continue
+			}
+			checkZPLU(t, name, a, ipiv, aCopy, tol)
+		}
+	}
+
+	// Check that a matrix with an exactly zero pivot is detected as singular.
+	a := randomGeneral128(4, 4, 4, rnd)
+	for i := 0; i < 4; i++ {
+		a.Data[i*a.Stride+2] = 0
+	}
+	ipiv := make([]int, 4)
+	if impl.Zgetrf(4, 4, a.Data, a.Stride, ipiv) {
+		t.Error("singular matrix not detected")
+	}
+}
+
+// checkZPLU checks that the LU factorization stored in factorized together
+// with the pivot indices in ipiv reconstructs the original matrix as P*L*U.
+func checkZPLU(t *testing.T, name string, factorized cblas128.General, ipiv []int, original cblas128.General, tol float64) {
+	t.Helper()
+
+	m, n := factorized.Rows, factorized.Cols
+	mn := min(m, n)
+	for i, p := range ipiv {
+		if p < i || m <= p {
+			t.Errorf("%v: pivot index %v out of range: %v", name, i, p)
+			return
+		}
+	}
+	l := zeroGeneral128(m, mn)
+	u := zeroGeneral128(mn, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			v := factorized.Data[i*factorized.Stride+j]
+			switch {
+			case i == j:
+				l.Data[i*l.Stride+i] = 1
+				u.Data[i*u.Stride+i] = v
+			case i > j:
+				if j < mn {
+					l.Data[i*l.Stride+j] = v
+				}
+			case i < j:
+				if i < mn {
+					u.Data[i*u.Stride+j] = v
+				}
+			}
+		}
+	}
+	lu := zeroGeneral128(m, n)
+	if m > 0 && n > 0 {
+		cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, l, u, 0, lu)
+	}
+	// Apply the row interchanges in reverse order to get P*L*U.
+	for i := len(ipiv) - 1; i >= 0; i-- {
+		p := ipiv[i]
+		if p != i {
+			cblas128.Swap(cblas128.Vector{N: n, Inc: 1, Data: lu.Data[i*lu.Stride:]},
+				cblas128.Vector{N: n, Inc: 1, Data: lu.Data[p*lu.Stride:]})
+		}
+	}
+	if d := distGeneral128(lu, original); d > tol*float64(max(1, mn))*max(1, normGeneral128(original)) {
+		t.Errorf("%v: P*L*U does not match the original matrix, |P*L*U - A| = %v", name, d)
+	}
+}
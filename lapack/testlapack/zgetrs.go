@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zgetrser interface {
+	Zgetrfer
+	Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+}
+
+func ZgetrsTest(t *testing.T, impl Zgetrser) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+		for _, test := range []struct {
+			n, nrhs, lda, ldb int
+		}{
+			{0, 0, 0, 0},
+			{0, 3, 0, 0},
+			{3, 0, 0, 0},
+			{1, 1, 0, 0},
+			{3, 3, 0, 0},
+			{3, 5, 0, 0},
+			{5, 3, 0, 0},
+			{3, 3, 8, 10},
+			{3, 5, 8, 10},
+			{5, 3, 8, 10},
+			{100, 50, 0, 0},
+			{150, 100, 200, 120},
+		} {
+			n := test.n
+			nrhs := test.nrhs
+			lda := test.lda
+			if lda == 0 {
+				lda = max(1, n)
+			}
+			ldb := test.ldb
+			if ldb == 0 {
+				ldb = max(1, nrhs)
+			}
+			name := fmt.Sprintf("trans=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", transToString(trans), n, nrhs, lda, ldb)
+
+			a := randomGeneral128(n, n, lda, rnd)
+			aCopy := cloneGeneral128(a)
+			// Construct the right-hand side as B = op(A) * X for a known X.
+			want := randomGeneral128(n, nrhs, ldb, rnd)
+			b := mulGeneral128(trans, blas.NoTrans, aCopy, want)
+			x := nanGeneral128(n, nrhs, ldb)
+			copyGeneral128(x, b)
+
+			ipiv := make([]int, n)
+			impl.Zgetrf(n, n, a.Data, lda, ipiv)
+			impl.Zgetrs(trans, n, nrhs, a.Data, lda, ipiv, x.Data, ldb)
+
+			// Check the residual |op(A)*X - B| relative to |A|*|X|.
+			got := mulGeneral128(trans, blas.NoTrans, aCopy, x)
+			resid := distGeneral128(got, b)
+			if resid > tol*float64(max(1, n))*max(1, normGeneral128(aCopy)*normGeneral128(x)) {
+				t.Errorf("%v: unexpected residual |op(A)*X - B| = %v", name, resid)
+			}
+		}
+	}
+}
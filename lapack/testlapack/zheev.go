@@ -0,0 +1,181 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zheever interface {
+	Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool)
+}
+
+func ZheevTest(t *testing.T, impl Zheever) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	// Reference cases with known eigenvalues.
+	for _, test := range []struct {
+		a    []complex128
+		n    int
+		want []float64
+	}{
+		{
+			a:    []complex128{5},
+			n:    1,
+			want: []float64{5},
+		},
+		{
+			a: []complex128{
+				2, 1 - 1i,
+				1 + 1i, 3,
+			},
+			n:    2,
+			want: []float64{1, 4},
+		},
+		{
+			// The Pauli matrix σ_y.
+			a: []complex128{
+				0, -1i,
+				1i, 0,
+			},
+			n:    2,
+			want: []float64{-1, 1},
+		},
+		{
+			a: []complex128{
+				2, 1i, 0,
+				-1i, 2, 1i,
+				0, -1i, 2,
+			},
+			n:    3,
+			want: []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2},
+		},
+	} {
+		n := test.n
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+				name := fmt.Sprintf("reference,n=%v,uplo=%v,jobz=%c", n, uploToString(uplo), jobz)
+				a := cblas128.General{Rows: n, Cols: n, Stride: n, Data: make([]complex128, n*n)}
+				copy(a.Data, test.a)
+				testZheev(t, impl, name, jobz, uplo, a, test.want, 1e-14)
+			}
+		}
+	}
+
+	// Random Hermitian matrices A = U * Λ * Uᴴ with known eigenvalues.
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50} {
+		for _, lda := range []int{n, n + 5} {
+			lda = max(1, lda)
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+					name := fmt.Sprintf("n=%v,lda=%v,uplo=%v,jobz=%c", n, lda, uploToString(uplo), jobz)
+					want := make([]float64, n)
+					for i := range want {
+						want[i] = rnd.NormFloat64()
+					}
+					// Include a repeated eigenvalue.
+					if n > 2 {
+						want[1] = want[0]
+					}
+					u := randomUnitary(n, rnd)
+					ul := zeroGeneral128(n, n)
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							ul.Data[i*ul.Stride+j] = u.Data[i*u.Stride+j] * complex(want[j], 0)
+						}
+					}
+					h := mulGeneral128(blas.NoTrans, blas.ConjTrans, ul, u)
+					a := nanGeneral128(n, n, lda)
+					copyGeneral128(a, h)
+					// Remove rounding errors so that the matrix is exactly Hermitian.
+					for i := 0; i < n; i++ {
+						a.Data[i*lda+i] = complex(real(a.Data[i*lda+i]), 0)
+					}
+					sort.Float64s(want)
+					testZheev(t, impl, name, jobz, uplo, a, want, 1e-13)
+				}
+			}
+		}
+	}
+
+	// Random Hermitian matrices with unknown eigenvalues.
+	for _, n := range []int{3, 10, 40} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("random,n=%v,uplo=%v", n, uploToString(uplo))
+			a := randomHermitian128(n, n, rnd)
+			testZheev(t, impl, name, lapack.EVCompute, uplo, a, nil, 1e-13)
+		}
+	}
+}
+
+func testZheev(t *testing.T, impl Zheever, name string, jobz lapack.EVJob, uplo blas.Uplo, a cblas128.General, want []float64, tol float64) {
+	t.Helper()
+
+	n := a.Rows
+	lda := a.Stride
+	aCopy := cloneGeneral128(a)
+	// Destroy the triangle that is not referenced.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && i > j) || (uplo == blas.Lower && i < j) {
+				a.Data[i*lda+j] = complex(math.NaN(), math.NaN())
+			}
+		}
+	}
+
+	work := make([]complex128, 1)
+	impl.Zheev(jobz, uplo, n, a.Data, lda, nil, work, -1, nil)
+	for _, wl := range []worklen{minimumWork, optimumWork} {
+		name := fmt.Sprintf("%v,work=%v", name, wl)
+		lwork := max(1, 2*n-1)
+		if wl == optimumWork {
+			lwork = max(lwork, int(real(work[0])))
+		}
+		aWork := cloneGeneral128(a)
+		w := make([]float64, n)
+		ok := impl.Zheev(jobz, uplo, n, aWork.Data, lda, w, make([]complex128, lwork), lwork, make([]float64, max(1, 3*n-2)))
+		if !ok {
+			t.Errorf("%v: unexpected failure", name)
+			continue
+		}
+
+		if !sort.Float64sAreSorted(w) {
+			t.Errorf("%v: eigenvalues not sorted in ascending order", name)
+		}
+		anorm := max(1, normGeneral128(aCopy))
+		for i := range want {
+			if math.Abs(w[i]-want[i]) > tol*anorm*float64(max(1, n)) {
+				t.Errorf("%v: unexpected eigenvalue %v: got %v, want %v", name, i, w[i], want[i])
+			}
+		}
+
+		if jobz != lapack.EVCompute {
+			continue
+		}
+		// Check that the eigenvectors are orthonormal.
+		if resid := residualUnitary(aWork, false); resid > tol*float64(max(1, n)) {
+			t.Errorf("%v: eigenvectors not orthonormal, |I - Zᴴ*Z| = %v", name, resid)
+		}
+		// Check that A * Z = Z * Λ.
+		az := mulGeneral128(blas.NoTrans, blas.NoTrans, aCopy, aWork)
+		zl := zeroGeneral128(n, n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				zl.Data[i*zl.Stride+j] = aWork.Data[i*lda+j] * complex(w[j], 0)
+			}
+		}
+		if d := distGeneral128(az, zl); d > tol*anorm*float64(max(1, n)) {
+			t.Errorf("%v: |A*Z - Z*Λ| = %v", name, d)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zlantrer interface {
+	Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+	Zlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []complex128, lda int, work []float64) float64
+}
+
+func ZlantrTest(t *testing.T, impl Zlantrer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10} {
+			for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+				for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+					for _, lda := range []int{max(1, n), n + 3} {
+						zlantrTest(t, impl, rnd, uplo, diag, m, n, lda)
+					}
+				}
+			}
+		}
+	}
+}
+
+func zlantrTest(t *testing.T, impl Zlantrer, rnd *rand.Rand, uplo blas.Uplo, diag blas.Diag, m, n, lda int) {
+	const tol = 1e-14
+
+	// Generate a random matrix. Elements outside of the triangle and, if the
+	// matrix has unit diagonal, the diagonal elements must not be referenced.
+	a := randomGeneral128(m, n, lda, rnd)
+	aCopy := cloneGeneral128(a)
+
+	// Construct the full trapezoidal matrix explicitly.
+	tr := zeroGeneral128(m, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			switch {
+			case i == j && diag == blas.Unit:
+				tr.Data[i*tr.Stride+j] = 1
+			case i == j, uplo == blas.Upper && j > i, uplo == blas.Lower && j < i:
+				tr.Data[i*tr.Stride+j] = a.Data[i*a.Stride+j]
+			}
+		}
+	}
+
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxColumnSum, lapack.MaxRowSum, lapack.Frobenius} {
+		name := fmt.Sprintf("norm=%v,uplo=%v,diag=%v,m=%v,n=%v,lda=%v", string(norm), string(uplo), string(diag), m, n, lda)
+
+		work := make([]float64, n)
+		got := impl.Zlantr(norm, uplo, diag, m, n, a.Data, lda, work)
+
+		if distGeneral128(a, aCopy) != 0 {
+			t.Fatalf("%v: unexpected modification of a", name)
+		}
+
+		want := impl.Zlange(norm, m, n, tr.Data, max(1, tr.Stride), work)
+		if math.Abs(got-want) > tol*max(1, want) {
+			t.Errorf("%v: unexpected norm: got %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zlatrser interface {
+	Zlatrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, normin bool, n int, a []complex128, lda int, x []complex128, cnorm []float64) (scale float64)
+}
+
+func ZlatrsTest(t *testing.T, impl Zlatrser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50} {
+					for _, lda := range []int{max(1, n), 2*n + 1} {
+						for _, mattype := range []int{0, 1, 2, 3, 4} {
+							testZlatrs(t, impl, mattype, uplo, trans, diag, n, lda, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func testZlatrs(t *testing.T, impl Zlatrser, mattype int, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, lda int, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	// Generate a triangular test matrix. Elements outside of the triangle
+	// are NaN and must not be referenced.
+	a := nanGeneral128(n, n, lda).Data
+	for i := 0; i < n; i++ {
+		jlo, jhi := i+1, n
+		if uplo == blas.Lower {
+			jlo, jhi = 0, i
+		}
+		for j := jlo; j < jhi; j++ {
+			a[i*lda+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+		if diag == blas.NonUnit {
+			a[i*lda+i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	switch mattype {
+	default:
+		panic("bad mattype")
+	case 0:
+		// Well-conditioned matrix with a dominant diagonal.
+		if diag == blas.NonUnit {
+			for i := 0; i < n; i++ {
+				a[i*lda+i] += complex(float64(2*n), 0)
+			}
+		}
+	case 1:
+		// Random matrix whose solution usually grows quickly.
+	case 2:
+		// Off-diagonal elements close to overflow.
+		for i := 0; i < n; i++ {
+			jlo, jhi := i+1, n
+			if uplo == blas.Lower {
+				jlo, jhi = 0, i
+			}
+			for j := jlo; j < jhi; j++ {
+				a[i*lda+j] *= 1e300
+			}
+		}
+	case 3:
+		// Diagonal elements close to underflow.
+		if diag == blas.NonUnit {
+			for i := 0; i < n; i++ {
+				a[i*lda+i] *= 1e-300
+			}
+		}
+	case 4:
+		// Exactly singular matrix.
+		if diag == blas.NonUnit && n > 0 {
+			a[(n/2)*lda+n/2] = 0
+		}
+	}
+
+	b := make([]complex128, n)
+	for i := range b {
+		b[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	x := make([]complex128, n)
+	cnorm := nanSlice(n)
+	work := make([]complex128, n)
+	name := fmt.Sprintf("mattype=%v,uplo=%c,trans=%c,diag=%c,n=%v,lda=%v", mattype, uplo, trans, diag, n, lda)
+
+	// Call Zlatrs with normin=false.
+	copy(x, b)
+	scale := impl.Zlatrs(uplo, trans, diag, false, n, a, lda, x, cnorm)
+	for i, v := range cnorm {
+		if math.IsNaN(v) {
+			t.Errorf("%v: cnorm[%v] not computed (scale=%v,normin=false)", name, i, scale)
+		}
+	}
+	resid, hasNaN := zlatrsResidual(uplo, trans, diag, n, a, lda, scale, cnorm, x, b, work)
+	if hasNaN {
+		t.Errorf("%v: unexpected NaN (scale=%v,normin=false)", name, scale)
+	} else if resid > tol {
+		t.Errorf("%v: residual %v too large (scale=%v,normin=false)", name, resid, scale)
+	}
+
+	// Call Zlatrs with normin=true because cnorm has been filled.
+	copy(x, b)
+	scale = impl.Zlatrs(uplo, trans, diag, true, n, a, lda, x, cnorm)
+	resid, hasNaN = zlatrsResidual(uplo, trans, diag, n, a, lda, scale, cnorm, x, b, work)
+	if hasNaN {
+		t.Errorf("%v: unexpected NaN (scale=%v,normin=true)", name, scale)
+	} else if resid > tol {
+		t.Errorf("%v: residual %v too large (scale=%v,normin=true)", name, resid, scale)
+	}
+}
+
+// zlatrsResidual returns norm(op(A)*x-scale*b) / (norm(op(A))*norm(x)) and
+// whether NaN has been encountered in the process.
+func zlatrsResidual(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n int, a []complex128, lda int, scale float64, cnorm []float64, x, b, work []complex128) (resid float64, hasNaN bool) {
+	if n == 0 {
+		return 0, false
+	}
+
+	// Compute the norm of the triangular matrix A using the column norms
+	// already computed by Zlatrs.
+	var tnorm float64
+	if diag == blas.NonUnit {
+		for j := 0; j < n; j++ {
+			tnorm = math.Max(tnorm, cmplx.Abs(a[j*lda+j])+cnorm[j])
+		}
+	} else {
+		for j := 0; j < n; j++ {
+			tnorm = math.Max(tnorm, 1+cnorm[j])
+		}
+	}
+
+	const (
+		eps  = dlamchE
+		tiny = safmin
+	)
+
+	bi := cblas128.Implementation()
+
+	// Compute norm(op(A)*x-scale*b) / (norm(op(A))*norm(x)).
+	copy(work, x)
+	ix := bi.Izamax(n, work, 1)
+	xnorm := math.Max(1, cmplx.Abs(work[ix]))
+	xscal := 1 / xnorm / float64(n)
+	bi.Zdscal(n, xscal, work, 1)
+	bi.Ztrmv(uplo, trans, diag, n, a, lda, work, 1)
+	bi.Zaxpy(n, complex(-scale*xscal, 0), b, 1, work, 1)
+	for _, v := range work {
+		if cmplx.IsNaN(v) {
+			return 1 / eps, true
+		}
+	}
+	ix = bi.Izamax(n, work, 1)
+	resid = cmplx.Abs(work[ix])
+	ix = bi.Izamax(n, x, 1)
+	xnorm = cmplx.Abs(x[ix])
+	if resid*tiny <= xnorm {
+		if xnorm > 0 {
+			resid /= xnorm
+		}
+	} else if resid > 0 {
+		resid = 1 / eps
+	}
+	if resid*tiny <= tnorm {
+		if tnorm > 0 {
+			resid /= tnorm
+		}
+	} else if resid > 0 {
+		resid = 1 / eps
+	}
+	return resid, false
+}
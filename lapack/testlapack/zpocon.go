@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zpoconer interface {
+	Zpocon(uplo blas.Uplo, n int, a []complex128, lda int, anorm float64, work []complex128, rwork []float64) float64
+
+	Zpotrser
+	Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+}
+
+func ZpoconTest(t *testing.T, impl Zpoconer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				zpoconTest(t, impl, rnd, uplo, n, lda)
+			}
+		}
+	}
+}
+
+func zpoconTest(t *testing.T, impl Zpoconer, rnd *rand.Rand, uplo blas.Uplo, n, lda int) {
+	const ratioThresh = 10
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v", uploToString(uplo), n, lda)
+
+	// Generate a random Hermitian positive definite matrix and make it
+	// ill-conditioned by scaling its rows and columns.
+	a := randomHPD128(n, lda, rnd)
+	for i := 0; i < n; i++ {
+		d := complex(math.Pow(10, float64(3*i)/float64(max(1, n-1))), 0)
+		for j := 0; j < n; j++ {
+			a.Data[i*lda+j] *= d
+			a.Data[j*lda+i] *= d
+		}
+	}
+
+	// Allocate work slices.
+	work := make([]complex128, 2*n)
+	rwork := make([]float64, n)
+
+	// Compute the norm of A. The 1-norm and the ∞-norm of a Hermitian
+	// matrix are equal.
+	aNorm := impl.Zlange(lapack.MaxColumnSum, n, n, a.Data, lda, rwork)
+
+	// Compute the Cholesky factorization of A.
+	aFac := cloneGeneral128(a)
+	ok := impl.Zpotrf(uplo, n, aFac.Data, lda)
+	if !ok {
+		t.Fatalf("%v: bad matrix, Zpotrf failed", name)
+	}
+	aFacCopy := cloneGeneral128(aFac)
+
+	// Compute the inverse A⁻¹ from the Cholesky factorization.
+	aInv := eye128(n, lda)
+	impl.Zpotrs(uplo, n, n, aFac.Data, lda, aInv.Data, lda)
+	aInvNorm := impl.Zlange(lapack.MaxColumnSum, n, n, aInv.Data, lda, rwork)
+
+	rcondWant := 1.0
+	if aNorm > 0 && aInvNorm > 0 {
+		rcondWant = 1 / aNorm / aInvNorm
+	}
+
+	// Compute an estimate of rcond using the Cholesky factorization and
+	// Zpocon.
+	rcondGot := impl.Zpocon(uplo, n, aFac.Data, lda, aNorm, work, rwork)
+	if distGeneral128(aFac, aFacCopy) != 0 {
+		t.Errorf("%v: unexpected modification of aFac", name)
+	}
+
+	ratio := rCondTestRatio(rcondGot, rcondWant)
+	if ratio >= ratioThresh {
+		t.Errorf("%v: unexpected value of rcond; got=%v, want=%v (ratio=%v)",
+			name, rcondGot, rcondWant, ratio)
+	}
+}
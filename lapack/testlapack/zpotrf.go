@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zpotrfer interface {
+	Zpotf2(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+}
+
+func ZpotrfTest(t *testing.T, impl Zpotrfer) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, test := range []struct {
+			n, lda int
+		}{
+			{0, 0},
+			{1, 0},
+			{2, 0},
+			{3, 0},
+			{10, 0},
+			{63, 0},
+			{65, 0},
+			{129, 0},
+			{1, 10},
+			{3, 10},
+			{10, 20},
+			{65, 100},
+			{129, 200},
+		} {
+			n := test.n
+			lda := test.lda
+			if lda == 0 {
+				lda = max(1, n)
+			}
+			for _, blocked := range []bool{false, true} {
+				name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,blocked=%v", uploToString(uplo), n, lda, blocked)
+
+				a := randomHPD128(n, lda, rnd)
+				aCopy := cloneGeneral128(a)
+
+				var ok bool
+				if blocked {
+					ok = impl.Zpotrf(uplo, n, a.Data, lda)
+				} else {
+					ok = impl.Zpotf2(uplo, n, a.Data, lda)
+				}
+				if !ok {
+					t.Errorf("%v: unexpected failure for positive definite matrix", name)
+					continue
+				}
+
+				// Check that the opposite triangle has not been modified.
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if (uplo == blas.Upper && i > j) || (uplo == blas.Lower && i < j) {
+							if a.Data[i*lda+j] != aCopy.Data[i*lda+j] {
+								t.Errorf("%v: unexpected modification of the opposite triangle", name)
+								i, j = n, n
+							}
+						}
+					}
+				}
+
+				// Extract the triangular factor and check the reconstruction.
+				f := zeroGeneral128(n, n)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if (uplo == blas.Upper && i <= j) || (uplo == blas.Lower && i >= j) {
+							f.Data[i*f.Stride+j] = a.Data[i*lda+j]
+						}
+					}
+					if imag(f.Data[i*f.Stride+i]) != 0 || real(f.Data[i*f.Stride+i]) <= 0 {
+						t.Errorf("%v: diagonal element %v not real positive", name, i)
+					}
+				}
+				var got cblas128.General
+				if uplo == blas.Upper {
+					got = mulGeneral128(blas.ConjTrans, blas.NoTrans, f, f)
+				} else {
+					got = mulGeneral128(blas.NoTrans, blas.ConjTrans, f, f)
+				}
+				if d := distGeneral128(got, aCopy); d > tol*float64(max(1, n))*normGeneral128(aCopy) {
+					t.Errorf("%v: unexpected reconstruction error %v", name, d)
+				}
+			}
+		}
+	}
+
+	// Check that an indefinite matrix is detected.
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		const n = 10
+		a := randomHPD128(n, n, rnd)
+		a.Data[5*n+5] = -1
+		if impl.Zpotrf(uplo, n, a.Data, n) {
+			t.Errorf("uplo=%v: indefinite matrix not detected", uploToString(uplo))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zpotrser interface {
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+	Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+}
+
+func ZpotrsTest(t *testing.T, impl Zpotrser) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, test := range []struct {
+			n, nrhs, lda, ldb int
+		}{
+			{0, 0, 0, 0},
+			{0, 3, 0, 0},
+			{3, 0, 0, 0},
+			{1, 1, 0, 0},
+			{3, 5, 0, 0},
+			{5, 3, 0, 0},
+			{5, 3, 8, 10},
+			{70, 20, 0, 0},
+			{70, 20, 80, 30},
+		} {
+			n := test.n
+			nrhs := test.nrhs
+			lda := test.lda
+			if lda == 0 {
+				lda = max(1, n)
+			}
+			ldb := test.ldb
+			if ldb == 0 {
+				ldb = max(1, nrhs)
+			}
+			name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", uploToString(uplo), n, nrhs, lda, ldb)
+
+			a := randomHPD128(n, lda, rnd)
+			aCopy := cloneGeneral128(a)
+			want := randomGeneral128(n, nrhs, ldb, rnd)
+			b := mulGeneral128(blas.NoTrans, blas.NoTrans, aCopy, want)
+			x := nanGeneral128(n, nrhs, ldb)
+			copyGeneral128(x, b)
+
+			if !impl.Zpotrf(uplo, n, a.Data, lda) {
+				t.Errorf("%v: unexpected failure of Zpotrf", name)
+				continue
+			}
+			impl.Zpotrs(uplo, n, nrhs, a.Data, lda, x.Data, ldb)
+
+			if d := distGeneral128(x, want); d > tol*float64(max(1, n))*max(1, normGeneral128(want)) {
+				t.Errorf("%v: unexpected solution error %v", name, d)
+			}
+		}
+	}
+}
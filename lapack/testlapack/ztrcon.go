@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Ztrconer interface {
+	Ztrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int, work []complex128, rwork []float64) float64
+
+	Zlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []complex128, lda int, work []float64) float64
+}
+
+func ZtrconTest(t *testing.T, impl Ztrconer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, lda := range []int{max(1, n), n + 3} {
+					for _, mattype := range []int{0, 1} {
+						ztrconTest(t, impl, rnd, uplo, diag, n, lda, mattype)
+					}
+				}
+			}
+		}
+	}
+}
+
+func ztrconTest(t *testing.T, impl Ztrconer, rnd *rand.Rand, uplo blas.Uplo, diag blas.Diag, n, lda, mattype int) {
+	const ratioThresh = 10
+
+	a := nanGeneral128(n, n, lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*lda+j] = complex(2*rnd.Float64()-1, 2*rnd.Float64()-1)
+		}
+	}
+	switch mattype {
+	default:
+		panic("bad mattype")
+	case 0:
+		// Well-conditioned matrix with a dominant diagonal.
+		for i := 0; i < n; i++ {
+			a.Data[i*lda+i] += complex(float64(n), 0)
+		}
+	case 1:
+		// Matrix filled with random values whose real and imaginary parts
+		// are uniformly in [-1,1).
+	}
+	aCopy := cloneGeneral128(a)
+
+	// Compute the inverse A⁻¹ by solving A * X = I.
+	aInv := eye128(n, lda)
+	if n > 0 {
+		tri := cblas128.Triangular{
+			N:      n,
+			Stride: lda,
+			Data:   a.Data,
+			Uplo:   uplo,
+			Diag:   diag,
+		}
+		cblas128.Trsm(blas.Left, blas.NoTrans, 1, tri, aInv)
+	}
+
+	work := make([]complex128, 2*n)
+	rwork := make([]float64, n)
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+		name := fmt.Sprintf("norm=%v,uplo=%v,diag=%v,n=%v,lda=%v,mattype=%v", string(norm), string(uplo), string(diag), n, lda, mattype)
+
+		// Compute the norm of A and A⁻¹.
+		aNorm := impl.Zlantr(norm, uplo, diag, n, n, a.Data, lda, rwork)
+		aInvNorm := impl.Zlantr(norm, uplo, diag, n, n, aInv.Data, lda, rwork)
+
+		// Compute a good estimate of the condition number
+		//  rcondWant := 1/(norm(A) * norm(inv(A)))
+		rcondWant := 1.0
+		if aNorm > 0 && aInvNorm > 0 {
+			rcondWant = 1 / aNorm / aInvNorm
+		}
+
+		// Compute an estimate of rcond using Ztrcon.
+		rcondGot := impl.Ztrcon(norm, uplo, diag, n, a.Data, lda, work, rwork)
+		if distGeneral128(a, aCopy) != 0 {
+			t.Errorf("%v: unexpected modification of a", name)
+		}
+
+		ratio := rCondTestRatio(rcondGot, rcondWant)
+		if ratio >= ratioThresh {
+			t.Errorf("%v: unexpected value of rcond; got=%v, want=%v (ratio=%v)",
+				name, rcondGot, rcondWant, ratio)
+		}
+	}
+}
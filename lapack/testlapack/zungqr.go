@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+type Zungqrer interface {
+	Zung2r(m, n, k int, a []complex128, lda int, tau []complex128, work []complex128)
+	Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zgeqrfer
+}
+
+func ZungqrTest(t *testing.T, impl Zungqrer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k, lda int
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 1, 0},
+		{5, 0, 0, 0},
+		{5, 3, 3, 0},
+		{5, 5, 3, 0},
+		{10, 5, 5, 0},
+		{10, 10, 10, 0},
+		{10, 10, 4, 0},
+		{150, 100, 100, 0},
+		{150, 100, 60, 0},
+		{200, 200, 200, 0},
+		{10, 5, 5, 20},
+		{150, 100, 80, 120},
+	} {
+		m := test.m
+		n := test.n
+		k := test.k
+		lda := test.lda
+		if lda == 0 {
+			lda = max(1, n)
+		}
+
+		// Compute the QR factorization of a random matrix to get valid
+		// elementary reflectors.
+		a := randomGeneral128(m, n, lda, rnd)
+		tau := make([]complex128, k)
+		impl.Zgeqr2(m, k, a.Data, lda, tau, make([]complex128, k))
+		aCopy := cloneGeneral128(a)
+
+		// The first n columns of the full Q.
+		q := constructQ128(m, k, k, a.Data, lda, tau)
+		q.Cols = n
+		want := nanGeneral128(m, n, lda)
+		copyGeneral128(want, q)
+
+		for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+			name := fmt.Sprintf("m=%v,n=%v,k=%v,lda=%v,work=%v", m, n, k, lda, wl)
+			var lwork int
+			switch wl {
+			case minimumWork:
+				lwork = max(1, n)
+			case mediumWork:
+				work := make([]complex128, 1)
+				impl.Zungqr(m, n, k, a.Data, lda, tau, work, -1)
+				lwork = max(1, (int(real(work[0]))+n)/2)
+			case optimumWork:
+				work := make([]complex128, 1)
+				impl.Zungqr(m, n, k, a.Data, lda, tau, work, -1)
+				lwork = max(1, n, int(real(work[0])))
+			}
+			work := make([]complex128, lwork)
+
+			copy(a.Data, aCopy.Data)
+			impl.Zungqr(m, n, k, a.Data, lda, tau, work, lwork)
+			if d := distGeneral128(a, want); d > tol {
+				t.Errorf("%v: unexpected Q, |Q - Q_want| = %v", name, d)
+			}
+			if resid := residualUnitary(a, false); resid > tol*float64(max(1, m)) {
+				t.Errorf("%v: columns of Q not orthonormal, |I - Qᴴ*Q| = %v", name, resid)
+			}
+		}
+
+		name := fmt.Sprintf("m=%v,n=%v,k=%v,lda=%v,unblocked", m, n, k, lda)
+		copy(a.Data, aCopy.Data)
+		impl.Zung2r(m, n, k, a.Data, lda, tau, make([]complex128, n))
+		if d := distGeneral128(a, want); d > tol {
+			t.Errorf("%v: unexpected Q, |Q - Q_want| = %v", name, d)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zunmqrer interface {
+	Zunm2r(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128)
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+	Zgeqrfer
+}
+
+func ZunmqrTest(t *testing.T, impl Zunmqrer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			for _, test := range []struct {
+				m, n, k, lda, ldc int
+			}{
+				{0, 0, 0, 0, 0},
+				{1, 1, 1, 0, 0},
+				{3, 4, 0, 0, 0},
+				{3, 4, 2, 0, 0},
+				{4, 3, 3, 0, 0},
+				{10, 10, 5, 0, 0},
+				{10, 10, 10, 0, 0},
+				{150, 120, 90, 0, 0},
+				{120, 150, 100, 0, 0},
+				{4, 3, 2, 10, 10},
+				{150, 120, 90, 100, 130},
+			} {
+				m := test.m
+				n := test.n
+				k := test.k
+				nq := n
+				nw := m
+				if side == blas.Left {
+					nq = m
+					nw = n
+				}
+				lda := test.lda
+				if lda == 0 {
+					lda = max(1, k)
+				}
+				ldc := test.ldc
+				if ldc == 0 {
+					ldc = max(1, n)
+				}
+
+				// Compute the QR factorization of a random nq×k matrix to
+				// get valid elementary reflectors.
+				a := randomGeneral128(nq, k, lda, rnd)
+				tau := make([]complex128, k)
+				impl.Zgeqr2(nq, k, a.Data, lda, tau, make([]complex128, k))
+				q := constructQ128(nq, k, k, a.Data, lda, tau)
+
+				c := randomGeneral128(m, n, ldc, rnd)
+				cCopy := cloneGeneral128(c)
+				var want cblas128.General
+				if side == blas.Left {
+					want = mulGeneral128(trans, blas.NoTrans, q, cCopy)
+				} else {
+					want = mulGeneral128(blas.NoTrans, trans, cCopy, q)
+				}
+
+				for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+					name := fmt.Sprintf("side=%v,trans=%v,m=%v,n=%v,k=%v,lda=%v,ldc=%v,work=%v",
+						sideToString(side), transToString(trans), m, n, k, lda, ldc, wl)
+					var lwork int
+					switch wl {
+					case minimumWork:
+						lwork = max(1, nw)
+					case mediumWork:
+						work := make([]complex128, 1)
+						impl.Zunmqr(side, trans, m, n, k, a.Data, lda, tau, c.Data, ldc, work, -1)
+						lwork = max(1, nw, (int(real(work[0]))+nw)/2)
+					case optimumWork:
+						work := make([]complex128, 1)
+						impl.Zunmqr(side, trans, m, n, k, a.Data, lda, tau, c.Data, ldc, work, -1)
+						lwork = max(1, nw, int(real(work[0])))
+					}
+					work := make([]complex128, lwork)
+
+					copy(c.Data, cCopy.Data)
+					impl.Zunmqr(side, trans, m, n, k, a.Data, lda, tau, c.Data, ldc, work, lwork)
+					if d := distGeneral128(c, want); d > tol*float64(max(1, nq))*max(1, normGeneral128(cCopy)) {
+						t.Errorf("%v: unexpected result, |got - want| = %v", name, d)
+					}
+				}
+
+				name := fmt.Sprintf("side=%v,trans=%v,m=%v,n=%v,k=%v,lda=%v,ldc=%v,unblocked",
+					sideToString(side), transToString(trans), m, n, k, lda, ldc)
+				copy(c.Data, cCopy.Data)
+				impl.Zunm2r(side, trans, m, n, k, a.Data, lda, tau, c.Data, ldc, make([]complex128, nw))
+				if d := distGeneral128(c, want); d > tol*float64(max(1, nq))*max(1, normGeneral128(cCopy)) {
+					t.Errorf("%v: unexpected result, |got - want| = %v", name, d)
+				}
+			}
+		}
+	}
+}
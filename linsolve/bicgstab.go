@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// BiCGStab implements the BiConjugate Gradient Stabilized iterative method
+// with preconditioning for solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a non-symmetric matrix. For symmetric positive definite
+// systems use CG.
+//
+// BiCGStab needs MulVec and PreconSolve operations.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.8 BiConjugate Gradient Stabilized (Bi-CGSTAB).
+//     In Templates for the Solution of Linear Systems: Building Blocks
+//     for Iterative Methods (2nd ed.) (pp. 24-25). Philadelphia, PA: SIAM.
+//     Retrieved from http://www.netlib.org/templates/templates.pdf
+type BiCGStab struct {
+	r, rt   mat.VecDense
+	p, v    mat.VecDense
+	pHat, s mat.VecDense
+	sHat    mat.VecDense
+
+	rho, rhoPrev float64
+	alpha, omega float64
+	first        bool
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for more details.
+func (b *BiCGStab) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("bicgstab: vector length mismatch")
+	}
+
+	b.r.CloneFromVec(residual)
+	b.rt.CloneFromVec(residual)
+	for _, v := range []*mat.VecDense{&b.p, &b.v, &b.pHat, &b.s, &b.sHat} {
+		v.Reset()
+		v.ReuseAsVec(dim)
+	}
+
+	b.first = true
+	b.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface for more details.
+//
+// BiCGStab will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+//	NoOperation
+func (b *BiCGStab) Iterate(ctx *Context) (Operation, error) {
+	switch b.resume {
+	case 1:
+		b.rho = mat.Dot(&b.rt, &b.r) // ρ_{i-1} = r̃ · r_{i-1}
+		if b.rho == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: b.rho}
+		}
+		if b.first {
+			b.p.CopyVec(&b.r) // p_i = r_{i-1}
+		} else {
+			beta := (b.rho / b.rhoPrev) * (b.alpha / b.omega)
+			b.p.AddScaledVec(&b.p, -b.omega, &b.v)
+			b.p.AddScaledVec(&b.r, beta, &b.p) // p_i = r_{i-1} + β (p_{i-1} - ω v_{i-1})
+		}
+		// Solve M p̂ = p_i.
+		ctx.Src.CopyVec(&b.p)
+		b.resume = 2
+		return PreconSolve, nil
+	case 2:
+		b.pHat.CopyVec(ctx.Dst)
+		// Compute A p̂.
+		ctx.Src.CopyVec(&b.pHat)
+		b.resume = 3
+		return MulVec, nil
+	case 3:
+		b.v.CopyVec(ctx.Dst) // v_i = A p̂
+		rtv := mat.Dot(&b.rt, &b.v)
+		if rtv == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: rtv}
+		}
+		b.alpha = b.rho / rtv                  // α_i = ρ_{i-1} / (r̃ · v_i)
+		b.s.AddScaledVec(&b.r, -b.alpha, &b.v) // s = r_{i-1} - α_i v_i
+		ctx.ResidualNorm = mat.Norm(&b.s, 2)
+		b.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		if ctx.Converged {
+			ctx.X.AddScaledVec(ctx.X, b.alpha, &b.pHat) // x_i = x_{i-1} + α_i p̂
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		// Solve M ŝ = s.
+		ctx.Src.CopyVec(&b.s)
+		b.resume = 5
+		return PreconSolve, nil
+	case 5:
+		b.sHat.CopyVec(ctx.Dst)
+		// Compute A ŝ.
+		ctx.Src.CopyVec(&b.sHat)
+		b.resume = 6
+		return MulVec, nil
+	case 6:
+		t := ctx.Dst
+		tt := mat.Dot(t, t)
+		if tt == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: tt}
+		}
+		b.omega = mat.Dot(t, &b.s) / tt             // ω_i = (t · s) / (t · t)
+		ctx.X.AddScaledVec(ctx.X, b.alpha, &b.pHat) // x_i = x_{i-1} + α_i p̂ + ω_i ŝ
+		ctx.X.AddScaledVec(ctx.X, b.omega, &b.sHat)
+		b.r.AddScaledVec(&b.s, -b.omega, t) // r_i = s - ω_i t
+		ctx.ResidualNorm = mat.Norm(&b.r, 2)
+		b.resume = 7
+		return CheckResidualNorm, nil
+	case 7:
+		if ctx.Converged {
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		if b.omega == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: b.omega}
+		}
+		b.rhoPrev = b.rho
+		b.first = false
+		b.resume = 1
+		return MajorIteration, nil
+
+	default:
+		panic("bicgstab: Init not called")
+	}
+}
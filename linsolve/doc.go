@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linsolve provides iterative methods for solving linear systems.
+//
+// # Background
+//
+// A system of linear equations can be written as
+//
+//	A * x = b,
+//
+// where A is a given n×n non-singular matrix, b is a given n-vector (the
+// right-hand side), and x is an unknown n-vector.
+//
+// Direct methods such as the LU or QR decomposition compute (in the absence
+// of roundoff errors) the exact solution after a finite number of steps. For
+// a general matrix A they require O(n²) storage and O(n³) arithmetic
+// operations, which makes them impractical for large n.
+//
+// Iterative methods instead compute a sequence of approximate solutions
+// x_k that converge to the exact solution. They only need A through
+// matrix-vector products, so A may be a sparse matrix such as a
+// sparse.CSR, a banded matrix such as a mat.BandDense, or an operator that
+// is never formed explicitly.
+//
+// # Usage
+//
+// The matrix A is passed to Iterative as a MulVecToer, together with the
+// right-hand side and a Method such as CG, MINRES, GMRES or BiCGStab. The
+// choice of Method depends on the properties of A:
+//
+//   - CG for symmetric positive definite matrices,
+//   - MINRES for symmetric, possibly indefinite, matrices,
+//   - GMRES and BiCGStab for general non-symmetric matrices.
+//
+// The rate of convergence of iterative methods depends on the spectrum of
+// A and can be improved with a preconditioner, which is an approximation M
+// of A that is cheap to solve with. Preconditioners are provided to
+// Iterative through Settings.Preconditioner, and the package provides
+// Jacobi, IncompleteCholesky and IncompleteLU. A mat.LU also satisfies the
+// Preconditioner interface.
+//
+// # References
+//
+//   - Barrett, R. et al. (1994). Templates for the Solution of Linear
+//     Systems: Building Blocks for Iterative Methods (2nd ed.).
+//     Philadelphia, PA: SIAM. Retrieved from
+//     http://www.netlib.org/templates/templates.pdf
+//   - Saad, Y. (2003). Iterative methods for sparse linear systems (2nd
+//     ed.). Philadelphia, PA: SIAM. Retrieved from
+//     https://www-users.cse.umn.edu/~saad/IterMethBook_2ndEd.pdf
+package linsolve // import "gonum.org/v1/gonum/linsolve"
@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/linsolve"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/sparse"
+)
+
+func ExampleIterative() {
+	// Solve the 1-D Poisson equation -u'' = 1 on [0, 1] with
+	// u(0) = u(1) = 0 using second order finite differences.
+	const n = 9
+	h := 1.0 / (n + 1)
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 2/(h*h))
+		if i > 0 {
+			a.Append(i, i-1, -1/(h*h))
+		}
+		if i < n-1 {
+			a.Append(i, i+1, -1/(h*h))
+		}
+		b.SetVec(i, 1)
+	}
+	csr := a.ToCSR()
+
+	var ic linsolve.IncompleteCholesky
+	err := ic.Factorize(csr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	res, err := linsolve.Iterative(csr, b, &linsolve.CG{}, &linsolve.Settings{
+		Preconditioner: &ic,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The exact solution is u(x) = x(1-x)/2.
+	fmt.Printf("u = %.4f\n", mat.Formatted(res.X.T()))
+
+	// Output:
+	// u = [0.0450  0.0800  0.1050  0.1200  0.1250  0.1200  0.1050  0.0800  0.0450]
+}
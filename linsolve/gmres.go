@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultRestart = 30
+
+// GMRES implements the Generalized Minimum Residual method with restarts
+// and right preconditioning for solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a general non-singular matrix.
+//
+// GMRES builds an orthonormal basis of a Krylov subspace of dimension at
+// most Restart and computes the approximate solution that minimizes the
+// residual norm over that subspace. The solution estimate is updated and
+// a MajorIteration is reported only at the end of each restart cycle, so
+// Settings.MaxIterations limits the number of restart cycles. The residual
+// norm estimate is checked for convergence after each inner step.
+//
+// Since right preconditioning is used, the residual norm estimate is the
+// norm of the residual of the original system.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.4 Generalized Minimal Residual
+//     (GMRES). In Templates for the Solution of Linear Systems: Building
+//     Blocks for Iterative Methods (2nd ed.) (pp. 17-19). Philadelphia, PA:
+//     SIAM. Retrieved from http://www.netlib.org/templates/templates.pdf
+//   - Saad, Y. (2003). Section 9.3.2 Right-Preconditioned GMRES. In
+//     Iterative methods for sparse linear systems (2nd ed.) (pp. 284-285).
+//     Philadelphia, PA: SIAM.
+type GMRES struct {
+	// Restart is the restart parameter which limits the dimension of
+	// the Krylov subspace and so the amount of storage needed. If
+	// Restart is zero, a default value of min(30, n) is used, where n
+	// is the dimension of the system. Restart must not be negative.
+	Restart int
+
+	m int
+	// v holds the orthonormal basis of the Krylov subspace.
+	v []mat.VecDense
+	// h holds the (m+1)×m upper Hessenberg matrix reduced to upper
+	// triangular form by Givens rotations.
+	h *mat.Dense
+	// cs and sn hold the cosines and sines of the Givens rotations.
+	cs, sn []float64
+	// g holds the right-hand side of the least-squares problem.
+	g []float64
+	y []float64
+
+	// j is the index of the current inner step and hNext is the norm of
+	// the last computed Arnoldi vector before normalization.
+	j     int
+	hNext float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for more details.
+func (g *GMRES) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("gmres: vector length mismatch")
+	}
+	if g.Restart < 0 {
+		panic("gmres: negative restart")
+	}
+
+	g.m = g.Restart
+	if g.m == 0 {
+		g.m = min(defaultRestart, dim)
+	}
+	g.m = min(g.m, dim)
+
+	g.v = make([]mat.VecDense, g.m+1)
+	for i := range g.v {
+		g.v[i].ReuseAsVec(dim)
+	}
+	g.h = mat.NewDense(g.m+1, g.m, nil)
+	g.cs = make([]float64, g.m)
+	g.sn = make([]float64, g.m)
+	g.g = make([]float64, g.m+1)
+	g.y = make([]float64, g.m)
+
+	g.startCycle(residual)
+	g.resume = 1
+}
+
+// startCycle initializes a restart cycle from the residual r.
+func (g *GMRES) startCycle(r *mat.VecDense) {
+	beta := mat.Norm(r, 2)
+	g.v[0].ScaleVec(1/beta, r)
+	g.h.Zero()
+	clear(g.g)
+	g.g[0] = beta
+	g.j = 0
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface for more details.
+//
+// GMRES will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	ComputeResidual
+//	CheckResidualNorm
+//	MajorIteration
+func (g *GMRES) Iterate(ctx *Context) (Operation, error) {
+	switch g.resume {
+	case 1:
+		// Solve M z = v_j.
+		ctx.Src.CopyVec(&g.v[g.j])
+		g.resume = 2
+		return PreconSolve, nil
+	case 2:
+		// Compute w = A z.
+		ctx.Src.CopyVec(ctx.Dst)
+		g.resume = 3
+		return MulVec, nil
+	case 3:
+		j := g.j
+		w := ctx.Dst
+		// Orthogonalize w against the basis using
+		// the modified Gram-Schmidt process.
+		for i := 0; i <= j; i++ {
+			hij := mat.Dot(w, &g.v[i])
+			g.h.Set(i, j, hij)
+			w.AddScaledVec(w, -hij, &g.v[i])
+		}
+		g.hNext = mat.Norm(w, 2)
+		if g.hNext != 0 {
+			g.v[j+1].ScaleVec(1/g.hNext, w)
+		}
+		g.h.Set(j+1, j, g.hNext)
+
+		// Apply the previous rotations to the new column of H.
+		for i := 0; i < j; i++ {
+			hi, hi1 := g.h.At(i, j), g.h.At(i+1, j)
+			g.h.Set(i, j, g.cs[i]*hi+g.sn[i]*hi1)
+			g.h.Set(i+1, j, -g.sn[i]*hi+g.cs[i]*hi1)
+		}
+		// Compute and apply the new rotation to eliminate H[j+1,j].
+		c, s, r := givens(g.h.At(j, j), g.hNext)
+		g.cs[j], g.sn[j] = c, s
+		g.h.Set(j, j, r)
+		g.h.Set(j+1, j, 0)
+		g.g[j+1] = -s * g.g[j]
+		g.g[j] = c * g.g[j]
+
+		ctx.ResidualNorm = math.Abs(g.g[j+1])
+		g.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		g.j++
+		if !ctx.Converged && g.j < g.m && g.hNext != 0 {
+			// Continue with the next inner step.
+			ctx.Src.CopyVec(&g.v[g.j])
+			g.resume = 2
+			return PreconSolve, nil
+		}
+		// Solve the upper triangular system H y = g and
+		// form the update M⁻¹ V y.
+		k := g.j
+		for i := k - 1; i >= 0; i-- {
+			sum := g.g[i]
+			for l := i + 1; l < k; l++ {
+				sum -= g.h.At(i, l) * g.y[l]
+			}
+			hii := g.h.At(i, i)
+			if hii == 0 {
+				g.resume = 0
+				return NoOperation, &BreakdownError{Value: hii}
+			}
+			g.y[i] = sum / hii
+		}
+		ctx.Src.Zero()
+		for i := 0; i < k; i++ {
+			ctx.Src.AddScaledVec(ctx.Src, g.y[i], &g.v[i])
+		}
+		g.resume = 5
+		return PreconSolve, nil
+	case 5:
+		ctx.X.AddVec(ctx.X, ctx.Dst)
+		if ctx.Converged {
+			g.resume = 0
+		} else {
+			g.resume = 6
+		}
+		return MajorIteration, nil
+	case 6:
+		g.resume = 7
+		return ComputeResidual, nil
+	case 7:
+		if mat.Norm(ctx.Dst, 2) == 0 {
+			ctx.ResidualNorm = 0
+			g.resume = 8
+			return CheckResidualNorm, nil
+		}
+		g.startCycle(ctx.Dst)
+		// Solve M z = v_0.
+		ctx.Src.CopyVec(&g.v[0])
+		g.resume = 2
+		return PreconSolve, nil
+	case 8:
+		g.resume = 0
+		return MajorIteration, nil
+
+	default:
+		panic("gmres: Init not called")
+	}
+}
+
+// givens returns the cosine and sine of the plane rotation that
+// eliminates b, and the resulting value r such that
+//
+//	[ c s] [a]   [r]
+//	[-s c] [b] = [0].
+func givens(a, b float64) (c, s, r float64) {
+	if b == 0 {
+		return 1, 0, a
+	}
+	r = math.Hypot(a, b)
+	return a / r, b / r, r
+}
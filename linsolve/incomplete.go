@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/sparse"
+)
+
+// IncompleteLU is an incomplete LU factorization with zero fill-in, ILU(0),
+// of a sparse matrix A such that
+//
+//	A ≈ L * U,
+//
+// where L is unit lower triangular and U is upper triangular, and the
+// non-zero pattern of L+U is the same as that of A.
+//
+// IncompleteLU is used as a preconditioner for non-symmetric systems, for
+// example with GMRES or BiCGStab.
+//
+// References:
+//   - Saad, Y. (2003). Section 10.3.2 Zero Fill-in ILU (ILU(0)). In
+//     Iterative methods for sparse linear systems (2nd ed.) (pp. 307-310).
+//     Philadelphia, PA: SIAM.
+type IncompleteLU struct {
+	n      int
+	indptr []int
+	ind    []int
+	// data holds the strictly lower triangular elements of L and the
+	// upper triangular elements of U in the pattern of A.
+	data []float64
+	// diag holds the index into ind and data of the diagonal
+	// element of each row.
+	diag []int
+}
+
+// Factorize computes the ILU(0) factorization of the square matrix a.
+// Factorize returns ErrZeroPivot if a diagonal element of a is not stored
+// or a zero pivot is encountered, in which case the receiver must not be
+// used as a preconditioner.
+func (ilu *IncompleteLU) Factorize(a *sparse.CSR) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	indptr, ind, data := a.RawCSR()
+	ilu.n = n
+	ilu.indptr = slices.Clone(indptr)
+	ilu.ind = slices.Clone(ind[:indptr[n]])
+	ilu.data = slices.Clone(data[:indptr[n]])
+	ilu.diag = make([]int, n)
+	if !findDiag(ilu.diag, ilu.indptr, ilu.ind) {
+		return ErrZeroPivot
+	}
+
+	indptr, ind, data = ilu.indptr, ilu.ind, ilu.data
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			pos[ind[k]] = k
+		}
+		for k := indptr[i]; k < ilu.diag[i]; k++ {
+			j := ind[k]
+			data[k] /= data[ilu.diag[j]]
+			lij := data[k]
+			for kk := ilu.diag[j] + 1; kk < indptr[j+1]; kk++ {
+				if p := pos[ind[kk]]; p >= 0 {
+					data[p] -= lij * data[kk]
+				}
+			}
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			pos[ind[k]] = -1
+		}
+		if data[ilu.diag[i]] == 0 {
+			return ErrZeroPivot
+		}
+	}
+	return nil
+}
+
+// SolveVecTo solves L*U*x = b or (L*U)ᵀ*x = b and stores the result
+// into dst.
+func (ilu *IncompleteLU) SolveVecTo(dst *mat.VecDense, trans bool, b mat.Vector) error {
+	n := ilu.n
+	if b.Len() != n {
+		panic(mat.ErrShape)
+	}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = b.AtVec(i)
+	}
+	indptr, ind, data := ilu.indptr, ilu.ind, ilu.data
+	if !trans {
+		// Solve L y = b.
+		for i := 0; i < n; i++ {
+			for k := indptr[i]; k < ilu.diag[i]; k++ {
+				x[i] -= data[k] * x[ind[k]]
+			}
+		}
+		// Solve U x = y.
+		for i := n - 1; i >= 0; i-- {
+			for k := ilu.diag[i] + 1; k < indptr[i+1]; k++ {
+				x[i] -= data[k] * x[ind[k]]
+			}
+			x[i] /= data[ilu.diag[i]]
+		}
+	} else {
+		// Solve Uᵀ y = b.
+		for i := 0; i < n; i++ {
+			x[i] /= data[ilu.diag[i]]
+			for k := ilu.diag[i] + 1; k < indptr[i+1]; k++ {
+				x[ind[k]] -= data[k] * x[i]
+			}
+		}
+		// Solve Lᵀ x = y.
+		for i := n - 1; i >= 0; i-- {
+			for k := indptr[i]; k < ilu.diag[i]; k++ {
+				x[ind[k]] -= data[k] * x[i]
+			}
+		}
+	}
+	reuseVec(dst, n)
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// IncompleteCholesky is an incomplete Cholesky factorization with zero
+// fill-in, IC(0), of a sparse symmetric positive definite matrix A such
+// that
+//
+//	A ≈ L * Lᵀ,
+//
+// where L is lower triangular with the same non-zero pattern as the lower
+// triangle of A.
+//
+// IncompleteCholesky is used as a preconditioner for symmetric positive
+// definite systems with CG or MINRES.
+//
+// References:
+//   - Saad, Y. (2003). Section 10.3.5 Incomplete Cholesky. In Iterative
+//     methods for sparse linear systems (2nd ed.). Philadelphia, PA: SIAM.
+type IncompleteCholesky struct {
+	n      int
+	indptr []int
+	ind    []int
+	// data holds the elements of L. The diagonal
+	// element is the last element of each row.
+	data []float64
+}
+
+// Factorize computes the IC(0) factorization of the symmetric matrix a. Only
+// the lower triangle of a is used. Factorize returns ErrZeroPivot if a
+// diagonal element of a is not stored or a non-positive pivot is
+// encountered, in which case the receiver must not be used as a
+// preconditioner.
+func (ic *IncompleteCholesky) Factorize(a *sparse.CSR) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	aIndptr, aInd, aData := a.RawCSR()
+
+	// Extract the lower triangle of a.
+	ic.n = n
+	ic.indptr = make([]int, n+1)
+	ic.ind = ic.ind[:0]
+	ic.data = ic.data[:0]
+	for i := 0; i < n; i++ {
+		for k := aIndptr[i]; k < aIndptr[i+1] && aInd[k] <= i; k++ {
+			ic.ind = append(ic.ind, aInd[k])
+			ic.data = append(ic.data, aData[k])
+		}
+		ic.indptr[i+1] = len(ic.ind)
+		if ic.indptr[i+1] == ic.indptr[i] || ic.ind[ic.indptr[i+1]-1] != i {
+			return ErrZeroPivot
+		}
+	}
+
+	indptr, ind, data := ic.indptr, ic.ind, ic.data
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		diag := indptr[i+1] - 1
+		for k := indptr[i]; k < diag; k++ {
+			pos[ind[k]] = k
+		}
+		for k := indptr[i]; k < diag; k++ {
+			// l_ij = (a_ij - Σ_{m<j} l_im l_jm) / l_jj
+			j := ind[k]
+			sum := data[k]
+			for kk := indptr[j]; kk < indptr[j+1]-1; kk++ {
+				if p := pos[ind[kk]]; p >= 0 && p < k {
+					sum -= data[p] * data[kk]
+				}
+			}
+			data[k] = sum / data[indptr[j+1]-1]
+		}
+		// l_ii = sqrt(a_ii - Σ_{m<i} l_im²)
+		sum := data[diag]
+		for k := indptr[i]; k < diag; k++ {
+			sum -= data[k] * data[k]
+			pos[ind[k]] = -1
+		}
+		if sum <= 0 {
+			return ErrZeroPivot
+		}
+		data[diag] = math.Sqrt(sum)
+	}
+	return nil
+}
+
+// SolveVecTo solves L*Lᵀ*x = b and stores the result into dst. Since
+// L*Lᵀ is symmetric, trans is ignored.
+func (ic *IncompleteCholesky) SolveVecTo(dst *mat.VecDense, _ bool, b mat.Vector) error {
+	n := ic.n
+	if b.Len() != n {
+		panic(mat.ErrShape)
+	}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = b.AtVec(i)
+	}
+	indptr, ind, data := ic.indptr, ic.ind, ic.data
+	// Solve L y = b.
+	for i := 0; i < n; i++ {
+		diag := indptr[i+1] - 1
+		for k := indptr[i]; k < diag; k++ {
+			x[i] -= data[k] * x[ind[k]]
+		}
+		x[i] /= data[diag]
+	}
+	// Solve Lᵀ x = y.
+	for i := n - 1; i >= 0; i-- {
+		diag := indptr[i+1] - 1
+		x[i] /= data[diag]
+		for k := indptr[i]; k < diag; k++ {
+			x[ind[k]] -= data[k] * x[i]
+		}
+	}
+	reuseVec(dst, n)
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// findDiag stores in diag the index of the diagonal element of each row of
+// the compressed row representation given by indptr and ind. It returns
+// false if any diagonal element is not stored.
+func findDiag(diag, indptr, ind []int) bool {
+	for i := range diag {
+		k, ok := slices.BinarySearch(ind[indptr[i]:indptr[i+1]], i)
+		if !ok {
+			return false
+		}
+		diag[i] = indptr[i] + k
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/sparse"
+)
+
+// randTridiag returns a random n×n tridiagonal matrix. If sym is true, the
+// matrix is symmetric positive definite.
+func randTridiag(n int, sym bool, rnd *rand.Rand) *sparse.CSR {
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 4+rnd.Float64())
+		if i < n-1 {
+			v := rnd.Float64() - 0.5
+			a.Append(i, i+1, v)
+			if sym {
+				a.Append(i+1, i, v)
+			} else {
+				a.Append(i+1, i, rnd.Float64()-0.5)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+func TestIncompleteLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20} {
+		// ILU(0) of a tridiagonal matrix is exact since the
+		// LU factorization has no fill-in.
+		a := randTridiag(n, false, rnd)
+		var ilu IncompleteLU
+		err := ilu.Factorize(a)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		for _, trans := range []bool{false, true} {
+			want := randVec(n, rnd)
+			var b mat.VecDense
+			a.MulVecTo(&b, trans, want)
+			var got mat.VecDense
+			err = ilu.SolveVecTo(&got, trans, &b)
+			if err != nil {
+				t.Fatalf("n=%d trans=%t: unexpected error: %v", n, trans, err)
+			}
+			if !mat.EqualApprox(&got, want, 1e-13) {
+				t.Errorf("n=%d trans=%t: unexpected solution\ngot: %v\nwant:%v", n, trans, mat.Formatted(got.T()), mat.Formatted(want.T()))
+			}
+		}
+	}
+
+	// Missing diagonal.
+	var ilu IncompleteLU
+	err := ilu.Factorize(sparse.NewCSR(2, 2, []int{0, 1, 2}, []int{1, 0}, []float64{1, 1}))
+	if err != ErrZeroPivot {
+		t.Errorf("unexpected error for missing diagonal: got %v, want %v", err, ErrZeroPivot)
+	}
+}
+
+func TestIncompleteCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20} {
+		// IC(0) of a tridiagonal matrix is exact since the
+		// Cholesky factorization has no fill-in.
+		a := randTridiag(n, true, rnd)
+		var ic IncompleteCholesky
+		err := ic.Factorize(a)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		want := randVec(n, rnd)
+		var b mat.VecDense
+		a.MulVecTo(&b, false, want)
+		var got mat.VecDense
+		err = ic.SolveVecTo(&got, false, &b)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		if !mat.EqualApprox(&got, want, 1e-13) {
+			t.Errorf("n=%d: unexpected solution\ngot: %v\nwant:%v", n, mat.Formatted(got.T()), mat.Formatted(want.T()))
+		}
+	}
+
+	// Not positive definite.
+	var ic IncompleteCholesky
+	a := sparse.NewCSR(2, 2, []int{0, 2, 4}, []int{0, 1, 0, 1}, []float64{1, 2, 2, 1})
+	err := ic.Factorize(a)
+	if err != ErrZeroPivot {
+		t.Errorf("unexpected error for indefinite matrix: got %v, want %v", err, ErrZeroPivot)
+	}
+}
+
+func TestJacobi(t *testing.T) {
+	t.Parallel()
+	d := mat.NewDiagDense(3, []float64{2, -4, 0.5})
+	j, err := NewJacobi(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got mat.VecDense
+	err = j.SolveVecTo(&got, false, mat.NewVecDense(3, []float64{1, 2, 3}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := mat.NewVecDense(3, []float64{0.5, -0.5, 6})
+	if !mat.Equal(&got, want) {
+		t.Errorf("unexpected solution: got %v, want %v", mat.Formatted(got.T()), mat.Formatted(want.T()))
+	}
+	_, err = NewJacobi(mat.NewDiagDense(2, []float64{1, 0}))
+	if err != ErrZeroPivot {
+		t.Errorf("unexpected error for zero diagonal: got %v, want %v", err, ErrZeroPivot)
+	}
+}
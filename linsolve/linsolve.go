@@ -0,0 +1,362 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultTolerance = 1e-8
+
+var (
+	// ErrIterationLimit is returned when the maximum number of iterations
+	// was reached before the convergence criterion was satisfied.
+	ErrIterationLimit = errors.New("linsolve: iteration limit reached")
+
+	// ErrRuntimeLimit is returned when the maximum runtime was exceeded
+	// before the convergence criterion was satisfied.
+	ErrRuntimeLimit = errors.New("linsolve: runtime limit reached")
+)
+
+// BreakdownError signifies that a breakdown occurred in a Method and the
+// iteration cannot continue. The Value field holds the quantity that
+// became zero or otherwise invalid.
+type BreakdownError struct {
+	Value float64
+}
+
+func (e *BreakdownError) Error() string {
+	return fmt.Sprintf("linsolve: breakdown, value=%v", e.Value)
+}
+
+// MulVecToer represents a square matrix A by means of a matrix-vector
+// product. mat.BandDense, mat.SymBandDense, mat.Tridiag, sparse.CSR and
+// sparse.CSC satisfy MulVecToer.
+type MulVecToer interface {
+	// MulVecTo computes A*x or Aᵀ*x and stores the result into dst.
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// Preconditioner represents a preconditioner M, an approximation of A that
+// is cheap to solve with. mat.LU satisfies Preconditioner.
+type Preconditioner interface {
+	// SolveVecTo solves M*x = b or Mᵀ*x = b and stores the result
+	// into dst.
+	SolveVecTo(dst *mat.VecDense, trans bool, b mat.Vector) error
+}
+
+// Operation specifies the type of operation requested by a Method from
+// the caller of Method.Iterate.
+type Operation uint
+
+// Operations commanded by Method.Iterate.
+const (
+	// NoOperation does not require any action from the caller.
+	NoOperation Operation = 0
+
+	// MulVec specifies that the caller must compute A*Src and store the
+	// result in Dst. If combined with Trans, Aᵀ*Src must be computed
+	// instead.
+	MulVec Operation = 1 << (iota - 1)
+
+	// PreconSolve specifies that the caller must solve M*z = Src for z
+	// and store the result in Dst. If combined with Trans, Mᵀ*z = Src
+	// must be solved instead. If no preconditioner has been provided, M
+	// is the identity matrix.
+	PreconSolve
+
+	// Trans modifies MulVec and PreconSolve to use the transpose of the
+	// respective matrix.
+	Trans
+
+	// ComputeResidual specifies that the caller must compute the
+	// residual b - A*X and store the result in Dst.
+	ComputeResidual
+
+	// CheckResidualNorm specifies that the caller must check whether
+	// ResidualNorm satisfies the convergence criterion and update the
+	// Converged field accordingly.
+	CheckResidualNorm
+
+	// MajorIteration indicates that the Method has finished an iteration
+	// and X holds the current estimate of the solution. If Converged is
+	// true, the caller should terminate the iteration.
+	MajorIteration
+)
+
+// Context mediates the communication between a Method and the caller of
+// Method.Iterate. The caller must not modify the fields of Context other
+// than as requested by the returned Operation.
+type Context struct {
+	// X holds the current approximate solution. It is updated by the
+	// Method and is valid whenever MajorIteration is returned.
+	X *mat.VecDense
+
+	// ResidualNorm is an estimate of the norm of the current residual.
+	// It is updated by the Method and is valid whenever
+	// CheckResidualNorm is returned.
+	ResidualNorm float64
+
+	// Converged is set by the caller in response to CheckResidualNorm
+	// to indicate whether ResidualNorm satisfies the convergence
+	// criterion.
+	Converged bool
+
+	// Src and Dst are the source and destination vectors of the
+	// MulVec, PreconSolve and ComputeResidual operations.
+	Src, Dst *mat.VecDense
+}
+
+// Method is an iterative method for solving linear systems.
+//
+// A Method communicates with its caller through the Operation returned by
+// Iterate, in the same way that an optimize.Method does, so that the
+// caller has full control over the matrix-vector products, preconditioner
+// solves and the convergence check.
+type Method interface {
+	// Init initializes the Method for solving a linear system with an
+	// initial estimate x and the corresponding residual b - A*x. Init
+	// must not retain x or residual.
+	Init(x, residual *mat.VecDense)
+
+	// Iterate performs a step of the Method and returns the Operation
+	// that the caller must perform before calling Iterate again. On the
+	// first call to Iterate ctx.X holds the initial estimate of the
+	// solution.
+	Iterate(ctx *Context) (Operation, error)
+}
+
+// Settings holds settings for solving a linear system. See the field
+// comments for default values.
+type Settings struct {
+	// InitX holds the initial guess. If it is nil or empty, the zero
+	// vector will be used, otherwise its length must be equal to the
+	// length of the right-hand side.
+	InitX *mat.VecDense
+
+	// Dst, if not nil, will be used for storing the approximate
+	// solution. If Dst is empty, it will be resized to the correct
+	// length, otherwise its length must be equal to the length of the
+	// right-hand side.
+	Dst *mat.VecDense
+
+	// Tolerance specifies the tolerance for the relative residual norm
+	// such that the iteration has converged when
+	//
+	//	|r_k| < Tolerance * |b|,
+	//
+	// where r_k is the residual at iteration k. If Tolerance is zero,
+	// a default value of 1e-8 will be used, otherwise it must satisfy
+	// 0 < Tolerance < 1.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of major iterations allowed.
+	// ErrIterationLimit is returned if the number of major iterations
+	// reaches this value. If MaxIterations is zero, a default value of
+	// 4 times the dimension of the system will be used.
+	MaxIterations int
+
+	// Runtime is the maximum runtime allowed. ErrRuntimeLimit is
+	// returned if the duration of the run is longer than this value.
+	// Runtime is only checked at major iterations. If Runtime is zero,
+	// this setting has no effect.
+	Runtime time.Duration
+
+	// Preconditioner is used to precondition the system. If it is nil,
+	// the identity matrix is used and no preconditioning takes place.
+	Preconditioner Preconditioner
+}
+
+// defaultSettings fills s with default values for a system of dimension n.
+func defaultSettings(s *Settings, n int) {
+	if s.InitX != nil && !s.InitX.IsEmpty() && s.InitX.Len() != n {
+		panic("linsolve: mismatched length of initial guess")
+	}
+	if s.Dst != nil && !s.Dst.IsEmpty() && s.Dst.Len() != n {
+		panic("linsolve: mismatched length of destination")
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = defaultTolerance
+	}
+	if s.Tolerance <= 0 || 1 <= s.Tolerance {
+		panic("linsolve: invalid tolerance")
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 4 * n
+	}
+	if s.MaxIterations < 0 {
+		panic("linsolve: negative maximum number of iterations")
+	}
+}
+
+// Result holds the result of an iterative solve.
+type Result struct {
+	// X is the approximate solution.
+	X *mat.VecDense
+
+	// ResidualNorm is the estimate of the norm of the final residual.
+	ResidualNorm float64
+
+	// ResidualHistory holds the residual norm estimates in the order
+	// they were checked for convergence. The first element is the
+	// norm of the initial residual.
+	ResidualHistory []float64
+
+	// Stats holds statistics about the run.
+	Stats Stats
+}
+
+// Stats holds statistics about an iterative solve.
+type Stats struct {
+	// Iterations is the number of major iterations performed.
+	Iterations int
+
+	// MulVec is the number of matrix-vector products with A
+	// or Aᵀ performed.
+	MulVec int
+
+	// PreconSolve is the number of solves with the
+	// preconditioner M or Mᵀ performed.
+	PreconSolve int
+
+	// Runtime is the total runtime of the solve.
+	Runtime time.Duration
+}
+
+// Iterative finds an approximate solution of the system of n linear
+// equations
+//
+//	A*x = b,
+//
+// where A is a non-singular square matrix of order n and b is the
+// right-hand side vector, using an iterative method m. If m is nil,
+// GMRES with the default parameters is used.
+//
+// settings provide means for adjusting the iterative process. Zero values
+// of the fields mean default values. If settings is nil, the default
+// settings are used.
+//
+// Iterative returns a Result holding the approximate solution and
+// statistics about the run. If the iteration does not converge, a non-nil
+// error is returned together with the last approximate solution.
+func Iterative(a MulVecToer, b *mat.VecDense, m Method, settings *Settings) (*Result, error) {
+	n := b.Len()
+
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	defaultSettings(&s, n)
+	if m == nil {
+		m = &GMRES{}
+	}
+
+	start := time.Now()
+	stats := Stats{}
+
+	x := s.Dst
+	if x == nil {
+		x = mat.NewVecDense(n, nil)
+	} else if x.IsEmpty() {
+		x.ReuseAsVec(n)
+	}
+	if s.InitX != nil && !s.InitX.IsEmpty() {
+		x.CopyVec(s.InitX)
+	} else {
+		x.Zero()
+	}
+
+	ctx := &Context{
+		X:   x,
+		Src: mat.NewVecDense(n, nil),
+		Dst: mat.NewVecDense(n, nil),
+	}
+	computeResidual(ctx.Dst, a, b, x, &stats)
+
+	bNorm := mat.Norm(b, 2)
+	if bNorm == 0 {
+		// The solution of A*x = 0 is x = 0.
+		bNorm = 1
+		x.Zero()
+		ctx.Dst.Zero()
+	}
+	threshold := s.Tolerance * bNorm
+
+	ctx.ResidualNorm = mat.Norm(ctx.Dst, 2)
+	res := &Result{
+		X:               x,
+		ResidualNorm:    ctx.ResidualNorm,
+		ResidualHistory: []float64{ctx.ResidualNorm},
+	}
+	if ctx.ResidualNorm < threshold {
+		stats.Runtime = time.Since(start)
+		res.Stats = stats
+		return res, nil
+	}
+
+	m.Init(x, ctx.Dst)
+	var err error
+	for {
+		var op Operation
+		op, err = m.Iterate(ctx)
+		if err != nil {
+			break
+		}
+		switch op {
+		case NoOperation:
+		case MulVec, MulVec | Trans:
+			stats.MulVec++
+			a.MulVecTo(ctx.Dst, op&Trans != 0, ctx.Src)
+		case PreconSolve, PreconSolve | Trans:
+			stats.PreconSolve++
+			if s.Preconditioner == nil {
+				ctx.Dst.CopyVec(ctx.Src)
+				break
+			}
+			err = s.Preconditioner.SolveVecTo(ctx.Dst, op&Trans != 0, ctx.Src)
+		case ComputeResidual:
+			computeResidual(ctx.Dst, a, b, ctx.X, &stats)
+		case CheckResidualNorm:
+			res.ResidualHistory = append(res.ResidualHistory, ctx.ResidualNorm)
+			ctx.Converged = ctx.ResidualNorm < threshold
+		case MajorIteration:
+			stats.Iterations++
+			if ctx.Converged {
+				break
+			}
+			if stats.Iterations >= s.MaxIterations {
+				err = ErrIterationLimit
+				break
+			}
+			if s.Runtime > 0 && time.Since(start) > s.Runtime {
+				err = ErrRuntimeLimit
+			}
+		default:
+			panic("linsolve: invalid operation")
+		}
+		if err != nil || (op == MajorIteration && ctx.Converged) {
+			break
+		}
+	}
+
+	res.ResidualNorm = ctx.ResidualNorm
+	stats.Runtime = time.Since(start)
+	res.Stats = stats
+	return res, err
+}
+
+// computeResidual computes dst = b - A*x.
+func computeResidual(dst *mat.VecDense, a MulVecToer, b, x *mat.VecDense, stats *Stats) {
+	stats.MulVec++
+	a.MulVecTo(dst, false, x)
+	dst.SubVec(b, dst)
+}
+
+// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
+const dlamchE = 0x1p-53
@@ -0,0 +1,372 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/spectral"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/sparse"
+)
+
+// denseOp is a MulVecToer backed by a mat.Matrix.
+type denseOp struct {
+	mat.Matrix
+}
+
+func (d denseOp) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	if trans {
+		dst.MulVec(d.T(), x)
+	} else {
+		dst.MulVec(d.Matrix, x)
+	}
+}
+
+// poisson2D returns the matrix of the 5-point finite difference
+// discretization of the negative Laplacian on a k×k grid with Dirichlet
+// boundary conditions, shifted by -shift times the identity.
+func poisson2D(k int, shift float64) *sparse.CSR {
+	n := k * k
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			row := i*k + j
+			a.Append(row, row, 4-shift)
+			if i > 0 {
+				a.Append(row, row-k, -1)
+			}
+			if i < k-1 {
+				a.Append(row, row+k, -1)
+			}
+			if j > 0 {
+				a.Append(row, row-1, -1)
+			}
+			if j < k-1 {
+				a.Append(row, row+1, -1)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+// convectionDiffusion2D returns the matrix of the upwind finite difference
+// discretization of -Δu + c*(u_x + u_y) on a k×k grid with Dirichlet
+// boundary conditions.
+func convectionDiffusion2D(k int, c float64) *sparse.CSR {
+	n := k * k
+	h := 1 / float64(k+1)
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			row := i*k + j
+			a.Append(row, row, 4+2*c*h)
+			if i > 0 {
+				a.Append(row, row-k, -1-c*h)
+			}
+			if i < k-1 {
+				a.Append(row, row+k, -1)
+			}
+			if j > 0 {
+				a.Append(row, row-1, -1-c*h)
+			}
+			if j < k-1 {
+				a.Append(row, row+1, -1)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+// randSPD returns a random n×n symmetric positive definite matrix.
+func randSPD(n int, rnd *rand.Rand) *mat.SymDense {
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var s mat.SymDense
+	s.SymOuterK(1, a)
+	for i := 0; i < n; i++ {
+		s.SetSym(i, i, s.At(i, i)+float64(n))
+	}
+	return &s
+}
+
+func randVec(n int, rnd *rand.Rand) *mat.VecDense {
+	v := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		v.SetVec(i, rnd.NormFloat64())
+	}
+	return v
+}
+
+type testProblem struct {
+	name      string
+	a         MulVecToer
+	symmetric bool
+	spd       bool
+	// indefinite problems are not
+	// expected to be solved by
+	// restarted methods.
+	indefinite bool
+	csr        *sparse.CSR
+}
+
+func testProblems(rnd *rand.Rand) []testProblem {
+	var probs []testProblem
+	for _, k := range []int{1, 3, 10} {
+		p := poisson2D(k, 0)
+		probs = append(probs, testProblem{name: fmt.Sprintf("Poisson%d", k), a: p, symmetric: true, spd: true, csr: p})
+	}
+	for _, k := range []int{4, 10} {
+		p := poisson2D(k, 1.5)
+		probs = append(probs, testProblem{name: fmt.Sprintf("ShiftedPoisson%d", k), a: p, symmetric: true, indefinite: true, csr: p})
+	}
+	for _, k := range []int{3, 10} {
+		p := convectionDiffusion2D(k, 20)
+		probs = append(probs, testProblem{name: fmt.Sprintf("ConvectionDiffusion%d", k), a: p, csr: p})
+	}
+	for _, n := range []int{1, 2, 5, 20} {
+		probs = append(probs, testProblem{name: fmt.Sprintf("DenseSPD%d", n), a: denseOp{randSPD(n, rnd)}, symmetric: true, spd: true})
+	}
+	band := mat.NewBandDense(30, 30, 2, 1, nil)
+	for i := 0; i < 30; i++ {
+		for j := max(0, i-2); j < min(30, i+2); j++ {
+			v := rnd.Float64() - 0.5
+			if i == j {
+				v = 5
+			}
+			band.SetBand(i, j, v)
+		}
+	}
+	probs = append(probs, testProblem{name: "Band30", a: band})
+	return probs
+}
+
+func TestIterative(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, prob := range testProblems(rnd) {
+		for _, test := range []struct {
+			name      string
+			method    func() Method
+			symmetric bool
+			spd       bool
+			// definite methods are not expected
+			// to solve indefinite problems.
+			definite bool
+			precon   string
+		}{
+			{name: "CG", method: func() Method { return &CG{} }, symmetric: true, spd: true},
+			{name: "CG-Jacobi", method: func() Method { return &CG{} }, symmetric: true, spd: true, precon: "jacobi"},
+			{name: "CG-IC", method: func() Method { return &CG{} }, symmetric: true, spd: true, precon: "ic"},
+			{name: "MINRES", method: func() Method { return &MINRES{} }, symmetric: true},
+			{name: "MINRES-Jacobi", method: func() Method { return &MINRES{} }, symmetric: true, spd: true, precon: "jacobi"},
+			{name: "GMRES", method: func() Method { return &GMRES{} }},
+			{name: "GMRES(5)", method: func() Method { return &GMRES{Restart: 5} }, definite: true},
+			{name: "GMRES-ILU", method: func() Method { return &GMRES{} }, definite: true, precon: "ilu"},
+			{name: "GMRES(5)-Jacobi", method: func() Method { return &GMRES{Restart: 5} }, definite: true, precon: "jacobi"},
+			{name: "BiCGStab", method: func() Method { return &BiCGStab{} }},
+			{name: "BiCGStab-ILU", method: func() Method { return &BiCGStab{} }, precon: "ilu"},
+		} {
+			if test.symmetric && !prob.symmetric {
+				continue
+			}
+			if test.spd && !prob.spd {
+				continue
+			}
+			if test.definite && prob.indefinite {
+				continue
+			}
+			var precon Preconditioner
+			switch test.precon {
+			case "jacobi":
+				p, err := NewJacobi(matrixOf(prob))
+				if err != nil {
+					t.Fatalf("%s: unexpected error creating Jacobi preconditioner: %v", prob.name, err)
+				}
+				precon = p
+			case "ic":
+				if prob.csr == nil {
+					continue
+				}
+				var ic IncompleteCholesky
+				err := ic.Factorize(prob.csr)
+				if err != nil {
+					t.Fatalf("%s: unexpected error creating IC preconditioner: %v", prob.name, err)
+				}
+				precon = &ic
+			case "ilu":
+				if prob.csr == nil {
+					continue
+				}
+				var ilu IncompleteLU
+				err := ilu.Factorize(prob.csr)
+				if err != nil {
+					t.Fatalf("%s: unexpected error creating ILU preconditioner: %v", prob.name, err)
+				}
+				precon = &ilu
+			}
+
+			n, _ := matrixOf(prob).Dims()
+			want := randVec(n, rnd)
+			var b mat.VecDense
+			prob.a.MulVecTo(&b, false, want)
+
+			const tol = 1e-10
+			settings := &Settings{
+				Tolerance:      tol,
+				MaxIterations:  10 * n,
+				Preconditioner: precon,
+			}
+			res, err := Iterative(prob.a, &b, test.method(), settings)
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", prob.name, test.name, err)
+				continue
+			}
+			var r mat.VecDense
+			prob.a.MulVecTo(&r, false, res.X)
+			r.SubVec(&b, &r)
+			rNorm := mat.Norm(&r, 2)
+			bNorm := mat.Norm(&b, 2)
+			// Allow for the difference between the true residual and
+			// the estimate computed by the method.
+			if rNorm > 100*tol*bNorm {
+				t.Errorf("%s %s: residual too large: |r|/|b| = %v", prob.name, test.name, rNorm/bNorm)
+			}
+			if len(res.ResidualHistory) < 2 {
+				t.Errorf("%s %s: missing residual history", prob.name, test.name)
+			}
+			if res.ResidualHistory[0] != bNorm {
+				t.Errorf("%s %s: unexpected initial residual norm: got %v, want %v", prob.name, test.name, res.ResidualHistory[0], bNorm)
+			}
+			if res.Stats.MulVec == 0 || res.Stats.Iterations == 0 {
+				t.Errorf("%s %s: unexpected stats: %+v", prob.name, test.name, res.Stats)
+			}
+		}
+	}
+}
+
+func matrixOf(prob testProblem) mat.Matrix {
+	return prob.a.(mat.Matrix)
+}
+
+func TestIterativeSettings(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := poisson2D(8, 0)
+	n, _ := a.Dims()
+	want := randVec(n, rnd)
+	var b mat.VecDense
+	a.MulVecTo(&b, false, want)
+
+	// The exact solution as the initial guess.
+	res, err := Iterative(a, &b, &CG{}, &Settings{InitX: want})
+	if err != nil {
+		t.Errorf("unexpected error for exact initial guess: %v", err)
+	}
+	if res.Stats.Iterations != 0 {
+		t.Errorf("unexpected number of iterations for exact initial guess: %d", res.Stats.Iterations)
+	}
+
+	// Zero right-hand side.
+	res, err = Iterative(a, mat.NewVecDense(n, nil), &CG{}, &Settings{InitX: want})
+	if err != nil {
+		t.Errorf("unexpected error for zero right-hand side: %v", err)
+	}
+	if mat.Norm(res.X, 2) != 0 {
+		t.Errorf("unexpected non-zero solution for zero right-hand side")
+	}
+
+	// Iteration limit.
+	res, err = Iterative(a, &b, &CG{}, &Settings{MaxIterations: 3})
+	if err != ErrIterationLimit {
+		t.Errorf("unexpected error for iteration limit: got %v, want %v", err, ErrIterationLimit)
+	}
+	if res.Stats.Iterations != 3 {
+		t.Errorf("unexpected number of iterations: got %d, want 3", res.Stats.Iterations)
+	}
+
+	// Destination reuse.
+	var dst mat.VecDense
+	res, err = Iterative(a, &b, nil, &Settings{Dst: &dst})
+	if err != nil {
+		t.Errorf("unexpected error with default method: %v", err)
+	}
+	if res.X != &dst {
+		t.Errorf("result not stored in Dst")
+	}
+	if !mat.EqualApprox(&dst, want, 1e-6) {
+		t.Errorf("unexpected solution with default method")
+	}
+
+	// An exact preconditioner converges in a single iteration.
+	var lu mat.LU
+	lu.Factorize(a.ToDense())
+	res, err = Iterative(a, &b, &GMRES{}, &Settings{Preconditioner: &lu})
+	if err != nil {
+		t.Errorf("unexpected error with LU preconditioner: %v", err)
+	}
+	if res.Stats.PreconSolve > 3 {
+		t.Errorf("unexpected number of preconditioner solves with LU preconditioner: %d", res.Stats.PreconSolve)
+	}
+
+	for _, s := range []*Settings{
+		{Tolerance: -1},
+		{Tolerance: 1},
+		{MaxIterations: -1},
+		{InitX: mat.NewVecDense(n+1, nil)},
+	} {
+		if !panics(func() { Iterative(a, &b, &CG{}, s) }) {
+			t.Errorf("expected panic for settings %+v", s)
+		}
+	}
+}
+
+func TestIterativeLaplacian(t *testing.T) {
+	t.Parallel()
+	// Solve (L + I) x = b where L is the Laplacian of a cycle graph.
+	const n = 50
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < n; i++ {
+		g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node((i + 1) % n)})
+	}
+	l := spectral.NewLaplacian(g)
+	var a sparse.CSR
+	a.CloneFrom(l)
+	diag := make([]float64, n)
+	for i := range diag {
+		diag[i] = 1
+	}
+	a.Add(&a, mat.NewDiagDense(n, diag))
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	want := randVec(n, rnd)
+	var b mat.VecDense
+	a.MulVecTo(&b, false, want)
+	for _, m := range []Method{&CG{}, &MINRES{}, &GMRES{}, &BiCGStab{}} {
+		res, err := Iterative(&a, &b, m, &Settings{Tolerance: 1e-12})
+		if err != nil {
+			t.Errorf("%T: unexpected error: %v", m, err)
+			continue
+		}
+		if !mat.EqualApprox(res.X, want, 1e-9) {
+			t.Errorf("%T: unexpected solution", m)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
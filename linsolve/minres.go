@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// MINRES implements the Minimum Residual method with preconditioning for
+// solving systems of linear equations
+//
+//	A * x = b,
+//
+// where A is a symmetric, possibly indefinite, matrix. If a preconditioner
+// is provided through Settings, it must be symmetric positive definite.
+//
+// The residual norm estimate computed by MINRES is the norm of the residual
+// measured in the norm induced by the inverse of the preconditioner. When no
+// preconditioner is used, this is the Euclidean norm of the residual.
+//
+// References:
+//   - Paige, C. C., & Saunders, M. A. (1975). Solution of sparse indefinite
+//     systems of linear equations. SIAM Journal on Numerical Analysis, 12(4),
+//     617-629. https://doi.org/10.1137/0712047
+//   - Choi, S.-C. T. (2006). Iterative methods for singular linear equations
+//     and least-squares problems (Doctoral dissertation). Stanford University.
+type MINRES struct {
+	r1, r2    mat.VecDense
+	y, v      mat.VecDense
+	w, w1, w2 mat.VecDense
+
+	iter                 int
+	alpha, beta, betaOld float64
+	cs, sn               float64
+	dbar, eps            float64
+	phibar               float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for more details.
+func (m *MINRES) Init(x, residual *mat.VecDense) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("minres: vector length mismatch")
+	}
+
+	m.r1.CloneFromVec(residual)
+	m.r2.CloneFromVec(residual)
+	for _, v := range []*mat.VecDense{&m.y, &m.v, &m.w, &m.w1, &m.w2} {
+		v.Reset()
+		v.ReuseAsVec(dim)
+	}
+
+	m.iter = 0
+	m.cs = -1
+	m.sn = 0
+	m.dbar = 0
+	m.eps = 0
+	m.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface for more details.
+//
+// MINRES will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+//	NoOperation
+func (m *MINRES) Iterate(ctx *Context) (Operation, error) {
+	switch m.resume {
+	case 1:
+		// Solve M y = r_0.
+		ctx.Src.CopyVec(&m.r1)
+		m.resume = 2
+		return PreconSolve, nil
+	case 2:
+		m.y.CopyVec(ctx.Dst)
+		beta := mat.Dot(&m.r1, &m.y)
+		if beta <= 0 {
+			// The preconditioner is not positive definite.
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: beta}
+		}
+		m.beta = math.Sqrt(beta)
+		m.phibar = m.beta
+		fallthrough
+	case 3:
+		// Compute A v_k where v_k = y / β_k is the next Lanczos vector.
+		m.v.ScaleVec(1/m.beta, &m.y)
+		ctx.Src.CopyVec(&m.v)
+		m.resume = 4
+		return MulVec, nil
+	case 4:
+		m.iter++
+		m.y.CopyVec(ctx.Dst)
+		if m.iter >= 2 {
+			m.y.AddScaledVec(&m.y, -m.beta/m.betaOld, &m.r1)
+		}
+		m.alpha = mat.Dot(&m.v, &m.y)
+		m.y.AddScaledVec(&m.y, -m.alpha/m.beta, &m.r2)
+		m.r1.CopyVec(&m.r2)
+		m.r2.CopyVec(&m.y)
+		// Solve M y = r2.
+		ctx.Src.CopyVec(&m.r2)
+		m.resume = 5
+		return PreconSolve, nil
+	case 5:
+		m.y.CopyVec(ctx.Dst)
+		m.betaOld = m.beta
+		beta := mat.Dot(&m.r2, &m.y)
+		if beta < 0 {
+			// The preconditioner is not positive definite.
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: beta}
+		}
+		m.beta = math.Sqrt(beta)
+
+		// Apply the previous rotation.
+		epsOld := m.eps
+		delta := m.cs*m.dbar + m.sn*m.alpha
+		gbar := m.sn*m.dbar - m.cs*m.alpha
+		m.eps = m.sn * m.beta
+		m.dbar = -m.cs * m.beta
+
+		// Compute the next rotation.
+		gamma := math.Max(math.Hypot(gbar, m.beta), dlamchE)
+		m.cs = gbar / gamma
+		m.sn = m.beta / gamma
+		phi := m.cs * m.phibar
+		m.phibar *= m.sn
+
+		// Update the solution.
+		m.w1.CopyVec(&m.w2)
+		m.w2.CopyVec(&m.w)
+		m.w.AddScaledVec(&m.v, -epsOld, &m.w1)
+		m.w.AddScaledVec(&m.w, -delta, &m.w2)
+		m.w.ScaleVec(1/gamma, &m.w)
+		ctx.X.AddScaledVec(ctx.X, phi, &m.w)
+
+		ctx.ResidualNorm = m.phibar
+		m.resume = 6
+		return CheckResidualNorm, nil
+	case 6:
+		if ctx.Converged {
+			m.resume = 0
+			return MajorIteration, nil
+		}
+		if m.beta == 0 {
+			// The Krylov subspace is exhausted without convergence.
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: m.beta}
+		}
+		m.resume = 3
+		return MajorIteration, nil
+
+	default:
+		panic("minres: Init not called")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Preconditioner = (*Jacobi)(nil)
+	_ Preconditioner = (*IncompleteCholesky)(nil)
+	_ Preconditioner = (*IncompleteLU)(nil)
+	_ Preconditioner = (*mat.LU)(nil)
+)
+
+// ErrZeroPivot is returned when a zero or, for IncompleteCholesky,
+// non-positive pivot is encountered during the construction of a
+// preconditioner.
+var ErrZeroPivot = errors.New("linsolve: zero pivot")
+
+// Jacobi is the Jacobi (diagonal) preconditioner M = diag(A).
+type Jacobi struct {
+	d []float64
+}
+
+// NewJacobi returns a Jacobi preconditioner for the square matrix a.
+// NewJacobi returns ErrZeroPivot if any diagonal element of a is zero.
+func NewJacobi(a mat.Matrix) (*Jacobi, error) {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	d := make([]float64, r)
+	for i := range d {
+		d[i] = a.At(i, i)
+		if d[i] == 0 {
+			return nil, ErrZeroPivot
+		}
+	}
+	return &Jacobi{d: d}, nil
+}
+
+// SolveVecTo solves M*x = b and stores the result into dst. Since M is
+// diagonal, trans is ignored.
+func (j *Jacobi) SolveVecTo(dst *mat.VecDense, _ bool, b mat.Vector) error {
+	n := len(j.d)
+	if b.Len() != n {
+		panic(mat.ErrShape)
+	}
+	reuseVec(dst, n)
+	for i, d := range j.d {
+		dst.SetVec(i, b.AtVec(i)/d)
+	}
+	return nil
+}
+
+// reuseVec sizes dst to have length n if it is empty, or checks that it
+// has length n otherwise.
+func reuseVec(dst *mat.VecDense, n int) {
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+		return
+	}
+	if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+}
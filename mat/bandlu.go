@@ -0,0 +1,335 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badBandLU = "mat: invalid band LU factorization"
+
+var (
+	_ Matrix = (*BandLU)(nil)
+	_ Banded = (*BandLU)(nil)
+)
+
+// BandLU is a square n×n band matrix represented by its LU factorization with
+// partial pivoting.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements and U is upper triangular. If A has kl sub-diagonals and ku
+// super-diagonals, L has at most kl non-zero elements below the diagonal in
+// each column and U has kl+ku super-diagonals.
+//
+// Note that this matrix representation is useful for certain operations, in
+// particular for solving linear systems of equations. It is very inefficient at
+// other operations, in particular At is slow.
+//
+// BandLU methods may only be called on a receiver that has been initialized by
+// a call to Factorize.
+type BandLU struct {
+	// lu holds the factors L and U. lu.KU is the
+	// number of super-diagonals of U, kl+ku.
+	lu    blas64.Band
+	ku    int // The number of super-diagonals of A
+	swaps []int
+	cond  float64
+	ok    bool // Whether A is nonsingular
+}
+
+// Factorize computes the LU factorization of the square band matrix A and
+// stores the result in the receiver. The LU decomposition will complete
+// regardless of the singularity of a.
+func (lu *BandLU) Factorize(a Banded) {
+	m, n := a.Dims()
+	if m != n {
+		panic(ErrSquare)
+	}
+	kl, ku := a.Bandwidth()
+	stride := 2*kl + ku + 1
+	lu.lu = blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     kl + ku,
+		Stride: stride,
+		Data:   use(lu.lu.Data, n*stride),
+	}
+	lu.ku = ku
+	lu.swaps = useInt(lu.swaps, n)
+
+	// Copy the band of A into the leading kl+ku+1 columns of the storage.
+	data := lu.lu.Data
+	if rb, ok := a.(RawBander); ok {
+		src := rb.RawBand()
+		for i := 0; i < n; i++ {
+			lo := max(0, kl-i)
+			hi := min(kl+ku+1, n+kl-i)
+			copy(data[i*stride+lo:i*stride+hi], src.Data[i*src.Stride+lo:i*src.Stride+hi])
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+				data[i*stride+kl+j-i] = a.At(i, j)
+			}
+		}
+	}
+
+	orig := lu.lu
+	orig.KU = ku
+	anorm := lapack64.Langb(CondNorm, orig)
+
+	lu.ok = lapack64.Gbtrf(lu.lu, lu.swaps)
+	if !lu.ok {
+		lu.cond = math.Inf(1)
+		return
+	}
+	work := getFloat64s(3*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	lu.cond = 1 / lapack64.Gbcon(CondNorm, lu.lu, lu.swaps, anorm, work, iwork)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *BandLU) isValid() bool {
+	return lu.lu.Stride != 0
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *BandLU) Reset() {
+	lu.lu.Rows = 0
+	lu.lu.Cols = 0
+	lu.lu.KL = 0
+	lu.lu.KU = 0
+	lu.lu.Stride = 0
+	lu.lu.Data = lu.lu.Data[:0]
+	lu.ku = 0
+	lu.swaps = lu.swaps[:0]
+}
+
+// IsEmpty returns whether the receiver is empty. Empty factorizations can be
+// the receiver for dimensionally restricted operations. The receiver can be
+// emptied using Reset.
+func (lu *BandLU) IsEmpty() bool {
+	return lu.lu.Stride == 0
+}
+
+// Dims returns the dimensions of the matrix A.
+func (lu *BandLU) Dims() (r, c int) {
+	return lu.lu.Rows, lu.lu.Cols
+}
+
+// Bandwidth returns the lower and upper bandwidth values of the matrix A.
+func (lu *BandLU) Bandwidth() (kl, ku int) {
+	return lu.lu.KL, lu.ku
+}
+
+// At returns the element of A at row i, column j.
+func (lu *BandLU) At(i, j int) float64 {
+	n, _ := lu.Dims()
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	kl, ku := lu.Bandwidth()
+	if i-j > kl || j-i > ku {
+		return 0
+	}
+
+	// Form column j of A by applying the factors of
+	//  L = P_0 * L_0 * ... * P_{n-1} * L_{n-1}
+	// to column j of U in reverse order.
+	x := getFloat64s(n, true)
+	defer putFloat64s(x)
+	ldab := lu.lu.Stride
+	data := lu.lu.Data
+	for k := max(0, j-lu.lu.KU); k <= j; k++ {
+		x[k] = data[k*ldab+kl+j-k]
+	}
+	for k := min(n-1, j); k >= 0; k-- {
+		for r := k + 1; r <= min(n-1, k+kl); r++ {
+			x[r] += data[r*ldab+kl+k-r] * x[k]
+		}
+		if p := lu.swaps[k]; p != k {
+			x[k], x[p] = x[p], x[k]
+		}
+	}
+	return x[i]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (lu *BandLU) T() Matrix {
+	return Transpose{lu}
+}
+
+// TBand performs an implicit transpose by returning the receiver inside a
+// TransposeBand.
+func (lu *BandLU) TBand() Banded {
+	return TransposeBand{lu}
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Cond() float64 {
+	if !lu.isValid() {
+		panic(badBandLU)
+	}
+	return lu.cond
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Det() float64 {
+	if !lu.isValid() {
+		panic(badBandLU)
+	}
+	if !lu.ok {
+		return 0
+	}
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *BandLU) LogDet() (det float64, sign float64) {
+	if !lu.isValid() {
+		panic(badBandLU)
+	}
+
+	n := lu.lu.Rows
+	logDiag := getFloat64s(n, false)
+	defer putFloat64s(logDiag)
+	sign = 1.0
+	for i := 0; i < n; i++ {
+		v := lu.lu.Data[i*lu.lu.Stride+lu.lu.KL]
+		if v < 0 {
+			sign *= -1
+		}
+		if lu.swaps[i] != i {
+			sign *= -1
+		}
+		logDiag[i] = math.Log(math.Abs(v))
+	}
+	return floats.Sum(logDiag), sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix X
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (lu *BandLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.isValid() {
+		panic(badBandLU)
+	}
+
+	n := lu.lu.Rows
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu.lu, lu.swaps, dst.mat)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution vector x
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveVecTo will panic if the
+// receiver does not contain a factorization.
+func (lu *BandLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.isValid() {
+		panic(badBandLU)
+	}
+
+	n := lu.lu.Rows
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return lu.SolveTo(dst.asDense(), trans, b)
+	case RawVectorer:
+		if dst != b {
+			dst.checkOverlap(rv.RawVector())
+		}
+
+		if !lu.ok {
+			return Condition(math.Inf(1))
+		}
+
+		dst.reuseAsNonZeroed(n)
+		var restore func()
+		if dst == b {
+			dst, restore = dst.isolatedWorkspace(b)
+			defer restore()
+		}
+		dst.CopyVec(b)
+		t := blas.NoTrans
+		if trans {
+			t = blas.Trans
+		}
+		lapack64.Gbtrs(t, lu.lu, lu.swaps, dst.asGeneral())
+		if lu.cond > ConditionTolerance {
+			return Condition(lu.cond)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// randBandDense returns an n×n band matrix with kl sub-diagonals and ku
+// super-diagonals whose elements within the band are standard normal.
+func randBandDense(n, kl, ku int, rnd *rand.Rand) *BandDense {
+	a := NewBandDense(n, n, kl, ku, nil)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			a.SetBand(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+var bandLUTests = []struct {
+	n, kl, ku int
+}{
+	{1, 0, 0},
+	{3, 1, 1},
+	{5, 0, 2},
+	{5, 2, 0},
+	{6, 4, 4},
+	{10, 2, 2},
+	{10, 3, 3},
+	{20, 1, 5},
+	{20, 5, 1},
+	{150, 40, 70},
+}
+
+func TestBandLU(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range bandLUTests {
+		n, kl, ku := test.n, test.kl, test.ku
+		a := randBandDense(n, kl, ku, rnd)
+		for _, typ := range []Banded{a, asBasicBanded(a)} {
+			name := fmt.Sprintf("n=%d,kl=%d,ku=%d,type=%T", n, kl, ku, typ)
+
+			var lu BandLU
+			lu.Factorize(typ)
+			if r, c := lu.Dims(); r != n || c != n {
+				t.Errorf("%s: unexpected dimensions: got %d×%d", name, r, c)
+			}
+			if gotKL, gotKU := lu.Bandwidth(); gotKL != kl || gotKU != ku {
+				t.Errorf("%s: unexpected bandwidth: got (%d,%d)", name, gotKL, gotKU)
+			}
+			if !EqualApprox(&lu, a, tol) {
+				t.Errorf("%s: A and BandLU are not equal", name)
+			}
+
+			var dlu LU
+			dlu.Factorize(a)
+			got, want := lu.Det(), dlu.Det()
+			if !scalar.EqualWithinRel(got, want, tol) {
+				t.Errorf("%s: unexpected determinant: got %v, want %v", name, got, want)
+			}
+			// Both condition numbers are estimates, so only check that they
+			// are of the same order.
+			if cond, dcond := lu.Cond(), Cond(a, 1); cond > 10*dcond || dcond > 10*cond {
+				t.Errorf("%s: unexpected condition number: got %v, want %v", name, cond, dcond)
+			}
+		}
+	}
+}
+
+func TestBandLUSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []bool{false, true} {
+		for _, test := range bandLUTests {
+			n, kl, ku := test.n, test.kl, test.ku
+			for _, bc := range []int{1, 4} {
+				name := fmt.Sprintf("trans=%t,n=%d,kl=%d,ku=%d,bc=%d", trans, n, kl, ku, bc)
+				a := randBandDense(n, kl, ku, rnd)
+				b := randNormDense(n, bc, rnd)
+
+				var lu BandLU
+				lu.Factorize(a)
+
+				var want Dense
+				var err error
+				if trans {
+					err = want.Solve(a.T(), b)
+				} else {
+					err = want.Solve(a, b)
+				}
+				if err != nil {
+					t.Fatalf("%s: unexpected error from dense solve: %v", name, err)
+				}
+
+				var got Dense
+				if err := lu.SolveTo(&got, trans, b); err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if !EqualApprox(&got, &want, tol) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+
+				// Check that solving in place gives the same result.
+				got.Copy(b)
+				if err := lu.SolveTo(&got, trans, &got); err != nil {
+					t.Errorf("%s: unexpected error when dst == b: %v", name, err)
+					continue
+				}
+				if !EqualApprox(&got, &want, tol) {
+					t.Errorf("%s: unexpected solution when dst == b", name)
+				}
+
+				if bc == 1 {
+					var x VecDense
+					if err := lu.SolveVecTo(&x, trans, b.ColView(0)); err != nil {
+						t.Errorf("%s: unexpected error: %v", name, err)
+						continue
+					}
+					if !EqualApprox(&x, want.ColView(0), tol) {
+						t.Errorf("%s: unexpected vector solution", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBandLUSingular(t *testing.T) {
+	t.Parallel()
+	// The third row is zero.
+	a := NewBandDense(4, 4, 1, 1, []float64{
+		0, 2, 1,
+		1, 3, 1,
+		0, 0, 0,
+		1, 4, 0,
+	})
+	var lu BandLU
+	lu.Factorize(a)
+	if det := lu.Det(); det != 0 {
+		t.Errorf("unexpected determinant of singular matrix: got %v, want 0", det)
+	}
+	if cond := lu.Cond(); !math.IsInf(cond, 1) {
+		t.Errorf("unexpected condition number of singular matrix: got %v, want +Inf", cond)
+	}
+	var x Dense
+	err := lu.SolveTo(&x, false, NewDense(4, 1, []float64{1, 2, 3, 4}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular matrix: got %v, want Condition error", err)
+	}
+
+	panicked, message := panics(func() {
+		var lu BandLU
+		lu.Cond()
+	})
+	if !panicked || message != badBandLU {
+		t.Errorf("expected panic for empty factorization, got %q", message)
+	}
+}
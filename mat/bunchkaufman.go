@@ -0,0 +1,348 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badBunchKaufman = "mat: invalid Bunch-Kaufman factorization"
+
+// BunchKaufman is a symmetric, possibly indefinite, matrix represented by its
+// Bunch-Kaufman factorization with diagonal pivoting.
+//
+// The factorization has the form
+//
+//	A = U * D * Uᵀ
+//
+// where U is a product of permutation and unit upper triangular matrices, and
+// D is symmetric and block diagonal with 1×1 and 2×2 diagonal blocks.
+//
+// Unlike the Cholesky factorization, the Bunch-Kaufman factorization exists
+// for every symmetric matrix. By Sylvester's law of inertia, A and D have the
+// same number of positive, negative and zero eigenvalues, so the inertia of A
+// is obtained cheaply from D.
+type BunchKaufman struct {
+	// The factors are stored in the upper triangle of bk in the format
+	// returned by lapack64.Sytrf.
+	bk   *SymDense
+	ipiv []int
+	cond float64
+	ok   bool // Whether D is nonsingular
+}
+
+// Factorize computes the Bunch-Kaufman factorization of the symmetric matrix A
+// and stores the result in the receiver. The factorization will complete
+// regardless of the singularity of a. Factorize returns whether the block
+// diagonal factor D, and hence A, is nonsingular.
+func (bk *BunchKaufman) Factorize(a Symmetric) (ok bool) {
+	n := a.SymmetricDim()
+	if bk.bk == nil {
+		bk.bk = NewSymDense(n, nil)
+	} else {
+		bk.bk.Reset()
+		bk.bk.reuseAsNonZeroed(n)
+	}
+	bk.bk.CopySym(a)
+	bk.ipiv = useInt(bk.ipiv, n)
+
+	work := getFloat64s(max(1, n), false)
+	anorm := lapack64.Lansy(CondNorm, bk.bk.mat, work)
+	putFloat64s(work)
+
+	work = getFloat64s(1, false)
+	lapack64.Sytrf(bk.bk.mat, bk.ipiv, work, -1)
+	lwork := int(work[0])
+	putFloat64s(work)
+	work = getFloat64s(lwork, false)
+	bk.ok = lapack64.Sytrf(bk.bk.mat, bk.ipiv, work, lwork)
+	putFloat64s(work)
+
+	bk.updateCond(anorm)
+	return bk.ok
+}
+
+// updateCond updates the stored condition number of the matrix. anorm is the
+// norm of the original matrix.
+func (bk *BunchKaufman) updateCond(anorm float64) {
+	if !bk.ok {
+		bk.cond = math.Inf(1)
+		return
+	}
+	n := bk.bk.mat.N
+	work := getFloat64s(2*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Sycon(bk.bk.mat, bk.ipiv, anorm, work, iwork)
+	bk.cond = 1 / v
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (bk *BunchKaufman) isValid() bool {
+	return bk.bk != nil && !bk.bk.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (bk *BunchKaufman) Reset() {
+	if bk.bk != nil {
+		bk.bk.Reset()
+	}
+	bk.ipiv = bk.ipiv[:0]
+	bk.cond = math.Inf(1)
+	bk.ok = false
+}
+
+// IsEmpty returns whether the receiver is empty. Empty factorizations can be
+// the receiver for size-restricted operations. The receiver can be emptied
+// using Reset.
+func (bk *BunchKaufman) IsEmpty() bool {
+	return !bk.isValid()
+}
+
+// SymmetricDim returns the number of rows and columns of the factorized matrix.
+func (bk *BunchKaufman) SymmetricDim() int {
+	if bk.bk == nil {
+		return 0
+	}
+	return bk.bk.mat.N
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Cond() float64 {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	return bk.cond
+}
+
+// Inertia returns the inertia of the factorized matrix, that is the number of
+// its positive, negative and zero eigenvalues. The counts are determined from
+// the block diagonal factor D, so zero counts only eigenvalues of D that are
+// exactly zero. Matrices that are numerically singular may report no zero
+// eigenvalues, and Cond should be used to detect them.
+// Inertia will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Inertia() (pos, neg, zero int) {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.bk.mat.N
+	for k := 0; k < n; {
+		if bk.ipiv[k] >= 0 {
+			switch d := bk.bk.at(k, k); {
+			case d > 0:
+				pos++
+			case d < 0:
+				neg++
+			default:
+				zero++
+			}
+			k++
+			continue
+		}
+		// The eigenvalues of the 2×2 block have opposite signs if the
+		// determinant is negative, and the sign of the trace otherwise.
+		a, b, c := bk.bk.at(k, k), bk.bk.at(k, k+1), bk.bk.at(k+1, k+1)
+		t := math.Abs(b)
+		det := t * t * ((a/t)*(c/t) - 1)
+		switch tr := a + c; {
+		case det < 0:
+			pos++
+			neg++
+		case det > 0 && tr > 0:
+			pos += 2
+		case det > 0 && tr < 0:
+			neg += 2
+		case tr > 0:
+			pos++
+			zero++
+		case tr < 0:
+			neg++
+			zero++
+		default:
+			zero += 2
+		}
+		k += 2
+	}
+	return pos, neg, zero
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Det() float64 {
+	det, sign := bk.LogDet()
+	if sign == 0 {
+		return 0
+	}
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) LogDet() (det float64, sign float64) {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	if !bk.ok {
+		return math.Inf(-1), 0
+	}
+	// The permutations and unit triangular factors have determinant ±1
+	// and cancel in U*D*Uᵀ, so det(A) = det(D).
+	n := bk.bk.mat.N
+	sign = 1
+	for k := 0; k < n; {
+		if bk.ipiv[k] >= 0 {
+			d := bk.bk.at(k, k)
+			if d < 0 {
+				sign *= -1
+			}
+			det += math.Log(math.Abs(d))
+			k++
+			continue
+		}
+		// Compute the determinant of the 2×2 block scaled by the
+		// off-diagonal element to avoid overflow, as in Dsytrs.
+		a, b, c := bk.bk.at(k, k), bk.bk.at(k, k+1), bk.bk.at(k+1, k+1)
+		t := math.Abs(b)
+		d := (a/t)*(c/t) - 1
+		if d < 0 {
+			sign *= -1
+		}
+		det += 2*math.Log(t) + math.Log(math.Abs(d))
+		k += 2
+	}
+	return det, sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B
+//
+// using the Bunch-Kaufman factorization of A stored in the receiver. The
+// result is stored in-place into dst. If A is singular or near-singular a
+// Condition error is returned. See the documentation for Condition for more
+// information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) SolveTo(dst *Dense, b Matrix) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+
+	n := bk.bk.mat.N
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !bk.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	lapack64.Sytrs(bk.bk.mat, bk.ipiv, dst.mat)
+	if bk.cond > ConditionTolerance {
+		return Condition(bk.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b
+//
+// using the Bunch-Kaufman factorization of A stored in the receiver. The
+// result is stored in-place into dst. If A is singular or near-singular a
+// Condition error is returned. See the documentation for Condition for more
+// information.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) SolveVecTo(dst *VecDense, b Vector) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+
+	n := bk.bk.mat.N
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return bk.SolveTo(dst.asDense(), b)
+	case RawVectorer:
+		if dst != b {
+			dst.checkOverlap(rv.RawVector())
+		}
+
+		if !bk.ok {
+			return Condition(math.Inf(1))
+		}
+
+		dst.reuseAsNonZeroed(n)
+		if dst != b {
+			dst.CopyVec(b)
+		}
+		vMat := blas64.General{
+			Rows:   n,
+			Cols:   1,
+			Stride: dst.mat.Inc,
+			Data:   dst.mat.Data,
+		}
+		lapack64.Sytrs(bk.bk.mat, bk.ipiv, vMat)
+		if bk.cond > ConditionTolerance {
+			return Condition(bk.cond)
+		}
+		return nil
+	}
+}
+
+// InverseTo computes the inverse of the factorized matrix and stores the
+// result into dst. If A is singular or near-singular a Condition error is
+// returned. See the documentation for Condition for more information.
+//
+// If dst is empty, it is resized to be an n×n symmetric matrix, where n is
+// the order of the factorized matrix. If dst is not empty, it must be of size
+// n×n, otherwise InverseTo will panic.
+// InverseTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) InverseTo(dst *SymDense) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.bk.mat.N
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+	if !bk.ok {
+		return Condition(math.Inf(1))
+	}
+	dst.CopySym(bk.bk)
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	lapack64.Sytri(dst.mat, bk.ipiv, work)
+	if bk.cond > ConditionTolerance {
+		return Condition(bk.cond)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// randSymIndefinite returns a random n×n symmetric matrix with npos positive,
+// nneg negative and n-npos-nneg zero eigenvalues.
+func randSymIndefinite(n, npos, nneg int, rnd *rand.Rand) *SymDense {
+	q := NewDense(n, n, nil)
+	q.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, q)
+	var qr QR
+	qr.Factorize(q)
+	qr.QTo(q)
+
+	d := make([]float64, n)
+	for i := range d {
+		switch {
+		case i < npos:
+			d[i] = 1 + rnd.Float64()
+		case i < npos+nneg:
+			d[i] = -1 - rnd.Float64()
+		}
+	}
+	rnd.Shuffle(n, func(i, j int) { d[i], d[j] = d[j], d[i] })
+
+	var qd Dense
+	qd.Mul(q, NewDiagDense(n, d))
+	var a Dense
+	a.Mul(&qd, q.T())
+	s := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, (a.At(i, j)+a.At(j, i))/2)
+		}
+	}
+	return s
+}
+
+func TestBunchKaufman(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 31, 70, 150} {
+		for _, npos := range []int{0, n / 3, n} {
+			nneg := n - npos
+			a := randSymIndefinite(n, npos, nneg, rnd)
+
+			var bk BunchKaufman
+			ok := bk.Factorize(a)
+			if !ok {
+				t.Errorf("n=%d,npos=%d: unexpected singular factorization", n, npos)
+				continue
+			}
+
+			p, m, z := bk.Inertia()
+			if p != npos || m != nneg || z != 0 {
+				t.Errorf("n=%d,npos=%d: unexpected inertia: got (%d,%d,%d), want (%d,%d,0)",
+					n, npos, p, m, z, npos, nneg)
+			}
+
+			var lu LU
+			lu.Factorize(a)
+			wantDet, wantSign := lu.LogDet()
+			det, sign := bk.LogDet()
+			if sign != wantSign || !scalar.EqualWithinAbsOrRel(det, wantDet, tol, tol) {
+				t.Errorf("n=%d,npos=%d: unexpected LogDet: got (%v,%v), want (%v,%v)",
+					n, npos, det, sign, wantDet, wantSign)
+			}
+			if !scalar.EqualWithinRel(bk.Det(), lu.Det(), 1e-10) {
+				t.Errorf("n=%d,npos=%d: unexpected Det: got %v, want %v", n, npos, bk.Det(), lu.Det())
+			}
+
+			// The condition number is an estimate so only check that it
+			// is in the right ballpark.
+			if c, want := bk.Cond(), lu.Cond(); c < want/10 || c > want*10 {
+				t.Errorf("n=%d,npos=%d: unexpected Cond: got %v, want about %v", n, npos, c, want)
+			}
+
+			var inv SymDense
+			err := bk.InverseTo(&inv)
+			if err != nil {
+				t.Errorf("n=%d,npos=%d: unexpected error from InverseTo: %v", n, npos, err)
+			}
+			var ainv Dense
+			ainv.Mul(a, &inv)
+			if !EqualApprox(&ainv, eye(n), 1e-10) {
+				t.Errorf("n=%d,npos=%d: A*inv(A) is not the identity", n, npos)
+			}
+		}
+	}
+}
+
+func TestBunchKaufmanSingular(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 70} {
+		// Construct a random symmetric matrix with a zero row and
+		// column so that it is exactly singular.
+		a := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+		k := n / 2
+		for i := 0; i < n; i++ {
+			a.SetSym(i, k, 0)
+		}
+
+		var bk BunchKaufman
+		if bk.Factorize(a) {
+			t.Errorf("n=%d: singular matrix not detected", n)
+		}
+		if _, _, z := bk.Inertia(); z == 0 {
+			t.Errorf("n=%d: expected a zero eigenvalue in inertia", n)
+		}
+		if bk.Det() != 0 {
+			t.Errorf("n=%d: unexpected nonzero determinant: %v", n, bk.Det())
+		}
+		if !math.IsInf(bk.Cond(), 1) {
+			t.Errorf("n=%d: unexpected finite condition number: %v", n, bk.Cond())
+		}
+		var x Dense
+		err := bk.SolveTo(&x, NewDense(n, 1, nil))
+		if _, ok := err.(Condition); !ok {
+			t.Errorf("n=%d: expected Condition error from SolveTo, got %v", n, err)
+		}
+	}
+}
+
+func TestBunchKaufmanSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 70} {
+		for _, bc := range []int{1, 3, 10} {
+			a := randSymIndefinite(n, n/2, n-n/2, rnd)
+			var bk BunchKaufman
+			bk.Factorize(a)
+
+			b := NewDense(n, bc, nil)
+			b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+
+			var x Dense
+			err := bk.SolveTo(&x, b)
+			if err != nil {
+				t.Errorf("n=%d,bc=%d: unexpected error: %v", n, bc, err)
+			}
+			var got Dense
+			got.Mul(a, &x)
+			if !EqualApprox(&got, b, tol) {
+				t.Errorf("n=%d,bc=%d: A*X != B", n, bc)
+			}
+
+			// Solve in-place.
+			bCopy := DenseCopyOf(b)
+			err = bk.SolveTo(bCopy, bCopy)
+			if err != nil {
+				t.Errorf("n=%d,bc=%d: unexpected error for in-place solve: %v", n, bc, err)
+			}
+			if !Equal(bCopy, &x) {
+				t.Errorf("n=%d,bc=%d: in-place solve does not match", n, bc)
+			}
+		}
+	}
+}
+
+func TestBunchKaufmanSolveVecTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 70} {
+		a := randSymIndefinite(n, n/2, n-n/2, rnd)
+		var bk BunchKaufman
+		bk.Factorize(a)
+
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		var x VecDense
+		err := bk.SolveVecTo(&x, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		var got VecDense
+		got.MulVec(a, &x)
+		if !EqualApprox(&got, b, tol) {
+			t.Errorf("n=%d: A*x != b", n)
+		}
+	}
+}
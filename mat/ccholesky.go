@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCCholesky = "mat: invalid CCholesky factorization"
+
+// CCholesky is a Hermitian positive definite complex matrix represented by
+// its Cholesky decomposition.
+//
+// The decomposition can be constructed using the Factorize method. The
+// factorization itself can be extracted using the UTo or LTo methods, and the
+// original matrix can be recovered with ToCDense.
+//
+// Note that this matrix representation is useful for certain operations, in
+// particular finding solutions to linear equations. It is very inefficient
+// at other operations, in particular At is slow.
+type CCholesky struct {
+	// chol holds the upper triangular factor U in its
+	// upper triangle. The strict lower triangle is zero.
+	chol *CDense
+	cond float64
+}
+
+var _ CMatrix = (*CCholesky)(nil)
+
+// Dims returns the dimensions of the matrix.
+func (ch *CCholesky) Dims() (r, c int) {
+	if ch.chol == nil {
+		return 0, 0
+	}
+	return ch.chol.Dims()
+}
+
+// At returns the element at row i, column j.
+func (c *CCholesky) At(i, j int) complex128 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.Dims()
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	var val complex128
+	for k := 0; k <= min(i, j); k++ {
+		val += cmplx.Conj(c.chol.at(k, i)) * c.chol.at(k, j)
+	}
+	return val
+}
+
+// H returns the receiver, the conjugate transpose of a Hermitian matrix.
+func (c *CCholesky) H() CMatrix {
+	return c
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// CTranspose.
+func (c *CCholesky) T() CMatrix {
+	return CTranspose{c}
+}
+
+// Cond returns the condition number of the factorized matrix.
+func (c *CCholesky) Cond() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	return c.cond
+}
+
+// Factorize calculates the Cholesky decomposition of the Hermitian matrix A
+// and returns whether the matrix is positive definite. Only the upper
+// triangle of a is used and the imaginary parts of its diagonal elements are
+// ignored. If Factorize returns false, the factorization must not be used.
+//
+// Factorize will panic if a is not square.
+func (c *CCholesky) Factorize(a CMatrix) (ok bool) {
+	m, n := a.Dims()
+	if m != n {
+		panic(ErrSquare)
+	}
+	if c.chol == nil {
+		c.chol = NewCDense(n, n, nil)
+	} else {
+		c.chol.Reset()
+		c.chol.reuseAsNonZeroed(n, n)
+	}
+	// Copy the upper triangle of A and compute its
+	// infinity norm assuming that A is Hermitian.
+	rowSum := make([]float64, n)
+	for i := 0; i < n; i++ {
+		zeroC(c.chol.mat.Data[i*c.chol.mat.Stride : i*c.chol.mat.Stride+i])
+		v := complex(real(a.At(i, i)), 0)
+		c.chol.set(i, i, v)
+		rowSum[i] += cmplx.Abs(v)
+		for j := i + 1; j < n; j++ {
+			v := a.At(i, j)
+			c.chol.set(i, j, v)
+			rowSum[i] += cmplx.Abs(v)
+			rowSum[j] += cmplx.Abs(v)
+		}
+	}
+	var anorm float64
+	for _, v := range rowSum {
+		anorm = math.Max(anorm, v)
+	}
+	herm := c.hermitian()
+	_, ok = lapack128.Potrf(herm)
+	if !ok {
+		c.Reset()
+		return false
+	}
+	// The row sums are no longer needed, so reuse them as workspace.
+	work := make([]complex128, 2*n)
+	v := lapack128.Pocon(herm, anorm, work, rowSum)
+	c.cond = 1 / v
+	return true
+}
+
+// hermitian returns the factorization held in the upper triangle of the
+// receiver as a Hermitian matrix for use with lapack128.
+func (c *CCholesky) hermitian() cblas128.Hermitian {
+	return cblas128.Hermitian{
+		N:      c.chol.mat.Rows,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+	}
+}
+
+// triangularU returns the upper triangular factor U held in the receiver.
+func (c *CCholesky) triangularU() cblas128.Triangular {
+	return cblas128.Triangular{
+		N:      c.chol.mat.Rows,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *CCholesky) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+	c.cond = math.Inf(1)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for dimensionally restricted operations. The receiver can be emptied
+// using Reset.
+func (c *CCholesky) IsEmpty() bool {
+	return c.chol == nil || c.chol.IsEmpty()
+}
+
+// valid returns whether the receiver contains a factorization.
+func (c *CCholesky) valid() bool {
+	return c.chol != nil && !c.chol.IsEmpty()
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (c *CCholesky) Det() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been factorized.
+func (c *CCholesky) LogDet() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	var det float64
+	n, _ := c.chol.Dims()
+	for i := 0; i < n; i++ {
+		det += 2 * math.Log(real(c.chol.at(i, i)))
+	}
+	return det
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the Cholesky decomposition is singular or near-singular a Condition error
+// is returned. See the documentation for Condition for more information.
+func (c *CCholesky) SolveTo(dst *CDense, b CMatrix) error {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+
+	dst.reuseAsNonZeroed(bm, bn)
+	bU, _, _ := untransposeCmplx(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawCMatrixer); ok {
+		dst.checkOverlap(rm.RawCMatrix())
+	}
+	dst.Copy(b)
+	lapack128.Potrs(c.triangularU(), dst.mat)
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// UTo stores into dst the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//
+//	A = Uᴴ * U.
+//
+// If dst is empty, it is resized to be an n×n matrix. When dst is
+// non-empty, UTo panics if dst is not n×n.
+func (c *CCholesky) UTo(dst *CDense) {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		n2, c2 := dst.Dims()
+		if n != n2 || n != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(c.chol)
+}
+
+// LTo stores into dst the n×n lower triangular matrix L from a Cholesky
+// decomposition
+//
+//	A = L * Lᴴ.
+//
+// If dst is empty, it is resized to be an n×n matrix. When dst is
+// non-empty, LTo panics if dst is not n×n.
+func (c *CCholesky) LTo(dst *CDense) {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		n2, c2 := dst.Dims()
+		if n != n2 || n != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(c.chol.H())
+}
+
+// ToCDense reconstructs the original Hermitian positive definite matrix from
+// its Cholesky decomposition, storing the result into dst. If dst is
+// empty it is resized to be n×n. If dst is non-empty it must be n×n.
+// ToCDense will panic if the receiver does not contain a successful
+// factorization.
+func (c *CCholesky) ToCDense(dst *CDense) {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n, _ := c.chol.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		n2, c2 := dst.Dims()
+		if n != n2 || n != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Mul(c.chol.H(), c.chol)
+}
@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestCCholesky(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25} {
+		a := randHermitianPD(n, rnd)
+		var chol CCholesky
+		ok := chol.Factorize(a)
+		if !ok {
+			t.Errorf("n=%d: unexpected Factorize failure", n)
+			continue
+		}
+
+		var u, l CDense
+		chol.UTo(&u)
+		chol.LTo(&l)
+		for i := 0; i < n; i++ {
+			if imag(u.at(i, i)) != 0 || real(u.at(i, i)) <= 0 {
+				t.Errorf("n=%d: diagonal of U not real and positive", n)
+			}
+			for j := 0; j < i; j++ {
+				if u.at(i, j) != 0 {
+					t.Errorf("n=%d: U not upper triangular", n)
+				}
+			}
+		}
+		var got CDense
+		got.Mul(u.H(), &u)
+		if !CEqualApprox(&got, a, tol*float64(n)) {
+			t.Errorf("n=%d: Uᴴ*U != A", n)
+		}
+		got.Mul(&l, l.H())
+		if !CEqualApprox(&got, a, tol*float64(n)) {
+			t.Errorf("n=%d: L*Lᴴ != A", n)
+		}
+		var rec CDense
+		chol.ToCDense(&rec)
+		if !CEqualApprox(&rec, a, tol*float64(n)) {
+			t.Errorf("n=%d: unexpected result from ToCDense", n)
+		}
+		if !CEqualApprox(&chol, a, tol*float64(n)) {
+			t.Errorf("n=%d: unexpected result from At", n)
+		}
+
+		var lu CLU
+		lu.Factorize(a)
+		want, _ := lu.LogDet()
+		if got := chol.LogDet(); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("n=%d: unexpected LogDet: got %v, want %v", n, got, want)
+		}
+
+		b := randCDense(n, 3, rnd)
+		var x CDense
+		err := chol.SolveTo(&x, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		var ax CDense
+		ax.Mul(a, &x)
+		if !CEqualApprox(&ax, b, tol*float64(n)) {
+			t.Errorf("n=%d: unexpected solution", n)
+		}
+
+		var inv CDense
+		inv.Inverse(a)
+		cond := CNorm(a, math.Inf(1)) * CNorm(&inv, math.Inf(1))
+		if c := chol.Cond(); c > cond*(1+1e-10) || c < cond/10 {
+			t.Errorf("n=%d: unexpected condition number: got %v, want %v", n, c, cond)
+		}
+	}
+
+	// Indefinite matrix.
+	a := NewCDense(2, 2, []complex128{
+		1, 2i,
+		-2i, 1,
+	})
+	var chol CCholesky
+	if chol.Factorize(a) {
+		t.Error("unexpected success factorizing indefinite matrix")
+	}
+	if !chol.IsEmpty() {
+		t.Error("receiver not empty after failed factorization")
+	}
+	if !math.IsInf(chol.cond, 1) {
+		t.Error("unexpected condition number after failed factorization")
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Add(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x + y })
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Sub(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x - y })
+}
+
+// MulElem performs element-wise multiplication of a and b, placing the result
+// in the receiver. MulElem will panic if the two matrices do not have the same
+// shape.
+func (m *CDense) MulElem(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x * y })
+}
+
+// DivElem performs element-wise division of a by b, placing the result
+// in the receiver. DivElem will panic if the two matrices do not have the same
+// shape.
+func (m *CDense) DivElem(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x / y })
+}
+
+// elementwise places fn(a_ij, b_ij) into the receiver for all elements of a
+// and b.
+func (m *CDense) elementwise(a, b CMatrix, fn func(x, y complex128) complex128) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	bU, bTrans, bConj := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*CDense); ok {
+		if brm, ok := b.(*CDense); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = fn(v, bmat.Data[i+jb])
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans != aConj && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans != bConj && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, fn(a.At(r, c), b.At(r, c)))
+		}
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+func (m *CDense) Scale(f complex128, a CMatrix) {
+	ar, ac := a.Dims()
+
+	m.reuseAsNonZeroed(ar, ac)
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	if rm, ok := aU.(*CDense); ok && !aTrans && !aConj {
+		amat := rm.mat
+		if m != aU {
+			m.checkOverlap(amat)
+		}
+		for ja, jm := 0, 0; ja < ar*amat.Stride; ja, jm = ja+amat.Stride, jm+m.mat.Stride {
+			for i, v := range amat.Data[ja : ja+ac] {
+				m.mat.Data[i+jm] = v * f
+			}
+		}
+		return
+	}
+
+	m.checkOverlapMatrix(aU)
+	if aTrans != aConj && m == aU {
+		var restore func()
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	}
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, f*a.At(r, c))
+		}
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *CDense) Mul(a, b CMatrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+
+	if ac != br {
+		panic(ErrShape)
+	}
+
+	aU, _, _ := untransposeExtractCmplx(a)
+	bU, _, _ := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, bc)
+	var restore func()
+	if m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	amat, aT, aWork := cblasOperand(a)
+	if aWork != nil {
+		defer putCDenseWorkspace(aWork)
+	}
+	bmat, bT, bWork := cblasOperand(b)
+	if bWork != nil {
+		defer putCDenseWorkspace(bWork)
+	}
+	if aWork == nil {
+		m.checkOverlap(amat)
+	}
+	if bWork == nil {
+		m.checkOverlap(bmat)
+	}
+	cblas128.Gemm(aT, bT, 1, amat, bmat, 0, m.mat)
+}
+
+// cblasOperand returns a cblas128.General and the transpose operation that
+// together represent a for use in a call to a Level 3 BLAS routine. If a
+// cannot be represented without copying, its elements are copied into a
+// workspace which is returned and must be returned to the pool by the
+// caller.
+func cblasOperand(a CMatrix) (cblas128.General, blas.Transpose, *CDense) {
+	aU, trans, conj := untransposeExtractCmplx(a)
+	if rm, ok := aU.(*CDense); ok {
+		switch {
+		case !trans && !conj:
+			return rm.mat, blas.NoTrans, nil
+		case trans && !conj:
+			return rm.mat, blas.Trans, nil
+		case !trans && conj:
+			return rm.mat, blas.ConjTrans, nil
+		}
+	}
+	r, c := a.Dims()
+	w := getCDenseWorkspace(r, c, false)
+	w.Copy(a)
+	return w.mat, blas.NoTrans, w
+}
+
+// Inverse computes the inverse of the matrix a, storing the result into the
+// receiver. If a is ill-conditioned, a Condition error will be returned.
+// Note that matrix inversion is numerically unstable, and should generally
+// be avoided where possible, for example by using the Solve routines.
+func (m *CDense) Inverse(a CMatrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	var lu CLU
+	lu.Factorize(a)
+	m.reuseAsNonZeroed(r, c)
+	if !lu.ok {
+		// A is exactly singular.
+		return Condition(math.Inf(1))
+	}
+	m.Zero()
+	for i := 0; i < r; i++ {
+		m.mat.Data[i*m.mat.Stride+i] = 1
+	}
+	lapack128.Getrs(blas.NoTrans, lu.lu.mat, m.mat, lu.swaps)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// CNorm returns the specified norm of the complex matrix a. Valid norms are:
+//
+//	1 - The maximum absolute column sum
+//	2 - The Frobenius norm, the square root of the sum of the squares of the absolute values of the elements
+//	Inf - The maximum absolute row sum
+//
+// CNorm will panic with ErrNormOrder if an illegal norm is specified and with
+// ErrZeroLength if the matrix has zero size.
+func CNorm(a CMatrix, norm float64) float64 {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		panic(ErrZeroLength)
+	}
+	switch norm {
+	default:
+		panic(ErrNormOrder)
+	case 1:
+		var max float64
+		for j := 0; j < c; j++ {
+			var sum float64
+			for i := 0; i < r; i++ {
+				sum += cmplx.Abs(a.At(i, j))
+			}
+			max = math.Max(max, sum)
+		}
+		return max
+	case 2:
+		var scale float64
+		sum := 1.0
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				v := a.At(i, j)
+				for _, x := range [2]float64{real(v), imag(v)} {
+					if x == 0 {
+						continue
+					}
+					absxi := math.Abs(x)
+					if scale < absxi {
+						sum = 1 + sum*(scale/absxi)*(scale/absxi)
+						scale = absxi
+					} else {
+						sum += (absxi / scale) * (absxi / scale)
+					}
+				}
+			}
+		}
+		return scale * math.Sqrt(sum)
+	case math.Inf(1):
+		var max float64
+		for i := 0; i < r; i++ {
+			var sum float64
+			for j := 0; j < c; j++ {
+				sum += cmplx.Abs(a.At(i, j))
+			}
+			max = math.Max(max, sum)
+		}
+		return max
+	}
+}
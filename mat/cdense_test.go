@@ -5,9 +5,12 @@
 package mat
 
 import (
+	"math"
 	"math/cmplx"
 	"math/rand/v2"
 	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestCDenseNewAtSet(t *testing.T) {
@@ -197,3 +200,277 @@ func TestCDenseGrow(t *testing.T) {
 		t.Errorf("unexpected value for At(0, 0): got: %v want: 0", v.At(0, 0))
 	}
 }
+
+// randCDense returns an r×c matrix with elements whose real and imaginary
+// parts are drawn from the standard normal distribution.
+func randCDense(r, c int, rnd *rand.Rand) *CDense {
+	m := NewCDense(r, c, nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return m
+}
+
+// randHermitianPD returns a random n×n Hermitian positive definite matrix.
+func randHermitianPD(n int, rnd *rand.Rand) *CDense {
+	b := randCDense(n, n, rnd)
+	var a CDense
+	a.Mul(b.H(), b)
+	for i := 0; i < n; i++ {
+		a.set(i, i, a.at(i, i)+complex(float64(n), 0))
+	}
+	return &a
+}
+
+// isUnitary returns whether the columns of q are orthonormal.
+func isUnitary(q CMatrix, tol float64) bool {
+	_, c := q.Dims()
+	var qhq CDense
+	qhq.Mul(q.H(), q)
+	eye := NewCDense(c, c, nil)
+	for i := 0; i < c; i++ {
+		eye.set(i, i, 1)
+	}
+	return CEqualApprox(&qhq, eye, tol)
+}
+
+func TestCDenseElementwise(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name string
+		fn   func(dst *CDense, a, b CMatrix)
+		want func(x, y complex128) complex128
+	}{
+		{
+			name: "Add",
+			fn:   func(dst *CDense, a, b CMatrix) { dst.Add(a, b) },
+			want: func(x, y complex128) complex128 { return x + y },
+		},
+		{
+			name: "Sub",
+			fn:   func(dst *CDense, a, b CMatrix) { dst.Sub(a, b) },
+			want: func(x, y complex128) complex128 { return x - y },
+		},
+		{
+			name: "MulElem",
+			fn:   func(dst *CDense, a, b CMatrix) { dst.MulElem(a, b) },
+			want: func(x, y complex128) complex128 { return x * y },
+		},
+		{
+			name: "DivElem",
+			fn:   func(dst *CDense, a, b CMatrix) { dst.DivElem(a, b) },
+			want: func(x, y complex128) complex128 { return x / y },
+		},
+	} {
+		for _, r := range []int{1, 3, 4} {
+			for _, c := range []int{1, 3, 5} {
+				a := randCDense(r, c, rnd)
+				b := randCDense(c, r, rnd)
+				for _, ops := range []struct {
+					a, b CMatrix
+				}{
+					{a, b.H()},
+					{a, b.T()},
+					{a.H().H(), b.H()},
+					{a.T().H().H().T(), b.T().H().H()},
+				} {
+					want := NewCDense(r, c, nil)
+					for i := 0; i < r; i++ {
+						for j := 0; j < c; j++ {
+							want.set(i, j, test.want(ops.a.At(i, j), ops.b.At(i, j)))
+						}
+					}
+					var got CDense
+					test.fn(&got, ops.a, ops.b)
+					if !CEqual(&got, want) {
+						t.Errorf("%s: unexpected result for r=%d c=%d", test.name, r, c)
+					}
+
+					// Test the receiver as the first operand.
+					dst := NewCDense(r, c, nil)
+					dst.Copy(a)
+					test.fn(dst, dst, ops.b)
+					want.Zero()
+					for i := 0; i < r; i++ {
+						for j := 0; j < c; j++ {
+							want.set(i, j, test.want(a.At(i, j), ops.b.At(i, j)))
+						}
+					}
+					if !CEqual(dst, want) {
+						t.Errorf("%s: unexpected result for aliased receiver r=%d c=%d", test.name, r, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCDenseScale(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	f := complex(rnd.NormFloat64(), rnd.NormFloat64())
+	for _, r := range []int{1, 3, 4} {
+		for _, c := range []int{1, 3, 5} {
+			a := randCDense(r, c, rnd)
+			for _, src := range []CMatrix{a, a.T(), a.H(), a.H().T()} {
+				sr, sc := src.Dims()
+				want := NewCDense(sr, sc, nil)
+				for i := 0; i < sr; i++ {
+					for j := 0; j < sc; j++ {
+						want.set(i, j, f*src.At(i, j))
+					}
+				}
+				var got CDense
+				got.Scale(f, src)
+				if !CEqual(&got, want) {
+					t.Errorf("unexpected result for r=%d c=%d", r, c)
+				}
+			}
+			aCopy := NewCDense(r, c, nil)
+			aCopy.Copy(a)
+			aCopy.Scale(f, aCopy)
+			var want CDense
+			want.Scale(f, a)
+			if !CEqual(aCopy, &want) {
+				t.Errorf("unexpected result for aliased receiver r=%d c=%d", r, c)
+			}
+		}
+	}
+}
+
+func TestCDenseMul(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	ops := []struct {
+		name string
+		fn   func(CMatrix) CMatrix
+		swap bool
+	}{
+		{name: "", fn: func(m CMatrix) CMatrix { return m }},
+		{name: ".T", fn: func(m CMatrix) CMatrix { return m.T() }, swap: true},
+		{name: ".H", fn: func(m CMatrix) CMatrix { return m.H() }, swap: true},
+		{name: ".H.T", fn: func(m CMatrix) CMatrix { return m.H().T() }},
+	}
+	for _, test := range []struct {
+		ar, ac, bc int
+	}{
+		{1, 1, 1},
+		{1, 3, 2},
+		{3, 1, 4},
+		{4, 3, 2},
+		{5, 5, 5},
+		{7, 4, 6},
+	} {
+		for _, opA := range ops {
+			for _, opB := range ops {
+				ar, ac := test.ar, test.ac
+				if opA.swap {
+					ar, ac = ac, ar
+				}
+				a := opA.fn(randCDense(ar, ac, rnd))
+				br, bc := test.ac, test.bc
+				if opB.swap {
+					br, bc = bc, br
+				}
+				b := opB.fn(randCDense(br, bc, rnd))
+
+				want := NewCDense(test.ar, test.bc, nil)
+				for i := 0; i < test.ar; i++ {
+					for j := 0; j < test.bc; j++ {
+						var v complex128
+						for k := 0; k < test.ac; k++ {
+							v += a.At(i, k) * b.At(k, j)
+						}
+						want.set(i, j, v)
+					}
+				}
+				var got CDense
+				got.Mul(a, b)
+				if !CEqualApprox(&got, want, tol) {
+					t.Errorf("unexpected result for a%s*b%s with ar=%d ac=%d bc=%d", opA.name, opB.name, test.ar, test.ac, test.bc)
+				}
+			}
+		}
+	}
+
+	// Test the receiver as an operand.
+	a := randCDense(4, 4, rnd)
+	b := randCDense(4, 4, rnd)
+	var want CDense
+	want.Mul(a.H(), b)
+	a.Mul(a.H(), b)
+	if !CEqualApprox(a, &want, tol) {
+		t.Error("unexpected result for aliased receiver")
+	}
+}
+
+func TestCDenseInverse(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25} {
+		a := randCDense(n, n, rnd)
+		for _, src := range []CMatrix{a, a.H(), a.T()} {
+			var inv CDense
+			err := inv.Inverse(src)
+			if err != nil {
+				t.Errorf("n=%d: unexpected error: %v", n, err)
+				continue
+			}
+			var got CDense
+			got.Mul(src, &inv)
+			eye := NewCDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				eye.set(i, i, 1)
+			}
+			if !CEqualApprox(&got, eye, tol) {
+				t.Errorf("n=%d: A*A⁻¹ is not the identity", n)
+			}
+		}
+
+		// Test the receiver as the argument.
+		var want CDense
+		want.Inverse(a)
+		err := a.Inverse(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error for aliased receiver: %v", n, err)
+		}
+		if !CEqualApprox(a, &want, tol) {
+			t.Errorf("n=%d: unexpected result for aliased receiver", n)
+		}
+	}
+
+	// Singular matrix.
+	a := NewCDense(2, 2, []complex128{1, 1i, 2, 2i})
+	var inv CDense
+	err := inv.Inverse(a)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+}
+
+func TestCNorm(t *testing.T) {
+	t.Parallel()
+	a := NewCDense(2, 3, []complex128{
+		3 + 4i, 1, -2i,
+		0, 1 - 1i, 5,
+	})
+	for _, test := range []struct {
+		norm float64
+		want float64
+	}{
+		{norm: 1, want: 7},
+		{norm: 2, want: math.Sqrt(25 + 1 + 4 + 2 + 25)},
+		{norm: math.Inf(1), want: 8},
+	} {
+		got := CNorm(a, test.norm)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected norm for norm=%v: got:%v want:%v", test.norm, got, test.want)
+		}
+		if got := CNorm(a.H(), test.norm); test.norm == 2 && !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected norm of conjugate transpose for norm=%v: got:%v want:%v", test.norm, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CEigenHerm is a type for computing all eigenvalues and, optionally,
+// eigenvectors of a Hermitian matrix A.
+//
+// It is a Hermitian matrix represented by its spectral factorization. Once
+// computed, this representation is useful for extracting eigenvalues and
+// eigenvector, but At is slow.
+type CEigenHerm struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *CDense
+}
+
+var _ CMatrix = (*CEigenHerm)(nil)
+
+// Dims returns the dimensions of the matrix.
+func (e *CEigenHerm) Dims() (r, c int) {
+	n := len(e.values)
+	return n, n
+}
+
+// At returns the element at row i, column j of the matrix A.
+//
+// At will panic if the eigenvectors have not been computed.
+func (e *CEigenHerm) At(i, j int) complex128 {
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	n, _ := e.Dims()
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	var val complex128
+	for k := 0; k < n; k++ {
+		val += complex(e.values[k], 0) * e.vectors.at(i, k) * cmplx.Conj(e.vectors.at(j, k))
+	}
+	return val
+}
+
+// H returns the receiver, the conjugate transpose of a Hermitian matrix.
+func (e *CEigenHerm) H() CMatrix {
+	return e
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// CTranspose.
+func (e *CEigenHerm) T() CMatrix {
+	return CTranspose{e}
+}
+
+// Factorize computes the spectral factorization (eigendecomposition) of the
+// Hermitian matrix A. Only the upper triangle of a is used and the imaginary
+// parts of its diagonal elements are ignored.
+//
+// The spectral factorization of A can be written as
+//
+//	A = Q * Λ * Qᴴ
+//
+// where Λ is a real diagonal matrix whose entries are the eigenvalues, and Q is
+// a unitary matrix whose columns are the eigenvectors.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo and At will panic.
+//
+// Factorize returns whether the factorization succeeded. If it returns false,
+// methods that require a successful factorization will panic. Factorize will
+// panic if a is not square.
+func (e *CEigenHerm) Factorize(a CMatrix, vectors bool) (ok bool) {
+	// kill previous decomposition
+	e.vectorsComputed = false
+	e.values = e.values[:0]
+
+	m, n := a.Dims()
+	if m != n {
+		panic(ErrSquare)
+	}
+
+	if n == 0 {
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+
+	// Copy the upper triangle of A, ignoring the imaginary parts of its
+	// diagonal elements.
+	h := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		h.set(i, i, complex(real(a.At(i, i)), 0))
+		for j := i + 1; j < n; j++ {
+			h.set(i, j, a.At(i, j))
+		}
+	}
+	herm := cblas128.Hermitian{
+		N:      n,
+		Stride: h.mat.Stride,
+		Data:   h.mat.Data,
+		Uplo:   blas.Upper,
+	}
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	w := make([]float64, n)
+	rwork := getFloat64s(max(1, 3*n-2), false)
+	defer putFloat64s(rwork)
+	work := []complex128{0}
+	lapack128.Heev(jobz, herm, w, work, -1, rwork)
+	work = make([]complex128, int(real(work[0])))
+	ok = lapack128.Heev(jobz, herm, w, work, len(work), rwork)
+	if !ok {
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	e.values = w
+	if !vectors {
+		return true
+	}
+	e.vectors = h
+	e.vectorsComputed = true
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *CEigenHerm) succFact() bool {
+	return len(e.values) != 0
+}
+
+// Values extracts the eigenvalues of the factorized n×n matrix A in ascending
+// order.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length equal to n.
+//
+// If the receiver does not contain a successful factorization, Values will
+// panic.
+func (e *CEigenHerm) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the eigenvectors of the decomposition into the columns of
+// dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *CEigenHerm) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	r, c := e.vectors.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
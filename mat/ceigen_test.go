@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestCEigenHerm(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		b := randCDense(n, n, rnd)
+		var a CDense
+		a.Add(b, b.H())
+
+		var eh CEigenHerm
+		if !eh.Factorize(&a, true) {
+			t.Errorf("n=%d: unexpected Factorize failure", n)
+			continue
+		}
+		values := eh.Values(nil)
+		if !sort.Float64sAreSorted(values) {
+			t.Errorf("n=%d: eigenvalues not sorted", n)
+		}
+		var v CDense
+		eh.VectorsTo(&v)
+		if !isUnitary(&v, tol) {
+			t.Errorf("n=%d: eigenvectors not orthonormal", n)
+		}
+		var av CDense
+		av.Mul(&a, &v)
+		vl := NewCDense(n, n, nil)
+		vl.Copy(&v)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				vl.set(i, j, vl.at(i, j)*complex(values[j], 0))
+			}
+		}
+		if !CEqualApprox(&av, vl, tol*float64(n)) {
+			t.Errorf("n=%d: A*V != V*Λ", n)
+		}
+		if !CEqualApprox(&eh, &a, tol*float64(n)) {
+			t.Errorf("n=%d: unexpected result from At", n)
+		}
+
+		var ehNo CEigenHerm
+		if !ehNo.Factorize(&a, false) {
+			t.Errorf("n=%d: unexpected Factorize failure without vectors", n)
+			continue
+		}
+		if !floats.EqualApprox(ehNo.Values(nil), values, tol*float64(n)) {
+			t.Errorf("n=%d: eigenvalues mismatch when vectors not computed", n)
+		}
+	}
+}
+
+func TestCEigenHermReal(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 4, 10} {
+		s := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				s.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+		a := NewCDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.set(i, j, complex(s.At(i, j), 0))
+			}
+		}
+		var es EigenSym
+		es.Factorize(s, false)
+		var eh CEigenHerm
+		eh.Factorize(a, false)
+		if !floats.EqualApprox(eh.Values(nil), es.Values(nil), tol) {
+			t.Errorf("n=%d: eigenvalues mismatch with EigenSym", n)
+		}
+	}
+}
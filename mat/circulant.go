@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+)
+
+var (
+	circulant *Circulant
+	_         Matrix = circulant
+)
+
+// Circulant represents an n×n circulant matrix, a matrix in which each column
+// is the previous column rotated down by one element,
+//
+//	    ⎡c[0]   c[n-1] ⋯  c[1]⎤
+//	C = ⎢c[1]   c[0]   ⋯  c[2]⎥
+//	    ⎢ ⋮      ⋮     ⋱   ⋮  ⎥
+//	    ⎣c[n-1] c[n-2] ⋯  c[0]⎦
+//
+// so that C[i,j] = c[(i-j) mod n]. Circulant matrices are diagonalized by the
+// discrete Fourier transform,
+//
+//	C = F * Λ * Fᴴ
+//
+// where F is the unitary Fourier matrix with F[j,k] = exp(2πi*j*k/n)/√n, and Λ
+// is the diagonal matrix of the eigenvalues, which form the discrete Fourier
+// transform of the first column c. Products and solutions of linear systems with
+// a circulant matrix are computed in O(n log n) time using the fast Fourier
+// transform.
+type Circulant struct {
+	col []float64
+
+	// spec holds the first n/2+1 eigenvalues of the matrix. The remaining
+	// eigenvalues are their complex conjugates.
+	spec []complex128
+}
+
+// NewCirculant creates a new n×n circulant matrix with the first column c,
+// where n is the length of c. The elements of c are copied, so changes to c
+// after the call are not reflected in the returned matrix. NewCirculant will
+// panic if c has zero length.
+func NewCirculant(c []float64) *Circulant {
+	n := len(c)
+	if n == 0 {
+		panic(ErrZeroLength)
+	}
+	c = append([]float64(nil), c...)
+	return &Circulant{
+		col:  c,
+		spec: fourier.NewFFT(n).Coefficients(nil, c),
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (a *Circulant) Dims() (r, c int) {
+	n := len(a.col)
+	return n, n
+}
+
+// At returns the element at row i, column j.
+func (a *Circulant) At(i, j int) float64 {
+	n := len(a.col)
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	k := i - j
+	if k < 0 {
+		k += n
+	}
+	return a.col[k]
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (a *Circulant) T() Matrix {
+	return Transpose{a}
+}
+
+// MulVecTo computes C⋅x or Cᵀ⋅x storing the result into dst.
+func (a *Circulant) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	n := len(a.col)
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	work := make([]float64, n)
+	for i := range work {
+		work[i] = x.AtVec(i)
+	}
+	circulantMul(fourier.NewFFT(n), work, a.spec, trans)
+	dst.reuseAsNonZeroed(n)
+	for i, v := range work {
+		dst.setVec(i, v)
+	}
+}
+
+// SolveVecTo solves the linear system C⋅x = b or Cᵀ⋅x = b using the fast
+// Fourier transform and stores the result in dst.
+//
+// If C is exactly singular, a Condition error with value +Inf is returned and
+// the contents of dst are undefined. If the condition number of C is larger
+// than ConditionTolerance, the solution is computed and a Condition error is
+// returned.
+func (a *Circulant) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := len(a.col)
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	cond := a.Cond()
+	if math.IsInf(cond, 1) {
+		return Condition(cond)
+	}
+	work := make([]float64, n)
+	for i := range work {
+		work[i] = b.AtVec(i)
+	}
+	fft := fourier.NewFFT(n)
+	coeff := fft.Coefficients(nil, work)
+	for k, v := range a.spec {
+		if trans {
+			v = cmplx.Conj(v)
+		}
+		coeff[k] /= v
+	}
+	fft.Sequence(work, coeff)
+	dst.reuseAsNonZeroed(n)
+	f := 1 / float64(n)
+	for i, v := range work {
+		dst.setVec(i, f*v)
+	}
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// Cond returns the condition number of the matrix in the 2-norm, which is the
+// ratio of the largest and the smallest magnitude of its eigenvalues.
+func (a *Circulant) Cond() float64 {
+	lmin := math.Inf(1)
+	var lmax float64
+	for _, v := range a.spec {
+		abs := cmplx.Abs(v)
+		lmin = math.Min(lmin, abs)
+		lmax = math.Max(lmax, abs)
+	}
+	if lmin == 0 {
+		return math.Inf(1)
+	}
+	return lmax / lmin
+}
+
+// Values extracts the eigenvalues of the matrix. The k-th eigenvalue is
+//
+//	λ_k = \sum_j c[j] * exp(-2πi*j*k/n)
+//
+// and corresponds to the k-th eigenvector returned by VectorsTo. Because C is
+// real, λ_{n-k} is the complex conjugate of λ_k.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length equal to the size of the matrix.
+func (a *Circulant) Values(dst []complex128) []complex128 {
+	n := len(a.col)
+	if dst == nil {
+		dst = make([]complex128, n)
+	}
+	if len(dst) != n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, a.spec)
+	for k := len(a.spec); k < n; k++ {
+		dst[k] = cmplx.Conj(a.spec[n-k])
+	}
+	return dst
+}
+
+// VectorsTo stores the orthonormal eigenvectors of the matrix into the columns
+// of dst. The eigenvectors form the unitary Fourier matrix
+//
+//	F[j,k] = exp(2πi*j*k/n)/√n
+//
+// and do not depend on the elements of the matrix.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
+// VectorsTo will panic if dst is not n×n.
+func (a *Circulant) VectorsTo(dst *CDense) {
+	n := len(a.col)
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	f := 1 / math.Sqrt(float64(n))
+	for j := 0; j < n; j++ {
+		for k := 0; k < n; k++ {
+			// Reduce the exponent modulo n for accuracy.
+			sin, cos := math.Sincos(2 * math.Pi * float64(j*k%n) / float64(n))
+			dst.set(j, k, complex(f*cos, f*sin))
+		}
+	}
+}
+
+// circulantMul overwrites x with the product of the real circulant matrix that
+// has the first n/2+1 eigenvalues in spec and x, or with the product of its
+// transpose if trans is true. The length of x and fft must be n.
+func circulantMul(fft *fourier.FFT, x []float64, spec []complex128, trans bool) {
+	coeff := fft.Coefficients(nil, x)
+	for k, v := range spec {
+		if trans {
+			v = cmplx.Conj(v)
+		}
+		coeff[k] *= v
+	}
+	fft.Sequence(x, coeff)
+	f := 1 / float64(len(x))
+	for i := range x {
+		x[i] *= f
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestCirculant(t *testing.T) {
+	t.Parallel()
+	c := []float64{1, 2, 3}
+	a := NewCirculant(c)
+	want := NewDense(3, 3, []float64{
+		1, 3, 2,
+		2, 1, 3,
+		3, 2, 1,
+	})
+	if !Equal(a, want) {
+		t.Errorf("unexpected circulant matrix:\ngot:\n%v\nwant:\n%v", Formatted(a), Formatted(want))
+	}
+	if !Equal(a.T(), want.T()) {
+		t.Errorf("unexpected transpose of circulant matrix")
+	}
+	c[1] = 10
+	if a.At(1, 0) != 2 {
+		t.Errorf("circulant matrix shares data with its argument")
+	}
+}
+
+func TestCirculantEigen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 7, 16, 33} {
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+		a := NewCirculant(c)
+		values := a.Values(nil)
+		var vectors CDense
+		a.VectorsTo(&vectors)
+
+		// Check that C * V = V * Λ for each eigenpair.
+		for k := 0; k < n; k++ {
+			for i := 0; i < n; i++ {
+				var av complex128
+				for j := 0; j < n; j++ {
+					av += complex(a.At(i, j), 0) * vectors.At(j, k)
+				}
+				if d := av - values[k]*vectors.At(i, k); math.Hypot(real(d), imag(d)) > tol*float64(n) {
+					t.Errorf("n=%d: eigenpair %d mismatch", n, k)
+					break
+				}
+			}
+		}
+
+		// Check that the eigenvectors are orthonormal.
+		for k := 0; k < n; k++ {
+			for l := 0; l < n; l++ {
+				var dot complex128
+				for i := 0; i < n; i++ {
+					v := vectors.At(i, k)
+					dot += complex(real(v), -imag(v)) * vectors.At(i, l)
+				}
+				want := 0.0
+				if k == l {
+					want = 1
+				}
+				if math.Hypot(real(dot)-want, imag(dot)) > tol*float64(n) {
+					t.Errorf("n=%d: eigenvectors %d and %d are not orthonormal", n, k, l)
+				}
+			}
+		}
+	}
+}
+
+func TestCirculantSolveVecTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 7, 16, 33} {
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+		c[0] += float64(n)
+		a := NewCirculant(c)
+		var aDense Dense
+		aDense.CloneFrom(a)
+
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		for _, trans := range []bool{false, true} {
+			name := fmt.Sprintf("n=%d,trans=%t", n, trans)
+			var x VecDense
+			err := a.SolveVecTo(&x, trans, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var got VecDense
+			if trans {
+				got.MulVec(aDense.T(), &x)
+			} else {
+				got.MulVec(&aDense, &x)
+			}
+			if !EqualApprox(&got, b, tol) {
+				t.Errorf("%s: unexpected solution; residual=%v", name, residual(&got, b))
+			}
+		}
+
+		var svd SVD
+		svd.Factorize(&aDense, SVDNone)
+		if got, want := a.Cond(), svd.Cond(); math.Abs(got-want) > tol*want {
+			t.Errorf("n=%d: unexpected condition number; got %v, want %v", n, got, want)
+		}
+	}
+
+	// A circulant matrix with equal columns is singular.
+	a := NewCirculant([]float64{1, 1, 1})
+	var x VecDense
+	err := a.SolveVecTo(&x, false, NewVecDense(3, []float64{1, 2, 3}))
+	if cond, ok := err.(Condition); !ok || !math.IsInf(float64(cond), 1) {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCLU = "mat: invalid CLU factorization"
+
+// CLU is a square n×n complex matrix represented by its LU factorization with
+// partial pivoting.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements, and U is upper triangular.
+//
+// Note that this matrix representation is useful for certain operations, in
+// particular for solving linear systems of equations. It is very inefficient at
+// other operations, in particular At is slow.
+type CLU struct {
+	lu    *CDense
+	swaps []int
+	piv   []int
+	cond  float64
+	ok    bool // Whether A is nonsingular
+}
+
+var _ CMatrix = (*CLU)(nil)
+
+// Dims returns the dimensions of the matrix A.
+func (lu *CLU) Dims() (r, c int) {
+	if lu.lu == nil {
+		return 0, 0
+	}
+	return lu.lu.Dims()
+}
+
+// At returns the element of A at row i, column j.
+func (lu *CLU) At(i, j int) complex128 {
+	n, _ := lu.Dims()
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	i = lu.piv[i]
+	var val complex128
+	for k := 0; k < min(i, j+1); k++ {
+		val += lu.lu.at(i, k) * lu.lu.at(k, j)
+	}
+	if i <= j {
+		val += lu.lu.at(i, j)
+	}
+	return val
+}
+
+// H performs an implicit conjugate transpose by returning the receiver inside
+// a ConjTranspose.
+func (lu *CLU) H() CMatrix {
+	return ConjTranspose{lu}
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// CTranspose.
+func (lu *CLU) T() CMatrix {
+	return CTranspose{lu}
+}
+
+// Factorize computes the LU factorization of the square matrix A and stores the
+// result in the receiver. The LU decomposition will complete regardless of the
+// singularity of a.
+//
+// The L and U matrix factors can be extracted from the factorization using the
+// LTo and UTo methods. The matrix P can be extracted as a row permutation using
+// the RowPivots method.
+func (lu *CLU) Factorize(a CMatrix) {
+	m, n := a.Dims()
+	if m != n {
+		panic(ErrSquare)
+	}
+	if lu.lu == nil {
+		lu.lu = NewCDense(n, n, nil)
+	} else {
+		lu.lu.Reset()
+		lu.lu.reuseAsNonZeroed(n, n)
+	}
+	lu.lu.Copy(a)
+	lu.swaps = useInt(lu.swaps, n)
+	lu.piv = useInt(lu.piv, n)
+	work := getFloat64s(2*n, false)
+	defer putFloat64s(work)
+	anorm := lapack128.Lange(CondNorm, lu.lu.mat, work)
+	lu.ok = lapack128.Getrf(lu.lu.mat, lu.swaps)
+	lu.updatePivots()
+	if !lu.ok {
+		lu.cond = math.Inf(1)
+		return
+	}
+	v := lapack128.Gecon(CondNorm, lu.lu.mat, anorm, make([]complex128, 2*n), work)
+	lu.cond = 1 / v
+}
+
+func (lu *CLU) updatePivots() {
+	// Replay the sequence of row swaps in order to find the row permutation.
+	for i := range lu.piv {
+		lu.piv[i] = i
+	}
+	for i := len(lu.swaps) - 1; i >= 0; i-- {
+		v := lu.swaps[i]
+		lu.piv[i], lu.piv[v] = lu.piv[v], lu.piv[i]
+	}
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *CLU) isValid() bool {
+	return lu.lu != nil && !lu.lu.IsEmpty()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (lu *CLU) Cond() float64 {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+	return lu.cond
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *CLU) Reset() {
+	if lu.lu != nil {
+		lu.lu.Reset()
+	}
+	lu.swaps = lu.swaps[:0]
+	lu.piv = lu.piv[:0]
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *CLU) Det() complex128 {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+	if !lu.ok {
+		return 0
+	}
+	det, phase := lu.LogDet()
+	return complex(math.Exp(det), 0) * phase
+}
+
+// LogDet returns the log of the absolute value of the determinant and the
+// phase of the determinant for the matrix that has been factorized, so that
+// the determinant is equal to phase * exp(det). The phase has unit absolute
+// value. Numerical stability in product and division expressions is generally
+// improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *CLU) LogDet() (det float64, phase complex128) {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	_, n := lu.lu.Dims()
+	phase = 1
+	for i := 0; i < n; i++ {
+		v := lu.lu.at(i, i)
+		abs := cmplx.Abs(v)
+		if abs != 0 {
+			phase *= v / complex(abs, 0)
+		}
+		if lu.swaps[i] != i {
+			phase = -phase
+		}
+		det += math.Log(abs)
+	}
+	// Renormalize to guard against accumulated rounding.
+	phase /= complex(cmplx.Abs(phase), 0)
+	return det, phase
+}
+
+// RowPivots returns the row permutation that represents the permutation matrix
+// P from the LU factorization
+//
+//	A = P * L * U.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the size of the factorized matrix, RowPivots
+// will panic. RowPivots will panic if the receiver does not contain a
+// factorization.
+func (lu *CLU) RowPivots(dst []int) []int {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+	_, n := lu.lu.Dims()
+	if dst == nil {
+		dst = make([]int, n)
+	}
+	if len(dst) != n {
+		panic(badSliceLength)
+	}
+	copy(dst, lu.piv)
+	return dst
+}
+
+// LTo extracts the lower triangular matrix from an LU factorization.
+//
+// If dst is empty, LTo will resize dst to be n×n. When dst is non-empty, LTo
+// will panic if dst is not n×n. LTo will also panic if the receiver does not
+// contain a successful factorization.
+func (lu *CLU) LTo(dst *CDense) {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	_, n := lu.lu.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		n2, c2 := dst.Dims()
+		if n != n2 || n != c2 {
+			panic(ErrShape)
+		}
+	}
+	for i := 0; i < n; i++ {
+		row := dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+n]
+		copy(row[:i], lu.lu.mat.Data[i*lu.lu.mat.Stride:i*lu.lu.mat.Stride+i])
+		row[i] = 1
+		zeroC(row[i+1:])
+	}
+}
+
+// UTo extracts the upper triangular matrix from an LU factorization.
+//
+// If dst is empty, UTo will resize dst to be n×n. When dst is non-empty, UTo
+// will panic if dst is not n×n. UTo will also panic if the receiver does not
+// contain a successful factorization.
+func (lu *CLU) UTo(dst *CDense) {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	_, n := lu.lu.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		n2, c2 := dst.Dims()
+		if n != n2 || n != c2 {
+			panic(ErrShape)
+		}
+	}
+	for i := 0; i < n; i++ {
+		row := dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+n]
+		zeroC(row[:i])
+		copy(row[i:], lu.lu.mat.Data[i*lu.lu.mat.Stride+i:i*lu.lu.mat.Stride+n])
+	}
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᴴ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix X
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (lu *CLU) SolveTo(dst *CDense, trans bool, b CMatrix) error {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	_, n := lu.lu.Dims()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _, _ := untransposeCmplx(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawCMatrixer); ok {
+		dst.checkOverlap(rm.RawCMatrix())
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.ConjTrans
+	}
+	lapack128.Getrs(t, lu.lu.mat, dst.mat, lu.swaps)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
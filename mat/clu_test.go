@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestCLU(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 20} {
+		a := randCDense(n, n, rnd)
+		var lu CLU
+		lu.Factorize(a)
+
+		var l, u CDense
+		lu.LTo(&l)
+		lu.UTo(&u)
+		var lu2 CDense
+		lu2.Mul(&l, &u)
+		piv := lu.RowPivots(nil)
+		got := NewCDense(n, n, nil)
+		for i, p := range piv {
+			for j := 0; j < n; j++ {
+				got.set(i, j, lu2.at(p, j))
+			}
+		}
+		if !CEqualApprox(got, a, tol) {
+			t.Errorf("n=%d: A != P*L*U", n)
+		}
+		if !CEqualApprox(&lu, a, tol) {
+			t.Errorf("n=%d: unexpected result from At", n)
+		}
+
+		// The determinant of a product is the product of determinants.
+		b := randCDense(n, n, rnd)
+		var ab CDense
+		ab.Mul(a, b)
+		var luB, luAB CLU
+		luB.Factorize(b)
+		luAB.Factorize(&ab)
+		want := lu.Det() * luB.Det()
+		if det := luAB.Det(); cmplx.Abs(det-want) > tol*cmplx.Abs(want) {
+			t.Errorf("n=%d: unexpected determinant: got %v, want %v", n, det, want)
+		}
+		det, phase := lu.LogDet()
+		if d := lu.Det(); cmplx.Abs(cmplx.Exp(complex(det, 0))*phase-d) > tol*cmplx.Abs(d) {
+			t.Errorf("n=%d: LogDet does not match Det", n)
+		}
+	}
+}
+
+func TestCLUSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 20} {
+		for _, bc := range []int{1, 3, 6} {
+			a := randCDense(n, n, rnd)
+			b := randCDense(n, bc, rnd)
+			var lu CLU
+			lu.Factorize(a)
+			for _, trans := range []bool{false, true} {
+				var x CDense
+				err := lu.SolveTo(&x, trans, b)
+				if err != nil {
+					t.Errorf("n=%d bc=%d trans=%t: unexpected error: %v", n, bc, trans, err)
+					continue
+				}
+				var got CDense
+				if trans {
+					got.Mul(a.H(), &x)
+				} else {
+					got.Mul(a, &x)
+				}
+				if !CEqualApprox(&got, b, tol) {
+					t.Errorf("n=%d bc=%d trans=%t: unexpected solution", n, bc, trans)
+				}
+			}
+
+			// Test the right-hand side as the receiver.
+			bCopy := NewCDense(n, bc, nil)
+			bCopy.Copy(b)
+			var want CDense
+			lu.SolveTo(&want, false, b)
+			lu.SolveTo(bCopy, false, bCopy)
+			if !CEqualApprox(bCopy, &want, tol) {
+				t.Errorf("n=%d bc=%d: unexpected solution for aliased receiver", n, bc)
+			}
+		}
+	}
+}
+
+func TestCLUCond(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10} {
+		a := randCDense(n, n, rnd)
+		var lu CLU
+		lu.Factorize(a)
+		var inv CDense
+		inv.Inverse(a)
+		want := CNorm(a, math.Inf(1)) * CNorm(&inv, math.Inf(1))
+		got := lu.Cond()
+		// The condition number is estimated, so allow for underestimation.
+		if got > want*(1+1e-10) || got < want/10 {
+			t.Errorf("n=%d: unexpected condition number: got %v, want %v", n, got, want)
+		}
+	}
+
+	// Singular matrix.
+	a := NewCDense(2, 2, []complex128{1, 1i, 2, 2i})
+	var lu CLU
+	lu.Factorize(a)
+	var x CDense
+	err := lu.SolveTo(&x, false, NewCDense(2, 1, []complex128{1, 2}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badCOD = "mat: invalid COD factorization"
+
+// COD is a type for creating and using the complete orthogonal decomposition
+// of a matrix.
+//
+// The complete orthogonal decomposition of an m×n matrix A of numerical rank k
+// is
+//
+//	A * P = Q * [ T 0 ] * Z
+//	            [ 0 0 ]
+//
+// where P is an n×n permutation matrix, Q is an m×m orthonormal matrix, T is a
+// k×k lower triangular matrix and Z is an n×n orthonormal matrix. It is
+// computed from a column pivoted QR factorization by annihilating the part of
+// R to the right of its leading nonsingular triangle using an LQ factorization.
+// The decomposition gives minimum norm solutions of rank deficient least
+// squares problems.
+type COD struct {
+	// qr holds the column pivoted QR factorization of A as returned by Geqp3.
+	qr  *Dense
+	tau []float64
+	piv []int
+
+	rank int
+	// lq holds the LQ factorization of the leading rank×n rows of R as
+	// returned by Gelqf. Its leading rank×rank lower triangle is T.
+	lq    *Dense
+	tauLQ []float64
+
+	cond float64
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (cod *COD) Dims() (r, c int) {
+	if cod.qr == nil {
+		return 0, 0
+	}
+	return cod.qr.Dims()
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (cod *COD) isValid() bool {
+	return cod.qr != nil && !cod.qr.IsEmpty()
+}
+
+// Factorize computes the complete orthogonal decomposition of the m×n matrix a.
+// The numerical rank k of a is determined from the column pivoted QR
+// factorization of a as the number of diagonal elements of R whose magnitude
+// is greater than tol times the magnitude of the largest one. The trailing rows
+// of R are then treated as zero. A typical choice of tol is max(m,n)*ε where ε
+// is the machine epsilon.
+//
+// Factorize will panic if tol is negative.
+func (cod *COD) Factorize(a Matrix, tol float64) {
+	if tol < 0 {
+		panic(badRcond)
+	}
+	m, n := a.Dims()
+	if cod.qr == nil {
+		cod.qr = &Dense{}
+	}
+	cod.qr.CloneFrom(a)
+	cod.tau = make([]float64, min(m, n))
+	cod.piv = useInt(cod.piv, n)
+	for i := range cod.piv {
+		cod.piv[i] = -1
+	}
+	work := []float64{0}
+	lapack64.Geqp3(cod.qr.mat, cod.piv, cod.tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqp3(cod.qr.mat, cod.piv, cod.tau, work, len(work))
+	putFloat64s(work)
+
+	k := rankFromDiag(cod.qr, tol)
+	cod.rank = k
+	if k == 0 {
+		cod.lq = nil
+		cod.tauLQ = nil
+		cod.cond = 1
+		return
+	}
+
+	// Compute the LQ factorization [R11 R12] = [T 0] * Z.
+	if cod.lq == nil {
+		cod.lq = &Dense{}
+	}
+	cod.lq.reuseAsZeroed(k, n)
+	for i := 0; i < k; i++ {
+		copy(cod.lq.mat.Data[i*cod.lq.mat.Stride+i:i*cod.lq.mat.Stride+n], cod.qr.mat.Data[i*cod.qr.mat.Stride+i:i*cod.qr.mat.Stride+n])
+	}
+	cod.tauLQ = make([]float64, k)
+	work = []float64{0}
+	lapack64.Gelqf(cod.lq.mat, cod.tauLQ, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Gelqf(cod.lq.mat, cod.tauLQ, work, len(work))
+	putFloat64s(work)
+
+	work = getFloat64s(3*k, false)
+	iwork := getInts(k, false)
+	t := cod.lq.asTriDense(k, blas.NonUnit, blas.Lower)
+	v := lapack64.Trcon(CondNorm, t.mat, work, iwork)
+	putFloat64s(work)
+	putInts(iwork)
+	cod.cond = 1 / v
+}
+
+// Rank returns the numerical rank of the factorized matrix determined
+// during factorization. Rank will panic if the receiver does not contain
+// a factorization.
+func (cod *COD) Rank() int {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+	return cod.rank
+}
+
+// Cond returns the condition number of the k×k triangular factor T, which
+// is the condition number of the factorized matrix restricted to its
+// numerical range. Cond will panic if the receiver does not contain a
+// factorization.
+func (cod *COD) Cond() float64 {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+	return cod.cond
+}
+
+// ColPivots returns the column permutation that represents the permutation
+// matrix P from the decomposition, that is, the jth column of A * P is the
+// dst[j]th column of A.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the number of columns of the factorized
+// matrix, ColPivots will panic. ColPivots will panic if the receiver does not
+// contain a factorization.
+func (cod *COD) ColPivots(dst []int) []int {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+	_, n := cod.Dims()
+	if dst == nil {
+		dst = make([]int, n)
+	}
+	if len(dst) != n {
+		panic(badSliceLength)
+	}
+	copy(dst, cod.piv)
+	return dst
+}
+
+// QTo extracts the m×m orthonormal matrix Q from the decomposition.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is non-empty,
+// QTo will panic if dst is not m×m. QTo will also panic if the receiver
+// does not contain a successful factorization.
+func (cod *COD) QTo(dst *Dense) {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+	m, _ := cod.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(m, m)
+	} else {
+		r, c := dst.Dims()
+		if r != m || c != m {
+			panic(ErrShape)
+		}
+	}
+	dst.Zero()
+	for i := 0; i < m; i++ {
+		dst.set(i, i, 1)
+	}
+	cod.applyQ(blas.NoTrans, dst)
+}
+
+// ZTo extracts the n×n orthonormal matrix Z from the decomposition.
+//
+// If dst is empty, ZTo will resize dst to be n×n. When dst is non-empty,
+// ZTo will panic if dst is not n×n. ZTo will also panic if the receiver
+// does not contain a successful factorization.
+func (cod *COD) ZTo(dst *Dense) {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+	_, n := cod.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Zero()
+	for i := 0; i < n; i++ {
+		dst.set(i, i, 1)
+	}
+	cod.applyZ(blas.NoTrans, dst)
+}
+
+// TTo extracts the k×k lower triangular matrix T from the decomposition,
+// where k is the numerical rank of the factorized matrix.
+//
+// If dst is empty, TTo will resize dst to be k×k. When dst is non-empty,
+// TTo will panic if dst is not k×k. TTo will also panic if the receiver
+// does not contain a successful factorization or if the rank is zero.
+func (cod *COD) TTo(dst *TriDense) {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+	k := cod.rank
+	if k == 0 {
+		panic(ErrZeroLength)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAsTri(k, Lower)
+	} else {
+		n, kind := dst.Triangle()
+		if n != k || kind != Lower {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(cod.lq.asTriDense(k, blas.NonUnit, blas.Lower))
+}
+
+// applyQ computes Q*w if trans is blas.NoTrans or Qᵀ*w if trans is
+// blas.Trans, storing the result in-place into the m-row matrix w.
+func (cod *COD) applyQ(trans blas.Transpose, w *Dense) {
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, trans, cod.qr.mat, cod.tau, w.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, trans, cod.qr.mat, cod.tau, w.mat, work, len(work))
+	putFloat64s(work)
+}
+
+// applyZ computes Z*w if trans is blas.NoTrans or Zᵀ*w if trans is
+// blas.Trans, storing the result in-place into the n-row matrix w.
+func (cod *COD) applyZ(trans blas.Transpose, w *Dense) {
+	if cod.rank == 0 {
+		// Z is the identity.
+		return
+	}
+	work := []float64{0}
+	lapack64.Ormlq(blas.Left, trans, cod.lq.mat, cod.tauLQ, w.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormlq(blas.Left, trans, cod.lq.mat, cod.tauLQ, w.mat, work, len(work))
+	putFloat64s(work)
+}
+
+// SolveTo finds the minimum norm solution to a system of linear equations
+// defined by the matrices A and b, where A is an m×n matrix represented in its
+// complete orthogonal decomposition. The part of A outside its numerical range
+// is ignored, so a solution is returned even when A is rank deficient.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find the minimum norm X such that ||A*X - B||_2 is minimized.
+//	If trans == true, find the minimum norm X such that ||Aᵀ*X - B||_2 is minimized.
+//
+// The solution matrix, X, is stored in place into dst. If the triangular factor
+// T is near-singular, a Condition error is returned. See the documentation for
+// Condition for more information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (cod *COD) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+
+	m, n := cod.Dims()
+	br, bc := b.Dims()
+	if trans {
+		if n != br {
+			panic(ErrShape)
+		}
+		dst.reuseAsNonZeroed(m, bc)
+	} else {
+		if m != br {
+			panic(ErrShape)
+		}
+		dst.reuseAsNonZeroed(n, bc)
+	}
+
+	k := cod.rank
+	w := getDenseWorkspace(max(m, n), bc, true)
+	defer putDenseWorkspace(w)
+	if trans {
+		// Aᵀ = P * Zᵀ * [ Tᵀ 0 ] * Qᵀ, so the minimum norm solution is
+		//              [ 0  0 ]
+		//  X = Q * [ T⁻ᵀ * (Z * Pᵀ * B)[0:k] ]
+		//          [            0            ].
+		wn := w.slice(0, n, 0, bc)
+		wn.Copy(b)
+		wn.PermuteRows(cod.piv, false)
+		cod.applyZ(blas.NoTrans, wn)
+		if k > 0 {
+			t := cod.lq.asTriDense(k, blas.NonUnit, blas.Lower).mat
+			ok := lapack64.Trtrs(blas.Trans, t, w.slice(0, k, 0, bc).mat)
+			if !ok {
+				return Condition(math.Inf(1))
+			}
+		}
+		for i := k; i < m; i++ {
+			zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+		}
+		wm := w.slice(0, m, 0, bc)
+		cod.applyQ(blas.NoTrans, wm)
+		dst.Copy(wm)
+	} else {
+		// A = Q * [ T 0 ] * Z * Pᵀ, so the minimum norm solution is
+		//         [ 0 0 ]
+		//  X = P * Zᵀ * [ T⁻¹ * (Qᵀ * B)[0:k] ]
+		//               [          0          ].
+		wm := w.slice(0, m, 0, bc)
+		wm.Copy(b)
+		cod.applyQ(blas.Trans, wm)
+		if k > 0 {
+			t := cod.lq.asTriDense(k, blas.NonUnit, blas.Lower).mat
+			ok := lapack64.Trtrs(blas.NoTrans, t, w.slice(0, k, 0, bc).mat)
+			if !ok {
+				return Condition(math.Inf(1))
+			}
+		}
+		for i := k; i < n; i++ {
+			zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+		}
+		wn := w.slice(0, n, 0, bc)
+		cod.applyZ(blas.Trans, wn)
+		wn.PermuteRows(cod.piv, true)
+		dst.Copy(wn)
+	}
+	if cod.cond > ConditionTolerance {
+		return Condition(cod.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the minimum norm solution to a system of linear equations,
+//
+//	Ax = b.
+//
+// See COD.SolveTo for the full documentation.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (cod *COD) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !cod.isValid() {
+		panic(badCOD)
+	}
+
+	r, c := cod.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+
+	bm := Matrix(b)
+	if rv, ok := b.(RawVectorer); ok {
+		bmat := rv.RawVector()
+		if dst != b {
+			dst.checkOverlap(bmat)
+		}
+		b := VecDense{mat: bmat}
+		bm = b.asDense()
+	}
+	if trans {
+		dst.reuseAsNonZeroed(r)
+	} else {
+		dst.reuseAsNonZeroed(c)
+	}
+	return cod.SolveTo(dst.asDense(), trans, bm)
+}
@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+var codTests = []struct {
+	m, n, k int
+}{
+	{1, 1, 1},
+	{4, 4, 4},
+	{4, 4, 2},
+	{6, 3, 3},
+	{6, 3, 1},
+	{3, 6, 3},
+	{3, 6, 2},
+	{10, 10, 0},
+	{20, 12, 7},
+	{12, 20, 7},
+	{40, 30, 30},
+}
+
+func TestCOD(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range codTests {
+		m, n, k := test.m, test.n, test.k
+		name := fmt.Sprintf("m=%d,n=%d,k=%d", m, n, k)
+		a := randRankDense(m, n, k, rnd)
+
+		var cod COD
+		cod.Factorize(a, float64(max(m, n))*dlamchE*10)
+		if rank := cod.Rank(); rank != k {
+			t.Errorf("%s: unexpected rank: got %d, want %d", name, rank, k)
+			continue
+		}
+
+		var q, z Dense
+		cod.QTo(&q)
+		if !isOrthonormal(&q, tol) {
+			t.Errorf("%s: Q is not orthonormal", name)
+		}
+		cod.ZTo(&z)
+		if !isOrthonormal(&z, tol) {
+			t.Errorf("%s: Z is not orthonormal", name)
+		}
+
+		// Check that A*P = Q * [T 0; 0 0] * Z.
+		mid := NewDense(m, n, nil)
+		if k > 0 {
+			var tri TriDense
+			cod.TTo(&tri)
+			mid.slice(0, k, 0, k).Copy(&tri)
+		}
+		var got Dense
+		got.Mul(&q, mid)
+		got.Mul(&got, &z)
+		var ap Dense
+		ap.CloneFrom(a)
+		ap.PermuteCols(cod.ColPivots(nil), false)
+		if !EqualApprox(&got, &ap, tol*Norm(a, 2)+tol) {
+			t.Errorf("%s: A*P != Q*[T 0;0 0]*Z", name)
+		}
+	}
+}
+
+func TestCODSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []bool{false, true} {
+		for _, test := range codTests {
+			m, n, k := test.m, test.n, test.k
+			if k == 0 {
+				continue
+			}
+			for _, bc := range []int{1, 3} {
+				name := fmt.Sprintf("trans=%t,m=%d,n=%d,k=%d,bc=%d", trans, m, n, k, bc)
+				a := randRankDense(m, n, k, rnd)
+				op := Matrix(a)
+				br := m
+				if trans {
+					op = a.T()
+					br = n
+				}
+				b := randNormDense(br, bc, rnd)
+
+				var cod COD
+				cod.Factorize(a, float64(max(m, n))*dlamchE*10)
+				var got Dense
+				if err := cod.SolveTo(&got, trans, b); err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+
+				// The minimum norm least squares solution is the one given
+				// by the pseudo-inverse.
+				var svd SVD
+				if !svd.Factorize(op, SVDThin) {
+					t.Fatalf("%s: SVD failed", name)
+				}
+				var want Dense
+				svd.SolveTo(&want, b, k)
+				if !EqualApprox(&got, &want, tol) {
+					t.Errorf("%s: solution does not match minimum norm solution\ngot: %v\nwant:%v",
+						name, Formatted(&got), Formatted(&want))
+				}
+
+				if bc == 1 {
+					var x VecDense
+					if err := cod.SolveVecTo(&x, trans, b.ColView(0)); err != nil {
+						t.Errorf("%s: unexpected error: %v", name, err)
+						continue
+					}
+					if !EqualApprox(&x, want.ColView(0), tol) {
+						t.Errorf("%s: vector solution does not match minimum norm solution", name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCQR = "mat: invalid CQR factorization"
+
+// CQR is a type for creating and using the QR factorization of a complex
+// matrix.
+type CQR struct {
+	qr   *CDense
+	q    *CDense
+	tau  []complex128
+	cond float64
+}
+
+var _ CMatrix = (*CQR)(nil)
+
+// Dims returns the dimensions of the matrix.
+func (qr *CQR) Dims() (r, c int) {
+	if qr.qr == nil {
+		return 0, 0
+	}
+	return qr.qr.Dims()
+}
+
+// At returns the element at row i, column j. At will panic if the receiver
+// does not contain a successful factorization.
+func (qr *CQR) At(i, j int) complex128 {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	m, n := qr.Dims()
+	if uint(i) >= uint(m) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	if qr.q == nil || qr.q.IsEmpty() {
+		qr.updateQ()
+	}
+	var val complex128
+	for k := 0; k <= j; k++ {
+		val += qr.q.at(i, k) * qr.qr.at(k, j)
+	}
+	return val
+}
+
+// H performs an implicit conjugate transpose by returning the receiver inside
+// a ConjTranspose.
+func (qr *CQR) H() CMatrix {
+	return ConjTranspose{qr}
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// CTranspose.
+func (qr *CQR) T() CMatrix {
+	return CTranspose{qr}
+}
+
+// Factorize computes the QR factorization of an m×n matrix a where m >= n. The QR
+// factorization always exists even if A is singular.
+//
+// The QR decomposition is a factorization of the matrix A such that A = Q * R.
+// The matrix Q is a unitary m×m matrix, and R is an m×n upper triangular matrix.
+// Q and R can be extracted using the QTo and RTo methods.
+func (qr *CQR) Factorize(a CMatrix) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	if qr.qr == nil {
+		qr.qr = &CDense{}
+	} else {
+		qr.qr.Reset()
+	}
+	qr.qr.reuseAsNonZeroed(m, n)
+	qr.qr.Copy(a)
+	qr.tau = useC(qr.tau, n)
+	work := []complex128{0}
+	lapack128.Geqrf(qr.qr.mat, qr.tau, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Geqrf(qr.qr.mat, qr.tau, work, len(work))
+	qr.updateCond()
+	if qr.q != nil {
+		qr.q.Reset()
+	}
+}
+
+func (qr *CQR) updateCond() {
+	// Since A = Q*R, and Q is unitary, we get for the condition number κ
+	//  κ(A) := |A| |A^-1| = |Q*R| |(Q*R)^-1| = |R| |R^-1 * Qᴴ|
+	//        = |R| |R^-1| = κ(R),
+	// where we used that fact that Q^-1 = Qᴴ. However, this assumes that
+	// the matrix norm is invariant under unitary transformations which
+	// is not the case for CondNorm. Hopefully the error is negligible: κ
+	// is only a qualitative measure anyway.
+	n := qr.qr.mat.Cols
+	work := make([]complex128, 2*n)
+	rwork := getFloat64s(n, false)
+	v := lapack128.Trcon(CondNorm, qr.triangularR(), work, rwork)
+	putFloat64s(rwork)
+	qr.cond = 1 / v
+}
+
+// triangularR returns the n×n upper triangular factor R held in the receiver.
+func (qr *CQR) triangularR() cblas128.Triangular {
+	return cblas128.Triangular{
+		N:      qr.qr.mat.Cols,
+		Stride: qr.qr.mat.Stride,
+		Data:   qr.qr.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+}
+
+func (qr *CQR) updateQ() {
+	m, _ := qr.Dims()
+	if qr.q == nil {
+		qr.q = NewCDense(m, m, nil)
+	} else {
+		qr.q.reuseAsNonZeroed(m, m)
+	}
+	// Construct Q from the elementary reflectors.
+	qr.q.Copy(qr.qr)
+	work := []complex128{0}
+	lapack128.Ungqr(qr.q.mat, qr.tau, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Ungqr(qr.q.mat, qr.tau, work, len(work))
+}
+
+// applyQ computes Q*w if trans is blas.NoTrans or Qᴴ*w if trans is
+// blas.ConjTrans, storing the result in-place into w.
+func (qr *CQR) applyQ(trans blas.Transpose, w *CDense) {
+	work := []complex128{0}
+	lapack128.Unmqr(blas.Left, trans, qr.qr.mat, qr.tau, w.mat, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Unmqr(blas.Left, trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *CQR) isValid() bool {
+	return qr.qr != nil && !qr.qr.IsEmpty()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (qr *CQR) Cond() float64 {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+	return qr.cond
+}
+
+// RTo extracts the m×n upper trapezoidal matrix from a QR decomposition.
+//
+// If dst is empty, RTo will resize dst to be r×c. When dst is non-empty,
+// RTo will panic if dst is not r×c. RTo will also panic if the receiver
+// does not contain a successful factorization.
+func (qr *CQR) RTo(dst *CDense) {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+
+	for i := 0; i < r; i++ {
+		row := dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+c]
+		if i >= c {
+			zeroC(row)
+			continue
+		}
+		zeroC(row[:i])
+		copy(row[i:], qr.qr.mat.Data[i*qr.qr.mat.Stride+i:i*qr.qr.mat.Stride+c])
+	}
+}
+
+// QTo extracts the r×r unitary matrix Q from a QR decomposition.
+//
+// If dst is empty, QTo will resize dst to be r×r. When dst is non-empty,
+// QTo will panic if dst is not r×r. QTo will also panic if the receiver
+// does not contain a successful factorization.
+func (qr *CQR) QTo(dst *CDense) {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, _ := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, r)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || r != c2 {
+			panic(ErrShape)
+		}
+	}
+
+	if qr.q == nil || qr.q.IsEmpty() {
+		qr.updateQ()
+	}
+	dst.Copy(qr.q)
+}
+
+// SolveTo finds a minimum-norm solution to a system of linear equations defined
+// by the matrices A and b, where A is an m×n matrix represented in its QR factorized
+// form. If A is singular or near-singular a Condition error is returned.
+// See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find X such that ||A*X - B||_2 is minimized.
+//	If trans == true, find the minimum norm solution of Aᴴ * X = B.
+//
+// The solution matrix, X, is stored in place into dst.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (qr *CQR) SolveTo(dst *CDense, trans bool, b CMatrix) error {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	br, bc := b.Dims()
+
+	// The QR solve algorithm stores the result in-place into the right hand side.
+	// The storage for the answer must be large enough to hold both b and x.
+	// However, this method's receiver must be the size of x. Copy b, and then
+	// copy the result into dst at the end.
+	if trans {
+		if c != br {
+			panic(ErrShape)
+		}
+		dst.reuseAsNonZeroed(r, bc)
+	} else {
+		if r != br {
+			panic(ErrShape)
+		}
+		dst.reuseAsNonZeroed(c, bc)
+	}
+	if qr.cond == math.Inf(1) {
+		return Condition(math.Inf(1))
+	}
+	// Do not need to worry about overlap between m and b because x has its own
+	// independent storage.
+	w := getCDenseWorkspace(max(r, c), bc, false)
+	defer putCDenseWorkspace(w)
+	w.Copy(b)
+	t := qr.triangularR()
+	if trans {
+		cblas128.Trsm(blas.Left, blas.ConjTrans, 1, t, w.slice(0, c, 0, bc).mat)
+		for i := c; i < r; i++ {
+			zeroC(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+		}
+		qr.applyQ(blas.NoTrans, w)
+	} else {
+		qr.applyQ(blas.ConjTrans, w)
+		cblas128.Trsm(blas.Left, blas.NoTrans, 1, t, w.slice(0, c, 0, bc).mat)
+	}
+	// X was set above to be the correct size for the result.
+	dst.Copy(w)
+	if qr.cond > ConditionTolerance {
+		return Condition(qr.cond)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestCQR(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{3, 3},
+		{5, 3},
+		{10, 10},
+		{12, 5},
+		{30, 20},
+	} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+		var qr CQR
+		qr.Factorize(a)
+
+		var q, r CDense
+		qr.QTo(&q)
+		if !isUnitary(&q, tol) {
+			t.Errorf("m=%d n=%d: Q is not unitary", m, n)
+		}
+		qr.RTo(&r)
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.at(i, j) != 0 {
+					t.Errorf("m=%d n=%d: R is not upper triangular", m, n)
+				}
+			}
+		}
+		var got CDense
+		got.Mul(&q, &r)
+		if !CEqualApprox(&got, a, tol) {
+			t.Errorf("m=%d n=%d: Q*R != A", m, n)
+		}
+		if !CEqualApprox(&qr, a, tol) {
+			t.Errorf("m=%d n=%d: unexpected result from At", m, n)
+		}
+	}
+}
+
+func TestCQRSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{4, 4},
+		{6, 4},
+		{10, 3},
+		{20, 20},
+	} {
+		for _, bc := range []int{1, 4} {
+			m, n := test.m, test.n
+			a := randCDense(m, n, rnd)
+			var qr CQR
+			qr.Factorize(a)
+
+			// Least squares: the residual is orthogonal to the range of A.
+			b := randCDense(m, bc, rnd)
+			var x CDense
+			err := qr.SolveTo(&x, false, b)
+			if err != nil {
+				t.Errorf("m=%d n=%d bc=%d: unexpected error: %v", m, n, bc, err)
+				continue
+			}
+			var res, ahr CDense
+			res.Mul(a, &x)
+			res.Sub(&res, b)
+			ahr.Mul(a.H(), &res)
+			if !CEqualApprox(&ahr, NewCDense(n, bc, nil), tol) {
+				t.Errorf("m=%d n=%d bc=%d: residual not orthogonal to range of A", m, n, bc)
+			}
+
+			// Minimum norm: Aᴴ*X = B and X is in the range of A.
+			b = randCDense(n, bc, rnd)
+			x.Reset()
+			err = qr.SolveTo(&x, true, b)
+			if err != nil {
+				t.Errorf("m=%d n=%d bc=%d trans: unexpected error: %v", m, n, bc, err)
+				continue
+			}
+			var got CDense
+			got.Mul(a.H(), &x)
+			if !CEqualApprox(&got, b, tol) {
+				t.Errorf("m=%d n=%d bc=%d trans: Aᴴ*X != B", m, n, bc)
+			}
+			var q CDense
+			qr.QTo(&q)
+			if m > n {
+				var perp CDense
+				perp.Mul(q.slice(0, m, n, m).H(), &x)
+				if !CEqualApprox(&perp, NewCDense(m-n, bc, nil), tol) {
+					t.Errorf("m=%d n=%d bc=%d trans: solution not minimum norm", m, n, bc)
+				}
+			}
+		}
+	}
+}
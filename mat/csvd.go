@@ -0,0 +1,308 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CSVD is a type for creating and using the Singular Value Decomposition
+// of a complex matrix.
+type CSVD struct {
+	kind SVDKind
+
+	s []float64
+	u *CDense
+	v *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (svd *CSVD) succFact() bool {
+	return len(svd.s) != 0
+}
+
+// Factorize computes the singular value decomposition (SVD) of the input
+// complex matrix A. The singular values of A are computed in all cases, while
+// the singular vectors are optionally computed depending on the input kind.
+//
+// The full singular value decomposition (kind == SVDFull) is a factorization
+// of an m×n matrix A of the form
+//
+//	A = U * Σ * Vᴴ
+//
+// where Σ is an m×n real diagonal matrix, U is an m×m unitary matrix, and V is
+// an n×n unitary matrix. The diagonal elements of Σ are the singular values of
+// A. The first min(m,n) columns of U and V are, respectively, the left and
+// right singular vectors of A.
+//
+// Significant storage space can be saved by using the thin representation of
+// the SVD (kind == SVDThin) instead of the full SVD, especially if
+// m >> n or m << n. The thin SVD finds
+//
+//	A = U~ * Σ * V~ᴴ
+//
+// where U~ is of size m×min(m,n), Σ is a diagonal matrix of size min(m,n)×min(m,n)
+// and V~ is of size n×min(m,n).
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (svd *CSVD) Factorize(a CMatrix, kind SVDKind) (ok bool) {
+	// kill previous factorization
+	svd.s = svd.s[:0]
+	svd.kind = kind
+
+	m, n := a.Dims()
+	var jobU, jobVT lapack.SVDJob
+	var u, vt cblas128.General
+	switch {
+	case kind&SVDFullU != 0:
+		jobU = lapack.SVDAll
+		u = cblas128.General{
+			Rows:   m,
+			Cols:   m,
+			Stride: m,
+			Data:   make([]complex128, m*m),
+		}
+	case kind&SVDThinU != 0:
+		jobU = lapack.SVDStore
+		u = cblas128.General{
+			Rows:   m,
+			Cols:   min(m, n),
+			Stride: min(m, n),
+			Data:   make([]complex128, m*min(m, n)),
+		}
+	default:
+		jobU = lapack.SVDNone
+	}
+	switch {
+	case kind&SVDFullV != 0:
+		jobVT = lapack.SVDAll
+		vt = cblas128.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   make([]complex128, n*n),
+		}
+	case kind&SVDThinV != 0:
+		jobVT = lapack.SVDStore
+		vt = cblas128.General{
+			Rows:   min(m, n),
+			Cols:   n,
+			Stride: n,
+			Data:   make([]complex128, min(m, n)*n),
+		}
+	default:
+		jobVT = lapack.SVDNone
+	}
+
+	// A is destroyed on call, so copy the matrix.
+	var aCopy CDense
+	aCopy.reuseAsNonZeroed(m, n)
+	aCopy.Copy(a)
+	s := make([]float64, min(m, n))
+
+	rwork := getFloat64s(5*min(m, n), false)
+	defer putFloat64s(rwork)
+	work := []complex128{0}
+	lapack128.Gesvd(jobU, jobVT, aCopy.mat, u, vt, s, work, -1, rwork)
+	work = make([]complex128, int(real(work[0])))
+	ok = lapack128.Gesvd(jobU, jobVT, aCopy.mat, u, vt, s, work, len(work), rwork)
+	if !ok {
+		svd.kind = 0
+		return false
+	}
+	svd.s = s
+	if jobU != lapack.SVDNone {
+		svd.u = NewCDense(u.Rows, u.Cols, u.Data)
+	}
+	if jobVT != lapack.SVDNone {
+		// The right singular vectors are stored in the columns of V,
+		// while Gesvd returns the rows of Vᴴ.
+		svd.v = NewCDense(vt.Cols, vt.Rows, nil)
+		svd.v.Copy(NewCDense(vt.Rows, vt.Cols, vt.Data).H())
+	}
+	return true
+}
+
+// Kind returns the SVDKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (svd *CSVD) Kind() SVDKind {
+	if !svd.succFact() {
+		return -1
+	}
+	return svd.kind
+}
+
+// Rank returns the rank of A based on the count of singular values greater than
+// rcond scaled by the largest singular value.
+// Rank will panic if the receiver does not contain a successful factorization or
+// rcond is negative.
+func (svd *CSVD) Rank(rcond float64) int {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	s0 := svd.s[0]
+	for i, v := range svd.s {
+		if v <= rcond*s0 {
+			return i
+		}
+	}
+	return len(svd.s)
+}
+
+// Cond returns the 2-norm condition number for the factorized matrix. Cond will
+// panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Cond() float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	return svd.s[0] / svd.s[len(svd.s)-1]
+}
+
+// Values returns the singular values of the factorized matrix in descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length min(m,n), and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Values(s []float64) []float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the matrix U from the singular value decomposition. The first
+// min(m,n) columns are the left singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is empty, UTo will resize dst to be m×m if the full U was computed
+// and size m×min(m,n) if the thin U was computed. When dst is non-empty, then
+// UTo will panic if dst is not the appropriate size. UTo will also panic if
+// the receiver does not contain a successful factorization, or if U was
+// not computed during factorization.
+func (svd *CSVD) UTo(dst *CDense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.kind&SVDThinU == 0 && svd.kind&SVDFullU == 0 {
+		panic("svd: u not computed during factorization")
+	}
+	r, c := svd.u.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(svd.u)
+}
+
+// VTo extracts the matrix V from the singular value decomposition. The first
+// min(m,n) columns are the right singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is empty, VTo will resize dst to be n×n if the full V was computed
+// and size n×min(m,n) if the thin V was computed. When dst is non-empty, then
+// VTo will panic if dst is not the appropriate size. VTo will also panic if
+// the receiver does not contain a successful factorization, or if V was
+// not computed during factorization.
+func (svd *CSVD) VTo(dst *CDense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.kind&SVDThinV == 0 && svd.kind&SVDFullV == 0 {
+		panic("svd: v not computed during factorization")
+	}
+	r, c := svd.v.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(svd.v)
+}
+
+// SolveTo calculates the minimum-norm solution to a linear least squares problem
+//
+//	minimize over n-element vectors x: |b - A*x|_2 and |x|_2
+//
+// where b is a given m-element vector, using the SVD of m×n matrix A stored in
+// the receiver. A may be rank-deficient, that is, the given effective rank can be
+//
+//	rank ≤ min(m,n)
+//
+// The rank can be computed using CSVD.Rank.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B and the
+// resulting vectors x will be stored in the columns of dst. dst must be either
+// empty or have the size equal to n×k.
+//
+// The decomposition must have been factorized computing both the U and V
+// singular vectors.
+//
+// SolveTo returns the residuals calculated from the complete SVD. For this
+// value to be valid the factorization must have been performed with at least
+// SVDFullU.
+func (svd *CSVD) SolveTo(dst *CDense, b CMatrix, rank int) []float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if rank < 1 || len(svd.s) < rank {
+		panic("svd: rank out of range")
+	}
+	kind := svd.kind
+	if kind&SVDThinU == 0 && kind&SVDFullU == 0 {
+		panic("svd: u not computed during factorization")
+	}
+	if kind&SVDThinV == 0 && kind&SVDFullV == 0 {
+		panic("svd: v not computed during factorization")
+	}
+
+	_, uc := svd.u.Dims()
+	vr, _ := svd.v.Dims()
+	_, bc := b.Dims()
+	c := getCDenseWorkspace(uc, bc, false)
+	defer putCDenseWorkspace(c)
+	c.Mul(svd.u.H(), b)
+
+	y := getCDenseWorkspace(rank, bc, false)
+	defer putCDenseWorkspace(y)
+	for i, s := range svd.s[:rank] {
+		for j := 0; j < bc; j++ {
+			y.set(i, j, c.at(i, j)/complex(s, 0))
+		}
+	}
+	dst.Mul(svd.v.slice(0, vr, 0, rank), y)
+
+	res := make([]float64, bc)
+	for i := rank; i < uc; i++ {
+		for j := range res {
+			v := c.at(i, j)
+			res[j] += real(v)*real(v) + imag(v)*imag(v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestCSVD(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{1, 4},
+		{4, 1},
+		{5, 5},
+		{8, 5},
+		{5, 8},
+		{20, 12},
+		{12, 20},
+	} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+		var want []float64
+		for _, kind := range []SVDKind{SVDNone, SVDThin, SVDFull, SVDThinU | SVDFullV, SVDFullU | SVDThinV} {
+			var svd CSVD
+			ok := svd.Factorize(a, kind)
+			if !ok {
+				t.Errorf("m=%d n=%d kind=%d: unexpected Factorize failure", m, n, kind)
+				continue
+			}
+			s := svd.Values(nil)
+			if len(s) != min(m, n) {
+				t.Errorf("m=%d n=%d kind=%d: unexpected number of singular values", m, n, kind)
+				continue
+			}
+			for i := 1; i < len(s); i++ {
+				if s[i] > s[i-1] {
+					t.Errorf("m=%d n=%d kind=%d: singular values not sorted", m, n, kind)
+				}
+			}
+			if want == nil {
+				want = s
+			} else if !floats.EqualApprox(s, want, tol) {
+				t.Errorf("m=%d n=%d kind=%d: singular values mismatch between kinds", m, n, kind)
+			}
+			if kind == SVDNone {
+				continue
+			}
+
+			var u, v CDense
+			svd.UTo(&u)
+			svd.VTo(&v)
+			if !isUnitary(&u, tol) {
+				t.Errorf("m=%d n=%d kind=%d: U does not have orthonormal columns", m, n, kind)
+			}
+			if !isUnitary(&v, tol) {
+				t.Errorf("m=%d n=%d kind=%d: V does not have orthonormal columns", m, n, kind)
+			}
+			wantUC := min(m, n)
+			if kind&SVDFullU != 0 {
+				wantUC = m
+			}
+			wantVC := min(m, n)
+			if kind&SVDFullV != 0 {
+				wantVC = n
+			}
+			if ur, uc := u.Dims(); ur != m || uc != wantUC {
+				t.Errorf("m=%d n=%d kind=%d: unexpected size of U: %d×%d", m, n, kind, ur, uc)
+			}
+			if vr, vc := v.Dims(); vr != n || vc != wantVC {
+				t.Errorf("m=%d n=%d kind=%d: unexpected size of V: %d×%d", m, n, kind, vr, vc)
+			}
+
+			k := min(m, n)
+			us := NewCDense(m, k, nil)
+			us.Copy(u.slice(0, m, 0, k))
+			for i := 0; i < m; i++ {
+				for j := 0; j < k; j++ {
+					us.set(i, j, us.at(i, j)*complex(s[j], 0))
+				}
+			}
+			var got CDense
+			got.Mul(us, v.slice(0, n, 0, k).H())
+			if !CEqualApprox(&got, a, tol) {
+				t.Errorf("m=%d n=%d kind=%d: U*Σ*Vᴴ != A", m, n, kind)
+			}
+		}
+	}
+}
+
+func TestCSVDSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{4, 4},
+		{7, 4},
+		{4, 7},
+		{15, 10},
+	} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+		b := randCDense(m, 2, rnd)
+		var svd CSVD
+		if !svd.Factorize(a, SVDFull) {
+			t.Errorf("m=%d n=%d: unexpected Factorize failure", m, n)
+			continue
+		}
+		var x CDense
+		res := svd.SolveTo(&x, b, svd.Rank(1e-15))
+
+		if m >= n {
+			var qr CQR
+			qr.Factorize(a)
+			var want CDense
+			qr.SolveTo(&want, false, b)
+			if !CEqualApprox(&x, &want, tol) {
+				t.Errorf("m=%d n=%d: solution mismatch with CQR", m, n)
+			}
+		} else {
+			var qr CQR
+			qr.Factorize(a.H())
+			var want CDense
+			qr.SolveTo(&want, true, b)
+			if !CEqualApprox(&x, &want, tol) {
+				t.Errorf("m=%d n=%d: solution mismatch with CQR minimum norm solution", m, n)
+			}
+		}
+
+		var r CDense
+		r.Mul(a, &x)
+		r.Sub(&r, b)
+		for j := range res {
+			var want float64
+			for i := 0; i < m; i++ {
+				v := r.at(i, j)
+				want += real(v)*real(v) + imag(v)*imag(v)
+			}
+			if !scalar.EqualWithinAbsOrRel(res[j], want, tol, tol) {
+				t.Errorf("m=%d n=%d: unexpected residual: got %v, want %v", m, n, res[j], want)
+			}
+		}
+	}
+}
@@ -132,6 +132,16 @@
 // a cgo BLAS implementation is registered, the lapack64 calls will be partially
 // executed in Go and partially executed in C.
 //
+// The Cholesky, LU and QR factorizations of large matrices can be computed
+// concurrently by registering the tiled implementation of the Go LAPACK
+// package, for example
+//
+//	lapack64.Use(gonum.Tiled{Workers: 16})
+//
+// after which Cholesky, LU and QR, and the types built on them, factorize
+// matrices using up to the given number of goroutines. See the example for
+// lapack64.Use.
+//
 // # Type Switching
 //
 // The Matrix abstraction enables efficiency as well as interoperability. Go's
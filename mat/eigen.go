@@ -5,22 +5,47 @@
 package mat
 
 import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
 const (
-	badFact   = "mat: use without successful factorization"
-	noVectors = "mat: eigenvectors not computed"
+	badFact              = "mat: use without successful factorization"
+	badEigenSymAlgorithm = "mat: invalid symmetric eigendecomposition algorithm"
+	badInterval          = "mat: invalid interval"
+	noVectors            = "mat: eigenvectors not computed"
 )
 
-// EigenSym is a type for computing all eigenvalues and, optionally,
+// EigenSymAlgorithm specifies the algorithm used by EigenSym to compute the
+// spectral factorization of a symmetric matrix.
+type EigenSymAlgorithm int
+
+const (
+	// EigenSymQR specifies the implicit QL or QR iteration on the
+	// tridiagonal form of the matrix.
+	EigenSymQR EigenSymAlgorithm = iota
+	// EigenSymDivideConquer specifies the divide and conquer algorithm. It
+	// is considerably faster than EigenSymQR for large matrices when the
+	// eigenvectors are computed, at the cost of more workspace.
+	EigenSymDivideConquer
+	// EigenSymMRRR specifies the method of multiple relatively robust
+	// representations. It computes the eigenvectors of the tridiagonal form
+	// in O(n²) operations and needs the least workspace.
+	EigenSymMRRR
+)
+
+// EigenSym is a type for computing all or some eigenvalues and, optionally,
 // eigenvectors of a symmetric matrix A.
 //
 // It is a Symmetric matrix represented by its spectral factorization. Once
 // computed, this representation is useful for extracting eigenvalues and
-// eigenvector, but At is slow.
+// eigenvector, but At is slow. If only some eigenpairs are computed, the
+// receiver represents the matrix Q * Λ * Qᵀ formed by the computed
+// eigenvalues and eigenvectors.
 type EigenSym struct {
+	n               int
 	vectorsComputed bool
 
 	values  []float64
@@ -35,7 +60,7 @@ func (e *EigenSym) Dims() (r, c int) {
 
 // SymmetricDim implements the Symmetric interface.
 func (e *EigenSym) SymmetricDim() int {
-	return len(e.values)
+	return e.n
 }
 
 // At returns the element at row i, column j of the matrix A.
@@ -54,8 +79,8 @@ func (e *EigenSym) At(i, j int) float64 {
 	}
 
 	var val float64
-	for k := 0; k < n; k++ {
-		val += e.values[k] * e.vectors.at(i, k) * e.vectors.at(j, k)
+	for k, v := range e.values {
+		val += v * e.vectors.at(i, k) * e.vectors.at(j, k)
 	}
 	return val
 }
@@ -66,7 +91,7 @@ func (e *EigenSym) T() Matrix {
 }
 
 // Factorize computes the spectral factorization (eigendecomposition) of the
-// symmetric matrix A.
+// symmetric matrix A using the implicit QL or QR iteration.
 //
 // The spectral factorization of A can be written as
 //
@@ -81,11 +106,30 @@ func (e *EigenSym) T() Matrix {
 // Factorize returns whether the factorization succeeded. If it returns false,
 // methods that require a successful factorization will panic.
 func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
-	// kill previous decomposition
-	e.vectorsComputed = false
-	e.values = e.values[:]
+	return e.FactorizeAlgorithm(a, vectors, EigenSymQR)
+}
+
+// FactorizeAlgorithm computes the spectral factorization of the symmetric
+// matrix A using the specified algorithm. See Factorize for more information.
+//
+// FactorizeAlgorithm returns whether the factorization succeeded. If it
+// returns false, methods that require a successful factorization will panic.
+// The factorization of a 0×0 matrix is not successful.
+func (e *EigenSym) FactorizeAlgorithm(a Symmetric, vectors bool, alg EigenSymAlgorithm) (ok bool) {
+	switch alg {
+	case EigenSymQR, EigenSymDivideConquer:
+	case EigenSymMRRR:
+		return e.factorizeMRRR(a, vectors, lapack.EVRangeAll, 0, 0, 0, 0)
+	default:
+		panic(badEigenSymAlgorithm)
+	}
+
+	e.reset()
 
 	n := a.SymmetricDim()
+	if n == 0 {
+		return false
+	}
 	sd := NewSymDense(n, nil)
 	sd.CopySym(a)
 
@@ -95,34 +139,151 @@ func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	}
 	w := make([]float64, n)
 	work := []float64{0}
-	lapack64.Syev(jobz, sd.mat, w, work, -1)
+	if alg == EigenSymQR {
+		lapack64.Syev(jobz, sd.mat, w, work, -1)
+		work = getFloat64s(int(work[0]), false)
+		ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
+		putFloat64s(work)
+	} else {
+		iwork := []int{0}
+		lapack64.Syevd(jobz, sd.mat, w, work, -1, iwork, -1)
+		work = getFloat64s(int(work[0]), false)
+		iwork = getInts(iwork[0], false)
+		ok = lapack64.Syevd(jobz, sd.mat, w, work, len(work), iwork, len(iwork))
+		putFloat64s(work)
+		putInts(iwork)
+	}
+	if !ok {
+		return false
+	}
+	e.n = n
+	e.vectorsComputed = vectors
+	e.values = w
+	e.vectors = NewDense(n, n, sd.mat.Data)
+	return true
+}
 
+// FactorizeIndex computes the eigenvalues of the symmetric matrix A with
+// indices lo through hi-1 in ascending order and, optionally, the
+// corresponding eigenvectors using the method of multiple relatively robust
+// representations. lo and hi must satisfy 0 <= lo <= hi <= n, and
+// FactorizeIndex will panic otherwise.
+//
+// After a successful factorization, Values returns hi-lo eigenvalues and
+// VectorsTo returns an n×(hi-lo) matrix.
+//
+// FactorizeIndex returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSym) FactorizeIndex(a Symmetric, vectors bool, lo, hi int) (ok bool) {
+	n := a.SymmetricDim()
+	if lo < 0 || hi < lo || n < hi {
+		panic(ErrIndexOutOfRange)
+	}
+	if lo == hi {
+		e.reset()
+		if n == 0 {
+			return false
+		}
+		e.n = n
+		e.vectorsComputed = vectors
+		e.values = []float64{}
+		return true
+	}
+	return e.factorizeMRRR(a, vectors, lapack.EVRangeIndex, 0, 0, lo, hi-1)
+}
+
+// FactorizeValue computes the eigenvalues of the symmetric matrix A in the
+// half-open interval (lo,hi] and, optionally, the corresponding eigenvectors
+// using the method of multiple relatively robust representations. lo must be
+// less than hi, and FactorizeValue will panic otherwise.
+//
+// After a successful factorization, Values returns the m eigenvalues found in
+// the interval and VectorsTo returns an n×m matrix. m may be zero.
+//
+// FactorizeValue returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSym) FactorizeValue(a Symmetric, vectors bool, lo, hi float64) (ok bool) {
+	if !(lo < hi) {
+		panic(badInterval)
+	}
+	return e.factorizeMRRR(a, vectors, lapack.EVRangeValue, lo, hi, 0, 0)
+}
+
+// factorizeMRRR computes the selected eigenvalues and, optionally,
+// eigenvectors of the symmetric matrix A using lapack64.Syevr.
+func (e *EigenSym) factorizeMRRR(a Symmetric, vectors bool, rng lapack.EVRange, vl, vu float64, il, iu int) (ok bool) {
+	e.reset()
+
+	n := a.SymmetricDim()
+	if n == 0 {
+		return false
+	}
+	sd := NewSymDense(n, nil)
+	sd.CopySym(a)
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = iu - il + 1
+	}
+	w := make([]float64, n)
+	z := blas64.General{Rows: n, Cols: ncols, Stride: ncols}
+	if vectors {
+		z.Data = make([]float64, n*ncols)
+	}
+	work := []float64{0}
+	iwork := []int{0}
+	lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, w, z, work, -1, iwork, -1)
 	work = getFloat64s(int(work[0]), false)
-	ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
+	iwork = getInts(iwork[0], false)
+	m, ok := lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, w, z, work, len(work), iwork, len(iwork))
 	putFloat64s(work)
+	putInts(iwork)
 	if !ok {
-		e.vectorsComputed = false
-		e.values = nil
-		e.vectors = nil
 		return false
 	}
+	e.n = n
 	e.vectorsComputed = vectors
-	e.values = w
-	e.vectors = NewDense(n, n, sd.mat.Data)
+	e.values = w[:m:m]
+	switch {
+	case !vectors || m == 0:
+	case m == ncols:
+		e.vectors = NewDense(n, m, z.Data)
+	default:
+		// Copy the computed eigenvectors so that the eigenvector matrix
+		// has exactly m columns.
+		e.vectors = NewDense(n, m, nil)
+		e.vectors.Copy(&Dense{
+			mat:     blas64.General{Rows: n, Cols: m, Stride: z.Stride, Data: z.Data},
+			capRows: n,
+			capCols: m,
+		})
+	}
 	return true
 }
 
+// reset clears the receiver.
+func (e *EigenSym) reset() {
+	e.n = 0
+	e.vectorsComputed = false
+	e.values = nil
+	e.vectors = nil
+}
+
 // succFact returns whether the receiver contains a successful factorization.
 func (e *EigenSym) succFact() bool {
-	return len(e.values) != 0
+	return e.n != 0
 }
 
-// Values extracts the eigenvalues of the factorized n×n matrix A in ascending
-// order.
+// Values extracts the computed eigenvalues of the factorized n×n matrix A in
+// ascending order.
 //
 // If dst is not nil, the values are stored in-place into dst and returned,
 // otherwise a new slice is allocated first. If dst is not nil, it must have
-// length equal to n.
+// length equal to the number of computed eigenvalues.
 //
 // If the receiver does not contain a successful factorization, Values will
 // panic.
@@ -140,7 +301,8 @@ func (e *EigenSym) Values(dst []float64) []float64 {
 	return dst
 }
 
-// RawValues returns the slice storing the eigenvalues of A in ascending order.
+// RawValues returns the slice storing the computed eigenvalues of A in
+// ascending order.
 //
 // If the returned slice is modified, the factorization is invalid and should
 // not be used.
@@ -155,12 +317,13 @@ func (e *EigenSym) RawValues() []float64 {
 }
 
 // VectorsTo stores the orthonormal eigenvectors of the factorized n×n matrix A
-// into the columns of dst.
+// corresponding to the computed eigenvalues into the columns of dst.
 //
-// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
-// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
-// eigenvectors were not computed during the factorization, or if the receiver
-// does not contain a successful factorization.
+// If dst is empty, VectorsTo will resize dst to be n×m where m is the number
+// of computed eigenvalues. When dst is non-empty, VectorsTo will panic if dst
+// is not n×m. VectorsTo will also panic if the eigenvectors were not computed
+// during the factorization, or if the receiver does not contain a successful
+// factorization.
 func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.succFact() {
 		panic(badFact)
@@ -168,8 +331,11 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.vectorsComputed {
 		panic(noVectors)
 	}
-	r, c := e.vectors.Dims()
+	r, c := e.n, len(e.values)
 	if dst.IsEmpty() {
+		if c == 0 {
+			return
+		}
 		dst.ReuseAs(r, c)
 	} else {
 		r2, c2 := dst.Dims()
@@ -177,28 +343,284 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 			panic(ErrShape)
 		}
 	}
-	dst.Copy(e.vectors)
+	if c != 0 {
+		dst.Copy(e.vectors)
+	}
 }
 
-// RawQ returns the orthogonal matrix Q from the spectral factorization of the
-// original matrix A
+// RawQ returns the matrix Q from the spectral factorization of the original
+// matrix A
 //
 //	A = Q * Λ * Qᵀ
 //
-// The columns of Q contain the eigenvectors of A.
+// The columns of Q contain the computed eigenvectors of A.
 //
 // If the returned matrix is modified, the factorization is invalid and should
 // not be used.
 //
-// If the receiver does not contain a successful factorization or eigenvectors
-// not computed, RawU will return nil.
+// If the receiver does not contain a successful factorization, eigenvectors
+// were not computed or no eigenvalues were selected, RawQ will return nil.
 func (e *EigenSym) RawQ() Matrix {
-	if !e.succFact() || !e.vectorsComputed {
+	if !e.succFact() || !e.vectorsComputed || e.vectors == nil {
 		return nil
 	}
 	return e.vectors
 }
 
+// EigenSymBand is a type for computing all or some eigenvalues and,
+// optionally, eigenvectors of a symmetric band matrix A.
+//
+// EigenSymBand works on the band storage of A and reduces it to tridiagonal
+// form without forming a dense copy, so for an n×n matrix with bandwidth k the
+// factorization uses O(n·k) memory when the eigenvectors are not computed.
+// Computing eigenvectors requires an additional n×n orthogonal matrix.
+type EigenSymBand struct {
+	n               int
+	vectorsComputed bool
+
+	values  []float64
+	vectors *Dense
+}
+
+// Factorize computes all eigenvalues and, optionally, the eigenvectors of the
+// symmetric band matrix A using the implicit QL or QR iteration on the
+// tridiagonal form of A.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo will panic.
+//
+// Factorize returns whether the factorization succeeded. If it returns false,
+// methods that require a successful factorization will panic.
+func (e *EigenSymBand) Factorize(a SymBanded, vectors bool) (ok bool) {
+	e.reset()
+
+	n, _ := a.SymBand()
+	if n == 0 {
+		return false
+	}
+	ab := copySymBand(a)
+
+	jobz := lapack.EVNone
+	z := blas64.General{Rows: n, Cols: n, Stride: n}
+	if vectors {
+		jobz = lapack.EVCompute
+		z.Data = make([]float64, n*n)
+	}
+	w := make([]float64, n)
+	work := getFloat64s(max(1, 3*n-2), false)
+	ok = lapack64.Sbev(jobz, ab, w, z, work)
+	putFloat64s(work)
+	if !ok {
+		return false
+	}
+	e.n = n
+	e.vectorsComputed = vectors
+	e.values = w
+	if vectors {
+		e.vectors = NewDense(n, n, z.Data)
+	}
+	return true
+}
+
+// FactorizeIndex computes the eigenvalues of the symmetric band matrix A with
+// indices lo through hi-1 in ascending order and, optionally, the
+// corresponding eigenvectors. lo and hi must satisfy 0 <= lo <= hi <= n, and
+// FactorizeIndex will panic otherwise.
+//
+// After a successful factorization, Values returns hi-lo eigenvalues and
+// VectorsTo returns an n×(hi-lo) matrix.
+//
+// FactorizeIndex returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSymBand) FactorizeIndex(a SymBanded, vectors bool, lo, hi int) (ok bool) {
+	n, _ := a.SymBand()
+	if lo < 0 || hi < lo || n < hi {
+		panic(ErrIndexOutOfRange)
+	}
+	if lo == hi {
+		e.reset()
+		if n == 0 {
+			return false
+		}
+		e.n = n
+		e.vectorsComputed = vectors
+		e.values = []float64{}
+		return true
+	}
+	return e.factorizeRange(a, vectors, lapack.EVRangeIndex, 0, 0, lo, hi-1)
+}
+
+// FactorizeValue computes the eigenvalues of the symmetric band matrix A in
+// the half-open interval (lo,hi] and, optionally, the corresponding
+// eigenvectors. lo must be less than hi, and FactorizeValue will panic
+// otherwise.
+//
+// After a successful factorization, Values returns the m eigenvalues found in
+// the interval and VectorsTo returns an n×m matrix. m may be zero.
+//
+// FactorizeValue returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSymBand) FactorizeValue(a SymBanded, vectors bool, lo, hi float64) (ok bool) {
+	if !(lo < hi) {
+		panic(badInterval)
+	}
+	return e.factorizeRange(a, vectors, lapack.EVRangeValue, lo, hi, 0, 0)
+}
+
+// factorizeRange computes the selected eigenvalues and, optionally,
+// eigenvectors of the symmetric band matrix A using lapack64.Sbevx.
+func (e *EigenSymBand) factorizeRange(a SymBanded, vectors bool, rng lapack.EVRange, vl, vu float64, il, iu int) (ok bool) {
+	e.reset()
+
+	n, _ := a.SymBand()
+	if n == 0 {
+		return false
+	}
+	ab := copySymBand(a)
+
+	jobz := lapack.EVNone
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = iu - il + 1
+	}
+	q := blas64.General{Rows: n, Cols: n, Stride: n}
+	z := blas64.General{Rows: n, Cols: ncols, Stride: ncols}
+	if vectors {
+		jobz = lapack.EVCompute
+		q.Data = getFloat64s(n*n, false)
+		z.Data = make([]float64, n*ncols)
+	}
+	w := make([]float64, n)
+	work := getFloat64s(20*n, false)
+	iwork := getInts(12*n, false)
+	m, ok := lapack64.Sbevx(jobz, rng, ab, q, vl, vu, il, iu, w, z, work, iwork)
+	putFloat64s(work)
+	putInts(iwork)
+	if vectors {
+		putFloat64s(q.Data)
+	}
+	if !ok {
+		return false
+	}
+	e.n = n
+	e.vectorsComputed = vectors
+	e.values = w[:m:m]
+	switch {
+	case !vectors || m == 0:
+	case m == ncols:
+		e.vectors = NewDense(n, m, z.Data)
+	default:
+		// Copy the computed eigenvectors so that the eigenvector matrix
+		// has exactly m columns.
+		e.vectors = NewDense(n, m, nil)
+		e.vectors.Copy(&Dense{
+			mat:     blas64.General{Rows: n, Cols: m, Stride: z.Stride, Data: z.Data},
+			capRows: n,
+			capCols: m,
+		})
+	}
+	return true
+}
+
+// copySymBand returns a copy of the n×n symmetric band matrix A in upper band
+// storage.
+func copySymBand(a SymBanded) blas64.SymmetricBand {
+	n, k := a.SymBand()
+	t := NewTriBandDense(n, k, Upper, nil)
+	copySymBandIntoTriBand(t, a)
+	return blas64.SymmetricBand{
+		Uplo:   blas.Upper,
+		N:      n,
+		K:      k,
+		Data:   t.mat.Data,
+		Stride: t.mat.Stride,
+	}
+}
+
+// reset clears the receiver.
+func (e *EigenSymBand) reset() {
+	e.n = 0
+	e.vectorsComputed = false
+	e.values = nil
+	e.vectors = nil
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *EigenSymBand) succFact() bool {
+	return e.n != 0
+}
+
+// Values extracts the computed eigenvalues of the factorized n×n symmetric
+// band matrix A in ascending order.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length equal to the number of computed eigenvalues.
+//
+// If the receiver does not contain a successful factorization, Values will
+// panic.
+func (e *EigenSymBand) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// RawValues returns the slice storing the computed eigenvalues of A in
+// ascending order.
+//
+// If the returned slice is modified, the factorization is invalid and should
+// not be used.
+//
+// If the receiver does not contain a successful factorization, RawValues will
+// return nil.
+func (e *EigenSymBand) RawValues() []float64 {
+	if !e.succFact() {
+		return nil
+	}
+	return e.values
+}
+
+// VectorsTo stores the orthonormal eigenvectors of the factorized n×n
+// symmetric band matrix A corresponding to the computed eigenvalues into the
+// columns of dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×m where m is the number
+// of computed eigenvalues. When dst is non-empty, VectorsTo will panic if dst
+// is not n×m. VectorsTo will also panic if the eigenvectors were not computed
+// during the factorization, or if the receiver does not contain a successful
+// factorization.
+func (e *EigenSymBand) VectorsTo(dst *Dense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	r, c := e.n, len(e.values)
+	if dst.IsEmpty() {
+		if c == 0 {
+			return
+		}
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	if c != 0 {
+		dst.Copy(e.vectors)
+	}
+}
+
 // EigenKind specifies the computation of eigenvectors during factorization.
 type EigenKind int
 
@@ -310,14 +732,14 @@ func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	var cvl, cvr CDense
 	if left {
 		cvl = *NewCDense(r, r, nil)
-		e.complexEigenTo(&cvl, &vl)
+		complexEigenTo(&cvl, &vl, e.values)
 		e.lVectors = &cvl
 	} else {
 		e.lVectors = nil
 	}
 	if right {
 		cvr = *NewCDense(c, c, nil)
-		e.complexEigenTo(&cvr, &vr)
+		complexEigenTo(&cvr, &vr, e.values)
 		e.rVectors = &cvr
 	} else {
 		e.rVectors = nil
@@ -356,7 +778,8 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 }
 
 // complexEigenTo extracts the complex eigenvectors from the real matrix d
-// and stores them into the complex matrix dst.
+// and stores them into the complex matrix dst. Only the imaginary parts of
+// values, the corresponding eigenvalues, are used.
 //
 // The columns of the returned n×n dense matrix contain the eigenvectors of the
 // decomposition in the same order as the eigenvalues.
@@ -371,7 +794,7 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 //	dst[:,j+1] = d[:,j] - i*d[:,j+1],
 //
 // where i is the imaginary unit.
-func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
+func complexEigenTo(dst *CDense, d *Dense, values []complex128) {
 	r, c := d.Dims()
 	cr, cc := dst.Dims()
 	if r != cr {
@@ -381,7 +804,7 @@ func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
 		panic("size mismatch")
 	}
 	for j := 0; j < c; j++ {
-		if imag(e.values[j]) == 0 {
+		if imag(values[j]) == 0 {
 			for i := 0; i < r; i++ {
 				dst.set(i, j, complex(d.at(i, j), 0))
 			}
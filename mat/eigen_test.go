@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"fmt"
 	"math"
 	"math/rand/v2"
 	"sort"
@@ -232,3 +233,282 @@ func TestEigenSym(t *testing.T) {
 		}
 	}
 }
+
+func TestEigenSymAlgorithm(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, alg := range []EigenSymAlgorithm{EigenSymQR, EigenSymDivideConquer, EigenSymMRRR} {
+		for _, vectors := range []bool{false, true} {
+			var es EigenSym
+			if es.FactorizeAlgorithm(&SymDense{}, vectors, alg) {
+				t.Errorf("alg=%d,n=0,vectors=%t: unexpected success", alg, vectors)
+			}
+			if es.RawQ() != nil {
+				t.Errorf("alg=%d,n=0,vectors=%t: unexpected non-nil RawQ", alg, vectors)
+			}
+		}
+
+		for _, n := range []int{1, 2, 3, 5, 10, 26, 70} {
+			a := make([]float64, n*n)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			s := NewSymDense(n, a)
+
+			var want EigenSym
+			ok := want.Factorize(s, false)
+			if !ok {
+				t.Errorf("alg=%d,n=%d: bad test", alg, n)
+				continue
+			}
+
+			for _, vectors := range []bool{false, true} {
+				var es EigenSym
+				ok := es.FactorizeAlgorithm(s, vectors, alg)
+				if !ok {
+					t.Errorf("alg=%d,n=%d,vectors=%t: factorization failed", alg, n, vectors)
+					continue
+				}
+				if r, c := es.Dims(); r != n || c != n {
+					t.Errorf("alg=%d,n=%d,vectors=%t: unexpected dimensions %d×%d", alg, n, vectors, r, c)
+				}
+				if !floats.EqualApprox(es.Values(nil), want.RawValues(), tol*float64(n)) {
+					t.Errorf("alg=%d,n=%d,vectors=%t: eigenvalue mismatch", alg, n, vectors)
+				}
+				if !vectors {
+					continue
+				}
+				var q Dense
+				es.VectorsTo(&q)
+				if !isOrthonormal(&q, tol*float64(n)) {
+					t.Errorf("alg=%d,n=%d: eigenvectors not orthonormal", alg, n)
+				}
+				if !EqualApprox(s, &es, tol*float64(n)) {
+					t.Errorf("alg=%d,n=%d: A and EigenSym are not equal as Matrix", alg, n)
+				}
+			}
+		}
+	}
+}
+
+func TestEigenSymSubset(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 26, 70} {
+		for cas := 0; cas < 5; cas++ {
+			a := make([]float64, n*n)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			s := NewSymDense(n, a)
+
+			var all EigenSym
+			ok := all.Factorize(s, false)
+			if !ok {
+				t.Errorf("n=%d,cas=%d: bad test", n, cas)
+				continue
+			}
+			values := all.RawValues()
+
+			lo := rnd.IntN(n + 1)
+			hi := lo + rnd.IntN(n-lo+1)
+
+			// Select the interval of values so that it contains the
+			// eigenvalues with indices lo through hi-1.
+			vlo := values[0] - 1
+			switch {
+			case lo == n:
+				vlo = values[n-1] + 1
+			case lo > 0:
+				vlo = (values[lo-1] + values[lo]) / 2
+			}
+			vhi := values[n-1] + 2
+			switch {
+			case lo == hi && lo < n:
+				vhi = (vlo + values[lo]) / 2
+			case hi < n:
+				vhi = (values[hi-1] + values[hi]) / 2
+			}
+
+			for _, vectors := range []bool{false, true} {
+				for _, byValue := range []bool{false, true} {
+					var es EigenSym
+					if byValue {
+						ok = es.FactorizeValue(s, vectors, vlo, vhi)
+					} else {
+						ok = es.FactorizeIndex(s, vectors, lo, hi)
+					}
+					name := fmt.Sprintf("n=%d,cas=%d,lo=%d,hi=%d,vectors=%t,byValue=%t", n, cas, lo, hi, vectors, byValue)
+					if !ok {
+						t.Errorf("%s: factorization failed", name)
+						continue
+					}
+					if r, c := es.Dims(); r != n || c != n {
+						t.Errorf("%s: unexpected dimensions %d×%d", name, r, c)
+					}
+					got := es.Values(nil)
+					if len(got) != hi-lo {
+						t.Errorf("%s: unexpected number of eigenvalues; got %d, want %d", name, len(got), hi-lo)
+						continue
+					}
+					if !floats.EqualApprox(got, values[lo:hi], tol*float64(n)) {
+						t.Errorf("%s: eigenvalue mismatch", name)
+					}
+					if !vectors {
+						if es.RawQ() != nil {
+							t.Errorf("%s: unexpected non-nil RawQ", name)
+						}
+						continue
+					}
+
+					var q Dense
+					es.VectorsTo(&q)
+					if len(got) == 0 {
+						if !q.IsEmpty() {
+							t.Errorf("%s: unexpected non-empty eigenvector matrix", name)
+						}
+						if es.RawQ() != nil {
+							t.Errorf("%s: unexpected non-nil RawQ", name)
+						}
+						continue
+					}
+					if r, c := q.Dims(); r != n || c != hi-lo {
+						t.Errorf("%s: unexpected eigenvector matrix dimensions %d×%d", name, r, c)
+						continue
+					}
+					var qtq Dense
+					qtq.Mul(q.T(), &q)
+					if !EqualApprox(&qtq, eye(len(got)), tol*float64(n)) {
+						t.Errorf("%s: eigenvectors not orthonormal", name)
+					}
+
+					// Check that A * Q = Q * Λ.
+					var aq, ql Dense
+					aq.Mul(s, &q)
+					ql.Mul(&q, NewDiagDense(len(got), got))
+					if !EqualApprox(&aq, &ql, tol*float64(n)) {
+						t.Errorf("%s: A * Q != Q * Λ", name)
+					}
+
+					// Check that EigenSym represents Q * Λ * Qᵀ.
+					var want Dense
+					want.Product(&q, NewDiagDense(len(got), got), q.T())
+					if !EqualApprox(&want, &es, tol*float64(n)) {
+						t.Errorf("%s: EigenSym is not Q * Λ * Qᵀ", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestEigenSymBand(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 26, 70} {
+		for _, k := range []int{0, 1, 2, n / 3, n - 1} {
+			if k >= n {
+				continue
+			}
+			data := make([]float64, n*(k+1))
+			for i := range data {
+				data[i] = rnd.NormFloat64()
+			}
+			a := NewSymBandDense(n, k, data)
+
+			var es EigenSym
+			ok := es.Factorize(a, false)
+			if !ok {
+				t.Errorf("n=%d,k=%d: bad test", n, k)
+				continue
+			}
+			values := es.RawValues()
+
+			lo := rnd.IntN(n + 1)
+			hi := lo + rnd.IntN(n-lo+1)
+
+			// Select the interval of values so that it contains the
+			// eigenvalues with indices lo through hi-1.
+			vlo := values[0] - 1
+			switch {
+			case lo == n:
+				vlo = values[n-1] + 1
+			case lo > 0:
+				vlo = (values[lo-1] + values[lo]) / 2
+			}
+			vhi := values[n-1] + 2
+			switch {
+			case lo == hi && lo < n:
+				vhi = (vlo + values[lo]) / 2
+			case hi < n:
+				vhi = (values[hi-1] + values[hi]) / 2
+			}
+
+			for _, vectors := range []bool{false, true} {
+				for _, rng := range []string{"all", "index", "value"} {
+					name := fmt.Sprintf("n=%d,k=%d,lo=%d,hi=%d,vectors=%t,range=%s", n, k, lo, hi, vectors, rng)
+
+					var eb EigenSymBand
+					first, last := 0, n
+					switch rng {
+					case "all":
+						ok = eb.Factorize(a, vectors)
+					case "index":
+						ok = eb.FactorizeIndex(a, vectors, lo, hi)
+						first, last = lo, hi
+					case "value":
+						ok = eb.FactorizeValue(a, vectors, vlo, vhi)
+						first, last = lo, hi
+					}
+					if !ok {
+						t.Errorf("%s: factorization failed", name)
+						continue
+					}
+					got := eb.Values(nil)
+					if len(got) != last-first {
+						t.Errorf("%s: unexpected number of eigenvalues; got %d, want %d", name, len(got), last-first)
+						continue
+					}
+					if !floats.EqualApprox(got, values[first:last], tol*float64(n)) {
+						t.Errorf("%s: eigenvalue mismatch with EigenSym", name)
+					}
+					if !vectors {
+						continue
+					}
+
+					var q Dense
+					eb.VectorsTo(&q)
+					if len(got) == 0 {
+						if !q.IsEmpty() {
+							t.Errorf("%s: unexpected non-empty eigenvector matrix", name)
+						}
+						if es.RawQ() != nil {
+							t.Errorf("%s: unexpected non-nil RawQ", name)
+						}
+						continue
+					}
+					if r, c := q.Dims(); r != n || c != last-first {
+						t.Errorf("%s: unexpected eigenvector matrix dimensions %d×%d", name, r, c)
+						continue
+					}
+					var qtq Dense
+					qtq.Mul(q.T(), &q)
+					if !EqualApprox(&qtq, eye(len(got)), tol*float64(n)) {
+						t.Errorf("%s: eigenvectors not orthonormal", name)
+					}
+
+					// Check that A * Q = Q * Λ.
+					var aq, ql Dense
+					aq.Mul(a, &q)
+					ql.Mul(&q, NewDiagDense(len(got), got))
+					if !EqualApprox(&aq, &ql, tol*float64(n)) {
+						t.Errorf("%s: A * Q != Q * Λ", name)
+					}
+				}
+			}
+		}
+	}
+}
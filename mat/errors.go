@@ -137,6 +137,8 @@ var (
 	ErrSliceLengthMismatch = Error{"mat: input slice length mismatch"}
 	ErrNotPSD              = Error{"mat: input not positive symmetric definite"}
 	ErrFailedEigen         = Error{"mat: eigendecomposition not successful"}
+	ErrNegativeEigenvalue  = Error{"mat: matrix has a negative real eigenvalue"}
+	ErrNoConvergence       = Error{"mat: iteration failed to converge"}
 )
 
 // ErrorStack represents matrix handling errors that have been recovered by Maybe wrappers.
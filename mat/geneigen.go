@@ -0,0 +1,399 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// GeneralizedEigen is a type for creating and using the generalized eigenvalue
+// decomposition of a pair of dense matrices (A,B).
+type GeneralizedEigen struct {
+	n int // The size of the factorized matrices.
+
+	kind EigenKind
+
+	alpha    []complex128
+	beta     []float64
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (ge *GeneralizedEigen) succFact() bool {
+	return ge.n != 0
+}
+
+// Factorize computes the generalized eigenvalues of the pair of square
+// matrices (A,B), and optionally the eigenvectors.
+//
+// A generalized eigenvalue of (A,B) is a scalar λ such that A - λ*B is
+// singular. It is represented as the ratio α/β of a complex number α and a
+// non-negative real number β. An eigenvalue with β = 0 is infinite and
+// corresponds to a singular B.
+//
+// A right eigenvalue/eigenvector combination is defined by
+//
+//	β * A * x_r = α * B * x_r
+//
+// and a left eigenvalue/eigenvector combination is defined by
+//
+//	β * x_lᴴ * A = α * x_lᴴ * B
+//
+// where x_lᴴ is the conjugate transpose of x_l.
+//
+// In all cases, Factorize computes the eigenvalues of the matrix pair. kind
+// specifies which of the eigenvectors, if any, to compute. See the EigenKind
+// documentation for more information.
+// Factorize panics if the input matrices are not square or do not have the
+// same size.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (ge *GeneralizedEigen) Factorize(a, b Matrix, kind EigenKind) (ok bool) {
+	// kill previous factorization.
+	ge.n = 0
+	ge.kind = 0
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != r || bc != c {
+		panic(ErrShape)
+	}
+	// Copy a and b because they are modified during the Lapack call.
+	var sa, sb Dense
+	sa.CloneFrom(a)
+	sb.CloneFrom(b)
+
+	left := kind&EigenLeft != 0
+	right := kind&EigenRight != 0
+
+	var vl, vr Dense
+	jobvl := lapack.LeftEVNone
+	jobvr := lapack.RightEVNone
+	if left {
+		vl = *NewDense(r, r, nil)
+		jobvl = lapack.LeftEVCompute
+	}
+	if right {
+		vr = *NewDense(c, c, nil)
+		jobvr = lapack.RightEVCompute
+	}
+
+	alphar := getFloat64s(c, false)
+	defer putFloat64s(alphar)
+	alphai := getFloat64s(c, false)
+	defer putFloat64s(alphai)
+	beta := make([]float64, c)
+
+	work := []float64{0}
+	lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	_, ok = lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, len(work))
+	putFloat64s(work)
+
+	if !ok {
+		ge.alpha = nil
+		ge.beta = nil
+		return false
+	}
+	ge.n = r
+	ge.kind = kind
+
+	// Construct complex alpha from float64 data.
+	alpha := make([]complex128, r)
+	for i, v := range alphar {
+		alpha[i] = complex(v, alphai[i])
+	}
+	ge.alpha = alpha
+	ge.beta = beta
+
+	// Construct complex eigenvectors from float64 data.
+	if left {
+		ge.lVectors = NewCDense(r, r, nil)
+		complexEigenTo(ge.lVectors, &vl, alpha)
+	} else {
+		ge.lVectors = nil
+	}
+	if right {
+		ge.rVectors = NewCDense(c, c, nil)
+		complexEigenTo(ge.rVectors, &vr, alpha)
+	} else {
+		ge.rVectors = nil
+	}
+	return true
+}
+
+// Kind returns the EigenKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (ge *GeneralizedEigen) Kind() EigenKind {
+	if !ge.succFact() {
+		return -1
+	}
+	return ge.kind
+}
+
+// Alphas extracts the numerators α of the generalized eigenvalues of the
+// factorized matrix pair. If dst is non-nil, the values are stored in-place
+// into dst. In this case dst must have length n, otherwise Alphas will panic.
+// If dst is nil, then a new slice will be allocated of the proper length and
+// filled with the values.
+//
+// Alphas panics if the decomposition was not successful.
+func (ge *GeneralizedEigen) Alphas(dst []complex128) []complex128 {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, ge.n)
+	}
+	if len(dst) != ge.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, ge.alpha)
+	return dst
+}
+
+// Betas extracts the non-negative denominators β of the generalized
+// eigenvalues of the factorized matrix pair. If dst is non-nil, the values
+// are stored in-place into dst. In this case dst must have length n,
+// otherwise Betas will panic. If dst is nil, then a new slice will be
+// allocated of the proper length and filled with the values.
+//
+// Betas panics if the decomposition was not successful.
+func (ge *GeneralizedEigen) Betas(dst []float64) []float64 {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, ge.n)
+	}
+	if len(dst) != ge.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, ge.beta)
+	return dst
+}
+
+// Values extracts the generalized eigenvalues α/β of the factorized matrix
+// pair. An eigenvalue with β = 0 is returned as complex infinity, unless
+// α is also zero, in which case the pair is singular and the eigenvalue is
+// returned as complex NaN. Since the ratio α/β may over- or underflow, Alphas
+// and Betas should be used when the eigenvalues may be badly scaled.
+//
+// If dst is non-nil, the values are stored in-place into dst. In this case
+// dst must have length n, otherwise Values will panic. If dst is nil, then a
+// new slice will be allocated of the proper length and filled with the
+// eigenvalues.
+//
+// Values panics if the decomposition was not successful.
+func (ge *GeneralizedEigen) Values(dst []complex128) []complex128 {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, ge.n)
+	}
+	if len(dst) != ge.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	for i, a := range ge.alpha {
+		b := ge.beta[i]
+		switch {
+		case b != 0:
+			dst[i] = a / complex(b, 0)
+		case a != 0:
+			dst[i] = cmplx.Inf()
+		default:
+			dst[i] = cmplx.NaN()
+		}
+	}
+	return dst
+}
+
+// VectorsTo stores the right generalized eigenvectors of the decomposition
+// into the columns of dst. Each computed eigenvector is normalized so that
+// its largest component has |real part| + |imag. part| = 1.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (ge *GeneralizedEigen) VectorsTo(dst *CDense) {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if ge.kind&EigenRight == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(ge.n, ge.n)
+	} else {
+		r, c := dst.Dims()
+		if r != ge.n || c != ge.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(ge.rVectors)
+}
+
+// LeftVectorsTo stores the left generalized eigenvectors of the decomposition
+// into the columns of dst. Each computed eigenvector is normalized so that
+// its largest component has |real part| + |imag. part| = 1.
+//
+// If dst is empty, LeftVectorsTo will resize dst to be n×n. When dst is
+// non-empty, LeftVectorsTo will panic if dst is not n×n. LeftVectorsTo will also
+// panic if the left eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (ge *GeneralizedEigen) LeftVectorsTo(dst *CDense) {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if ge.kind&EigenLeft == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(ge.n, ge.n)
+	} else {
+		r, c := dst.Dims()
+		if r != ge.n || c != ge.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(ge.lVectors)
+}
+
+// GeneralizedEigenSym is a type for computing all generalized eigenvalues and,
+// optionally, eigenvectors of a symmetric-definite matrix pair (A,B), where A
+// is symmetric and B is symmetric positive definite.
+type GeneralizedEigenSym struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *Dense
+}
+
+// Factorize computes the generalized eigendecomposition of the
+// symmetric-definite matrix pair (A,B), that is the real eigenvalues λ and
+// eigenvectors x that satisfy
+//
+//	A * x = λ * B * x.
+//
+// The decomposition is computed by reducing the problem to a standard
+// symmetric eigenvalue problem using the Cholesky factorization B = Uᵀ * U,
+//
+//	C = U⁻ᵀ * A * U⁻¹,
+//
+// and computing the spectral factorization C = Y * Λ * Yᵀ. The generalized
+// eigenvectors are the columns of X = U⁻¹ * Y and are normalized so that
+//
+//	Xᵀ * B * X = I.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo will panic.
+//
+// Factorize panics if A and B do not have the same size. Factorize returns
+// whether the factorization succeeded. It fails if B is not positive
+// definite. If it returns false, methods that require a successful
+// factorization will panic.
+func (ge *GeneralizedEigenSym) Factorize(a, b Symmetric, vectors bool) (ok bool) {
+	// kill previous decomposition
+	ge.vectorsComputed = false
+	ge.values = nil
+	ge.vectors = nil
+
+	n := a.SymmetricDim()
+	if b.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+
+	var chol Cholesky
+	if !chol.Factorize(b) {
+		return false
+	}
+	u := chol.chol.mat
+
+	// Form C = U⁻ᵀ * A * U⁻¹. Only the upper triangle of C is referenced
+	// by the symmetric eigensolver.
+	c := NewDense(n, n, nil)
+	c.Copy(a)
+	blas64.Trsm(blas.Left, blas.Trans, 1, u, c.mat)
+	blas64.Trsm(blas.Right, blas.NoTrans, 1, u, c.mat)
+
+	var eig EigenSym
+	if !eig.Factorize(NewSymDense(n, c.mat.Data), vectors) {
+		return false
+	}
+	ge.values = eig.values
+	if vectors {
+		// Back-transform the eigenvectors, X = U⁻¹ * Y.
+		x := eig.vectors
+		blas64.Trsm(blas.Left, blas.NoTrans, 1, u, x.mat)
+		ge.vectors = x
+	}
+	ge.vectorsComputed = vectors
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (ge *GeneralizedEigenSym) succFact() bool {
+	return len(ge.values) != 0
+}
+
+// Values extracts the generalized eigenvalues of the factorized n×n matrix
+// pair (A,B) in ascending order.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length equal to n.
+//
+// If the receiver does not contain a successful factorization, Values will
+// panic.
+func (ge *GeneralizedEigenSym) Values(dst []float64) []float64 {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(ge.values))
+	}
+	if len(dst) != len(ge.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, ge.values)
+	return dst
+}
+
+// VectorsTo stores the B-orthonormal generalized eigenvectors of the
+// factorized n×n matrix pair (A,B) into the columns of dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
+// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
+// eigenvectors were not computed during the factorization, or if the receiver
+// does not contain a successful factorization.
+func (ge *GeneralizedEigenSym) VectorsTo(dst *Dense) {
+	if !ge.succFact() {
+		panic(badFact)
+	}
+	if !ge.vectorsComputed {
+		panic(noVectors)
+	}
+	r, c := ge.vectors.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(ge.vectors)
+}
@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestGeneralizedEigen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	// Hand coded test with a singular B.
+	a := NewDense(3, 3, []float64{
+		1, 0, 0,
+		0, 2, 0,
+		0, 0, 3,
+	})
+	b := NewDense(3, 3, []float64{
+		2, 0, 0,
+		0, 4, 0,
+		0, 0, 0,
+	})
+	var ge GeneralizedEigen
+	if !ge.Factorize(a, b, EigenNone) {
+		t.Fatal("unexpected factorization failure")
+	}
+	var nInf int
+	for _, v := range ge.Values(nil) {
+		switch {
+		case cmplx.IsInf(v):
+			nInf++
+		case cmplx.Abs(v-0.5) > tol:
+			t.Errorf("unexpected finite eigenvalue: got %v, want 0.5", v)
+		}
+	}
+	if nInf != 1 {
+		t.Errorf("unexpected number of infinite eigenvalues: got %d, want 1", nInf)
+	}
+	if ge.Kind() != EigenNone {
+		t.Errorf("unexpected kind: got %v, want %v", ge.Kind(), EigenNone)
+	}
+
+	// Randomized tests.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for cas := 0; cas < 5; cas++ {
+			a := NewDense(n, n, nil)
+			b := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+
+			var both, none GeneralizedEigen
+			if !both.Factorize(a, b, EigenBoth) {
+				t.Errorf("n=%d,cas=%d: unexpected factorization failure", n, cas)
+				continue
+			}
+			if !none.Factorize(a, b, EigenNone) {
+				t.Errorf("n=%d,cas=%d: unexpected factorization failure without vectors", n, cas)
+				continue
+			}
+			alpha := both.Alphas(nil)
+			beta := both.Betas(nil)
+			if !cmplxEqualTol(alpha, none.Alphas(nil), tol) || !floats.EqualApprox(beta, none.Betas(nil), tol) {
+				t.Errorf("n=%d,cas=%d: eigenvalue mismatch when no vectors computed", n, cas)
+			}
+			values := both.Values(nil)
+			for j, v := range values {
+				if cmplx.Abs(v*complex(beta[j], 0)-alpha[j]) > tol*cmplx.Abs(alpha[j]) {
+					t.Errorf("n=%d,cas=%d: eigenvalue %d does not match alpha/beta", n, cas, j)
+				}
+			}
+
+			var vr, vl CDense
+			both.VectorsTo(&vr)
+			both.LeftVectorsTo(&vl)
+			if resid := genEigenResidual(a, b, &vr, alpha, beta, false); resid > tol {
+				t.Errorf("n=%d,cas=%d: unexpected right eigenvector residual: %v", n, cas, resid)
+			}
+			if resid := genEigenResidual(a, b, &vl, alpha, beta, true); resid > tol {
+				t.Errorf("n=%d,cas=%d: unexpected left eigenvector residual: %v", n, cas, resid)
+			}
+
+			var right GeneralizedEigen
+			right.Factorize(a, b, EigenRight)
+			var vr2 CDense
+			right.VectorsTo(&vr2)
+			if !CEqualApprox(&vr, &vr2, tol) {
+				t.Errorf("n=%d,cas=%d: right eigenvectors computed alone do not match", n, cas)
+			}
+			if p, _ := panics(func() { right.LeftVectorsTo(&CDense{}) }); !p {
+				t.Errorf("n=%d,cas=%d: expected panic for left vectors not computed", n, cas)
+			}
+		}
+	}
+}
+
+// genEigenResidual returns the maximum over all columns v of V of
+//
+//	|β*A*v - α*B*v| / ((|β|*|A| + |α|*|B|) * |v|)
+//
+// or, if left is true,
+//
+//	|β*vᴴ*A - α*vᴴ*B| / ((|β|*|A| + |α|*|B|) * |v|).
+//
+// All norms are max-abs norms.
+func genEigenResidual(a, b Matrix, v *CDense, alpha []complex128, beta []float64, left bool) float64 {
+	n, _ := a.Dims()
+	anorm := Norm(a, math.Inf(1))
+	bnorm := Norm(b, math.Inf(1))
+	var resid float64
+	for j := 0; j < n; j++ {
+		al := alpha[j]
+		if left {
+			// vᴴ*A = λ*vᴴ*B is equivalent to Aᵀ*v = conj(λ)*Bᵀ*v for
+			// real A and B.
+			al = cmplx.Conj(al)
+		}
+		bt := complex(beta[j], 0)
+		var rnorm, vnorm float64
+		for i := 0; i < n; i++ {
+			vnorm = math.Max(vnorm, cmplx.Abs(v.At(i, j)))
+			var av, bv complex128
+			for k := 0; k < n; k++ {
+				aik, bik := a.At(i, k), b.At(i, k)
+				if left {
+					aik, bik = a.At(k, i), b.At(k, i)
+				}
+				av += complex(aik, 0) * v.At(k, j)
+				bv += complex(bik, 0) * v.At(k, j)
+			}
+			rnorm = math.Max(rnorm, cmplx.Abs(bt*av-al*bv))
+		}
+		denom := (math.Abs(beta[j])*anorm + cmplx.Abs(al)*bnorm) * vnorm
+		if denom == 0 {
+			continue
+		}
+		resid = math.Max(resid, rnorm/denom)
+	}
+	return resid
+}
+
+func TestGeneralizedEigenSym(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+
+	// B not positive definite.
+	var ges GeneralizedEigenSym
+	if ges.Factorize(NewSymDense(2, []float64{1, 0, 0, 1}), NewSymDense(2, []float64{1, 0, 0, -1}), true) {
+		t.Error("unexpected success with indefinite B")
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for cas := 0; cas < 5; cas++ {
+			a := NewSymDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					a.SetSym(i, j, rnd.NormFloat64())
+				}
+			}
+			// Construct a well-conditioned positive definite B.
+			g := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					g.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			b := NewSymDense(n, nil)
+			b.SymOuterK(1, g)
+			for i := 0; i < n; i++ {
+				b.SetSym(i, i, b.At(i, i)+float64(n))
+			}
+
+			var ges GeneralizedEigenSym
+			if !ges.Factorize(a, b, true) {
+				t.Errorf("n=%d,cas=%d: unexpected factorization failure", n, cas)
+				continue
+			}
+			values := ges.Values(nil)
+			if !sort.Float64sAreSorted(values) {
+				t.Errorf("n=%d,cas=%d: eigenvalues not sorted", n, cas)
+			}
+			var x Dense
+			ges.VectorsTo(&x)
+
+			// Check that A*X = B*X*Λ.
+			var ax, bx Dense
+			ax.Mul(a, &x)
+			bx.Mul(b, &x)
+			bx.Mul(&bx, NewDiagDense(n, values))
+			if !EqualApprox(&ax, &bx, tol) {
+				t.Errorf("n=%d,cas=%d: A*X != B*X*Λ", n, cas)
+			}
+
+			// Check that Xᵀ*B*X = I.
+			var xbx Dense
+			xbx.Product(x.T(), b, &x)
+			if !EqualApprox(&xbx, eye(n), tol) {
+				t.Errorf("n=%d,cas=%d: eigenvectors are not B-orthonormal", n, cas)
+			}
+
+			// Check that the eigenvalues agree with the general decomposition.
+			var ge GeneralizedEigen
+			if !ge.Factorize(a, b, EigenNone) {
+				t.Errorf("n=%d,cas=%d: unexpected failure of general decomposition", n, cas)
+				continue
+			}
+			want := make([]float64, n)
+			for i, v := range ge.Values(nil) {
+				if math.Abs(imag(v)) > tol*math.Max(1, cmplx.Abs(v)) {
+					t.Errorf("n=%d,cas=%d: unexpected complex eigenvalue %v", n, cas, v)
+				}
+				want[i] = real(v)
+			}
+			sort.Float64s(want)
+			if !floats.EqualApprox(values, want, tol) {
+				t.Errorf("n=%d,cas=%d: eigenvalues do not match general decomposition", n, cas)
+			}
+
+			var valsOnly GeneralizedEigenSym
+			valsOnly.Factorize(a, b, false)
+			if !floats.EqualApprox(valsOnly.Values(nil), values, tol) {
+				t.Errorf("n=%d,cas=%d: eigenvalue mismatch when no vectors computed", n, cas)
+			}
+			if p, _ := panics(func() { valsOnly.VectorsTo(&Dense{}) }); !p {
+				t.Errorf("n=%d,cas=%d: expected panic for vectors not computed", n, cas)
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// LSE solves the linear equality-constrained least squares problem
+//
+//	minimize |c - A*x|_2  subject to  B*x = d
+//
+// placing the solution x into dst, where A is an m×n matrix, B is a p×n matrix,
+// c is a vector of length m and d is a vector of length p. The problem must
+// satisfy p <= n <= m+p. It has a unique solution if B has full row rank p
+// and the matrix [A; B] obtained by stacking A on top of B has full column
+// rank n.
+//
+// The solution is computed using a generalized RQ factorization of B and A.
+// If B or [A; B] is singular or near-singular, a Condition error is returned
+// with the larger of the condition numbers of the triangular factors of the
+// factorization. See the documentation for Condition for more information.
+// The solution is stored into dst unless the factors are exactly singular.
+//
+// LSE will panic if the dimensions of the arguments are not compatible, if
+// p > n or if n > m+p.
+func LSE(dst *VecDense, a, b Matrix, c, d Vector) error {
+	m, n := a.Dims()
+	p, bc := b.Dims()
+	if bc != n || c.Len() != m || d.Len() != p {
+		panic(ErrShape)
+	}
+	if p > n || n > m+p {
+		panic(ErrShape)
+	}
+
+	wa := getDenseWorkspace(m, n, false)
+	defer putDenseWorkspace(wa)
+	wa.Copy(a)
+	wb := getDenseWorkspace(p, n, false)
+	defer putDenseWorkspace(wb)
+	wb.Copy(b)
+	wc := getVectorData(c)
+	defer putFloat64s(wc)
+	wd := getVectorData(d)
+	defer putFloat64s(wd)
+	x := getFloat64s(n, false)
+	defer putFloat64s(x)
+
+	work := []float64{0}
+	lapack64.Gglse(wa.mat, wb.mat, wc, wd, x, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	defer putFloat64s(work)
+	ok := lapack64.Gglse(wa.mat, wb.mat, wc, wd, x, work, len(work))
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	dst.reuseAsNonZeroed(n)
+	dst.CopyVec(NewVecDense(n, x))
+
+	// The solution is obtained from the p×p upper triangular factor of B
+	// stored in its last p columns and the (n-p)×(n-p) upper triangular
+	// factor of A stored in its leading columns.
+	cond := upperTriCond(wb.slice(0, p, n-p, n))
+	if n > p {
+		cond = math.Max(cond, upperTriCond(wa.slice(0, n-p, 0, n-p)))
+	}
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// GLM solves the general Gauss-Markov linear model problem
+//
+//	minimize |y|_2  subject to  d = A*x + B*y
+//	   x
+//
+// placing the solution into x and y, where A is an n×m matrix, B is an n×p
+// matrix and d is a vector of length n. The problem must satisfy m <= n <= m+p.
+// It has a unique solution x and a minimal norm solution y if A has full
+// column rank m and the matrix [A B] has full row rank n.
+//
+// If B is square and nonsingular, the problem is equivalent to the weighted
+// linear least squares problem
+//
+//	minimize |B⁻¹*(d - A*x)|_2,
+//	   x
+//
+// so GLM solves least squares problems with correlated errors whose
+// covariance matrix is B*Bᵀ without forming B⁻¹.
+//
+// The solution is computed using a generalized QR factorization of A and B.
+// If A or [A B] is singular or near-singular, a Condition error is returned
+// with the larger of the condition numbers of the triangular factors of the
+// factorization. See the documentation for Condition for more information.
+// The solution is stored into x and y unless the factors are exactly singular.
+//
+// GLM will panic if the dimensions of the arguments are not compatible, if
+// m > n or if n > m+p.
+func GLM(x, y *VecDense, a, b Matrix, d Vector) error {
+	n, m := a.Dims()
+	br, p := b.Dims()
+	if br != n || d.Len() != n {
+		panic(ErrShape)
+	}
+	if m > n || n > m+p {
+		panic(ErrShape)
+	}
+
+	wa := getDenseWorkspace(n, m, false)
+	defer putDenseWorkspace(wa)
+	wa.Copy(a)
+	wb := getDenseWorkspace(n, p, false)
+	defer putDenseWorkspace(wb)
+	wb.Copy(b)
+	wd := getVectorData(d)
+	defer putFloat64s(wd)
+	wx := getFloat64s(m, false)
+	defer putFloat64s(wx)
+	wy := getFloat64s(p, false)
+	defer putFloat64s(wy)
+
+	work := []float64{0}
+	lapack64.Ggglm(wa.mat, wb.mat, wd, wx, wy, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	defer putFloat64s(work)
+	ok := lapack64.Ggglm(wa.mat, wb.mat, wd, wx, wy, work, len(work))
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	x.reuseAsNonZeroed(m)
+	x.CopyVec(NewVecDense(m, wx))
+	y.reuseAsNonZeroed(p)
+	y.CopyVec(NewVecDense(p, wy))
+
+	// The solution is obtained from the m×m upper triangular factor of A
+	// and the (n-m)×(n-m) upper triangular factor of B stored in the trailing
+	// rows and columns of B.
+	cond := upperTriCond(wa.slice(0, m, 0, m))
+	if n > m {
+		cond = math.Max(cond, upperTriCond(wb.slice(m, n, m+p-n, p)))
+	}
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// getVectorData returns a slice obtained from the pool holding the elements of v.
+// The slice should be returned to the pool with putFloat64s.
+func getVectorData(v Vector) []float64 {
+	n := v.Len()
+	s := getFloat64s(n, false)
+	if rv, ok := v.(RawVectorer); ok {
+		blasv := rv.RawVector()
+		for i := range s {
+			s[i] = blasv.Data[i*blasv.Inc]
+		}
+		return s
+	}
+	for i := range s {
+		s[i] = v.AtVec(i)
+	}
+	return s
+}
+
+// upperTriCond returns an estimate of the condition number of the upper
+// triangle of the square matrix a.
+func upperTriCond(a *Dense) float64 {
+	n, _ := a.Dims()
+	work := getFloat64s(3*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Trcon(CondNorm, a.asTriDense(n, blas.NonUnit, blas.Upper).mat, work, iwork)
+	return 1 / v
+}
@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestLSE(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, p int
+	}{
+		{1, 1, 1},
+		{3, 2, 1},
+		{5, 5, 2},
+		{2, 4, 2},
+		{10, 6, 3},
+		{20, 15, 15},
+		{30, 20, 5},
+	} {
+		m, n, p := test.m, test.n, test.p
+		name := fmt.Sprintf("m=%d,n=%d,p=%d", m, n, p)
+		a := randNormDense(m, n, rnd)
+		b := randNormDense(p, n, rnd)
+		c := NewVecDense(m, nil)
+		for i := 0; i < m; i++ {
+			c.SetVec(i, rnd.NormFloat64())
+		}
+		d := NewVecDense(p, nil)
+		for i := 0; i < p; i++ {
+			d.SetVec(i, rnd.NormFloat64())
+		}
+		aCopy := DenseCopyOf(a)
+		bCopy := DenseCopyOf(b)
+
+		var x VecDense
+		err := LSE(&x, a, b, c, d)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !Equal(a, aCopy) || !Equal(b, bCopy) {
+			t.Errorf("%s: input matrices modified", name)
+		}
+
+		// The solution satisfies the KKT system
+		//  [ Aᵀ*A Bᵀ ] [ x ] = [ Aᵀ*c ]
+		//  [  B   0  ] [ λ ]   [  d   ].
+		kkt := NewDense(n+p, n+p, nil)
+		kkt.slice(0, n, 0, n).Mul(a.T(), a)
+		kkt.slice(n, n+p, 0, n).Copy(b)
+		kkt.slice(0, n, n, n+p).Copy(b.T())
+		rhs := NewVecDense(n+p, nil)
+		rhs.SliceVec(0, n).(*VecDense).MulVec(a.T(), c)
+		rhs.SliceVec(n, n+p).(*VecDense).CopyVec(d)
+		var want VecDense
+		if err := want.SolveVec(kkt, rhs); err != nil {
+			t.Fatalf("%s: unexpected error solving KKT system: %v", name, err)
+		}
+		if !EqualApprox(&x, want.SliceVec(0, n), tol) {
+			t.Errorf("%s: unexpected solution:\ngot  %v\nwant %v", name, Formatted(x.T()), Formatted(want.SliceVec(0, n).T()))
+		}
+
+		var bx VecDense
+		bx.MulVec(b, &x)
+		if !EqualApprox(&bx, d, tol) {
+			t.Errorf("%s: constraint not satisfied", name)
+		}
+	}
+
+	// Rank deficient constraint matrix.
+	b := NewDense(2, 3, []float64{1, 2, 3, 2, 4, 6})
+	var x VecDense
+	err := LSE(&x, eye(3), b, NewVecDense(3, nil), NewVecDense(2, []float64{1, 2}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for rank deficient B: got %v, want Condition error", err)
+	}
+
+	panicked, message := panics(func() {
+		LSE(&x, eye(3), NewDense(4, 3, nil), NewVecDense(3, nil), NewVecDense(4, nil))
+	})
+	if !panicked || message != ErrShape.Error() {
+		t.Errorf("expected panic for p > n, got %q", message)
+	}
+}
+
+func TestGLM(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, m, p int
+	}{
+		{1, 1, 1},
+		{3, 2, 1},
+		{5, 5, 2},
+		{4, 2, 4},
+		{10, 6, 10},
+		{20, 15, 8},
+		{30, 20, 35},
+	} {
+		n, m, p := test.n, test.m, test.p
+		name := fmt.Sprintf("n=%d,m=%d,p=%d", n, m, p)
+		a := randNormDense(n, m, rnd)
+		b := randNormDense(n, p, rnd)
+		d := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			d.SetVec(i, rnd.NormFloat64())
+		}
+
+		var x, y VecDense
+		err := GLM(&x, &y, a, b, d)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		// The solution satisfies the KKT system
+		//  [ A  B*Bᵀ ] [ x ] = [ d ]
+		//  [ 0   Aᵀ  ] [ λ ]   [ 0 ],
+		// with y = Bᵀ*λ.
+		kkt := NewDense(n+m, m+n, nil)
+		kkt.slice(0, n, 0, m).Copy(a)
+		kkt.slice(0, n, m, m+n).Mul(b, b.T())
+		kkt.slice(n, n+m, m, m+n).Copy(a.T())
+		rhs := NewVecDense(n+m, nil)
+		rhs.SliceVec(0, n).(*VecDense).CopyVec(d)
+		var sol VecDense
+		if err := sol.SolveVec(kkt, rhs); err != nil {
+			t.Fatalf("%s: unexpected error solving KKT system: %v", name, err)
+		}
+		if !EqualApprox(&x, sol.SliceVec(0, m), tol) {
+			t.Errorf("%s: unexpected x:\ngot  %v\nwant %v", name, Formatted(x.T()), Formatted(sol.SliceVec(0, m).T()))
+		}
+		var wantY VecDense
+		wantY.MulVec(b.T(), sol.SliceVec(m, m+n))
+		if !EqualApprox(&y, &wantY, tol) {
+			t.Errorf("%s: unexpected y:\ngot  %v\nwant %v", name, Formatted(y.T()), Formatted(wantY.T()))
+		}
+
+		var res, by VecDense
+		res.MulVec(a, &x)
+		by.MulVec(b, &y)
+		res.AddVec(&res, &by)
+		if !EqualApprox(&res, d, tol) {
+			t.Errorf("%s: constraint not satisfied", name)
+		}
+	}
+
+	// Rank deficient A.
+	a := NewDense(3, 2, []float64{1, 2, 2, 4, 3, 6})
+	var x, y VecDense
+	err := GLM(&x, &y, a, eye(3), NewVecDense(3, []float64{1, 2, 3}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for rank deficient A: got %v, want Condition error", err)
+	}
+
+	panicked, message := panics(func() {
+		GLM(&x, &y, NewDense(2, 3, nil), eye(2), NewVecDense(2, nil))
+	})
+	if !panicked || message != ErrShape.Error() {
+		t.Errorf("expected panic for m > n, got %q", message)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+// dlamchE is the machine epsilon for float64. For IEEE this is 2^{-53}.
+const dlamchE = 0x1p-53
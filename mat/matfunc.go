@@ -0,0 +1,1030 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Sqrt calculates the principal square root of the matrix a, placing the
+// result in the receiver. The principal square root is the unique square root
+// whose eigenvalues all have positive real part. It exists and is real when a
+// has no real negative eigenvalues and at most a simple zero eigenvalue.
+//
+// The implementation uses the real Schur method of Higham, "Computing real
+// square roots of a real matrix", Linear Algebra Appl. 88/89 (1987).
+//
+// Sqrt returns ErrNegativeEigenvalue if a has a real negative eigenvalue,
+// ErrSingular if a has a repeated zero eigenvalue, and ErrFailedEigen if the
+// Schur decomposition of a fails. In these cases the receiver is not modified.
+// Sqrt will panic with ErrShape if a is not square.
+func (m *Dense) Sqrt(a Matrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+
+	var s Schur
+	if !s.Factorize(a) {
+		return ErrFailedEigen
+	}
+	var rt Dense
+	err := sqrtQuasiTri(&rt, s.t)
+	if err != nil {
+		return err
+	}
+	m.schurProduct(s.z, &rt)
+	return nil
+}
+
+// Log calculates the principal logarithm of the matrix a, placing the result
+// in the receiver. The principal logarithm is the unique logarithm whose
+// eigenvalues have imaginary parts in the open interval (-π, π). It exists and
+// is real when a has no eigenvalues on the closed negative real axis.
+//
+// The implementation uses the inverse scaling and squaring method applied to
+// the real Schur form of a, from Functions of Matrices: Theory and Computation
+// Chapter 11, https://doi.org/10.1137/1.9780898717778.ch11.
+//
+// Log returns ErrNegativeEigenvalue if a has a real negative eigenvalue,
+// ErrSingular if a is singular, and ErrFailedEigen if the Schur decomposition
+// of a fails. In these cases the receiver is not modified.
+// Log will panic with ErrShape if a is not square.
+func (m *Dense) Log(a Matrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	var s Schur
+	if !s.Factorize(a) {
+		return ErrFailedEigen
+	}
+	for _, v := range s.values {
+		if imag(v) != 0 {
+			continue
+		}
+		switch {
+		case real(v) < 0:
+			return ErrNegativeEigenvalue
+		case real(v) == 0:
+			return ErrSingular
+		}
+	}
+
+	// Take repeated square roots of T until it is close enough to the
+	// identity for the Padé approximant to be accurate, so that
+	//  log(T) = 2^k log(T^{1/2^k}).
+	const (
+		theta      = 0.25
+		padeDegree = 8
+		maxSqrt    = 64
+	)
+	t := s.t
+	w := &Dense{}
+	var k int
+	for normMinusIdentity(t) > theta {
+		if k == maxSqrt {
+			return ErrNoConvergence
+		}
+		err := sqrtQuasiTri(w, t)
+		if err != nil {
+			return err
+		}
+		t, w = w, t
+		k++
+	}
+
+	// Evaluate the [8/8] Padé approximant to log(I+X) at X = T - I using its
+	// partial fraction form
+	//  r(X) = Σ_j w_j * X * (I + x_j*X)^{-1}
+	// where x_j and w_j are the nodes and weights of the Gauss-Legendre
+	// quadrature rule on [0, 1].
+	x := NewDense(n, n, nil)
+	x.Copy(t)
+	for i := 0; i < n; i++ {
+		x.set(i, i, x.at(i, i)-1)
+	}
+	f := NewDense(n, n, nil)
+	d := NewDense(n, n, nil)
+	var y Dense
+	nodes, weights := gaussLegendre(padeDegree)
+	for j, xj := range nodes {
+		d.Scale(xj, x)
+		for i := 0; i < n; i++ {
+			d.set(i, i, d.at(i, i)+1)
+		}
+		_ = y.Solve(d, x)
+		addScaled(f, weights[j], &y)
+	}
+	f.Scale(math.Ldexp(1, k), f)
+
+	m.schurProduct(s.z, f)
+	return nil
+}
+
+// Sin calculates the sine of the matrix a, placing the result in the receiver.
+// Sin will panic with ErrShape if a is not square.
+func (m *Dense) Sin(a Matrix) {
+	sinCos(m, nil, a)
+}
+
+// Cos calculates the cosine of the matrix a, placing the result in the
+// receiver. Cos will panic with ErrShape if a is not square.
+func (m *Dense) Cos(a Matrix) {
+	sinCos(nil, m, a)
+}
+
+// sinCos calculates the sine and cosine of a, placing the results in sin and
+// cos respectively if they are not nil.
+//
+// The implementation evaluates truncated Taylor series of the sine and cosine
+// of a scaled so that its norm is at most 1/2, and recovers the functions of a
+// using the double angle formulas
+//
+//	sin(2A) = 2 sin(A) cos(A)
+//	cos(2A) = 2 cos(A)² - I.
+func sinCos(sin, cos *Dense, a Matrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	// With |B|_1 <= 1/2 the first neglected Taylor terms are of order
+	// 2^{-18}/18! < 1e-21.
+	const (
+		theta = 0.5
+		terms = 9
+	)
+
+	b := NewDense(n, n, nil)
+	b.Copy(a)
+	var s int
+	if norm := Norm(b, 1); norm > theta {
+		s = int(math.Ceil(math.Log2(norm / theta)))
+		b.Scale(math.Ldexp(1, -s), b)
+	}
+	needSin := sin != nil
+
+	var b2 Dense
+	b2.Mul(b, b)
+
+	// Evaluate
+	//  cos(B) = Σ_k (-1)^k B^{2k}/(2k)!
+	//  sin(B) = B Σ_k (-1)^k B^{2k}/(2k+1)!
+	// using Horner's method in B².
+	var cosCoef, sinCoef [terms]float64
+	fact := 1.0
+	for k := 0; k < terms; k++ {
+		if k > 0 {
+			fact *= float64(2 * k)
+		}
+		cosCoef[k] = 1 / fact
+		fact *= float64(2*k + 1)
+		sinCoef[k] = 1 / fact
+		if k%2 == 1 {
+			cosCoef[k] = -cosCoef[k]
+			sinCoef[k] = -sinCoef[k]
+		}
+	}
+	horner := func(dst *Dense, coef []float64) {
+		dst.reuseAsZeroed(n, n)
+		for i := 0; i < n; i++ {
+			dst.set(i, i, coef[len(coef)-1])
+		}
+		for k := len(coef) - 2; k >= 0; k-- {
+			dst.Mul(dst, &b2)
+			for i := 0; i < n; i++ {
+				dst.set(i, i, dst.at(i, i)+coef[k])
+			}
+		}
+	}
+
+	cs := &Dense{}
+	if cos != nil || s > 0 {
+		horner(cs, cosCoef[:])
+	}
+	sn := &Dense{}
+	if needSin {
+		horner(sn, sinCoef[:])
+		sn.Mul(b, sn)
+	}
+
+	var work Dense
+	for ; s > 0; s-- {
+		if needSin {
+			work.Mul(sn, cs)
+			sn.Scale(2, &work)
+		}
+		if cos != nil || s > 1 {
+			work.Mul(cs, cs)
+			cs.Scale(2, &work)
+			for i := 0; i < n; i++ {
+				cs.set(i, i, cs.at(i, i)-1)
+			}
+		}
+	}
+
+	if sin != nil {
+		sin.reuseAsNonZeroed(n, n)
+		sin.Copy(sn)
+	}
+	if cos != nil {
+		cos.reuseAsNonZeroed(n, n)
+		cos.Copy(cs)
+	}
+}
+
+// Sign calculates the matrix sign function of a, placing the result in the
+// receiver. The matrix sign function maps each eigenvalue of a to 1 or -1
+// according to the sign of its real part, and is defined when a has no
+// eigenvalues on the imaginary axis.
+//
+// The implementation uses the Newton iteration with determinantal scaling
+// from Functions of Matrices: Theory and Computation Chapter 5,
+// https://doi.org/10.1137/1.9780898717778.ch5.
+//
+// Sign returns ErrSingular if a singular iterate is encountered, which
+// happens when a is singular, and ErrNoConvergence if the iteration fails to
+// converge, which may happen when a has eigenvalues on or close to the
+// imaginary axis. In these cases the receiver is not modified.
+// Sign will panic with ErrShape if a is not square.
+func (m *Dense) Sign(a Matrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	const maxIter = 100
+	tol := math.Sqrt(float64(n) * dlamchE)
+
+	eye := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		eye.set(i, i, 1)
+	}
+	x := NewDense(n, n, nil)
+	x.Copy(a)
+	xNew := NewDense(n, n, nil)
+	var (
+		xInv Dense
+		lu   LU
+	)
+	scale := true
+	for k := 0; k < maxIter; k++ {
+		lu.Factorize(x)
+		err := lu.SolveTo(&xInv, false, eye)
+		if c, ok := err.(Condition); ok && math.IsInf(float64(c), 1) {
+			return ErrSingular
+		}
+		mu := 1.0
+		if scale {
+			logDet, _ := lu.LogDet()
+			mu = math.Exp(-logDet / float64(n))
+		}
+
+		// X_{k+1} = (μ*X_k + (μ*X_k)^{-1}) / 2.
+		xNew.Scale(mu/2, x)
+		addScaled(xNew, 1/(2*mu), &xInv)
+
+		x.Sub(xNew, x)
+		diff := Norm(x, 1) / Norm(xNew, 1)
+		x, xNew = xNew, x
+		if diff <= tol {
+			m.reuseAsNonZeroed(n, n)
+			m.Copy(x)
+			return nil
+		}
+		if diff < 1e-2 {
+			// Scaling is no longer beneficial once the iteration
+			// is in its quadratically convergent phase.
+			scale = false
+		}
+	}
+	return ErrNoConvergence
+}
+
+// Func calculates f(a) for a scalar function f using the Schur-Parlett
+// algorithm, placing the result in the receiver.
+//
+// f(k, z) must return the k-th derivative of the scalar function at z, with
+// the function value itself returned for k = 0. The function must be analytic
+// on a region containing the eigenvalues of a, and it must be real on the real
+// axis, that is f(k, conj(z)) must equal conj(f(k, z)), so that f(a) is real.
+// The imaginary part of the computed result, which is zero in exact
+// arithmetic, is discarded. Derivatives of order one and higher are only
+// requested when a has eigenvalues that are close to each other.
+//
+// The implementation follows Davies and Higham, "A Schur-Parlett algorithm for
+// computing matrix functions", SIAM J. Matrix Anal. Appl. 25(2) (2003).
+//
+// Func returns ErrNoConvergence if the Taylor series of f fails to converge on
+// a cluster of close eigenvalues, and ErrFailedEigen if the Schur
+// decomposition of a fails. In these cases the receiver is not modified.
+// Func will panic with ErrShape if a is not square.
+func (m *Dense) Func(a Matrix, f func(k int, z complex128) complex128) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	var s Schur
+	if !s.Factorize(a) {
+		return ErrFailedEigen
+	}
+	t, q := complexSchur(s.t, s.z)
+	blk := parlettBlocks(t, q, n)
+	nb := len(blk) - 1
+
+	// Evaluate f on the diagonal blocks of T.
+	fm := make([]complex128, n*n)
+	for b := 0; b < nb; b++ {
+		if !taylorBlock(fm, t, n, blk[b], blk[b+1], f) {
+			return ErrNoConvergence
+		}
+	}
+
+	// Compute the off-diagonal blocks of F = f(T) using the block Parlett
+	// recurrence. F commutes with T, so each block F_ij solves the
+	// Sylvester equation
+	//  T_ii*F_ij - F_ij*T_jj = F_ii*T_ij - T_ij*F_jj + Σ_k (F_ik*T_kj - T_ik*F_kj)
+	// where the sum is over the blocks strictly between i and j.
+	for j := 1; j < nb; j++ {
+		j0, j1 := blk[j], blk[j+1]
+		for i := j - 1; i >= 0; i-- {
+			i0, i1 := blk[i], blk[i+1]
+			for r := i0; r < i1; r++ {
+				for c := j0; c < j1; c++ {
+					var v complex128
+					for l := i0; l < j0; l++ {
+						v += fm[r*n+l] * t[l*n+c]
+					}
+					for l := i1; l < j1; l++ {
+						v -= t[r*n+l] * fm[l*n+c]
+					}
+					fm[r*n+c] = v
+				}
+			}
+			if !solveTriSylvester(t, fm, n, i0, i1, j0, j1) {
+				return ErrSingular
+			}
+		}
+	}
+
+	// Form f(A) = Q*F*Qᴴ.
+	qm := cblas128.General{Rows: n, Cols: n, Stride: n, Data: q}
+	fg := cblas128.General{Rows: n, Cols: n, Stride: n, Data: fm}
+	tmp := cblas128.General{Rows: n, Cols: n, Stride: n, Data: make([]complex128, n*n)}
+	cblas128.Gemm(blas.NoTrans, blas.NoTrans, 1, qm, fg, 0, tmp)
+	cblas128.Gemm(blas.NoTrans, blas.ConjTrans, 1, tmp, qm, 0, fg)
+	m.reuseAsNonZeroed(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.set(i, j, real(fm[i*n+j]))
+		}
+	}
+	return nil
+}
+
+// ExpFrechet calculates the Fréchet derivative of the matrix exponential at a
+// in the direction e, placing the result in the receiver. The Fréchet
+// derivative L(A, E) is the linear function of E satisfying
+//
+//	e^(A+E) = e^A + L(A, E) + o(‖E‖).
+//
+// The derivative is obtained from the exponential of the block matrix
+//
+//	[A E]
+//	[0 A]
+//
+// whose upper right block is L(A, E). ExpFrechet will panic with ErrShape if
+// a is not square or if e is not the same size as a.
+func (m *Dense) ExpFrechet(a, e Matrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	if er, ec := e.Dims(); er != r || ec != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	// L(A, E) is linear in E, so scale E to have the norm of A to prevent
+	// it from increasing the amount of scaling needed by Exp.
+	scale := 1.0
+	normA := Norm(a, 1)
+	normE := Norm(e, 1)
+	if normE == 0 {
+		m.reuseAsZeroed(n, n)
+		return
+	}
+	if normA > 0 {
+		scale = normA / normE
+	}
+
+	blk := NewDense(2*n, 2*n, nil)
+	blk.slice(0, n, 0, n).Copy(a)
+	blk.slice(n, 2*n, n, 2*n).Copy(a)
+	blk.slice(0, n, n, 2*n).Scale(scale, e)
+	var x Dense
+	x.Exp(blk)
+	m.reuseAsNonZeroed(n, n)
+	m.Scale(1/scale, x.slice(0, n, n, 2*n))
+}
+
+// CondExp returns an estimate of the relative condition number of the matrix
+// exponential at a in the Frobenius norm,
+//
+//	κ(A) = ‖L(A)‖ ‖A‖ / ‖e^A‖,
+//
+// where ‖L(A)‖ is the norm of the Fréchet derivative of the exponential at a
+// as computed by ExpFrechet. The norm of the Fréchet derivative is estimated
+// using the power method.
+// CondExp will panic with ErrShape if a is not square.
+func CondExp(a Matrix) float64 {
+	return condFunc(a, func(dst *Dense, a Matrix) error {
+		dst.Exp(a)
+		return nil
+	})
+}
+
+// CondLog returns an estimate of the relative condition number of the
+// principal matrix logarithm at a in the Frobenius norm,
+//
+//	κ(A) = ‖L(A)‖ ‖A‖ / ‖log(A)‖,
+//
+// where ‖L(A)‖ is the norm of the Fréchet derivative of the logarithm at a.
+// The norm of the Fréchet derivative is estimated using the power method.
+// CondLog returns +Inf if Log returns an error for a or if log(a) is zero.
+// CondLog will panic with ErrShape if a is not square.
+func CondLog(a Matrix) float64 {
+	return condFunc(a, (*Dense).Log)
+}
+
+// CondSqrt returns an estimate of the relative condition number of the
+// principal matrix square root at a in the Frobenius norm,
+//
+//	κ(A) = ‖L(A)‖ ‖A‖ / ‖sqrt(A)‖,
+//
+// where ‖L(A)‖ is the norm of the Fréchet derivative of the square root at a.
+// The norm of the Fréchet derivative is estimated using the power method.
+// CondSqrt returns +Inf if Sqrt returns an error for a.
+// CondSqrt will panic with ErrShape if a is not square.
+func CondSqrt(a Matrix) float64 {
+	return condFunc(a, (*Dense).Sqrt)
+}
+
+// condFunc returns an estimate of the relative condition number in the
+// Frobenius norm of the primary matrix function f at a. f must be a function
+// with a real power series so that the adjoint of its Fréchet derivative at A
+// is its Fréchet derivative at Aᵀ.
+//
+// The norm of the Fréchet derivative is estimated using the power method on
+// L(A)*L(A) from Functions of Matrices: Theory and Computation, Algorithm
+// 3.20, with the Fréchet derivatives computed from f applied to the block
+// matrix [A E; 0 A].
+func condFunc(a Matrix, f func(dst *Dense, a Matrix) error) float64 {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	var fa Dense
+	if f(&fa, a) != nil {
+		return math.Inf(1)
+	}
+	normFA := Norm(&fa, 2)
+	if normFA == 0 {
+		return math.Inf(1)
+	}
+
+	blk := NewDense(2*n, 2*n, nil)
+	var fblk Dense
+	frechet := func(dst *Dense, a, e Matrix) error {
+		blk.slice(0, n, 0, n).Copy(a)
+		blk.slice(n, 2*n, n, 2*n).Copy(a)
+		blk.slice(0, n, n, 2*n).Copy(e)
+		fblk.Reset()
+		err := f(&fblk, blk)
+		if err != nil {
+			return err
+		}
+		dst.reuseAsNonZeroed(n, n)
+		dst.Copy(fblk.slice(0, n, n, 2*n))
+		return nil
+	}
+
+	const (
+		maxIter = 10
+		tol     = 1e-2
+	)
+	z := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			z.set(i, j, 1/float64(n))
+		}
+	}
+	var w Dense
+	var gamma float64
+	for k := 0; k < maxIter; k++ {
+		if frechet(&w, a, z) != nil {
+			return math.Inf(1)
+		}
+		if frechet(z, a.T(), &w) != nil {
+			return math.Inf(1)
+		}
+		normW := Norm(&w, 2)
+		normZ := Norm(z, 2)
+		if normW == 0 || normZ == 0 {
+			break
+		}
+		prev := gamma
+		gamma = normZ / normW
+		z.Scale(1/normZ, z)
+		if math.Abs(gamma-prev) <= tol*gamma {
+			break
+		}
+	}
+	return gamma * Norm(a, 2) / normFA
+}
+
+// schurProduct places Z * T * Zᵀ into the receiver.
+func (m *Dense) schurProduct(z, t *Dense) {
+	n := z.mat.Rows
+	zt := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(zt)
+	zt.Mul(z, t)
+	m.reuseAsNonZeroed(n, n)
+	m.Mul(zt, z.T())
+}
+
+// addScaled adds alpha*a to the receiver. The receiver and a must have the
+// same dimensions.
+func addScaled(m *Dense, alpha float64, a *Dense) {
+	r, c := m.Dims()
+	for i := 0; i < r; i++ {
+		blas64.Implementation().Daxpy(c, alpha, a.mat.Data[i*a.mat.Stride:i*a.mat.Stride+c], 1, m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c], 1)
+	}
+}
+
+// normMinusIdentity returns the 1-norm of T - I.
+func normMinusIdentity(t *Dense) float64 {
+	n := t.mat.Rows
+	var norm float64
+	for j := 0; j < n; j++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			v := t.at(i, j)
+			if i == j {
+				v--
+			}
+			sum += math.Abs(v)
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
+
+// gaussLegendre returns the nodes and weights of the m-point Gauss-Legendre
+// quadrature rule on the interval [0, 1].
+func gaussLegendre(m int) (x, w []float64) {
+	x = make([]float64, m)
+	w = make([]float64, m)
+	for i := 0; i < m; i++ {
+		// Find the i-th root of the Legendre polynomial P_m using
+		// Newton's method.
+		z := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(m) + 0.5))
+		var dp float64
+		for iter := 0; iter < 100; iter++ {
+			p0, p1 := 1.0, z
+			for k := 2; k <= m; k++ {
+				p0, p1 = p1, (float64(2*k-1)*z*p1-float64(k-1)*p0)/float64(k)
+			}
+			dp = float64(m) * (z*p1 - p0) / (z*z - 1)
+			dz := p1 / dp
+			z -= dz
+			if math.Abs(dz) <= 1e-15 {
+				break
+			}
+		}
+		x[i] = (1 - z) / 2
+		w[i] = 1 / ((1 - z*z) * dp * dp)
+	}
+	return x, w
+}
+
+// quasiTriBlocks returns the indices of the first rows of the diagonal blocks
+// of the upper quasi-triangular matrix t, followed by the order of t.
+func quasiTriBlocks(t *Dense) []int {
+	n := t.mat.Rows
+	blk := make([]int, 0, n+1)
+	for i := 0; i < n; {
+		blk = append(blk, i)
+		if i < n-1 && t.at(i+1, i) != 0 {
+			i += 2
+		} else {
+			i++
+		}
+	}
+	return append(blk, n)
+}
+
+// sqrtQuasiTri computes the principal square root of the upper
+// quasi-triangular matrix t in standard Schur form, placing the result in dst.
+// The square root is computed block column by block column, solving a small
+// Sylvester equation for each off-diagonal block.
+func sqrtQuasiTri(dst, t *Dense) error {
+	n := t.mat.Rows
+	dst.reuseAsZeroed(n, n)
+	blk := quasiTriBlocks(t)
+	for j := 0; j < len(blk)-1; j++ {
+		j0, j1 := blk[j], blk[j+1]
+		if j1-j0 == 1 {
+			v := t.at(j0, j0)
+			if v < 0 {
+				return ErrNegativeEigenvalue
+			}
+			dst.set(j0, j0, math.Sqrt(v))
+		} else {
+			// The block has a complex conjugate pair of eigenvalues
+			// θ±iμ. Its principal square root is
+			//  α*I + (T_jj - θ*I) / (2*α)
+			// where α+iβ is the principal square root of θ+iμ.
+			t11, t12 := t.at(j0, j0), t.at(j0, j0+1)
+			t21, t22 := t.at(j0+1, j0), t.at(j0+1, j0+1)
+			theta := (t11 + t22) / 2
+			p := (t11 - t22) / 2
+			mu := math.Sqrt(math.Max(0, -(p*p + t12*t21)))
+			h := math.Hypot(theta, mu)
+			var alpha float64
+			if theta >= 0 {
+				alpha = math.Sqrt((h + theta) / 2)
+			} else {
+				alpha = mu / (2 * math.Sqrt((h-theta)/2))
+			}
+			dst.set(j0, j0, alpha+(t11-theta)/(2*alpha))
+			dst.set(j0, j0+1, t12/(2*alpha))
+			dst.set(j0+1, j0, t21/(2*alpha))
+			dst.set(j0+1, j0+1, alpha+(t22-theta)/(2*alpha))
+		}
+
+		// Solve R_ii*R_ij + R_ij*R_jj = T_ij - Σ_k R_ik*R_kj for the
+		// blocks above the diagonal block.
+		for i := j - 1; i >= 0; i-- {
+			i0, i1 := blk[i], blk[i+1]
+			x := dst.slice(i0, i1, j0, j1)
+			x.Copy(t.slice(i0, i1, j0, j1))
+			if i1 < j0 {
+				blas64.Gemm(blas.NoTrans, blas.NoTrans,
+					-1, dst.slice(i0, i1, i1, j0).mat, dst.slice(i1, j0, j0, j1).mat,
+					1, x.mat)
+			}
+			if !solveSmallSylvester(dst.slice(i0, i1, i0, i1), dst.slice(j0, j1, j0, j1), x, 1) {
+				return ErrSingular
+			}
+		}
+	}
+	return nil
+}
+
+// solveSmallSylvester solves the Sylvester equation
+//
+//	A*X + sgn*X*B = C
+//
+// where A and B are at most 2×2, overwriting c with the solution X. The
+// equation is solved as a linear system of order at most 4 using Gaussian
+// elimination with partial pivoting. solveSmallSylvester returns false if the
+// system is exactly singular.
+func solveSmallSylvester(a, b, c *Dense, sgn float64) (ok bool) {
+	p, q := a.mat.Rows, b.mat.Rows
+	n := p * q
+	var (
+		k [16]float64
+		x [4]float64
+	)
+	for r := 0; r < p; r++ {
+		for s := 0; s < q; s++ {
+			row := r*q + s
+			x[row] = c.at(r, s)
+			for l := 0; l < p; l++ {
+				k[row*n+l*q+s] += a.at(r, l)
+			}
+			for l := 0; l < q; l++ {
+				k[row*n+r*q+l] += sgn * b.at(l, s)
+			}
+		}
+	}
+	for j := 0; j < n; j++ {
+		piv := j
+		for i := j + 1; i < n; i++ {
+			if math.Abs(k[i*n+j]) > math.Abs(k[piv*n+j]) {
+				piv = i
+			}
+		}
+		if k[piv*n+j] == 0 {
+			return false
+		}
+		if piv != j {
+			for l := 0; l < n; l++ {
+				k[j*n+l], k[piv*n+l] = k[piv*n+l], k[j*n+l]
+			}
+			x[j], x[piv] = x[piv], x[j]
+		}
+		for i := j + 1; i < n; i++ {
+			f := k[i*n+j] / k[j*n+j]
+			for l := j; l < n; l++ {
+				k[i*n+l] -= f * k[j*n+l]
+			}
+			x[i] -= f * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		v := x[i]
+		for l := i + 1; l < n; l++ {
+			v -= k[i*n+l] * x[l]
+		}
+		x[i] = v / k[i*n+i]
+	}
+	for r := 0; r < p; r++ {
+		for s := 0; s < q; s++ {
+			c.set(r, s, x[r*q+s])
+		}
+	}
+	return true
+}
+
+// complexSchur returns the complex Schur form and Schur vectors corresponding
+// to the real Schur form t and Schur vectors z, stored as row-major n×n
+// matrices. Each 2×2 diagonal block of t is reduced to upper triangular form
+// by a complex Givens rotation.
+func complexSchur(t, z *Dense) (tc, qc []complex128) {
+	n := t.mat.Rows
+	tc = make([]complex128, n*n)
+	qc = make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			tc[i*n+j] = complex(t.at(i, j), 0)
+			qc[i*n+j] = complex(z.at(i, j), 0)
+		}
+	}
+	for k := n - 1; k > 0; k-- {
+		t21 := real(tc[k*n+k-1])
+		if t21 == 0 {
+			continue
+		}
+		// Construct the rotation
+		//  G = [conj(cs) sn]
+		//      [  -sn    cs]
+		// that annihilates T[k,k-1], with G*[mu; t21] = [r; 0] where
+		// mu + T[k,k] is an eigenvalue of the block.
+		t11, t12, t22 := tc[(k-1)*n+k-1], tc[(k-1)*n+k], tc[k*n+k]
+		p := (t11 - t22) / 2
+		mu := p + cmplx.Sqrt(p*p+t12*complex(t21, 0))
+		r := math.Hypot(cmplx.Abs(mu), t21)
+		cs := mu / complex(r, 0)
+		sn := complex(t21/r, 0)
+		for j := k - 1; j < n; j++ {
+			x, y := tc[(k-1)*n+j], tc[k*n+j]
+			tc[(k-1)*n+j] = cmplx.Conj(cs)*x + sn*y
+			tc[k*n+j] = -sn*x + cs*y
+		}
+		for i := 0; i <= k; i++ {
+			x, y := tc[i*n+k-1], tc[i*n+k]
+			tc[i*n+k-1] = cs*x + sn*y
+			tc[i*n+k] = -sn*x + cmplx.Conj(cs)*y
+		}
+		for i := 0; i < n; i++ {
+			x, y := qc[i*n+k-1], qc[i*n+k]
+			qc[i*n+k-1] = cs*x + sn*y
+			qc[i*n+k] = -sn*x + cmplx.Conj(cs)*y
+		}
+		tc[k*n+k-1] = 0
+	}
+	return tc, qc
+}
+
+// parlettBlocks partitions the eigenvalues on the diagonal of the upper
+// triangular matrix t into clusters of close eigenvalues and reorders the
+// Schur form t and Schur vectors q so that each cluster is contiguous.
+// Eigenvalues closer than 0.1 are placed in the same cluster. parlettBlocks
+// returns the indices of the first rows of the clusters on the diagonal of the
+// reordered t, followed by n.
+func parlettBlocks(t, q []complex128, n int) []int {
+	const delta = 0.1
+
+	cluster := make([]int, n)
+	for i := range cluster {
+		cluster[i] = i
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if cluster[i] == cluster[j] || cmplx.Abs(t[i*n+i]-t[j*n+j]) > delta {
+				continue
+			}
+			from, to := cluster[j], cluster[i]
+			for k, c := range cluster {
+				if c == from {
+					cluster[k] = to
+				}
+			}
+		}
+	}
+
+	// Order the clusters by the mean position of their eigenvalues.
+	sum := make([]float64, n)
+	count := make([]float64, n)
+	for i, c := range cluster {
+		sum[c] += float64(i)
+		count[c]++
+	}
+	key := make([]float64, n)
+	for i, c := range cluster {
+		key[i] = sum[c]/count[c] + float64(c)/float64(n+1)
+	}
+
+	// Move the eigenvalues into position using a bubble sort of adjacent
+	// swaps of the diagonal of t.
+	for swapped := true; swapped; {
+		swapped = false
+		for k := 0; k < n-1; k++ {
+			if key[k] > key[k+1] {
+				swapSchur(t, q, n, k)
+				key[k], key[k+1] = key[k+1], key[k]
+				swapped = true
+			}
+		}
+	}
+
+	blk := []int{0}
+	for k := 1; k < n; k++ {
+		if key[k] != key[k-1] {
+			blk = append(blk, k)
+		}
+	}
+	return append(blk, n)
+}
+
+// swapSchur swaps the adjacent diagonal elements k and k+1 of the complex
+// upper triangular Schur form t, updating the Schur vectors q.
+func swapSchur(t, q []complex128, n, k int) {
+	t11, t22 := t[k*n+k], t[(k+1)*n+k+1]
+	cs, sn := zlartg(t[k*n+k+1], t22-t11)
+	c := complex(cs, 0)
+	for j := k + 2; j < n; j++ {
+		x, y := t[k*n+j], t[(k+1)*n+j]
+		t[k*n+j] = c*x + sn*y
+		t[(k+1)*n+j] = c*y - cmplx.Conj(sn)*x
+	}
+	for i := 0; i < k; i++ {
+		x, y := t[i*n+k], t[i*n+k+1]
+		t[i*n+k] = c*x + cmplx.Conj(sn)*y
+		t[i*n+k+1] = c*y - sn*x
+	}
+	t[k*n+k], t[(k+1)*n+k+1] = t22, t11
+	for i := 0; i < n; i++ {
+		x, y := q[i*n+k], q[i*n+k+1]
+		q[i*n+k] = c*x + cmplx.Conj(sn)*y
+		q[i*n+k+1] = c*y - sn*x
+	}
+}
+
+// zlartg generates a plane rotation with real cosine cs and complex sine sn
+// such that
+//
+//	[    cs     sn] * [f] = [r]
+//	[-conj(sn)  cs]   [g]   [0]
+func zlartg(f, g complex128) (cs float64, sn complex128) {
+	if g == 0 {
+		return 1, 0
+	}
+	if f == 0 {
+		return 0, cmplx.Conj(g) / complex(cmplx.Abs(g), 0)
+	}
+	fa := cmplx.Abs(f)
+	d := math.Hypot(fa, cmplx.Abs(g))
+	return fa / d, f / complex(fa, 0) * cmplx.Conj(g) / complex(d, 0)
+}
+
+// taylorBlock evaluates f on the diagonal block T[i0:i1,i0:i1] of the upper
+// triangular matrix t, placing the result in the corresponding block of fm.
+// Blocks of order greater than one are evaluated using the Taylor series of f
+// about the mean of their eigenvalues. taylorBlock returns false if the
+// Taylor series fails to converge.
+func taylorBlock(fm, t []complex128, n, i0, i1 int, f func(k int, z complex128) complex128) (ok bool) {
+	p := i1 - i0
+	if p == 1 {
+		fm[i0*n+i0] = f(0, t[i0*n+i0])
+		return true
+	}
+
+	const maxTerms = 250
+
+	var sigma complex128
+	for i := i0; i < i1; i++ {
+		sigma += t[i*n+i]
+	}
+	sigma /= complex(float64(p), 0)
+
+	// m = T_ii - σ*I, pow = m^k/k! and fb = Σ_k f^(k)(σ) * m^k/k!.
+	m := make([]complex128, p*p)
+	pow := make([]complex128, p*p)
+	fb := make([]complex128, p*p)
+	tmp := make([]complex128, p*p)
+	f0 := f(0, sigma)
+	for i := 0; i < p; i++ {
+		for j := i; j < p; j++ {
+			m[i*p+j] = t[(i0+i)*n+i0+j]
+		}
+		m[i*p+i] -= sigma
+		pow[i*p+i] = 1
+		fb[i*p+i] = f0
+	}
+	normInf := func(a []complex128) float64 {
+		var norm float64
+		for i := 0; i < p; i++ {
+			var sum float64
+			for _, v := range a[i*p : i*p+p] {
+				sum += cmplx.Abs(v)
+			}
+			norm = math.Max(norm, sum)
+		}
+		return norm
+	}
+
+	var small int
+	for k := 1; k < maxTerms; k++ {
+		// pow = pow*m/k exploiting the upper triangular structure.
+		for i := 0; i < p; i++ {
+			for j := i; j < p; j++ {
+				var v complex128
+				for l := i; l <= j; l++ {
+					v += pow[i*p+l] * m[l*p+j]
+				}
+				tmp[i*p+j] = v / complex(float64(k), 0)
+			}
+		}
+		pow, tmp = tmp, pow
+		dk := f(k, sigma)
+		for i := 0; i < p; i++ {
+			for j := i; j < p; j++ {
+				fb[i*p+j] += dk * pow[i*p+j]
+			}
+		}
+		if cmplx.Abs(dk)*normInf(pow) <= dlamchE*normInf(fb) {
+			small++
+			if small >= 2 && k >= p {
+				for i := 0; i < p; i++ {
+					copy(fm[(i0+i)*n+i0:(i0+i)*n+i1], fb[i*p:i*p+p])
+				}
+				return true
+			}
+		} else {
+			small = 0
+		}
+	}
+	return false
+}
+
+// solveTriSylvester solves the Sylvester equation
+//
+//	T_ii*X - X*T_jj = C
+//
+// where T_ii = T[i0:i1,i0:i1] and T_jj = T[j0:j1,j0:j1] are diagonal blocks of
+// the upper triangular matrix t and C is stored in fm[i0:i1,j0:j1]. C is
+// overwritten with the solution X. solveTriSylvester returns false if the
+// equation is singular.
+func solveTriSylvester(t, fm []complex128, n, i0, i1, j0, j1 int) (ok bool) {
+	for c := j0; c < j1; c++ {
+		// Solve (T_ii - T[c,c]*I)*x_c = C_c + Σ_{l<c} x_l*T[l,c].
+		for l := j0; l < c; l++ {
+			tlc := t[l*n+c]
+			for r := i0; r < i1; r++ {
+				fm[r*n+c] += fm[r*n+l] * tlc
+			}
+		}
+		tcc := t[c*n+c]
+		for r := i1 - 1; r >= i0; r-- {
+			v := fm[r*n+c]
+			for l := r + 1; l < i1; l++ {
+				v -= t[r*n+l] * fm[l*n+c]
+			}
+			d := t[r*n+r] - tcc
+			if d == 0 {
+				return false
+			}
+			fm[r*n+c] = v / d
+		}
+	}
+	return true
+}
@@ -0,0 +1,389 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// matFuncTestMatrices returns a set of test matrices with no eigenvalues on
+// the closed negative real axis, including nonsymmetric matrices with complex
+// eigenvalues and matrices with repeated eigenvalues.
+func matFuncTestMatrices(rnd *rand.Rand) []*Dense {
+	var mats []*Dense
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 20} {
+		// Random nonsymmetric matrix shifted to the right half plane.
+		a := NewDense(n, n, nil)
+		a.Apply(func(i, j int, _ float64) float64 {
+			v := rnd.NormFloat64() / math.Sqrt(float64(n))
+			if i == j {
+				v += 2
+			}
+			return v
+		}, a)
+		mats = append(mats, a)
+
+		// Random symmetric positive definite matrix.
+		b := NewDense(n, n, nil)
+		b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+		var spd Dense
+		spd.Mul(b, b.T())
+		for i := 0; i < n; i++ {
+			spd.Set(i, i, spd.At(i, i)+1)
+		}
+		mats = append(mats, &spd)
+	}
+	// Rotation-like matrix with complex eigenvalues 1±2i.
+	mats = append(mats, NewDense(2, 2, []float64{1, -2, 2, 1}))
+	// Jordan block with a repeated eigenvalue.
+	mats = append(mats, NewDense(3, 3, []float64{
+		3, 1, 0,
+		0, 3, 1,
+		0, 0, 3,
+	}))
+	// Matrix with eigenvalues of very different magnitude.
+	mats = append(mats, NewDense(3, 3, []float64{
+		1e-3, 1, 2,
+		0, 1, 3,
+		0, 0, 1e2,
+	}))
+	return mats
+}
+
+func TestSqrt(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for cas, a := range matFuncTestMatrices(rnd) {
+		var x Dense
+		err := x.Sqrt(a)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", cas, err)
+			continue
+		}
+		var got Dense
+		got.Mul(&x, &x)
+		if !EqualApprox(&got, a, tol*Norm(a, 1)) {
+			t.Errorf("case %d: X*X != A\nX*X=%v\nA=%v", cas, Formatted(&got), Formatted(a))
+		}
+		var ev Eigen
+		ev.Factorize(&x, EigenNone)
+		for _, v := range ev.Values(nil) {
+			if real(v) < 0 {
+				t.Errorf("case %d: square root is not principal: eigenvalue %v", cas, v)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		a    *Dense
+		want error
+	}{
+		{a: NewDense(2, 2, []float64{-1, 0, 0, 2}), want: ErrNegativeEigenvalue},
+		{a: NewDense(2, 2, []float64{0, 0, 0, 0}), want: ErrSingular},
+	} {
+		var x Dense
+		err := x.Sqrt(test.a)
+		if err != test.want {
+			t.Errorf("unexpected error for A=%v: got %v, want %v", Formatted(test.a), err, test.want)
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for cas, a := range matFuncTestMatrices(rnd) {
+		var l Dense
+		err := l.Log(a)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", cas, err)
+			continue
+		}
+		var got Dense
+		got.Exp(&l)
+		if !EqualApprox(&got, a, tol*Norm(a, 1)) {
+			t.Errorf("case %d: exp(log(A)) != A\nexp(log(A))=%v\nA=%v", cas, Formatted(&got), Formatted(a))
+		}
+	}
+
+	// log(exp(B)) == B when the eigenvalues of B have imaginary parts in
+	// (-π, π).
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		b := NewDense(n, n, nil)
+		b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() / float64(n) }, b)
+		var e, got Dense
+		e.Exp(b)
+		err := got.Log(&e)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		if !EqualApprox(&got, b, tol) {
+			t.Errorf("n=%d: log(exp(B)) != B", n)
+		}
+	}
+
+	for _, test := range []struct {
+		a    *Dense
+		want error
+	}{
+		{a: NewDense(2, 2, []float64{-1, 0, 0, 2}), want: ErrNegativeEigenvalue},
+		{a: NewDense(2, 2, []float64{0, 1, 0, 2}), want: ErrSingular},
+	} {
+		var x Dense
+		err := x.Log(test.a)
+		if err != test.want {
+			t.Errorf("unexpected error for A=%v: got %v, want %v", Formatted(test.a), err, test.want)
+		}
+	}
+}
+
+func TestSinCos(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		for _, scale := range []float64{0.1, 1, 10} {
+			name := fmt.Sprintf("n=%d,scale=%v", n, scale)
+			a := NewDense(n, n, nil)
+			a.Apply(func(_, _ int, _ float64) float64 { return scale * rnd.NormFloat64() / float64(n) }, a)
+
+			var sin, cos Dense
+			sin.Sin(a)
+			cos.Cos(a)
+
+			// sin(A)² + cos(A)² = I.
+			var s2, c2 Dense
+			s2.Mul(&sin, &sin)
+			c2.Mul(&cos, &cos)
+			s2.Add(&s2, &c2)
+			if !EqualApprox(&s2, eye(n), tol*math.Max(1, Norm(&c2, 1))) {
+				t.Errorf("%s: sin(A)²+cos(A)² != I", name)
+			}
+
+			// Compare with the Schur-Parlett algorithm.
+			var want Dense
+			err := want.Func(a, func(k int, z complex128) complex128 {
+				switch k % 4 {
+				case 0:
+					return cmplx.Sin(z)
+				case 1:
+					return cmplx.Cos(z)
+				case 2:
+					return -cmplx.Sin(z)
+				default:
+					return -cmplx.Cos(z)
+				}
+			})
+			if err != nil {
+				t.Errorf("%s: unexpected error from Func: %v", name, err)
+				continue
+			}
+			if !EqualApprox(&sin, &want, tol*math.Max(1, Norm(&want, 1))) {
+				t.Errorf("%s: sin(A) does not match Func", name)
+			}
+		}
+	}
+
+	// Scalar values.
+	for _, v := range []float64{-3, -0.2, 0, 0.4, 2, 7} {
+		a := NewDense(1, 1, []float64{v})
+		var sin, cos Dense
+		sin.Sin(a)
+		cos.Cos(a)
+		if !scalar.EqualWithinAbsOrRel(sin.At(0, 0), math.Sin(v), 1e-14, 1e-14) {
+			t.Errorf("unexpected sin(%v): got %v, want %v", v, sin.At(0, 0), math.Sin(v))
+		}
+		if !scalar.EqualWithinAbsOrRel(cos.At(0, 0), math.Cos(v), 1e-14, 1e-14) {
+			t.Errorf("unexpected cos(%v): got %v, want %v", v, cos.At(0, 0), math.Cos(v))
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		a := NewDense(n, n, nil)
+		a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+
+		var s Dense
+		err := s.Sign(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+
+		// sign(A)² = I.
+		var s2 Dense
+		s2.Mul(&s, &s)
+		if !EqualApprox(&s2, eye(n), tol*Norm(&s, 1)) {
+			t.Errorf("n=%d: sign(A)² != I", n)
+		}
+		// sign(A) commutes with A.
+		var sa, as Dense
+		sa.Mul(&s, a)
+		as.Mul(a, &s)
+		if !EqualApprox(&sa, &as, tol*Norm(&sa, 1)) {
+			t.Errorf("n=%d: sign(A)*A != A*sign(A)", n)
+		}
+		// The trace of sign(A) is the number of eigenvalues in the right
+		// half plane minus the number in the left half plane.
+		var ev Eigen
+		ev.Factorize(a, EigenNone)
+		var want float64
+		for _, v := range ev.Values(nil) {
+			if real(v) > 0 {
+				want++
+			} else {
+				want--
+			}
+		}
+		if got := Trace(&s); math.Abs(got-want) > tol*float64(n) {
+			t.Errorf("n=%d: unexpected trace of sign(A): got %v, want %v", n, got, want)
+		}
+	}
+
+	var s Dense
+	err := s.Sign(NewDense(2, 2, []float64{1, 0, 0, 0}))
+	if err != ErrSingular {
+		t.Errorf("unexpected error for singular matrix: got %v, want %v", err, ErrSingular)
+	}
+}
+
+func TestFunc(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	exp := func(_ int, z complex128) complex128 { return cmplx.Exp(z) }
+	sqrt := func(k int, z complex128) complex128 {
+		// The k-th derivative of z^{1/2}.
+		c := complex(1, 0)
+		for j := 0; j < k; j++ {
+			c *= complex(0.5-float64(j), 0)
+		}
+		return c * cmplx.Pow(z, complex(0.5-float64(k), 0))
+	}
+	for cas, a := range matFuncTestMatrices(rnd) {
+		var got, want Dense
+		err := got.Func(a, exp)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", cas, err)
+			continue
+		}
+		want.Exp(a)
+		if !EqualApprox(&got, &want, tol*Norm(&want, 1)) {
+			t.Errorf("case %d: Func(exp) does not match Exp\ngot: %v\nwant:%v", cas, Formatted(&got), Formatted(&want))
+		}
+
+		err = got.Func(a, sqrt)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", cas, err)
+			continue
+		}
+		err = want.Sqrt(a)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", cas, err)
+			continue
+		}
+		if !EqualApprox(&got, &want, tol*Norm(&want, 1)) {
+			t.Errorf("case %d: Func(sqrt) does not match Sqrt\ngot: %v\nwant:%v", cas, Formatted(&got), Formatted(&want))
+		}
+	}
+}
+
+func TestExpFrechet(t *testing.T) {
+	t.Parallel()
+	const (
+		h   = 1e-6
+		tol = 1e-6
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		for _, scale := range []float64{0.1, 1, 5} {
+			name := fmt.Sprintf("n=%d,scale=%v", n, scale)
+			a := NewDense(n, n, nil)
+			a.Apply(func(_, _ int, _ float64) float64 { return scale * rnd.NormFloat64() }, a)
+			e := NewDense(n, n, nil)
+			e.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, e)
+
+			var l Dense
+			l.ExpFrechet(a, e)
+
+			// Compare with a central finite difference.
+			var ap, am, expP, expM, fd Dense
+			ap.Scale(h, e)
+			ap.Add(a, &ap)
+			am.Scale(-h, e)
+			am.Add(a, &am)
+			expP.Exp(&ap)
+			expM.Exp(&am)
+			fd.Sub(&expP, &expM)
+			fd.Scale(1/(2*h), &fd)
+			if !EqualApprox(&l, &fd, tol*Norm(&fd, 1)) {
+				t.Errorf("%s: Fréchet derivative does not match finite difference", name)
+			}
+
+			// L(A, A) = A*e^A since A commutes with itself.
+			var want Dense
+			want.Exp(a)
+			want.Mul(a, &want)
+			l.ExpFrechet(a, a)
+			if !EqualApprox(&l, &want, 1e-10*Norm(&want, 1)) {
+				t.Errorf("%s: L(A, A) != A*e^A", name)
+			}
+		}
+	}
+}
+
+func TestCondMatFunc(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-2
+
+	// For a symmetric matrix the norm of the Fréchet derivative of the
+	// exponential is the largest eigenvalue of e^A.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := randSymDense(n, rnd)
+		var ev EigenSym
+		ev.Factorize(a, false)
+		var maxExp float64
+		for _, v := range ev.Values(nil) {
+			maxExp = math.Max(maxExp, math.Exp(v))
+		}
+		var e Dense
+		e.Exp(a)
+		want := maxExp * Norm(a, 2) / Norm(&e, 2)
+		got := CondExp(a)
+		if !scalar.EqualWithinRel(got, want, tol) {
+			t.Errorf("n=%d: unexpected CondExp: got %v, want %v", n, got, want)
+		}
+	}
+
+	// For a positive diagonal matrix D the norm of the Fréchet derivative of
+	// the logarithm is 1/min(D) and of the square root is 1/(2*sqrt(min(D))).
+	d := NewDiagDense(4, []float64{0.5, 2, 3, 10})
+	var logD, sqrtD Dense
+	_ = logD.Log(d)
+	_ = sqrtD.Sqrt(d)
+	if got, want := CondLog(d), 2*Norm(d, 2)/Norm(&logD, 2); !scalar.EqualWithinRel(got, want, tol) {
+		t.Errorf("unexpected CondLog: got %v, want %v", got, want)
+	}
+	if got, want := CondSqrt(d), 1/(2*math.Sqrt(0.5))*Norm(d, 2)/Norm(&sqrtD, 2); !scalar.EqualWithinRel(got, want, tol) {
+		t.Errorf("unexpected CondSqrt: got %v, want %v", got, want)
+	}
+	if got := CondLog(NewDense(2, 2, []float64{-1, 0, 0, 1})); !math.IsInf(got, 1) {
+		t.Errorf("unexpected CondLog for matrix without real logarithm: got %v, want +Inf", got)
+	}
+}
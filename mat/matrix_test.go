@@ -736,6 +736,15 @@ func TestMulVecToer(t *testing.T) {
 		NewTridiag(4, random(3), random(4), random(3)),
 		NewTridiag(7, random(6), random(7), random(6)),
 		NewTridiag(10, random(9), random(10), random(9)),
+		NewToeplitz([]float64{1.5}, []float64{1.5}),
+		NewToeplitz(append([]float64{0.5}, random(6)...), append([]float64{0.5}, random(6)...)),
+		NewToeplitz(append([]float64{-2}, random(9)...), append([]float64{-2}, random(9)...)),
+		NewSymToeplitz(random(1)),
+		NewSymToeplitz(random(8)),
+		NewCirculant(random(1)),
+		NewCirculant(random(2)),
+		NewCirculant(random(7)),
+		NewCirculant(random(10)),
 	} {
 		// Dense copy of A used for computing the expected result.
 		var aDense Dense
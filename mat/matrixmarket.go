@@ -0,0 +1,515 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MatrixMarketFormat is the storage format of a Matrix Market file.
+type MatrixMarketFormat string
+
+const (
+	// MatrixMarketArray stores all elements of a matrix, or the lower
+	// triangle of a matrix with symmetry, in column-major order.
+	MatrixMarketArray MatrixMarketFormat = "array"
+	// MatrixMarketCoordinate stores the row and column indices and the
+	// values of the non-zero elements of a matrix.
+	MatrixMarketCoordinate MatrixMarketFormat = "coordinate"
+)
+
+// MatrixMarketField is the type of the elements of a Matrix Market file.
+type MatrixMarketField string
+
+const (
+	MatrixMarketReal    MatrixMarketField = "real"
+	MatrixMarketInteger MatrixMarketField = "integer"
+	MatrixMarketComplex MatrixMarketField = "complex"
+	// MatrixMarketPattern stores only the positions of the non-zero
+	// elements. It is only valid with the coordinate format and the
+	// elements are read as ones.
+	MatrixMarketPattern MatrixMarketField = "pattern"
+)
+
+// MatrixMarketSymmetry is the symmetry structure of a Matrix Market file.
+// Matrices with symmetry only store the elements in the lower triangle.
+type MatrixMarketSymmetry string
+
+const (
+	MatrixMarketGeneral       MatrixMarketSymmetry = "general"
+	MatrixMarketSymmetric     MatrixMarketSymmetry = "symmetric"
+	MatrixMarketSkewSymmetric MatrixMarketSymmetry = "skew-symmetric"
+	// MatrixMarketHermitian is only valid with the complex field.
+	MatrixMarketHermitian MatrixMarketSymmetry = "hermitian"
+)
+
+// MatrixMarketHeader describes how a matrix is stored in a Matrix Market
+// file. Empty fields of a header passed to WriteMatrixMarket or
+// WriteMatrixMarketComplex mean MatrixMarketArray, MatrixMarketReal (or
+// MatrixMarketComplex) and MatrixMarketGeneral, respectively.
+type MatrixMarketHeader struct {
+	Format   MatrixMarketFormat
+	Field    MatrixMarketField
+	Symmetry MatrixMarketSymmetry
+}
+
+const matrixMarketBanner = "%%MatrixMarket"
+
+var errMatrixMarketSymmetry = errors.New("mat: matrix does not have the requested symmetry")
+
+// ReadMatrixMarket reads a real matrix in the Matrix Market exchange format
+// from r. Both the array and coordinate formats are supported with the real,
+// integer and pattern fields and with general, symmetric and skew-symmetric
+// structure. Elements of pattern matrices are set to one and duplicate
+// elements in coordinate files are summed.
+//
+// Symmetric matrices are returned as a *SymDense and all other matrices as a
+// *Dense. Complex matrices must be read with ReadMatrixMarketComplex.
+//
+// ReadMatrixMarket only returns an error for matrices with more elements than
+// the largest slice length on the platform. It does not otherwise limit the
+// size of the matrix, and so it should not be used on untrusted data.
+func ReadMatrixMarket(r io.Reader) (Matrix, MatrixMarketHeader, error) {
+	p, h, err := newMarketParser(r)
+	if err != nil {
+		return nil, h, err
+	}
+	if h.Field == MatrixMarketComplex {
+		return nil, h, errors.New("mat: complex Matrix Market data in real matrix")
+	}
+	var m Matrix
+	var set func(i, j int, v complex128)
+	if h.Symmetry == MatrixMarketSymmetric {
+		s := NewSymDense(p.rows, nil)
+		set = func(i, j int, v complex128) { s.set(i, j, s.at(i, j)+real(v)) }
+		m = s
+	} else {
+		d := NewDense(p.rows, p.cols, nil)
+		set = func(i, j int, v complex128) {
+			d.set(i, j, d.at(i, j)+real(v))
+			if i != j && h.Symmetry == MatrixMarketSkewSymmetric {
+				d.set(j, i, d.at(j, i)-real(v))
+			}
+		}
+		m = d
+	}
+	err = p.read(set)
+	if err != nil {
+		return nil, h, err
+	}
+	return m, h, nil
+}
+
+// ReadMatrixMarketComplex reads a matrix in the Matrix Market exchange format
+// from r and returns it as a *CDense. All fields and symmetry structures are
+// supported, real matrices are read with zero imaginary parts. See
+// ReadMatrixMarket for details.
+//
+// As with ReadMatrixMarket, the size of the matrix is only limited by the
+// largest slice length on the platform, and so ReadMatrixMarketComplex should
+// not be used on untrusted data.
+func ReadMatrixMarketComplex(r io.Reader) (*CDense, MatrixMarketHeader, error) {
+	p, h, err := newMarketParser(r)
+	if err != nil {
+		return nil, h, err
+	}
+	m := NewCDense(p.rows, p.cols, nil)
+	err = p.read(func(i, j int, v complex128) {
+		m.set(i, j, m.at(i, j)+v)
+		if i == j {
+			return
+		}
+		switch h.Symmetry {
+		case MatrixMarketSymmetric:
+			m.set(j, i, m.at(j, i)+v)
+		case MatrixMarketSkewSymmetric:
+			m.set(j, i, m.at(j, i)-v)
+		case MatrixMarketHermitian:
+			m.set(j, i, m.at(j, i)+complex(real(v), -imag(v)))
+		}
+	})
+	if err != nil {
+		return nil, h, err
+	}
+	return m, h, nil
+}
+
+// marketParser reads the data lines of a Matrix Market file.
+type marketParser struct {
+	sc   *bufio.Scanner
+	line int
+
+	h          MatrixMarketHeader
+	rows, cols int
+	nnz        int
+}
+
+// newMarketParser reads the banner, the comments and the size line of a
+// Matrix Market file.
+func newMarketParser(r io.Reader) (*marketParser, MatrixMarketHeader, error) {
+	p := &marketParser{sc: bufio.NewScanner(r)}
+	p.sc.Buffer(nil, 1<<20)
+
+	banner, err := p.next()
+	if err != nil {
+		return nil, MatrixMarketHeader{}, err
+	}
+	f := strings.Fields(strings.ToLower(banner))
+	if len(f) != 5 || f[0] != strings.ToLower(matrixMarketBanner) || f[1] != "matrix" {
+		return nil, MatrixMarketHeader{}, p.errorf("invalid Matrix Market banner %q", banner)
+	}
+	h := MatrixMarketHeader{
+		Format:   MatrixMarketFormat(f[2]),
+		Field:    MatrixMarketField(f[3]),
+		Symmetry: MatrixMarketSymmetry(f[4]),
+	}
+	err = h.check()
+	if err != nil {
+		return nil, h, err
+	}
+	p.h = h
+
+	var size string
+	for {
+		size, err = p.next()
+		if err != nil {
+			return nil, h, err
+		}
+		if !strings.HasPrefix(size, "%") {
+			break
+		}
+	}
+	f = strings.Fields(size)
+	want := 2
+	if h.Format == MatrixMarketCoordinate {
+		want = 3
+	}
+	if len(f) != want {
+		return nil, h, p.errorf("invalid size line %q", size)
+	}
+	dims := make([]int, want)
+	for i, s := range f {
+		dims[i], err = strconv.Atoi(s)
+		if err != nil || dims[i] < 0 {
+			return nil, h, p.errorf("invalid size line %q", size)
+		}
+	}
+	p.rows, p.cols = dims[0], dims[1]
+	if want == 3 {
+		p.nnz = dims[2]
+	}
+	if p.rows == 0 || p.cols == 0 {
+		return nil, h, ErrZeroLength
+	}
+	if int64(p.rows) > maxLen/int64(p.cols) {
+		return nil, h, errTooBig
+	}
+	if h.Symmetry != MatrixMarketGeneral && p.rows != p.cols {
+		return nil, h, p.errorf("non-square matrix with %s symmetry", h.Symmetry)
+	}
+	return p, h, nil
+}
+
+// check returns an error if the header is not a valid combination of format,
+// field and symmetry.
+func (h MatrixMarketHeader) check() error {
+	switch h.Format {
+	case MatrixMarketArray, MatrixMarketCoordinate:
+	default:
+		return fmt.Errorf("mat: unsupported Matrix Market format %q", h.Format)
+	}
+	switch h.Field {
+	case MatrixMarketReal, MatrixMarketInteger, MatrixMarketComplex:
+	case MatrixMarketPattern:
+		if h.Format == MatrixMarketArray {
+			return errors.New("mat: Matrix Market pattern field requires coordinate format")
+		}
+	default:
+		return fmt.Errorf("mat: unsupported Matrix Market field %q", h.Field)
+	}
+	switch h.Symmetry {
+	case MatrixMarketGeneral, MatrixMarketSymmetric, MatrixMarketSkewSymmetric:
+		if h.Symmetry == MatrixMarketSkewSymmetric && h.Field == MatrixMarketPattern {
+			return errors.New("mat: Matrix Market pattern field cannot be skew-symmetric")
+		}
+	case MatrixMarketHermitian:
+		if h.Field != MatrixMarketComplex {
+			return errors.New("mat: Matrix Market hermitian symmetry requires complex field")
+		}
+	default:
+		return fmt.Errorf("mat: unsupported Matrix Market symmetry %q", h.Symmetry)
+	}
+	return nil
+}
+
+// next returns the next non-empty line.
+func (p *marketParser) next() (string, error) {
+	for p.sc.Scan() {
+		p.line++
+		line := strings.TrimSpace(p.sc.Text())
+		if line != "" {
+			return line, nil
+		}
+	}
+	err := p.sc.Err()
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return "", err
+}
+
+func (p *marketParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("mat: Matrix Market line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+// value parses the element value from the fields f.
+func (p *marketParser) value(f []string) (complex128, error) {
+	want := 1
+	switch p.h.Field {
+	case MatrixMarketPattern:
+		want = 0
+	case MatrixMarketComplex:
+		want = 2
+	}
+	if len(f) != want {
+		return 0, p.errorf("expected %d values, got %d", want, len(f))
+	}
+	if want == 0 {
+		return 1, nil
+	}
+	var v [2]float64
+	for i, s := range f {
+		var err error
+		if p.h.Field == MatrixMarketInteger {
+			var n int64
+			n, err = strconv.ParseInt(s, 10, 64)
+			v[i] = float64(n)
+		} else {
+			v[i], err = strconv.ParseFloat(s, 64)
+		}
+		if err != nil {
+			return 0, p.errorf("invalid value %q", s)
+		}
+	}
+	return complex(v[0], v[1]), nil
+}
+
+// read reads the data lines and calls set for each stored element.
+func (p *marketParser) read(set func(i, j int, v complex128)) error {
+	if p.h.Format == MatrixMarketCoordinate {
+		for k := 0; k < p.nnz; k++ {
+			line, err := p.next()
+			if err != nil {
+				return err
+			}
+			f := strings.Fields(line)
+			if len(f) < 2 {
+				return p.errorf("invalid coordinate entry %q", line)
+			}
+			i, erri := strconv.Atoi(f[0])
+			j, errj := strconv.Atoi(f[1])
+			if erri != nil || errj != nil || i < 1 || p.rows < i || j < 1 || p.cols < j {
+				return p.errorf("invalid coordinate entry %q", line)
+			}
+			v, err := p.value(f[2:])
+			if err != nil {
+				return err
+			}
+			i--
+			j--
+			if i == j && p.h.Symmetry == MatrixMarketSkewSymmetric {
+				if v != 0 {
+					return p.errorf("non-zero diagonal element in skew-symmetric matrix")
+				}
+				continue
+			}
+			if i < j && p.h.Symmetry != MatrixMarketGeneral {
+				// Accept elements in the upper triangle by
+				// storing the corresponding lower element.
+				i, j = j, i
+				switch p.h.Symmetry {
+				case MatrixMarketSkewSymmetric:
+					v = -v
+				case MatrixMarketHermitian:
+					v = complex(real(v), -imag(v))
+				}
+			}
+			set(i, j, v)
+		}
+		return nil
+	}
+
+	for j := 0; j < p.cols; j++ {
+		i0 := 0
+		switch p.h.Symmetry {
+		case MatrixMarketSymmetric, MatrixMarketHermitian:
+			i0 = j
+		case MatrixMarketSkewSymmetric:
+			i0 = j + 1
+		}
+		for i := i0; i < p.rows; i++ {
+			line, err := p.next()
+			if err != nil {
+				return err
+			}
+			v, err := p.value(strings.Fields(line))
+			if err != nil {
+				return err
+			}
+			set(i, j, v)
+		}
+	}
+	return nil
+}
+
+// WriteMatrixMarket writes the real matrix m to w in the Matrix Market
+// exchange format described by h. The field of h must be real, integer or
+// pattern, and the symmetry must be general, symmetric or skew-symmetric.
+// An error is returned if m does not have the requested symmetry or if m has
+// non-integer elements and the integer field is requested. Only the lower
+// triangle of matrices with symmetry is written. In the coordinate format
+// only non-zero elements are written.
+func WriteMatrixMarket(w io.Writer, m Matrix, h MatrixMarketHeader) error {
+	if h.Field == "" {
+		h.Field = MatrixMarketReal
+	}
+	if h.Field == MatrixMarketComplex {
+		return errors.New("mat: complex Matrix Market field for real matrix")
+	}
+	return writeMatrixMarket(w, h, m.Dims, func(i, j int) complex128 {
+		return complex(m.At(i, j), 0)
+	})
+}
+
+// WriteMatrixMarketComplex writes the complex matrix m to w in the Matrix
+// Market exchange format described by h. The field of h must be complex and
+// all symmetries are supported. See WriteMatrixMarket for details.
+func WriteMatrixMarketComplex(w io.Writer, m CMatrix, h MatrixMarketHeader) error {
+	if h.Field == "" {
+		h.Field = MatrixMarketComplex
+	}
+	if h.Field != MatrixMarketComplex {
+		return errors.New("mat: non-complex Matrix Market field for complex matrix")
+	}
+	return writeMatrixMarket(w, h, m.Dims, m.At)
+}
+
+func writeMatrixMarket(w io.Writer, h MatrixMarketHeader, dims func() (int, int), at func(i, j int) complex128) error {
+	if h.Format == "" {
+		h.Format = MatrixMarketArray
+	}
+	if h.Symmetry == "" {
+		h.Symmetry = MatrixMarketGeneral
+	}
+	err := h.check()
+	if err != nil {
+		return err
+	}
+
+	r, c := dims()
+	if h.Symmetry != MatrixMarketGeneral {
+		if r != c {
+			return errMatrixMarketSymmetry
+		}
+		for i := 0; i < r; i++ {
+			for j := 0; j <= i; j++ {
+				v, vt := at(i, j), at(j, i)
+				switch h.Symmetry {
+				case MatrixMarketSymmetric:
+					vt = v - vt
+				case MatrixMarketSkewSymmetric:
+					vt = v + vt
+				case MatrixMarketHermitian:
+					vt = v - complex(real(vt), -imag(vt))
+				}
+				if vt != 0 {
+					return errMatrixMarketSymmetry
+				}
+			}
+		}
+	}
+
+	// lower returns the first row of column j that is stored.
+	lower := func(j int) int {
+		switch h.Symmetry {
+		case MatrixMarketSymmetric, MatrixMarketHermitian:
+			return j
+		case MatrixMarketSkewSymmetric:
+			return j + 1
+		}
+		return 0
+	}
+
+	var nnz int
+	if h.Format == MatrixMarketCoordinate {
+		for j := 0; j < c; j++ {
+			for i := lower(j); i < r; i++ {
+				if at(i, j) != 0 {
+					nnz++
+				}
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s matrix %s %s %s\n", matrixMarketBanner, h.Format, h.Field, h.Symmetry)
+	if h.Format == MatrixMarketCoordinate {
+		fmt.Fprintf(bw, "%d %d %d\n", r, c, nnz)
+	} else {
+		fmt.Fprintf(bw, "%d %d\n", r, c)
+	}
+	var buf []byte
+	for j := 0; j < c; j++ {
+		for i := lower(j); i < r; i++ {
+			v := at(i, j)
+			buf = buf[:0]
+			if h.Format == MatrixMarketCoordinate {
+				if v == 0 {
+					continue
+				}
+				buf = strconv.AppendInt(buf, int64(i+1), 10)
+				buf = append(buf, ' ')
+				buf = strconv.AppendInt(buf, int64(j+1), 10)
+			}
+			switch h.Field {
+			case MatrixMarketReal:
+				buf = appendMarketFloat(buf, real(v))
+			case MatrixMarketComplex:
+				buf = appendMarketFloat(buf, real(v))
+				buf = appendMarketFloat(buf, imag(v))
+			case MatrixMarketInteger:
+				x := real(v)
+				if x != math.Trunc(x) || math.Abs(x) > 1<<53 {
+					return fmt.Errorf("mat: non-integer element %v at (%d, %d) for Matrix Market integer field", x, i, j)
+				}
+				if len(buf) != 0 {
+					buf = append(buf, ' ')
+				}
+				buf = strconv.AppendInt(buf, int64(x), 10)
+			}
+			buf = append(buf, '\n')
+			_, err = bw.Write(buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// appendMarketFloat appends the shortest representation of v that reads back
+// exactly to buf, separated by a space from any previous field.
+func appendMarketFloat(buf []byte, v float64) []byte {
+	if len(buf) != 0 {
+		buf = append(buf, ' ')
+	}
+	return strconv.AppendFloat(buf, v, 'g', -1, 64)
+}
@@ -0,0 +1,416 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestReadMatrixMarket(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		data   string
+		want   Matrix
+		header MatrixMarketHeader
+	}{
+		{
+			name: "array general",
+			data: `%%MatrixMarket matrix array real general
+% A comment.
+2 3
+1
+4
+2
+5
+3
+6.5e0
+`,
+			want:   NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6.5}),
+			header: MatrixMarketHeader{MatrixMarketArray, MatrixMarketReal, MatrixMarketGeneral},
+		},
+		{
+			name: "array symmetric integer",
+			data: `%%MatrixMarket matrix array integer symmetric
+3 3
+1
+2
+3
+4
+5
+6
+`,
+			want: NewSymDense(3, []float64{
+				1, 2, 3,
+				2, 4, 5,
+				3, 5, 6,
+			}),
+			header: MatrixMarketHeader{MatrixMarketArray, MatrixMarketInteger, MatrixMarketSymmetric},
+		},
+		{
+			name: "array skew-symmetric",
+			data: `%%MatrixMarket matrix array real skew-symmetric
+3 3
+1
+2
+3
+`,
+			want: NewDense(3, 3, []float64{
+				0, -1, -2,
+				1, 0, -3,
+				2, 3, 0,
+			}),
+			header: MatrixMarketHeader{MatrixMarketArray, MatrixMarketReal, MatrixMarketSkewSymmetric},
+		},
+		{
+			name: "coordinate general with duplicates",
+			data: `%%MatrixMarket matrix coordinate real general
+%
+3 4 4
+1 1 1.5
+3 4 -2
+
+2 2 1
+3 4 -1
+`,
+			want: NewDense(3, 4, []float64{
+				1.5, 0, 0, 0,
+				0, 1, 0, 0,
+				0, 0, 0, -3,
+			}),
+			header: MatrixMarketHeader{MatrixMarketCoordinate, MatrixMarketReal, MatrixMarketGeneral},
+		},
+		{
+			name: "coordinate symmetric",
+			data: `%%MatrixMarket matrix coordinate real symmetric
+3 3 3
+1 1 4
+3 1 2
+2 3 5
+`,
+			want: NewSymDense(3, []float64{
+				4, 0, 2,
+				0, 0, 5,
+				2, 5, 0,
+			}),
+			header: MatrixMarketHeader{MatrixMarketCoordinate, MatrixMarketReal, MatrixMarketSymmetric},
+		},
+		{
+			name: "coordinate skew-symmetric",
+			data: `%%MatrixMarket matrix coordinate integer skew-symmetric
+3 3 2
+2 1 7
+1 3 1
+`,
+			want: NewDense(3, 3, []float64{
+				0, -7, 1,
+				7, 0, 0,
+				-1, 0, 0,
+			}),
+			header: MatrixMarketHeader{MatrixMarketCoordinate, MatrixMarketInteger, MatrixMarketSkewSymmetric},
+		},
+		{
+			name: "coordinate pattern",
+			data: `%%MatrixMarket matrix coordinate pattern general
+2 3 3
+1 1
+2 3
+1 2
+`,
+			want: NewDense(2, 3, []float64{
+				1, 1, 0,
+				0, 0, 1,
+			}),
+			header: MatrixMarketHeader{MatrixMarketCoordinate, MatrixMarketPattern, MatrixMarketGeneral},
+		},
+		{
+			name: "coordinate pattern symmetric upper case",
+			data: `%%MatrixMarket MATRIX Coordinate Pattern Symmetric
+2 2 2
+1 1
+2 1
+`,
+			want: NewSymDense(2, []float64{
+				1, 1,
+				1, 0,
+			}),
+			header: MatrixMarketHeader{MatrixMarketCoordinate, MatrixMarketPattern, MatrixMarketSymmetric},
+		},
+	} {
+		m, h, err := ReadMatrixMarket(strings.NewReader(test.data))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if h != test.header {
+			t.Errorf("%s: unexpected header: got %v, want %v", test.name, h, test.header)
+		}
+		switch test.want.(type) {
+		case *SymDense:
+			if _, ok := m.(*SymDense); !ok {
+				t.Errorf("%s: unexpected type %T, want *SymDense", test.name, m)
+			}
+		case *Dense:
+			if _, ok := m.(*Dense); !ok {
+				t.Errorf("%s: unexpected type %T, want *Dense", test.name, m)
+			}
+		}
+		if !Equal(m, test.want) {
+			t.Errorf("%s: unexpected matrix:\ngot  %v\nwant %v", test.name, Formatted(m), Formatted(test.want))
+		}
+
+		// Real data must also be readable as complex.
+		c, _, err := ReadMatrixMarketComplex(strings.NewReader(test.data))
+		if err != nil {
+			t.Errorf("%s: unexpected error reading as complex: %v", test.name, err)
+			continue
+		}
+		r, cc := test.want.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < cc; j++ {
+				if c.At(i, j) != complex(test.want.At(i, j), 0) {
+					t.Errorf("%s: unexpected complex element (%d,%d): got %v, want %v",
+						test.name, i, j, c.At(i, j), test.want.At(i, j))
+				}
+			}
+		}
+	}
+}
+
+func TestReadMatrixMarketComplex(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		data string
+		want *CDense
+	}{
+		{
+			name: "array general",
+			data: `%%MatrixMarket matrix array complex general
+2 2
+1 0
+3 -1
+2 2
+4 0
+`,
+			want: NewCDense(2, 2, []complex128{1, 2 + 2i, 3 - 1i, 4}),
+		},
+		{
+			name: "array hermitian",
+			data: `%%MatrixMarket matrix array complex hermitian
+2 2
+1 0
+2 3
+4 0
+`,
+			want: NewCDense(2, 2, []complex128{1, 2 - 3i, 2 + 3i, 4}),
+		},
+		{
+			name: "coordinate symmetric",
+			data: `%%MatrixMarket matrix coordinate complex symmetric
+2 2 2
+2 1 1 1
+2 2 0 5
+`,
+			want: NewCDense(2, 2, []complex128{0, 1 + 1i, 1 + 1i, 5i}),
+		},
+		{
+			name: "coordinate skew-symmetric",
+			data: `%%MatrixMarket matrix coordinate complex skew-symmetric
+2 2 1
+2 1 1 1
+`,
+			want: NewCDense(2, 2, []complex128{0, -1 - 1i, 1 + 1i, 0}),
+		},
+		{
+			name: "coordinate hermitian",
+			data: `%%MatrixMarket matrix coordinate complex hermitian
+2 2 2
+1 1 3 0
+2 1 1 2
+`,
+			want: NewCDense(2, 2, []complex128{3, 1 - 2i, 1 + 2i, 0}),
+		},
+	} {
+		m, _, err := ReadMatrixMarketComplex(strings.NewReader(test.data))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !CEqual(m, test.want) {
+			t.Errorf("%s: unexpected matrix: got %v, want %v", test.name, m.RawCMatrix().Data, test.want.RawCMatrix().Data)
+		}
+	}
+}
+
+func TestReadMatrixMarketErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		data string
+	}{
+		{name: "empty", data: ""},
+		{name: "bad banner", data: "%%MatrixMarked matrix array real general\n1 1\n1\n"},
+		{name: "bad format", data: "%%MatrixMarket matrix dense real general\n1 1\n1\n"},
+		{name: "array pattern", data: "%%MatrixMarket matrix array pattern general\n1 1\n"},
+		{name: "real hermitian", data: "%%MatrixMarket matrix array real hermitian\n1 1\n1\n"},
+		{name: "bad size", data: "%%MatrixMarket matrix coordinate real general\n2 2\n"},
+		{name: "zero size", data: "%%MatrixMarket matrix array real general\n0 2\n"},
+		{name: "non-square symmetric", data: "%%MatrixMarket matrix array real symmetric\n2 3\n1\n2\n3\n"},
+		{name: "short array", data: "%%MatrixMarket matrix array real general\n2 1\n1\n"},
+		{name: "short coordinate", data: "%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n"},
+		{name: "index out of range", data: "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n"},
+		{name: "bad value", data: "%%MatrixMarket matrix array real general\n1 1\nx\n"},
+		{name: "non-integer", data: "%%MatrixMarket matrix array integer general\n1 1\n1.5\n"},
+		{name: "missing imaginary", data: "%%MatrixMarket matrix array complex general\n1 1\n1\n"},
+		{name: "skew diagonal", data: "%%MatrixMarket matrix coordinate real skew-symmetric\n2 2 1\n1 1 1\n"},
+	} {
+		_, _, err := ReadMatrixMarketComplex(strings.NewReader(test.data))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+	_, _, err := ReadMatrixMarket(strings.NewReader("%%MatrixMarket matrix array complex general\n1 1\n1 1\n"))
+	if err == nil {
+		t.Error("expected error reading complex data as real")
+	}
+}
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 5
+	general := NewDense(n, n+2, nil)
+	general.Apply(func(_, _ int, _ float64) float64 {
+		if rnd.Float64() < 0.5 {
+			return 0
+		}
+		return rnd.NormFloat64()
+	}, general)
+	sym := NewSymDense(n, nil)
+	skew := NewDense(n, n, nil)
+	integer := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sym.SetSym(i, j, rnd.NormFloat64())
+			integer.Set(i, j, float64(rnd.IntN(100)-50))
+			if i != j {
+				v := rnd.NormFloat64()
+				skew.Set(i, j, v)
+				skew.Set(j, i, -v)
+			}
+		}
+	}
+	pattern := DenseCopyOf(general)
+	pattern.Apply(func(_, _ int, v float64) float64 {
+		if v != 0 {
+			return 1
+		}
+		return 0
+	}, pattern)
+
+	for _, format := range []MatrixMarketFormat{MatrixMarketArray, MatrixMarketCoordinate} {
+		for _, test := range []struct {
+			m        Matrix
+			field    MatrixMarketField
+			symmetry MatrixMarketSymmetry
+		}{
+			{m: general, field: MatrixMarketReal, symmetry: MatrixMarketGeneral},
+			{m: sym, field: MatrixMarketReal, symmetry: MatrixMarketSymmetric},
+			{m: sym, field: MatrixMarketReal, symmetry: MatrixMarketGeneral},
+			{m: skew, field: MatrixMarketReal, symmetry: MatrixMarketSkewSymmetric},
+			{m: integer, field: MatrixMarketInteger, symmetry: MatrixMarketGeneral},
+			{m: pattern, field: MatrixMarketPattern, symmetry: MatrixMarketGeneral},
+		} {
+			h := MatrixMarketHeader{Format: format, Field: test.field, Symmetry: test.symmetry}
+			var buf bytes.Buffer
+			err := WriteMatrixMarket(&buf, test.m, h)
+			if test.field == MatrixMarketPattern && format == MatrixMarketArray {
+				if err == nil {
+					t.Errorf("%v: expected error for array pattern", h)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%v: unexpected error: %v", h, err)
+				continue
+			}
+			got, gotHeader, err := ReadMatrixMarket(&buf)
+			if err != nil {
+				t.Errorf("%v: unexpected error reading: %v", h, err)
+				continue
+			}
+			if gotHeader != h {
+				t.Errorf("%v: unexpected header: got %v", h, gotHeader)
+			}
+			if !Equal(got, test.m) {
+				t.Errorf("%v: round trip mismatch", h)
+			}
+		}
+	}
+
+	// Check the default header and complex round trips.
+	c := NewCDense(n, n, nil)
+	herm := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			c.Set(i, j, complex(rnd.NormFloat64(), rnd.NormFloat64()))
+			if i == j {
+				herm.Set(i, i, complex(rnd.NormFloat64(), 0))
+			} else if i > j {
+				v := complex(rnd.NormFloat64(), rnd.NormFloat64())
+				herm.Set(i, j, v)
+				herm.Set(j, i, complex(real(v), -imag(v)))
+			}
+		}
+	}
+	for _, test := range []struct {
+		m *CDense
+		h MatrixMarketHeader
+	}{
+		{m: c},
+		{m: c, h: MatrixMarketHeader{Format: MatrixMarketCoordinate}},
+		{m: herm, h: MatrixMarketHeader{Symmetry: MatrixMarketHermitian}},
+		{m: herm, h: MatrixMarketHeader{Format: MatrixMarketCoordinate, Symmetry: MatrixMarketHermitian}},
+	} {
+		var buf bytes.Buffer
+		err := WriteMatrixMarketComplex(&buf, test.m, test.h)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.h, err)
+			continue
+		}
+		got, _, err := ReadMatrixMarketComplex(&buf)
+		if err != nil {
+			t.Errorf("%v: unexpected error reading: %v", test.h, err)
+			continue
+		}
+		if !CEqual(got, test.m) {
+			t.Errorf("%v: complex round trip mismatch", test.h)
+		}
+	}
+
+	// Check that matrices without the requested structure are rejected.
+	for _, test := range []struct {
+		m Matrix
+		h MatrixMarketHeader
+	}{
+		{m: general, h: MatrixMarketHeader{Symmetry: MatrixMarketSymmetric}},
+		{m: sym, h: MatrixMarketHeader{Symmetry: MatrixMarketSkewSymmetric}},
+		{m: general, h: MatrixMarketHeader{Field: MatrixMarketInteger}},
+		{m: general, h: MatrixMarketHeader{Field: MatrixMarketComplex}},
+	} {
+		err := WriteMatrixMarket(&bytes.Buffer{}, test.m, test.h)
+		if err == nil {
+			t.Errorf("%v: expected error", test.h)
+		}
+	}
+	err := WriteMatrixMarketComplex(&bytes.Buffer{}, c, MatrixMarketHeader{Symmetry: MatrixMarketHermitian})
+	if err == nil {
+		t.Error("expected error for non-hermitian matrix")
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// npyMagic is the magic string at the start of a NumPy .npy file.
+const npyMagic = "\x93NUMPY"
+
+// npyHeader describes the array stored in a NumPy .npy file.
+type npyHeader struct {
+	complex    bool
+	order      binary.ByteOrder
+	fortran    bool
+	rows, cols int
+}
+
+// ReadNPY reads a real matrix stored in the NumPy .npy format from r. The
+// array must have float64 elements of either byte order, stored in C or
+// Fortran order, and have at most two dimensions. One-dimensional arrays are
+// read as column vectors and zero-dimensional arrays as 1×1 matrices.
+// Complex arrays must be read with ReadNPYComplex.
+//
+// ReadNPY only returns an error for arrays with more elements than the largest
+// slice length on the platform. It does not otherwise limit the size of the
+// matrix, and so it should not be used on untrusted data.
+func ReadNPY(r io.Reader) (*Dense, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if h.complex {
+		return nil, errors.New("mat: complex NumPy data in real matrix")
+	}
+	return readNPYDense(r, h)
+}
+
+// ReadNPYComplex reads a complex matrix stored in the NumPy .npy format from
+// r. The array must have complex128 or float64 elements, real arrays are read
+// with zero imaginary parts. See ReadNPY for details.
+//
+// As with ReadNPY, the size of the matrix is only limited by the largest slice
+// length on the platform, and so ReadNPYComplex should not be used on
+// untrusted data.
+func ReadNPYComplex(r io.Reader) (*CDense, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	return readNPYCDense(r, h)
+}
+
+// WriteNPY writes the matrix m to w in the NumPy .npy format as a
+// two-dimensional array of little-endian float64 elements in C order.
+func WriteNPY(w io.Writer, m Matrix) error {
+	r, c := m.Dims()
+	bw := bufio.NewWriter(w)
+	err := writeNPYHeader(bw, "<f8", r, c)
+	if err != nil {
+		return err
+	}
+	var b [8]byte
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.At(i, j)))
+			_, err = bw.Write(b[:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteNPYComplex writes the complex matrix m to w in the NumPy .npy format as
+// a two-dimensional array of little-endian complex128 elements in C order.
+func WriteNPYComplex(w io.Writer, m CMatrix) error {
+	r, c := m.Dims()
+	bw := bufio.NewWriter(w)
+	err := writeNPYHeader(bw, "<c16", r, c)
+	if err != nil {
+		return err
+	}
+	var b [16]byte
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := m.At(i, j)
+			binary.LittleEndian.PutUint64(b[:8], math.Float64bits(real(v)))
+			binary.LittleEndian.PutUint64(b[8:], math.Float64bits(imag(v)))
+			_, err = bw.Write(b[:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadNPZ reads the arrays stored in a NumPy .npz archive of the given size
+// from r. Compressed and uncompressed archives are supported. Real arrays are
+// returned in dense and complex arrays in cdense, keyed by the name of the
+// array without the .npy suffix. Entries that are not .npy files are
+// ignored. See ReadNPY for the supported arrays.
+//
+// As with ReadNPY, the size of each matrix is only limited by the largest
+// slice length on the platform, and so ReadNPZ should not be used on untrusted
+// data.
+func ReadNPZ(r io.ReaderAt, size int64) (dense map[string]*Dense, cdense map[string]*CDense, err error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	dense = make(map[string]*Dense)
+	cdense = make(map[string]*CDense)
+	for _, f := range zr.File {
+		name, ok := strings.CutSuffix(f.Name, ".npy")
+		if !ok {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		h, err := readNPYHeader(rc)
+		if err == nil {
+			if h.complex {
+				cdense[name], err = readNPYCDense(rc, h)
+			} else {
+				dense[name], err = readNPYDense(rc, h)
+			}
+		}
+		rc.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("mat: reading %s: %w", f.Name, err)
+		}
+	}
+	return dense, cdense, nil
+}
+
+// WriteNPZ writes the real matrices in dense and the complex matrices in
+// cdense to w as an uncompressed NumPy .npz archive. The keys are used as
+// the array names and must be unique across both maps. See WriteNPY and
+// WriteNPYComplex for the format of the arrays.
+func WriteNPZ(w io.Writer, dense map[string]Matrix, cdense map[string]CMatrix) error {
+	names := make([]string, 0, len(dense)+len(cdense))
+	for name := range dense {
+		names = append(names, name)
+	}
+	for name := range cdense {
+		if _, ok := dense[name]; ok {
+			return fmt.Errorf("mat: duplicate NumPy array name %q", name)
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	zw := zip.NewWriter(w)
+	for _, name := range names {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:   name + ".npy",
+			Method: zip.Store,
+		})
+		if err != nil {
+			return err
+		}
+		if m, ok := dense[name]; ok {
+			err = WriteNPY(fw, m)
+		} else {
+			err = WriteNPYComplex(fw, cdense[name])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// readNPYHeader reads and parses the header of a .npy file.
+func readNPYHeader(r io.Reader) (npyHeader, error) {
+	var h npyHeader
+	var pre [8]byte
+	_, err := io.ReadFull(r, pre[:])
+	if err != nil {
+		return h, err
+	}
+	if string(pre[:6]) != npyMagic {
+		return h, errors.New("mat: invalid NumPy magic string")
+	}
+	var hlen int
+	switch major := pre[6]; major {
+	case 1:
+		var b [2]byte
+		_, err = io.ReadFull(r, b[:])
+		hlen = int(binary.LittleEndian.Uint16(b[:]))
+	case 2, 3:
+		var b [4]byte
+		_, err = io.ReadFull(r, b[:])
+		hlen = int(binary.LittleEndian.Uint32(b[:]))
+	default:
+		return h, fmt.Errorf("mat: unsupported NumPy format version %d.%d", major, pre[7])
+	}
+	if err != nil {
+		return h, err
+	}
+	buf := make([]byte, hlen)
+	_, err = io.ReadFull(r, buf)
+	if err != nil {
+		return h, err
+	}
+	dict := strings.ReplaceAll(string(buf), `"`, `'`)
+
+	descr, ok := npyDictValue(dict, "descr")
+	if !ok || len(descr) < 2 || descr[0] != '\'' || descr[len(descr)-1] != '\'' {
+		return h, errors.New("mat: invalid NumPy descr")
+	}
+	descr = descr[1 : len(descr)-1]
+	switch descr {
+	case "<f8", "<c16":
+		h.order = binary.LittleEndian
+	case ">f8", ">c16":
+		h.order = binary.BigEndian
+	case "=f8", "=c16":
+		h.order = binary.NativeEndian
+	default:
+		return h, fmt.Errorf("mat: unsupported NumPy dtype %q", descr)
+	}
+	h.complex = strings.HasSuffix(descr, "c16")
+
+	fortran, ok := npyDictValue(dict, "fortran_order")
+	switch {
+	case ok && fortran == "True":
+		h.fortran = true
+	case ok && fortran == "False":
+	default:
+		return h, errors.New("mat: invalid NumPy fortran_order")
+	}
+
+	shape, ok := npyDictValue(dict, "shape")
+	if !ok || len(shape) < 2 || shape[0] != '(' || shape[len(shape)-1] != ')' {
+		return h, errors.New("mat: invalid NumPy shape")
+	}
+	var dims []int
+	for _, s := range strings.Split(shape[1:len(shape)-1], ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		d, err := strconv.Atoi(s)
+		if err != nil || d < 0 {
+			return h, errors.New("mat: invalid NumPy shape")
+		}
+		dims = append(dims, d)
+	}
+	switch len(dims) {
+	case 0:
+		h.rows, h.cols = 1, 1
+	case 1:
+		h.rows, h.cols = dims[0], 1
+	case 2:
+		h.rows, h.cols = dims[0], dims[1]
+	default:
+		return h, fmt.Errorf("mat: unsupported %d-dimensional NumPy array", len(dims))
+	}
+	if h.rows == 0 || h.cols == 0 {
+		return h, ErrZeroLength
+	}
+	if int64(h.rows) > maxLen/int64(h.cols) {
+		return h, errTooBig
+	}
+	return h, nil
+}
+
+// npyDictValue returns the literal value of key in the Python dictionary
+// literal dict, which must use single quotes for strings.
+func npyDictValue(dict, key string) (string, bool) {
+	_, rest, ok := strings.Cut(dict, "'"+key+"':")
+	if !ok {
+		return "", false
+	}
+	rest = strings.TrimSpace(rest)
+	var end int
+	switch {
+	case strings.HasPrefix(rest, "'"):
+		end = strings.IndexByte(rest[1:], '\'') + 2
+	case strings.HasPrefix(rest, "("):
+		end = strings.IndexByte(rest, ')') + 1
+	default:
+		end = strings.IndexAny(rest, ",}")
+	}
+	if end <= 0 {
+		return "", false
+	}
+	return strings.TrimSpace(rest[:end]), true
+}
+
+// index returns the row and column of the k-th stored element.
+func (h npyHeader) index(k int) (i, j int) {
+	if h.fortran {
+		return k % h.rows, k / h.rows
+	}
+	return k / h.cols, k % h.cols
+}
+
+// readNPYDense reads the real data of a .npy file described by h.
+func readNPYDense(r io.Reader, h npyHeader) (*Dense, error) {
+	m := NewDense(h.rows, h.cols, nil)
+	err := readNPYData(r, h, func(i, j int, re, _ float64) { m.set(i, j, re) })
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// readNPYCDense reads the real or complex data of a .npy file described by h.
+func readNPYCDense(r io.Reader, h npyHeader) (*CDense, error) {
+	m := NewCDense(h.rows, h.cols, nil)
+	err := readNPYData(r, h, func(i, j int, re, im float64) { m.set(i, j, complex(re, im)) })
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// readNPYData reads the data of a .npy file described by h and calls set for
+// each element. The data are read in blocks so that no bytes past the end of
+// the array are consumed from r.
+func readNPYData(r io.Reader, h npyHeader, set func(i, j int, re, im float64)) error {
+	size := 8
+	if h.complex {
+		size = 16
+	}
+	const block = 512
+	buf := make([]byte, block*size)
+	n := h.rows * h.cols
+	for k0 := 0; k0 < n; k0 += block {
+		b := buf[:min(block, n-k0)*size]
+		_, err := io.ReadFull(r, b)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		for k := k0; len(b) != 0; k++ {
+			re := math.Float64frombits(h.order.Uint64(b))
+			var im float64
+			if h.complex {
+				im = math.Float64frombits(h.order.Uint64(b[8:]))
+			}
+			i, j := h.index(k)
+			set(i, j, re, im)
+			b = b[size:]
+		}
+	}
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// writeNPYHeader writes a version 1.0 .npy header for a two-dimensional
+// r×c array with the given dtype in C order.
+func writeNPYHeader(w io.Writer, descr string, r, c int) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{'descr': '%s', 'fortran_order': False, 'shape': (%d, %d), }", descr, r, c)
+	// The total header length including the terminating newline is a
+	// multiple of 64 bytes so that the data are aligned.
+	const preamble = len(npyMagic) + 2 + 2
+	pad := 64 - (preamble+buf.Len()+1)%64
+	if pad == 64 {
+		pad = 0
+	}
+	buf.WriteString(strings.Repeat(" ", pad))
+	buf.WriteByte('\n')
+	if buf.Len() > math.MaxUint16 {
+		return errTooBig
+	}
+
+	var pre [preamble]byte
+	copy(pre[:], npyMagic)
+	pre[6], pre[7] = 1, 0
+	binary.LittleEndian.PutUint16(pre[8:], uint16(buf.Len()))
+	_, err := w.Write(pre[:])
+	if err != nil {
+		return err
+	}
+	_, err = buf.WriteTo(w)
+	return err
+}
@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// npyBytes returns the .npy encoding of the given header dictionary and data
+// written with the provided byte order and format version.
+func npyBytes(major byte, dict string, order binary.ByteOrder, data []float64) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.WriteByte(major)
+	buf.WriteByte(0)
+	hdr := dict + "\n"
+	if major == 1 {
+		binary.Write(&buf, binary.LittleEndian, uint16(len(hdr)))
+	} else {
+		binary.Write(&buf, binary.LittleEndian, uint32(len(hdr)))
+	}
+	buf.WriteString(hdr)
+	for _, v := range data {
+		binary.Write(&buf, order, math.Float64bits(v))
+	}
+	return buf.Bytes()
+}
+
+func TestReadNPY(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		data  []byte
+		want  *Dense
+		cwant *CDense
+	}{
+		{
+			name: "C order",
+			data: npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }", binary.LittleEndian,
+				[]float64{1, 2, 3, 4, 5, 6}),
+			want: NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			name: "Fortran order",
+			data: npyBytes(1, "{'descr': '<f8', 'fortran_order': True, 'shape': (2, 3), }", binary.LittleEndian,
+				[]float64{1, 4, 2, 5, 3, 6}),
+			want: NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			name: "big endian version 2",
+			data: npyBytes(2, "{'descr': '>f8', 'fortran_order': False, 'shape': (2, 2), }", binary.BigEndian,
+				[]float64{1, -2, math.Inf(1), 0.5}),
+			want: NewDense(2, 2, []float64{1, -2, math.Inf(1), 0.5}),
+		},
+		{
+			name: "one-dimensional",
+			data: npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", binary.LittleEndian,
+				[]float64{1, 2, 3}),
+			want: NewDense(3, 1, []float64{1, 2, 3}),
+		},
+		{
+			name: "zero-dimensional",
+			data: npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (), }", binary.LittleEndian,
+				[]float64{7}),
+			want: NewDense(1, 1, []float64{7}),
+		},
+		{
+			name: "reordered keys with double quotes",
+			data: npyBytes(3, `{"shape": (1, 2), "fortran_order": False, "descr": "<f8"}`, binary.LittleEndian,
+				[]float64{1, 2}),
+			want: NewDense(1, 2, []float64{1, 2}),
+		},
+		{
+			name: "complex C order",
+			data: npyBytes(1, "{'descr': '<c16', 'fortran_order': False, 'shape': (2, 2), }", binary.LittleEndian,
+				[]float64{1, 1, 2, 0, 3, -1, 4, 2}),
+			cwant: NewCDense(2, 2, []complex128{1 + 1i, 2, 3 - 1i, 4 + 2i}),
+		},
+		{
+			name: "complex Fortran order big endian",
+			data: npyBytes(1, "{'descr': '>c16', 'fortran_order': True, 'shape': (2, 2), }", binary.BigEndian,
+				[]float64{1, 1, 3, -1, 2, 0, 4, 2}),
+			cwant: NewCDense(2, 2, []complex128{1 + 1i, 2, 3 - 1i, 4 + 2i}),
+		},
+	} {
+		m, err := ReadNPY(bytes.NewReader(test.data))
+		if test.cwant != nil {
+			if err == nil {
+				t.Errorf("%s: expected error reading complex data as real", test.name)
+			}
+		} else {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+				continue
+			}
+			if !Equal(m, test.want) {
+				t.Errorf("%s: unexpected matrix:\ngot  %v\nwant %v", test.name, Formatted(m), Formatted(test.want))
+			}
+		}
+
+		cwant := test.cwant
+		if cwant == nil {
+			r, c := test.want.Dims()
+			cwant = NewCDense(r, c, nil)
+			for i := 0; i < r; i++ {
+				for j := 0; j < c; j++ {
+					cwant.Set(i, j, complex(test.want.At(i, j), 0))
+				}
+			}
+		}
+		cm, err := ReadNPYComplex(bytes.NewReader(test.data))
+		if err != nil {
+			t.Errorf("%s: unexpected error reading as complex: %v", test.name, err)
+			continue
+		}
+		if !CEqual(cm, cwant) {
+			t.Errorf("%s: unexpected complex matrix: got %v, want %v", test.name, cm.RawCMatrix().Data, cwant.RawCMatrix().Data)
+		}
+	}
+}
+
+func TestReadNPYErrors(t *testing.T) {
+	t.Parallel()
+	valid := npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2), }", binary.LittleEndian, []float64{1, 2, 3, 4})
+	for _, test := range []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "bad magic", data: append([]byte("\x93NUMPZ"), valid[6:]...)},
+		{name: "bad version", data: append([]byte("\x93NUMPY\x04\x00"), valid[8:]...)},
+		{name: "truncated data", data: valid[:len(valid)-1]},
+		{name: "unsupported dtype", data: npyBytes(1, "{'descr': '<f4', 'fortran_order': False, 'shape': (1,), }", binary.LittleEndian, []float64{1})},
+		{name: "missing fortran_order", data: npyBytes(1, "{'descr': '<f8', 'shape': (1,), }", binary.LittleEndian, []float64{1})},
+		{name: "three dimensions", data: npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (1, 1, 1), }", binary.LittleEndian, []float64{1})},
+		{name: "zero size", data: npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (0, 2), }", binary.LittleEndian, nil)},
+		{name: "bad shape", data: npyBytes(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (a, 2), }", binary.LittleEndian, nil)},
+	} {
+		_, err := ReadNPYComplex(bytes.NewReader(test.data))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+func TestNPYRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		r, c int
+	}{
+		{1, 1}, {1, 5}, {5, 1}, {3, 4}, {10, 10},
+	} {
+		m := NewDense(test.r, test.c, nil)
+		cm := NewCDense(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				v := float64(i*test.c+j) + 0.25
+				m.Set(i, j, v)
+				cm.Set(i, j, complex(v, -v))
+			}
+		}
+
+		var buf bytes.Buffer
+		err := WriteNPY(&buf, m.T())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkNPYHeader(t, buf.Bytes(), "<f8", test.c, test.r)
+		got, err := ReadNPY(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !Equal(got, m.T()) {
+			t.Errorf("%d×%d: round trip mismatch", test.r, test.c)
+		}
+
+		buf.Reset()
+		err = WriteNPYComplex(&buf, cm)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkNPYHeader(t, buf.Bytes(), "<c16", test.r, test.c)
+		cgot, err := ReadNPYComplex(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !CEqual(cgot, cm) {
+			t.Errorf("%d×%d: complex round trip mismatch", test.r, test.c)
+		}
+	}
+
+	// Check that consecutive arrays can be read from a stream.
+	a := NewDense(30, 40, nil)
+	a.Apply(func(i, j int, _ float64) float64 { return float64(i - j) }, a)
+	b := NewDense(2, 2, []float64{1, 2, 3, 4})
+	var buf bytes.Buffer
+	WriteNPY(&buf, a)
+	WriteNPY(&buf, b)
+	gotA, errA := ReadNPY(&buf)
+	gotB, errB := ReadNPY(&buf)
+	if errA != nil || errB != nil || !Equal(gotA, a) || !Equal(gotB, b) {
+		t.Errorf("failed to read consecutive arrays: %v, %v", errA, errB)
+	}
+}
+
+// checkNPYHeader checks that data holds a version 1.0 header as written by
+// NumPy for an r×c C-order array, followed by the array data.
+func checkNPYHeader(t *testing.T, data []byte, descr string, r, c int) {
+	t.Helper()
+	hlen := int(binary.LittleEndian.Uint16(data[8:10]))
+	if (10+hlen)%64 != 0 {
+		t.Errorf("header length %d not aligned", hlen)
+	}
+	hdr := string(data[10 : 10+hlen])
+	want := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%d, %d), }", descr, r, c)
+	if strings.TrimRight(hdr, " \n") != want || !strings.HasSuffix(hdr, "\n") {
+		t.Errorf("unexpected header %q", hdr)
+	}
+	size := 8
+	if descr == "<c16" {
+		size = 16
+	}
+	if len(data) != 10+hlen+r*c*size {
+		t.Errorf("unexpected data length: got %d, want %d", len(data), 10+hlen+r*c*size)
+	}
+}
+
+func TestNPZ(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	b := NewSymDense(2, []float64{1, 2, 2, 3})
+	c := NewCDense(2, 2, []complex128{1i, 2, 3, 4 - 1i})
+
+	var buf bytes.Buffer
+	err := WriteNPZ(&buf, map[string]Matrix{"a": a, "b": b}, map[string]CMatrix{"c": c})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dense, cdense, err := ReadNPZ(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dense) != 2 || len(cdense) != 1 {
+		t.Fatalf("unexpected number of arrays: got %d real and %d complex", len(dense), len(cdense))
+	}
+	if !Equal(dense["a"], a) || !Equal(dense["b"], b) || !CEqual(cdense["c"], c) {
+		t.Error("round trip mismatch")
+	}
+
+	// Check a compressed archive with a non-array entry as written by
+	// numpy.savez_compressed.
+	buf.Reset()
+	zw := zip.NewWriter(&buf)
+	w, _ := zw.CreateHeader(&zip.FileHeader{Name: "x.npy", Method: zip.Deflate})
+	WriteNPY(w, a)
+	w, _ = zw.Create("README")
+	w.Write([]byte("not an array"))
+	zw.Close()
+	dense, cdense, err = ReadNPZ(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dense) != 1 || len(cdense) != 0 || !Equal(dense["x"], a) {
+		t.Error("unexpected result for compressed archive")
+	}
+
+	err = WriteNPZ(&bytes.Buffer{}, map[string]Matrix{"a": a}, map[string]CMatrix{"a": c})
+	if err == nil {
+		t.Error("expected error for duplicate names")
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+// Polar is a type for creating and using the polar decomposition of a matrix.
+type Polar struct {
+	svd SVD
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (p *Polar) succFact() bool {
+	return p.svd.succFact()
+}
+
+// Factorize computes the polar decomposition of the m×n matrix A
+//
+//	A = U * P
+//
+// where P is an n×n symmetric positive semidefinite matrix and U is an m×n
+// matrix with orthonormal columns if m ≥ n, or with orthonormal rows if m < n.
+// The factorization is computed from the thin singular value decomposition
+// A = W * Σ * Vᵀ as U = W * Vᵀ and P = V * Σ * Vᵀ.
+//
+// The factor P = (Aᵀ*A)^{1/2} is always unique. When A has full column rank,
+// U is unique as well and is the matrix with orthonormal columns nearest to A
+// in the Frobenius norm. Note that for square A, U may be a reflection, that is,
+// det(U) may be -1. The nearest rotation matrix can be found with
+// ProcrustesRotation.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (p *Polar) Factorize(a Matrix) (ok bool) {
+	return p.svd.Factorize(a, SVDThin)
+}
+
+// UTo extracts the matrix U from the polar decomposition.
+//
+// If dst is empty, UTo will resize dst to be m×n. When dst is non-empty, UTo
+// will panic if dst is not m×n. UTo will also panic if the receiver does not
+// contain a successful factorization.
+func (p *Polar) UTo(dst *Dense) {
+	if !p.succFact() {
+		panic(badFact)
+	}
+	m := p.svd.u.Rows
+	n := p.svd.vt.Cols
+	if dst.IsEmpty() {
+		dst.ReuseAs(m, n)
+	} else {
+		r, c := dst.Dims()
+		if r != m || c != n {
+			panic(ErrShape)
+		}
+	}
+	w := &Dense{
+		mat:     p.svd.u,
+		capRows: p.svd.u.Rows,
+		capCols: p.svd.u.Cols,
+	}
+	vt := &Dense{
+		mat:     p.svd.vt,
+		capRows: p.svd.vt.Rows,
+		capCols: p.svd.vt.Cols,
+	}
+	dst.Mul(w, vt)
+}
+
+// PTo extracts the matrix P from the polar decomposition.
+//
+// If dst is empty, PTo will resize dst to be n×n. When dst is non-empty, PTo
+// will panic if dst is not n×n. PTo will also panic if the receiver does not
+// contain a successful factorization.
+func (p *Polar) PTo(dst *SymDense) {
+	if !p.succFact() {
+		panic(badFact)
+	}
+	n := p.svd.vt.Cols
+	if !dst.IsEmpty() && dst.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+	// P = (V * Σ^{1/2}) * (V * Σ^{1/2})ᵀ.
+	var x Dense
+	p.svd.VTo(&x)
+	for j, s := range p.svd.s {
+		s = math.Sqrt(s)
+		for i := 0; i < n; i++ {
+			x.set(i, j, s*x.at(i, j))
+		}
+	}
+	dst.SymOuterK(1, &x)
+}
+
+// Procrustes solves the orthogonal Procrustes problem, finding the n×n
+// orthogonal matrix Q that minimizes
+//
+//	‖A * Q - B‖_F
+//
+// for the m×n matrices A and B, and stores it into dst. The solution is
+// Q = W * Vᵀ where W * Σ * Vᵀ is the singular value decomposition of Aᵀ * B.
+// The solution is unique if Aᵀ * B has full rank. Note that Q may be a
+// reflection; use ProcrustesRotation to constrain Q to be a rotation.
+//
+// If dst is empty, Procrustes will resize dst to be n×n. When dst is non-empty,
+// Procrustes will panic if dst is not n×n. Procrustes will also panic if A and
+// B do not have the same shape.
+//
+// If the singular value decomposition of Aᵀ * B could not be computed,
+// Procrustes returns ErrNoConvergence and dst is not modified.
+func Procrustes(dst *Dense, a, b Matrix) error {
+	_, ok := procrustes(dst, a, b, false)
+	if !ok {
+		return ErrNoConvergence
+	}
+	return nil
+}
+
+// ProcrustesRotation solves the rotation-constrained Procrustes problem,
+// also known as the Wahba or Kabsch problem, finding the n×n rotation matrix Q,
+// that is, the orthogonal matrix with det(Q) = 1, that minimizes
+//
+//	‖A * Q - B‖_F
+//
+// for the m×n matrices A and B, and stores it into dst. The solution is
+//
+//	Q = W * D * Vᵀ
+//
+// where W * Σ * Vᵀ is the singular value decomposition of Aᵀ * B and D is the
+// identity matrix with its last diagonal element replaced by det(W * Vᵀ). The
+// solution is unique if the rank of Aᵀ * B is at least n-1 and, when
+// det(W * Vᵀ) is -1, its smallest singular value is simple.
+//
+// If dst is empty, ProcrustesRotation will resize dst to be n×n. When dst is
+// non-empty, ProcrustesRotation will panic if dst is not n×n.
+// ProcrustesRotation will also panic if A and B do not have the same shape.
+//
+// If the singular value decomposition of Aᵀ * B could not be computed,
+// ProcrustesRotation returns ErrNoConvergence and dst is not modified.
+func ProcrustesRotation(dst *Dense, a, b Matrix) error {
+	_, ok := procrustes(dst, a, b, true)
+	if !ok {
+		return ErrNoConvergence
+	}
+	return nil
+}
+
+// ProcrustesScaled solves the scaled Procrustes problem, finding the n×n
+// orthogonal matrix Q and the scale factor s that minimize
+//
+//	‖s * A * Q - B‖_F
+//
+// for the m×n matrices A and B. If rotation is true, Q is constrained to be a
+// rotation matrix as in ProcrustesRotation, otherwise Q is computed as in
+// Procrustes. The optimal scale factor is
+//
+//	s = trace(Σ * D) / ‖A‖_F²
+//
+// where Σ and D are as described in Procrustes and ProcrustesRotation, and D is
+// the identity matrix if rotation is false. Q is stored into dst and s is
+// returned. If A is zero, s is zero.
+//
+// If dst is empty, ProcrustesScaled will resize dst to be n×n. When dst is
+// non-empty, ProcrustesScaled will panic if dst is not n×n. ProcrustesScaled
+// will also panic if A and B do not have the same shape.
+//
+// If the singular value decomposition of Aᵀ * B could not be computed,
+// ProcrustesScaled returns ErrNoConvergence and dst is not modified.
+func ProcrustesScaled(dst *Dense, a, b Matrix, rotation bool) (scale float64, err error) {
+	tr, ok := procrustes(dst, a, b, rotation)
+	if !ok {
+		return 0, ErrNoConvergence
+	}
+	norm := Norm(a, 2)
+	if norm == 0 {
+		return 0, nil
+	}
+	return tr / norm / norm, nil
+}
+
+// procrustes computes the orthogonal matrix Q that minimizes ‖A * Q - B‖_F,
+// constrained to be a rotation if rotation is true, and stores it into dst. It
+// returns trace(Σ * D) and whether the singular value decomposition of Aᵀ * B
+// succeeded.
+func procrustes(dst *Dense, a, b Matrix, rotation bool) (trace float64, ok bool) {
+	ma, n := a.Dims()
+	mb, nb := b.Dims()
+	if ma != mb || n != nb {
+		panic(ErrShape)
+	}
+	if !dst.IsEmpty() {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+
+	var m Dense
+	m.Mul(a.T(), b)
+	var svd SVD
+	if !svd.Factorize(&m, SVDFull) {
+		return 0, false
+	}
+	s := svd.Values(nil)
+	var w, v Dense
+	svd.UTo(&w)
+	svd.VTo(&v)
+
+	var q Dense
+	q.Mul(&w, v.T())
+	if rotation && Det(&q) < 0 {
+		// Flip the direction of the singular vector corresponding to the
+		// smallest singular value to turn the reflection into a rotation.
+		for i := 0; i < n; i++ {
+			w.set(i, n-1, -w.at(i, n-1))
+		}
+		s[n-1] = -s[n-1]
+		q.Mul(&w, v.T())
+	}
+	for _, v := range s {
+		trace += v
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	}
+	dst.Copy(&q)
+	return trace, true
+}
@@ -0,0 +1,267 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestPolar(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{1, 1, 1},
+		{3, 3, 3},
+		{3, 3, 2},
+		{5, 3, 3},
+		{3, 5, 3},
+		{10, 10, 10},
+		{12, 7, 7},
+		{7, 12, 7},
+	} {
+		m, n := test.m, test.n
+		a := randRankDense(m, n, test.rank, rnd)
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, test.rank)
+
+		var polar Polar
+		if !polar.Factorize(a) {
+			t.Errorf("%s: factorization failed", name)
+			continue
+		}
+		var u Dense
+		polar.UTo(&u)
+		if r, c := u.Dims(); r != m || c != n {
+			t.Errorf("%s: unexpected dimensions of U %d×%d", name, r, c)
+			continue
+		}
+		var p SymDense
+		polar.PTo(&p)
+		if p.SymmetricDim() != n {
+			t.Errorf("%s: unexpected dimension of P %d", name, p.SymmetricDim())
+			continue
+		}
+
+		var got Dense
+		got.Mul(&u, &p)
+		if !EqualApprox(&got, a, tol) {
+			t.Errorf("%s: U * P != A", name)
+		}
+
+		// U has orthonormal columns if m ≥ n and orthonormal rows otherwise.
+		var utu Dense
+		if m >= n {
+			utu.Mul(u.T(), &u)
+			if !EqualApprox(&utu, eye(n), tol) {
+				t.Errorf("%s: columns of U not orthonormal", name)
+			}
+		} else {
+			utu.Mul(&u, u.T())
+			if !EqualApprox(&utu, eye(m), tol) {
+				t.Errorf("%s: rows of U not orthonormal", name)
+			}
+		}
+
+		// P is positive semidefinite and P² = Aᵀ * A.
+		var ed EigenSym
+		if !ed.Factorize(&p, false) {
+			t.Errorf("%s: eigendecomposition of P failed", name)
+			continue
+		}
+		for _, v := range ed.Values(nil) {
+			if v < -tol {
+				t.Errorf("%s: P not positive semidefinite, eigenvalue %v", name, v)
+				break
+			}
+		}
+		var p2, ata Dense
+		p2.Mul(&p, &p)
+		ata.Mul(a.T(), a)
+		if !EqualApprox(&p2, &ata, tol) {
+			t.Errorf("%s: P² != Aᵀ * A", name)
+		}
+
+		// Extraction into non-empty destinations.
+		u2 := NewDense(m, n, nil)
+		polar.UTo(u2)
+		if !Equal(u2, &u) {
+			t.Errorf("%s: unexpected U for non-empty destination", name)
+		}
+		p3 := NewSymDense(n, nil)
+		polar.PTo(p3)
+		if !Equal(p3, &p) {
+			t.Errorf("%s: unexpected P for non-empty destination", name)
+		}
+	}
+
+	var polar Polar
+	if ok, _ := panics(func() { polar.UTo(&Dense{}) }); !ok {
+		t.Error("expected panic for UTo without factorization")
+	}
+	polar.Factorize(eye(3))
+	if ok, _ := panics(func() { polar.PTo(NewSymDense(2, nil)) }); !ok {
+		t.Error("expected panic for PTo with wrong destination size")
+	}
+}
+
+// randOrthogonal returns a random n×n orthogonal matrix with determinant det.
+func randOrthogonal(n int, det float64, rnd *rand.Rand) *Dense {
+	var qr QR
+	qr.Factorize(randNormDense(n, n, rnd))
+	var q Dense
+	qr.QTo(&q)
+	if math.Signbit(Det(&q)) != math.Signbit(det) {
+		for i := 0; i < n; i++ {
+			q.Set(i, 0, -q.At(i, 0))
+		}
+	}
+	return &q
+}
+
+func TestProcrustes(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{2, 2},
+		{3, 3},
+		{10, 3},
+		{4, 4},
+		{20, 6},
+	} {
+		m, n := test.m, test.n
+		for _, det := range []float64{1, -1} {
+			name := fmt.Sprintf("m=%d,n=%d,det=%v", m, n, det)
+			a := randNormDense(m, n, rnd)
+			q0 := randOrthogonal(n, det, rnd)
+			const s0 = 2.5
+			var b Dense
+			b.Mul(a, q0)
+
+			// Exact data is recovered by the orthogonal Procrustes solution.
+			var q Dense
+			err := Procrustes(&q, a, &b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !EqualApprox(&q, q0, tol) {
+				t.Errorf("%s: unexpected orthogonal solution:\ngot: %v\nwant:%v", name, Formatted(&q), Formatted(q0))
+			}
+
+			// The rotation solution recovers rotations, and is always a
+			// rotation.
+			var r Dense
+			err = ProcrustesRotation(&r, a, &b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var rtr Dense
+			rtr.Mul(r.T(), &r)
+			if !EqualApprox(&rtr, eye(n), tol) {
+				t.Errorf("%s: rotation solution not orthogonal", name)
+			}
+			if d := Det(&r); math.Abs(d-1) > tol {
+				t.Errorf("%s: unexpected determinant of rotation solution: %v", name, d)
+			}
+			if det == 1 && !EqualApprox(&r, q0, tol) {
+				t.Errorf("%s: unexpected rotation solution:\ngot: %v\nwant:%v", name, Formatted(&r), Formatted(q0))
+			}
+
+			// The scaled solution recovers the scale factor.
+			var bs Dense
+			bs.Scale(s0, &b)
+			var qs Dense
+			s, err := ProcrustesScaled(&qs, a, &bs, false)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if math.Abs(s-s0) > tol {
+				t.Errorf("%s: unexpected scale: got %v, want %v", name, s, s0)
+			}
+			if !EqualApprox(&qs, q0, tol) {
+				t.Errorf("%s: unexpected scaled orthogonal solution", name)
+			}
+			s, err = ProcrustesScaled(&qs, a, &bs, true)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !EqualApprox(&qs, &r, tol) {
+				t.Errorf("%s: scaled rotation solution does not match rotation solution", name)
+			}
+			if det == 1 && math.Abs(s-s0) > tol {
+				t.Errorf("%s: unexpected scale for rotation: got %v, want %v", name, s, s0)
+			}
+		}
+	}
+}
+
+func TestProcrustesOptimal(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	resid := func(a, q, b Matrix, s float64) float64 {
+		var d Dense
+		d.Mul(a, q)
+		d.Scale(s, &d)
+		d.Sub(&d, b)
+		return Norm(&d, 2)
+	}
+	for _, n := range []int{1, 2, 3, 5} {
+		m := 3 * n
+		for trial := 0; trial < 10; trial++ {
+			name := fmt.Sprintf("n=%d,trial=%d", n, trial)
+			a := randNormDense(m, n, rnd)
+			b := randNormDense(m, n, rnd)
+
+			var q, r, qs Dense
+			if err := Procrustes(&q, a, b); err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			if err := ProcrustesRotation(&r, a, b); err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			s, err := ProcrustesScaled(&qs, a, b, false)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			rq := resid(a, &q, b, 1)
+			rr := resid(a, &r, b, 1)
+			rs := resid(a, &qs, b, s)
+			const tol = 1e-12
+			if rq > rr+tol {
+				t.Errorf("%s: orthogonal residual %v larger than rotation residual %v", name, rq, rr)
+			}
+			if rs > rq+tol {
+				t.Errorf("%s: scaled residual %v larger than unscaled residual %v", name, rs, rq)
+			}
+			for k := 0; k < 10; k++ {
+				det := 1.0
+				if k%2 == 1 {
+					det = -1
+				}
+				p := randOrthogonal(n, det, rnd)
+				if got := resid(a, p, b, 1); got < rq-tol {
+					t.Errorf("%s: orthogonal residual %v not minimal, found %v", name, rq, got)
+				}
+				if det == 1 {
+					if got := resid(a, p, b, 1); got < rr-tol {
+						t.Errorf("%s: rotation residual %v not minimal, found %v", name, rr, got)
+					}
+				}
+			}
+		}
+	}
+}
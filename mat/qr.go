@@ -13,12 +13,35 @@ import (
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
-const badQR = "mat: invalid QR factorization"
+const (
+	badQR          = "mat: invalid QR factorization"
+	badQRAlgorithm = "mat: invalid QR algorithm"
+)
+
+// QRAlgorithm specifies the algorithm used by QR to compute the factorization
+// of a matrix.
+type QRAlgorithm int
+
+const (
+	// QRHouseholder specifies the blocked Householder algorithm.
+	QRHouseholder QRAlgorithm = iota
+	// QRTSQR specifies the tall and skinny QR algorithm. The rows of the
+	// matrix are partitioned into blocks that are factorized concurrently
+	// and the resulting triangular factors are combined with a binary
+	// reduction tree. It is considerably faster than QRHouseholder for
+	// matrices with many more rows than columns on a multi-core machine, at
+	// the cost of more floating point operations and an additional m×n
+	// workspace.
+	QRTSQR
+)
 
 // QR is a type for creating and using the QR factorization of a matrix.
 type QR struct {
-	qr   *Dense
-	q    *Dense
+	qr *Dense
+	q  *Dense
+	// tau holds the scalar factors of the elementary reflectors stored
+	// below the diagonal of qr. It is nil after the factorization has been
+	// updated, in which case Q is held explicitly in q and qr holds only R.
 	tau  []float64
 	cond float64
 }
@@ -120,6 +143,23 @@ func (qr *QR) Factorize(a Matrix) {
 	qr.factorize(a, CondNorm)
 }
 
+// FactorizeAlgorithm computes the QR factorization of an m×n matrix a where
+// m >= n using the specified algorithm. See Factorize for more information.
+//
+// The factorization computed by QRTSQR is stored in the same form as the one
+// computed by QRHouseholder, so all methods of QR can be used with either
+// algorithm.
+func (qr *QR) FactorizeAlgorithm(a Matrix, alg QRAlgorithm) {
+	switch alg {
+	case QRHouseholder:
+		qr.factorize(a, CondNorm)
+	case QRTSQR:
+		qr.factorizeTSQR(a, CondNorm)
+	default:
+		panic(badQRAlgorithm)
+	}
+}
+
 func (qr *QR) factorize(a Matrix, norm lapack.MatrixNorm) {
 	m, n := a.Dims()
 	if m < n {
@@ -157,6 +197,25 @@ func (qr *QR) updateQ() {
 	putFloat64s(work)
 }
 
+// applyQ computes Q*w if trans is blas.NoTrans or Qᵀ*w if trans is blas.Trans,
+// storing the result in-place into w.
+func (qr *QR) applyQ(trans blas.Transpose, w *Dense) {
+	if qr.tau == nil {
+		// Q is held explicitly after an update of the factorization.
+		r, c := w.Dims()
+		tmp := getDenseWorkspace(r, c, false)
+		blas64.Gemm(trans, blas.NoTrans, 1, qr.q.mat, w.mat, 0, tmp.mat)
+		w.Copy(tmp)
+		putDenseWorkspace(tmp)
+		return
+	}
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, trans, qr.qr.mat, qr.tau, w.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
+	putFloat64s(work)
+}
+
 // isValid returns whether the receiver contains a factorization.
 func (qr *QR) isValid() bool {
 	return qr.qr != nil && !qr.qr.IsEmpty()
@@ -287,17 +346,9 @@ func (qr *QR) SolveTo(dst *Dense, trans bool, b Matrix) error {
 		for i := c; i < r; i++ {
 			zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
 		}
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.applyQ(blas.NoTrans, w)
 	} else {
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.applyQ(blas.Trans, w)
 
 		ok := lapack64.Trtrs(blas.NoTrans, t, w.mat)
 		if !ok {
@@ -347,3 +398,347 @@ func (qr *QR) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 	}
 	return qr.SolveTo(dst.asDense(), trans, bm)
 }
+
+// RankOne updates a QR factorization as if a rank-one update had been applied
+// to the original matrix A, storing the result into the receiver. That is, if
+// in the original QR factorization Q * R = A, in the updated factorization
+// Q' * R' = A + alpha * x * yᵀ. The receiver may be orig.
+//
+// The update is computed using Givens rotations in O(m² + mn) operations, as
+// described in Golub and Van Loan, Matrix Computations, Section 6.5.1.
+// RankOne will panic if orig does not contain a factorization, or if x does
+// not have length m or y does not have length n.
+func (qr *QR) RankOne(orig *QR, alpha float64, x, y Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if r, c := x.Dims(); r != m || c != 1 {
+		panic(ErrShape)
+	}
+	if r, c := y.Dims(); r != n || c != 1 {
+		panic(ErrShape)
+	}
+	qr.explicitFrom(orig)
+	q, r := qr.q, qr.qr
+
+	// Compute w = Qᵀ*x.
+	w := getFloat64s(m, true)
+	defer putFloat64s(w)
+	for k := 0; k < m; k++ {
+		xk := x.AtVec(k)
+		if xk == 0 {
+			continue
+		}
+		blas64.Axpy(xk, blas64.Vector{N: m, Inc: 1, Data: q.mat.Data[k*q.mat.Stride:]}, blas64.Vector{N: m, Inc: 1, Data: w})
+	}
+
+	// Reduce w to a multiple of the first unit vector using rotations from
+	// the bottom, which transforms R into upper Hessenberg form.
+	for k := m - 1; k > 0; k-- {
+		c, s, rr := lapack64.Lartg(w[k-1], w[k])
+		w[k-1] = rr
+		w[k] = 0
+		if k-1 < n {
+			rotRows(r, k-1, k, k-1, n, c, s)
+		}
+		rotCols(q, k-1, k, c, s)
+	}
+
+	// Add alpha*w[0]*e_0*yᵀ to the Hessenberg matrix.
+	for j := 0; j < n; j++ {
+		r.set(0, j, r.at(0, j)+alpha*w[0]*y.AtVec(j))
+	}
+
+	qr.restoreTriangle(0)
+	qr.updateCond(CondNorm)
+}
+
+// InsertRow updates a QR factorization of the m×n matrix A in orig to be the
+// factorization of the (m+1)×n matrix formed by inserting x as a new row of A
+// at index i, storing the result into the receiver. The rows of A at index i
+// and beyond are moved down by one. The receiver may be orig.
+//
+// The update is computed using Givens rotations, as described in Golub and Van
+// Loan, Matrix Computations, Section 6.5.3.
+// InsertRow will panic if orig does not contain a factorization, if i is not in
+// [0, m] or if x does not have length n.
+func (qr *QR) InsertRow(orig *QR, i int, x Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if i < 0 || m < i {
+		panic(ErrRowAccess)
+	}
+	if r, c := x.Dims(); r != n || c != 1 {
+		panic(ErrShape)
+	}
+	orig.ensureQ()
+
+	// Form the factorization
+	//  P * [xᵀ] = P * [1 0] * [xᵀ]
+	//      [A ]       [0 Q]   [R ]
+	// where P moves the first row to row i, so that the right factor is
+	// upper Hessenberg.
+	q := NewDense(m+1, m+1, nil)
+	q.set(i, 0, 1)
+	for k := 0; k < m; k++ {
+		kk := k
+		if k >= i {
+			kk++
+		}
+		copy(q.mat.Data[kk*q.mat.Stride+1:kk*q.mat.Stride+m+1], orig.q.mat.Data[k*orig.q.mat.Stride:k*orig.q.mat.Stride+m])
+	}
+	r := NewDense(m+1, n, nil)
+	for j := 0; j < n; j++ {
+		r.set(0, j, x.AtVec(j))
+		for k := 0; k <= j && k < m; k++ {
+			r.set(k+1, j, orig.qr.at(k, j))
+		}
+	}
+
+	qr.q = q
+	qr.qr = r
+	qr.tau = nil
+	qr.restoreTriangle(0)
+	qr.updateCond(CondNorm)
+}
+
+// DeleteRow updates a QR factorization of the m×n matrix A in orig to be the
+// factorization of the (m-1)×n matrix formed by deleting row i of A, storing
+// the result into the receiver. The receiver may be orig.
+//
+// The update is computed using Givens rotations, as described in Golub and Van
+// Loan, Matrix Computations, Section 6.5.3.
+// DeleteRow will panic if orig does not contain a factorization, if i is not in
+// [0, m) or if m-1 < n.
+func (qr *QR) DeleteRow(orig *QR, i int) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if i < 0 || m <= i {
+		panic(ErrRowAccess)
+	}
+	if m-1 < n {
+		panic(ErrShape)
+	}
+	qr.explicitFrom(orig)
+	q, r := qr.q, qr.qr
+
+	// Reduce row i of Q to a multiple of the first unit vector using
+	// rotations from the right, which transforms R into upper Hessenberg
+	// form. Column 0 of Q is then ±e_i, so removing it together with the
+	// first row of R leaves a factorization of A without row i.
+	for k := m - 1; k > 0; k-- {
+		c, s, _ := lapack64.Lartg(q.at(i, k-1), q.at(i, k))
+		rotCols(q, k-1, k, c, s)
+		q.set(i, k, 0)
+		if k-1 < n {
+			rotRows(r, k-1, k, k-1, n, c, s)
+		}
+	}
+
+	qNew := NewDense(m-1, m-1, nil)
+	for k := 0; k < m; k++ {
+		if k == i {
+			continue
+		}
+		kk := k
+		if k > i {
+			kk--
+		}
+		copy(qNew.mat.Data[kk*qNew.mat.Stride:kk*qNew.mat.Stride+m-1], q.mat.Data[k*q.mat.Stride+1:k*q.mat.Stride+m])
+	}
+	rNew := NewDense(m-1, n, nil)
+	for k := 0; k < n; k++ {
+		copy(rNew.mat.Data[k*rNew.mat.Stride+k:k*rNew.mat.Stride+n], r.mat.Data[(k+1)*r.mat.Stride+k:(k+1)*r.mat.Stride+n])
+	}
+	qr.q = qNew
+	qr.qr = rNew
+	qr.updateCond(CondNorm)
+}
+
+// InsertCol updates a QR factorization of the m×n matrix A in orig to be the
+// factorization of the m×(n+1) matrix formed by inserting x as a new column of
+// A at index j, storing the result into the receiver. The columns of A at
+// index j and beyond are moved right by one. The receiver may be orig.
+//
+// The update is computed using Givens rotations, as described in Golub and Van
+// Loan, Matrix Computations, Section 6.5.2.
+// InsertCol will panic if orig does not contain a factorization, if j is not in
+// [0, n], if x does not have length m or if m < n+1.
+func (qr *QR) InsertCol(orig *QR, j int, x Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if j < 0 || n < j {
+		panic(ErrColAccess)
+	}
+	if r, c := x.Dims(); r != m || c != 1 {
+		panic(ErrShape)
+	}
+	if m < n+1 {
+		panic(ErrShape)
+	}
+	orig.ensureQ()
+
+	q := NewDense(m, m, nil)
+	q.Copy(orig.q)
+	r := NewDense(m, n+1, nil)
+	for k := 0; k < n; k++ {
+		kk := k
+		if k >= j {
+			kk++
+		}
+		for l := 0; l <= k; l++ {
+			r.set(l, kk, orig.qr.at(l, k))
+		}
+	}
+	// Set column j of R to Qᵀ*x.
+	for k := 0; k < m; k++ {
+		xk := x.AtVec(k)
+		if xk == 0 {
+			continue
+		}
+		blas64.Axpy(xk,
+			blas64.Vector{N: m, Inc: 1, Data: q.mat.Data[k*q.mat.Stride:]},
+			blas64.Vector{N: m, Inc: r.mat.Stride, Data: r.mat.Data[j:]})
+	}
+
+	// Zero the new column below the diagonal using rotations from the
+	// bottom. The rotations only introduce fill on the diagonal of the
+	// columns to the right, so R stays upper triangular.
+	for k := m - 1; k > j; k-- {
+		c, s, rr := lapack64.Lartg(r.at(k-1, j), r.at(k, j))
+		r.set(k-1, j, rr)
+		r.set(k, j, 0)
+		rotRows(r, k-1, k, j+1, n+1, c, s)
+		rotCols(q, k-1, k, c, s)
+	}
+
+	qr.q = q
+	qr.qr = r
+	qr.tau = nil
+	qr.updateCond(CondNorm)
+}
+
+// DeleteCol updates a QR factorization of the m×n matrix A in orig to be the
+// factorization of the m×(n-1) matrix formed by deleting column j of A,
+// storing the result into the receiver. The receiver may be orig.
+//
+// The update is computed using Givens rotations, as described in Golub and Van
+// Loan, Matrix Computations, Section 6.5.2.
+// DeleteCol will panic if orig does not contain a factorization, if j is not in
+// [0, n) or if n == 1.
+func (qr *QR) DeleteCol(orig *QR, j int) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if j < 0 || n <= j {
+		panic(ErrColAccess)
+	}
+	if n == 1 {
+		panic(ErrShape)
+	}
+	orig.ensureQ()
+
+	q := NewDense(m, m, nil)
+	q.Copy(orig.q)
+	r := NewDense(m, n-1, nil)
+	for k := 0; k < n; k++ {
+		if k == j {
+			continue
+		}
+		kk := k
+		if k > j {
+			kk--
+		}
+		for l := 0; l <= k; l++ {
+			r.set(l, kk, orig.qr.at(l, k))
+		}
+	}
+
+	qr.q = q
+	qr.qr = r
+	qr.tau = nil
+	// Removing column j leaves R upper Hessenberg from column j on.
+	qr.restoreTriangle(j)
+	qr.updateCond(CondNorm)
+}
+
+// ensureQ computes the explicit orthonormal matrix Q if it is not already
+// held by the receiver.
+func (qr *QR) ensureQ() {
+	if qr.q == nil || qr.q.IsEmpty() {
+		qr.updateQ()
+	}
+}
+
+// explicitFrom sets the receiver to the factorization in orig, holding Q
+// explicitly in qr.q and R in the upper triangle of qr.qr with zeros below.
+func (qr *QR) explicitFrom(orig *QR) {
+	orig.ensureQ()
+	householder := orig.tau != nil
+	if orig != qr {
+		if qr.q == nil {
+			qr.q = &Dense{}
+		}
+		if qr.qr == nil {
+			qr.qr = &Dense{}
+		}
+		qr.q.CloneFrom(orig.q)
+		qr.qr.CloneFrom(orig.qr)
+		qr.cond = orig.cond
+	}
+	if householder {
+		// Remove the elementary reflectors from below the diagonal.
+		r, c := qr.qr.Dims()
+		for i := 1; i < r; i++ {
+			zero(qr.qr.mat.Data[i*qr.qr.mat.Stride : i*qr.qr.mat.Stride+min(i, c)])
+		}
+	}
+	qr.tau = nil
+}
+
+// restoreTriangle reduces the upper Hessenberg matrix R held by the receiver
+// whose subdiagonal is nonzero only in columns j and beyond to upper triangular
+// form using Givens rotations, updating the explicit Q accordingly.
+func (qr *QR) restoreTriangle(j int) {
+	m, n := qr.qr.Dims()
+	q, r := qr.q, qr.qr
+	for k := j; k < min(n, m-1); k++ {
+		c, s, rr := lapack64.Lartg(r.at(k, k), r.at(k+1, k))
+		r.set(k, k, rr)
+		r.set(k+1, k, 0)
+		rotRows(r, k, k+1, k+1, n, c, s)
+		rotCols(q, k, k+1, c, s)
+	}
+}
+
+// rotRows applies the plane rotation defined by c and s to rows i and k of m
+// in the columns [j0, j1).
+func rotRows(m *Dense, i, k, j0, j1 int, c, s float64) {
+	if j0 >= j1 {
+		return
+	}
+	stride := m.mat.Stride
+	blas64.Rot(
+		blas64.Vector{N: j1 - j0, Inc: 1, Data: m.mat.Data[i*stride+j0:]},
+		blas64.Vector{N: j1 - j0, Inc: 1, Data: m.mat.Data[k*stride+j0:]},
+		c, s)
+}
+
+// rotCols applies the plane rotation defined by c and s to columns i and k of
+// m.
+func rotCols(m *Dense, i, k int, c, s float64) {
+	r, _ := m.Dims()
+	stride := m.mat.Stride
+	blas64.Rot(
+		blas64.Vector{N: r, Inc: stride, Data: m.mat.Data[i:]},
+		blas64.Vector{N: r, Inc: stride, Data: m.mat.Data[k:]},
+		c, s)
+}
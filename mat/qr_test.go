@@ -248,3 +248,203 @@ func TestQRSolveCondTo(t *testing.T) {
 		}
 	}
 }
+
+// checkQRUpdate checks that qr is a valid QR factorization of a and that it
+// gives the same least squares solution as a factorization of a computed from
+// scratch.
+func checkQRUpdate(t *testing.T, name string, qr *QR, a *Dense, rnd *rand.Rand) {
+	t.Helper()
+	const tol = 1e-12
+
+	m, n := a.Dims()
+	if r, c := qr.Dims(); r != m || c != n {
+		t.Errorf("%s: unexpected dimensions: got %d×%d, want %d×%d", name, r, c, m, n)
+		return
+	}
+
+	var q, r Dense
+	qr.QTo(&q)
+	qr.RTo(&r)
+	if !isOrthonormal(&q, tol) {
+		t.Errorf("%s: Q is not orthonormal", name)
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < min(i, n); j++ {
+			if r.At(i, j) != 0 {
+				t.Errorf("%s: R is not upper triangular at (%d,%d)", name, i, j)
+			}
+		}
+	}
+	var got Dense
+	got.Mul(&q, &r)
+	if !EqualApprox(&got, a, tol) {
+		t.Errorf("%s: Q*R != A", name)
+	}
+	if !EqualApprox(qr, a, tol) {
+		t.Errorf("%s: A and QR are not equal using At", name)
+	}
+
+	b := NewDense(m, 2, nil)
+	b.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, b)
+	var want QR
+	want.Factorize(a)
+	var x, xWant Dense
+	err := qr.SolveTo(&x, false, b)
+	if err != nil {
+		t.Errorf("%s: unexpected error from SolveTo: %v", name, err)
+	}
+	_ = want.SolveTo(&xWant, false, b)
+	if !EqualApprox(&x, &xWant, 1e-10) {
+		t.Errorf("%s: unexpected solution from SolveTo", name)
+	}
+	if math.Abs(qr.Cond()-want.Cond()) > 1e-8*want.Cond() {
+		t.Errorf("%s: unexpected condition number: got %v, want %v", name, qr.Cond(), want.Cond())
+	}
+}
+
+func TestQRRankOne(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 1}, {3, 3}, {5, 3}, {10, 10}, {20, 7}} {
+		m, n := dims[0], dims[1]
+		a := NewDense(m, n, nil)
+		a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+		var qr QR
+		qr.Factorize(a)
+
+		x := NewVecDense(m, nil)
+		for i := 0; i < m; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+		}
+		y := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			y.SetVec(i, rnd.NormFloat64())
+		}
+		alpha := rnd.NormFloat64()
+		var want Dense
+		want.Outer(alpha, x, y)
+		want.Add(a, &want)
+
+		// Update into a new receiver.
+		var up QR
+		up.RankOne(&qr, alpha, x, y)
+		checkQRUpdate(t, "new receiver", &up, &want, rnd)
+		if !EqualApprox(&qr, a, 1e-12) {
+			t.Errorf("m=%d,n=%d: original factorization modified", m, n)
+		}
+
+		// Update in place, twice, to exercise updates of an updated
+		// factorization.
+		qr.RankOne(&qr, alpha, x, y)
+		checkQRUpdate(t, "in place", &qr, &want, rnd)
+		qr.RankOne(&qr, -alpha, x, y)
+		checkQRUpdate(t, "in place twice", &qr, a, rnd)
+	}
+}
+
+func TestQRInsertDeleteRow(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 1}, {3, 3}, {5, 3}, {10, 10}, {20, 7}} {
+		m, n := dims[0], dims[1]
+		a := NewDense(m, n, nil)
+		a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+		for _, i := range []int{0, m / 2, m} {
+			var qr QR
+			qr.Factorize(a)
+
+			x := make([]float64, n)
+			for j := range x {
+				x[j] = rnd.NormFloat64()
+			}
+			want := NewDense(m+1, n, nil)
+			want.Apply(func(r, c int, _ float64) float64 {
+				switch {
+				case r < i:
+					return a.At(r, c)
+				case r == i:
+					return x[c]
+				default:
+					return a.At(r-1, c)
+				}
+			}, want)
+
+			qr.InsertRow(&qr, i, NewVecDense(n, x))
+			checkQRUpdate(t, "insert row", &qr, want, rnd)
+
+			qr.DeleteRow(&qr, i)
+			checkQRUpdate(t, "delete row", &qr, a, rnd)
+
+			if m > n {
+				// Delete a row from a Householder factorization
+				// into a new receiver.
+				var orig, up QR
+				orig.Factorize(a)
+				k := min(i, m-1)
+				up.DeleteRow(&orig, k)
+				want := NewDense(m-1, n, nil)
+				want.Apply(func(r, c int, _ float64) float64 {
+					if r < k {
+						return a.At(r, c)
+					}
+					return a.At(r+1, c)
+				}, want)
+				checkQRUpdate(t, "delete row new receiver", &up, want, rnd)
+				if !EqualApprox(&orig, a, 1e-12) {
+					t.Errorf("m=%d,n=%d: original factorization modified", m, n)
+				}
+			}
+		}
+	}
+}
+
+func TestQRInsertDeleteCol(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{2, 1}, {4, 3}, {5, 3}, {11, 10}, {20, 7}} {
+		m, n := dims[0], dims[1]
+		a := NewDense(m, n, nil)
+		a.Apply(func(_, _ int, _ float64) float64 { return rnd.NormFloat64() }, a)
+		for _, j := range []int{0, n / 2, n} {
+			var qr QR
+			qr.Factorize(a)
+
+			x := make([]float64, m)
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			want := NewDense(m, n+1, nil)
+			want.Apply(func(r, c int, _ float64) float64 {
+				switch {
+				case c < j:
+					return a.At(r, c)
+				case c == j:
+					return x[r]
+				default:
+					return a.At(r, c-1)
+				}
+			}, want)
+
+			qr.InsertCol(&qr, j, NewVecDense(m, x))
+			checkQRUpdate(t, "insert col", &qr, want, rnd)
+
+			qr.DeleteCol(&qr, j)
+			checkQRUpdate(t, "delete col", &qr, a, rnd)
+
+			if n > 1 {
+				var orig, up QR
+				orig.Factorize(a)
+				k := min(j, n-1)
+				up.DeleteCol(&orig, k)
+				want := NewDense(m, n-1, nil)
+				want.Apply(func(r, c int, _ float64) float64 {
+					if c < k {
+						return a.At(r, c)
+					}
+					return a.At(r, c+1)
+				}, want)
+				checkQRUpdate(t, "delete col new receiver", &up, want, rnd)
+			}
+		}
+	}
+}
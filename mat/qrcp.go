@@ -0,0 +1,265 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badQRCP = "mat: invalid QRCP factorization"
+
+// QRCP is a type for creating and using the QR factorization with column
+// pivoting of a matrix.
+//
+// The column pivoted QR factorization of an m×n matrix A with m >= n is
+//
+//	A * P = Q * R,
+//
+// where P is an n×n permutation matrix, Q is an m×m orthonormal matrix and R
+// is an m×n upper triangular matrix. The permutation is chosen so that the
+// magnitudes of the diagonal elements of R are non-increasing, which makes
+// the factorization suitable for revealing the numerical rank of A.
+type QRCP struct {
+	qr QR
+	// piv holds the column permutation. The jth column of A*P is
+	// the piv[j]th column of A.
+	piv []int
+}
+
+// Dims returns the dimensions of the matrix.
+func (qr *QRCP) Dims() (r, c int) {
+	return qr.qr.Dims()
+}
+
+// At returns the element at row i, column j. At will panic if the receiver
+// does not contain a successful factorization.
+func (qr *QRCP) At(i, j int) float64 {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+
+	m, n := qr.Dims()
+	if uint(i) >= uint(m) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	// Column j of A is the column k of Q*R for which piv[k] == j.
+	for k, p := range qr.piv {
+		if p == j {
+			return qr.qr.At(i, k)
+		}
+	}
+	panic("mat: invalid column permutation")
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (qr *QRCP) T() Matrix {
+	return Transpose{qr}
+}
+
+// Factorize computes the column pivoted QR factorization of an m×n matrix a
+// where m >= n. The factorization always exists even if A is singular.
+//
+// The factorization is such that A * P = Q * R. Q and R can be extracted using
+// the QTo and RTo methods, and the permutation P using the ColPivots method.
+func (qr *QRCP) Factorize(a Matrix) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	f := &qr.qr
+	if f.qr == nil {
+		f.qr = &Dense{}
+	}
+	f.qr.CloneFrom(a)
+	f.tau = make([]float64, n)
+	qr.piv = useInt(qr.piv, n)
+	for i := range qr.piv {
+		qr.piv[i] = -1
+	}
+	work := []float64{0}
+	lapack64.Geqp3(f.qr.mat, qr.piv, f.tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqp3(f.qr.mat, qr.piv, f.tau, work, len(work))
+	putFloat64s(work)
+	f.updateCond(CondNorm)
+	if f.q != nil {
+		f.q.Reset()
+	}
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *QRCP) isValid() bool {
+	return qr.qr.isValid()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (qr *QRCP) Cond() float64 {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+	return qr.qr.cond
+}
+
+// Rank returns the numerical rank of the factorized matrix, that is, the
+// number of diagonal elements of R whose magnitude is greater than tol times
+// the magnitude of the largest one. A typical choice of tol is max(m,n)*ε
+// where ε is the machine epsilon. Rank will panic if the receiver does not
+// contain a factorization or if tol is negative.
+func (qr *QRCP) Rank(tol float64) int {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+	if tol < 0 {
+		panic(badRcond)
+	}
+	return rankFromDiag(qr.qr.qr, tol)
+}
+
+// rankFromDiag returns the number of diagonal elements of the upper
+// triangular matrix r whose magnitude is greater than tol times the magnitude
+// of r[0,0]. The diagonal elements of r must be non-increasing in magnitude.
+func rankFromDiag(r *Dense, tol float64) int {
+	m, n := r.Dims()
+	r00 := math.Abs(r.at(0, 0))
+	if r00 == 0 {
+		return 0
+	}
+	var rank int
+	for i := 0; i < min(m, n); i++ {
+		if math.Abs(r.at(i, i)) <= tol*r00 {
+			break
+		}
+		rank++
+	}
+	return rank
+}
+
+// ColPivots returns the column permutation that represents the permutation
+// matrix P from the factorization
+//
+//	A * P = Q * R,
+//
+// that is, the jth column of A * P is the dst[j]th column of A.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the number of columns of the factorized
+// matrix, ColPivots will panic. ColPivots will panic if the receiver does not
+// contain a factorization.
+func (qr *QRCP) ColPivots(dst []int) []int {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+	_, n := qr.Dims()
+	if dst == nil {
+		dst = make([]int, n)
+	}
+	if len(dst) != n {
+		panic(badSliceLength)
+	}
+	copy(dst, qr.piv)
+	return dst
+}
+
+// RTo extracts the m×n upper trapezoidal matrix R from a column pivoted QR
+// decomposition.
+//
+// If dst is empty, RTo will resize dst to be m×n. When dst is non-empty,
+// RTo will panic if dst is not m×n. RTo will also panic if the receiver
+// does not contain a successful factorization.
+func (qr *QRCP) RTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+	qr.qr.RTo(dst)
+}
+
+// QTo extracts the m×m orthonormal matrix Q from a column pivoted QR
+// decomposition.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is non-empty,
+// QTo will panic if dst is not m×m. QTo will also panic if the receiver
+// does not contain a successful factorization.
+func (qr *QRCP) QTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+	qr.qr.QTo(dst)
+}
+
+// SolveTo finds a solution to a system of linear equations defined by the
+// matrices A and b, where A is an m×n matrix represented in its column pivoted
+// QR factorized form. If A is singular or near-singular a Condition error is
+// returned. See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find X such that ||A*X - B||_2 is minimized.
+//	If trans == true, find the minimum norm solution of Aᵀ * X = B.
+//
+// The solution matrix, X, is stored in place into dst. For rank deficient A,
+// COD.SolveTo should be used to obtain the minimum norm solution.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (qr *QRCP) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+
+	if trans {
+		_, c := qr.Dims()
+		if br, _ := b.Dims(); br != c {
+			panic(ErrShape)
+		}
+		// Aᵀ * X = P * Rᵀ * Qᵀ * X = B, so solve (Q*R)ᵀ * X = Pᵀ * B.
+		var pb Dense
+		pb.CloneFrom(b)
+		pb.PermuteRows(qr.piv, false)
+		return qr.qr.SolveTo(dst, true, &pb)
+	}
+	// A * X = Q * R * Pᵀ * X, so solve for Pᵀ * X and permute the result.
+	err := qr.qr.SolveTo(dst, false, b)
+	dst.PermuteRows(qr.piv, true)
+	return err
+}
+
+// SolveVecTo finds a solution to a system of linear equations,
+//
+//	Ax = b.
+//
+// See QRCP.SolveTo for the full documentation.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (qr *QRCP) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !qr.isValid() {
+		panic(badQRCP)
+	}
+
+	r, c := qr.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+
+	bm := Matrix(b)
+	if rv, ok := b.(RawVectorer); ok {
+		bmat := rv.RawVector()
+		if dst != b {
+			dst.checkOverlap(bmat)
+		}
+		b := VecDense{mat: bmat}
+		bm = b.asDense()
+	}
+	if trans {
+		dst.reuseAsNonZeroed(r)
+	} else {
+		dst.reuseAsNonZeroed(c)
+	}
+	return qr.SolveTo(dst.asDense(), trans, bm)
+}
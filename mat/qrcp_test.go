@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// randRankDense returns an m×n matrix of rank k with standard normal factors.
+func randRankDense(m, n, k int, rnd *rand.Rand) *Dense {
+	a := NewDense(m, n, nil)
+	if k == 0 {
+		return a
+	}
+	a.Mul(randNormDense(m, k, rnd), randNormDense(k, n, rnd))
+	return a
+}
+
+func TestQRCP(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{3, 3},
+		{5, 3},
+		{10, 10},
+		{12, 7},
+		{50, 30},
+	} {
+		m, n := test.m, test.n
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := randNormDense(m, n, rnd)
+
+		var qr QRCP
+		qr.Factorize(a)
+		if !EqualApprox(a, &qr, tol) {
+			t.Errorf("%s: A and QRCP are not equal", name)
+		}
+
+		var q, r Dense
+		qr.QTo(&q)
+		if !isOrthonormal(&q, tol) {
+			t.Errorf("%s: Q is not orthonormal", name)
+		}
+		qr.RTo(&r)
+		for i := 1; i < n; i++ {
+			if math.Abs(r.At(i, i)) > math.Abs(r.At(i-1, i-1)) {
+				t.Errorf("%s: diagonal of R not non-increasing in magnitude", name)
+				break
+			}
+		}
+
+		piv := qr.ColPivots(nil)
+		seen := make([]bool, n)
+		for _, p := range piv {
+			if p < 0 || n <= p || seen[p] {
+				t.Fatalf("%s: invalid permutation %v", name, piv)
+			}
+			seen[p] = true
+		}
+		var ap, qrp Dense
+		ap.CloneFrom(a)
+		ap.PermuteCols(piv, false)
+		qrp.Mul(&q, &r)
+		if !EqualApprox(&ap, &qrp, tol) {
+			t.Errorf("%s: A*P != Q*R", name)
+		}
+
+		if rank := qr.Rank(1e-12); rank != n {
+			t.Errorf("%s: unexpected rank of full rank matrix: got %d, want %d", name, rank, n)
+		}
+	}
+}
+
+func TestQRCPRank(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k int
+	}{
+		{3, 3, 0},
+		{3, 3, 1},
+		{5, 4, 2},
+		{10, 10, 9},
+		{20, 10, 5},
+		{40, 25, 13},
+	} {
+		m, n, k := test.m, test.n, test.k
+		a := randRankDense(m, n, k, rnd)
+		var qr QRCP
+		qr.Factorize(a)
+		tol := float64(max(m, n)) * dlamchE * 10
+		if rank := qr.Rank(tol); rank != k {
+			t.Errorf("m=%d,n=%d: unexpected rank: got %d, want %d", m, n, rank, k)
+		}
+	}
+
+	panicked, message := panics(func() {
+		var qr QRCP
+		qr.Factorize(eye(3))
+		qr.Rank(-1)
+	})
+	if !panicked || message != badRcond {
+		t.Errorf("expected panic for negative tolerance, got %q", message)
+	}
+}
+
+func TestQRCPSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []bool{false, true} {
+		for _, test := range []struct {
+			m, n, bc int
+		}{
+			{5, 5, 1},
+			{5, 5, 3},
+			{8, 5, 1},
+			{8, 5, 4},
+			{30, 20, 2},
+		} {
+			m, n, bc := test.m, test.n, test.bc
+			name := fmt.Sprintf("trans=%t,m=%d,n=%d,bc=%d", trans, m, n, bc)
+			a := randNormDense(m, n, rnd)
+			br := m
+			if trans {
+				br = n
+			}
+			b := randNormDense(br, bc, rnd)
+
+			var qr QR
+			qr.Factorize(a)
+			var want Dense
+			if err := qr.SolveTo(&want, trans, b); err != nil {
+				t.Fatalf("%s: unexpected QR error: %v", name, err)
+			}
+
+			var qrcp QRCP
+			qrcp.Factorize(a)
+			var got Dense
+			if err := qrcp.SolveTo(&got, trans, b); err != nil {
+				t.Fatalf("%s: unexpected QRCP error: %v", name, err)
+			}
+			if !EqualApprox(&got, &want, tol) {
+				t.Errorf("%s: QRCP solution does not match QR solution", name)
+			}
+
+			if bc == 1 {
+				var x VecDense
+				if err := qrcp.SolveVecTo(&x, trans, b.ColView(0)); err != nil {
+					t.Fatalf("%s: unexpected QRCP error: %v", name, err)
+				}
+				if !EqualApprox(&x, want.ColView(0), tol) {
+					t.Errorf("%s: QRCP vector solution does not match QR solution", name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// SolveCARE solves the continuous-time algebraic Riccati equation
+//
+//	Aᵀ * X + X * A - X * B * R⁻¹ * Bᵀ * X + Q = 0
+//
+// for its stabilizing solution X, placing the result in the receiver, where A
+// and Q are n×n matrices, B is an n×m matrix and R is an m×m symmetric
+// positive definite matrix. The solution X is symmetric and stabilizing, that
+// is, all eigenvalues of A - B*R⁻¹*Bᵀ*X have negative real part. It exists and
+// is unique if (A, B) is stabilizable and the Hamiltonian matrix
+//
+//	H = [ A   -G ]
+//	    [ -Q  -Aᵀ]
+//
+// where G = B*R⁻¹*Bᵀ has no eigenvalues on the imaginary axis, which holds for
+// example if Q is positive semi-definite and (Q, A) is detectable.
+//
+// The implementation uses the Schur method of Laub, "A Schur method for
+// solving algebraic Riccati equations", IEEE Trans. Automat. Control 24(6)
+// (1979). The Schur vectors of H are reordered so that the leading n columns
+// [U₁₁; U₂₁] span its stable invariant subspace, and X = U₂₁ * U₁₁⁻¹.
+//
+// SolveCARE returns ErrNotPSD if R is not positive definite and ErrFailedEigen
+// if the Schur decomposition of H fails. If H does not have exactly n
+// eigenvalues with negative real part, there is no stabilizing solution and a
+// Condition error with value +Inf is returned. In these cases the receiver is
+// not modified. Otherwise a Condition error is returned if U₁₁ is
+// ill-conditioned or if the relative residual of the computed solution
+//
+//	|Aᵀ*X + X*A - X*G*X + Q|_F / (2*|X*A|_F + |X*G*X|_F + |Q|_F)
+//
+// is large, and the value of the Condition is the larger of the condition
+// number of U₁₁ and the relative residual in units of the machine epsilon. The
+// receiver holds the computed solution whenever such an error is returned.
+//
+// SolveCARE will panic if A is not square or if the dimensions of B, Q and R
+// do not match A.
+func (s *SymDense) SolveCARE(a, b Matrix, q, r Symmetric) error {
+	n := checkRiccatiDims(a, b, q, r)
+
+	g, err := riccatiGain(b, r)
+	if err != nil {
+		return err
+	}
+
+	h := NewDense(2*n, 2*n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.set(i, j, a.At(i, j))
+			h.set(i, n+j, -g.at(i, j))
+			h.set(n+i, j, -q.At(i, j))
+			h.set(n+i, n+j, -a.At(j, i))
+		}
+	}
+	x := NewSymDense(n, nil)
+	cond, err := riccatiSolution(x, h, func(λ complex128) bool {
+		return real(λ) < 0
+	})
+	if err != nil {
+		return err
+	}
+
+	// Compute the residual R = Aᵀ*X + X*A - X*G*X + Q.
+	xa := NewDense(n, n, nil)
+	xa.Mul(x, a)
+	xgx := NewDense(n, n, nil)
+	xgx.Mul(g, x)
+	xgx.Mul(x, xgx)
+	res := NewDense(n, n, nil)
+	res.Add(xa, xa.T())
+	res.Sub(res, xgx)
+	res.Add(res, q)
+	xanorm := xa.Norm(2)
+	rel := relResidual(res.Norm(2), xanorm, xanorm, xgx.Norm(2), Norm(q, 2))
+
+	s.reuseAsNonZeroed(n)
+	s.CopySym(x)
+	return checkSolution(cond, rel)
+}
+
+// SolveDARE solves the discrete-time algebraic Riccati equation
+//
+//	Aᵀ * X * A - X - Aᵀ * X * B * (R + Bᵀ * X * B)⁻¹ * Bᵀ * X * A + Q = 0
+//
+// for its stabilizing solution X, placing the result in the receiver, where A
+// and Q are n×n matrices, B is an n×m matrix and R is an m×m symmetric
+// positive definite matrix. The solution X is symmetric and stabilizing, that
+// is, all eigenvalues of A - B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A lie inside the unit
+// circle. It exists and is unique if (A, B) is stabilizable and the
+// symplectic matrix
+//
+//	S = [ A + G*A⁻ᵀ*Q  -G*A⁻ᵀ ]
+//	    [ -A⁻ᵀ*Q        A⁻ᵀ   ]
+//
+// where G = B*R⁻¹*Bᵀ has no eigenvalues on the unit circle, which holds for
+// example if Q is positive semi-definite and (Q, A) is detectable.
+//
+// The implementation uses the Schur method of Laub, "A Schur method for
+// solving algebraic Riccati equations", IEEE Trans. Automat. Control 24(6)
+// (1979), applied to S. The Schur vectors of S are reordered so that the
+// leading n columns [U₁₁; U₂₁] span its stable invariant subspace, and
+// X = U₂₁ * U₁₁⁻¹. Forming S requires A to be nonsingular; if A is singular or
+// near-singular a Condition error with the condition number of A is returned.
+//
+// SolveDARE returns ErrNotPSD if R is not positive definite and ErrFailedEigen
+// if the Schur decomposition of S fails. If S does not have exactly n
+// eigenvalues inside the unit circle, there is no stabilizing solution and a
+// Condition error with value +Inf is returned. In these cases the receiver is
+// not modified. Otherwise failures are reported as for SolveCARE, with the
+// relative residual
+//
+//	|Aᵀ*X*A - X - K + Q|_F / (|Aᵀ*X*A|_F + |X|_F + |K|_F + |Q|_F)
+//
+// where K = Aᵀ*X*B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A.
+//
+// SolveDARE will panic if A is not square or if the dimensions of B, Q and R
+// do not match A.
+func (s *SymDense) SolveDARE(a, b Matrix, q, r Symmetric) error {
+	n := checkRiccatiDims(a, b, q, r)
+	_, m := b.Dims()
+
+	g, err := riccatiGain(b, r)
+	if err != nil {
+		return err
+	}
+
+	// Compute W = A⁻ᵀ.
+	var lu LU
+	lu.Factorize(a)
+	if cond := lu.Cond(); cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	w := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		w.set(i, i, 1)
+	}
+	lu.SolveTo(w, true, w)
+
+	gw := NewDense(n, n, nil)
+	gw.Mul(g, w)
+	s11 := NewDense(n, n, nil)
+	s11.Mul(gw, q)
+	s11.Add(s11, a)
+	s21 := NewDense(n, n, nil)
+	s21.Mul(w, q)
+	h := NewDense(2*n, 2*n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.set(i, j, s11.at(i, j))
+			h.set(i, n+j, -gw.at(i, j))
+			h.set(n+i, j, -s21.at(i, j))
+			h.set(n+i, n+j, w.at(i, j))
+		}
+	}
+	x := NewSymDense(n, nil)
+	cond, err := riccatiSolution(x, h, func(λ complex128) bool {
+		return cmplx.Abs(λ) < 1
+	})
+	if err != nil {
+		return err
+	}
+
+	// Compute the residual
+	//  R = Aᵀ*X*A - X - Aᵀ*X*B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A + Q.
+	xa := NewDense(n, n, nil)
+	xa.Mul(x, a)
+	atxa := NewDense(n, n, nil)
+	atxa.Mul(a.T(), xa)
+	bxa := NewDense(m, n, nil)
+	bxa.Mul(b.T(), xa)
+	bx := NewDense(m, n, nil)
+	bx.Mul(b.T(), x)
+	rbxb := NewDense(m, m, nil)
+	rbxb.Mul(bx, b)
+	rbxb.Add(rbxb, r)
+	var gain Dense
+	var mid LU
+	mid.Factorize(rbxb)
+	rel := math.Inf(1)
+	err = mid.SolveTo(&gain, false, bxa)
+	if c, isCond := err.(Condition); !isCond || !math.IsInf(float64(c), 1) {
+		term := NewDense(n, n, nil)
+		term.Mul(bxa.T(), &gain)
+		res := NewDense(n, n, nil)
+		res.Sub(atxa, x)
+		res.Sub(res, term)
+		res.Add(res, q)
+		rel = relResidual(res.Norm(2), atxa.Norm(2), x.Norm(2), term.Norm(2), Norm(q, 2))
+	}
+
+	s.reuseAsNonZeroed(n)
+	s.CopySym(x)
+	return checkSolution(cond, rel)
+}
+
+// checkRiccatiDims checks the dimensions of the coefficients of an algebraic
+// Riccati equation and returns the order of the equation.
+func checkRiccatiDims(a, b Matrix, q, r Symmetric) int {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, m := b.Dims()
+	if br != n || q.SymmetricDim() != n || r.SymmetricDim() != m {
+		panic(ErrShape)
+	}
+	return n
+}
+
+// riccatiGain returns G = B * R⁻¹ * Bᵀ for the symmetric positive definite
+// matrix R.
+func riccatiGain(b Matrix, r Symmetric) (*SymDense, error) {
+	var chol Cholesky
+	if !chol.Factorize(r) {
+		return nil, ErrNotPSD
+	}
+	var rb Dense
+	err := chol.SolveTo(&rb, b.T())
+	if err != nil {
+		return nil, err
+	}
+	n, _ := b.Dims()
+	var gd Dense
+	gd.Mul(b, &rb)
+	g := NewSymDense(n, nil)
+	symmetrize(g, &gd, 1)
+	return g, nil
+}
+
+// riccatiSolution computes the solution X = U₂₁ * U₁₁⁻¹ of an algebraic
+// Riccati equation of order n from the Schur vectors [U₁₁; U₂₁] spanning the
+// invariant subspace of the 2n×2n matrix h corresponding to the eigenvalues
+// for which sel returns true, and stores it into x. It returns the condition
+// number of U₁₁.
+func riccatiSolution(x *SymDense, h *Dense, sel func(λ complex128) bool) (cond float64, err error) {
+	var sh Schur
+	if !sh.Factorize(h) {
+		return 0, ErrFailedEigen
+	}
+	n := x.mat.N
+	m, ok := sh.Reorder(sel)
+	if !ok || m != n {
+		return 0, Condition(math.Inf(1))
+	}
+
+	// Solve X * U₁₁ = U₂₁ as U₁₁ᵀ * Xᵀ = U₂₁ᵀ.
+	var lu LU
+	lu.Factorize(sh.z.Slice(0, n, 0, n))
+	var xt Dense
+	err = lu.SolveTo(&xt, true, sh.z.Slice(n, 2*n, 0, n).T())
+	if c, isCond := err.(Condition); isCond && math.IsInf(float64(c), 1) {
+		return 0, err
+	}
+	symmetrize(x, &xt, 1)
+	return lu.Cond(), nil
+}
@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestSolveCAREScalar(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b, q, r float64
+	}{
+		{a: 1, b: 1, q: 1, r: 1},
+		{a: -2, b: 0.5, q: 3, r: 2},
+		{a: 0, b: 1, q: 1, r: 1},
+	} {
+		// 2*a*x - x²*b²/r + q = 0 has the stabilizing solution
+		// x = r*(a + sqrt(a² + b²*q/r))/b².
+		want := test.r * (test.a + math.Sqrt(test.a*test.a+test.b*test.b*test.q/test.r)) / (test.b * test.b)
+		var x SymDense
+		err := x.SolveCARE(NewDense(1, 1, []float64{test.a}), NewDense(1, 1, []float64{test.b}),
+			NewSymDense(1, []float64{test.q}), NewSymDense(1, []float64{test.r}))
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", test, err)
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(x.At(0, 0), want, 1e-14, 1e-14) {
+			t.Errorf("%+v: unexpected solution: got %v, want %v", test, x.At(0, 0), want)
+		}
+	}
+}
+
+func TestSolveDAREScalar(t *testing.T) {
+	t.Parallel()
+	// With a = b = q = r = 1 the equation reduces to x² - x - 1 = 0 whose
+	// positive root is the golden ratio.
+	var x SymDense
+	one := NewDense(1, 1, []float64{1})
+	err := x.SolveDARE(one, one, NewSymDense(1, []float64{1}), NewSymDense(1, []float64{1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := (1 + math.Sqrt(5)) / 2
+	if !scalar.EqualWithinAbsOrRel(x.At(0, 0), want, 1e-14, 1e-14) {
+		t.Errorf("unexpected solution: got %v, want %v", x.At(0, 0), want)
+	}
+}
+
+func TestSolveRiccati(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		for _, m := range []int{1, 2, 4} {
+			name := fmt.Sprintf("n=%d,m=%d", n, m)
+			// Scale A so that its spectral radius is about one.
+			a := randNormDense(n, n, rnd)
+			a.Scale(1/math.Sqrt(float64(n)), a)
+			b := randNormDense(n, m, rnd)
+			c := randNormDense(n, n, rnd)
+			q := NewSymDense(n, nil)
+			q.SymOuterK(1, c)
+			r := NewSymDense(m, nil)
+			r.SymOuterK(1, randNormDense(m, m, rnd))
+			for i := 0; i < m; i++ {
+				r.SetSym(i, i, r.At(i, i)+1)
+			}
+			var g Dense
+			var rb Dense
+			rb.Solve(r, b.T())
+			g.Mul(b, &rb)
+
+			var x SymDense
+			err := x.SolveCARE(a, b, q, r)
+			if err != nil {
+				t.Errorf("%s: unexpected CARE error: %v", name, err)
+			} else {
+				// Check the residual Aᵀ*X + X*A - X*G*X + Q.
+				var xa, xgx, res Dense
+				xa.Mul(&x, a)
+				xgx.Mul(&x, &g)
+				xgx.Mul(&xgx, &x)
+				res.Add(&xa, xa.T())
+				res.Sub(&res, &xgx)
+				res.Add(&res, q)
+				if Norm(&res, 2) > tol*(Norm(&xgx, 2)+Norm(q, 2)) {
+					t.Errorf("%s: unexpected CARE residual %v", name, Norm(&res, 2))
+				}
+				// Check that A - G*X is stable.
+				var cl Dense
+				cl.Mul(&g, &x)
+				cl.Sub(a, &cl)
+				var eig Eigen
+				eig.Factorize(&cl, EigenNone)
+				for _, v := range eig.Values(nil) {
+					if real(v) >= 0 {
+						t.Errorf("%s: CARE solution not stabilizing: eigenvalue %v", name, v)
+					}
+				}
+			}
+
+			err = x.SolveDARE(a, b, q, r)
+			if err != nil {
+				t.Errorf("%s: unexpected DARE error: %v", name, err)
+				continue
+			}
+			// Check the residual Aᵀ*X*A - X - Aᵀ*X*B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A + Q
+			// and that A - B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A is stable.
+			var xa, atxa, bxa, bx, rbxb, k, term, res Dense
+			xa.Mul(&x, a)
+			atxa.Mul(a.T(), &xa)
+			bxa.Mul(b.T(), &xa)
+			bx.Mul(b.T(), &x)
+			rbxb.Mul(&bx, b)
+			rbxb.Add(&rbxb, r)
+			k.Solve(&rbxb, &bxa)
+			term.Mul(bxa.T(), &k)
+			res.Sub(&atxa, &x)
+			res.Sub(&res, &term)
+			res.Add(&res, q)
+			if Norm(&res, 2) > tol*(Norm(&atxa, 2)+Norm(&x, 2)+Norm(&term, 2)+Norm(q, 2)) {
+				t.Errorf("%s: unexpected DARE residual %v", name, Norm(&res, 2))
+			}
+			var cl Dense
+			cl.Mul(b, &k)
+			cl.Sub(a, &cl)
+			var eig Eigen
+			eig.Factorize(&cl, EigenNone)
+			for _, v := range eig.Values(nil) {
+				if cmplx.Abs(v) >= 1 {
+					t.Errorf("%s: DARE solution not stabilizing: eigenvalue %v", name, v)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveRiccatiErrors(t *testing.T) {
+	t.Parallel()
+	zero := NewDense(1, 1, []float64{0})
+	one := NewDense(1, 1, []float64{1})
+	q := NewSymDense(1, []float64{1})
+	r := NewSymDense(1, []float64{1})
+
+	// (A, B) = (1, 0) is not stabilizable so there is no stabilizing
+	// solution.
+	x := NewSymDense(1, []float64{42})
+	err := x.SolveCARE(one, zero, q, r)
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("unexpected CARE error for unstabilizable system: %v", err)
+	}
+	if x.At(0, 0) != 42 {
+		t.Error("receiver modified after CARE failure")
+	}
+	err = x.SolveDARE(NewDense(1, 1, []float64{2}), zero, q, r)
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("unexpected DARE error for unstabilizable system: %v", err)
+	}
+
+	// DARE requires a nonsingular A.
+	err = x.SolveDARE(zero, one, q, r)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected DARE error for singular A: %v", err)
+	}
+
+	// R must be positive definite.
+	err = x.SolveCARE(one, one, q, NewSymDense(1, []float64{-1}))
+	if err != ErrNotPSD {
+		t.Errorf("unexpected CARE error for indefinite R: %v", err)
+	}
+
+	if panicked, _ := panics(func() { x.SolveCARE(one, NewDense(2, 1, nil), q, r) }); !panicked {
+		t.Error("expected panic for shape mismatch")
+	}
+}
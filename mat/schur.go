@@ -0,0 +1,255 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// Schur is a type for creating and using the real Schur decomposition of a
+// dense matrix.
+//
+// The real Schur decomposition of an n×n real matrix A is
+//
+//	A = Z * T * Zᵀ
+//
+// where Z is an n×n orthogonal matrix of Schur vectors and T is an n×n upper
+// quasi-triangular matrix, the Schur form of A. T is block upper triangular
+// with 1×1 and 2×2 blocks on the diagonal. Each 1×1 block holds a real
+// eigenvalue of A and each 2×2 block holds a complex conjugate pair of
+// eigenvalues of A. The 2×2 blocks are in standardized form, that is, their
+// diagonal elements are equal and their off-diagonal elements have opposite
+// signs.
+//
+// The leading k columns of Z, where the leading k×k block of T does not split
+// a 2×2 block, span an invariant subspace of A corresponding to the
+// eigenvalues in that block. The Reorder method can be used to move selected
+// eigenvalues into the leading block.
+type Schur struct {
+	t, z *Dense
+
+	values []complex128
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (s *Schur) succFact() bool {
+	return s.t != nil && !s.t.IsEmpty()
+}
+
+// Factorize computes the real Schur decomposition of the square matrix a.
+// Factorize panics if a is not square.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (s *Schur) Factorize(a Matrix) (ok bool) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	n := r
+
+	// Kill any previous factorization.
+	if s.t == nil {
+		s.t = &Dense{}
+	} else {
+		s.t.Reset()
+	}
+	if s.z == nil {
+		s.z = &Dense{}
+	} else {
+		s.z.Reset()
+	}
+	s.t.reuseAsNonZeroed(n, n)
+	s.t.Copy(a)
+	s.z.reuseAsNonZeroed(n, n)
+
+	// Reduce A to upper Hessenberg form H = Qᵀ * A * Q.
+	tau := getFloat64s(max(0, n-1), false)
+	defer putFloat64s(tau)
+	work := []float64{0}
+	lapack64.Gehrd(s.t.mat, 0, n-1, tau, work, -1)
+	lwork := int(work[0])
+	lapack64.Orghr(s.z.mat, 0, n-1, tau, work, -1)
+	lwork = max(lwork, int(work[0]))
+	lapack64.Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, s.t.mat, 0, n-1, nil, nil, s.z.mat, work, -1)
+	lwork = max(lwork, int(work[0]))
+	work = getFloat64s(max(1, lwork), false)
+	defer putFloat64s(work)
+
+	lapack64.Gehrd(s.t.mat, 0, n-1, tau, work, len(work))
+	s.z.Copy(s.t)
+	lapack64.Orghr(s.z.mat, 0, n-1, tau, work, len(work))
+	for i := 2; i < n; i++ {
+		zero(s.t.mat.Data[i*s.t.mat.Stride : i*s.t.mat.Stride+i-1])
+	}
+
+	// Compute the Schur form T of H and accumulate the transformations
+	// into Z.
+	wr := getFloat64s(n, false)
+	defer putFloat64s(wr)
+	wi := getFloat64s(n, false)
+	defer putFloat64s(wi)
+	unconverged := lapack64.Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, s.t.mat, 0, n-1, wr, wi, s.z.mat, work, len(work))
+	if unconverged != 0 {
+		s.t.Reset()
+		s.z.Reset()
+		s.values = nil
+		return false
+	}
+	s.values = useC(s.values, n)
+	for i, v := range wr {
+		s.values[i] = complex(v, wi[i])
+	}
+	return true
+}
+
+// Values extracts the eigenvalues of the factorized matrix in the order in
+// which they appear on the diagonal of T. Complex conjugate pairs of
+// eigenvalues appear consecutively with the eigenvalue having the positive
+// imaginary part first.
+//
+// If dst is non-nil, the values are stored in-place into dst. In this case dst
+// must have length n, otherwise Values will panic. If dst is nil, then a new
+// slice will be allocated of the proper length and filled with the eigenvalues.
+//
+// Values panics if the receiver does not contain a successful factorization.
+func (s *Schur) Values(dst []complex128) []complex128 {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, len(s.values))
+	}
+	if len(dst) != len(s.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, s.values)
+	return dst
+}
+
+// TTo extracts the n×n upper quasi-triangular Schur form T from the
+// decomposition.
+//
+// If dst is empty, TTo will resize dst to be n×n. When dst is non-empty, TTo
+// will panic if dst is not n×n. TTo will also panic if the receiver does not
+// contain a successful factorization.
+func (s *Schur) TTo(dst *Dense) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	n, _ := s.t.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(s.t)
+}
+
+// ZTo extracts the n×n orthogonal matrix Z of Schur vectors from the
+// decomposition.
+//
+// If dst is empty, ZTo will resize dst to be n×n. When dst is non-empty, ZTo
+// will panic if dst is not n×n. ZTo will also panic if the receiver does not
+// contain a successful factorization.
+func (s *Schur) ZTo(dst *Dense) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	n, _ := s.z.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(s.z)
+}
+
+// Reorder reorders the Schur decomposition so that the eigenvalues for which
+// sel returns true are moved to the leading block of T. The relative order of
+// the selected eigenvalues and of the remaining eigenvalues is preserved.
+// A complex conjugate pair of eigenvalues is selected if sel returns true for
+// either of its members. Reorder updates T, Z and the eigenvalues returned by
+// Values.
+//
+// On return, the leading m columns of Z form an orthonormal basis of the
+// invariant subspace of A corresponding to the selected eigenvalues.
+//
+// If ok is false, two adjacent blocks of T were too close to swap because the
+// problem is very ill-conditioned, and reordering stops at the first such
+// failure. In this case T and Z have been partially reordered but still form a
+// valid Schur decomposition of A. Blocks are only moved across unselected
+// blocks below the leading block, so the leading m×m block of T still holds
+// exactly the selected eigenvalues that were moved before the failure and the
+// leading m columns of Z span their invariant subspace. The block that could
+// not be moved may have been moved part of the way towards the leading block,
+// and neither it nor the selected eigenvalues after it are counted in m.
+//
+// Reorder will panic if the receiver does not contain a successful
+// factorization.
+func (s *Schur) Reorder(sel func(λ complex128) bool) (m int, ok bool) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	n, _ := s.t.Dims()
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+
+	ok = true
+	for k := 0; k < n; {
+		pair := k < n-1 && s.t.at(k+1, k) != 0
+		selected := sel(s.values[k])
+		if pair {
+			selected = selected || sel(s.values[k+1])
+		}
+		if selected {
+			if k != m {
+				// Move the block at k to the position m.
+				_, _, ok = lapack64.Trexc(lapack.UpdateSchur, s.t.mat, s.z.mat, k, m, work)
+				if !ok {
+					break
+				}
+			}
+			if pair {
+				m += 2
+			} else {
+				m++
+			}
+		}
+		if pair {
+			k += 2
+		} else {
+			k++
+		}
+	}
+	s.updateValues()
+	return m, ok
+}
+
+// updateValues recomputes the eigenvalues from the diagonal blocks of T.
+func (s *Schur) updateValues() {
+	n, _ := s.t.Dims()
+	for k := 0; k < n; {
+		if k == n-1 || s.t.at(k+1, k) == 0 {
+			s.values[k] = complex(s.t.at(k, k), 0)
+			k++
+			continue
+		}
+		re := s.t.at(k, k)
+		im := math.Sqrt(math.Abs(s.t.at(k, k+1))) * math.Sqrt(math.Abs(s.t.at(k+1, k)))
+		s.values[k] = complex(re, im)
+		s.values[k+1] = complex(re, -im)
+		k += 2
+	}
+}
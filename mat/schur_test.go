@@ -0,0 +1,244 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+func TestSchur(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 25} {
+		a := NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		var schur Schur
+		ok := schur.Factorize(a)
+		if !ok {
+			t.Errorf("n=%d: unexpected Factorize failure", n)
+			continue
+		}
+		checkSchur(t, a, &schur, tol, fmt.Sprintf("n=%d", n))
+
+		// Compare the eigenvalues with those from Eigen.
+		var eig Eigen
+		eig.Factorize(a, EigenNone)
+		got := schur.Values(nil)
+		want := eig.Values(nil)
+		sortComplex(got)
+		sortComplex(want)
+		for i := range got {
+			if cmplx.Abs(got[i]-want[i]) > 1e-10 {
+				t.Errorf("n=%d: eigenvalue mismatch with Eigen: got %v, want %v", n, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestSchurReorder(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 8, 20} {
+		for _, test := range []struct {
+			name string
+			sel  func(complex128) bool
+		}{
+			{name: "stable", sel: func(v complex128) bool { return real(v) < 0 }},
+			{name: "unstable", sel: func(v complex128) bool { return real(v) > 0 }},
+			{name: "inside unit disk", sel: func(v complex128) bool { return cmplx.Abs(v) < 1 }},
+			{name: "complex", sel: func(v complex128) bool { return imag(v) != 0 }},
+			{name: "none", sel: func(complex128) bool { return false }},
+			{name: "all", sel: func(complex128) bool { return true }},
+		} {
+			a := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var schur Schur
+			if !schur.Factorize(a) {
+				t.Errorf("n=%d %s: unexpected Factorize failure", n, test.name)
+				continue
+			}
+			before := schur.Values(nil)
+			var wantM int
+			for _, v := range before {
+				if test.sel(v) || test.sel(cmplx.Conj(v)) {
+					wantM++
+				}
+			}
+
+			m, ok := schur.Reorder(test.sel)
+			if !ok {
+				t.Errorf("n=%d %s: unexpected Reorder failure", n, test.name)
+				continue
+			}
+			if m != wantM {
+				t.Errorf("n=%d %s: unexpected size of leading block: got %d, want %d", n, test.name, m, wantM)
+			}
+			name := fmt.Sprintf("n=%d %s", n, test.name)
+			checkSchur(t, a, &schur, tol, name)
+
+			after := schur.Values(nil)
+			for i, v := range after {
+				selected := test.sel(v) || test.sel(cmplx.Conj(v))
+				if selected != (i < m) {
+					t.Errorf("%s: eigenvalue %v at position %d incorrectly placed", name, v, i)
+				}
+			}
+			sortComplex(before)
+			sortComplex(after)
+			for i := range before {
+				if cmplx.Abs(before[i]-after[i]) > 1e-10 {
+					t.Errorf("%s: eigenvalues changed by reordering", name)
+					break
+				}
+			}
+
+			// The leading m columns of Z span an invariant subspace of A.
+			if m == 0 {
+				continue
+			}
+			var z, tm, az, zt Dense
+			schur.ZTo(&z)
+			schur.TTo(&tm)
+			az.Mul(a, z.Slice(0, n, 0, m))
+			zt.Mul(z.Slice(0, n, 0, m), tm.Slice(0, m, 0, m))
+			if !EqualApprox(&az, &zt, tol*float64(n)) {
+				t.Errorf("%s: leading Schur vectors do not span an invariant subspace", name)
+			}
+		}
+	}
+}
+
+func TestSchurReorderFailure(t *testing.T) {
+	t.Parallel()
+	const (
+		tol  = 1e-12
+		tiny = 0x1p-1022 // Smallest positive normal float64.
+	)
+	// T is in Schur canonical form with a selected eigenvalue 5, an
+	// unselected complex pair with tiny imaginary parts, an unselected real
+	// eigenvalue and a selected complex pair. The selected pair can be
+	// swapped with the real eigenvalue but not with the unselected pair.
+	a := NewDense(6, 6, []float64{
+		5, 1, 1, 1, 1, 1,
+		0, 0, tiny, 1.6537463899199678, 0.4845634374842284, 1.2648783658305394,
+		0, -0.9291755237546648, 0, -0.87438165314321, 1.0293257035687824, 0.6531756501247465,
+		0, 0, 0, 1.1756241936105127, 0.03622331926998734, 0.03622331926998734,
+		0, 0, 0, 0, -0.026400732929216686, tiny,
+		0, 0, 0, 0, -tiny, -0.026400732929216686,
+	})
+	n, _ := a.Dims()
+	// Construct the decomposition directly since Factorize would deflate
+	// the tiny off-diagonal elements.
+	schur := Schur{
+		t:      DenseCopyOf(a),
+		z:      NewDense(n, n, nil),
+		values: make([]complex128, n),
+	}
+	for i := 0; i < n; i++ {
+		schur.z.set(i, i, 1)
+	}
+	schur.updateValues()
+
+	sel := func(v complex128) bool {
+		return real(v) > 4 || (real(v) < -0.01 && imag(v) != 0)
+	}
+	m, ok := schur.Reorder(sel)
+	if ok {
+		t.Fatal("unexpected Reorder success")
+	}
+	if m != 1 {
+		t.Errorf("unexpected size of leading block: got %d, want 1", m)
+	}
+	checkSchur(t, a, &schur, tol, "failure")
+
+	values := schur.Values(nil)
+	if values[0] != 5 {
+		t.Errorf("unexpected leading eigenvalue: got %v, want 5", values[0])
+	}
+	// The selected pair has been moved across the real eigenvalue.
+	if real(values[3]) != real(values[4]) || real(values[3]) > -0.01 {
+		t.Errorf("selected pair not partially moved: %v", values)
+	}
+	if values[5] != complex(a.At(3, 3), 0) {
+		t.Errorf("unexpected trailing eigenvalue: got %v, want %v", values[5], a.At(3, 3))
+	}
+
+	// The leading m columns of Z span an invariant subspace of A.
+	var z, tm, az, zt Dense
+	schur.ZTo(&z)
+	schur.TTo(&tm)
+	az.Mul(a, z.Slice(0, n, 0, m))
+	zt.Mul(z.Slice(0, n, 0, m), tm.Slice(0, m, 0, m))
+	if !EqualApprox(&az, &zt, tol*float64(n)) {
+		t.Error("leading Schur vectors do not span an invariant subspace")
+	}
+}
+
+// checkSchur checks that the Schur decomposition held in schur is a valid
+// decomposition of a.
+func checkSchur(t *testing.T, a Matrix, schur *Schur, tol float64, name string) {
+	t.Helper()
+	n, _ := a.Dims()
+	var tm, z Dense
+	schur.TTo(&tm)
+	schur.ZTo(&z)
+
+	var ztz Dense
+	ztz.Mul(z.T(), &z)
+	if !EqualApprox(&ztz, eye(n), tol*float64(n)) {
+		t.Errorf("%s: Z is not orthogonal", name)
+	}
+
+	var got Dense
+	got.Product(&z, &tm, z.T())
+	if !EqualApprox(&got, a, tol*float64(n)) {
+		t.Errorf("%s: Z*T*Zᵀ != A", name)
+	}
+
+	// T must be in Schur canonical form.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i-1; j++ {
+			if tm.At(i, j) != 0 {
+				t.Errorf("%s: T not quasi-triangular", name)
+			}
+		}
+	}
+	for i := 0; i < n-1; i++ {
+		if tm.At(i+1, i) == 0 {
+			continue
+		}
+		if i < n-2 && tm.At(i+2, i+1) != 0 {
+			t.Errorf("%s: T has consecutive nonzero subdiagonal elements", name)
+		}
+		if tm.At(i, i) != tm.At(i+1, i+1) || math.Signbit(tm.At(i, i+1)) == math.Signbit(tm.At(i+1, i)) {
+			t.Errorf("%s: 2×2 block at %d not in standardized form", name, i)
+		}
+	}
+}
+
+// sortComplex sorts s by real part and then by imaginary part.
+func sortComplex(s []complex128) {
+	sort.Slice(s, func(i, j int) bool {
+		if real(s[i]) != real(s[j]) {
+			return real(s[i]) < real(s[j])
+		}
+		return imag(s[i]) < imag(s[j])
+	})
+}
@@ -0,0 +1,699 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The tests in this file are shared with the mat32 package, which generates
+// its copy from this file. They must only use the parts of the package that
+// are translated into mat32, and their tolerances must be expressed in terms
+// of the machine epsilon dlamchE.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// sharedSizes are the matrix dimensions used in the shared tests.
+var sharedSizes = []int{1, 2, 3, 5, 10, 23}
+
+// sharedTol returns the tolerance for relative errors of computations on
+// matrices of dimension n.
+func sharedTol(n int) float64 {
+	return 100 * float64(n) * dlamchE
+}
+
+// sharedRandDense returns an r×c matrix with standard normal entries.
+func sharedRandDense(r, c int, rnd *rand.Rand) *Dense {
+	a := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			a.Set(i, j, float64(rnd.NormFloat64()))
+		}
+	}
+	return a
+}
+
+// sharedRandSPD returns a random n×n symmetric positive definite matrix.
+func sharedRandSPD(n int, rnd *rand.Rand) *SymDense {
+	a := NewSymDense(n, nil)
+	a.SymOuterK(1, sharedRandDense(n, n, rnd))
+	for i := 0; i < n; i++ {
+		a.SetSym(i, i, a.At(i, i)+float64(n))
+	}
+	return a
+}
+
+// sharedRelErr returns |a-b|_F/|b|_F, or |a-b|_F if b is zero.
+func sharedRelErr(a, b Matrix) float64 {
+	var diff Dense
+	diff.Sub(a, b)
+	bnorm := Norm(b, 2)
+	if bnorm == 0 {
+		return Norm(&diff, 2)
+	}
+	return Norm(&diff, 2) / bnorm
+}
+
+// sharedOrthonormalErr returns |Qᵀ*Q - I|_F for the matrix Q.
+func sharedOrthonormalErr(q Matrix) float64 {
+	_, c := q.Dims()
+	var qtq Dense
+	qtq.Mul(q.T(), q)
+	for i := 0; i < c; i++ {
+		qtq.Set(i, i, qtq.At(i, i)-1)
+	}
+	return Norm(&qtq, 2)
+}
+
+func TestSharedDense(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range sharedSizes {
+		for _, n := range sharedSizes {
+			k := n + 1
+			a := sharedRandDense(m, k, rnd)
+			b := sharedRandDense(k, n, rnd)
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+
+			var got Dense
+			got.Mul(a, b)
+			want := NewDense(m, n, nil)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					var v float64
+					for l := 0; l < k; l++ {
+						v += a.At(i, l) * b.At(l, j)
+					}
+					want.Set(i, j, v)
+				}
+			}
+			if err := sharedRelErr(&got, want); err > sharedTol(k) {
+				t.Errorf("%s: unexpected Mul error %v", name, err)
+			}
+
+			var bt Dense
+			bt.CloneFrom(b.T())
+			got.Mul(a, bt.T())
+			if err := sharedRelErr(&got, want); err > sharedTol(k) {
+				t.Errorf("%s: unexpected Mul with transpose error %v", name, err)
+			}
+
+			x := NewVecDense(n, nil)
+			for i := 0; i < n; i++ {
+				x.SetVec(i, float64(rnd.NormFloat64()))
+			}
+			var y, bx, wantY VecDense
+			y.MulVec(want, x)
+			bx.MulVec(b, x)
+			wantY.MulVec(a, &bx)
+			if err := sharedRelErr(&y, &wantY); err > sharedTol(k*n) {
+				t.Errorf("%s: unexpected MulVec error %v", name, err)
+			}
+
+			var sum, diff Dense
+			sum.Add(&got, want)
+			diff.Sub(&sum, want)
+			diff.Scale(2, &diff)
+			got.Scale(2, &got)
+			if err := sharedRelErr(&diff, &got); err > sharedTol(1) {
+				t.Errorf("%s: unexpected Add, Sub, Scale error %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		a := sharedRandDense(n, n, rnd)
+		for i := 0; i < n; i++ {
+			a.Set(i, i, a.At(i, i)+float64(n))
+		}
+		b := sharedRandDense(n, 3, rnd)
+
+		var lu LU
+		lu.Factorize(a)
+		var l, u TriDense
+		lu.LTo(&l)
+		lu.UTo(&u)
+		var plu Dense
+		plu.Mul(&l, &u)
+		plu.PermuteRows(lu.RowPivots(nil), false)
+		if err := sharedRelErr(&plu, a); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected reconstruction error %v", n, err)
+		}
+
+		var x Dense
+		err := lu.SolveTo(&x, false, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		var ax Dense
+		ax.Mul(a, &x)
+		if err := sharedRelErr(&ax, b); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected LU solve residual %v", n, err)
+		}
+
+		err = lu.SolveTo(&x, true, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		ax.Mul(a.T(), &x)
+		if err := sharedRelErr(&ax, b); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected LU transposed solve residual %v", n, err)
+		}
+
+		var inv Dense
+		err = inv.Inverse(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected Inverse error: %v", n, err)
+			continue
+		}
+		var ainv Dense
+		ainv.Mul(a, &inv)
+		if err := sharedRelErr(&ainv, NewDiagDense(n, sharedOnes(n))); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected inverse error %v", n, err)
+		}
+	}
+}
+
+// sharedOnes returns a slice of n ones.
+func sharedOnes(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = 1
+	}
+	return s
+}
+
+func TestSharedCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		a := sharedRandSPD(n, rnd)
+
+		var chol Cholesky
+		if !chol.Factorize(a) {
+			t.Errorf("n=%d: unexpected Cholesky failure", n)
+			continue
+		}
+		var u TriDense
+		chol.UTo(&u)
+		var utu Dense
+		utu.Mul(u.T(), &u)
+		if err := sharedRelErr(&utu, a); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected reconstruction error %v", n, err)
+		}
+
+		b := sharedRandDense(n, 2, rnd)
+		var x Dense
+		err := chol.SolveTo(&x, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		var ax Dense
+		ax.Mul(a, &x)
+		if err := sharedRelErr(&ax, b); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected Cholesky solve residual %v", n, err)
+		}
+	}
+
+	// A matrix that is not positive definite.
+	var chol Cholesky
+	if chol.Factorize(NewSymDense(2, []float64{1, 2, 2, 1})) {
+		t.Error("unexpected Cholesky success for indefinite matrix")
+	}
+}
+
+func TestSharedQR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, m := range []int{n, n + 4} {
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+			a := sharedRandDense(m, n, rnd)
+
+			var qr QR
+			qr.Factorize(a)
+			var q, r Dense
+			qr.QTo(&q)
+			qr.RTo(&r)
+			if err := sharedOrthonormalErr(&q); err > sharedTol(m) {
+				t.Errorf("%s: Q not orthonormal: %v", name, err)
+			}
+			var qrProd Dense
+			qrProd.Mul(&q, &r)
+			if err := sharedRelErr(&qrProd, a); err > sharedTol(m) {
+				t.Errorf("%s: unexpected reconstruction error %v", name, err)
+			}
+
+			// The least squares residual is orthogonal to the range of A.
+			b := sharedRandDense(m, 1, rnd)
+			var x Dense
+			err := qr.SolveTo(&x, false, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var res Dense
+			res.Mul(a, &x)
+			res.Sub(&res, b)
+			var atres Dense
+			atres.Mul(a.T(), &res)
+			if err := Norm(&atres, 2) / (Norm(a, 2) * Norm(b, 2)); err > sharedTol(m) {
+				t.Errorf("%s: least squares residual not orthogonal: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, m := range []int{n, n + 3} {
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+			a := sharedRandDense(m, n, rnd)
+
+			var svd SVD
+			if !svd.Factorize(a, SVDThin) {
+				t.Errorf("%s: unexpected SVD failure", name)
+				continue
+			}
+			values := svd.Values(nil)
+			for i := 1; i < len(values); i++ {
+				if values[i] > values[i-1] {
+					t.Errorf("%s: singular values not sorted", name)
+					break
+				}
+			}
+			var u, v Dense
+			svd.UTo(&u)
+			svd.VTo(&v)
+			if err := sharedOrthonormalErr(&u); err > sharedTol(m) {
+				t.Errorf("%s: U not orthonormal: %v", name, err)
+			}
+			if err := sharedOrthonormalErr(&v); err > sharedTol(n) {
+				t.Errorf("%s: V not orthonormal: %v", name, err)
+			}
+			var usvt Dense
+			usvt.Mul(&u, NewDiagDense(len(values), values))
+			usvt.Mul(&usvt, v.T())
+			if err := sharedRelErr(&usvt, a); err > sharedTol(m) {
+				t.Errorf("%s: unexpected reconstruction error %v", name, err)
+			}
+			// The Frobenius norm of A is the 2-norm of its singular values.
+			var ss float64
+			for _, v := range values {
+				ss += v * v
+			}
+			if err := math.Abs(math.Sqrt(ss)-Norm(a, 2)) / Norm(a, 2); err > sharedTol(m) {
+				t.Errorf("%s: unexpected singular values: error %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedEigenSym(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		a := NewSymDense(n, nil)
+		b := sharedRandDense(n, n, rnd)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a.SetSym(i, j, b.At(i, j))
+			}
+		}
+
+		var es EigenSym
+		if !es.Factorize(a, true) {
+			t.Errorf("n=%d: unexpected EigenSym failure", n)
+			continue
+		}
+		values := es.Values(nil)
+		for i := 1; i < len(values); i++ {
+			if values[i] < values[i-1] {
+				t.Errorf("n=%d: eigenvalues not sorted", n)
+				break
+			}
+		}
+		var v Dense
+		es.VectorsTo(&v)
+		if err := sharedOrthonormalErr(&v); err > sharedTol(n) {
+			t.Errorf("n=%d: eigenvectors not orthonormal: %v", n, err)
+		}
+		var av, vl Dense
+		av.Mul(a, &v)
+		vl.Mul(&v, NewDiagDense(n, values))
+		if err := sharedRelErr(&av, &vl); err > sharedTol(n) {
+			t.Errorf("n=%d: unexpected eigendecomposition error %v", n, err)
+		}
+	}
+}
+
+func TestSharedEigenSymAlgorithm(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, alg := range []EigenSymAlgorithm{EigenSymQR, EigenSymDivideConquer, EigenSymMRRR} {
+		for _, n := range sharedSizes {
+			name := fmt.Sprintf("alg=%d,n=%d", alg, n)
+			a := NewSymDense(n, nil)
+			a.SymOuterK(1, sharedRandDense(n, n, rnd))
+
+			var es EigenSym
+			if !es.FactorizeAlgorithm(a, true, alg) {
+				t.Errorf("%s: unexpected EigenSym failure", name)
+				continue
+			}
+			values := es.Values(nil)
+			var v Dense
+			es.VectorsTo(&v)
+			if err := sharedOrthonormalErr(&v); err > sharedTol(n) {
+				t.Errorf("%s: eigenvectors not orthonormal: %v", name, err)
+			}
+			var av, vl Dense
+			av.Mul(a, &v)
+			vl.Mul(&v, NewDiagDense(n, values))
+			if err := sharedRelErr(&av, &vl); err > sharedTol(n) {
+				t.Errorf("%s: unexpected eigendecomposition error %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedEigenSymBand(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, k := range []int{0, 1, 3} {
+			k = min(k, n-1)
+			name := fmt.Sprintf("n=%d,k=%d", n, k)
+			a := NewSymBandDense(n, k, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < min(n, i+k+1); j++ {
+					a.SetSymBand(i, j, float64(rnd.NormFloat64()))
+				}
+			}
+
+			var es EigenSymBand
+			if !es.Factorize(a, true) {
+				t.Errorf("%s: unexpected EigenSymBand failure", name)
+				continue
+			}
+			values := es.Values(nil)
+			for i := 1; i < len(values); i++ {
+				if values[i] < values[i-1] {
+					t.Errorf("%s: eigenvalues not sorted", name)
+					break
+				}
+			}
+			var v Dense
+			es.VectorsTo(&v)
+			if err := sharedOrthonormalErr(&v); err > sharedTol(n) {
+				t.Errorf("%s: eigenvectors not orthonormal: %v", name, err)
+			}
+			var av, vl Dense
+			av.Mul(a, &v)
+			vl.Mul(&v, NewDiagDense(n, values))
+			if err := sharedRelErr(&av, &vl); err > sharedTol(n) {
+				t.Errorf("%s: unexpected eigendecomposition error %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedLQ(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range sharedSizes {
+		for _, n := range []int{m, m + 4} {
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+			a := sharedRandDense(m, n, rnd)
+
+			var lq LQ
+			lq.Factorize(a)
+			var l, q Dense
+			lq.LTo(&l)
+			lq.QTo(&q)
+			if err := sharedOrthonormalErr(q.T()); err > sharedTol(n) {
+				t.Errorf("%s: Q not orthonormal: %v", name, err)
+			}
+			var lqProd Dense
+			lqProd.Mul(&l, &q)
+			if err := sharedRelErr(&lqProd, a); err > sharedTol(n) {
+				t.Errorf("%s: unexpected reconstruction error %v", name, err)
+			}
+
+			// The minimum norm solution of an underdetermined system
+			// solves it exactly.
+			b := sharedRandDense(m, 1, rnd)
+			var x Dense
+			err := lq.SolveTo(&x, false, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var ax Dense
+			ax.Mul(a, &x)
+			if err := sharedRelErr(&ax, b); err > sharedTol(n) {
+				t.Errorf("%s: unexpected solution error %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedQRCP(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, m := range []int{n, n + 4} {
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+			a := sharedRandDense(m, n, rnd)
+
+			var qr QRCP
+			qr.Factorize(a)
+			var q, r Dense
+			qr.QTo(&q)
+			qr.RTo(&r)
+			if err := sharedOrthonormalErr(&q); err > sharedTol(m) {
+				t.Errorf("%s: Q not orthonormal: %v", name, err)
+			}
+			for i := 1; i < n; i++ {
+				if math.Abs(r.At(i, i)) > math.Abs(r.At(i-1, i-1)) {
+					t.Errorf("%s: diagonal of R not decreasing in magnitude", name)
+					break
+				}
+			}
+			// The jth column of A*P is the piv[j]th column of A.
+			piv := qr.ColPivots(nil)
+			ap := NewDense(m, n, nil)
+			for j, p := range piv {
+				for i := 0; i < m; i++ {
+					ap.Set(i, j, a.At(i, p))
+				}
+			}
+			var qrProd Dense
+			qrProd.Mul(&q, &r)
+			if err := sharedRelErr(&qrProd, ap); err > sharedTol(m) {
+				t.Errorf("%s: unexpected reconstruction error %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedCOD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, m := range []int{n, n + 4} {
+			// A is a product of random factors so its rank is known.
+			rank := max(1, n/2)
+			name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, rank)
+			var a Dense
+			a.Mul(sharedRandDense(m, rank, rnd), sharedRandDense(rank, n, rnd))
+
+			var cod COD
+			cod.Factorize(&a, sharedTol(m))
+			if got := cod.Rank(); got != rank {
+				t.Errorf("%s: unexpected rank %d", name, got)
+				continue
+			}
+
+			// The least squares residual is orthogonal to the range of A.
+			b := sharedRandDense(m, 1, rnd)
+			var x Dense
+			err := cod.SolveTo(&x, false, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var res Dense
+			res.Mul(&a, &x)
+			res.Sub(&res, b)
+			var atres Dense
+			atres.Mul(a.T(), &res)
+			if err := Norm(&atres, 2) / (Norm(&a, 2) * Norm(b, 2)); err > sharedTol(m) {
+				t.Errorf("%s: least squares residual not orthogonal: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedBandLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, kl := range []int{0, 1, 3} {
+			for _, ku := range []int{0, 2} {
+				kl, ku := min(kl, n-1), min(ku, n-1)
+				name := fmt.Sprintf("n=%d,kl=%d,ku=%d", n, kl, ku)
+				a := NewBandDense(n, n, kl, ku, nil)
+				for i := 0; i < n; i++ {
+					for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+						a.SetBand(i, j, float64(rnd.NormFloat64()))
+					}
+					// Make A diagonally dominant so that it is well
+					// conditioned.
+					a.SetBand(i, i, a.At(i, i)+float64(kl+ku+1))
+				}
+
+				var lu BandLU
+				lu.Factorize(a)
+				b := sharedRandDense(n, 2, rnd)
+				for _, trans := range []bool{false, true} {
+					var x Dense
+					err := lu.SolveTo(&x, trans, b)
+					if err != nil {
+						t.Errorf("%s,trans=%t: unexpected error: %v", name, trans, err)
+						continue
+					}
+					var ax Dense
+					if trans {
+						ax.Mul(a.T(), &x)
+					} else {
+						ax.Mul(a, &x)
+					}
+					if err := sharedRelErr(&ax, b); err > sharedTol(n) {
+						t.Errorf("%s,trans=%t: unexpected solution error %v", name, trans, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSharedTSQR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		m := 10*n + 7
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := sharedRandDense(m, n, rnd)
+		b := sharedRandDense(m, 1, rnd)
+		ab := NewDense(m, n+1, nil)
+		ab.Augment(a, b)
+
+		tsqr := NewTSQR(n + 1)
+		tsqr.BlockRows = n + 3
+		tsqr.AddRows(ab)
+		var x Dense
+		err := tsqr.SolveTo(&x, 1)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		// The solution agrees with the one computed by QR.
+		var qr QR
+		qr.Factorize(a)
+		var want Dense
+		err = qr.SolveTo(&want, false, b)
+		if err != nil {
+			t.Errorf("%s: unexpected QR error: %v", name, err)
+			continue
+		}
+		if err := sharedRelErr(&x, &want); err > sharedTol(m) {
+			t.Errorf("%s: solution differs from QR: %v", name, err)
+		}
+	}
+}
+
+func TestSharedSolve(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, m := range []int{n, n + 4} {
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+			a := sharedRandDense(m, n, rnd)
+			b := sharedRandDense(m, 2, rnd)
+
+			var x Dense
+			err := x.Solve(a, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			// The residual is orthogonal to the range of A. For square
+			// A it is zero.
+			var res Dense
+			res.Mul(a, &x)
+			res.Sub(&res, b)
+			var atres Dense
+			atres.Mul(a.T(), &res)
+			if err := Norm(&atres, 2) / (Norm(a, 2) * Norm(b, 2)); err > sharedTol(m) {
+				t.Errorf("%s: residual not orthogonal: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestSharedLSE(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sharedSizes {
+		for _, p := range []int{1, (n + 1) / 2, n} {
+			m := n + 3
+			name := fmt.Sprintf("m=%d,n=%d,p=%d", m, n, p)
+			a := sharedRandDense(m, n, rnd)
+			b := sharedRandDense(p, n, rnd)
+			c := sharedRandDense(m, 1, rnd).ColView(0)
+			d := sharedRandDense(p, 1, rnd).ColView(0)
+
+			var x VecDense
+			err := LSE(&x, a, b, c, d)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			// The solution satisfies the constraints B*x = d.
+			var bx VecDense
+			bx.MulVec(b, &x)
+			if err := sharedRelErr(&bx, d); err > sharedTol(n) {
+				t.Errorf("%s: constraints not satisfied: %v", name, err)
+			}
+			// The gradient Aᵀ*(A*x - c) lies in the range of Bᵀ.
+			var res, grad VecDense
+			res.MulVec(a, &x)
+			res.SubVec(&res, c)
+			grad.MulVec(a.T(), &res)
+			var lambda, bTl VecDense
+			err = lambda.SolveVec(b.T(), &grad)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			bTl.MulVec(b.T(), &lambda)
+			if err := sharedRelErr(&bTl, &grad); err > sharedTol(m) {
+				t.Errorf("%s: solution not optimal: %v", name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,468 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"slices"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	badIndptr     = "sparse: malformed index pointer"
+	unsortedIndex = "sparse: indices not strictly increasing"
+)
+
+// compressed is the storage shared by the CSR and CSC types. It represents
+// a major×minor matrix where the non-zero elements of major index i are
+// stored in data[indptr[i]:indptr[i+1]] with minor indices held in the
+// corresponding elements of ind. Minor indices are strictly increasing
+// within each major index.
+//
+// For CSR the major dimension is the rows and for CSC it is the columns.
+type compressed struct {
+	major, minor int
+	indptr       []int
+	ind          []int
+	data         []float64
+}
+
+// newCompressed returns compressed storage using the provided slices after
+// checking that they form a valid representation. If all of indptr, ind and
+// data are nil, an empty matrix is returned.
+func newCompressed(major, minor int, indptr, ind []int, data []float64) compressed {
+	if major <= 0 || minor <= 0 {
+		if major == 0 || minor == 0 {
+			panic(mat.ErrZeroLength)
+		}
+		panic(mat.ErrNegativeDimension)
+	}
+	if indptr == nil && ind == nil && data == nil {
+		return compressed{
+			major:  major,
+			minor:  minor,
+			indptr: make([]int, major+1),
+		}
+	}
+	if len(indptr) != major+1 || len(ind) != len(data) {
+		panic(mat.ErrShape)
+	}
+	if indptr[0] != 0 || indptr[major] != len(ind) {
+		panic(badIndptr)
+	}
+	for i := 0; i < major; i++ {
+		if indptr[i] > indptr[i+1] {
+			panic(badIndptr)
+		}
+		prev := -1
+		for _, j := range ind[indptr[i]:indptr[i+1]] {
+			if j < 0 || minor <= j {
+				panic(mat.ErrIndexOutOfRange)
+			}
+			if j <= prev {
+				panic(unsortedIndex)
+			}
+			prev = j
+		}
+	}
+	return compressed{
+		major:  major,
+		minor:  minor,
+		indptr: indptr,
+		ind:    ind,
+		data:   data,
+	}
+}
+
+// compress returns compressed storage for the elements given in coordinate
+// form. Duplicate entries are summed and zero-valued results are dropped.
+// The input slices are not modified.
+func compress(major, minor int, mi, ni []int, v []float64) compressed {
+	c := compressed{
+		major:  major,
+		minor:  minor,
+		indptr: make([]int, major+1),
+	}
+	// Counting sort by major index.
+	for _, i := range mi {
+		c.indptr[i+1]++
+	}
+	for i := 0; i < major; i++ {
+		c.indptr[i+1] += c.indptr[i]
+	}
+	ind := make([]int, len(mi))
+	data := make([]float64, len(mi))
+	next := slices.Clone(c.indptr[:major])
+	for k, i := range mi {
+		p := next[i]
+		ind[p] = ni[k]
+		data[p] = v[k]
+		next[i]++
+	}
+
+	// Sort each major slice by minor index, then sum
+	// duplicates and drop zeros, compacting in place.
+	var n int
+	for i := 0; i < major; i++ {
+		lo, hi := c.indptr[i], c.indptr[i+1]
+		sort.Sort(byIndex{ind: ind[lo:hi], data: data[lo:hi]})
+		c.indptr[i] = n
+		for k := lo; k < hi; {
+			j := ind[k]
+			var sum float64
+			for ; k < hi && ind[k] == j; k++ {
+				sum += data[k]
+			}
+			if sum != 0 {
+				ind[n] = j
+				data[n] = sum
+				n++
+			}
+		}
+	}
+	c.indptr[major] = n
+	c.ind = ind[:n:n]
+	c.data = data[:n:n]
+	return c
+}
+
+// byIndex sorts paired index and data slices by index.
+type byIndex struct {
+	ind  []int
+	data []float64
+}
+
+func (b byIndex) Len() int           { return len(b.ind) }
+func (b byIndex) Less(i, j int) bool { return b.ind[i] < b.ind[j] }
+func (b byIndex) Swap(i, j int) {
+	b.ind[i], b.ind[j] = b.ind[j], b.ind[i]
+	b.data[i], b.data[j] = b.data[j], b.data[i]
+}
+
+// nnz returns the number of stored elements.
+func (c *compressed) nnz() int {
+	return c.indptr[c.major]
+}
+
+// at returns the element at major index i and minor index j.
+func (c *compressed) at(i, j int) float64 {
+	lo, hi := c.indptr[i], c.indptr[i+1]
+	k, ok := slices.BinarySearch(c.ind[lo:hi], j)
+	if !ok {
+		return 0
+	}
+	return c.data[lo+k]
+}
+
+// doNonZero calls fn for each non-zero element with the major and minor
+// indices and the element value.
+func (c *compressed) doNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < c.major; i++ {
+		c.doMajor(i, fn)
+	}
+}
+
+// doMajor calls fn for each non-zero element of major index i.
+func (c *compressed) doMajor(i int, fn func(i, j int, v float64)) {
+	for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+		if c.data[k] != 0 {
+			fn(i, c.ind[k], c.data[k])
+		}
+	}
+}
+
+// doMinor calls fn for each non-zero element of minor index j.
+func (c *compressed) doMinor(j int, fn func(i, j int, v float64)) {
+	for i := 0; i < c.major; i++ {
+		v := c.at(i, j)
+		if v != 0 {
+			fn(i, j, v)
+		}
+	}
+}
+
+// mulVec computes y = A*x where A is the major×minor matrix represented by c.
+func (c *compressed) mulVec(y, x []float64) {
+	for i := 0; i < c.major; i++ {
+		var sum float64
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			sum += c.data[k] * x[c.ind[k]]
+		}
+		y[i] = sum
+	}
+}
+
+// mulVecTrans computes y = Aᵀ*x where A is the major×minor matrix
+// represented by c.
+func (c *compressed) mulVecTrans(y, x []float64) {
+	clear(y)
+	for i := 0; i < c.major; i++ {
+		xi := x[i]
+		if xi == 0 {
+			continue
+		}
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			y[c.ind[k]] += c.data[k] * xi
+		}
+	}
+}
+
+// transpose returns an explicit transpose of c, that is the minor×major
+// compressed representation of the same elements.
+func (c *compressed) transpose() compressed {
+	t := compressed{
+		major:  c.minor,
+		minor:  c.major,
+		indptr: make([]int, c.minor+1),
+		ind:    make([]int, c.nnz()),
+		data:   make([]float64, c.nnz()),
+	}
+	for _, j := range c.ind[:c.nnz()] {
+		t.indptr[j+1]++
+	}
+	for j := 0; j < c.minor; j++ {
+		t.indptr[j+1] += t.indptr[j]
+	}
+	next := slices.Clone(t.indptr[:c.minor])
+	// Iterating in increasing major order keeps the minor
+	// indices of the result sorted.
+	for i := 0; i < c.major; i++ {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			j := c.ind[k]
+			p := next[j]
+			t.ind[p] = i
+			t.data[p] = c.data[k]
+			next[j]++
+		}
+	}
+	return t
+}
+
+// clone returns a deep copy of c.
+func (c *compressed) clone() compressed {
+	n := c.nnz()
+	return compressed{
+		major:  c.major,
+		minor:  c.minor,
+		indptr: slices.Clone(c.indptr),
+		ind:    slices.Clone(c.ind[:n]),
+		data:   slices.Clone(c.data[:n]),
+	}
+}
+
+// scale multiplies the stored elements of c by f.
+func (c *compressed) scale(f float64) {
+	for k := range c.data[:c.nnz()] {
+		c.data[k] *= f
+	}
+}
+
+// addScaled returns the compressed representation of a + alpha*b. The
+// dimensions of a and b must match. Zero-valued results are dropped.
+func addScaled(a *compressed, alpha float64, b *compressed) compressed {
+	c := compressed{
+		major:  a.major,
+		minor:  a.minor,
+		indptr: make([]int, a.major+1),
+		ind:    make([]int, 0, a.nnz()+b.nnz()),
+		data:   make([]float64, 0, a.nnz()+b.nnz()),
+	}
+	for i := 0; i < a.major; i++ {
+		ka, kb := a.indptr[i], b.indptr[i]
+		ea, eb := a.indptr[i+1], b.indptr[i+1]
+		for ka < ea || kb < eb {
+			var j int
+			var v float64
+			switch {
+			case kb == eb || (ka < ea && a.ind[ka] < b.ind[kb]):
+				j, v = a.ind[ka], a.data[ka]
+				ka++
+			case ka == ea || b.ind[kb] < a.ind[ka]:
+				j, v = b.ind[kb], alpha*b.data[kb]
+				kb++
+			default:
+				j, v = a.ind[ka], a.data[ka]+alpha*b.data[kb]
+				ka++
+				kb++
+			}
+			if v != 0 {
+				c.ind = append(c.ind, j)
+				c.data = append(c.data, v)
+			}
+		}
+		c.indptr[i+1] = len(c.ind)
+	}
+	return c
+}
+
+// compressedOf returns a compressed representation of a with the major
+// dimension along the rows if byRow is true and along the columns otherwise.
+// The returned value may share storage with a.
+func compressedOf(a mat.Matrix, byRow bool) compressed {
+	switch a := a.(type) {
+	case *CSR:
+		if byRow {
+			return a.mat
+		}
+		return a.mat.transpose()
+	case *CSC:
+		if !byRow {
+			return a.mat
+		}
+		return a.mat.transpose()
+	case *COO:
+		if byRow {
+			return compress(a.r, a.c, a.rows, a.cols, a.data)
+		}
+		return compress(a.c, a.r, a.cols, a.rows, a.data)
+	}
+
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		panic(mat.ErrZeroLength)
+	}
+	var rows, cols []int
+	var data []float64
+	add := func(i, j int, v float64) {
+		rows = append(rows, i)
+		cols = append(cols, j)
+		data = append(data, v)
+	}
+	if nz, ok := a.(mat.NonZeroDoer); ok {
+		nz.DoNonZero(add)
+	} else {
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				v := a.At(i, j)
+				if v != 0 {
+					add(i, j, v)
+				}
+			}
+		}
+	}
+	if byRow {
+		return compress(r, c, rows, cols, data)
+	}
+	return compress(c, r, cols, rows, data)
+}
+
+// vecData returns the elements of x as a contiguous slice. The returned
+// slice may share storage with x.
+func vecData(x mat.Vector) []float64 {
+	if rv, ok := x.(mat.RawVectorer); ok {
+		v := rv.RawVector()
+		if v.Inc == 1 {
+			return v.Data[:v.N]
+		}
+	}
+	data := make([]float64, x.Len())
+	for i := range data {
+		data[i] = x.AtVec(i)
+	}
+	return data
+}
+
+// reuseVec sizes dst to have length n if it is empty, or checks that it
+// has length n otherwise.
+func reuseVec(dst *mat.VecDense, n int) {
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+		return
+	}
+	if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+}
+
+// reuseDense sizes dst to be r×c if it is empty, or checks that it is r×c
+// otherwise.
+func reuseDense(dst *mat.Dense, r, c int) {
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+		return
+	}
+	dr, dc := dst.Dims()
+	if dr != r || dc != c {
+		panic(mat.ErrShape)
+	}
+}
+
+// mulVecTo computes dst = op(A)*x where A is the matrix represented by c in
+// major×minor orientation, and op(A) is A if trans is false and Aᵀ otherwise.
+func (c *compressed) mulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	m, n := c.major, c.minor
+	if trans {
+		m, n = n, m
+	}
+	if x.Len() != n {
+		panic(mat.ErrShape)
+	}
+	reuseVec(dst, m)
+
+	xd := vecData(x)
+	// Compute into a temporary so that dst and x may alias.
+	y := make([]float64, m)
+	if trans {
+		c.mulVecTrans(y, xd)
+	} else {
+		c.mulVec(y, xd)
+	}
+	for i, v := range y {
+		dst.SetVec(i, v)
+	}
+}
+
+// mulMatTo computes dst = op(A)*b where A is the matrix represented by c in
+// major×minor orientation, and op(A) is A if trans is false and Aᵀ otherwise.
+func (c *compressed) mulMatTo(dst *mat.Dense, trans bool, b mat.Matrix) {
+	m, n := c.major, c.minor
+	if trans {
+		m, n = n, m
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(mat.ErrShape)
+	}
+	reuseDense(dst, m, bc)
+
+	// Compute into a temporary so that dst and b may alias.
+	tmp := mat.NewDense(m, bc, nil)
+	x := make([]float64, n)
+	y := make([]float64, m)
+	for j := 0; j < bc; j++ {
+		mat.Col(x, j, b)
+		if trans {
+			c.mulVecTrans(y, x)
+		} else {
+			c.mulVec(y, x)
+		}
+		tmp.SetCol(j, y)
+	}
+	dst.Copy(tmp)
+}
+
+// toDense returns a dense copy of the matrix represented by c. If trans is
+// true the major dimension is placed along the columns.
+func (c *compressed) toDense(trans bool) *mat.Dense {
+	var d *mat.Dense
+	if trans {
+		d = mat.NewDense(c.minor, c.major, nil)
+	} else {
+		d = mat.NewDense(c.major, c.minor, nil)
+	}
+	raw := d.RawMatrix()
+	for i := 0; i < c.major; i++ {
+		for k := c.indptr[i]; k < c.indptr[i+1]; k++ {
+			j := c.ind[k]
+			if trans {
+				raw.Data[j*raw.Stride+i] = c.data[k]
+			} else {
+				raw.Data[i*raw.Stride+j] = c.data[k]
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import "gonum.org/v1/gonum/mat"
+
+var (
+	coo *COO
+	_   mat.Matrix = coo
+)
+
+// COO is a sparse matrix in coordinate list format. Each stored element is
+// held as a row index, a column index and a value. Elements may be stored in
+// any order and the same position may be stored more than once, in which case
+// the value of the element is the sum of the stored values.
+//
+// COO is intended for building sparse matrices. Arithmetic should be done
+// after conversion to CSR or CSC format.
+type COO struct {
+	r, c int
+	rows []int
+	cols []int
+	data []float64
+}
+
+// NewCOO returns a new r×c COO matrix holding the elements specified by
+// rows, cols and data such that the element at rows[k], cols[k] has the
+// value data[k]. The slices are used as the backing storage of the returned
+// matrix. If rows, cols and data are nil, an empty matrix is returned.
+//
+// NewCOO will panic if r or c is not positive, if the lengths of rows, cols
+// and data differ, or if any index is out of range.
+func NewCOO(r, c int, rows, cols []int, data []float64) *COO {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(mat.ErrZeroLength)
+		}
+		panic(mat.ErrNegativeDimension)
+	}
+	if len(rows) != len(data) || len(cols) != len(data) {
+		panic(mat.ErrShape)
+	}
+	for k := range data {
+		if rows[k] < 0 || r <= rows[k] {
+			panic(mat.ErrRowAccess)
+		}
+		if cols[k] < 0 || c <= cols[k] {
+			panic(mat.ErrColAccess)
+		}
+	}
+	return &COO{r: r, c: c, rows: rows, cols: cols, data: data}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *COO) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j. At sums all the stored values
+// at the position and so takes time proportional to the number of stored
+// elements.
+func (m *COO) At(i, j int) float64 {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	var v float64
+	for k, r := range m.rows {
+		if r == i && m.cols[k] == j {
+			v += m.data[k]
+		}
+	}
+	return v
+}
+
+// T returns the transpose of the receiver. The returned matrix shares
+// storage with the receiver.
+func (m *COO) T() mat.Matrix {
+	return &COO{r: m.c, c: m.r, rows: m.cols, cols: m.rows, data: m.data}
+}
+
+// NNZ returns the number of stored elements, including duplicates and
+// explicitly stored zeros.
+func (m *COO) NNZ() int {
+	return len(m.data)
+}
+
+// Append adds the value v at row i, column j. If an element is already
+// stored at that position, the value of the element becomes the sum of the
+// stored values.
+func (m *COO) Append(i, j int, v float64) {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	m.rows = append(m.rows, i)
+	m.cols = append(m.cols, j)
+	m.data = append(m.data, v)
+}
+
+// ToCSR returns a CSR matrix holding the elements of the receiver. Duplicate
+// entries are summed and zero-valued elements are not stored.
+func (m *COO) ToCSR() *CSR {
+	return &CSR{mat: compress(m.r, m.c, m.rows, m.cols, m.data)}
+}
+
+// ToCSC returns a CSC matrix holding the elements of the receiver. Duplicate
+// entries are summed and zero-valued elements are not stored.
+func (m *COO) ToCSC() *CSC {
+	return &CSC{mat: compress(m.c, m.r, m.cols, m.rows, m.data)}
+}
+
+// ToDense returns a dense copy of the receiver.
+func (m *COO) ToDense() *mat.Dense {
+	d := mat.NewDense(m.r, m.c, nil)
+	raw := d.RawMatrix()
+	for k, v := range m.data {
+		raw.Data[m.rows[k]*raw.Stride+m.cols[k]] += v
+	}
+	return d
+}
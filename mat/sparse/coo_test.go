@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randCOO returns a random r×c COO matrix with approximately density*r*c
+// stored elements, some of which may be duplicates, together with the
+// equivalent dense matrix.
+func randCOO(r, c int, density float64, rnd *rand.Rand) (*COO, *mat.Dense) {
+	m := NewCOO(r, c, nil, nil, nil)
+	d := mat.NewDense(r, c, nil)
+	n := int(density * float64(r*c))
+	for k := 0; k < n; k++ {
+		i := rnd.IntN(r)
+		j := rnd.IntN(c)
+		v := rnd.NormFloat64()
+		m.Append(i, j, v)
+		d.Set(i, j, d.At(i, j)+v)
+	}
+	return m, d
+}
+
+func TestCOO(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 2, 5, 10} {
+		for _, c := range []int{1, 3, 7} {
+			for _, density := range []float64{0, 0.2, 1, 3} {
+				m, want := randCOO(r, c, density, rnd)
+				if !mat.Equal(m, want) {
+					t.Errorf("unexpected At result for r=%d c=%d density=%v", r, c, density)
+				}
+				if !mat.Equal(m.T(), want.T()) {
+					t.Errorf("unexpected transpose for r=%d c=%d density=%v", r, c, density)
+				}
+				if !mat.EqualApprox(m.ToDense(), want, 1e-14) {
+					t.Errorf("unexpected ToDense result for r=%d c=%d density=%v", r, c, density)
+				}
+				if !mat.EqualApprox(m.ToCSR(), want, 1e-14) {
+					t.Errorf("unexpected ToCSR result for r=%d c=%d density=%v", r, c, density)
+				}
+				if !mat.EqualApprox(m.ToCSC(), want, 1e-14) {
+					t.Errorf("unexpected ToCSC result for r=%d c=%d density=%v", r, c, density)
+				}
+			}
+		}
+	}
+}
+
+func TestCOODuplicates(t *testing.T) {
+	t.Parallel()
+	m := NewCOO(2, 3, []int{0, 1, 0, 0}, []int{2, 1, 2, 0}, []float64{1, 4, 2, 5})
+	m.Append(1, 1, -4)
+	if m.NNZ() != 5 {
+		t.Errorf("unexpected number of stored elements: got %d, want 5", m.NNZ())
+	}
+	want := mat.NewDense(2, 3, []float64{
+		5, 0, 3,
+		0, 0, 0,
+	})
+	if !mat.Equal(m, want) {
+		t.Errorf("unexpected matrix:\ngot:\n%v\nwant:\n%v", mat.Formatted(m), mat.Formatted(want))
+	}
+	csr := m.ToCSR()
+	if csr.NNZ() != 2 {
+		t.Errorf("unexpected number of CSR stored elements: got %d, want 2", csr.NNZ())
+	}
+	csc := m.ToCSC()
+	if csc.NNZ() != 2 {
+		t.Errorf("unexpected number of CSC stored elements: got %d, want 2", csc.NNZ())
+	}
+}
+
+func TestNewCOOPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name       string
+		r, c       int
+		rows, cols []int
+		data       []float64
+	}{
+		{name: "zero rows", r: 0, c: 2},
+		{name: "negative cols", r: 2, c: -1},
+		{name: "length mismatch", r: 2, c: 2, rows: []int{0}, cols: []int{0, 1}, data: []float64{1}},
+		{name: "row out of range", r: 2, c: 2, rows: []int{2}, cols: []int{0}, data: []float64{1}},
+		{name: "col out of range", r: 2, c: 2, rows: []int{0}, cols: []int{-1}, data: []float64{1}},
+	} {
+		if !panics(func() { NewCOO(test.r, test.c, test.rows, test.cols, test.data) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
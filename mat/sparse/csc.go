@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import "gonum.org/v1/gonum/mat"
+
+var (
+	csc *CSC
+	_   mat.Matrix     = csc
+	_   mat.ClonerFrom = csc
+
+	_ mat.NonZeroDoer    = csc
+	_ mat.RowNonZeroDoer = csc
+	_ mat.ColNonZeroDoer = csc
+)
+
+// CSC is a sparse matrix in compressed sparse column format.
+//
+// The non-zero elements of each column are stored contiguously together with
+// their row indices, which are strictly increasing within each column. This
+// makes CSC efficient for column access and for computing Aᵀ⋅x.
+type CSC struct {
+	// mat holds the storage with the
+	// columns as the major dimension.
+	mat compressed
+}
+
+// NewCSC returns a new r×c CSC matrix. If indptr, ind and data are all nil,
+// the returned matrix has no stored elements. Otherwise indptr must have
+// length c+1 and ind and data must have length indptr[c], and the slices
+// are used as the backing storage of the returned matrix. The non-zero
+// elements of column j are held in data[indptr[j]:indptr[j+1]] with their
+// row indices in ind[indptr[j]:indptr[j+1]].
+//
+// NewCSC will panic if r or c is not positive, if the slices do not form a
+// valid representation or if the row indices in a column are not strictly
+// increasing.
+func NewCSC(r, c int, indptr, ind []int, data []float64) *CSC {
+	return &CSC{mat: newCompressed(c, r, indptr, ind, data)}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSC) Dims() (r, c int) {
+	return m.mat.minor, m.mat.major
+}
+
+// At returns the element at row i, column j.
+func (m *CSC) At(i, j int) float64 {
+	if i < 0 || m.mat.minor <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.mat.major <= j {
+		panic(mat.ErrColAccess)
+	}
+	return m.mat.at(j, i)
+}
+
+// T returns the transpose of the receiver as a CSR matrix. The returned
+// matrix shares storage with the receiver, so no copying is performed.
+func (m *CSC) T() mat.Matrix {
+	return m.TCSR()
+}
+
+// TCSR returns the transpose of the receiver as a CSR matrix. The returned
+// matrix shares storage with the receiver, so no copying is performed.
+func (m *CSC) TCSR() *CSR {
+	return &CSR{mat: m.mat}
+}
+
+// NNZ returns the number of stored elements.
+func (m *CSC) NNZ() int {
+	return m.mat.nnz()
+}
+
+// RawCSC returns the underlying index pointer, row index and data slices
+// of the receiver. Changes to the elements of data will be reflected in the
+// receiver.
+func (m *CSC) RawCSC() (indptr, ind []int, data []float64) {
+	return m.mat.indptr, m.mat.ind, m.mat.data
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m.
+// The function fn takes a row/column index and the element value of m at
+// (i, j). Elements are visited in column-major order.
+func (m *CSC) DoNonZero(fn func(i, j int, v float64)) {
+	m.mat.doNonZero(func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of
+// row i of m. The function fn takes a row/column index and the element value
+// of m at (i, j).
+func (m *CSC) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if i < 0 || m.mat.minor <= i {
+		panic(mat.ErrRowAccess)
+	}
+	m.mat.doMinor(i, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m. The function fn takes a row/column index and the element
+// value of m at (i, j).
+func (m *CSC) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if j < 0 || m.mat.major <= j {
+		panic(mat.ErrColAccess)
+	}
+	m.mat.doMajor(j, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. If a implements mat.NonZeroDoer, only the non-zero
+// elements of a are visited, otherwise every element is inspected.
+func (m *CSC) CloneFrom(a mat.Matrix) {
+	if a, ok := a.(*CSC); ok {
+		m.mat = a.mat.clone()
+		return
+	}
+	m.mat = compressedOf(a, false)
+}
+
+// ToDense returns a dense copy of the receiver.
+func (m *CSC) ToDense() *mat.Dense {
+	return m.mat.toDense(true)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+//
+// If dst is empty, MulVecTo will resize it to the correct size, otherwise it
+// must have the correct size or MulVecTo will panic.
+func (m *CSC) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	m.mat.mulVecTo(dst, !trans, x)
+}
+
+// MulMatTo computes A⋅B or Aᵀ⋅B storing the result into dst.
+//
+// If dst is empty, MulMatTo will resize it to the correct size, otherwise it
+// must have the correct size or MulMatTo will panic.
+func (m *CSC) MulMatTo(dst *mat.Dense, trans bool, b mat.Matrix) {
+	m.mat.mulMatTo(dst, !trans, b)
+}
+
+// Add adds a and b element-wise, placing the result in the receiver.
+// Add will panic if the two matrices do not have the same shape.
+func (m *CSC) Add(a, b mat.Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(mat.ErrShape)
+	}
+	ca := compressedOf(a, false)
+	cb := compressedOf(b, false)
+	m.mat = addScaled(&ca, 1, &cb)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver.
+// Sub will panic if the two matrices do not have the same shape.
+func (m *CSC) Sub(a, b mat.Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(mat.ErrShape)
+	}
+	ca := compressedOf(a, false)
+	cb := compressedOf(b, false)
+	m.mat = addScaled(&ca, -1, &cb)
+}
+
+// Scale multiplies the elements of a by f, placing the result in the
+// receiver.
+func (m *CSC) Scale(f float64, a mat.Matrix) {
+	if a, ok := a.(*CSC); ok {
+		m.mat = a.mat.clone()
+	} else {
+		m.mat = compressedOf(a, false)
+	}
+	m.mat.scale(f)
+}
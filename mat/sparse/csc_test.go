@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNewCSC(t *testing.T) {
+	t.Parallel()
+	m := NewCSC(4, 3,
+		[]int{0, 2, 2, 5},
+		[]int{1, 3, 0, 1, 2},
+		[]float64{1, 2, 3, 4, 5},
+	)
+	want := mat.NewDense(4, 3, []float64{
+		0, 0, 3,
+		1, 0, 4,
+		0, 0, 5,
+		2, 0, 0,
+	})
+	if !mat.Equal(m, want) {
+		t.Errorf("unexpected matrix:\ngot:\n%v\nwant:\n%v", mat.Formatted(m), mat.Formatted(want))
+	}
+	if !mat.Equal(m.TCSR(), want.T()) {
+		t.Errorf("unexpected transpose:\ngot:\n%v\nwant:\n%v", mat.Formatted(m.TCSR()), mat.Formatted(want.T()))
+	}
+	if !panics(func() { NewCSC(4, 3, []int{0, 1}, []int{0}, []float64{1}) }) {
+		t.Errorf("expected panic for short indptr")
+	}
+}
+
+func TestCSC(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 2, 5, 10} {
+		for _, c := range []int{1, 3, 7} {
+			for _, density := range []float64{0, 0.2, 1} {
+				coo, want := randCOO(r, c, density, rnd)
+				m := coo.ToCSC()
+				testCompressed(t, m, want, rnd)
+
+				var got CSC
+				got.CloneFrom(want)
+				if !mat.Equal(&got, want) {
+					t.Errorf("unexpected CloneFrom dense result for r=%d c=%d density=%v", r, c, density)
+				}
+				got.CloneFrom(coo.ToCSR())
+				if !mat.EqualApprox(&got, want, 1e-14) {
+					t.Errorf("unexpected CloneFrom CSR result for r=%d c=%d density=%v", r, c, density)
+				}
+				if !mat.Equal(m.ToDense(), want) {
+					t.Errorf("unexpected ToDense result for r=%d c=%d density=%v", r, c, density)
+				}
+
+				ca, a := randCOO(r, c, 0.3, rnd)
+				var sum mat.Dense
+				sum.Add(want, a)
+				got.Add(m, ca.ToCSC())
+				if !mat.EqualApprox(&got, &sum, 1e-14) {
+					t.Errorf("unexpected Add result for r=%d c=%d density=%v", r, c, density)
+				}
+				sum.Sub(want, a)
+				got.Sub(m, ca)
+				if !mat.EqualApprox(&got, &sum, 1e-14) {
+					t.Errorf("unexpected Sub result for r=%d c=%d density=%v", r, c, density)
+				}
+				sum.Scale(3, want)
+				got.Scale(3, m)
+				if !mat.EqualApprox(&got, &sum, 1e-14) {
+					t.Errorf("unexpected Scale result for r=%d c=%d density=%v", r, c, density)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import "gonum.org/v1/gonum/mat"
+
+var (
+	csr *CSR
+	_   mat.Matrix     = csr
+	_   mat.ClonerFrom = csr
+
+	_ mat.NonZeroDoer    = csr
+	_ mat.RowNonZeroDoer = csr
+	_ mat.ColNonZeroDoer = csr
+)
+
+// CSR is a sparse matrix in compressed sparse row format.
+//
+// The non-zero elements of each row are stored contiguously together with
+// their column indices, which are strictly increasing within each row. This
+// makes CSR efficient for row access and for computing A⋅x.
+type CSR struct {
+	// mat holds the storage with the
+	// rows as the major dimension.
+	mat compressed
+}
+
+// NewCSR returns a new r×c CSR matrix. If indptr, ind and data are all nil,
+// the returned matrix has no stored elements. Otherwise indptr must have
+// length r+1 and ind and data must have length indptr[r], and the slices
+// are used as the backing storage of the returned matrix. The non-zero
+// elements of row i are held in data[indptr[i]:indptr[i+1]] with their
+// column indices in ind[indptr[i]:indptr[i+1]].
+//
+// NewCSR will panic if r or c is not positive, if the slices do not form a
+// valid representation or if the column indices in a row are not strictly
+// increasing.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	return &CSR{mat: newCompressed(r, c, indptr, ind, data)}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.mat.major, m.mat.minor
+}
+
+// At returns the element at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if i < 0 || m.mat.major <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.mat.minor <= j {
+		panic(mat.ErrColAccess)
+	}
+	return m.mat.at(i, j)
+}
+
+// T returns the transpose of the receiver as a CSC matrix. The returned
+// matrix shares storage with the receiver, so no copying is performed.
+func (m *CSR) T() mat.Matrix {
+	return m.TCSC()
+}
+
+// TCSC returns the transpose of the receiver as a CSC matrix. The returned
+// matrix shares storage with the receiver, so no copying is performed.
+func (m *CSR) TCSC() *CSC {
+	return &CSC{mat: m.mat}
+}
+
+// NNZ returns the number of stored elements.
+func (m *CSR) NNZ() int {
+	return m.mat.nnz()
+}
+
+// RawCSR returns the underlying index pointer, column index and data slices
+// of the receiver. Changes to the elements of data will be reflected in the
+// receiver.
+func (m *CSR) RawCSR() (indptr, ind []int, data []float64) {
+	return m.mat.indptr, m.mat.ind, m.mat.data
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m.
+// The function fn takes a row/column index and the element value of m at
+// (i, j). Elements are visited in row-major order.
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	m.mat.doNonZero(fn)
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of
+// row i of m. The function fn takes a row/column index and the element value
+// of m at (i, j).
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if i < 0 || m.mat.major <= i {
+		panic(mat.ErrRowAccess)
+	}
+	m.mat.doMajor(i, fn)
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m. The function fn takes a row/column index and the element
+// value of m at (i, j).
+func (m *CSR) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if j < 0 || m.mat.minor <= j {
+		panic(mat.ErrColAccess)
+	}
+	m.mat.doMinor(j, fn)
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. If a implements mat.NonZeroDoer, only the non-zero
+// elements of a are visited, otherwise every element is inspected.
+func (m *CSR) CloneFrom(a mat.Matrix) {
+	if a, ok := a.(*CSR); ok {
+		m.mat = a.mat.clone()
+		return
+	}
+	m.mat = compressedOf(a, true)
+}
+
+// ToDense returns a dense copy of the receiver.
+func (m *CSR) ToDense() *mat.Dense {
+	return m.mat.toDense(false)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+//
+// If dst is empty, MulVecTo will resize it to the correct size, otherwise it
+// must have the correct size or MulVecTo will panic.
+func (m *CSR) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	m.mat.mulVecTo(dst, trans, x)
+}
+
+// MulMatTo computes A⋅B or Aᵀ⋅B storing the result into dst.
+//
+// If dst is empty, MulMatTo will resize it to the correct size, otherwise it
+// must have the correct size or MulMatTo will panic.
+func (m *CSR) MulMatTo(dst *mat.Dense, trans bool, b mat.Matrix) {
+	m.mat.mulMatTo(dst, trans, b)
+}
+
+// Add adds a and b element-wise, placing the result in the receiver.
+// Add will panic if the two matrices do not have the same shape.
+func (m *CSR) Add(a, b mat.Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(mat.ErrShape)
+	}
+	ca := compressedOf(a, true)
+	cb := compressedOf(b, true)
+	m.mat = addScaled(&ca, 1, &cb)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver.
+// Sub will panic if the two matrices do not have the same shape.
+func (m *CSR) Sub(a, b mat.Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(mat.ErrShape)
+	}
+	ca := compressedOf(a, true)
+	cb := compressedOf(b, true)
+	m.mat = addScaled(&ca, -1, &cb)
+}
+
+// Scale multiplies the elements of a by f, placing the result in the
+// receiver.
+func (m *CSR) Scale(f float64, a mat.Matrix) {
+	if a, ok := a.(*CSR); ok {
+		m.mat = a.mat.clone()
+	} else {
+		m.mat = compressedOf(a, true)
+	}
+	m.mat.scale(f)
+}
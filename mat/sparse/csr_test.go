@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNewCSR(t *testing.T) {
+	t.Parallel()
+	m := NewCSR(3, 4,
+		[]int{0, 2, 2, 5},
+		[]int{1, 3, 0, 1, 2},
+		[]float64{1, 2, 3, 4, 5},
+	)
+	want := mat.NewDense(3, 4, []float64{
+		0, 1, 0, 2,
+		0, 0, 0, 0,
+		3, 4, 5, 0,
+	})
+	if !mat.Equal(m, want) {
+		t.Errorf("unexpected matrix:\ngot:\n%v\nwant:\n%v", mat.Formatted(m), mat.Formatted(want))
+	}
+	if m.NNZ() != 5 {
+		t.Errorf("unexpected number of stored elements: got %d, want 5", m.NNZ())
+	}
+
+	for _, test := range []struct {
+		name   string
+		indptr []int
+		ind    []int
+		data   []float64
+	}{
+		{name: "short indptr", indptr: []int{0, 1}, ind: []int{0}, data: []float64{1}},
+		{name: "length mismatch", indptr: []int{0, 1, 1, 1}, ind: []int{0}, data: []float64{1, 2}},
+		{name: "bad last indptr", indptr: []int{0, 1, 1, 2}, ind: []int{0}, data: []float64{1}},
+		{name: "decreasing indptr", indptr: []int{0, 2, 1, 2}, ind: []int{0, 1}, data: []float64{1, 2}},
+		{name: "index out of range", indptr: []int{0, 1, 1, 1}, ind: []int{4}, data: []float64{1}},
+		{name: "unsorted index", indptr: []int{0, 2, 2, 2}, ind: []int{1, 0}, data: []float64{1, 2}},
+		{name: "duplicate index", indptr: []int{0, 2, 2, 2}, ind: []int{1, 1}, data: []float64{1, 2}},
+	} {
+		if !panics(func() { NewCSR(3, 4, test.indptr, test.ind, test.data) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestCSR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 2, 5, 10} {
+		for _, c := range []int{1, 3, 7} {
+			for _, density := range []float64{0, 0.2, 1} {
+				coo, want := randCOO(r, c, density, rnd)
+				m := coo.ToCSR()
+				testCompressed(t, m, want, rnd)
+
+				var got CSR
+				got.CloneFrom(want)
+				if !mat.Equal(&got, want) {
+					t.Errorf("unexpected CloneFrom dense result for r=%d c=%d density=%v", r, c, density)
+				}
+				got.CloneFrom(m.T().(*CSC).TCSR())
+				if !mat.Equal(&got, want) {
+					t.Errorf("unexpected CloneFrom CSR result for r=%d c=%d density=%v", r, c, density)
+				}
+				got.CloneFrom(coo.ToCSC())
+				if !mat.EqualApprox(&got, want, 1e-14) {
+					t.Errorf("unexpected CloneFrom CSC result for r=%d c=%d density=%v", r, c, density)
+				}
+				got.CloneFrom(coo)
+				if !mat.EqualApprox(&got, want, 1e-14) {
+					t.Errorf("unexpected CloneFrom COO result for r=%d c=%d density=%v", r, c, density)
+				}
+				if !mat.Equal(m.ToDense(), want) {
+					t.Errorf("unexpected ToDense result for r=%d c=%d density=%v", r, c, density)
+				}
+			}
+		}
+	}
+}
+
+func TestCSRArithmetic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 4, 9} {
+		for _, c := range []int{1, 3, 8} {
+			ca, a := randCOO(r, c, 0.3, rnd)
+			cb, b := randCOO(r, c, 0.3, rnd)
+			sa := ca.ToCSR()
+			sb := cb.ToCSR()
+
+			var want mat.Dense
+			var got CSR
+			want.Add(a, b)
+			got.Add(sa, sb)
+			if !mat.EqualApprox(&got, &want, 1e-14) {
+				t.Errorf("unexpected Add result for r=%d c=%d", r, c)
+			}
+			got.Add(sa, cb.ToCSC())
+			if !mat.EqualApprox(&got, &want, 1e-14) {
+				t.Errorf("unexpected mixed Add result for r=%d c=%d", r, c)
+			}
+			want.Sub(a, b)
+			got.Sub(sa, sb)
+			if !mat.EqualApprox(&got, &want, 1e-14) {
+				t.Errorf("unexpected Sub result for r=%d c=%d", r, c)
+			}
+			got.Sub(sa, sa)
+			if got.NNZ() != 0 {
+				t.Errorf("unexpected stored elements after cancellation for r=%d c=%d: %d", r, c, got.NNZ())
+			}
+			want.Scale(-2.5, a)
+			got.Scale(-2.5, sa)
+			if !mat.EqualApprox(&got, &want, 1e-14) {
+				t.Errorf("unexpected Scale result for r=%d c=%d", r, c)
+			}
+			if !mat.EqualApprox(sa, a, 1e-14) {
+				t.Errorf("Scale modified its input for r=%d c=%d", r, c)
+			}
+			got.CloneFrom(sa)
+			got.Scale(2, &got)
+			want.Scale(2, a)
+			if !mat.EqualApprox(&got, &want, 1e-14) {
+				t.Errorf("unexpected in-place Scale result for r=%d c=%d", r, c)
+			}
+		}
+	}
+
+	if !panics(func() {
+		var m CSR
+		m.Add(NewCSR(2, 3, nil, nil, nil), NewCSR(3, 2, nil, nil, nil))
+	}) {
+		t.Errorf("expected panic for mismatched Add")
+	}
+}
+
+// compressedMatrix is the set of methods shared by CSR and CSC.
+type compressedMatrix interface {
+	mat.Matrix
+	mat.NonZeroDoer
+	mat.RowNonZeroDoer
+	mat.ColNonZeroDoer
+	NNZ() int
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+	MulMatTo(dst *mat.Dense, trans bool, b mat.Matrix)
+}
+
+// testCompressed checks the methods common to CSR and CSC against the
+// dense matrix want.
+func testCompressed(t *testing.T, m compressedMatrix, want *mat.Dense, rnd *rand.Rand) {
+	t.Helper()
+	r, c := want.Dims()
+	if !mat.EqualApprox(m, want, 1e-14) {
+		t.Errorf("unexpected At result for %d×%d matrix", r, c)
+	}
+	if !mat.EqualApprox(m.T(), want.T(), 1e-14) {
+		t.Errorf("unexpected transpose for %d×%d matrix", r, c)
+	}
+
+	got := mat.NewDense(r, c, nil)
+	var nnz int
+	m.DoNonZero(func(i, j int, v float64) {
+		if v == 0 {
+			t.Errorf("zero value visited at (%d,%d)", i, j)
+		}
+		got.Set(i, j, v)
+		nnz++
+	})
+	if !mat.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected DoNonZero result for %d×%d matrix", r, c)
+	}
+	if nnz > m.NNZ() {
+		t.Errorf("more non-zero elements than stored for %d×%d matrix", r, c)
+	}
+	got.Zero()
+	for i := 0; i < r; i++ {
+		m.DoRowNonZero(i, func(i2, j int, v float64) {
+			if i2 != i {
+				t.Errorf("unexpected row index: got %d, want %d", i2, i)
+			}
+			got.Set(i, j, v)
+		})
+	}
+	if !mat.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected DoRowNonZero result for %d×%d matrix", r, c)
+	}
+	got.Zero()
+	for j := 0; j < c; j++ {
+		m.DoColNonZero(j, func(i, j2 int, v float64) {
+			if j2 != j {
+				t.Errorf("unexpected column index: got %d, want %d", j2, j)
+			}
+			got.Set(i, j, v)
+		})
+	}
+	if !mat.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected DoColNonZero result for %d×%d matrix", r, c)
+	}
+
+	for _, trans := range []bool{false, true} {
+		n, k := c, r
+		if trans {
+			n, k = r, c
+		}
+		x := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+		}
+		var wantVec mat.VecDense
+		if trans {
+			wantVec.MulVec(want.T(), x)
+		} else {
+			wantVec.MulVec(want, x)
+		}
+		var gotVec mat.VecDense
+		m.MulVecTo(&gotVec, trans, x)
+		if !mat.EqualApprox(&gotVec, &wantVec, 1e-13) {
+			t.Errorf("unexpected MulVecTo result for %d×%d matrix, trans=%t", r, c, trans)
+		}
+		if n == k {
+			m.MulVecTo(x, trans, x)
+			if !mat.EqualApprox(x, &wantVec, 1e-13) {
+				t.Errorf("unexpected aliased MulVecTo result for %d×%d matrix, trans=%t", r, c, trans)
+			}
+		}
+
+		b := mat.NewDense(n, 3, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < 3; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		var wantMat mat.Dense
+		if trans {
+			wantMat.Mul(want.T(), b)
+		} else {
+			wantMat.Mul(want, b)
+		}
+		var gotMat mat.Dense
+		m.MulMatTo(&gotMat, trans, b)
+		if !mat.EqualApprox(&gotMat, &wantMat, 1e-13) {
+			t.Errorf("unexpected MulMatTo result for %d×%d matrix, trans=%t", r, c, trans)
+		}
+		if !panics(func() { m.MulVecTo(&gotVec, trans, mat.NewVecDense(n+1, nil)) }) {
+			t.Errorf("expected panic for mismatched MulVecTo for %d×%d matrix, trans=%t", r, c, trans)
+		}
+	}
+
+	// Interoperation with mat through the Matrix interface.
+	var prod, wantProd mat.Dense
+	prod.Mul(m.T(), m)
+	wantProd.Mul(want.T(), want)
+	if !mat.EqualApprox(&prod, &wantProd, 1e-13) {
+		t.Errorf("unexpected mat.Dense.Mul result for %d×%d matrix", r, c)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sparse provides sparse matrix types that interoperate with the
+// mat package.
+//
+// Three storage formats are provided. COO is a coordinate list format that
+// is convenient for incrementally building a matrix. CSR and CSC are
+// compressed sparse row and compressed sparse column formats that are
+// efficient for arithmetic and matrix-vector products.
+//
+// All the types in this package satisfy the mat.Matrix interface, so they
+// can be passed to any function in mat that accepts a mat.Matrix. The CSR
+// and CSC types additionally satisfy mat.NonZeroDoer, mat.RowNonZeroDoer and
+// mat.ColNonZeroDoer.
+//
+// A typical use is to build a matrix in COO format and then compress it.
+//
+//	a := sparse.NewCOO(3, 3, nil, nil, nil)
+//	a.Append(0, 0, 2)
+//	a.Append(1, 2, -1)
+//	a.Append(2, 1, 4)
+//	csr := a.ToCSR()
+//
+//	var y mat.VecDense
+//	csr.MulVecTo(&y, false, mat.NewVecDense(3, []float64{1, 2, 3}))
+package sparse // import "gonum.org/v1/gonum/mat/sparse"
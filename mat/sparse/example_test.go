@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/sparse"
+)
+
+func ExampleCOO_ToCSR() {
+	// Build the 1-D Laplacian using a coordinate list.
+	const n = 5
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 2)
+		if i > 0 {
+			a.Append(i, i-1, -1)
+		}
+		if i < n-1 {
+			a.Append(i, i+1, -1)
+		}
+	}
+	l := a.ToCSR()
+	fmt.Printf("nnz = %d\n", l.NNZ())
+
+	var y mat.VecDense
+	l.MulVecTo(&y, false, mat.NewVecDense(n, []float64{1, 2, 3, 4, 5}))
+	fmt.Printf("L*x = %v\n", mat.Formatted(y.T()))
+
+	// Output:
+	// nnz = 13
+	// L*x = [0  0  0  0  6]
+}
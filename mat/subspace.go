@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+// The functions in this file determine the numerical rank of a matrix A from
+// its singular value decomposition in the same way as SVD.Rank, that is, as
+// the number of singular values greater than rcond times the largest singular
+// value. A typical choice of rcond is max(m,n)*ε where ε is the machine
+// epsilon.
+
+// PseudoInverse computes the Moore–Penrose pseudo-inverse A⁺ of the m×n matrix
+// A and stores the result into dst. The singular values of A that are not
+// greater than rcond times the largest singular value are treated as zero, see
+// SVD.Rank. If dst is empty, PseudoInverse will resize dst to be n×m. When dst
+// is non-empty, PseudoInverse will panic if dst is not n×m.
+//
+// PseudoInverse returns the numerical rank of A. If the singular value
+// decomposition of A could not be computed, PseudoInverse returns
+// ErrNoConvergence and dst is not modified. PseudoInverse will panic if rcond is
+// negative.
+func PseudoInverse(dst *Dense, a Matrix, rcond float64) (rank int, err error) {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	var svd SVD
+	if !svd.Factorize(a, SVDThin) {
+		return 0, ErrNoConvergence
+	}
+	rank = svd.Rank(rcond)
+	svd.PseudoInverseTo(dst, rank)
+	return rank, nil
+}
+
+// NullSpace computes an orthonormal basis for the null space of the m×n matrix
+// A and stores it into the columns of dst. The dimension k of the null space is
+// n minus the numerical rank of A, determined as in SVD.Rank. If dst is empty,
+// NullSpace will resize dst to be n×k. When dst is non-empty, NullSpace will
+// panic if dst is not n×k.
+//
+// NullSpace returns the dimension of the null space. If A has full column rank,
+// NullSpace returns zero and dst is not modified. If the singular value
+// decomposition of A could not be computed, NullSpace returns ErrNoConvergence
+// and dst is not modified. NullSpace will panic if rcond is negative.
+func NullSpace(dst *Dense, a Matrix, rcond float64) (k int, err error) {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	var svd SVD
+	if !svd.Factorize(a, SVDFullV) {
+		return 0, ErrNoConvergence
+	}
+	_, n := a.Dims()
+	k = n - svd.Rank(rcond)
+	if k == 0 {
+		return 0, nil
+	}
+	svd.NullSpaceTo(dst, n-k)
+	return k, nil
+}
+
+// Range computes an orthonormal basis for the range (column space) of the m×n
+// matrix A and stores it into the columns of dst. The dimension of the range is
+// the numerical rank r of A, determined as in SVD.Rank. If dst is empty, Range
+// will resize dst to be m×r. When dst is non-empty, Range will panic if dst is
+// not m×r.
+//
+// Range returns the rank of A. If A is numerically zero, Range returns zero and
+// dst is not modified. If the singular value decomposition of A could not be
+// computed, Range returns ErrNoConvergence and dst is not modified. Range will
+// panic if rcond is negative.
+func Range(dst *Dense, a Matrix, rcond float64) (rank int, err error) {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	var svd SVD
+	if !svd.Factorize(a, SVDThinU) {
+		return 0, ErrNoConvergence
+	}
+	rank = svd.Rank(rcond)
+	if rank == 0 {
+		return 0, nil
+	}
+	svd.RangeTo(dst, rank)
+	return rank, nil
+}
+
+// PrincipalAngles computes the principal angles between the ranges of the m×p
+// matrix A and the m×q matrix B. The ranges are determined as in Range using
+// the tolerance rcond. If the ranges have dimensions ka and kb, there are
+// k = min(ka,kb) principal angles
+//
+//	0 ≤ θ_0 ≤ θ_1 ≤ ... ≤ θ_{k-1} ≤ π/2.
+//
+// The cosines of the angles are the singular values of Qaᵀ*Qb, where Qa and Qb
+// are orthonormal bases of the ranges. Small angles are computed from their
+// sines to avoid the loss of accuracy of the arc cosine near zero.
+//
+// The angles are stored in dst, which is grown if its capacity is less than k,
+// and the slice of the k angles is returned. If either A or B is numerically
+// zero, the returned slice is empty. If a singular value decomposition could
+// not be computed, PrincipalAngles returns ErrNoConvergence.
+//
+// PrincipalAngles will panic if A and B do not have the same number of rows or
+// if rcond is negative.
+func PrincipalAngles(dst []float64, a, b Matrix, rcond float64) ([]float64, error) {
+	ma, _ := a.Dims()
+	mb, _ := b.Dims()
+	if ma != mb {
+		panic(ErrShape)
+	}
+	var qa, qb Dense
+	ka, err := Range(&qa, a, rcond)
+	if err != nil {
+		return dst[:0], err
+	}
+	kb, err := Range(&qb, b, rcond)
+	if err != nil {
+		return dst[:0], err
+	}
+	if ka == 0 || kb == 0 {
+		return dst[:0], nil
+	}
+	if ka < kb {
+		qa, qb = qb, qa
+		ka, kb = kb, ka
+	}
+	k := kb
+	if cap(dst) < k {
+		dst = make([]float64, k)
+	}
+	dst = dst[:k]
+
+	// The singular values of Qaᵀ*Qb in descending order are the cosines of
+	// the angles in ascending order.
+	var m Dense
+	m.Mul(qa.T(), &qb)
+	var svd SVD
+	if !svd.Factorize(&m, SVDNone) {
+		return dst[:0], ErrNoConvergence
+	}
+	cos := svd.Values(nil)
+
+	// The singular values of Qb - Qa*Qaᵀ*Qb in ascending order are the sines
+	// of the angles in ascending order.
+	var r Dense
+	r.Mul(&qa, &m)
+	r.Sub(&qb, &r)
+	if !svd.Factorize(&r, SVDNone) {
+		return dst[:0], ErrNoConvergence
+	}
+	sin := svd.Values(nil)
+
+	for i := range dst {
+		s := sin[k-1-i]
+		if s*s <= 0.5 {
+			dst[i] = math.Asin(math.Min(s, 1))
+		} else {
+			dst[i] = math.Acos(math.Min(cos[i], 1))
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,237 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+var subspaceTests = []struct {
+	m, n, rank int
+}{
+	{1, 1, 0},
+	{1, 1, 1},
+	{3, 3, 3},
+	{3, 3, 2},
+	{5, 3, 3},
+	{5, 3, 1},
+	{3, 5, 3},
+	{3, 5, 2},
+	{10, 10, 7},
+	{20, 12, 12},
+	{12, 20, 5},
+}
+
+func TestPseudoInverse(t *testing.T) {
+	t.Parallel()
+	const (
+		tol   = 1e-12
+		rcond = 1e-12
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range subspaceTests {
+		m, n := test.m, test.n
+		a := randRankDense(m, n, test.rank, rnd)
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, test.rank)
+
+		var ap Dense
+		rank, err := PseudoInverse(&ap, a, rcond)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if rank != test.rank {
+			t.Errorf("%s: unexpected rank; got %d, want %d", name, rank, test.rank)
+		}
+		if r, c := ap.Dims(); r != n || c != m {
+			t.Errorf("%s: unexpected dimensions of pseudo-inverse %d×%d", name, r, c)
+			continue
+		}
+
+		// Check the Penrose conditions.
+		var aap, apa, got Dense
+		aap.Mul(a, &ap)
+		apa.Mul(&ap, a)
+		got.Mul(&aap, a)
+		if !EqualApprox(&got, a, tol) {
+			t.Errorf("%s: A * A⁺ * A != A", name)
+		}
+		got.Reset()
+		got.Mul(&apa, &ap)
+		if !EqualApprox(&got, &ap, tol) {
+			t.Errorf("%s: A⁺ * A * A⁺ != A⁺", name)
+		}
+		if !EqualApprox(&aap, aap.T(), tol) {
+			t.Errorf("%s: A * A⁺ is not symmetric", name)
+		}
+		if !EqualApprox(&apa, apa.T(), tol) {
+			t.Errorf("%s: A⁺ * A is not symmetric", name)
+		}
+
+		// For a matrix of full rank, the pseudo-inverse gives the
+		// least-squares solution.
+		if rank == n && m >= n {
+			var want Dense
+			err := want.Solve(a, eye(m))
+			if err != nil {
+				t.Errorf("%s: unexpected error from Solve: %v", name, err)
+				continue
+			}
+			if !EqualApprox(&ap, &want, tol) {
+				t.Errorf("%s: pseudo-inverse does not match least-squares solution", name)
+			}
+		}
+	}
+}
+
+func TestNullSpaceRange(t *testing.T) {
+	t.Parallel()
+	const (
+		tol   = 1e-12
+		rcond = 1e-12
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range subspaceTests {
+		m, n := test.m, test.n
+		a := randRankDense(m, n, test.rank, rnd)
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, test.rank)
+
+		var ns Dense
+		k, err := NullSpace(&ns, a, rcond)
+		if err != nil {
+			t.Errorf("%s: unexpected error from NullSpace: %v", name, err)
+			continue
+		}
+		if k != n-test.rank {
+			t.Errorf("%s: unexpected dimension of null space; got %d, want %d", name, k, n-test.rank)
+		}
+		if k == 0 {
+			if !ns.IsEmpty() {
+				t.Errorf("%s: unexpected non-empty null space basis", name)
+			}
+		} else {
+			if r, c := ns.Dims(); r != n || c != k {
+				t.Errorf("%s: unexpected dimensions of null space basis %d×%d", name, r, c)
+				continue
+			}
+			var nn, an Dense
+			nn.Mul(ns.T(), &ns)
+			if !EqualApprox(&nn, eye(k), tol) {
+				t.Errorf("%s: null space basis is not orthonormal", name)
+			}
+			an.Mul(a, &ns)
+			if !EqualApprox(&an, NewDense(m, k, nil), tol) {
+				t.Errorf("%s: A * N != 0", name)
+			}
+		}
+
+		var q Dense
+		rank, err := Range(&q, a, rcond)
+		if err != nil {
+			t.Errorf("%s: unexpected error from Range: %v", name, err)
+			continue
+		}
+		if rank != test.rank {
+			t.Errorf("%s: unexpected rank; got %d, want %d", name, rank, test.rank)
+		}
+		if rank == 0 {
+			if !q.IsEmpty() {
+				t.Errorf("%s: unexpected non-empty range basis", name)
+			}
+			continue
+		}
+		if r, c := q.Dims(); r != m || c != rank {
+			t.Errorf("%s: unexpected dimensions of range basis %d×%d", name, r, c)
+			continue
+		}
+		var qq, qta, qqa Dense
+		qq.Mul(q.T(), &q)
+		if !EqualApprox(&qq, eye(rank), tol) {
+			t.Errorf("%s: range basis is not orthonormal", name)
+		}
+		// The projection onto the range leaves A unchanged.
+		qta.Mul(q.T(), a)
+		qqa.Mul(&q, &qta)
+		if !EqualApprox(&qqa, a, tol) {
+			t.Errorf("%s: Q * Qᵀ * A != A", name)
+		}
+	}
+}
+
+func TestPrincipalAngles(t *testing.T) {
+	t.Parallel()
+	const (
+		tol   = 1e-13
+		rcond = 1e-12
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m      int
+		angles []float64
+		extra  int // Number of additional orthogonal directions in B.
+	}{
+		{m: 2, angles: []float64{0.3}},
+		{m: 4, angles: []float64{0, math.Pi / 2}},
+		{m: 6, angles: []float64{1e-10, 0.5, 1.2}},
+		{m: 10, angles: []float64{1e-14, 1e-7, 0.7}, extra: 2},
+		{m: 10, angles: []float64{math.Pi/2 - 1e-9, math.Pi / 2}, extra: 1},
+	} {
+		m := test.m
+		k := len(test.angles)
+		name := fmt.Sprintf("m=%d,angles=%v,extra=%d", m, test.angles, test.extra)
+
+		// Construct the bases of the subspaces in the standard basis and
+		// rotate them by a random orthogonal matrix.
+		var qr QR
+		qr.Factorize(randRankDense(m, m, m, rnd))
+		var rot Dense
+		qr.QTo(&rot)
+		x := NewDense(m, k, nil)
+		y := NewDense(m, k+test.extra, nil)
+		for i, theta := range test.angles {
+			x.Set(i, i, 1)
+			y.Set(i, i, math.Cos(theta))
+			y.Set(k+i, i, math.Sin(theta))
+		}
+		for i := 0; i < test.extra; i++ {
+			y.Set(2*k+i, k+i, 1)
+		}
+		var a, b Dense
+		a.Mul(&rot, x)
+		b.Mul(&rot, y)
+		// Mix the columns of B so that they are not orthonormal.
+		b.Mul(&b, randRankDense(k+test.extra, k+test.extra, k+test.extra, rnd))
+
+		for _, swap := range []bool{false, true} {
+			aa, bb := Matrix(&a), Matrix(&b)
+			if swap {
+				aa, bb = bb, aa
+			}
+			got, err := PrincipalAngles(nil, aa, bb, rcond)
+			if err != nil {
+				t.Errorf("%s,swap=%t: unexpected error: %v", name, swap, err)
+				continue
+			}
+			if !floats.EqualApprox(got, test.angles, tol) {
+				t.Errorf("%s,swap=%t: unexpected angles; got %v, want %v", name, swap, got, test.angles)
+			}
+		}
+	}
+
+	// The angles between a subspace and itself are zero.
+	a := randRankDense(8, 5, 3, rnd)
+	got, err := PrincipalAngles(make([]float64, 10), a, a, rcond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(got, make([]float64, 3), tol) {
+		t.Errorf("unexpected angles between a subspace and itself: %v", got)
+	}
+}
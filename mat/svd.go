@@ -10,7 +10,10 @@ import (
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
-const badRcond = "mat: invalid rcond value"
+const (
+	badRcond        = "mat: invalid rcond value"
+	badSVDAlgorithm = "mat: invalid SVD algorithm"
+)
 
 // SVD is a type for creating and using the Singular Value Decomposition
 // of a matrix.
@@ -46,6 +49,25 @@ const (
 	SVDFull SVDKind = SVDFullU | SVDFullV
 )
 
+// SVDAlgorithm specifies the algorithm used by SVD to compute the singular
+// value decomposition.
+type SVDAlgorithm int
+
+const (
+	// SVDQR specifies the implicit zero-shift QR iteration on the bidiagonal
+	// form of the matrix.
+	SVDQR SVDAlgorithm = iota
+	// SVDDivideConquer specifies the divide and conquer algorithm. It is
+	// considerably faster than SVDQR for large matrices when the singular
+	// vectors are computed, at the cost of more workspace.
+	SVDDivideConquer
+	// SVDJacobi specifies the one-sided Jacobi method. It is slower than the
+	// other algorithms, but it computes the singular values to high relative
+	// accuracy when the matrix is a well-conditioned matrix with badly scaled
+	// columns.
+	SVDJacobi
+)
+
 // succFact returns whether the receiver contains a successful factorization.
 func (svd *SVD) succFact() bool {
 	return len(svd.s) != 0
@@ -77,6 +99,22 @@ func (svd *SVD) succFact() bool {
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
+	return svd.FactorizeAlgorithm(a, kind, SVDQR)
+}
+
+// FactorizeAlgorithm computes the singular value decomposition of the input
+// matrix A using the specified algorithm. See Factorize for more information.
+//
+// FactorizeAlgorithm returns whether the decomposition succeeded. If the
+// decomposition failed, routines that require a successful factorization will
+// panic.
+func (svd *SVD) FactorizeAlgorithm(a Matrix, kind SVDKind, alg SVDAlgorithm) (ok bool) {
+	switch alg {
+	case SVDQR, SVDDivideConquer, SVDJacobi:
+	default:
+		panic(badSVDAlgorithm)
+	}
+
 	// kill previous factorization
 	svd.s = svd.s[:0]
 	svd.kind = kind
@@ -133,15 +171,202 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	svd.kind = kind
 	svd.s = use(svd.s, min(m, n))
 
+	switch alg {
+	case SVDQR:
+		work := []float64{0}
+		lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
+		work = getFloat64s(int(work[0]), false)
+		ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
+		putFloat64s(work)
+	case SVDDivideConquer:
+		ok = svd.factorizeDivideConquer(aCopy, jobU, jobVT)
+	case SVDJacobi:
+		ok = svd.factorizeJacobi(aCopy, jobU != lapack.SVDNone, jobVT != lapack.SVDNone)
+	}
+	if !ok {
+		svd.kind = 0
+	}
+	return ok
+}
+
+// factorizeDivideConquer computes the singular value decomposition of a into
+// the receiver using Gesdd. Gesdd computes U and Vᵀ in the same form, so the
+// singular vectors are computed into temporary storage if jobU and jobVT
+// differ and then copied into place.
+func (svd *SVD) factorizeDivideConquer(a *Dense, jobU, jobVT lapack.SVDJob) (ok bool) {
+	m, n := a.Dims()
+	var jobz lapack.SVDJob
+	switch {
+	case jobU == jobVT:
+		jobz = jobU
+	case jobU == lapack.SVDAll, jobVT == lapack.SVDAll:
+		jobz = lapack.SVDAll
+	default:
+		jobz = lapack.SVDStore
+	}
+
+	u := svd.u
+	vt := svd.vt
+	if jobz != lapack.SVDNone {
+		ucol := min(m, n)
+		vrow := min(m, n)
+		if jobz == lapack.SVDAll {
+			ucol = m
+			vrow = n
+		}
+		if jobU != jobz {
+			u = blas64.General{
+				Rows:   m,
+				Cols:   ucol,
+				Stride: ucol,
+				Data:   getFloat64s(m*ucol, false),
+			}
+			defer putFloat64s(u.Data)
+		}
+		if jobVT != jobz {
+			vt = blas64.General{
+				Rows:   vrow,
+				Cols:   n,
+				Stride: n,
+				Data:   getFloat64s(vrow*n, false),
+			}
+			defer putFloat64s(vt.Data)
+		}
+	}
+
 	work := []float64{0}
-	lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
+	iwork := getInts(8*min(m, n), false)
+	lapack64.Gesdd(jobz, a.mat, u, vt, svd.s, work, -1, iwork)
 	work = getFloat64s(int(work[0]), false)
-	ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
+	ok = lapack64.Gesdd(jobz, a.mat, u, vt, svd.s, work, len(work), iwork)
 	putFloat64s(work)
+	putInts(iwork)
 	if !ok {
-		svd.kind = 0
+		return false
+	}
+
+	// Copy the leading columns of U and rows of Vᵀ if they were computed
+	// into temporary storage.
+	if jobU != lapack.SVDNone && jobU != jobz {
+		dst := &Dense{mat: svd.u, capRows: svd.u.Rows, capCols: svd.u.Cols}
+		dst.Copy(&Dense{mat: u, capRows: u.Rows, capCols: u.Cols})
+	}
+	if jobVT != lapack.SVDNone && jobVT != jobz {
+		dst := &Dense{mat: svd.vt, capRows: svd.vt.Rows, capCols: svd.vt.Cols}
+		dst.Copy(&Dense{mat: vt, capRows: vt.Rows, capCols: vt.Cols})
+	}
+	return true
+}
+
+// factorizeJacobi computes the singular value decomposition of a into the
+// receiver using Gesvj. Gesvj requires that A has at least as many rows as
+// columns, so if m < n the decomposition Aᵀ = V * Σ * Uᵀ is computed instead.
+func (svd *SVD) factorizeJacobi(a *Dense, wantU, wantV bool) (ok bool) {
+	m, n := a.Dims()
+	b := a
+	wantL, wantR := wantU, wantV
+	if m < n {
+		b = getDenseWorkspace(n, m, false)
+		defer putDenseWorkspace(b)
+		b.Copy(a.T())
+		wantL, wantR = wantV, wantU
+	}
+	_, q := b.Dims()
+
+	jobL := lapack.LeftSVNone
+	if wantL {
+		jobL = lapack.LeftSVCompute
+	}
+	jobR := lapack.RightSVNone
+	var v blas64.General
+	if wantR {
+		jobR = lapack.RightSVCompute
+		v = blas64.General{
+			Rows:   q,
+			Cols:   q,
+			Stride: q,
+			Data:   getFloat64s(q*q, false),
+		}
+		defer putFloat64s(v.Data)
+	}
+	work := []float64{0}
+	lapack64.Gesvj(lapack.General, jobL, jobR, b.mat, svd.s, v, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Gesvj(lapack.General, jobL, jobR, b.mat, svd.s, v, work, len(work))
+	scale := work[0]
+	// The columns of the left singular vectors of B that correspond to zero
+	// singular values are not computed.
+	rank := int(work[1])
+	putFloat64s(work)
+	if !ok {
+		return false
+	}
+	if scale != 1 {
+		for i := range svd.s {
+			svd.s[i] *= scale
+		}
+	}
+
+	right := &Dense{mat: v, capRows: q, capCols: q}
+	if m >= n {
+		if wantU {
+			u := &Dense{mat: svd.u, capRows: svd.u.Rows, capCols: svd.u.Cols}
+			completeBasis(u, b.mat, rank)
+		}
+		if wantV {
+			vt := &Dense{mat: svd.vt, capRows: svd.vt.Rows, capCols: svd.vt.Cols}
+			vt.Copy(right.T())
+		}
+		return true
+	}
+	if wantU {
+		u := &Dense{mat: svd.u, capRows: svd.u.Rows, capCols: svd.u.Cols}
+		u.Copy(right)
+	}
+	if wantV {
+		vt := &Dense{mat: svd.vt, capRows: svd.vt.Rows, capCols: svd.vt.Cols}
+		l := getDenseWorkspace(n, svd.vt.Rows, false)
+		completeBasis(l, b.mat, rank)
+		vt.Copy(l.T())
+		putDenseWorkspace(l)
+	}
+	return true
+}
+
+// completeBasis stores into the p×c matrix dst the first rank columns of the
+// p×q matrix src followed by c-rank orthonormal columns that are orthogonal to
+// them. The first rank columns of src must be orthonormal and c must satisfy
+// rank <= c <= p.
+func completeBasis(dst *Dense, src blas64.General, rank int) {
+	_, c := dst.Dims()
+	if rank < c {
+		// Form the orthogonal factor of the QR factorization of the leading
+		// columns. Its trailing columns complete the basis.
+		dst.Zero()
+		copyColumns(dst.mat, src, rank)
+		qr := dst.mat
+		qr.Cols = rank
+		tau := getFloat64s(rank, false)
+		work := []float64{0}
+		lapack64.Geqrf(qr, tau, work, -1)
+		lwork := int(work[0])
+		lapack64.Orgqr(dst.mat, tau, work, -1)
+		work = getFloat64s(max(lwork, int(work[0])), false)
+		lapack64.Geqrf(qr, tau, work, len(work))
+		lapack64.Orgqr(dst.mat, tau, work, len(work))
+		putFloat64s(work)
+		putFloat64s(tau)
+	}
+	// The leading columns of the orthogonal factor equal those of src up to
+	// sign, so the original columns are copied into place.
+	copyColumns(dst.mat, src, rank)
+}
+
+// copyColumns copies the first k columns of src into dst.
+func copyColumns(dst, src blas64.General, k int) {
+	for i := 0; i < dst.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+k], src.Data[i*src.Stride:i*src.Stride+k])
 	}
-	return ok
 }
 
 // Kind returns the SVDKind of the decomposition. If no decomposition has been
@@ -154,7 +379,8 @@ func (svd *SVD) Kind() SVDKind {
 }
 
 // Rank returns the rank of A based on the count of singular values greater than
-// rcond scaled by the largest singular value.
+// rcond scaled by the largest singular value. A typical choice of rcond is
+// max(m,n)*ε where ε is the machine epsilon.
 // Rank will panic if the receiver does not contain a successful factorization or
 // rcond is negative.
 func (svd *SVD) Rank(rcond float64) int {
@@ -276,6 +502,149 @@ func (svd *SVD) VTo(dst *Dense) {
 	dst.Copy(tmp.T())
 }
 
+// PseudoInverseTo computes the Moore–Penrose pseudo-inverse of the factorized
+// m×n matrix A of the given effective rank
+//
+//	A⁺ = V_r * Σ_r⁻¹ * U_rᵀ
+//
+// where U_r and V_r hold the first rank left and right singular vectors, and
+// Σ_r holds the corresponding singular values, and stores the result into dst.
+// The rank can be computed using SVD.Rank. If rank is zero, A⁺ is the zero
+// matrix.
+//
+// If dst is empty, PseudoInverseTo will resize dst to be n×m. When dst is
+// non-empty, PseudoInverseTo will panic if dst is not n×m. PseudoInverseTo will
+// also panic if the receiver does not contain a successful factorization, if
+// U or V were not computed during factorization or if rank is out of range.
+func (svd *SVD) PseudoInverseTo(dst *Dense, rank int) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if rank < 0 || len(svd.s) < rank {
+		panic("svd: rank out of range")
+	}
+	kind := svd.kind
+	if kind&SVDThinU == 0 && kind&SVDFullU == 0 {
+		panic("svd: u not computed during factorization")
+	}
+	if kind&SVDThinV == 0 && kind&SVDFullV == 0 {
+		panic("svd: v not computed during factorization")
+	}
+	m := svd.u.Rows
+	n := svd.vt.Cols
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, m)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != m {
+			panic(ErrShape)
+		}
+	}
+	if rank == 0 {
+		dst.Zero()
+		return
+	}
+
+	u := Dense{
+		mat:     svd.u,
+		capRows: svd.u.Rows,
+		capCols: svd.u.Cols,
+	}
+	// Scale the first rank right singular vectors by the reciprocals of the
+	// singular values.
+	vs := getDenseWorkspace(rank, n, false)
+	defer putDenseWorkspace(vs)
+	for i := 0; i < rank; i++ {
+		s := 1 / svd.s[i]
+		for j, v := range svd.vt.Data[i*svd.vt.Stride : i*svd.vt.Stride+n] {
+			vs.set(i, j, s*v)
+		}
+	}
+	dst.Mul(vs.T(), u.slice(0, m, 0, rank).T())
+}
+
+// NullSpaceTo stores an orthonormal basis for the null space of the factorized
+// m×n matrix A of the given effective rank into the columns of dst. The basis
+// is formed by the right singular vectors that correspond to the trailing
+// n-rank singular values, where the singular values beyond min(m,n) are zero.
+// The rank can be computed using SVD.Rank.
+//
+// If dst is empty, NullSpaceTo will resize dst to be n×(n-rank). When dst is
+// non-empty, NullSpaceTo will panic if dst is not n×(n-rank). NullSpaceTo will
+// also panic if the receiver does not contain a successful factorization, if
+// rank is out of range or equal to n, or if the full V was not computed during
+// factorization and m < n.
+func (svd *SVD) NullSpaceTo(dst *Dense, rank int) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if rank < 0 || len(svd.s) < rank {
+		panic("svd: rank out of range")
+	}
+	kind := svd.kind
+	if kind&SVDThinV == 0 && kind&SVDFullV == 0 {
+		panic("svd: v not computed during factorization")
+	}
+	n := svd.vt.Cols
+	if svd.vt.Rows != n {
+		panic("svd: full v not computed during factorization")
+	}
+	if rank == n {
+		panic(ErrZeroLength)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n-rank)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n-rank {
+			panic(ErrShape)
+		}
+	}
+	vt := Dense{
+		mat:     svd.vt,
+		capRows: svd.vt.Rows,
+		capCols: svd.vt.Cols,
+	}
+	dst.Copy(vt.slice(rank, n, 0, n).T())
+}
+
+// RangeTo stores an orthonormal basis for the range (column space) of the
+// factorized m×n matrix A of the given effective rank into the columns of
+// dst. The basis is formed by the left singular vectors that correspond to the
+// first rank singular values. The rank can be computed using SVD.Rank.
+//
+// If dst is empty, RangeTo will resize dst to be m×rank. When dst is
+// non-empty, RangeTo will panic if dst is not m×rank. RangeTo will also panic
+// if the receiver does not contain a successful factorization, if rank is out
+// of range or zero, or if U was not computed during factorization.
+func (svd *SVD) RangeTo(dst *Dense, rank int) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if rank < 1 || len(svd.s) < rank {
+		panic("svd: rank out of range")
+	}
+	kind := svd.kind
+	if kind&SVDThinU == 0 && kind&SVDFullU == 0 {
+		panic("svd: u not computed during factorization")
+	}
+	m := svd.u.Rows
+	if dst.IsEmpty() {
+		dst.ReuseAs(m, rank)
+	} else {
+		r, c := dst.Dims()
+		if r != m || c != rank {
+			panic(ErrShape)
+		}
+	}
+	u := Dense{
+		mat:     svd.u,
+		capRows: svd.u.Rows,
+		capCols: svd.u.Cols,
+	}
+	dst.Copy(u.slice(0, m, 0, rank))
+}
+
 // SolveTo calculates the minimum-norm solution to a linear least squares problem
 //
 //	minimize over n-element vectors x: |b - A*x|_2 and |x|_2
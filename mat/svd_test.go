@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"math"
 	"math/rand/v2"
 	"testing"
 
@@ -204,6 +205,161 @@ func TestSVD(t *testing.T) {
 	}
 }
 
+func TestSVDAlgorithm(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, alg := range []SVDAlgorithm{SVDQR, SVDDivideConquer, SVDJacobi} {
+		for _, test := range []struct {
+			m, n, rank int
+		}{
+			{1, 1, 1},
+			{5, 5, 5},
+			{5, 3, 3},
+			{3, 5, 3},
+			{5, 3, 0},
+			{3, 5, 0},
+			{10, 6, 4},
+			{6, 10, 4},
+			{60, 60, 60},
+			{80, 50, 50},
+			{50, 80, 50},
+			{80, 50, 30},
+			{50, 80, 30},
+		} {
+			m, n := test.m, test.n
+			// Construct a random m×n matrix of the given rank.
+			a := NewDense(m, n, nil)
+			if test.rank > 0 {
+				l := NewDense(m, test.rank, nil)
+				r := NewDense(test.rank, n, nil)
+				for i := range l.mat.Data {
+					l.mat.Data[i] = rnd.NormFloat64()
+				}
+				for i := range r.mat.Data {
+					r.mat.Data[i] = rnd.NormFloat64()
+				}
+				a.Mul(l, r)
+			}
+			aCopy := DenseCopyOf(a)
+
+			var want SVD
+			ok := want.Factorize(a, SVDNone)
+			if !ok {
+				t.Fatalf("unexpected SVD failure for m=%d,n=%d", m, n)
+			}
+			sWant := want.Values(nil)
+
+			for _, kindU := range []SVDKind{SVDNone, SVDThinU, SVDFullU} {
+				for _, kindV := range []SVDKind{SVDNone, SVDThinV, SVDFullV} {
+					kind := kindU | kindV
+					var svd SVD
+					ok := svd.FactorizeAlgorithm(a, kind, alg)
+					if !ok {
+						t.Errorf("alg=%d,m=%d,n=%d,kind=%d: unexpected failure", alg, m, n, kind)
+						continue
+					}
+					if !Equal(a, aCopy) {
+						t.Errorf("alg=%d,m=%d,n=%d,kind=%d: A changed during call to FactorizeAlgorithm", alg, m, n, kind)
+					}
+					if svd.Kind() != kind {
+						t.Errorf("alg=%d,m=%d,n=%d,kind=%d: unexpected kind %d", alg, m, n, kind, svd.Kind())
+					}
+					s := svd.Values(nil)
+					if !floats.EqualApprox(s, sWant, tol*sWant[0]+tol) {
+						t.Errorf("alg=%d,m=%d,n=%d,kind=%d: singular value mismatch\ngot: %v\nwant:%v", alg, m, n, kind, s, sWant)
+					}
+
+					var u, v Dense
+					if kindU != SVDNone {
+						svd.UTo(&u)
+						ucol := min(m, n)
+						if kindU == SVDFullU {
+							ucol = m
+						}
+						if r, c := u.Dims(); r != m || c != ucol {
+							t.Errorf("alg=%d,m=%d,n=%d,kind=%d: unexpected U shape %d×%d", alg, m, n, kind, r, c)
+							continue
+						}
+						var utu Dense
+						utu.Mul(u.T(), &u)
+						if !EqualApprox(&utu, eye(ucol), tol) {
+							t.Errorf("alg=%d,m=%d,n=%d,kind=%d: columns of U not orthonormal", alg, m, n, kind)
+						}
+					}
+					if kindV != SVDNone {
+						svd.VTo(&v)
+						vcol := min(m, n)
+						if kindV == SVDFullV {
+							vcol = n
+						}
+						if r, c := v.Dims(); r != n || c != vcol {
+							t.Errorf("alg=%d,m=%d,n=%d,kind=%d: unexpected V shape %d×%d", alg, m, n, kind, r, c)
+							continue
+						}
+						var vtv Dense
+						vtv.Mul(v.T(), &v)
+						if !EqualApprox(&vtv, eye(vcol), tol) {
+							t.Errorf("alg=%d,m=%d,n=%d,kind=%d: columns of V not orthonormal", alg, m, n, kind)
+						}
+					}
+					if kindU == SVDNone || kindV == SVDNone {
+						continue
+					}
+
+					// Check that U * Σ * Vᵀ multiply back to A.
+					k := min(m, n)
+					sigma := NewDense(k, k, nil)
+					for i := 0; i < k; i++ {
+						sigma.Set(i, i, s[i])
+					}
+					var got Dense
+					got.Product(u.Slice(0, m, 0, k), sigma, v.Slice(0, n, 0, k).T())
+					if !EqualApprox(&got, a, tol*float64(max(m, n))*(sWant[0]+1)) {
+						t.Errorf("alg=%d,m=%d,n=%d,kind=%d: A not recovered from U*Σ*Vᵀ", alg, m, n, kind)
+					}
+				}
+			}
+		}
+	}
+
+	// The one-sided Jacobi method computes the singular values of a matrix
+	// with badly scaled columns to high relative accuracy.
+	const n = 10
+	var q QR
+	g := NewDense(n, n, nil)
+	for i := range g.mat.Data {
+		g.mat.Data[i] = rnd.NormFloat64()
+	}
+	q.Factorize(g)
+	var b Dense
+	q.QTo(&b)
+	for j := 0; j < n; j++ {
+		col := b.ColView(j).(*VecDense)
+		col.ScaleVec(math.Pow(10, -4*float64(j)), col)
+	}
+	var svd SVD
+	if !svd.FactorizeAlgorithm(&b, SVDNone, SVDJacobi) {
+		t.Fatal("unexpected SVD failure for graded matrix")
+	}
+	for i, v := range svd.Values(nil) {
+		// The columns of b are orthogonal, so the singular values are the
+		// column scales.
+		want := math.Pow(10, -4*float64(i))
+		if math.Abs(v-want) > tol*want {
+			t.Errorf("singular value %d of graded matrix not accurate: got %v, want %v", i, v, want)
+		}
+	}
+
+	panicked, message := panics(func() {
+		var svd SVD
+		svd.FactorizeAlgorithm(NewDense(2, 2, nil), SVDNone, -1)
+	})
+	if !panicked || message != badSVDAlgorithm {
+		t.Errorf("expected panic %q for invalid algorithm, got %q", badSVDAlgorithm, message)
+	}
+}
+
 func extractSVD(svd *SVD) (s []float64, u, v *Dense) {
 	u = &Dense{}
 	svd.UTo(u)
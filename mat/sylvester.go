@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// residualTolerance is the largest relative residual of the computed solution
+// of a matrix equation that is accepted without returning a Condition error.
+var residualTolerance = math.Sqrt(dlamchE)
+
+// SolveSylvester solves the Sylvester equation
+//
+//	A * X + X * B = C
+//
+// for X, placing the result in the receiver, where A is an m×m matrix, B is an
+// n×n matrix and C is an m×n matrix. The equation has a unique solution if and
+// only if A and -B have no common eigenvalues.
+//
+// The implementation uses the Bartels–Stewart algorithm, which reduces A and B
+// to real Schur form and solves the resulting quasi-triangular equation by
+// substitution.
+//
+// If A and -B have common or very close eigenvalues, the equation is solved
+// with slightly perturbed values and a Condition error with value +Inf is
+// returned. A Condition error is also returned if the equation is
+// ill-conditioned or if the relative residual of the computed solution
+//
+//	|A*X + X*B - C|_F / (|A*X|_F + |X*B|_F + |C|_F)
+//
+// is large. In the latter cases the value of the Condition is the larger of an
+// estimate of the condition number of the equation and the relative residual
+// in units of the machine epsilon. The receiver holds the computed solution
+// whenever a Condition error is returned. SolveSylvester returns
+// ErrFailedEigen and does not modify the receiver if the Schur decomposition
+// of A or B fails.
+//
+// SolveSylvester will panic if A or B is not square or if C is not m×n.
+func (m *Dense) SolveSylvester(a, b, c Matrix) error {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != ac || br != bc {
+		panic(ErrSquare)
+	}
+	if cr, cc := c.Dims(); cr != ar || cc != br {
+		panic(ErrShape)
+	}
+
+	var sa, sb Schur
+	if !sa.Factorize(a) || !sb.Factorize(b) {
+		return ErrFailedEigen
+	}
+
+	// Transform the right-hand side to F = Uᵀ * C * V where A = U*S*Uᵀ and
+	// B = V*T*Vᵀ, solve S*Y + Y*T = scale*F and transform the solution back
+	// to X = U * Y * Vᵀ / scale.
+	x := NewDense(ar, br, nil)
+	x.Mul(sa.z.T(), c)
+	x.Mul(x, sb.z)
+	scale, ok := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, 1, sa.t.mat, sb.t.mat, x.mat)
+	x.Mul(sa.z, x)
+	x.Mul(x, sb.z.T())
+	if scale != 1 {
+		x.Scale(1/scale, x)
+	}
+
+	// Compute the residual R = A*X + X*B - C.
+	ax := NewDense(ar, br, nil)
+	ax.Mul(a, x)
+	xb := NewDense(ar, br, nil)
+	xb.Mul(x, b)
+	res := NewDense(ar, br, nil)
+	res.Add(ax, xb)
+	res.Sub(res, c)
+
+	// Estimate the condition number (|A| + |B|) / sep(A, -B) of the
+	// equation from below using |X| <= |C| / sep(A, -B).
+	xnorm := x.Norm(2)
+	cnorm := Norm(c, 2)
+	cond := 0.0
+	if xnorm != 0 {
+		cond = (Norm(a, 2) + Norm(b, 2)) * xnorm / cnorm
+	}
+	if !ok {
+		cond = math.Inf(1)
+	}
+	rel := relResidual(res.Norm(2), ax.Norm(2), xb.Norm(2), cnorm)
+
+	m.reuseAsNonZeroed(ar, br)
+	m.Copy(x)
+	return checkSolution(cond, rel)
+}
+
+// SolveLyapunov solves the continuous-time Lyapunov equation
+//
+//	A * X + X * Aᵀ + Q = 0
+//
+// for the symmetric matrix X, placing the result in the receiver, where A and
+// Q are n×n matrices. The equation has a unique solution if and only if no two
+// eigenvalues of A sum to zero. If all eigenvalues of A have negative real
+// part and Q is positive semi-definite, X is positive semi-definite.
+//
+// The implementation uses the Bartels–Stewart algorithm with a single real
+// Schur decomposition of A.
+//
+// SolveLyapunov reports failures in the same way as SolveSylvester, with the
+// relative residual
+//
+//	|A*X + X*Aᵀ + Q|_F / (2*|A*X|_F + |Q|_F).
+//
+// SolveLyapunov will panic if A is not square or if Q is not n×n.
+func (s *SymDense) SolveLyapunov(a Matrix, q Symmetric) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	if q.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+
+	var sa Schur
+	if !sa.Factorize(a) {
+		return ErrFailedEigen
+	}
+
+	// Solve S*Y + Y*Sᵀ = -scale*Uᵀ*Q*U where A = U*S*Uᵀ, and transform the
+	// solution back to X = U * Y * Uᵀ / scale.
+	y := NewDense(n, n, nil)
+	y.Mul(sa.z.T(), q)
+	y.Mul(y, sa.z)
+	y.Scale(-1, y)
+	scale, ok := lapack64.Trsyl(blas.NoTrans, blas.Trans, 1, sa.t.mat, sa.t.mat, y.mat)
+	y.Mul(sa.z, y)
+	y.Mul(y, sa.z.T())
+	x := NewSymDense(n, nil)
+	symmetrize(x, y, 1/scale)
+
+	// Compute the residual R = A*X + X*Aᵀ + Q.
+	ax := NewDense(n, n, nil)
+	ax.Mul(a, x)
+	res := NewDense(n, n, nil)
+	res.Add(ax, ax.T())
+	res.Add(res, q)
+
+	xnorm := x.Norm(2)
+	qn := Norm(q, 2)
+	cond := 0.0
+	if xnorm != 0 {
+		cond = 2 * Norm(a, 2) * xnorm / qn
+	}
+	if !ok {
+		cond = math.Inf(1)
+	}
+	axnorm := ax.Norm(2)
+	rel := relResidual(res.Norm(2), axnorm, axnorm, qn)
+
+	s.reuseAsNonZeroed(n)
+	s.CopySym(x)
+	return checkSolution(cond, rel)
+}
+
+// symmetrize stores alpha*(A + Aᵀ)/2 into the upper triangle of dst.
+func symmetrize(dst *SymDense, a *Dense, alpha float64) {
+	n := dst.mat.N
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			dst.set(i, j, alpha*(a.at(i, j)+a.at(j, i))/2)
+		}
+	}
+}
+
+// relResidual returns the norm of the residual of a matrix equation relative
+// to the sum of the norms of the terms of the equation.
+func relResidual(res float64, terms ...float64) float64 {
+	var sum float64
+	for _, v := range terms {
+		sum += v
+	}
+	if sum == 0 {
+		return res
+	}
+	return res / sum
+}
+
+// checkSolution returns a Condition error if the estimated condition number
+// cond of a matrix equation exceeds ConditionTolerance or if the relative
+// residual of its computed solution exceeds residualTolerance.
+func checkSolution(cond, residual float64) error {
+	if cond > ConditionTolerance || residual > residualTolerance {
+		return Condition(math.Max(cond, residual/dlamchE))
+	}
+	return nil
+}
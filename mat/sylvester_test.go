@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// randNormDense returns an r×c matrix with standard normal entries.
+func randNormDense(r, c int, rnd *rand.Rand) *Dense {
+	a := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			a.set(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// randStable returns a random n×n matrix whose eigenvalues all have negative
+// real part.
+func randStable(n int, rnd *rand.Rand) *Dense {
+	a := randNormDense(n, n, rnd)
+	shift := Norm(a, 2) + 1
+	for i := 0; i < n; i++ {
+		a.set(i, i, a.at(i, i)-shift)
+	}
+	return a
+}
+
+func TestSolveSylvester(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{1, 2, 3, 5, 10} {
+		for _, n := range []int{1, 2, 4, 7} {
+			a := randNormDense(m, m, rnd)
+			b := randNormDense(n, n, rnd)
+			// Separate the spectra of A and -B.
+			for i := 0; i < n; i++ {
+				b.set(i, i, b.at(i, i)+2*math.Sqrt(float64(m+n)))
+			}
+			c := randNormDense(m, n, rnd)
+
+			var x Dense
+			err := x.SolveSylvester(a, b, c)
+			if err != nil {
+				t.Errorf("m=%d,n=%d: unexpected error: %v", m, n, err)
+				continue
+			}
+			var res Dense
+			res.Mul(a, &x)
+			var xb Dense
+			xb.Mul(&x, b)
+			res.Add(&res, &xb)
+			if !EqualApprox(&res, c, tol*Norm(&x, 2)) {
+				t.Errorf("m=%d,n=%d: unexpected residual A*X+X*B-C", m, n)
+			}
+		}
+	}
+
+	// A and -B have a common eigenvalue.
+	a := NewDense(2, 2, []float64{1, 2, 0, 3})
+	b := NewDense(2, 2, []float64{-1, 0, 5, 4})
+	var x Dense
+	err := x.SolveSylvester(a, b, NewDense(2, 2, []float64{1, 2, 3, 4}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular equation, got %v", err)
+	}
+
+	if panicked, _ := panics(func() { x.SolveSylvester(a, b, NewDense(2, 3, nil)) }); !panicked {
+		t.Error("expected panic for shape mismatch")
+	}
+}
+
+func TestSolveLyapunov(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 20} {
+		a := randStable(n, rnd)
+		c := randNormDense(n, n, rnd)
+		q := NewSymDense(n, nil)
+		q.SymOuterK(1, c)
+
+		var x SymDense
+		err := x.SolveLyapunov(a, q)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		var ax Dense
+		ax.Mul(a, &x)
+		var res Dense
+		res.Add(&ax, ax.T())
+		res.Add(&res, q)
+		if !EqualApprox(&res, NewDense(n, n, nil), tol*Norm(&x, 2)*Norm(a, 2)) {
+			t.Errorf("n=%d: unexpected residual A*X+X*Aᵀ+Q", n)
+		}
+
+		// X is positive semi-definite for stable A and positive
+		// semi-definite Q.
+		var eig EigenSym
+		eig.Factorize(&x, false)
+		for _, v := range eig.Values(nil) {
+			if v < -tol*Norm(&x, 2) {
+				t.Errorf("n=%d: solution not positive semi-definite", n)
+				break
+			}
+		}
+	}
+
+	// The eigenvalues 1 and -1 of A sum to zero.
+	a := NewDense(2, 2, []float64{1, 3, 0, -1})
+	var x SymDense
+	err := x.SolveLyapunov(a, NewSymDense(2, []float64{1, 0, 0, 1}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular equation, got %v", err)
+	}
+}
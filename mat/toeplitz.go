@@ -0,0 +1,394 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+	"gonum.org/v1/gonum/floats"
+)
+
+const badToeplitzDiag = "mat: mismatched Toeplitz diagonal"
+
+var (
+	toeplitz *Toeplitz
+	_        Matrix = toeplitz
+
+	symToeplitz *SymToeplitz
+	_           Matrix    = symToeplitz
+	_           Symmetric = symToeplitz
+)
+
+// Toeplitz represents an n×n Toeplitz matrix, a matrix whose elements are
+// constant along each diagonal,
+//
+//	    ⎡c[0]   r[1]   ⋯ r[n-1]⎤
+//	T = ⎢c[1]   c[0]   ⋯ r[n-2]⎥
+//	    ⎢ ⋮      ⋮     ⋱  ⋮    ⎥
+//	    ⎣c[n-1] c[n-2] ⋯ c[0]  ⎦
+//
+// where c is the first column and r is the first row of the matrix. Only the
+// 2n-1 defining elements are stored. Products of a large Toeplitz matrix with
+// a vector are computed in O(n log n) time by embedding the matrix in a
+// circulant matrix of order at least 2n-1 and using the fast Fourier
+// transform, and linear systems are solved in O(n²) time by the Levinson
+// recursion.
+type Toeplitz struct {
+	col, row []float64
+
+	// spec holds the eigenvalues of the embedding circulant matrix. It is
+	// nil for small matrices.
+	spec []complex128
+}
+
+// NewToeplitz creates a new n×n Toeplitz matrix with the first column c and
+// the first row r, where n is the common length of c and r. The elements of c
+// and r are copied, so changes to c and r after the call are not reflected in
+// the returned matrix. NewToeplitz will panic if c and r have different or
+// zero lengths, or if c[0] != r[0].
+func NewToeplitz(c, r []float64) *Toeplitz {
+	n := len(c)
+	if n == 0 {
+		panic(ErrZeroLength)
+	}
+	if len(r) != n {
+		panic(ErrShape)
+	}
+	if c[0] != r[0] {
+		panic(badToeplitzDiag)
+	}
+	c = append([]float64(nil), c...)
+	r = append([]float64(nil), r...)
+	return &Toeplitz{
+		col:  c,
+		row:  r,
+		spec: toeplitzSpectrum(c, r),
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (t *Toeplitz) Dims() (r, c int) {
+	n := len(t.col)
+	return n, n
+}
+
+// At returns the element at row i, column j.
+func (t *Toeplitz) At(i, j int) float64 {
+	n := len(t.col)
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	if i >= j {
+		return t.col[i-j]
+	}
+	return t.row[j-i]
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (t *Toeplitz) T() Matrix {
+	return Transpose{t}
+}
+
+// MulVecTo computes T⋅x or Tᵀ⋅x storing the result into dst.
+func (t *Toeplitz) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	toeplitzMulVecTo(dst, t.col, t.row, t.spec, trans, x)
+}
+
+// SolveVecTo solves the linear system T⋅x = b or Tᵀ⋅x = b using the Levinson
+// recursion and stores the result in dst.
+//
+// The Levinson recursion requires that all leading principal submatrices of
+// the matrix are nonsingular, which holds for example for diagonally dominant
+// matrices. If the recursion breaks down, a Condition error with value +Inf is
+// returned and the contents of dst are undefined. The condition number of the
+// matrix is not estimated.
+func (t *Toeplitz) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	col, row := t.col, t.row
+	if trans {
+		col, row = row, col
+	}
+	return levinsonVecTo(dst, col, row, b)
+}
+
+// SymToeplitz represents an n×n symmetric Toeplitz matrix, a matrix whose
+// elements are constant along each diagonal,
+//
+//	    ⎡r[0]   r[1]   ⋯ r[n-1]⎤
+//	T = ⎢r[1]   r[0]   ⋯ r[n-2]⎥
+//	    ⎢ ⋮      ⋮     ⋱  ⋮    ⎥
+//	    ⎣r[n-1] r[n-2] ⋯ r[0]  ⎦
+//
+// where r is the first row of the matrix. Symmetric Toeplitz matrices arise as
+// the autocovariance matrices of stationary time series. Only the n defining
+// elements are stored. Products of a large symmetric Toeplitz matrix with a
+// vector are computed in O(n log n) time using the fast Fourier transform.
+// Linear systems are solved and the inverse is computed in O(n²) time by the
+// Levinson–Durbin recursion and the Trench algorithm.
+type SymToeplitz struct {
+	r []float64
+
+	// spec holds the eigenvalues of the embedding circulant matrix. It is
+	// nil for small matrices.
+	spec []complex128
+}
+
+// NewSymToeplitz creates a new n×n symmetric Toeplitz matrix with the first row
+// r, where n is the length of r. The elements of r are copied, so changes to r
+// after the call are not reflected in the returned matrix. NewSymToeplitz will
+// panic if r has zero length.
+func NewSymToeplitz(r []float64) *SymToeplitz {
+	if len(r) == 0 {
+		panic(ErrZeroLength)
+	}
+	r = append([]float64(nil), r...)
+	return &SymToeplitz{
+		r:    r,
+		spec: toeplitzSpectrum(r, r),
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (t *SymToeplitz) Dims() (r, c int) {
+	n := len(t.r)
+	return n, n
+}
+
+// SymmetricDim implements the Symmetric interface and returns the number of
+// rows and columns in the matrix.
+func (t *SymToeplitz) SymmetricDim() int {
+	return len(t.r)
+}
+
+// At returns the element at row i, column j.
+func (t *SymToeplitz) At(i, j int) float64 {
+	n := len(t.r)
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	if i >= j {
+		return t.r[i-j]
+	}
+	return t.r[j-i]
+}
+
+// T returns the receiver, the transpose of a symmetric matrix.
+func (t *SymToeplitz) T() Matrix {
+	return t
+}
+
+// MulVecTo computes T⋅x storing the result into dst. Since T is symmetric, the
+// trans parameter has no effect.
+func (t *SymToeplitz) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	toeplitzMulVecTo(dst, t.r, t.r, t.spec, false, x)
+}
+
+// SolveVecTo solves the linear system T⋅x = b using the Levinson–Durbin
+// recursion and stores the result in dst.
+//
+// The recursion requires that all leading principal submatrices of the matrix
+// are nonsingular, which holds for positive definite matrices such as
+// nonsingular autocovariance matrices. If the recursion breaks down, a
+// Condition error with value +Inf is returned and the contents of dst are
+// undefined. The condition number of the matrix is not estimated.
+func (t *SymToeplitz) SolveVecTo(dst *VecDense, b Vector) error {
+	return levinsonVecTo(dst, t.r, t.r, b)
+}
+
+// InverseTo computes the inverse of the matrix using the Trench algorithm and
+// stores the result into dst.
+//
+// The algorithm requires that all leading principal submatrices of the matrix
+// are nonsingular. If this is not the case, a Condition error with value +Inf
+// is returned and the contents of dst are undefined.
+func (t *SymToeplitz) InverseTo(dst *SymDense) error {
+	n := len(t.r)
+	// The first column of the inverse is the solution of T⋅x = e_0.
+	x := make([]float64, n)
+	x[0] = 1
+	if !levinson(x, t.r, t.r) {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n)
+	// The inverse is symmetric and persymmetric, so only the elements in the
+	// upper triangle above the anti-diagonal are computed by the recurrence
+	//  B[i,j] = B[i-1,j-1] + (x[i]*x[j] - x[n-i]*x[n-j]) / x[0]
+	// and the remaining elements are obtained by reflection.
+	for j := 0; j < n; j++ {
+		dst.SetSym(0, j, x[j])
+		dst.SetSym(n-1-j, n-1, x[j])
+	}
+	for i := 1; i <= (n-1)/2; i++ {
+		for j := i; j < n-i; j++ {
+			v := dst.at(i-1, j-1) + (x[i]*x[j]-x[n-i]*x[n-j])/x[0]
+			dst.SetSym(i, j, v)
+			dst.SetSym(n-1-j, n-1-i, v)
+		}
+	}
+	return nil
+}
+
+// toeplitzDirectMax is the largest order of a Toeplitz matrix for which
+// matrix-vector products are computed directly instead of using the fast
+// Fourier transform.
+const toeplitzDirectMax = 64
+
+// toeplitzSpectrum returns the eigenvalues of the circulant matrix of order
+// toeplitzEmbedLen(n) whose leading n×n submatrix is the Toeplitz matrix with
+// the first column c and the first row r. It returns nil if products with the
+// Toeplitz matrix are computed directly.
+func toeplitzSpectrum(c, r []float64) []complex128 {
+	n := len(c)
+	if n <= toeplitzDirectMax {
+		return nil
+	}
+	m := toeplitzEmbedLen(n)
+	emb := make([]float64, m)
+	copy(emb, c)
+	for k := 1; k < n; k++ {
+		emb[m-k] = r[k]
+	}
+	return fourier.NewFFT(m).Coefficients(nil, emb)
+}
+
+// toeplitzEmbedLen returns the order of the circulant matrix used to compute
+// products with an n×n Toeplitz matrix. It is the smallest integer not less
+// than 2n-1 with no prime factors other than 2, 3 and 5, for which the fast
+// Fourier transform is most efficient and accurate.
+func toeplitzEmbedLen(n int) int {
+	m := 2*n - 1
+	for {
+		k := m
+		for _, p := range []int{2, 3, 5} {
+			for k%p == 0 {
+				k /= p
+			}
+		}
+		if k == 1 {
+			return m
+		}
+		m++
+	}
+}
+
+// toeplitzMulVecTo computes T⋅x or Tᵀ⋅x for the n×n Toeplitz matrix T with the
+// first column c and the first row r and stores the result into dst. spec
+// holds the eigenvalues of the embedding circulant matrix computed by
+// toeplitzSpectrum.
+func toeplitzMulVecTo(dst *VecDense, c, r []float64, spec []complex128, trans bool, x Vector) {
+	n := len(c)
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	if trans {
+		c, r = r, c
+	}
+	if spec == nil {
+		xCopy := make([]float64, n)
+		for i := range xCopy {
+			xCopy[i] = x.AtVec(i)
+		}
+		dst.reuseAsNonZeroed(n)
+		for i := 0; i < n; i++ {
+			var v float64
+			for j := 0; j <= i; j++ {
+				v += c[i-j] * xCopy[j]
+			}
+			for j := i + 1; j < n; j++ {
+				v += r[j-i] * xCopy[j]
+			}
+			dst.setVec(i, v)
+		}
+		return
+	}
+	m := toeplitzEmbedLen(n)
+	work := make([]float64, m)
+	for i := 0; i < n; i++ {
+		work[i] = x.AtVec(i)
+	}
+	circulantMul(fourier.NewFFT(m), work, spec, trans)
+	dst.reuseAsNonZeroed(n)
+	for i, v := range work[:n] {
+		dst.setVec(i, v)
+	}
+}
+
+// levinsonVecTo solves the linear system with the Toeplitz matrix with the
+// first column c and the first row r and the right-hand side b, and stores the
+// result into dst.
+func levinsonVecTo(dst *VecDense, c, r []float64, b Vector) error {
+	n := len(c)
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = b.AtVec(i)
+	}
+	if !levinson(x, c, r) {
+		return Condition(math.Inf(1))
+	}
+	dst.reuseAsNonZeroed(n)
+	for i, v := range x {
+		dst.setVec(i, v)
+	}
+	return nil
+}
+
+// levinson overwrites y with the solution x of the linear system T⋅x = y,
+// where T is the Toeplitz matrix with the first column c and the first row r,
+// using the Levinson recursion. levinson returns false if the recursion breaks
+// down because a leading principal submatrix of T is singular.
+//
+// At step m, the recursion updates the solutions f and g of the systems
+// T_m⋅f = e_0 and T_m⋅g = e_{m-1}, where T_m is the leading m×m submatrix of
+// T, and uses g to extend the solution of the leading m×m subsystem.
+func levinson(y, c, r []float64) bool {
+	n := len(c)
+	if c[0] == 0 {
+		return false
+	}
+	f := make([]float64, n)
+	g := make([]float64, n)
+	f[0] = 1 / c[0]
+	g[0] = f[0]
+	x := make([]float64, n)
+	x[0] = y[0] / c[0]
+	for m := 1; m < n; m++ {
+		// The residuals of the last row of T_{m+1}⋅[f; 0] and the first
+		// row of T_{m+1}⋅[0; g].
+		var ef, eg, ex float64
+		for j := 0; j < m; j++ {
+			ef += c[m-j] * f[j]
+			eg += r[j+1] * g[j]
+			ex += c[m-j] * x[j]
+		}
+		d := 1 - ef*eg
+		if d == 0 {
+			return false
+		}
+		for j := m; j >= 0; j-- {
+			var fj, gj float64
+			if j < m {
+				fj = f[j]
+			}
+			if j > 0 {
+				gj = g[j-1]
+			}
+			f[j] = (fj - ef*gj) / d
+			g[j] = (gj - eg*fj) / d
+		}
+		floats.AddScaled(x[:m+1], y[m]-ex, g[:m+1])
+	}
+	copy(y, x)
+	return true
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestToeplitz(t *testing.T) {
+	t.Parallel()
+	c := []float64{1, 2, 3}
+	r := []float64{1, 4, 5}
+	a := NewToeplitz(c, r)
+	want := NewDense(3, 3, []float64{
+		1, 4, 5,
+		2, 1, 4,
+		3, 2, 1,
+	})
+	if !Equal(a, want) {
+		t.Errorf("unexpected Toeplitz matrix:\ngot:\n%v\nwant:\n%v", Formatted(a), Formatted(want))
+	}
+	if !Equal(a.T(), want.T()) {
+		t.Errorf("unexpected transpose of Toeplitz matrix")
+	}
+	c[1] = 10
+	if a.At(1, 0) != 2 {
+		t.Errorf("Toeplitz matrix shares data with its arguments")
+	}
+	if ok, _ := panics(func() { NewToeplitz([]float64{1, 2}, []float64{2, 2}) }); !ok {
+		t.Errorf("expected panic for mismatched diagonal")
+	}
+	if ok, _ := panics(func() { NewToeplitz([]float64{1, 2}, []float64{1}) }); !ok {
+		t.Errorf("expected panic for mismatched lengths")
+	}
+
+	s := NewSymToeplitz([]float64{4, 1, 0.5})
+	wantSym := NewSymDense(3, []float64{
+		4, 1, 0.5,
+		1, 4, 1,
+		0.5, 1, 4,
+	})
+	if !Equal(s, wantSym) {
+		t.Errorf("unexpected symmetric Toeplitz matrix:\ngot:\n%v\nwant:\n%v", Formatted(s), Formatted(wantSym))
+	}
+}
+
+func TestToeplitzMulVecTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	random := func(n int) []float64 {
+		d := make([]float64, n)
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		return d
+	}
+	// The sizes include matrices whose products are computed using the
+	// fast Fourier transform.
+	for _, n := range []int{1, 2, 10, toeplitzDirectMax, toeplitzDirectMax + 1, 100, 257, 1000} {
+		c := random(n)
+		r := random(n)
+		r[0] = c[0]
+		for _, a := range []interface {
+			Matrix
+			MulVecTo(*VecDense, bool, Vector)
+		}{NewToeplitz(c, r), NewSymToeplitz(r)} {
+			var aDense Dense
+			aDense.CloneFrom(a)
+			x := NewVecDense(n, random(n))
+			for _, trans := range []bool{false, true} {
+				var got, want VecDense
+				a.MulVecTo(&got, trans, x)
+				if trans {
+					want.MulVec(aDense.T(), x)
+				} else {
+					want.MulVec(&aDense, x)
+				}
+				// The error of the product is bounded by the norms of
+				// the matrix and the vector.
+				bound := tol * float64(n) * Norm(&aDense, 1) * Norm(x, math.Inf(1))
+				if resid := residual(&got, &want); resid > bound {
+					t.Errorf("%T,n=%d,trans=%t: unexpected result; resid=%v, want<=%v", a, n, trans, resid, bound)
+				}
+			}
+		}
+	}
+}
+
+func TestToeplitzSolveVecTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 10, 31, 100} {
+		// Diagonally dominant Toeplitz matrix.
+		c := make([]float64, n)
+		r := make([]float64, n)
+		for k := 1; k < n; k++ {
+			c[k] = rnd.NormFloat64() / float64(k*k)
+			r[k] = rnd.NormFloat64() / float64(k*k)
+		}
+		c[0] = 4
+		r[0] = 4
+		a := NewToeplitz(c, r)
+		var aDense Dense
+		aDense.CloneFrom(a)
+
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		for _, trans := range []bool{false, true} {
+			name := fmt.Sprintf("n=%d,trans=%t", n, trans)
+			var x VecDense
+			err := a.SolveVecTo(&x, trans, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var got VecDense
+			if trans {
+				got.MulVec(aDense.T(), &x)
+			} else {
+				got.MulVec(&aDense, &x)
+			}
+			if !EqualApprox(&got, b, tol) {
+				t.Errorf("%s: unexpected solution; residual=%v", name, residual(&got, b))
+			}
+		}
+	}
+
+	// The Levinson recursion breaks down if a leading principal submatrix
+	// is singular.
+	a := NewToeplitz([]float64{0, 1}, []float64{0, 1})
+	var x VecDense
+	err := a.SolveVecTo(&x, false, NewVecDense(2, []float64{1, 1}))
+	if cond, ok := err.(Condition); !ok || !math.IsInf(float64(cond), 1) {
+		t.Errorf("unexpected error for breakdown: %v", err)
+	}
+}
+
+func TestSymToeplitzSolve(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 31, 100} {
+		// Autocovariance matrix of an AR(1) process with noise.
+		phi := 2*rnd.Float64() - 1
+		r := make([]float64, n)
+		for k := range r {
+			r[k] = math.Pow(phi, float64(k))
+		}
+		r[0] += rnd.Float64()
+		a := NewSymToeplitz(r)
+		aDense := NewSymDense(n, nil)
+		aDense.CopySym(a)
+
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		var x VecDense
+		err := a.SolveVecTo(&x, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error from SolveVecTo: %v", n, err)
+			continue
+		}
+		var got VecDense
+		got.MulVec(aDense, &x)
+		if !EqualApprox(&got, b, tol) {
+			t.Errorf("n=%d: unexpected solution; residual=%v", n, residual(&got, b))
+		}
+
+		var inv SymDense
+		err = a.InverseTo(&inv)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error from InverseTo: %v", n, err)
+			continue
+		}
+		var prod Dense
+		prod.Mul(aDense, &inv)
+		if !EqualApprox(&prod, eye(n), tol) {
+			t.Errorf("n=%d: T * T⁻¹ is not the identity matrix", n)
+		}
+	}
+}
+
+func residual(got, want *VecDense) float64 {
+	var diff VecDense
+	diff.SubVec(got, want)
+	return Norm(&diff, math.Inf(1))
+}
@@ -0,0 +1,550 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"io"
+	"math"
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const (
+	// tsqrBlocksPerWorker is the maximum number of blocks of rows per
+	// goroutine used by QR.FactorizeAlgorithm with QRTSQR.
+	tsqrBlocksPerWorker = 4
+
+	// defaultTSQRBlockRows is the minimum number of rows of the blocks read
+	// by TSQR.ReadFrom if BlockRows is not positive.
+	defaultTSQRBlockRows = 1024
+)
+
+// tsqrNode is a node of the reduction tree of the TSQR algorithm.
+type tsqrNode struct {
+	// v holds the QR factorization of the node as computed by Geqrf. For a
+	// leaf it is a block of rows of the matrix, and for an inner node it is
+	// the 2n×n matrix formed by stacking the R factors of the children.
+	v   blas64.General
+	tau []float64
+
+	// left and right are the children of an inner node.
+	left, right *tsqrNode
+
+	// q is the block of rows of the explicit orthonormal factor that
+	// corresponds to a leaf.
+	q blas64.General
+}
+
+// formQ computes the rows of the explicit orthonormal factor that correspond to
+// the leaves of the subtree rooted at nd, given the n×n block c of the
+// orthonormal factor of the parent of nd. The leaves are computed concurrently
+// and wg is used to wait for their completion.
+func (nd *tsqrNode) formQ(c blas64.General, wg *sync.WaitGroup) {
+	n := c.Cols
+	if nd.left == nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			copyRows(nd.q, c)
+			ormqr(nd.v, nd.tau, nd.q)
+		}()
+		return
+	}
+	w := blas64.General{Rows: 2 * n, Cols: n, Stride: n, Data: make([]float64, 2*n*n)}
+	copyRows(w, c)
+	ormqr(nd.v, nd.tau, w)
+	nd.left.formQ(rowBlock(w, 0, n), wg)
+	nd.right.formQ(rowBlock(w, n, 2*n), wg)
+}
+
+// factorizeTSQR computes the QR factorization of the m×n matrix A using the
+// TSQR algorithm described in
+//
+//	Demmel, J., Grigori, L., Hoemmen, M., Langou, J. Communication-optimal
+//	parallel and sequential QR and LU factorizations. SIAM J. Sci. Comput.
+//	34(1), A206-A239 (2012).
+//
+// The explicit orthonormal factor computed by TSQR is converted into the
+// Householder representation computed by Geqrf as described in
+//
+//	Ballard, G., Demmel, J., Grigori, L., Jacquelin, M., Knight, N.,
+//	Nguyen, H. D. Reconstructing Householder vectors from tall-skinny QR.
+//	J. Parallel Distrib. Comput. 85, 3-31 (2015).
+//
+// If A has too few rows to be partitioned into blocks, the Householder
+// algorithm is used instead.
+func (qr *QR) factorizeTSQR(a Matrix, norm lapack.MatrixNorm) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	var p int
+	if n > 0 {
+		// Each block has at least 2n rows.
+		p = min(m/(2*n), tsqrBlocksPerWorker*runtime.GOMAXPROCS(0))
+	}
+	if p < 2 {
+		qr.factorize(a, norm)
+		return
+	}
+	if qr.qr == nil {
+		qr.qr = &Dense{}
+	}
+	qr.qr.CloneFrom(a)
+	qr.tau = make([]float64, n)
+	q := getDenseWorkspace(m, n, true)
+	defer putDenseWorkspace(q)
+
+	// Factorize the blocks of rows concurrently.
+	var wg sync.WaitGroup
+	level := make([]*tsqrNode, p)
+	for k := range level {
+		lo, hi := k*m/p, (k+1)*m/p
+		nd := &tsqrNode{
+			v:   rowBlock(qr.qr.mat, lo, hi),
+			tau: make([]float64, n),
+			q:   rowBlock(q.mat, lo, hi),
+		}
+		level[k] = nd
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			geqrf(nd.v, nd.tau)
+		}()
+	}
+	wg.Wait()
+
+	// Combine the R factors pairwise until a single R factor remains.
+	for len(level) > 1 {
+		next := make([]*tsqrNode, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 == len(level) {
+				next[i] = level[2*i]
+				continue
+			}
+			nd := &tsqrNode{
+				v:     blas64.General{Rows: 2 * n, Cols: n, Stride: n, Data: make([]float64, 2*n*n)},
+				tau:   make([]float64, n),
+				left:  level[2*i],
+				right: level[2*i+1],
+			}
+			next[i] = nd
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				copyUpper(rowBlock(nd.v, 0, n), nd.left.v)
+				copyUpper(rowBlock(nd.v, n, 2*n), nd.right.v)
+				geqrf(nd.v, nd.tau)
+			}()
+		}
+		wg.Wait()
+		level = next
+	}
+	root := level[0]
+
+	// Form the explicit orthonormal factor Q by applying the orthogonal
+	// factors of the tree from the root to the leaves.
+	eye := blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+	for i := 0; i < n; i++ {
+		eye.Data[i*n+i] = 1
+	}
+	root.formQ(eye, &wg)
+	wg.Wait()
+
+	// Reconstruct the Householder vectors from Q by computing the LU
+	// factorization Q - D = V * U without pivoting, where the diagonal sign
+	// matrix D is chosen so that the pivots are at least one in magnitude.
+	// The Householder vectors are the columns of V, the scalar factors are
+	// the diagonal elements of -U*D, and A = (Q*D) * (D*R).
+	d := getFloat64s(n, false)
+	defer putFloat64s(d)
+	q1 := q.mat
+	for i := 0; i < n; i++ {
+		qii := q1.Data[i*q1.Stride+i]
+		d[i] = -1
+		if qii < 0 {
+			d[i] = 1
+		}
+		piv := qii - d[i]
+		q1.Data[i*q1.Stride+i] = piv
+		qr.tau[i] = -d[i] * piv
+		if i == n-1 {
+			break
+		}
+		col := blas64.Vector{N: n - i - 1, Inc: q1.Stride, Data: q1.Data[(i+1)*q1.Stride+i:]}
+		blas64.Scal(1/piv, col)
+		blas64.Ger(-1, col,
+			blas64.Vector{N: n - i - 1, Inc: 1, Data: q1.Data[i*q1.Stride+i+1:]},
+			blas64.General{Rows: n - i - 1, Cols: n - i - 1, Stride: q1.Stride, Data: q1.Data[(i+1)*q1.Stride+i+1:]})
+	}
+	u := blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: n, Stride: q1.Stride, Data: q1.Data}
+	for k := 0; k < p; k++ {
+		lo, hi := n+k*(m-n)/p, n+(k+1)*(m-n)/p
+		if lo == hi {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			blas64.Trsm(blas.Right, blas.NoTrans, 1, u, rowBlock(q.mat, lo, hi))
+		}()
+	}
+	wg.Wait()
+
+	qr.qr.Copy(q)
+	r := root.v
+	for i := 0; i < n; i++ {
+		dst := qr.qr.mat.Data[i*qr.qr.mat.Stride+i : i*qr.qr.mat.Stride+n]
+		for j, v := range r.Data[i*r.Stride+i : i*r.Stride+n] {
+			dst[j] = d[i] * v
+		}
+	}
+	qr.updateCond(norm)
+	if qr.q != nil {
+		qr.q.Reset()
+	}
+}
+
+// RowReader is the interface that wraps the ReadRows method.
+//
+// ReadRows reads up to r rows of a matrix into the rows of dst, where r is the
+// number of rows of dst, and returns the number of rows read. The number of
+// columns of dst is the number of columns of the matrix. ReadRows follows the
+// conventions of io.Reader: when no rows remain it returns 0, io.EOF, and it
+// may return io.EOF or another error together with a positive number of rows
+// that the caller uses.
+type RowReader interface {
+	ReadRows(dst *Dense) (n int, err error)
+}
+
+// TSQR is a type for computing the upper triangular factor R of the QR
+// factorization
+//
+//	A = Q * R
+//
+// of a tall and skinny m×n matrix A whose rows are supplied in blocks, for
+// example as they are read from a file.
+//
+// The blocks of rows are factorized concurrently and their triangular factors
+// are combined with a reduction tree. Only R is retained, so the memory used
+// depends on n but not on the number of rows. The least squares problem
+//
+//	minimize ||A * X - B||_2
+//
+// can be solved by supplying the rows of the augmented matrix [A B] to TSQR
+// and calling SolveTo.
+//
+// A TSQR must be created with NewTSQR.
+type TSQR struct {
+	// Workers is the number of goroutines that factorize the blocks of rows
+	// read by ReadFrom. If Workers is not positive, runtime.GOMAXPROCS(0)
+	// goroutines are used.
+	Workers int
+
+	// BlockRows is the number of rows of the blocks read by ReadFrom. If
+	// BlockRows is not positive, a default number of rows that is at least
+	// 2*n is used.
+	BlockRows int
+
+	rows int
+	r    *Dense
+}
+
+// NewTSQR returns a TSQR for a matrix with n columns and no rows. NewTSQR will
+// panic if n is not positive.
+func NewTSQR(n int) *TSQR {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	return &TSQR{r: NewDense(n, n, nil)}
+}
+
+// Dims returns the number of rows supplied to the receiver and the number of
+// columns of the matrix.
+func (t *TSQR) Dims() (r, c int) {
+	return t.rows, t.r.mat.Cols
+}
+
+// Reset discards all rows supplied to the receiver.
+func (t *TSQR) Reset() {
+	t.rows = 0
+	t.r.Zero()
+}
+
+// workers returns the number of goroutines to use.
+func (t *TSQR) workers() int {
+	if t.Workers > 0 {
+		return t.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// blockRows returns the number of rows of the blocks read by ReadFrom.
+func (t *TSQR) blockRows() int {
+	if t.BlockRows > 0 {
+		return t.BlockRows
+	}
+	return max(2*t.r.mat.Cols, defaultTSQRBlockRows)
+}
+
+// AddRows adds the rows of a to the matrix factorized by the receiver. AddRows
+// will panic if a does not have n columns.
+func (t *TSQR) AddRows(a Matrix) {
+	_, c := a.Dims()
+	if c != t.r.mat.Cols {
+		panic(ErrShape)
+	}
+	t.ReadFrom(&matrixRowReader{a: a})
+}
+
+// ReadFrom reads blocks of rows from src until io.EOF or an error is returned
+// and adds them to the matrix factorized by the receiver. ReadFrom returns the
+// number of rows read and any error other than io.EOF returned by src. The
+// rows read before an error are added to the matrix.
+func (t *TSQR) ReadFrom(src RowReader) (rows int, err error) {
+	n := t.r.mat.Cols
+	w := t.workers()
+	br := t.blockRows()
+
+	// Each worker folds the blocks it receives into its own R factor. The
+	// blocks are distributed in turn so that the result does not depend on
+	// the scheduling of the goroutines.
+	type block struct {
+		buf  *Dense
+		rows int
+	}
+	var (
+		wg        sync.WaitGroup
+		free      = make(chan *Dense, 2*w)
+		allocated int
+		jobs      = make([]chan block, w)
+		rs        = make([]blas64.General, w+1)
+	)
+	rs[0] = t.r.mat
+	for i := range jobs {
+		jobs[i] = make(chan block, 1)
+		r := blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+		rs[i+1] = r
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range jobs[i] {
+				tsqrFold(r, rowBlock(b.buf.mat, 0, b.rows))
+				free <- b.buf
+			}
+		}()
+	}
+	for k := 0; ; {
+		var buf *Dense
+		select {
+		case buf = <-free:
+		default:
+			if allocated < 2*w {
+				buf = NewDense(br, n, nil)
+				allocated++
+			} else {
+				buf = <-free
+			}
+		}
+		nr, rerr := src.ReadRows(buf)
+		if nr > 0 {
+			rows += nr
+			jobs[k%w] <- block{buf: buf, rows: nr}
+			k++
+		} else {
+			free <- buf
+		}
+		if rerr != nil {
+			if rerr != io.EOF {
+				err = rerr
+			}
+			break
+		}
+	}
+	for _, c := range jobs {
+		close(c)
+	}
+	wg.Wait()
+
+	// Combine the R factors of the workers with the R factor of the rows
+	// added previously using a binary reduction tree. The result is stored
+	// in rs[0] which is the R factor of the receiver.
+	for len(rs) > 1 {
+		for i := 0; i+1 < len(rs); i += 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tsqrFold(rs[i], rs[i+1])
+			}()
+		}
+		wg.Wait()
+		next := rs[:0]
+		for i := 0; i < len(rs); i += 2 {
+			next = append(next, rs[i])
+		}
+		rs = next
+	}
+	t.rows += rows
+	return rows, err
+}
+
+// RTo extracts the n×n upper triangular factor R with non-negative diagonal
+// elements of the QR factorization of the rows supplied to the receiver.
+//
+// If dst is empty, RTo will resize dst to be an n×n upper triangular matrix.
+// When dst is non-empty, RTo will panic if dst is not n×n or not Upper.
+func (t *TSQR) RTo(dst *TriDense) {
+	n := t.r.mat.Cols
+	if dst.IsEmpty() {
+		dst.ReuseAsTri(n, Upper)
+	} else {
+		n2, kind := dst.Triangle()
+		if n != n2 {
+			panic(ErrShape)
+		}
+		if kind != Upper {
+			panic(ErrTriangle)
+		}
+	}
+	for i := 0; i < n; i++ {
+		src := t.r.mat.Data[i*t.r.mat.Stride+i : i*t.r.mat.Stride+n]
+		row := dst.mat.Data[i*dst.mat.Stride+i : i*dst.mat.Stride+n]
+		copy(row, src)
+		if src[0] < 0 {
+			blas64.Scal(-1, blas64.Vector{N: n - i, Inc: 1, Data: row})
+		}
+	}
+}
+
+// SolveTo finds the solution X that minimizes ||A * X - B||_2 where the rows
+// supplied to the receiver are the rows of the augmented m×n matrix [A B] and
+// B has nrhs columns. The (n-nrhs)×nrhs solution is stored in place into dst.
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+//
+// SolveTo will panic if nrhs is not positive or not less than n.
+func (t *TSQR) SolveTo(dst *Dense, nrhs int) error {
+	n := t.r.mat.Cols
+	if nrhs <= 0 || n <= nrhs {
+		panic(ErrShape)
+	}
+	k := n - nrhs
+	dst.reuseAsNonZeroed(k, nrhs)
+	r := t.r.mat
+	for i := 0; i < k; i++ {
+		copy(dst.rawRowView(i), r.Data[i*r.Stride+k:i*r.Stride+n])
+	}
+	ra := blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: k, Stride: r.Stride, Data: r.Data}
+	ok := lapack64.Trtrs(blas.NoTrans, ra, dst.mat)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	work := getFloat64s(3*k, false)
+	iwork := getInts(k, false)
+	cond := 1 / lapack64.Trcon(CondNorm, ra, work, iwork)
+	putFloat64s(work)
+	putInts(iwork)
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// matrixRowReader is a RowReader that reads the rows of a matrix.
+type matrixRowReader struct {
+	a Matrix
+	i int
+}
+
+func (r *matrixRowReader) ReadRows(dst *Dense) (n int, err error) {
+	m, c := r.a.Dims()
+	if r.i == m {
+		return 0, io.EOF
+	}
+	n = min(dst.mat.Rows, m-r.i)
+	if rm, ok := r.a.(RawMatrixer); ok {
+		src := rm.RawMatrix()
+		for i := 0; i < n; i++ {
+			copy(dst.rawRowView(i), src.Data[(r.i+i)*src.Stride:(r.i+i)*src.Stride+c])
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for j := 0; j < c; j++ {
+				dst.set(i, j, r.a.At(r.i+i, j))
+			}
+		}
+	}
+	r.i += n
+	return n, nil
+}
+
+// tsqrFold computes the R factor of the matrix formed by stacking the n×n upper
+// triangular matrix r and the k×n matrix a, and stores it into r. The contents
+// of a are overwritten.
+func tsqrFold(r, a blas64.General) {
+	n := r.Cols
+	s := blas64.General{Rows: n + min(a.Rows, n), Cols: n, Stride: n}
+	s.Data = getFloat64s(s.Rows*n, true)
+	defer putFloat64s(s.Data)
+	tau := getFloat64s(n, false)
+	defer putFloat64s(tau)
+	copyUpper(rowBlock(s, 0, n), r)
+	if a.Rows > n {
+		geqrf(a, tau)
+		copyUpper(rowBlock(s, n, 2*n), a)
+	} else if a.Rows > 0 {
+		copyRows(rowBlock(s, n, n+a.Rows), a)
+	}
+	geqrf(s, tau)
+	copyUpper(r, s)
+}
+
+// rowBlock returns the rows lo through hi-1 of a. lo must be less than hi.
+func rowBlock(a blas64.General, lo, hi int) blas64.General {
+	return blas64.General{Rows: hi - lo, Cols: a.Cols, Stride: a.Stride, Data: a.Data[lo*a.Stride:]}
+}
+
+// copyRows copies the rows of src into the first rows of dst.
+func copyRows(dst, src blas64.General) {
+	for i := 0; i < src.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+src.Cols], src.Data[i*src.Stride:i*src.Stride+src.Cols])
+	}
+}
+
+// copyUpper copies the upper triangle of the first n rows of the k×n matrix src
+// into dst, where n is the number of columns of src.
+func copyUpper(dst, src blas64.General) {
+	n := src.Cols
+	for i := 0; i < n; i++ {
+		copy(dst.Data[i*dst.Stride+i:i*dst.Stride+n], src.Data[i*src.Stride+i:i*src.Stride+n])
+	}
+}
+
+// geqrf computes the QR factorization of a using lapack64.Geqrf.
+func geqrf(a blas64.General, tau []float64) {
+	work := []float64{0}
+	lapack64.Geqrf(a, tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqrf(a, tau, work, len(work))
+	putFloat64s(work)
+}
+
+// ormqr computes Q * c where Q is the orthogonal matrix of the QR factorization
+// computed by geqrf, storing the result in place into c.
+func ormqr(a blas64.General, tau []float64, c blas64.General) {
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, blas.NoTrans, a, tau, c, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, blas.NoTrans, a, tau, c, work, len(work))
+	putFloat64s(work)
+}
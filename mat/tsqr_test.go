@@ -0,0 +1,345 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestQRTSQR(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{10, 1},
+		{12, 3},
+		{50, 3},
+		{64, 32},
+		{200, 7},
+		{1000, 20},
+	} {
+		m, n := test.m, test.n
+		a := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+
+		var want, qr QR
+		want.Factorize(a)
+		qr.FactorizeAlgorithm(a, QRTSQR)
+
+		var q, r Dense
+		qr.QTo(&q)
+		if !isOrthonormal(&q, tol) {
+			t.Errorf("%s: Q is not orthonormal", name)
+		}
+		qr.RTo(&r)
+		var qrMul Dense
+		qrMul.Mul(&q, &r)
+		if !EqualApprox(&qrMul, a, tol*float64(n)) {
+			t.Errorf("%s: Q*R != A", name)
+		}
+		if !EqualApprox(&qr, a, tol*float64(n)) {
+			t.Errorf("%s: QR is not equal to A as Matrix", name)
+		}
+
+		// The R factor is unique up to the signs of its rows.
+		var rWant Dense
+		want.RTo(&rWant)
+		for i := 0; i < n; i++ {
+			s := math.Copysign(1, r.At(i, i)*rWant.At(i, i))
+			for j := i; j < n; j++ {
+				if math.Abs(r.At(i, j)-s*rWant.At(i, j)) > tol*float64(n) {
+					t.Errorf("%s: R mismatch at (%d,%d); got %v, want %v", name, i, j, r.At(i, j), s*rWant.At(i, j))
+				}
+			}
+		}
+		if math.Abs(qr.Cond()-want.Cond()) > 1e-8*want.Cond() {
+			t.Errorf("%s: condition number mismatch; got %v, want %v", name, qr.Cond(), want.Cond())
+		}
+
+		b := NewDense(m, 2, nil)
+		for i := 0; i < m; i++ {
+			b.Set(i, 0, rnd.NormFloat64())
+			b.Set(i, 1, rnd.NormFloat64())
+		}
+		var x, xWant Dense
+		err := qr.SolveTo(&x, false, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		want.SolveTo(&xWant, false, b)
+		if !EqualApprox(&x, &xWant, tol*float64(m)) {
+			t.Errorf("%s: least squares solution mismatch", name)
+		}
+	}
+
+	panicked, message := panics(func() {
+		var qr QR
+		qr.FactorizeAlgorithm(NewDense(3, 2, nil), QRAlgorithm(-1))
+	})
+	if !panicked || message != badQRAlgorithm {
+		t.Errorf("expected panic for invalid algorithm")
+	}
+}
+
+// chunkRowReader is a RowReader that reads the rows of a matrix in chunks of
+// at most chunk rows, and returns err when all rows have been read.
+type chunkRowReader struct {
+	a     *Dense
+	i     int
+	chunk int
+	err   error
+}
+
+func (r *chunkRowReader) ReadRows(dst *Dense) (n int, err error) {
+	m, _ := r.a.Dims()
+	if r.i == m {
+		return 0, r.err
+	}
+	n = min(r.chunk, dst.RawMatrix().Rows, m-r.i)
+	dst.Slice(0, n, 0, dst.RawMatrix().Cols).(*Dense).Copy(r.a.Slice(r.i, r.i+n, 0, r.a.RawMatrix().Cols))
+	r.i += n
+	if r.i == m && r.err != io.EOF {
+		return n, r.err
+	}
+	return n, nil
+}
+
+func TestTSQR(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{0, 1},
+		{2, 4},
+		{7, 3},
+		{50, 5},
+		{300, 8},
+		{1000, 17},
+	} {
+		m, n := test.m, test.n
+		var a *Dense
+		if m > 0 {
+			a = NewDense(m, n, nil)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+			}
+		}
+
+		// Compute the reference R factor with non-negative diagonal.
+		rWant := NewTriDense(n, Upper, nil)
+		if m > 0 {
+			var qr QR
+			ext := NewDense(max(m, n), n, nil)
+			ext.Copy(a)
+			qr.Factorize(ext)
+			var r Dense
+			qr.RTo(&r)
+			for i := 0; i < n; i++ {
+				s := 1.0
+				if r.At(i, i) < 0 {
+					s = -1
+				}
+				for j := i; j < n; j++ {
+					rWant.SetTri(i, j, s*r.At(i, j))
+				}
+			}
+		}
+
+		for _, workers := range []int{0, 1, 3} {
+			for _, blockRows := range []int{0, 1, 5, 17} {
+				for _, chunk := range []int{1, 4, m + 1} {
+					name := fmt.Sprintf("m=%d,n=%d,workers=%d,blockRows=%d,chunk=%d", m, n, workers, blockRows, chunk)
+					ts := NewTSQR(n)
+					ts.Workers = workers
+					ts.BlockRows = blockRows
+
+					// Supply the first rows using AddRows and the
+					// remaining rows using ReadFrom.
+					m1 := m / 3
+					if m1 > 0 {
+						ts.AddRows(a.Slice(0, m1, 0, n))
+					}
+					var rows int
+					var err error
+					if m > 0 {
+						rows, err = ts.ReadFrom(&chunkRowReader{a: a.Slice(m1, m, 0, n).(*Dense), chunk: chunk, err: io.EOF})
+					} else {
+						rows, err = ts.ReadFrom(&matrixRowReader{a: &Dense{}})
+					}
+					if err != nil {
+						t.Errorf("%s: unexpected error: %v", name, err)
+					}
+					if rows != m-m1 {
+						t.Errorf("%s: unexpected number of rows read; got %d, want %d", name, rows, m-m1)
+					}
+					if r, c := ts.Dims(); r != m || c != n {
+						t.Errorf("%s: unexpected dimensions; got %d×%d, want %d×%d", name, r, c, m, n)
+					}
+
+					var r TriDense
+					ts.RTo(&r)
+					if !EqualApprox(&r, rWant, tol*float64(max(m, 1))) {
+						t.Errorf("%s: R mismatch", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTSQRReadError(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const m, n = 40, 3
+	a := NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	errRead := errors.New("read failure")
+	ts := NewTSQR(n)
+	ts.Workers = 2
+	ts.BlockRows = 6
+	rows, err := ts.ReadFrom(&chunkRowReader{a: a, chunk: 4, err: errRead})
+	if err != errRead {
+		t.Errorf("unexpected error; got %v, want %v", err, errRead)
+	}
+	if rows != m {
+		t.Errorf("unexpected number of rows read; got %d, want %d", rows, m)
+	}
+
+	// The rows read before the error are part of the factorization.
+	want := NewTSQR(n)
+	want.AddRows(a)
+	var r, rWant TriDense
+	ts.RTo(&r)
+	want.RTo(&rWant)
+	if !EqualApprox(&r, &rWant, 1e-12) {
+		t.Errorf("R mismatch after read error")
+	}
+
+	ts.Reset()
+	if r, c := ts.Dims(); r != 0 || c != n {
+		t.Errorf("unexpected dimensions after Reset; got %d×%d, want 0×%d", r, c, n)
+	}
+	ts.RTo(&r)
+	if !Equal(&r, NewTriDense(n, Upper, nil)) {
+		t.Errorf("R is not zero after Reset")
+	}
+}
+
+func TestTSQRSolveTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, nrhs int
+	}{
+		{1, 1, 1},
+		{10, 3, 1},
+		{100, 5, 2},
+		{1000, 20, 3},
+	} {
+		m, n, nrhs := test.m, test.n, test.nrhs
+		a := NewDense(m, n, nil)
+		b := NewDense(m, nrhs, nil)
+		aug := NewDense(m, n+nrhs, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+			for j := 0; j < nrhs; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		aug.Augment(a, b)
+		name := fmt.Sprintf("m=%d,n=%d,nrhs=%d", m, n, nrhs)
+
+		ts := NewTSQR(n + nrhs)
+		ts.Workers = 4
+		ts.BlockRows = 2*n + 1
+		ts.AddRows(aug)
+		var x Dense
+		err := ts.SolveTo(&x, nrhs)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		var qr QR
+		qr.Factorize(a)
+		var xWant Dense
+		qr.SolveTo(&xWant, false, b)
+		if !EqualApprox(&x, &xWant, tol*float64(m)) {
+			t.Errorf("%s: least squares solution mismatch", name)
+		}
+	}
+
+	// A singular matrix returns a Condition error.
+	ts := NewTSQR(3)
+	ts.AddRows(NewDense(4, 3, []float64{
+		1, 0, 1,
+		2, 0, 0,
+		3, 0, 1,
+		4, 0, 0,
+	}))
+	var x Dense
+	err := ts.SolveTo(&x, 1)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got %v", err)
+	}
+}
+
+func BenchmarkQRTSQR(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{10000, 10},
+		{10000, 100},
+		{100000, 50},
+	} {
+		a := NewDense(test.m, test.n, nil)
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < test.n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		for _, alg := range []struct {
+			name string
+			alg  QRAlgorithm
+		}{
+			{"Householder", QRHouseholder},
+			{"TSQR", QRTSQR},
+		} {
+			b.Run(fmt.Sprintf("%s/m=%d,n=%d", alg.name, test.m, test.n), func(b *testing.B) {
+				var qr QR
+				for i := 0; i < b.N; i++ {
+					qr.FactorizeAlgorithm(a, alg.alg)
+				}
+			})
+		}
+	}
+}
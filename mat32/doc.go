@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run generate_mat32.go
+
+// Package mat32 provides implementations of float32 matrix structures and
+// linear algebra operations on them.
+//
+// The mat32 package is the single precision counterpart of the mat package.
+// It provides the real-valued matrix types of mat, including Dense,
+// SymDense, TriDense, VecDense and the banded types, with the same API,
+// and the LU, Cholesky, QR, LQ, SVD and EigenSym factorizations. Halving
+// the size of the matrix elements halves the memory bandwidth required by
+// memory-bound operations at the cost of precision: the relative machine
+// precision of float32 is about 6e-8, compared to 1.1e-16 for float64, and
+// ConditionTolerance is reduced accordingly.
+//
+// The matrix operations are backed by the blas32 and lapack32 packages.
+//
+// Most of the package is generated from the mat package, and the
+// documentation of mat applies to mat32 with float64 replaced by float32.
+package mat32 // import "gonum.org/v1/gonum/mat32"
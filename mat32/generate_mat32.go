@@ -0,0 +1,459 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// generate_mat32 generates the mat32 package from the real-valued parts of
+// the mat package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// files are the mat source files translated into mat32.
+var files = []string{
+	"band.go",
+	"bandlu.go",
+	"cholesky.go",
+	"cod.go",
+	"consts.go",
+	"dense.go",
+	"dense_arithmetic.go",
+	"diagonal.go",
+	"eigen.go",
+	"errors.go",
+	"format.go",
+	"index_bound_checks.go",
+	"index_no_bound_checks.go",
+	"inner.go",
+	"lq.go",
+	"lsq.go",
+	"lu.go",
+	"matrix.go",
+	"offset.go",
+	"offset_appengine.go",
+	"pool.go",
+	"product.go",
+	"qr.go",
+	"qrcp.go",
+	"shadow.go",
+	"shadow_common.go",
+	"solve.go",
+	"svd.go",
+	"symband.go",
+	"symmetric.go",
+	"triangular.go",
+	"triband.go",
+	"tridiag.go",
+	"tsqr.go",
+	"vector.go",
+
+	"shared_test.go",
+}
+
+// excluded are identifiers declared in the mat package outside of the
+// translated files, or that depend on complex arithmetic. Declarations and
+// statements referring to them are not translated.
+var excluded = map[string]bool{
+	"CDense":        true,
+	"CMatrix":       true,
+	"cblas128":      true,
+	"cmplx":         true,
+	"complex":       true,
+	"complex128":    true,
+	"Eigen":         true,
+	"EigenKind":     true,
+	"zeroC":         true,
+	"offsetComplex": true,
+	"poolCDense":    true,
+}
+
+// packages are the import paths rewritten to their float32 counterparts.
+var packages = map[string]struct{ name, path string }{
+	"math":                                {"math", "gonum.org/v1/gonum/internal/math32"},
+	"gonum.org/v1/gonum/blas/blas64":      {"", "gonum.org/v1/gonum/blas/blas32"},
+	"gonum.org/v1/gonum/lapack/lapack64":  {"", "gonum.org/v1/gonum/lapack/lapack32"},
+	"gonum.org/v1/gonum/internal/asm/f64": {"", "gonum.org/v1/gonum/internal/asm/f32"},
+	"gonum.org/v1/gonum/mat":              {"", "gonum.org/v1/gonum/mat32"},
+	"gonum.org/v1/gonum/floats":           {"", "gonum.org/v1/gonum/internal/asm/f32"},
+}
+
+// idents are identifiers renamed in the translation.
+var idents = map[string]string{
+	"float64":  "float32",
+	"blas64":   "blas32",
+	"lapack64": "lapack32",
+	"f64":      "f32",
+	"dlamchE":  "slamchE",
+}
+
+// selectors are package-qualified functions replaced in the translation.
+var selectors = map[string]string{
+	"floats.Sum":                 "f32.Sum",
+	"scalar.EqualWithinAbsOrRel": "equalWithinAbsOrRel",
+	"math.Float64bits":           "math.Float32bits",
+}
+
+// consts are the values of float constants that depend on the precision.
+var consts = map[string]string{
+	"ConditionTolerance": "1e7",
+}
+
+// comments are textual replacements made in comments.
+var comments = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile(`float64`), "float32"},
+	{regexp.MustCompile(`Float64`), "Float32"},
+	{regexp.MustCompile(`\bblas64\b`), "blas32"},
+	{regexp.MustCompile(`\blapack64\b`), "lapack32"},
+	{regexp.MustCompile(`\bmat\.`), "mat32."},
+	{regexp.MustCompile(`\bD(asum|axpy|copy|dot|gemm|gemv|ger|nrm2|rot|scal|swap|syrk|trmm|trmv|trsm|trsv)\b`), "S$1"},
+}
+
+func main() {
+	for _, name := range files {
+		err := generate(filepath.Join("..", "mat", name), name)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(src, dst string) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, src, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	cmap := ast.NewCommentMap(fset, f, f.Comments)
+
+	if f.Name.Name == "mat" {
+		f.Name.Name = "mat32"
+	} else {
+		f.Name.Name = "mat32_test"
+	}
+	prune(f)
+	translate(f)
+	fixImports(fset, f)
+
+	f.Comments = cmap.Filter(f).Comments()
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			if strings.HasPrefix(c.Text, "//go:build") || strings.HasPrefix(c.Text, "// +build") {
+				continue
+			}
+			for _, r := range comments {
+				c.Text = r.re.ReplaceAllString(c.Text, r.repl)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"go generate gonum.org/v1/gonum/mat32\"; DO NOT EDIT.\n\n")
+	err = format.Node(&buf, fset, f)
+	if err != nil {
+		return err
+	}
+	b, err := imports.Process(dst, buf.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8, FormatOnly: true})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, b, 0o664)
+}
+
+// refersToExcluded returns whether the node n refers to an excluded
+// identifier.
+func refersToExcluded(n ast.Node) bool {
+	var found bool
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && excluded[id.Name] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// prune removes the declarations, specs and statements of f that refer to
+// excluded identifiers. The names of removed declarations are added to the
+// excluded identifiers.
+func prune(f *ast.File) {
+	decls := f.Decls[:0]
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if (d.Recv != nil && refersToExcluded(d.Recv)) || refersToExcluded(d.Type) {
+				if d.Recv == nil {
+					excluded[d.Name.Name] = true
+				}
+				continue
+			}
+			if d.Body != nil && refersToExcluded(d.Body) {
+				pruneBlock(d.Body)
+			}
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				break
+			}
+			specs := d.Specs[:0]
+			var dropImplicit bool
+			for _, s := range d.Specs {
+				if vs, ok := s.(*ast.ValueSpec); ok && d.Tok == token.CONST && vs.Type == nil && vs.Values == nil {
+					if dropImplicit {
+						for _, n := range vs.Names {
+							excluded[n.Name] = true
+						}
+						continue
+					}
+				} else {
+					dropImplicit = false
+				}
+				if refersToExcluded(s) {
+					switch s := s.(type) {
+					case *ast.TypeSpec:
+						excluded[s.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, n := range s.Names {
+							excluded[n.Name] = true
+						}
+						dropImplicit = true
+					}
+					continue
+				}
+				specs = append(specs, s)
+			}
+			if len(specs) == 0 {
+				continue
+			}
+			d.Specs = specs
+		}
+		decls = append(decls, d)
+	}
+	f.Decls = decls
+}
+
+// pruneBlock removes the statements of b that refer to excluded identifiers.
+func pruneBlock(b *ast.BlockStmt) {
+	stmts := b.List[:0]
+	for _, s := range b.List {
+		if !refersToExcluded(s) {
+			stmts = append(stmts, s)
+			continue
+		}
+		switch s := s.(type) {
+		case *ast.RangeStmt:
+			pruneBlock(s.Body)
+		case *ast.ForStmt:
+			pruneBlock(s.Body)
+		case *ast.SwitchStmt:
+			pruneClauses(s.Body)
+		case *ast.TypeSwitchStmt:
+			pruneClauses(s.Body)
+		default:
+			continue
+		}
+		stmts = append(stmts, s)
+	}
+	b.List = stmts
+}
+
+// pruneClauses removes the case clauses of b that refer to excluded
+// identifiers.
+func pruneClauses(b *ast.BlockStmt) {
+	clauses := b.List[:0]
+	for _, s := range b.List {
+		cc := s.(*ast.CaseClause)
+		var drop bool
+		for _, e := range cc.List {
+			drop = drop || refersToExcluded(e)
+		}
+		if drop {
+			continue
+		}
+		body := &ast.BlockStmt{List: cc.Body}
+		pruneBlock(body)
+		cc.Body = body.List
+		clauses = append(clauses, cc)
+	}
+	b.List = clauses
+}
+
+// translate rewrites the float64 identifiers, calls and constants in f to
+// their float32 counterparts.
+func translate(f *ast.File) {
+	astutil.Apply(f, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok && x.Obj == nil {
+				if r, ok := selectors[x.Name+"."+n.Sel.Name]; ok {
+					e, err := parser.ParseExpr(r)
+					if err != nil {
+						panic(err)
+					}
+					c.Replace(e)
+					return false
+				}
+				if x.Name == "mat" {
+					x.Name = "mat32"
+				}
+			}
+			if blasRoutine.MatchString(n.Sel.Name) {
+				n.Sel.Name = "S" + n.Sel.Name[1:]
+			}
+		case *ast.CallExpr:
+			// strconv.AppendFloat(dst, v, fmt, prec, 64) is rewritten to
+			// strconv.AppendFloat(dst, float64(v), fmt, prec, 32).
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				break
+			}
+			x, ok := sel.X.(*ast.Ident)
+			if !ok {
+				break
+			}
+			switch {
+			case x.Name == "strconv" && sel.Sel.Name == "AppendFloat" && len(n.Args) == 5:
+				for _, a := range n.Args[:4] {
+					astutil.Apply(a, nil, renameIdents)
+				}
+				n.Args[1] = &ast.CallExpr{Fun: ast.NewIdent("float64"), Args: []ast.Expr{n.Args[1]}}
+				n.Args[4] = &ast.BasicLit{Kind: token.INT, Value: "32"}
+				return false
+			case x.Name == "blas64" && sel.Sel.Name == "Rot" && len(n.Args) == 4:
+				// blas32.Rot takes the length of the vectors explicitly.
+				xn := &ast.SelectorExpr{X: n.Args[0], Sel: ast.NewIdent("N")}
+				n.Args = append([]ast.Expr{xn}, n.Args...)
+			}
+		case *ast.AssignStmt:
+			// Untyped float constants in short variable declarations are
+			// converted to float32.
+			if n.Tok != token.DEFINE {
+				break
+			}
+			for i, e := range n.Rhs {
+				if isFloatLit(e) {
+					n.Rhs[i] = &ast.CallExpr{Fun: ast.NewIdent("float32"), Args: []ast.Expr{e}}
+				}
+			}
+		case *ast.BasicLit:
+			if n.Kind == token.STRING && strings.HasPrefix(n.Value, `"mat: `) {
+				n.Value = `"mat32: ` + n.Value[len(`"mat: `):]
+			}
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if v, ok := consts[name.Name]; ok {
+					n.Values[i] = &ast.BasicLit{Kind: token.FLOAT, Value: v}
+				}
+			}
+		}
+		return true
+	}, renameIdents)
+}
+
+// blasRoutine matches the names of the float64 BLAS routines.
+var blasRoutine = regexp.MustCompile(`^D(asum|axpy|copy|dot|gbmv|gemm|gemv|ger|nrm2|rot|rotg|rotm|sbmv|scal|spmv|spr|spr2|swap|symm|symv|syr|syr2|syr2k|syrk|tbmv|tbsv|tpmv|tpsv|trmm|trmv|trsm|trsv)$`)
+
+// isFloatLit returns whether e is a possibly negated floating-point literal.
+func isFloatLit(e ast.Expr) bool {
+	if u, ok := e.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		e = u.X
+	}
+	lit, ok := e.(*ast.BasicLit)
+	return ok && lit.Kind == token.FLOAT
+}
+
+func renameIdents(c *astutil.Cursor) bool {
+	if id, ok := c.Node().(*ast.Ident); ok {
+		if r, ok := idents[id.Name]; ok {
+			id.Name = r
+		} else if strings.Contains(id.Name, "Float64") && id.Name != "NormFloat64" {
+			id.Name = strings.ReplaceAll(id.Name, "Float64", "Float32")
+		}
+	}
+	return true
+}
+
+// fixImports rewrites the imports of f to their float32 counterparts and
+// removes unused imports.
+func fixImports(fset *token.FileSet, f *ast.File) {
+	type spec struct{ name, path string }
+	var imports []spec
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			panic(err)
+		}
+		var name string
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports = append(imports, spec{name: name, path: path})
+	}
+	for _, imp := range imports {
+		if imp.name != "" {
+			astutil.DeleteNamedImport(fset, f, imp.name, imp.path)
+		} else {
+			astutil.DeleteImport(fset, f, imp.path)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, imp := range imports {
+		if r, ok := packages[imp.path]; ok {
+			imp = spec{name: r.name, path: r.path}
+		}
+		name := imp.name
+		if name == "" {
+			elems := strings.Split(imp.path, "/")
+			name = elems[len(elems)-1]
+			if majorVersion.MatchString(name) {
+				name = elems[len(elems)-2]
+			}
+		}
+		if seen[imp.path] || (name != "_" && !usesName(f, name)) {
+			continue
+		}
+		seen[imp.path] = true
+		if imp.name != "" {
+			astutil.AddNamedImport(fset, f, imp.name, imp.path)
+		} else {
+			astutil.AddImport(fset, f, imp.path)
+		}
+	}
+}
+
+// majorVersion matches the major version suffix of an import path.
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// usesName returns whether f refers to the package name in a selector
+// expression.
+func usesName(f *ast.File, name string) bool {
+	var used bool
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == name && x.Obj == nil {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
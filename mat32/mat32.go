@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import "gonum.org/v1/gonum/floats/scalar"
+
+// slamchE is the machine epsilon for float32. For IEEE this is 2^{-24}.
+const slamchE = 0x1p-24
+
+// equalWithinAbsOrRel returns true when a and b are equal to within
+// the absolute or relative tolerances. See scalar.EqualWithinAbsOrRel for
+// details.
+func equalWithinAbsOrRel(a, b, absTol, relTol float32) bool {
+	return scalar.EqualWithinAbsOrRel(float64(a), float64(b), float64(absTol), float64(relTol))
+}
@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "gonum.org/v1/gonum/mat"
+
+// NearestRotation returns the rotation matrix nearest to the 3×3 matrix a in
+// the Frobenius norm. Unlike the orthogonal factor of the polar decomposition
+// of a, the returned matrix is never a reflection.
+//
+// NearestRotation returns mat.ErrNoConvergence if the singular value
+// decomposition of a could not be computed. It will panic if a is not 3×3.
+func NearestRotation(a mat.Matrix) (*Mat, error) {
+	if r, c := a.Dims(); r != 3 || c != 3 {
+		panic(mat.ErrShape)
+	}
+	var q mat.Dense
+	err := mat.ProcrustesRotation(&q, Eye(), a)
+	if err != nil {
+		return nil, err
+	}
+	var m Mat
+	m.CloneFrom(&q)
+	return &m, nil
+}
+
+// Similarity is a similarity transform of points in space, composed of a
+// rotation, a uniform scaling and a translation. The transform maps a point p
+// to
+//
+//	Scale * Rotation * p + Translation
+type Similarity struct {
+	Rotation    *Mat
+	Scale       float64
+	Translation Vec
+}
+
+// Transform returns p transformed by s.
+func (s Similarity) Transform(p Vec) Vec {
+	return Add(Scale(s.Scale, s.Rotation.MulVec(p)), s.Translation)
+}
+
+// Register returns the similarity transform that best maps the points in a
+// onto the corresponding points in b, minimizing the sum of squared distances
+//
+//	\sum_i |Scale * Rotation * a[i] + Translation - b[i]|^2
+//
+// The rotation is computed with the Kabsch–Umeyama algorithm and is never a
+// reflection. If scaled is false, the scale of the returned transform is 1,
+// that is, the transform is rigid. If the points in a are all coincident, the
+// scale is zero.
+//
+// Register returns mat.ErrNoConvergence if the singular value decomposition of
+// the cross-covariance of the points could not be computed. It will panic if a
+// and b do not have the same length or if they are empty.
+func Register(a, b []Vec, scaled bool) (Similarity, error) {
+	if len(a) != len(b) {
+		panic(mat.ErrShape)
+	}
+	if len(a) == 0 {
+		panic(mat.ErrZeroLength)
+	}
+
+	ca := centroid(a)
+	cb := centroid(b)
+	pa := mat.NewDense(len(a), 3, nil)
+	pb := mat.NewDense(len(b), 3, nil)
+	for i := range a {
+		p := Sub(a[i], ca)
+		pa.SetRow(i, []float64{p.X, p.Y, p.Z})
+		q := Sub(b[i], cb)
+		pb.SetRow(i, []float64{q.X, q.Y, q.Z})
+	}
+
+	// With the centered points stored in the rows of pa and pb, the rotation
+	// R and scale s minimize ‖s * pa * Rᵀ - pb‖_F.
+	var q mat.Dense
+	s := 1.0
+	var err error
+	if scaled {
+		s, err = mat.ProcrustesScaled(&q, pa, pb, true)
+	} else {
+		err = mat.ProcrustesRotation(&q, pa, pb)
+	}
+	if err != nil {
+		return Similarity{}, err
+	}
+	var r Mat
+	r.CloneFrom(q.T())
+	return Similarity{
+		Rotation:    &r,
+		Scale:       s,
+		Translation: Sub(cb, Scale(s, r.MulVec(ca))),
+	}, nil
+}
+
+// centroid returns the mean of the points in p.
+func centroid(p []Vec) Vec {
+	var c Vec
+	for _, v := range p {
+		c = Add(c, v)
+	}
+	return Scale(1/float64(len(p)), c)
+}
@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3_test
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+func ExampleRegister() {
+	// The corners of a unit tetrahedron.
+	a := []r3.Vec{
+		{X: 0, Y: 0, Z: 0},
+		{X: 1, Y: 0, Z: 0},
+		{X: 0, Y: 1, Z: 0},
+		{X: 0, Y: 0, Z: 1},
+	}
+
+	// The same corners rotated by π/2 around the z axis, scaled by 2 and
+	// then translated.
+	rot := r3.NewRotation(math.Pi/2, r3.Vec{Z: 1})
+	b := make([]r3.Vec, len(a))
+	for i, p := range a {
+		b[i] = r3.Add(r3.Scale(2, rot.Rotate(p)), r3.Vec{X: 1, Y: 2, Z: 3})
+	}
+
+	s, err := r3.Register(a, b, true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	// Round away floating point noise in the rotation for display.
+	var r mat.Dense
+	r.Apply(func(_, _ int, v float64) float64 {
+		return math.Round(v*1e12)/1e12 + 0
+	}, s.Rotation)
+	fmt.Printf("rotation:\n%.4v\n", mat.Formatted(&r))
+	fmt.Printf("scale: %.4v\n", s.Scale)
+	fmt.Printf("translation: %.4v\n", s.Translation)
+
+	// Output:
+	// rotation:
+	// ⎡ 0  -1   0⎤
+	// ⎢ 1   0   0⎥
+	// ⎣ 0   0   1⎦
+	// scale: 2
+	// translation: {1 2 3}
+}
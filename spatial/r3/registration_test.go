@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNearestRotation(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for tc := 0; tc < 20; tc++ {
+		rot := NewRotation(rnd.Float64()*2*math.Pi, randomVec(rnd)).Mat()
+
+		// A slightly perturbed rotation is projected back close to the
+		// original rotation.
+		var a Mat
+		a.Scale(1e-6, randomMat(rnd))
+		a.Add(&a, rot)
+		got, err := NearestRotation(&a)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkRotation(t, got, tol)
+		if !mat.EqualApprox(got, rot, 1e-4) {
+			t.Errorf("unexpected nearest rotation:\ngot:\n%v\nwant:\n%v", mat.Formatted(got), mat.Formatted(rot))
+		}
+
+		// The nearest rotation to an arbitrary matrix is a rotation and
+		// is closer than the original rotation.
+		m := randomMat(rnd)
+		got, err = NearestRotation(m)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkRotation(t, got, tol)
+		var d Mat
+		d.Sub(got, m)
+		dGot := mat.Norm(&d, 2)
+		d.Sub(rot, m)
+		if dRot := mat.Norm(&d, 2); dGot > dRot+tol {
+			t.Errorf("nearest rotation not nearest: distance %v > %v", dGot, dRot)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for tc := 0; tc < 20; tc++ {
+		want := Similarity{
+			Rotation:    NewRotation(rnd.Float64()*2*math.Pi, randomVec(rnd)).Mat(),
+			Scale:       0.5 + 2*rnd.Float64(),
+			Translation: randomVec(rnd),
+		}
+		n := 3 + rnd.IntN(10)
+		a := make([]Vec, n)
+		b := make([]Vec, n)
+		for i := range a {
+			a[i] = randomVec(rnd)
+			b[i] = want.Transform(a[i])
+		}
+
+		got, err := Register(a, b, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkRotation(t, got.Rotation, tol)
+		if !mat.EqualApprox(got.Rotation, want.Rotation, tol) {
+			t.Errorf("unexpected rotation:\ngot:\n%v\nwant:\n%v", mat.Formatted(got.Rotation), mat.Formatted(want.Rotation))
+		}
+		if math.Abs(got.Scale-want.Scale) > tol {
+			t.Errorf("unexpected scale: got %v, want %v", got.Scale, want.Scale)
+		}
+		if Norm(Sub(got.Translation, want.Translation)) > 1e-10 {
+			t.Errorf("unexpected translation: got %v, want %v", got.Translation, want.Translation)
+		}
+
+		// A rigid registration of the unscaled points recovers the
+		// rotation and translation.
+		want.Scale = 1
+		for i := range a {
+			b[i] = want.Transform(a[i])
+		}
+		got, err = Register(a, b, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Scale != 1 {
+			t.Errorf("unexpected scale for rigid registration: %v", got.Scale)
+		}
+		if !mat.EqualApprox(got.Rotation, want.Rotation, tol) {
+			t.Errorf("unexpected rigid rotation:\ngot:\n%v\nwant:\n%v", mat.Formatted(got.Rotation), mat.Formatted(want.Rotation))
+		}
+		for i := range a {
+			if d := Norm(Sub(got.Transform(a[i]), b[i])); d > 1e-10 {
+				t.Errorf("unexpected transformed point %d: distance %v", i, d)
+			}
+		}
+
+		// Mirrored points are registered with a rotation, not a reflection.
+		for i := range a {
+			b[i] = Vec{X: -a[i].X, Y: a[i].Y, Z: a[i].Z}
+		}
+		got, err = Register(a, b, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkRotation(t, got.Rotation, tol)
+	}
+
+	for _, test := range []struct {
+		name string
+		a, b []Vec
+	}{
+		{name: "mismatched lengths", a: make([]Vec, 2), b: make([]Vec, 3)},
+		{name: "empty", a: nil, b: nil},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %s", test.name)
+				}
+			}()
+			_, _ = Register(test.a, test.b, false)
+		}()
+	}
+}
+
+func checkRotation(t *testing.T, r *Mat, tol float64) {
+	t.Helper()
+	var rtr Mat
+	rtr.Mul(r.T(), r)
+	if !mat.EqualApprox(&rtr, Eye(), tol) {
+		t.Errorf("matrix is not orthogonal:\n%v", mat.Formatted(r))
+	}
+	if d := r.Det(); math.Abs(d-1) > tol {
+		t.Errorf("unexpected determinant of rotation: %v", d)
+	}
+}